@@ -211,6 +211,23 @@ def start(args: argparse.Namespace) -> None:
                     f"--storage-max-concurrent-compaction={args.storage_max_concurrent_compaction}")
             if args.storage_verbose:
                 cmd.append("--storage-verbose")
+            if args.storage_shared_db:
+                cmd.append("--storage-shared-db")
+            if args.storage_key_index:
+                cmd.append("--storage-key-index")
+            if args.storage_encryption_keyfile:
+                cmd.append(
+                    f"--storage-encryption-keyfile={args.storage_encryption_keyfile}")
+            if args.storage_data_key_rotation_interval:
+                cmd.append(
+                    f"--storage-data-key-rotation-interval={args.storage_data_key_rotation_interval}")
+            for quota in args.topic_append_quotas or []:
+                cmd.append(f"--topic-append-quotas={quota}")
+            for quota in args.log_stream_append_quotas or []:
+                cmd.append(f"--log-stream-append-quotas={quota}")
+            if args.compaction_interval:
+                cmd.append(
+                    f"--compaction-interval={args.compaction_interval}")
 
             # logging options
             if args.logtostderr:
@@ -264,6 +281,13 @@ def main() -> None:
     parser.add_argument("--storage-mem-table-stop-writes-threshold", type=int)
     parser.add_argument("--storage-max-concurrent-compaction", type=int)
     parser.add_argument("--storage-verbose", action="store_true")
+    parser.add_argument("--storage-shared-db", action="store_true")
+    parser.add_argument("--storage-key-index", action="store_true")
+    parser.add_argument("--storage-encryption-keyfile", type=str)
+    parser.add_argument("--storage-data-key-rotation-interval", type=str)
+    parser.add_argument("--topic-append-quotas", nargs="*", type=str)
+    parser.add_argument("--log-stream-append-quotas", nargs="*", type=str)
+    parser.add_argument("--compaction-interval", type=str)
 
     # logging options
     parser.add_argument("--logtostderr", action="store_true")
@@ -4,14 +4,24 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/urfave/cli/v2"
 
+	"github.com/kakao/varlog/internal/metarepos"
+	"github.com/kakao/varlog/internal/mrsimulator"
 	"github.com/kakao/varlog/pkg/mrc"
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/mrpb"
 )
 
 const (
@@ -21,25 +31,101 @@ const (
 	flagClusterID = "cluster-id"
 	flagAddress   = "address"
 	flagTimeout   = "timeout"
+	flagOutput    = "output"
+	flagInput     = "input"
+
+	flagStorageNodes      = "storage-nodes"
+	flagTopics            = "topics"
+	flagLogStreams        = "log-streams"
+	flagReplicationFactor = "replication-factor"
+	flagListenHost        = "listen-host"
+	flagAppendInterval    = "append-interval"
+	flagBatchSize         = "batch-size"
+	flagDuration          = "duration"
+	flagReportInterval    = "report-interval"
+	flagDebugAddress      = "debug-address"
+	flagLogStreamID       = "log-stream-id"
+	flagRaftDir           = "raft-dir"
+	flagRaftAddress       = "raft-address"
 
 	defaultClusterID = types.ClusterID(1)
 	defaultTimeout   = time.Second
 )
 
 func run() int {
-	const cmdDescribe = "describe"
+	const (
+		cmdDescribe = "describe"
+		cmdBackup   = "backup"
+		cmdRestore  = "restore"
+		cmdSimulate = "simulate"
+
+		cmdRaftStatus    = "raft-status"
+		cmdReports       = "reports"
+		cmdUncommits     = "uncommits"
+		cmdCommitHistory = "commit-history"
+	)
 
 	action := func(c *cli.Context) error {
 		if c.NArg() > 0 {
 			return errors.Errorf("unexpected args: %v", c.Args().Slice())
 		}
 
-		if c.Command.Name == cmdDescribe {
+		switch c.Command.Name {
+		case cmdDescribe:
 			return describe(c)
+		case cmdBackup:
+			return backup(c)
+		case cmdRestore:
+			return restore(c)
+		case cmdSimulate:
+			return simulate(c)
+		case cmdRaftStatus:
+			return debugStatus(c, metarepos.DebugRaftStatusPath, nil)
+		case cmdReports:
+			return debugStatus(c, metarepos.DebugReportStatusPath, nil)
+		case cmdUncommits:
+			query := url.Values{}
+			if c.IsSet(flagLogStreamID) {
+				query.Set("lsid", strconv.Itoa(c.Int(flagLogStreamID)))
+			}
+			return debugStatus(c, metarepos.DebugUncommitsPath, query)
+		case cmdCommitHistory:
+			return debugStatus(c, metarepos.DebugCommitHistoryPath, nil)
 		}
 		return errors.Errorf("unknown command: %s", c.Command.Name)
 	}
 
+	commonFlags := func(flags ...cli.Flag) []cli.Flag {
+		return append([]cli.Flag{
+			&cli.StringFlag{
+				Name:  flagClusterID,
+				Value: defaultClusterID.String(),
+			},
+			&cli.StringFlag{
+				Name:     flagAddress,
+				Required: true,
+			},
+			&cli.DurationFlag{
+				Name:  flagTimeout,
+				Value: defaultTimeout,
+			},
+		}, flags...)
+	}
+
+	debugFlags := func(flags ...cli.Flag) []cli.Flag {
+		return append([]cli.Flag{
+			&cli.StringFlag{
+				Name:     flagDebugAddress,
+				Usage:    "debug address of the metadata repository node",
+				Required: true,
+			},
+			&cli.DurationFlag{
+				Name:  flagTimeout,
+				Value: defaultTimeout,
+			},
+		}, flags...)
+	}
+
 	app := &cli.App{
 		Name:    appName,
 		Version: version,
@@ -47,21 +133,138 @@ func run() int {
 			{
 				Name:   cmdDescribe,
 				Action: action,
+				Flags:  commonFlags(),
+			},
+			{
+				Name:   cmdBackup,
+				Usage:  "export the state machine of the metadata repository",
+				Action: action,
+				Flags: commonFlags(
+					&cli.StringFlag{
+						Name:  flagOutput,
+						Usage: "file to write the backup to, stdout if not set",
+					},
+				),
+			},
+			{
+				Name:  cmdRestore,
+				Usage: "restore the state machine into an empty metadata repository",
+				Description: "If --raft-dir is set, restore bootstraps a new single-node metadata repository offline:\n" +
+					"it writes the state machine into the raft directory, then varlogmr should be started with\n" +
+					"the same raft directory and raft address. Otherwise, it restores the state machine into\n" +
+					"the running metadata repository at --address.",
+				Action: action,
 				Flags: []cli.Flag{
 					&cli.StringFlag{
 						Name:  flagClusterID,
 						Value: defaultClusterID.String(),
 					},
 					&cli.StringFlag{
-						Name:     flagAddress,
-						Required: true,
+						Name:  flagAddress,
+						Usage: "address of the running metadata repository to restore into",
 					},
 					&cli.DurationFlag{
 						Name:  flagTimeout,
 						Value: defaultTimeout,
 					},
+					&cli.StringFlag{
+						Name:     flagInput,
+						Usage:    "file written by the backup command",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:  flagRaftDir,
+						Usage: "raft directory of the new metadata repository to bootstrap",
+					},
+					&cli.StringFlag{
+						Name:  flagRaftAddress,
+						Usage: "raft address of the new metadata repository to bootstrap",
+					},
 				},
 			},
+			{
+				Name:   cmdSimulate,
+				Usage:  "put load on a dedicated metadata repository by using fake storage nodes",
+				Action: action,
+				Flags: commonFlags(
+					&cli.IntFlag{
+						Name:  flagStorageNodes,
+						Usage: "number of fake storage nodes",
+						Value: mrsimulator.DefaultNumStorageNodes,
+					},
+					&cli.IntFlag{
+						Name:  flagTopics,
+						Usage: "number of topics",
+						Value: mrsimulator.DefaultNumTopics,
+					},
+					&cli.IntFlag{
+						Name:  flagLogStreams,
+						Usage: "number of log streams spread over topics",
+						Value: mrsimulator.DefaultNumLogStreams,
+					},
+					&cli.IntFlag{
+						Name:  flagReplicationFactor,
+						Usage: "replication factor of the metadata repository",
+						Value: mrsimulator.DefaultReplicationFactor,
+					},
+					&cli.StringFlag{
+						Name:  flagListenHost,
+						Usage: "host on which fake storage nodes listen, reachable from the metadata repository",
+						Value: mrsimulator.DefaultListenHost,
+					},
+					&cli.DurationFlag{
+						Name:  flagAppendInterval,
+						Usage: "interval at which every log stream appends a batch",
+						Value: mrsimulator.DefaultAppendInterval,
+					},
+					&cli.IntFlag{
+						Name:  flagBatchSize,
+						Usage: "number of log entries in a batch",
+						Value: mrsimulator.DefaultBatchSize,
+					},
+					&cli.DurationFlag{
+						Name:  flagDuration,
+						Value: mrsimulator.DefaultDuration,
+					},
+					&cli.DurationFlag{
+						Name:  flagReportInterval,
+						Value: mrsimulator.DefaultReportInterval,
+					},
+					&cli.StringSliceFlag{
+						Name:  flagDebugAddress,
+						Usage: "debug addresses of metadata repository nodes to read memory usage from",
+					},
+				),
+			},
+			{
+				Name:   cmdRaftStatus,
+				Usage:  "show term, leader, applied and committed index of the raft node",
+				Action: action,
+				Flags:  debugFlags(),
+			},
+			{
+				Name:   cmdReports,
+				Usage:  "show how fresh reports from each storage node are",
+				Action: action,
+				Flags:  debugFlags(),
+			},
+			{
+				Name:   cmdUncommits,
+				Usage:  "show uncommit reports of log streams",
+				Action: action,
+				Flags: debugFlags(
+					&cli.IntFlag{
+						Name:  flagLogStreamID,
+						Usage: "log stream to show, all log streams if not set",
+					},
+				),
+			},
+			{
+				Name:   cmdCommitHistory,
+				Usage:  "show oldest, latest and trim version of the commit history",
+				Action: action,
+				Flags:  debugFlags(),
+			},
 		},
 	}
 
@@ -101,6 +304,153 @@ func describe(c *cli.Context) error {
 	return nil
 }
 
+func backup(c *cli.Context) error {
+	clusterID, err := types.ParseClusterID(c.String(flagClusterID))
+	if err != nil {
+		return err
+	}
+	address := c.String(flagAddress)
+	timeout := c.Duration(flagTimeout)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	mcl, err := mrc.NewMetadataRepositoryManagementClient(ctx, address)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = mcl.Close()
+	}()
+
+	rsp, err := mcl.Backup(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+
+	buf, err := json.Marshal(rsp)
+	if err != nil {
+		return err
+	}
+
+	output := c.String(flagOutput)
+	if len(output) == 0 {
+		fmt.Println(string(buf))
+		return nil
+	}
+	return os.WriteFile(output, buf, 0o644)
+}
+
+func restore(c *cli.Context) error {
+	clusterID, err := types.ParseClusterID(c.String(flagClusterID))
+	if err != nil {
+		return err
+	}
+	address := c.String(flagAddress)
+	timeout := c.Duration(flagTimeout)
+
+	buf, err := os.ReadFile(c.String(flagInput))
+	if err != nil {
+		return err
+	}
+	var bak mrpb.BackupResponse
+	if err := json.Unmarshal(buf, &bak); err != nil {
+		return err
+	}
+	if bak.ClusterID != clusterID {
+		return errors.Errorf("cluster id mismatch: backup %v, expected %v", bak.ClusterID, clusterID)
+	}
+
+	if c.IsSet(flagRaftDir) {
+		if !c.IsSet(flagRaftAddress) {
+			return errors.Errorf("--%s is required to bootstrap", flagRaftAddress)
+		}
+		return metarepos.BootstrapFromBackup(c.String(flagRaftDir), c.String(flagRaftAddress), &bak, nil)
+	}
+	if !c.IsSet(flagAddress) {
+		return errors.Errorf("either --%s or --%s is required", flagAddress, flagRaftDir)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	mcl, err := mrc.NewMetadataRepositoryManagementClient(ctx, address)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = mcl.Close()
+	}()
+
+	return mcl.Restore(ctx, clusterID, bak.StateMachine)
+}
+
+func simulate(c *cli.Context) error {
+	clusterID, err := types.ParseClusterID(c.String(flagClusterID))
+	if err != nil {
+		return err
+	}
+
+	sim, err := mrsimulator.New(
+		mrsimulator.WithClusterID(clusterID),
+		mrsimulator.WithMetadataRepositoryAddress(c.String(flagAddress)),
+		mrsimulator.WithDebugAddress(c.StringSlice(flagDebugAddress)...),
+		mrsimulator.WithNumStorageNodes(c.Int(flagStorageNodes)),
+		mrsimulator.WithNumTopics(c.Int(flagTopics)),
+		mrsimulator.WithNumLogStreams(c.Int(flagLogStreams)),
+		mrsimulator.WithReplicationFactor(c.Int(flagReplicationFactor)),
+		mrsimulator.WithListenHost(c.String(flagListenHost)),
+		mrsimulator.WithAppendInterval(c.Duration(flagAppendInterval)),
+		mrsimulator.WithBatchSize(c.Int(flagBatchSize)),
+		mrsimulator.WithDuration(c.Duration(flagDuration)),
+		mrsimulator.WithReportInterval(c.Duration(flagReportInterval)),
+		mrsimulator.WithRPCTimeout(c.Duration(flagTimeout)),
+	)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = sim.Close()
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return sim.Run(ctx)
+}
+
+// debugStatus prints the status served by the debug server of a metadata
+// repository node.
+func debugStatus(c *cli.Context, path string, query url.Values) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.Duration(flagTimeout))
+	defer cancel()
+
+	u := url.URL{
+		Scheme:   "http",
+		Host:     c.String(flagDebugAddress),
+		Path:     path,
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = rsp.Body.Close()
+	}()
+
+	buf, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return err
+	}
+	if rsp.StatusCode != http.StatusOK {
+		return errors.Errorf("%s: %s", rsp.Status, strings.TrimSpace(string(buf)))
+	}
+	_, err = os.Stdout.Write(buf)
+	return err
+}
+
 func main() {
 	os.Exit(run())
 }
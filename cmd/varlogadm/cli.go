@@ -40,6 +40,9 @@ func newStartCommand() *cli.Command {
 			flagLogStreamGCTimeout.DurationFlag(false, admin.DefaultLogStreamGCTimeout),
 			flagDisableAutoLogStreamSync.BoolFlag(),
 			flagAutoUnseal.BoolFlag(),
+			flagOperationStorePath.StringFlag(false, ""),
+			flagLeaderElection.StringFlag(false, ""),
+			flagLeaderLease.DurationFlag(false, admin.DefaultLeaderLease),
 
 			flagMetadataRepository.StringSliceFlag(true, nil),
 			flagInitMRConnRetryCount.IntFlag(false, mrmanager.DefaultInitialMRConnectRetryCount),
@@ -100,6 +103,8 @@ func start(c *cli.Context) error {
 		admin.WithListenAddress(c.String(flagListen.Name)),
 		admin.WithReplicationFactor(c.Uint(flagReplicationFactor.Name)),
 		admin.WithLogStreamGCTimeout(c.Duration(flagLogStreamGCTimeout.Name)),
+		admin.WithOperationStorePath(c.String(flagOperationStorePath.Name)),
+		admin.WithLeaderLease(c.Duration(flagLeaderLease.Name)),
 		admin.WithMetadataRepositoryManager(mrMgr),
 		admin.WithStorageNodeManager(snMgr),
 		admin.WithStorageNodeWatcherOptions(
@@ -113,6 +118,9 @@ func start(c *cli.Context) error {
 	if c.Bool(flagAutoUnseal.Name) {
 		opts = append(opts, admin.WithAutoUnseal())
 	}
+	if addr := c.String(flagLeaderElection.Name); len(addr) != 0 {
+		opts = append(opts, admin.WithLeaderElection(addr))
+	}
 	return Main(opts, logger)
 }
 
@@ -31,6 +31,23 @@ var (
 		Envs:    []string{"AUTO_UNSEAL", "ENABLE_AUTO_UNSEAL", "WITH_AUTO_UNSEAL"},
 	}
 
+	flagOperationStorePath = flags.FlagDesc{
+		Name:  "operation-store-path",
+		Usage: "path of the file that long-running operations are persisted to",
+		Envs:  []string{"OPERATION_STORE_PATH"},
+	}
+
+	flagLeaderElection = flags.FlagDesc{
+		Name:  "leader-election",
+		Usage: "enable leader election among admin servers, the value is the address advertised to clients",
+		Envs:  []string{"LEADER_ELECTION"},
+	}
+	flagLeaderLease = flags.FlagDesc{
+		Name:  "leader-lease",
+		Usage: "length of the lease for the leader of admin servers",
+		Envs:  []string{"LEADER_LEASE"},
+	}
+
 	flagInitMRConnRetryCount = flags.FlagDesc{
 		Name:  "init-mr-conn-retry-count",
 		Usage: "the number of retry of initial metadata repository connect",
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/kakao/varlog/internal/varlogctl/cluster"
+)
+
+func newApplyCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "apply",
+		Usage: "reconcile the cluster with the spec, it prints the plan without applying it unless --apply is set",
+		Action: func(c *cli.Context) error {
+			if c.NArg() > 0 {
+				return fmt.Errorf("apply command: unexpected args: %v", c.Args().Slice())
+			}
+			spec, err := cluster.ReadSpec(c.String(flagSpecFile.name))
+			if err != nil {
+				return fmt.Errorf("apply command: %w", err)
+			}
+			return execute(c, cluster.Apply(spec, c.Bool(flagApply.name)))
+		},
+		Flags: commonFlags(
+			flagSpecFile.StringFlag(true, ""),
+			flagApply.BoolFlag(),
+		),
+	}
+}
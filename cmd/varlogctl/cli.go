@@ -32,6 +32,9 @@ func newVarlogControllerApp() *cli.App {
 			newTopicCommand(),
 			newLogStreamCommand(),
 			newMetadataRepositoryCommand(),
+			newOperationCommand(),
+			newApplyCommand(),
+			newEventCommand(),
 		},
 	}
 	return app
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/kakao/varlog/internal/varlogctl"
+	"github.com/kakao/varlog/internal/varlogctl/event"
+)
+
+func newEventCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "events",
+		Aliases: []string{"event"},
+		Usage:   "print events of the cluster, it waits for new events if --follow is set",
+		Action: func(c *cli.Context) error {
+			if c.NArg() > 0 {
+				return fmt.Errorf("events command: unexpected args: %v", c.Args().Slice())
+			}
+
+			after := c.Uint64(flagAfterEventID.name)
+			var f varlogctl.ExecuteFunc
+			if c.Bool(flagFollow.name) {
+				// Following events lasts until interrupted unless the
+				// timeout is given explicitly.
+				if !c.IsSet(flagTimeout.name) {
+					if err := c.Set(flagTimeout.name, "0"); err != nil {
+						return fmt.Errorf("events command: %w", err)
+					}
+				}
+				f = event.Follow(after, os.Stdout)
+			} else {
+				f = event.Describe(after)
+			}
+			return execute(c, f)
+		},
+		Flags: commonFlags(
+			flagAfterEventID.Uint64Flag(false, 0),
+			flagFollow.BoolFlag(),
+		),
+	}
+}
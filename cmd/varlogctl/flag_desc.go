@@ -50,6 +50,18 @@ func (fd *flagDesc) IntFlag(required bool, defaultValue int) *cli.IntFlag {
 	}
 }
 
+func (fd *flagDesc) Int64Flag(required bool, defaultValue int64) *cli.Int64Flag {
+	return &cli.Int64Flag{
+		Name:        fd.name,
+		Aliases:     fd.aliases,
+		Usage:       fd.usage,
+		EnvVars:     fd.envs,
+		Required:    required,
+		Value:       defaultValue,
+		DefaultText: fd.defaultText,
+	}
+}
+
 func (fd *flagDesc) StringFlag(required bool, defaultValue string) *cli.StringFlag {
 	return &cli.StringFlag{
 		Name:        fd.name,
@@ -24,6 +24,10 @@ var (
 	flagMRRPCAddr = flagDesc{
 		name: "rpc-addr",
 	}
+	flagMRMaxLag = flagDesc{
+		name:  "max-lag",
+		usage: "maximum number of entries that a metadata repository can lag behind the leader",
+	}
 
 	flagSNAddr = flagDesc{
 		name:    "storage-node-address",
@@ -34,10 +38,27 @@ var (
 		aliases: []string{"storagenode-id", "snid"},
 	}
 
+	flagSyncBandwidth = flagDesc{
+		name:  "bandwidth",
+		usage: "bandwidth limit per second for synchronization (B, KiB, MiB, GiB), zero means unlimited",
+	}
+	flagAppendQuotaBytes = flagDesc{
+		name:  "bytes-per-second",
+		usage: "limit of appended bytes per second (B, KiB, MiB, GiB), zero means unlimited",
+	}
+	flagAppendQuotaRecords = flagDesc{
+		name:  "records-per-second",
+		usage: "limit of appended log entries per second, zero means unlimited",
+	}
+
 	flagTopicID = flagDesc{
 		name:    "topic-id",
 		aliases: []string{"tpid"},
 	}
+	flagTopicCompacted = flagDesc{
+		name:  "compacted",
+		usage: "keep only the latest log entry for each record key in the topic",
+	}
 
 	flagLogStreamID = flagDesc{
 		name:    "log-stream-id",
@@ -49,4 +70,37 @@ var (
 	flagSyncDst = flagDesc{
 		name: "dst",
 	}
+	flagCheckpointPath = flagDesc{
+		name:  "path",
+		usage: "absolute path in the storage node where the checkpoint is made, which must not exist",
+	}
+	flagCheckpointArchive = flagDesc{
+		name:  "archive",
+		usage: "make the checkpoint a gzipped tar file",
+	}
+
+	flagOperationID = flagDesc{
+		name:    "operation-id",
+		aliases: []string{"opid"},
+	}
+
+	flagSpecFile = flagDesc{
+		name:    "file",
+		aliases: []string{"f"},
+		usage:   "path of the cluster spec written in YAML",
+	}
+	flagApply = flagDesc{
+		name:  "apply",
+		usage: "execute the plan",
+	}
+
+	flagAfterEventID = flagDesc{
+		name:  "after",
+		usage: "print events whose identifiers are greater than it",
+	}
+	flagFollow = flagDesc{
+		name:    "follow",
+		aliases: []string{"f"},
+		usage:   "wait for new events until interrupted",
+	}
 )
@@ -12,12 +12,13 @@ import (
 
 func newLogStreamCommand() *cli.Command {
 	const (
-		cmdAdd      = "add"
-		cmdSeal     = "seal"
-		cmdUnseal   = "unseal"
-		cmdSync     = "sync"
-		cmdDescribe = "get"
-		cmdRecover  = "recover"
+		cmdAdd        = "add"
+		cmdSeal       = "seal"
+		cmdUnseal     = "unseal"
+		cmdSync       = "sync"
+		cmdCheckpoint = "checkpoint"
+		cmdDescribe   = "get"
+		cmdRecover    = "recover"
 	)
 
 	action := func(c *cli.Context) error {
@@ -62,6 +63,12 @@ func newLogStreamCommand() *cli.Command {
 				return fmt.Errorf("log stream command: %w", err)
 			}
 			f = logstream.Sync(topicID, logStreamID, src, dst)
+		case cmdCheckpoint:
+			snid, err := types.ParseStorageNodeID(c.String(flagStorageNodeID.name))
+			if err != nil {
+				return fmt.Errorf("log stream command: %w", err)
+			}
+			f = logstream.Checkpoint(topicID, logStreamID, snid, c.String(flagCheckpointPath.name), c.Bool(flagCheckpointArchive.name))
 		case cmdDescribe:
 			if c.IsSet(flagLogStreamID.name) {
 				f = logstream.Describe(topicID, logStreamID)
@@ -110,6 +117,18 @@ func newLogStreamCommand() *cli.Command {
 					flagSyncDst.StringFlag(true, ""),
 				),
 			},
+			{
+				Name:   cmdCheckpoint,
+				Usage:  "make a checkpoint of the log stream replica for backup",
+				Action: action,
+				Flags: commonFlags(
+					flagTopicID.StringFlag(true, ""),
+					flagLogStreamID.StringFlag(true, ""),
+					flagStorageNodeID.StringFlag(true, ""),
+					flagCheckpointPath.StringFlag(true, ""),
+					flagCheckpointArchive.BoolFlag(),
+				),
+			},
 			{
 				Name:    cmdDescribe,
 				Aliases: []string{"describe"},
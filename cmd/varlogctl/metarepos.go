@@ -14,6 +14,8 @@ func newMetadataRepositoryCommand() *cli.Command {
 		cmdAdd      = "add"
 		cmdRemove   = "remove"
 		cmdDescribe = "get"
+		cmdTransfer = "transfer-leader"
+		cmdHealth   = "health"
 	)
 	action := func(c *cli.Context) error {
 		if c.NArg() > 0 {
@@ -34,6 +36,10 @@ func newMetadataRepositoryCommand() *cli.Command {
 			} else {
 				f = metarepos.Describe()
 			}
+		case cmdTransfer:
+			f = metarepos.TransferLeadership(raftURL)
+		case cmdHealth:
+			f = metarepos.Health(c.Uint64(flagMRMaxLag.name))
 		default:
 			return fmt.Errorf("metadata repository command: unknown command: %s", c.Command.Name)
 		}
@@ -68,6 +74,22 @@ func newMetadataRepositoryCommand() *cli.Command {
 					flagMRRaftURL.StringFlag(false, ""),
 				),
 			},
+			{
+				Name:   cmdTransfer,
+				Usage:  "transfer the leadership to a metadata repository",
+				Action: action,
+				Flags: commonFlags(
+					flagMRRaftURL.StringFlag(true, ""),
+				),
+			},
+			{
+				Name:   cmdHealth,
+				Usage:  "check whether all metadata repositories are active and catch up with the leader",
+				Action: action,
+				Flags: commonFlags(
+					flagMRMaxLag.Uint64Flag(false, 0),
+				),
+			},
 		},
 	}
 }
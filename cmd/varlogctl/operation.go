@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/kakao/varlog/internal/varlogctl"
+	"github.com/kakao/varlog/internal/varlogctl/operation"
+)
+
+func newOperationCommand() *cli.Command {
+	const (
+		cmdDescribe = "get"
+		cmdCancel   = "cancel"
+	)
+
+	action := func(c *cli.Context) error {
+		if c.NArg() > 0 {
+			return fmt.Errorf("operation command: unexpected args: %v", c.Args().Slice())
+		}
+
+		var opid uint64
+		if c.IsSet(flagOperationID.name) {
+			var err error
+			opid, err = strconv.ParseUint(c.String(flagOperationID.name), 10, 64)
+			if err != nil {
+				return fmt.Errorf("operation command: %w", err)
+			}
+		}
+
+		var f varlogctl.ExecuteFunc
+		switch c.Command.Name {
+		case cmdDescribe:
+			if c.IsSet(flagOperationID.name) {
+				f = operation.Describe(opid)
+			} else {
+				f = operation.Describe()
+			}
+		case cmdCancel:
+			f = operation.Cancel(opid)
+		default:
+			return fmt.Errorf("operation command: unknown command: %s", c.Command.Name)
+		}
+		return execute(c, f)
+	}
+
+	return &cli.Command{
+		Name:    "operation",
+		Aliases: []string{"op"},
+		Subcommands: []*cli.Command{
+			{
+				Name:    cmdDescribe,
+				Aliases: []string{"describe"},
+				Usage:   "describe a long-running operation",
+				Action:  action,
+				Flags: commonFlags(
+					flagOperationID.StringFlag(false, ""),
+				),
+			},
+			{
+				Name:   cmdCancel,
+				Usage:  "cancel a long-running operation",
+				Action: action,
+				Flags: commonFlags(
+					flagOperationID.StringFlag(true, ""),
+				),
+			},
+		},
+	}
+}
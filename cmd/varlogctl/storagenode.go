@@ -8,6 +8,8 @@ import (
 	"github.com/kakao/varlog/internal/varlogctl"
 	"github.com/kakao/varlog/internal/varlogctl/storagenode"
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/util/units"
+	"github.com/kakao/varlog/proto/snpb"
 )
 
 func newStorageNodeCommand() *cli.Command {
@@ -15,6 +17,9 @@ func newStorageNodeCommand() *cli.Command {
 		cmdDescribe            = "get"
 		cmdAdd                 = "add"
 		cmdRemove              = "remove"
+		cmdDrain               = "drain"
+		cmdSetSyncBandwidth    = "set-sync-bandwidth"
+		cmdSetAppendQuota      = "set-append-quota"
 		cmdUnregisterLogStream = "unregister-log-stream"
 	)
 	action := func(c *cli.Context) error {
@@ -44,6 +49,34 @@ func newStorageNodeCommand() *cli.Command {
 			f = storagenode.Add(addr, snid)
 		case cmdRemove:
 			f = storagenode.Remove(addr, snid)
+		case cmdDrain:
+			f = storagenode.Drain(snid)
+		case cmdSetSyncBandwidth:
+			bandwidth, err := units.FromByteSizeString(c.String(flagSyncBandwidth.name))
+			if err != nil {
+				return fmt.Errorf("storage node command: %w", err)
+			}
+			f = storagenode.SetSyncBandwidth(snid, bandwidth)
+		case cmdSetAppendQuota:
+			tpid, err := types.ParseTopicID(c.String(flagTopicID.name))
+			if err != nil {
+				return fmt.Errorf("storage node command: %w", err)
+			}
+			var lsid types.LogStreamID
+			if c.IsSet(flagLogStreamID.name) {
+				lsid, err = types.ParseLogStreamID(c.String(flagLogStreamID.name))
+				if err != nil {
+					return fmt.Errorf("storage node command: %w", err)
+				}
+			}
+			bytesPerSecond, err := units.FromByteSizeString(c.String(flagAppendQuotaBytes.name))
+			if err != nil {
+				return fmt.Errorf("storage node command: %w", err)
+			}
+			f = storagenode.SetAppendQuota(snid, tpid, lsid, snpb.AppendQuota{
+				BytesPerSecond:   bytesPerSecond,
+				RecordsPerSecond: c.Int64(flagAppendQuotaRecords.name),
+			})
 
 		case cmdUnregisterLogStream:
 			panic("not implemented")
@@ -80,6 +113,33 @@ func newStorageNodeCommand() *cli.Command {
 					flagStorageNodeID.StringFlag(true, ""),
 				),
 			},
+			{
+				Name:   cmdDrain, // or decommission
+				Action: action,
+				Flags: commonFlags(
+					flagStorageNodeID.StringFlag(true, ""),
+				),
+			},
+			{
+				Name:   cmdSetSyncBandwidth,
+				Action: action,
+				Flags: commonFlags(
+					flagStorageNodeID.StringFlag(true, ""),
+					flagSyncBandwidth.StringFlag(true, ""),
+				),
+			},
+			{
+				Name:   cmdSetAppendQuota,
+				Usage:  "set the quota of appends to a topic, or a log stream if given, in the storage node",
+				Action: action,
+				Flags: commonFlags(
+					flagStorageNodeID.StringFlag(true, ""),
+					flagTopicID.StringFlag(true, ""),
+					flagLogStreamID.StringFlag(false, ""),
+					flagAppendQuotaBytes.StringFlag(false, "0"),
+					flagAppendQuotaRecords.Int64Flag(false, 0),
+				),
+			},
 		},
 	}
 }
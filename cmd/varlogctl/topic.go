@@ -40,7 +40,7 @@ func newTopicCommand() *cli.Command {
 				f = topic.Describe()
 			}
 		case cmdAdd:
-			f = topic.Add()
+			f = topic.Add(c.Bool(flagTopicCompacted.name))
 		case cmdRemove:
 			f = topic.Remove(tpid)
 		default:
@@ -65,7 +65,9 @@ func newTopicCommand() *cli.Command {
 				Name:   cmdAdd,
 				Usage:  "add a new topic",
 				Action: action,
-				Flags:  commonFlags(),
+				Flags: commonFlags(
+					flagTopicCompacted.BoolFlag(),
+				),
 			},
 			{
 				Name:   cmdRemove,
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/urfave/cli/v2"
 	"go.uber.org/zap"
@@ -39,9 +41,15 @@ func execute(c *cli.Context, f varlogctl.ExecuteFunc) (err error) {
 		_ = logger.Sync()
 	}()
 
-	timeout := c.Duration(flagTimeout.name)
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	// A non-positive timeout means that the command runs until it is
+	// interrupted.
+	if timeout := c.Duration(flagTimeout.name); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
 
 	adminAddr := c.String(flagAdminAddress.name)
 	admin, err := varlog.NewAdmin(ctx, adminAddr)
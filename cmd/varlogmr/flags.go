@@ -5,6 +5,7 @@ import (
 
 	"github.com/kakao/varlog/internal/flags"
 	"github.com/kakao/varlog/internal/metarepos"
+	"github.com/kakao/varlog/pkg/util/units"
 )
 
 var (
@@ -126,6 +127,12 @@ var (
 		Envs: []string{"REPORTCOMMITTER_WRITE_BUFFER_SIZE"},
 	}
 
+	flagReportHeartbeatInterval = &cli.DurationFlag{
+		Name:    "report-heartbeat-interval",
+		Usage:   "Storage nodes push reports when they change and at least every interval, reports are pulled if it is zero",
+		EnvVars: []string{"REPORT_HEARTBEAT_INTERVAL"},
+	}
+
 	flagMaxTopicsCount = &cli.IntFlag{
 		Name:  "max-topics-count",
 		Usage: "Maximum number of topics, infinity if it is negative",
@@ -137,6 +144,24 @@ var (
 		Value: metarepos.DefaultMaxLogStreamsCountPerTopic,
 	}
 
+	flagSnapshotCompression = &cli.BoolFlag{
+		Name:    "snapshot-compression",
+		Usage:   "Compress snapshots with snappy",
+		EnvVars: []string{"SNAPSHOT_COMPRESSION"},
+		Value:   metarepos.DefaultSnapshotCompression,
+	}
+	flagSnapshotChunkSize = &cli.StringFlag{
+		Name:    "snapshot-chunk-size",
+		Usage:   "Size of commit history chunks in snapshots",
+		EnvVars: []string{"SNAPSHOT_CHUNK_SIZE"},
+		Value:   units.ToByteSizeString(metarepos.DefaultSnapshotChunkSize),
+	}
+	flagSnapshotDelta = &cli.BoolFlag{
+		Name:    "snapshot-delta",
+		Usage:   "Send only commit history the follower does not have when sending snapshots",
+		EnvVars: []string{"SNAPSHOT_DELTA"},
+	}
+
 	flagTelemetryCollectorName = flags.FlagDesc{
 		Name:    "telemetry-collector-name",
 		Aliases: []string{"collector-name"},
@@ -54,6 +54,11 @@ func start(c *cli.Context) error {
 		return err
 	}
 
+	snapChunkSize, err := units.FromByteSizeString(c.String(flagSnapshotChunkSize.Name))
+	if err != nil {
+		return err
+	}
+
 	opts := []metarepos.Option{
 		metarepos.WithClusterID(cid),
 		metarepos.WithRPCAddress(c.String(flagRPCAddr.Name)),
@@ -64,8 +69,12 @@ func start(c *cli.Context) error {
 		metarepos.WithSnapshotCount(c.Uint64(flagSnapshotCount.Name)),
 		metarepos.WithMaxSnapPurgeCount(c.Uint(flagMaxSnapPurgeCount.Name)),
 		metarepos.WithMaxWALPurgeCount(c.Uint(flagMaxWALPurgeCount.Name)),
+		metarepos.WithSnapshotCompression(c.Bool(flagSnapshotCompression.Name)),
+		metarepos.WithSnapshotChunkSize(int(snapChunkSize)),
+		metarepos.WithSnapshotDelta(c.Bool(flagSnapshotDelta.Name)),
 		metarepos.WithReportCommitterReadBufferSize(int(readBufferSize)),
 		metarepos.WithReportCommitterWriteBufferSize(int(writeBufferSize)),
+		metarepos.WithReportHeartbeatInterval(c.Duration(flagReportHeartbeatInterval.Name)),
 		metarepos.WithPeers(c.StringSlice(flagPeers.Name)...),
 		metarepos.WithMaxTopicsCount(int32(c.Int(flagMaxTopicsCount.Name))),
 		metarepos.WithMaxLogStreamsCountPerTopic(int32(c.Int(flagMaxLogStreamsCountPerTopic.Name))),
@@ -116,11 +125,15 @@ func initCLI() *cli.App {
 				flagMaxSnapshotCatchUpCount.Uint64Flag(false, metarepos.DefaultSnapshotCatchUpCount),
 				flagMaxSnapPurgeCount.UintFlag(false, metarepos.DefaultSnapshotPurgeCount),
 				flagMaxWALPurgeCount.UintFlag(false, metarepos.DefaultWalPurgeCount),
+				flagSnapshotCompression,
+				flagSnapshotChunkSize,
+				flagSnapshotDelta,
 				flagRaftTick.DurationFlag(false, metarepos.DefaultRaftTick),
 				flagRaftDir.StringFlag(false, metarepos.DefaultRaftDir),
 				flagPeers.StringSliceFlag(false, nil),
 				flagReportCommitterReadBufferSize.StringFlag(false, units.ToByteSizeString(metarepos.DefaultReportCommitterReadBufferSize)),
 				flagReportCommitterWriteBufferSize.StringFlag(false, units.ToByteSizeString(metarepos.DefaultReportCommitterWriteBufferSize)),
+				flagReportHeartbeatInterval,
 				flagMaxTopicsCount,
 				flagMaxLogStreamsCountPerTopic,
 				flagTelemetryCollectorName.StringFlag(false, metarepos.DefaultTelemetryCollectorName),
@@ -24,6 +24,9 @@ func newStorageNodeApp() *cli.App {
 		Version: version,
 		Commands: []*cli.Command{
 			newStartCommand(),
+			newRestoreCommand(),
+			newInspectCommand(),
+			newEncryptionCommand(),
 		},
 	}
 }
@@ -54,6 +57,12 @@ func newStartCommand() *cli.Command {
 			flagLogStreamExecutorWriteQueueCapacity.IntFlag(false, logstream.DefaultWriteQueueCapacity),
 			flagLogStreamExecutorCommitQueueCapacity.IntFlag(false, logstream.DefaultCommitQueueCapacity),
 			flagLogStreamExecutorReplicateclientQueueCapacity.IntFlag(false, logstream.DefaultReplicateClientQueueCapacity),
+			flagLogStreamExecutorDisableBulkSync.BoolFlag(),
+			flagSyncBandwidth.StringFlag(false, "0"),
+			flagMaxChunkGroupSize.StringFlag(false, units.ToByteSizeString(storagenode.DefaultMaxChunkGroupSize)),
+			flagMaxChunkGroupChunks.IntFlag(false, storagenode.DefaultMaxChunkGroupChunks),
+			flagTopicAppendQuotas.StringSliceFlag(false, nil),
+			flagLogStreamAppendQuotas.StringSliceFlag(false, nil),
 			flagMaxLogStreamReplicasCount,
 
 			// storage options
@@ -67,6 +76,11 @@ func newStartCommand() *cli.Command {
 			flagStorageMemTableStopWritesThreshold.IntFlag(false, storage.DefaultMemTableStopWritesThreshold),
 			flagStorageMaxConcurrentCompaction.IntFlag(false, storage.DefaultMaxConcurrentCompactions),
 			flagStorageVerbose.BoolFlag(),
+			flagStorageSharedDB.BoolFlag(),
+			flagStorageKeyIndex.BoolFlag(),
+			flagStorageEncryptionKeyfile.StringFlag(false, ""),
+			flagStorageDataKeyRotationInterval.DurationFlag(false, 0),
+			flagCompactionInterval.DurationFlag(false, storagenode.DefaultCompactionInterval),
 
 			flagLogDir.StringFlag(false, ""),
 			flagLogToStderr.BoolFlag(),
@@ -82,3 +96,92 @@ func newStartCommand() *cli.Command {
 		},
 	}
 }
+
+func newRestoreCommand() *cli.Command {
+	return &cli.Command{
+		Name:   "restore",
+		Usage:  "restore a checkpoint of a log stream replica into a volume of the storage node",
+		Action: restore,
+		Flags: []cli.Flag{
+			flagClusterID.StringFlag(false, types.ClusterID(1).String()),
+			flagStorageNodeID.StringFlag(false, types.StorageNodeID(1).String()),
+			flagRestoreCheckpoint.StringFlag(true, ""),
+			flagRestoreVolume.StringFlag(true, ""),
+			flagStorageEncryptionKeyfile.StringFlag(false, ""),
+		},
+	}
+}
+
+func newInspectCommand() *cli.Command {
+	pathFlag := flagInspectPath.StringFlag(true, "")
+	keyfileFlag := flagStorageEncryptionKeyfile.StringFlag(false, "")
+	return &cli.Command{
+		Name:  "inspect",
+		Usage: "inspect and repair a log stream replica offline, the storage node must not be running",
+		Subcommands: []*cli.Command{
+			{
+				Name:   "recovery-points",
+				Usage:  "print the commit context and the first and last committed log entries",
+				Action: inspectRecoveryPoints,
+				Flags:  []cli.Flag{pathFlag, keyfileFlag},
+			},
+			{
+				Name:   "dump",
+				Usage:  "print log entries in the range of llsn or glsn",
+				Action: inspectDump,
+				Flags: []cli.Flag{
+					pathFlag,
+					keyfileFlag,
+					flagInspectLLSNBegin.Uint64Flag(false, uint64(types.MinLLSN)),
+					flagInspectLLSNEnd.Uint64Flag(false, uint64(types.MaxLLSN)),
+					flagInspectGLSNBegin.Uint64Flag(false, uint64(types.MinGLSN)),
+					flagInspectGLSNEnd.Uint64Flag(false, uint64(types.MaxGLSN)),
+					flagInspectFormat.StringFlag(false, inspectFormatJSON),
+				},
+			},
+			{
+				Name:   "check",
+				Usage:  "check the consistency between data and commits",
+				Action: inspectCheck,
+				Flags:  []cli.Flag{pathFlag, keyfileFlag},
+			},
+			{
+				Name:   "truncate",
+				Usage:  "delete log entries written but not committed after confirmation",
+				Action: inspectTruncate,
+				Flags: []cli.Flag{
+					pathFlag,
+					keyfileFlag,
+					flagInspectYes.BoolFlag(),
+				},
+			},
+		},
+	}
+}
+
+func newEncryptionCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "encryption",
+		Usage: "manage keys for encryption at rest",
+		Subcommands: []*cli.Command{
+			{
+				Name:   "generate-kek",
+				Usage:  "append a new random key-encryption key to the keyfile, which becomes current",
+				Action: generateKEK,
+				Flags: []cli.Flag{
+					flagStorageEncryptionKeyfile.StringFlag(true, ""),
+					flagEncryptionKEKID.StringFlag(true, ""),
+				},
+			},
+			{
+				Name:   "rotate-data-key",
+				Usage:  "generate a new data key of a log stream replica offline, the storage node must not be running",
+				Action: rotateDataKey,
+				Flags: []cli.Flag{
+					flagInspectPath.StringFlag(true, ""),
+					flagStorageEncryptionKeyfile.StringFlag(true, ""),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"go.uber.org/multierr"
+
+	"github.com/kakao/varlog/internal/storage"
+	"github.com/kakao/varlog/internal/storage/encryption"
+)
+
+// encryptionStorageOptions returns storage options for encryption at rest if
+// the flag --storage-encryption-keyfile is given.
+func encryptionStorageOptions(c *cli.Context) ([]storage.Option, error) {
+	keyfile := c.String(flagStorageEncryptionKeyfile.Name)
+	if len(keyfile) == 0 {
+		return nil, nil
+	}
+	kp, err := encryption.NewKeyfileProvider(keyfile)
+	if err != nil {
+		return nil, err
+	}
+	return []storage.Option{storage.WithEncryption(kp)}, nil
+}
+
+// generateKEK appends a new key-encryption key to the keyfile. Storage nodes
+// wrap data keys again with it when they restart or rotate data keys while
+// running, and then the previous key-encryption key can be removed from the
+// keyfile.
+func generateKEK(c *cli.Context) error {
+	keyfile := c.String(flagStorageEncryptionKeyfile.Name)
+	kekID := c.String(flagEncryptionKEKID.Name)
+	if err := encryption.GenerateKEK(keyfile, kekID); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(c.App.Writer, "generated kek %s in %s\n", kekID, keyfile)
+	return err
+}
+
+// rotateDataKey generates a new data key of the replica specified by the flag
+// --path. Log entries written after it are encrypted by the new data key.
+// Running storage nodes rotate data keys by themselves if the flag
+// --storage-data-key-rotation-interval is set.
+func rotateDataKey(c *cli.Context) (err error) {
+	stg, err := openInspectedStorage(c, true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, stg.Close())
+	}()
+
+	id, err := stg.RotateDataKey(context.Background())
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(c.App.Writer, "rotated data key, active data key %d\n", id)
+	return err
+}
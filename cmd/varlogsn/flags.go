@@ -31,6 +31,50 @@ var (
 		Envs:    []string{"VOLUMES", "VOLUME"},
 	}
 
+	flagRestoreCheckpoint = flags.FlagDesc{
+		Name:  "checkpoint",
+		Usage: "checkpoint directory or archive made by the CheckpointLogStreamReplica RPC",
+	}
+	flagRestoreVolume = flags.FlagDesc{
+		Name:  "volume",
+		Usage: "volume of the storage node where the log stream replica is restored",
+	}
+
+	flagEncryptionKEKID = flags.FlagDesc{
+		Name:  "kek-id",
+		Usage: "identifier of the new key-encryption key",
+	}
+
+	flagInspectPath = flags.FlagDesc{
+		Name:  "path",
+		Usage: "data directory of the log stream replica",
+	}
+	flagInspectLLSNBegin = flags.FlagDesc{
+		Name:  "llsn-begin",
+		Usage: "first llsn to dump, inclusive",
+	}
+	flagInspectLLSNEnd = flags.FlagDesc{
+		Name:  "llsn-end",
+		Usage: "last llsn to dump, exclusive",
+	}
+	flagInspectGLSNBegin = flags.FlagDesc{
+		Name:  "glsn-begin",
+		Usage: "first glsn to dump, inclusive",
+	}
+	flagInspectGLSNEnd = flags.FlagDesc{
+		Name:  "glsn-end",
+		Usage: "last glsn to dump, exclusive",
+	}
+	flagInspectFormat = flags.FlagDesc{
+		Name:  "format",
+		Usage: "output format of log entries (json, hex)",
+	}
+	flagInspectYes = flags.FlagDesc{
+		Name:    "yes",
+		Aliases: []string{"y"},
+		Usage:   "do not ask for confirmation",
+	}
+
 	flagMaxLogStreamReplicasCount = &cli.IntFlag{
 		Name:  "max-logstream-replicas-count",
 		Usage: "The maximum number of log stream replicas in a storage node, infinity if a negative value",
@@ -82,6 +126,36 @@ var (
 		Name:    "logstream-executor-replicate-client-queue-capacity",
 		Aliases: []string{"lse-replicate-client-queue-capacity"},
 	}
+	flagLogStreamExecutorDisableBulkSync = flags.FlagDesc{
+		Name:    "logstream-executor-disable-bulk-sync",
+		Aliases: []string{"lse-disable-bulk-sync"},
+		Usage:   "Copy log entries one by one rather than shipping SSTables during synchronization",
+	}
+	flagSyncBandwidth = flags.FlagDesc{
+		Name:  "sync-bandwidth",
+		Envs:  []string{"SYNC_BANDWIDTH"},
+		Usage: "Bandwidth limit per second for synchronization of all log stream replicas in the storage node (B, KiB, MiB, GiB). Zero means unlimited.",
+	}
+	flagMaxChunkGroupSize = flags.FlagDesc{
+		Name:  "max-chunk-group-size",
+		Envs:  []string{"MAX_CHUNK_GROUP_SIZE"},
+		Usage: "Maximum total size of chunks of a chunk group (B, KiB, MiB, GiB)",
+	}
+	flagMaxChunkGroupChunks = flags.FlagDesc{
+		Name:  "max-chunk-group-chunks",
+		Envs:  []string{"MAX_CHUNK_GROUP_CHUNKS"},
+		Usage: "Maximum number of chunks of a chunk group",
+	}
+	flagTopicAppendQuotas = flags.FlagDesc{
+		Name:  "topic-append-quotas",
+		Envs:  []string{"TOPIC_APPEND_QUOTAS"},
+		Usage: "Quotas of appends to topics in the storage node, formatted as '<tpid>:<bytes per second>:<records per second>', for instance, '1:16MiB:10000'. Zero means unlimited.",
+	}
+	flagLogStreamAppendQuotas = flags.FlagDesc{
+		Name:  "log-stream-append-quotas",
+		Envs:  []string{"LOG_STREAM_APPEND_QUOTAS"},
+		Usage: "Quotas of appends to log streams in the storage node, formatted as '<tpid>:<lsid>:<bytes per second>:<records per second>'. They apply in addition to quotas of topics. Zero means unlimited.",
+	}
 
 	// flags for storage.
 	flagStorageDisableWAL = flags.FlagDesc{
@@ -126,6 +200,31 @@ var (
 		Name: "storage-verbose",
 		Envs: []string{"STORAGE_VERBOSE"},
 	}
+	flagStorageSharedDB = flags.FlagDesc{
+		Name:  "storage-shared-db",
+		Envs:  []string{"STORAGE_SHARED_DB"},
+		Usage: "Share a storage database among log stream replicas in the same volume. Log stream replicas that already have their own databases are not affected.",
+	}
+	flagStorageKeyIndex = flags.FlagDesc{
+		Name:  "storage-key-index",
+		Envs:  []string{"STORAGE_KEY_INDEX"},
+		Usage: "Maintain the index of record keys to look up log entries by their keys. It disables bulk synchronization.",
+	}
+	flagStorageEncryptionKeyfile = flags.FlagDesc{
+		Name:  "storage-encryption-keyfile",
+		Envs:  []string{"STORAGE_ENCRYPTION_KEYFILE"},
+		Usage: "Keyfile having key-encryption keys, one per line as '<kek id> <base64 key>', the last one is current. Storages of new log stream replicas are encrypted if set. Only data of log entries are encrypted, and record keys are not. It disables bulk synchronization of them.",
+	}
+	flagStorageDataKeyRotationInterval = flags.FlagDesc{
+		Name:  "storage-data-key-rotation-interval",
+		Envs:  []string{"STORAGE_DATA_KEY_ROTATION_INTERVAL"},
+		Usage: "Interval of rotation of data keys of encrypted storages while running. The keyfile is reloaded at each rotation, so that a new key-encryption key takes effect without restart. Zero disables it.",
+	}
+	flagCompactionInterval = flags.FlagDesc{
+		Name:  "compaction-interval",
+		Envs:  []string{"COMPACTION_INTERVAL"},
+		Usage: "Interval of compaction of log stream replicas of compacted topics",
+	}
 
 	// flags for logging.
 	flagLogDir = flags.FlagDesc{
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+	"go.uber.org/multierr"
+
+	"github.com/kakao/varlog/internal/storage"
+	"github.com/kakao/varlog/pkg/types"
+)
+
+const (
+	inspectFormatJSON = "json"
+	inspectFormatHex  = "hex"
+)
+
+// openInspectedStorage opens the storage of a log stream replica specified by
+// the flag --path. It is opened read-only unless the argument writable is
+// true. The storage node must not be running.
+func openInspectedStorage(c *cli.Context, writable bool) (*storage.Storage, error) {
+	opts := []storage.Option{
+		storage.WithPath(c.String(flagInspectPath.Name)),
+	}
+	if !writable {
+		opts = append(opts, storage.ReadOnly())
+	}
+	encryptionOpts, err := encryptionStorageOptions(c)
+	if err != nil {
+		return nil, err
+	}
+	return storage.New(append(opts, encryptionOpts...)...)
+}
+
+func printJSON(w io.Writer, v any) error {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(buf))
+	return err
+}
+
+// inspectRecoveryPoints prints the recovery points of the replica, which are
+// the last commit context and the first and last committed log entries.
+func inspectRecoveryPoints(c *cli.Context) (err error) {
+	stg, err := openInspectedStorage(c, false)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, stg.Close())
+	}()
+
+	rp, err := stg.ReadRecoveryPoints()
+	if err != nil {
+		return err
+	}
+	return printJSON(c.App.Writer, rp)
+}
+
+// inspectDump prints log entries in the range of LLSNs or GLSNs. Log entries
+// dumped by LLSNs do not have GLSNs since they can be uncommitted.
+func inspectDump(c *cli.Context) (err error) {
+	format := c.String(flagInspectFormat.Name)
+	if format != inspectFormatJSON && format != inspectFormatHex {
+		return fmt.Errorf("unknown format %s", format)
+	}
+	byGLSN := c.IsSet(flagInspectGLSNBegin.Name) || c.IsSet(flagInspectGLSNEnd.Name)
+	if byGLSN && (c.IsSet(flagInspectLLSNBegin.Name) || c.IsSet(flagInspectLLSNEnd.Name)) {
+		return errors.New("both ranges of llsn and glsn are given")
+	}
+
+	stg, err := openInspectedStorage(c, false)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, stg.Close())
+	}()
+
+	var scanOpt storage.ScanOption
+	if byGLSN {
+		scanOpt = storage.WithGLSN(types.GLSN(c.Uint64(flagInspectGLSNBegin.Name)), types.GLSN(c.Uint64(flagInspectGLSNEnd.Name)))
+	} else {
+		scanOpt = storage.WithLLSN(types.LLSN(c.Uint64(flagInspectLLSNBegin.Name)), types.LLSN(c.Uint64(flagInspectLLSNEnd.Name)))
+	}
+	scanner := stg.NewScanner(scanOpt)
+	defer func() {
+		err = multierr.Append(err, scanner.Close())
+	}()
+
+	w := bufio.NewWriter(c.App.Writer)
+	for ; scanner.Valid(); scanner.Next() {
+		le, err := scanner.Value()
+		if err != nil {
+			return err
+		}
+		if format == inspectFormatHex {
+			_, err = fmt.Fprintf(w, "llsn=%d glsn=%d data=%x\n", le.LLSN, le.GLSN, le.Data)
+		} else {
+			err = printJSON(w, le)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// inspectCheck checks the consistency between data and commits of the
+// replica. It returns an error if the replica is inconsistent.
+func inspectCheck(c *cli.Context) (err error) {
+	stg, err := openInspectedStorage(c, false)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, stg.Close())
+	}()
+
+	report, err := stg.CheckConsistency()
+	if err != nil {
+		return err
+	}
+	if err := printJSON(c.App.Writer, report); err != nil {
+		return err
+	}
+	if !report.Consistent() {
+		return fmt.Errorf("inconsistent: %d problems", len(report.Problems))
+	}
+	return nil
+}
+
+// inspectTruncate deletes the uncommitted tail of the replica after
+// confirmation. Committed log entries are never deleted.
+func inspectTruncate(c *cli.Context) error {
+	report, err := func() (_ storage.ConsistencyReport, err error) {
+		stg, err := openInspectedStorage(c, false)
+		if err != nil {
+			return storage.ConsistencyReport{}, err
+		}
+		defer func() {
+			err = multierr.Append(err, stg.Close())
+		}()
+		return stg.CheckConsistency()
+	}()
+	if err != nil {
+		return err
+	}
+	if !report.Consistent() {
+		return fmt.Errorf("inconsistent replica: %s", report.Problems[0])
+	}
+	if report.UncommittedBegin.Invalid() {
+		_, err := fmt.Fprintln(c.App.Writer, "no uncommitted log entries")
+		return err
+	}
+
+	_, _ = fmt.Fprintf(c.App.Writer, "delete uncommitted log entries in llsn [%d, %d), the last committed log entry is llsn %d glsn %d\n",
+		report.UncommittedBegin, report.UncommittedEnd, report.LastCommitted.LLSN, report.LastCommitted.GLSN)
+	if !c.Bool(flagInspectYes.Name) {
+		_, _ = fmt.Fprint(c.App.Writer, "continue? [y/N] ")
+		answer, err := bufio.NewReader(c.App.Reader).ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		if answer = strings.ToLower(strings.TrimSpace(answer)); answer != "y" && answer != "yes" {
+			return errors.New("canceled")
+		}
+	}
+
+	stg, err := openInspectedStorage(c, true)
+	if err != nil {
+		return err
+	}
+	begin, end, err := stg.DeleteUncommittedLogEntries()
+	err = multierr.Append(err, stg.Close())
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(c.App.Writer, "deleted llsn [%d, %d)\n", begin, end)
+	return err
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/kakao/varlog/internal/storagenode"
+	"github.com/kakao/varlog/pkg/types"
+)
+
+// restore turns a checkpoint of a log stream replica into the data directory
+// of a new replica. The storage node loads the replica when it starts, and the
+// admin can register it by adding the log stream replica and then synchronize
+// it to the other replicas.
+func restore(c *cli.Context) error {
+	clusterID, err := types.ParseClusterID(c.String(flagClusterID.Name))
+	if err != nil {
+		return err
+	}
+
+	storageNodeID, err := types.ParseStorageNodeID(c.String(flagStorageNodeID.Name))
+	if err != nil {
+		return err
+	}
+
+	volume, err := filepath.Abs(c.String(flagRestoreVolume.Name))
+	if err != nil {
+		return err
+	}
+
+	stgOpts, err := encryptionStorageOptions(c)
+	if err != nil {
+		return err
+	}
+
+	dd, err := storagenode.RestoreLogStreamReplica(c.String(flagRestoreCheckpoint.Name), volume, clusterID, storageNodeID, stgOpts...)
+	if err != nil {
+		return err
+	}
+
+	buf, err := json.Marshal(struct {
+		DataDir       string              `json:"dataDir"`
+		StorageNodeID types.StorageNodeID `json:"storageNodeId"`
+		TopicID       types.TopicID       `json:"topicId"`
+		LogStreamID   types.LogStreamID   `json:"logStreamId"`
+	}{
+		DataDir:       dd.String(),
+		StorageNodeID: dd.StorageNodeID,
+		TopicID:       dd.TopicID,
+		LogStreamID:   dd.LogStreamID,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(c.App.Writer, string(buf))
+	return err
+}
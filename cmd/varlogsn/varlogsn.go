@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"syscall"
 
@@ -24,12 +25,14 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/kakao/varlog/internal/storage"
+	"github.com/kakao/varlog/internal/storage/encryption"
 	"github.com/kakao/varlog/internal/storagenode"
 	"github.com/kakao/varlog/internal/storagenode/logstream"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/log"
 	"github.com/kakao/varlog/pkg/util/telemetry"
 	"github.com/kakao/varlog/pkg/util/units"
+	"github.com/kakao/varlog/proto/snpb"
 )
 
 func main() {
@@ -117,6 +120,16 @@ func start(c *cli.Context) error {
 		return fmt.Errorf("flagReplicationClientWriteBufferSize: %w", err)
 	}
 
+	syncBandwidth, err := units.FromByteSizeString(c.String(flagSyncBandwidth.Name))
+	if err != nil {
+		return fmt.Errorf("syncBandwidth: %w", err)
+	}
+
+	maxChunkGroupSize, err := units.FromByteSizeString(c.String(flagMaxChunkGroupSize.Name))
+	if err != nil {
+		return fmt.Errorf("maxChunkGroupSize: %w", err)
+	}
+
 	logger = logger.Named("sn").With(zap.Uint32("cid", uint32(clusterID)), zap.Int32("snid", int32(storageNodeID)))
 
 	mp, stop, err := initTelemetry(context.Background(), c, storageNodeID)
@@ -152,8 +165,11 @@ func start(c *cli.Context) error {
 	if c.Bool(flagStorageVerbose.Name) {
 		storageOpts = append(storageOpts, storage.WithVerboseLogging())
 	}
+	if c.Bool(flagStorageKeyIndex.Name) {
+		storageOpts = append(storageOpts, storage.WithKeyIndex())
+	}
 
-	sn, err := storagenode.NewStorageNode(
+	snOpts := []storagenode.Option{
 		storagenode.WithClusterID(clusterID),
 		storagenode.WithStorageNodeID(storageNodeID),
 		storagenode.WithListenAddress(c.String(flagListen.Name)),
@@ -170,11 +186,64 @@ func start(c *cli.Context) error {
 			logstream.WithWriteQueueCapacity(c.Int(flagLogStreamExecutorWriteQueueCapacity.Name)),
 			logstream.WithCommitQueueCapacity(c.Int(flagLogStreamExecutorCommitQueueCapacity.Name)),
 			logstream.WithReplicateClientQueueCapacity(c.Int(flagLogStreamExecutorReplicateclientQueueCapacity.Name)),
+			logstream.WithBulkSync(!c.Bool(flagLogStreamExecutorDisableBulkSync.Name)),
 		),
 		storagenode.WithMaxLogStreamReplicasCount(int32(c.Int(flagMaxLogStreamReplicasCount.Name))),
+		storagenode.WithSyncBandwidth(syncBandwidth),
+		storagenode.WithMaxChunkGroupSize(maxChunkGroupSize, c.Int(flagMaxChunkGroupChunks.Name)),
+		storagenode.WithCompactionInterval(c.Duration(flagCompactionInterval.Name)),
 		storagenode.WithDefaultStorageOptions(storageOpts...),
 		storagenode.WithLogger(logger),
-	)
+	}
+	if c.Bool(flagStorageSharedDB.Name) {
+		snOpts = append(snOpts, storagenode.WithSharedStorage())
+	}
+	if keyfile := c.String(flagStorageEncryptionKeyfile.Name); len(keyfile) > 0 {
+		kp, err := encryption.NewKeyfileProvider(keyfile)
+		if err != nil {
+			return err
+		}
+		snOpts = append(snOpts,
+			storagenode.WithEncryptionKeyProvider(kp),
+			storagenode.WithDataKeyRotationInterval(c.Duration(flagStorageDataKeyRotationInterval.Name)),
+		)
+	}
+	for _, s := range c.StringSlice(flagTopicAppendQuotas.Name) {
+		fields := strings.Split(s, ":")
+		if len(fields) != 3 {
+			return fmt.Errorf("topic append quota: malformed %q", s)
+		}
+		tpid, err := types.ParseTopicID(fields[0])
+		if err != nil {
+			return fmt.Errorf("topic append quota: %w", err)
+		}
+		quota, err := parseAppendQuota(fields[1], fields[2])
+		if err != nil {
+			return fmt.Errorf("topic append quota: %w", err)
+		}
+		snOpts = append(snOpts, storagenode.WithTopicAppendQuota(tpid, quota))
+	}
+	for _, s := range c.StringSlice(flagLogStreamAppendQuotas.Name) {
+		fields := strings.Split(s, ":")
+		if len(fields) != 4 {
+			return fmt.Errorf("log stream append quota: malformed %q", s)
+		}
+		tpid, err := types.ParseTopicID(fields[0])
+		if err != nil {
+			return fmt.Errorf("log stream append quota: %w", err)
+		}
+		lsid, err := types.ParseLogStreamID(fields[1])
+		if err != nil {
+			return fmt.Errorf("log stream append quota: %w", err)
+		}
+		quota, err := parseAppendQuota(fields[2], fields[3])
+		if err != nil {
+			return fmt.Errorf("log stream append quota: %w", err)
+		}
+		snOpts = append(snOpts, storagenode.WithLogStreamAppendQuota(tpid, lsid, quota))
+	}
+
+	sn, err := storagenode.NewStorageNode(snOpts...)
 	if err != nil {
 		return err
 	}
@@ -250,3 +319,12 @@ func initTelemetry(ctx context.Context, c *cli.Context, snid types.StorageNodeID
 
 	return telemetry.NewMeterProvider(meterProviderOpts...)
 }
+
+func parseAppendQuota(bytesPerSecond, recordsPerSecond string) (quota snpb.AppendQuota, err error) {
+	quota.BytesPerSecond, err = units.FromByteSizeString(bytesPerSecond)
+	if err != nil {
+		return quota, err
+	}
+	quota.RecordsPerSecond, err = strconv.ParseInt(recordsPerSecond, 10, 64)
+	return quota, err
+}
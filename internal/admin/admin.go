@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"path/filepath"
 	"sort"
 	"sync"
 	"time"
@@ -25,6 +26,7 @@ import (
 	"github.com/kakao/varlog/internal/admin/snwatcher"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/netutil"
+	"github.com/kakao/varlog/pkg/util/runner"
 	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/mrpb"
 	"github.com/kakao/varlog/proto/snpb"
@@ -50,6 +52,15 @@ type Admin struct {
 	snw     *snwatcher.StorageNodeWatcher
 	lsidGen *LogStreamIDGenerator
 	tpidGen *TopicIDGenerator
+
+	// runner runs background tasks such as draining storage nodes.
+	runner *runner.Runner
+	ops    *operationStore
+	events *eventLog
+
+	// elector is nil if leader election is disabled.
+	elector    *leaderElector
+	resumeOnce sync.Once
 }
 
 // New creates an Admin.
@@ -73,7 +84,24 @@ func New(ctx context.Context, opts ...Option) (*Admin, error) {
 		return nil, err
 	}
 
-	grpcServer := grpc.NewServer(
+	ops, err := newOperationStore(cfg.operationStorePath, cfg.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	cm := &Admin{
+		config:       cfg,
+		lsidGen:      logStreamIDGen,
+		tpidGen:      topicIDGen,
+		healthServer: health.NewServer(),
+		runner:       runner.New("admin", cfg.logger),
+		ops:          ops,
+		events:       newEventLog(),
+	}
+	if cfg.enableLeaderElection {
+		cm.elector = newLeaderElector(cfg.advertiseAddress, cfg.leaderLease, cfg.mrmgr, cm.becomeLeader, cfg.logger)
+	}
+	cm.server = grpc.NewServer(
 		grpcmiddleware.WithUnaryServerChain(
 			grpcctxtags.UnaryServerInterceptor(),
 			grpczap.UnaryServerInterceptor(cfg.logger, grpczap.WithDecider(
@@ -81,16 +109,12 @@ func New(ctx context.Context, opts ...Option) (*Admin, error) {
 					return err != nil || !grpcHandlerLogDenyList[fullMethodName]
 				},
 			)),
+			cm.leaderUnaryServerInterceptor,
+		),
+		grpcmiddleware.WithStreamServerChain(
+			cm.leaderStreamServerInterceptor,
 		),
 	)
-
-	cm := &Admin{
-		config:       cfg,
-		lsidGen:      logStreamIDGen,
-		tpidGen:      topicIDGen,
-		server:       grpcServer,
-		healthServer: health.NewServer(),
-	}
 	cm.snw, err = snwatcher.New(append(
 		cm.snwatcherOpts,
 		snwatcher.WithClusterMetadataView(cmView),
@@ -135,6 +159,12 @@ func (adm *Admin) Serve() error {
 		adm.mu.Unlock()
 		return err
 	}
+	if adm.elector == nil {
+		adm.resumeOnce.Do(adm.resumeOperations)
+	} else if _, err := adm.runner.Run(adm.elector.run); err != nil {
+		adm.mu.Unlock()
+		return err
+	}
 	adm.mu.Unlock()
 
 	return adm.server.Serve(lis)
@@ -143,6 +173,10 @@ func (adm *Admin) Serve() error {
 // Close closes the admin.
 // This method closes the gRPC server immediately.
 func (adm *Admin) Close() (err error) {
+	// Background tasks acquire the mutex, thus, they should be stopped
+	// before acquiring it.
+	adm.runner.Stop()
+
 	adm.mu.Lock()
 	defer adm.mu.Unlock()
 	if adm.closed {
@@ -181,6 +215,7 @@ func (adm *Admin) getStorageNode(ctx context.Context, snid types.StorageNodeID)
 		return nil, status.Errorf(codes.NotFound, "get storage node: %d", int32(snid))
 	}
 	if snm, ok := adm.statRepository.GetStorageNode(snid); ok {
+		snm.DrainStatus = adm.drains.get(snid)
 		return snm, nil
 	}
 	snm := &vmspb.StorageNodeMetadata{
@@ -188,7 +223,8 @@ func (adm *Admin) getStorageNode(ctx context.Context, snid types.StorageNodeID)
 			ClusterID:   adm.cid,
 			StorageNode: snd.StorageNode,
 		},
-		CreateTime: snd.CreateTime,
+		CreateTime:  snd.CreateTime,
+		DrainStatus: adm.drains.get(snid),
 	}
 	for _, lsd := range md.LogStreams {
 		for _, rd := range lsd.Replicas {
@@ -273,6 +309,9 @@ func (adm *Admin) listStorageNodes(ctx context.Context) ([]vmspb.StorageNodeMeta
 			CreateTime: snd.CreateTime,
 		})
 	}
+	for i := range snms {
+		snms[i].DrainStatus = adm.drains.get(snms[i].StorageNode.StorageNodeID)
+	}
 	sort.Slice(snms, func(i, j int) bool {
 		return snms[i].StorageNode.StorageNodeID < snms[j].StorageNode.StorageNodeID
 	})
@@ -349,6 +388,50 @@ func (adm *Admin) unregisterStorageNode(ctx context.Context, snid types.StorageN
 	return nil
 }
 
+// setStorageNodeSyncBandwidth changes the limit of bandwidth for
+// synchronization in the storage node, and returns the previous one.
+func (adm *Admin) setStorageNodeSyncBandwidth(ctx context.Context, snid types.StorageNodeID, bytesPerSecond int64) (int64, error) {
+	if bytesPerSecond < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "set sync bandwidth: negative bandwidth %d", bytesPerSecond)
+	}
+
+	adm.mu.RLock()
+	defer adm.mu.RUnlock()
+
+	md, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Unavailable, "set sync bandwidth: cluster metadata not fetched")
+	}
+	if md.GetStorageNode(snid) == nil {
+		return 0, status.Errorf(codes.NotFound, "set sync bandwidth: storage node %d", int32(snid))
+	}
+	return adm.snmgr.SetSyncBandwidth(ctx, snid, bytesPerSecond)
+}
+
+// setStorageNodeAppendQuota changes the quota of appends to the topic or the
+// log stream in the storage node, and returns the previous one. If the
+// argument lsid is invalid, it changes the quota of the topic.
+func (adm *Admin) setStorageNodeAppendQuota(ctx context.Context, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID, quota snpb.AppendQuota) (snpb.AppendQuota, error) {
+	if tpid.Invalid() {
+		return snpb.AppendQuota{}, status.Errorf(codes.InvalidArgument, "set append quota: invalid topic %d", int32(tpid))
+	}
+	if quota.BytesPerSecond < 0 || quota.RecordsPerSecond < 0 {
+		return snpb.AppendQuota{}, status.Errorf(codes.InvalidArgument, "set append quota: negative quota (%d bytes/s, %d records/s)", quota.BytesPerSecond, quota.RecordsPerSecond)
+	}
+
+	adm.mu.RLock()
+	defer adm.mu.RUnlock()
+
+	md, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
+	if err != nil {
+		return snpb.AppendQuota{}, status.Errorf(codes.Unavailable, "set append quota: cluster metadata not fetched")
+	}
+	if md.GetStorageNode(snid) == nil {
+		return snpb.AppendQuota{}, status.Errorf(codes.NotFound, "set append quota: storage node %d", int32(snid))
+	}
+	return adm.snmgr.SetAppendQuota(ctx, snid, tpid, lsid, quota)
+}
+
 func (adm *Admin) getTopic(ctx context.Context, tpid types.TopicID) (*varlogpb.TopicDescriptor, error) {
 	md, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
 	if err != nil {
@@ -377,18 +460,23 @@ func (adm *Admin) listTopics(ctx context.Context) ([]varlogpb.TopicDescriptor, e
 	return tds, nil
 }
 
-func (adm *Admin) addTopic(ctx context.Context) (*varlogpb.TopicDescriptor, error) {
+// addTopic adds a new topic. Log stream replicas of the topic are compacted
+// if the argument compacted is true.
+func (adm *Admin) addTopic(ctx context.Context, compacted bool) (*varlogpb.TopicDescriptor, error) {
 	adm.mu.Lock()
 	defer adm.mu.Unlock()
 
-	topicID := adm.tpidGen.Generate()
+	td := &varlogpb.TopicDescriptor{
+		TopicID:   adm.tpidGen.Generate(),
+		Compacted: compacted,
+	}
 	// Note that the metadata repository accepts redundant RegisterTopic
 	// RPC only if the topic has no log streams.
-	if err := adm.mrmgr.RegisterTopic(ctx, topicID); err != nil {
+	if err := adm.mrmgr.RegisterTopic(ctx, td); err != nil {
 		return nil, err
 	}
 
-	return &varlogpb.TopicDescriptor{TopicID: topicID}, nil
+	return td, nil
 }
 
 func (adm *Admin) unregisterTopic(ctx context.Context, tpid types.TopicID) error {
@@ -537,6 +625,11 @@ func (adm *Admin) addLogStreamInternal(ctx context.Context, tpid types.TopicID,
 			return nil, err
 		}
 	}
+	for _, rd := range replicas {
+		if adm.drains.draining(rd.StorageNodeID) {
+			return nil, status.Errorf(codes.FailedPrecondition, "add log stream: storage node %d is draining", int32(rd.StorageNodeID))
+		}
+	}
 
 	lsid := adm.lsidGen.Generate()
 
@@ -822,6 +915,11 @@ func (adm *Admin) seal(ctx context.Context, tpid types.TopicID, lsid types.LogSt
 }
 
 func (adm *Admin) sealInternal(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID) ([]snpb.LogStreamReplicaMetadataDescriptor, types.GLSN, error) {
+	adm.events.publish(logStreamSubject(lsid), vmspb.Event{
+		Kind:        vmspb.EventKindLogStreamSealing,
+		TopicID:     tpid,
+		LogStreamID: lsid,
+	})
 	adm.statRepository.SetLogStreamStatus(lsid, varlogpb.LogStreamStatusSealing)
 
 	lastGLSN, err := adm.mrmgr.Seal(ctx, lsid)
@@ -859,6 +957,12 @@ func (adm *Admin) unsealInternal(ctx context.Context, tpid types.TopicID, lsid t
 		goto errOut
 	}
 
+	adm.events.publish(logStreamSubject(lsid), vmspb.Event{
+		Kind:        vmspb.EventKindLogStreamUnsealed,
+		TopicID:     tpid,
+		LogStreamID: lsid,
+	})
+
 	if clusmeta, err = adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx); err != nil {
 		goto errOut
 	}
@@ -892,7 +996,9 @@ func (adm *Admin) syncInternal(ctx context.Context, tpid types.TopicID, lsid typ
 	if err != nil {
 		return nil, err
 	}
-	return adm.snmgr.Sync(ctx, tpid, lsid, srcID, dstID, lastGLSN)
+	syncStatus, err := adm.snmgr.Sync(ctx, tpid, lsid, srcID, dstID, lastGLSN)
+	adm.publishSync(tpid, lsid, srcID, dstID, syncStatus, err)
+	return syncStatus, err
 }
 
 // trim removes log entries from the log streams in a topic.
@@ -904,6 +1010,27 @@ func (adm *Admin) trim(ctx context.Context, tpid types.TopicID, lastGLSN types.G
 	return adm.snmgr.Trim(ctx, tpid, lastGLSN)
 }
 
+// checkpointLogStreamReplica makes a consistent point-in-time checkpoint of
+// the log stream replica in the storage node.
+func (adm *Admin) checkpointLogStreamReplica(ctx context.Context, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID, path string, archive bool) (snpb.LogStreamReplicaCheckpoint, error) {
+	if !filepath.IsAbs(path) {
+		return snpb.LogStreamReplicaCheckpoint{}, status.Errorf(codes.InvalidArgument, "checkpoint: not absolute path %s", path)
+	}
+
+	adm.mu.RLock()
+	defer adm.mu.RUnlock()
+
+	md, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
+	if err != nil {
+		return snpb.LogStreamReplicaCheckpoint{}, status.Errorf(codes.Unavailable, "checkpoint: cluster metadata not fetched")
+	}
+	lsd, err := md.MustHaveLogStream(lsid)
+	if err != nil || lsd.TopicID != tpid || !lsd.IsReplica(snid) {
+		return snpb.LogStreamReplicaCheckpoint{}, status.Errorf(codes.NotFound, "checkpoint: no replica of log stream %d in storage node %d", int32(lsid), int32(snid))
+	}
+	return adm.snmgr.CheckpointLogStreamReplica(ctx, snid, tpid, lsid, path, archive)
+}
+
 func (adm *Admin) getMetadataRepositoryNode(ctx context.Context, nid types.NodeID) (*varlogpb.MetadataRepositoryNode, error) {
 	ci, err := adm.mrmgr.GetClusterInfo(ctx)
 	if err != nil {
@@ -913,13 +1040,7 @@ func (adm *Admin) getMetadataRepositoryNode(ctx context.Context, nid types.NodeI
 	if !ok {
 		return nil, errors.New("admin: no such mr")
 	}
-	return &varlogpb.MetadataRepositoryNode{
-		NodeID:  nid,
-		RaftURL: member.Peer,
-		RPCAddr: member.Endpoint,
-		Leader:  ci.Leader == nid,
-		Learner: member.Learner,
-	}, nil
+	return newMetadataRepositoryNode(ci, nid, member), nil
 }
 
 func (adm *Admin) listMetadataRepositoryNodes(ctx context.Context) ([]varlogpb.MetadataRepositoryNode, error) {
@@ -929,17 +1050,38 @@ func (adm *Admin) listMetadataRepositoryNodes(ctx context.Context) ([]varlogpb.M
 	}
 	nodes := make([]varlogpb.MetadataRepositoryNode, 0, len(ci.GetMembers()))
 	for nid, member := range ci.GetMembers() {
-		nodes = append(nodes, varlogpb.MetadataRepositoryNode{
-			NodeID:  nid,
-			RaftURL: member.Peer,
-			RPCAddr: member.Endpoint,
-			Leader:  ci.Leader == nid,
-			Learner: member.Learner,
-		})
+		nodes = append(nodes, *newMetadataRepositoryNode(ci, nid, member))
 	}
 	return nodes, nil
 }
 
+func newMetadataRepositoryNode(ci *mrpb.ClusterInfo, nid types.NodeID, member *mrpb.ClusterInfo_Member) *varlogpb.MetadataRepositoryNode {
+	return &varlogpb.MetadataRepositoryNode{
+		NodeID:        nid,
+		RaftURL:       member.Peer,
+		RPCAddr:       member.Endpoint,
+		Leader:        ci.Leader == nid,
+		Learner:       member.Learner,
+		MatchIndex:    member.MatchIndex,
+		Lag:           member.Lag,
+		ProgressState: member.ProgressState,
+		Active:        member.Active,
+	}
+}
+
+// transferMetadataRepositoryLeadership transfers the leadership of the
+// metadata repository to the node specified by the argument nid.
+func (adm *Admin) transferMetadataRepositoryLeadership(ctx context.Context, nid types.NodeID) error {
+	if nid == types.InvalidNodeID {
+		return errors.Wrap(verrors.ErrInvalid, "node id")
+	}
+
+	adm.mu.RLock()
+	defer adm.mu.RUnlock()
+
+	return adm.mrmgr.TransferLeadership(ctx, nid)
+}
+
 func (adm *Admin) mrInfos(ctx context.Context) (*mrpb.ClusterInfo, error) {
 	adm.mu.RLock()
 	defer adm.mu.RUnlock()
@@ -1022,11 +1164,21 @@ func (adm *Admin) removeMRPeer(ctx context.Context, raftURL string) error {
 }
 
 func (adm *Admin) HandleHeartbeatTimeout(ctx context.Context, snid types.StorageNodeID) {
+	ctx, leader := adm.leaderContext(ctx)
+	if !leader {
+		return
+	}
+
 	meta, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
 	if err != nil {
 		return
 	}
 
+	adm.events.publish(storageNodeSubject(snid), vmspb.Event{
+		Kind:          vmspb.EventKindHeartbeatTimeout,
+		StorageNodeID: snid,
+	})
+
 	//TODO: store sn status
 	for _, ls := range meta.GetLogStreams() {
 		if ls.IsReplica(snid) {
@@ -1059,6 +1211,11 @@ func (adm *Admin) checkLogStreamStatus(ctx context.Context, tpid types.TopicID,
 		}
 		adm.logger.Info("sealed", zap.Any("lsid", lsid))
 		adm.statRepository.SetLogStreamStatus(lsid, varlogpb.LogStreamStatusSealed)
+		adm.events.publish(logStreamSubject(lsid), vmspb.Event{
+			Kind:        vmspb.EventKindLogStreamSealed,
+			TopicID:     tpid,
+			LogStreamID: lsid,
+		})
 
 	case varlogpb.LogStreamStatusSealed:
 		for _, r := range lsStat.Replicas() {
@@ -1128,7 +1285,8 @@ func (adm *Admin) syncLogStream(ctx context.Context, topicID types.TopicID, logS
 
 	// TODO (jun): Extract this block to separate method.
 	if sealed && adm.enableAutoUnseal {
-		lsd, err := adm.unsealInternal(context.Background(), topicID, logStreamID)
+		unsealCtx, _ := adm.leaderContext(context.Background())
+		lsd, err := adm.unsealInternal(unsealCtx, topicID, logStreamID)
 		if err != nil {
 			adm.logger.Error("could not unseal",
 				zap.Int32("tpid", int32(topicID)),
@@ -1165,6 +1323,15 @@ func (adm *Admin) syncLogStream(ctx context.Context, topicID types.TopicID, logS
 }
 
 func (adm *Admin) HandleReport(ctx context.Context, snm *snpb.StorageNodeMetadataDescriptor) {
+	ctx, leader := adm.leaderContext(ctx)
+	if !leader {
+		return
+	}
+
+	// The storage node responds again, thus, its next heartbeat timeout
+	// should be published.
+	adm.events.forget(storageNodeSubject(snm.StorageNode.StorageNodeID))
+
 	meta, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
 	if err != nil {
 		return
@@ -1178,7 +1345,14 @@ func (adm *Admin) HandleReport(ctx context.Context, snm *snpb.StorageNodeMetadat
 			continue
 		}
 		if time.Since(ls.CreatedTime) > adm.logStreamGCTimeout {
-			_ = adm.removeLogStreamReplica(ctx, snm.StorageNode.StorageNodeID, ls.TopicID, ls.LogStreamID)
+			if err := adm.removeLogStreamReplica(ctx, snm.StorageNode.StorageNodeID, ls.TopicID, ls.LogStreamID); err == nil {
+				adm.events.publish("", vmspb.Event{
+					Kind:          vmspb.EventKindLogStreamReplicaGC,
+					StorageNodeID: snm.StorageNode.StorageNodeID,
+					TopicID:       ls.TopicID,
+					LogStreamID:   ls.LogStreamID,
+				})
+			}
 		}
 	}
 
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"math"
 	"path/filepath"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -14,6 +15,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/goleak"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/kakao/varlog/internal/admin"
 	"github.com/kakao/varlog/internal/admin/mrmanager"
@@ -21,6 +25,7 @@ import (
 	"github.com/kakao/varlog/internal/admin/snwatcher"
 	"github.com/kakao/varlog/internal/admin/stats"
 	"github.com/kakao/varlog/internal/storagenode/volume"
+	"github.com/kakao/varlog/pkg/rpc"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/varlog"
 	"github.com/kakao/varlog/pkg/verrors"
@@ -72,6 +77,22 @@ func TestAdmin_InvalidConfig(t *testing.T) {
 		admin.WithLogger(nil),
 	)
 	assert.Error(t, err)
+
+	// no advertise address for leader election
+	_, err = admin.New(ctx,
+		admin.WithMetadataRepositoryManager(mrmgr),
+		admin.WithStorageNodeManager(snmgr),
+		admin.WithLeaderElection(""),
+	)
+	assert.Error(t, err)
+
+	// invalid leader lease
+	_, err = admin.New(ctx,
+		admin.WithMetadataRepositoryManager(mrmgr),
+		admin.WithStorageNodeManager(snmgr),
+		admin.WithLeaderLease(0),
+	)
+	assert.Error(t, err)
 }
 
 func TestAdminConstructor_UnfetchableClusterMetadata(t *testing.T) {
@@ -546,6 +567,520 @@ func TestAdmin_AddStorageNode(t *testing.T) {
 	}
 }
 
+func TestAdmin_DrainStorageNode(t *testing.T) {
+	const (
+		cid   = types.ClusterID(1)
+		tpid  = types.TopicID(1)
+		lsid  = types.LogStreamID(1)
+		snid1 = types.StorageNodeID(1)
+		snid2 = types.StorageNodeID(2)
+		snid3 = types.StorageNodeID(3)
+	)
+
+	newStorageNodeDescriptor := func(snid types.StorageNodeID) *varlogpb.StorageNodeDescriptor {
+		return &varlogpb.StorageNodeDescriptor{
+			StorageNode: varlogpb.StorageNode{
+				StorageNodeID: snid,
+			},
+			Paths: []string{filepath.Join("/tmp", volume.StorageNodeDirName(cid, snid))},
+		}
+	}
+
+	tcs := []struct {
+		name    string
+		snids   []types.StorageNodeID
+		prepare func(mu *sync.Mutex, md *varlogpb.MetadataDescriptor, mock *testMock)
+		testf   func(t *testing.T, client varlog.Admin)
+	}{
+		{
+			name:    "NoSuchStorageNode",
+			snids:   []types.StorageNodeID{snid2, snid3},
+			prepare: func(*sync.Mutex, *varlogpb.MetadataDescriptor, *testMock) {},
+			testf: func(t *testing.T, client varlog.Admin) {
+				_, err := client.DrainStorageNode(context.Background(), snid1)
+				require.ErrorIs(t, err, verrors.ErrNotExist)
+			},
+		},
+		{
+			name:  "NoStorageNodeToMoveReplicaTo",
+			snids: []types.StorageNodeID{snid1, snid2},
+			prepare: func(mu *sync.Mutex, md *varlogpb.MetadataDescriptor, mock *testMock) {
+				mock.MockMetadataRepositoryManager.EXPECT().Seal(gomock.Any(), lsid).DoAndReturn(
+					func(context.Context, types.LogStreamID) (types.GLSN, error) {
+						mu.Lock()
+						defer mu.Unlock()
+						md.GetLogStream(lsid).Status = varlogpb.LogStreamStatusSealed
+						return types.InvalidGLSN, nil
+					},
+				)
+				mock.MockStorageNodeManager.EXPECT().Seal(gomock.Any(), tpid, lsid, gomock.Any()).Return(nil, nil)
+			},
+			testf: func(t *testing.T, client varlog.Admin) {
+				snm, err := client.DrainStorageNode(context.Background(), snid1)
+				require.NoError(t, err)
+				require.NotNil(t, snm.DrainStatus)
+				require.EqualValues(t, 1, snm.DrainStatus.TotalReplicas)
+
+				require.Eventually(t, func() bool {
+					snm, err := client.GetStorageNode(context.Background(), snid1)
+					require.NoError(t, err)
+					return snm.DrainStatus.State == vmspb.DrainStateFailed
+				}, 5*time.Second, 10*time.Millisecond)
+
+				// No log stream can be placed on the draining storage node.
+				_, err = client.AddLogStream(context.Background(), tpid, []*varlogpb.ReplicaDescriptor{
+					{StorageNodeID: snid1, StorageNodePath: newStorageNodeDescriptor(snid1).Paths[0]},
+					{StorageNodeID: snid2, StorageNodePath: newStorageNodeDescriptor(snid2).Paths[0]},
+				})
+				require.Error(t, err)
+			},
+		},
+		{
+			name:  "Success",
+			snids: []types.StorageNodeID{snid1, snid2, snid3},
+			prepare: func(mu *sync.Mutex, md *varlogpb.MetadataDescriptor, mock *testMock) {
+				mock.MockMetadataRepositoryManager.EXPECT().Seal(gomock.Any(), lsid).DoAndReturn(
+					func(context.Context, types.LogStreamID) (types.GLSN, error) {
+						mu.Lock()
+						defer mu.Unlock()
+						md.GetLogStream(lsid).Status = varlogpb.LogStreamStatusSealed
+						return types.InvalidGLSN, nil
+					},
+				)
+				mock.MockStorageNodeManager.EXPECT().Seal(gomock.Any(), tpid, lsid, gomock.Any()).Return(nil, nil)
+				mock.MockStorageNodeManager.EXPECT().GetMetadata(gomock.Any(), gomock.Any()).Return(
+					&snpb.StorageNodeMetadataDescriptor{
+						LogStreamReplicas: []snpb.LogStreamReplicaMetadataDescriptor{
+							{
+								LogStreamReplica: varlogpb.LogStreamReplica{
+									TopicLogStream: varlogpb.TopicLogStream{
+										TopicID:     tpid,
+										LogStreamID: lsid,
+									},
+								},
+								Status: varlogpb.LogStreamStatusSealed,
+							},
+						},
+					}, nil,
+				).AnyTimes()
+				mock.MockStorageNodeManager.EXPECT().AddLogStreamReplica(
+					gomock.Any(), snid3, tpid, lsid, gomock.Any(),
+				).Return(snpb.LogStreamReplicaMetadataDescriptor{Path: "/tmp/data"}, nil)
+				mock.MockMetadataRepositoryManager.EXPECT().UpdateLogStream(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, lsd *varlogpb.LogStreamDescriptor) error {
+						mu.Lock()
+						defer mu.Unlock()
+						// The primary replica should move away from the
+						// draining storage node.
+						if !assert.Len(t, lsd.Replicas, 2) ||
+							!assert.Equal(t, snid2, lsd.Replicas[0].StorageNodeID) ||
+							!assert.Equal(t, snid3, lsd.Replicas[1].StorageNodeID) {
+							return errors.New("unexpected replicas")
+						}
+						md.GetLogStream(lsid).Replicas = lsd.Replicas
+						return nil
+					},
+				)
+				mock.MockRepository.EXPECT().GetLogStream(lsid).Return(stats.NewLogStreamStat(
+					varlogpb.LogStreamStatusSealed,
+					map[types.StorageNodeID]snpb.LogStreamReplicaMetadataDescriptor{
+						snid2: {Status: varlogpb.LogStreamStatusSealed},
+						snid3: {Status: varlogpb.LogStreamStatusSealed},
+					},
+				)).AnyTimes()
+				mock.MockStorageNodeManager.EXPECT().Unseal(gomock.Any(), tpid, lsid).Return(nil)
+				mock.MockMetadataRepositoryManager.EXPECT().Unseal(gomock.Any(), lsid).DoAndReturn(
+					func(context.Context, types.LogStreamID) error {
+						mu.Lock()
+						defer mu.Unlock()
+						md.GetLogStream(lsid).Status = varlogpb.LogStreamStatusRunning
+						return nil
+					},
+				)
+				mock.MockStorageNodeManager.EXPECT().RemoveLogStreamReplica(gomock.Any(), snid1, tpid, lsid).Return(nil)
+				mock.MockMetadataRepositoryManager.EXPECT().UnregisterStorageNode(gomock.Any(), snid1).DoAndReturn(
+					func(context.Context, types.StorageNodeID) error {
+						mu.Lock()
+						defer mu.Unlock()
+						md.StorageNodes = md.StorageNodes[1:]
+						return nil
+					},
+				)
+				mock.MockStorageNodeManager.EXPECT().RemoveStorageNode(snid1)
+				mock.MockRepository.EXPECT().RemoveStorageNode(snid1)
+			},
+			testf: func(t *testing.T, client varlog.Admin) {
+				snm, err := client.DrainStorageNode(context.Background(), snid1)
+				require.NoError(t, err)
+				require.NotNil(t, snm.DrainStatus)
+				require.EqualValues(t, 1, snm.DrainStatus.TotalReplicas)
+
+				require.Eventually(t, func() bool {
+					_, err := client.GetStorageNode(context.Background(), snid1)
+					return errors.Is(err, verrors.ErrNotExist)
+				}, 5*time.Second, 10*time.Millisecond)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var mu sync.Mutex
+			md := &varlogpb.MetadataDescriptor{
+				LogStreams: []*varlogpb.LogStreamDescriptor{
+					{
+						TopicID:     tpid,
+						LogStreamID: lsid,
+						Status:      varlogpb.LogStreamStatusRunning,
+						Replicas: []*varlogpb.ReplicaDescriptor{
+							{StorageNodeID: snid1, StorageNodePath: newStorageNodeDescriptor(snid1).Paths[0]},
+							{StorageNodeID: snid2, StorageNodePath: newStorageNodeDescriptor(snid2).Paths[0]},
+						},
+					},
+				},
+			}
+			for _, snid := range tc.snids {
+				md.StorageNodes = append(md.StorageNodes, newStorageNodeDescriptor(snid))
+			}
+
+			mock := newTestMock(ctrl)
+			mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).DoAndReturn(
+				func(context.Context) (*varlogpb.MetadataDescriptor, error) {
+					mu.Lock()
+					defer mu.Unlock()
+					return proto.Clone(md).(*varlogpb.MetadataDescriptor), nil
+				},
+			).AnyTimes()
+			mock.MockRepository.EXPECT().GetStorageNode(gomock.Any()).Return(nil, false).AnyTimes()
+			mock.MockRepository.EXPECT().SetLogStreamStatus(lsid, gomock.Any()).AnyTimes()
+			tc.prepare(&mu, md, mock)
+
+			tadm := admin.TestNewClusterManager(t,
+				admin.WithListenAddress("127.0.0.1:0"),
+				admin.WithMetadataRepositoryManager(mock.MockMetadataRepositoryManager),
+				admin.WithStorageNodeManager(mock.MockStorageNodeManager),
+				admin.WithStatisticsRepository(mock.MockRepository),
+				admin.WithStorageNodeWatcherOptions(
+					snwatcher.WithTick(time.Hour), // no heartbeat checking
+				),
+				admin.WithDrainCheckInterval(10*time.Millisecond),
+			)
+			tadm.Serve(t)
+			defer tadm.Close(t)
+
+			client, closer := newTestClient(t, tadm.Address())
+			defer closer()
+
+			tc.testf(t, client)
+		})
+	}
+}
+
+func TestAdmin_SetStorageNodeSyncBandwidth(t *testing.T) {
+	const (
+		snid           = types.StorageNodeID(1)
+		bytesPerSecond = int64(32 << 20)
+	)
+
+	tcs := []struct {
+		name           string
+		bytesPerSecond int64
+		success        bool
+		prepare        func(mock *testMock)
+	}{
+		{
+			name:           "NegativeBandwidth",
+			bytesPerSecond: -1,
+			success:        false,
+			prepare: func(mock *testMock) {
+				mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(
+					&varlogpb.MetadataDescriptor{}, nil,
+				).AnyTimes()
+			},
+		},
+		{
+			name:           "NoSuchStorageNode",
+			bytesPerSecond: bytesPerSecond,
+			success:        false,
+			prepare: func(mock *testMock) {
+				mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(
+					&varlogpb.MetadataDescriptor{}, nil,
+				).AnyTimes()
+			},
+		},
+		{
+			name:           "Success",
+			bytesPerSecond: bytesPerSecond,
+			success:        true,
+			prepare: func(mock *testMock) {
+				mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(
+					&varlogpb.MetadataDescriptor{
+						StorageNodes: []*varlogpb.StorageNodeDescriptor{
+							{
+								StorageNode: varlogpb.StorageNode{
+									StorageNodeID: snid,
+								},
+							},
+						},
+					}, nil,
+				).AnyTimes()
+				mock.MockStorageNodeManager.EXPECT().SetSyncBandwidth(gomock.Any(), snid, bytesPerSecond).Return(int64(0), nil)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := newTestMock(ctrl)
+			tc.prepare(mock)
+
+			tadm := admin.TestNewClusterManager(t,
+				admin.WithListenAddress("127.0.0.1:0"),
+				admin.WithMetadataRepositoryManager(mock.MockMetadataRepositoryManager),
+				admin.WithStorageNodeManager(mock.MockStorageNodeManager),
+				admin.WithStorageNodeWatcherOptions(
+					snwatcher.WithTick(time.Hour), // no heartbeat checking
+					snwatcher.WithStatisticsRepository(mock.MockRepository),
+				),
+				admin.WithStatisticsRepository(mock.MockRepository),
+			)
+			tadm.Serve(t)
+			defer tadm.Close(t)
+
+			client, closer := newTestClient(t, tadm.Address())
+			defer closer()
+
+			rsp, err := client.SetStorageNodeSyncBandwidth(context.Background(), snid, tc.bytesPerSecond)
+			if !tc.success {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, snid, rsp.StorageNodeID)
+			assert.Zero(t, rsp.PrevBytesPerSecond)
+			assert.Equal(t, tc.bytesPerSecond, rsp.BytesPerSecond)
+		})
+	}
+}
+
+func TestAdmin_SetStorageNodeAppendQuota(t *testing.T) {
+	const (
+		snid = types.StorageNodeID(1)
+		tpid = types.TopicID(1)
+		lsid = types.LogStreamID(1)
+	)
+	quota := snpb.AppendQuota{BytesPerSecond: 16 << 20, RecordsPerSecond: 1000}
+
+	tcs := []struct {
+		name    string
+		tpid    types.TopicID
+		quota   snpb.AppendQuota
+		success bool
+		prepare func(mock *testMock)
+	}{
+		{
+			name:    "InvalidTopic",
+			tpid:    types.TopicID(0),
+			quota:   quota,
+			success: false,
+			prepare: func(mock *testMock) {
+				mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(
+					&varlogpb.MetadataDescriptor{}, nil,
+				).AnyTimes()
+			},
+		},
+		{
+			name:    "NegativeQuota",
+			tpid:    tpid,
+			quota:   snpb.AppendQuota{RecordsPerSecond: -1},
+			success: false,
+			prepare: func(mock *testMock) {
+				mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(
+					&varlogpb.MetadataDescriptor{}, nil,
+				).AnyTimes()
+			},
+		},
+		{
+			name:    "NoSuchStorageNode",
+			tpid:    tpid,
+			quota:   quota,
+			success: false,
+			prepare: func(mock *testMock) {
+				mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(
+					&varlogpb.MetadataDescriptor{}, nil,
+				).AnyTimes()
+			},
+		},
+		{
+			name:    "Success",
+			tpid:    tpid,
+			quota:   quota,
+			success: true,
+			prepare: func(mock *testMock) {
+				mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(
+					&varlogpb.MetadataDescriptor{
+						StorageNodes: []*varlogpb.StorageNodeDescriptor{
+							{
+								StorageNode: varlogpb.StorageNode{
+									StorageNodeID: snid,
+								},
+							},
+						},
+					}, nil,
+				).AnyTimes()
+				mock.MockStorageNodeManager.EXPECT().SetAppendQuota(gomock.Any(), snid, tpid, lsid, quota).Return(snpb.AppendQuota{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := newTestMock(ctrl)
+			tc.prepare(mock)
+
+			tadm := admin.TestNewClusterManager(t,
+				admin.WithListenAddress("127.0.0.1:0"),
+				admin.WithMetadataRepositoryManager(mock.MockMetadataRepositoryManager),
+				admin.WithStorageNodeManager(mock.MockStorageNodeManager),
+				admin.WithStorageNodeWatcherOptions(
+					snwatcher.WithTick(time.Hour), // no heartbeat checking
+					snwatcher.WithStatisticsRepository(mock.MockRepository),
+				),
+				admin.WithStatisticsRepository(mock.MockRepository),
+			)
+			tadm.Serve(t)
+			defer tadm.Close(t)
+
+			client, closer := newTestClient(t, tadm.Address())
+			defer closer()
+
+			rsp, err := client.SetStorageNodeAppendQuota(context.Background(), snid, tc.tpid, lsid, tc.quota)
+			if !tc.success {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, snid, rsp.StorageNodeID)
+			assert.Equal(t, tc.tpid, rsp.TopicID)
+			assert.Equal(t, lsid, rsp.LogStreamID)
+			assert.Zero(t, rsp.PrevQuota)
+			assert.Equal(t, tc.quota, rsp.Quota)
+		})
+	}
+}
+
+func TestAdmin_CheckpointLogStreamReplica(t *testing.T) {
+	const (
+		snid = types.StorageNodeID(1)
+		tpid = types.TopicID(2)
+		lsid = types.LogStreamID(3)
+		path = "/backup/checkpoint"
+	)
+
+	md := &varlogpb.MetadataDescriptor{
+		StorageNodes: []*varlogpb.StorageNodeDescriptor{
+			{StorageNode: varlogpb.StorageNode{StorageNodeID: snid}},
+		},
+		Topics: []*varlogpb.TopicDescriptor{
+			{TopicID: tpid, LogStreams: []types.LogStreamID{lsid}},
+		},
+		LogStreams: []*varlogpb.LogStreamDescriptor{
+			{
+				TopicID:     tpid,
+				LogStreamID: lsid,
+				Replicas: []*varlogpb.ReplicaDescriptor{
+					{StorageNodeID: snid},
+				},
+			},
+		},
+	}
+
+	tcs := []struct {
+		name    string
+		snid    types.StorageNodeID
+		path    string
+		success bool
+		prepare func(mock *testMock)
+	}{
+		{
+			name:    "RelativePath",
+			snid:    snid,
+			path:    "checkpoint",
+			success: false,
+		},
+		{
+			name:    "NoSuchReplica",
+			snid:    snid + 1,
+			path:    path,
+			success: false,
+		},
+		{
+			name:    "Success",
+			snid:    snid,
+			path:    path,
+			success: true,
+			prepare: func(mock *testMock) {
+				mock.MockStorageNodeManager.EXPECT().CheckpointLogStreamReplica(gomock.Any(), snid, tpid, lsid, path, true).Return(
+					snpb.LogStreamReplicaCheckpoint{
+						StorageNodeID: snid,
+						TopicID:       tpid,
+						LogStreamID:   lsid,
+						Path:          path,
+					}, nil,
+				)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := newTestMock(ctrl)
+			mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(md, nil).AnyTimes()
+			if tc.prepare != nil {
+				tc.prepare(mock)
+			}
+
+			tadm := admin.TestNewClusterManager(t,
+				admin.WithListenAddress("127.0.0.1:0"),
+				admin.WithMetadataRepositoryManager(mock.MockMetadataRepositoryManager),
+				admin.WithStorageNodeManager(mock.MockStorageNodeManager),
+				admin.WithStorageNodeWatcherOptions(
+					snwatcher.WithTick(time.Hour), // no heartbeat checking
+					snwatcher.WithStatisticsRepository(mock.MockRepository),
+				),
+				admin.WithStatisticsRepository(mock.MockRepository),
+			)
+			tadm.Serve(t)
+			defer tadm.Close(t)
+
+			client, closer := newTestClient(t, tadm.Address())
+			defer closer()
+
+			cp, err := client.CheckpointLogStreamReplica(context.Background(), tc.snid, tpid, lsid, tc.path, true)
+			if !tc.success {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, snid, cp.StorageNodeID)
+			assert.Equal(t, lsid, cp.LogStreamID)
+			assert.Equal(t, path, cp.Path)
+		})
+	}
+}
+
 func TestAdmin_GetTopic(t *testing.T) {
 	const tpid = types.TopicID(1)
 
@@ -710,22 +1245,31 @@ func TestAdmin_AddTopic(t *testing.T) {
 	const tpid = types.TopicID(1)
 
 	tcs := []struct {
-		name    string
-		success bool
-		prepare func(mock *testMock)
+		name      string
+		compacted bool
+		success   bool
+		prepare   func(mock *testMock)
 	}{
 		{
 			name:    "RejectedByMetadataRepository",
 			success: false,
 			prepare: func(mock *testMock) {
-				mock.MockMetadataRepositoryManager.EXPECT().RegisterTopic(gomock.Any(), tpid).Return(errors.New("error"))
+				mock.MockMetadataRepositoryManager.EXPECT().RegisterTopic(gomock.Any(), &varlogpb.TopicDescriptor{TopicID: tpid}).Return(errors.New("error"))
 			},
 		},
 		{
 			name:    "Success",
 			success: true,
 			prepare: func(mock *testMock) {
-				mock.MockMetadataRepositoryManager.EXPECT().RegisterTopic(gomock.Any(), tpid).Return(nil)
+				mock.MockMetadataRepositoryManager.EXPECT().RegisterTopic(gomock.Any(), &varlogpb.TopicDescriptor{TopicID: tpid}).Return(nil)
+			},
+		},
+		{
+			name:      "Compacted",
+			compacted: true,
+			success:   true,
+			prepare: func(mock *testMock) {
+				mock.MockMetadataRepositoryManager.EXPECT().RegisterTopic(gomock.Any(), &varlogpb.TopicDescriptor{TopicID: tpid, Compacted: true}).Return(nil)
 			},
 		},
 	}
@@ -750,9 +1294,14 @@ func TestAdmin_AddTopic(t *testing.T) {
 			defer closer()
 
 			tc.prepare(mock)
-			_, err := client.AddTopic(context.Background())
+			add := client.AddTopic
+			if tc.compacted {
+				add = client.AddCompactedTopic
+			}
+			td, err := add(context.Background())
 			if tc.success {
 				assert.NoError(t, err)
+				assert.Equal(t, tc.compacted, td.Compacted)
 			} else {
 				assert.Error(t, err)
 			}
@@ -2194,6 +2743,235 @@ func TestAdmin_Sync(t *testing.T) {
 }
 
 // See VARLOG-716.
+func TestAdmin_Operations(t *testing.T) {
+	const (
+		tpid  = types.TopicID(1)
+		lsid  = types.LogStreamID(1)
+		srcid = types.StorageNodeID(1)
+		dstid = types.StorageNodeID(2)
+	)
+
+	inProgress := &snpb.SyncStatus{
+		State:   snpb.SyncStateInProgress,
+		First:   snpb.SyncPosition{LLSN: types.LLSN(1), GLSN: types.GLSN(1)},
+		Last:    snpb.SyncPosition{LLSN: types.LLSN(10), GLSN: types.GLSN(10)},
+		Current: snpb.SyncPosition{LLSN: types.LLSN(5), GLSN: types.GLSN(5)},
+	}
+	complete := &snpb.SyncStatus{
+		State: snpb.SyncStateComplete,
+		First: snpb.SyncPosition{LLSN: types.LLSN(1), GLSN: types.GLSN(1)},
+		Last:  snpb.SyncPosition{LLSN: types.LLSN(10), GLSN: types.GLSN(10)},
+	}
+
+	newTestAdmin := func(t *testing.T, mock *testMock, opts ...admin.Option) (*admin.TestServer, varlog.Admin, func()) {
+		mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(
+			&varlogpb.MetadataDescriptor{
+				LogStreams: []*varlogpb.LogStreamDescriptor{
+					{
+						TopicID:     tpid,
+						LogStreamID: lsid,
+						Status:      varlogpb.LogStreamStatusSealed,
+					},
+				},
+			}, nil,
+		).AnyTimes()
+		mock.MockMetadataRepositoryManager.EXPECT().Seal(gomock.Any(), lsid).Return(types.MinGLSN, nil).AnyTimes()
+
+		tadm := admin.TestNewClusterManager(t, append([]admin.Option{
+			admin.WithListenAddress("127.0.0.1:0"),
+			admin.WithMetadataRepositoryManager(mock.MockMetadataRepositoryManager),
+			admin.WithStorageNodeManager(mock.MockStorageNodeManager),
+			admin.WithStorageNodeWatcherOptions(
+				snwatcher.WithTick(time.Hour), // no heartbeat checking
+			),
+			admin.WithSyncCheckInterval(10 * time.Millisecond),
+		}, opts...)...)
+		tadm.Serve(t)
+
+		client, closer := newTestClient(t, tadm.Address())
+		return tadm, client, func() {
+			closer()
+			tadm.Close(t)
+		}
+	}
+
+	t.Run("NoSuchOperation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		_, client, closer := newTestAdmin(t, newTestMock(ctrl))
+		defer closer()
+
+		_, err := client.GetOperation(context.Background(), 1)
+		require.ErrorIs(t, err, verrors.ErrNotExist)
+
+		_, err = client.CancelOperation(context.Background(), 1)
+		require.ErrorIs(t, err, verrors.ErrNotExist)
+
+		ops, err := client.ListOperations(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, ops)
+	})
+
+	t.Run("SyncCompletes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mock := newTestMock(ctrl)
+		gomock.InOrder(
+			mock.MockStorageNodeManager.EXPECT().Sync(gomock.Any(), tpid, lsid, srcid, dstid, gomock.Any()).Return(inProgress, nil).Times(2),
+			mock.MockStorageNodeManager.EXPECT().Sync(gomock.Any(), tpid, lsid, srcid, dstid, gomock.Any()).Return(complete, nil),
+		)
+		_, client, closer := newTestAdmin(t, mock)
+		defer closer()
+
+		var opid uint64
+		syncStatus, err := client.Sync(context.Background(), tpid, lsid, srcid, dstid, varlog.WithOperationID(&opid))
+		require.NoError(t, err)
+		require.Equal(t, snpb.SyncStateInProgress, syncStatus.State)
+		require.NotZero(t, opid)
+
+		require.Eventually(t, func() bool {
+			op, err := client.GetOperation(context.Background(), opid)
+			require.NoError(t, err)
+			return op.State == vmspb.OperationStateSucceeded
+		}, 5*time.Second, 10*time.Millisecond)
+
+		op, err := client.GetOperation(context.Background(), opid)
+		require.NoError(t, err)
+		require.Equal(t, vmspb.OperationKindSync, op.Kind)
+		require.Equal(t, srcid, op.SrcStorageNodeID)
+		require.Equal(t, dstid, op.DstStorageNodeID)
+		require.EqualValues(t, 10, op.Progress.TotalEntries)
+		require.EqualValues(t, 10, op.Progress.DoneEntries)
+	})
+
+	t.Run("CancelSync", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mock := newTestMock(ctrl)
+		mock.MockStorageNodeManager.EXPECT().Sync(gomock.Any(), tpid, lsid, srcid, dstid, gomock.Any()).Return(inProgress, nil).AnyTimes()
+		_, client, closer := newTestAdmin(t, mock)
+		defer closer()
+
+		var opid, opid2 uint64
+		_, err := client.Sync(context.Background(), tpid, lsid, srcid, dstid, varlog.WithOperationID(&opid))
+		require.NoError(t, err)
+
+		// Sync for the same replicas does not issue a new operation.
+		_, err = client.Sync(context.Background(), tpid, lsid, srcid, dstid, varlog.WithOperationID(&opid2))
+		require.NoError(t, err)
+		require.Equal(t, opid, opid2)
+
+		op, err := client.CancelOperation(context.Background(), opid)
+		require.NoError(t, err)
+		require.Equal(t, vmspb.OperationStateCanceled, op.State)
+		require.EqualValues(t, 5, op.Progress.DoneEntries)
+
+		// Canceling an operation already finished does nothing.
+		op, err = client.CancelOperation(context.Background(), opid)
+		require.NoError(t, err)
+		require.Equal(t, vmspb.OperationStateCanceled, op.State)
+	})
+
+	t.Run("SealFails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mock := newTestMock(ctrl)
+		mock.MockStorageNodeManager.EXPECT().Seal(gomock.Any(), tpid, lsid, gomock.Any()).Return(nil, errors.New("error"))
+		_, client, closer := newTestAdmin(t, mock)
+		defer closer()
+
+		_, err := client.Seal(context.Background(), tpid, lsid)
+		require.Error(t, err)
+
+		ops, err := client.ListOperations(context.Background())
+		require.NoError(t, err)
+		require.Len(t, ops, 1)
+		require.Equal(t, vmspb.OperationKindSeal, ops[0].Kind)
+		require.Equal(t, vmspb.OperationStateFailed, ops[0].State)
+		require.NotEmpty(t, ops[0].Error)
+	})
+
+	t.Run("Restart", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "operations.json")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// The first admin server seals the log stream and starts sync.
+		mock := newTestMock(ctrl)
+		mock.MockStorageNodeManager.EXPECT().Seal(gomock.Any(), tpid, lsid, gomock.Any()).Return(nil, nil)
+		mock.MockStorageNodeManager.EXPECT().Sync(gomock.Any(), tpid, lsid, srcid, dstid, gomock.Any()).Return(inProgress, nil).AnyTimes()
+		_, client, closer := newTestAdmin(t, mock, admin.WithOperationStorePath(path))
+
+		var sealOpID, syncOpID uint64
+		_, err := client.Seal(context.Background(), tpid, lsid, varlog.WithOperationID(&sealOpID))
+		require.NoError(t, err)
+		_, err = client.Sync(context.Background(), tpid, lsid, srcid, dstid, varlog.WithOperationID(&syncOpID))
+		require.NoError(t, err)
+		closer()
+
+		// The second admin server resumes tracking the sync.
+		mock = newTestMock(ctrl)
+		mock.MockStorageNodeManager.EXPECT().Sync(gomock.Any(), tpid, lsid, srcid, dstid, gomock.Any()).Return(complete, nil).AnyTimes()
+		_, client, closer = newTestAdmin(t, mock, admin.WithOperationStorePath(path))
+		defer closer()
+
+		op, err := client.GetOperation(context.Background(), sealOpID)
+		require.NoError(t, err)
+		require.Equal(t, vmspb.OperationStateSucceeded, op.State)
+
+		require.Eventually(t, func() bool {
+			op, err := client.GetOperation(context.Background(), syncOpID)
+			require.NoError(t, err)
+			return op.State == vmspb.OperationStateSucceeded
+		}, 5*time.Second, 10*time.Millisecond)
+
+		// Identifiers of new operations do not collide with old ones.
+		var opid uint64
+		_, err = client.Sync(context.Background(), tpid, lsid, srcid, dstid, varlog.WithOperationID(&opid))
+		require.NoError(t, err)
+		require.Greater(t, opid, syncOpID)
+	})
+
+	t.Run("SealTimesOut", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sealed := make(chan struct{})
+		mock := newTestMock(ctrl)
+		mock.MockStorageNodeManager.EXPECT().Seal(gomock.Any(), tpid, lsid, gomock.Any()).DoAndReturn(
+			func(context.Context, types.TopicID, types.LogStreamID, types.GLSN) ([]snpb.LogStreamReplicaMetadataDescriptor, error) {
+				<-sealed
+				return nil, nil
+			},
+		)
+		_, client, closer := newTestAdmin(t, mock)
+		defer closer()
+
+		// The client receives the identifier of the operation even if the
+		// operation does not finish before the deadline.
+		var opid uint64
+		_, err := client.Seal(context.Background(), tpid, lsid, varlog.WithTimeout(time.Second), varlog.WithOperationID(&opid))
+		require.ErrorIs(t, err, verrors.ErrInprogress)
+		require.NotZero(t, opid)
+
+		op, err := client.GetOperation(context.Background(), opid)
+		require.NoError(t, err)
+		require.Equal(t, vmspb.OperationStateRunning, op.State)
+
+		close(sealed)
+		require.Eventually(t, func() bool {
+			op, err := client.GetOperation(context.Background(), opid)
+			require.NoError(t, err)
+			return op.State == vmspb.OperationStateSucceeded
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
+
 func TestAdmin_DoNotSyncSealedReplicas_(t *testing.T) {
 	const (
 		sealedGLSN = types.GLSN(5)
@@ -2313,6 +3091,93 @@ func TestAdmin_DoNotSyncSealedReplicas_(t *testing.T) {
 	time.Sleep(tick * reportInterval * 10)
 }
 
+func TestAdmin_WatchEvents(t *testing.T) {
+	const (
+		tpid = types.TopicID(1)
+		lsid = types.LogStreamID(1)
+	)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := newTestMock(ctrl)
+	mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(
+		&varlogpb.MetadataDescriptor{}, nil,
+	).AnyTimes()
+	mock.MockRepository.EXPECT().SetLogStreamStatus(lsid, gomock.Any()).AnyTimes()
+	mock.MockMetadataRepositoryManager.EXPECT().Seal(gomock.Any(), lsid).Return(types.InvalidGLSN, nil).Times(2)
+	mock.MockStorageNodeManager.EXPECT().Seal(gomock.Any(), tpid, lsid, gomock.Any()).Return(nil, nil).Times(2)
+	mock.MockStorageNodeManager.EXPECT().Unseal(gomock.Any(), tpid, lsid).Return(nil)
+	mock.MockMetadataRepositoryManager.EXPECT().Unseal(gomock.Any(), lsid).Return(nil)
+
+	tadm := admin.TestNewClusterManager(t,
+		admin.WithListenAddress("127.0.0.1:0"),
+		admin.WithMetadataRepositoryManager(mock.MockMetadataRepositoryManager),
+		admin.WithStorageNodeManager(mock.MockStorageNodeManager),
+		admin.WithStatisticsRepository(mock.MockRepository),
+		admin.WithStorageNodeWatcherOptions(
+			snwatcher.WithTick(time.Hour), // no heartbeat checking
+		),
+	)
+	tadm.Serve(t)
+	defer tadm.Close(t)
+
+	client, closer := newTestClient(t, tadm.Address())
+	defer closer()
+
+	collect := func(after uint64) (rsps []vmspb.WatchEventsResponse) {
+		err := client.WatchEvents(context.Background(), after, false, func(rsp *vmspb.WatchEventsResponse) error {
+			rsps = append(rsps, *rsp)
+			return nil
+		})
+		require.NoError(t, err)
+		return rsps
+	}
+
+	require.Empty(t, collect(0))
+
+	// Sealing the log stream again is published only once.
+	_, err := client.Seal(context.Background(), tpid, lsid)
+	require.NoError(t, err)
+	_, err = client.Seal(context.Background(), tpid, lsid)
+	require.NoError(t, err)
+
+	rsps := collect(0)
+	require.Len(t, rsps, 1)
+	require.False(t, rsps[0].Truncated)
+	require.Equal(t, vmspb.EventKindLogStreamSealing, rsps[0].Event.Kind)
+	require.Equal(t, tpid, rsps[0].Event.TopicID)
+	require.Equal(t, lsid, rsps[0].Event.LogStreamID)
+	cursor := rsps[0].Event.EventID
+	require.Empty(t, collect(cursor))
+
+	// A cursor issued before the admin server started might have missed
+	// events.
+	rsps = collect(1)
+	require.Len(t, rsps, 1)
+	require.True(t, rsps[0].Truncated)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := make(chan vmspb.Event)
+	done := make(chan error, 1)
+	go func() {
+		done <- client.WatchEvents(ctx, cursor, true, func(rsp *vmspb.WatchEventsResponse) error {
+			events <- rsp.Event
+			return nil
+		})
+	}()
+
+	_, err = client.Unseal(context.Background(), tpid, lsid)
+	require.NoError(t, err)
+	ev := <-events
+	require.Equal(t, vmspb.EventKindLogStreamUnsealed, ev.Kind)
+	require.Greater(t, ev.EventID, cursor)
+
+	cancel()
+	require.Error(t, <-done)
+}
+
 func TestAdmin_Trim(t *testing.T) {
 	const tpid = types.TopicID(1)
 
@@ -2725,3 +3590,120 @@ func TestAdmin_DeleteMetadataRepositoryNode(t *testing.T) {
 func TestMain(m *testing.M) {
 	goleak.VerifyTestMain(m)
 }
+
+func TestAdmin_LeaderElection(t *testing.T) {
+	const leaderLease = 300 * time.Millisecond
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// lease emulates the admin lease stored in the metadata repository.
+	var lease struct {
+		mu sync.Mutex
+		mrpb.AdminLease
+		renewed time.Time
+		// epochs are epochs sent with RegisterTopic.
+		epochs []uint64
+	}
+	acquireAdminLease := func(_ context.Context, holder string, duration time.Duration) (*mrpb.AdminLease, error) {
+		lease.mu.Lock()
+		defer lease.mu.Unlock()
+		if lease.Holder == "" || lease.Holder == holder || time.Since(lease.renewed) >= lease.Duration {
+			if lease.Holder != holder {
+				lease.Epoch++
+			}
+			lease.Holder = holder
+			lease.Duration = duration
+			lease.renewed = time.Now()
+		}
+		ret := lease.AdminLease
+		return &ret, nil
+	}
+	registerTopic := func(ctx context.Context, _ *varlogpb.TopicDescriptor) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		epoch := rpc.AdminLeaseEpochFromIncomingContext(metadata.NewIncomingContext(ctx, md))
+		lease.mu.Lock()
+		defer lease.mu.Unlock()
+		if epoch < lease.Epoch {
+			return verrors.ErrStaleAdminLease
+		}
+		lease.epochs = append(lease.epochs, epoch)
+		return nil
+	}
+
+	newTestAdmin := func(holder string) *admin.TestServer {
+		mock := newTestMock(ctrl)
+		mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(&varlogpb.MetadataDescriptor{}, nil).AnyTimes()
+		mock.MockMetadataRepositoryManager.EXPECT().RegisterTopic(gomock.Any(), gomock.Any()).DoAndReturn(registerTopic).AnyTimes()
+		mock.MockMetadataRepositoryManager.EXPECT().AcquireAdminLease(gomock.Any(), holder, gomock.Any()).DoAndReturn(acquireAdminLease).AnyTimes()
+		tadm := admin.TestNewClusterManager(t,
+			admin.WithListenAddress("127.0.0.1:0"),
+			admin.WithMetadataRepositoryManager(mock.MockMetadataRepositoryManager),
+			admin.WithStorageNodeManager(mock.MockStorageNodeManager),
+			admin.WithStorageNodeWatcherOptions(
+				snwatcher.WithTick(time.Hour), // no heartbeat checking
+			),
+			admin.WithLeaderElection(holder),
+			admin.WithLeaderLease(leaderLease),
+		)
+		tadm.Serve(t)
+		return tadm
+	}
+
+	leader := newTestAdmin("admin1")
+	leaderClient, closer := newTestClient(t, leader.Address())
+	defer closer()
+	require.Eventually(t, func() bool {
+		_, err := leaderClient.AddTopic(context.Background())
+		return err == nil
+	}, 5*leaderLease, 10*time.Millisecond)
+
+	follower := newTestAdmin("admin2")
+	defer follower.Close(t)
+	followerClient, closer := newTestClient(t, follower.Address())
+	defer closer()
+
+	// The follower serves RPCs that do not change the cluster.
+	_, err := followerClient.ListTopics(context.Background())
+	require.NoError(t, err)
+
+	// The follower rejects RPCs that change the cluster.
+	_, err = followerClient.AddTopic(context.Background())
+	require.Error(t, err)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	// The follower rejects streaming RPCs that only the leader serves.
+	err = followerClient.WatchEvents(context.Background(), 0, false, func(*vmspb.WatchEventsResponse) error {
+		return nil
+	})
+	require.ErrorIs(t, err, verrors.ErrUnavailable)
+
+	// The client fails over to the leader.
+	client, closer := newTestClient(t, follower.Address()+","+leader.Address())
+	defer closer()
+	_, err = client.AddTopic(context.Background())
+	require.NoError(t, err)
+	err = client.WatchEvents(context.Background(), 0, false, func(*vmspb.WatchEventsResponse) error {
+		return nil
+	})
+	require.NoError(t, err)
+
+	// The follower becomes the leader after the leader stops.
+	leader.Close(t)
+	require.Eventually(t, func() bool {
+		_, err := followerClient.AddTopic(context.Background())
+		return err == nil
+	}, 5*leaderLease, 10*time.Millisecond)
+	_, err = client.AddTopic(context.Background())
+	require.NoError(t, err)
+
+	lease.mu.Lock()
+	defer lease.mu.Unlock()
+	require.Equal(t, "admin2", lease.Holder)
+	require.EqualValues(t, 2, lease.Epoch)
+
+	// Requests to the metadata repository are fenced by the epoch of the
+	// lease.
+	require.EqualValues(t, 1, lease.epochs[0])
+	require.EqualValues(t, 2, lease.epochs[len(lease.epochs)-1])
+}
@@ -19,6 +19,9 @@ const (
 	DefaultListenAddress      = "127.0.0.1:9090"
 	DefaultReplicationFactor  = 1
 	DefaultLogStreamGCTimeout = 24 * time.Hour
+	DefaultDrainCheckInterval = time.Second
+	DefaultSyncCheckInterval  = time.Second
+	DefaultLeaderLease        = 10 * time.Second
 )
 
 type config struct {
@@ -28,6 +31,13 @@ type config struct {
 	logStreamGCTimeout       time.Duration
 	disableAutoLogStreamSync bool
 	enableAutoUnseal         bool
+	drainCheckInterval       time.Duration
+	drains                   *drainTracker
+	syncCheckInterval        time.Duration
+	operationStorePath       string
+	enableLeaderElection     bool
+	advertiseAddress         string
+	leaderLease              time.Duration
 	mrmgr                    mrmanager.MetadataRepositoryManager
 	snmgr                    snmanager.StorageNodeManager
 	snSelector               ReplicaSelector
@@ -42,6 +52,10 @@ func newConfig(opts []Option) (config, error) {
 		listenAddress:      DefaultListenAddress,
 		replicationFactor:  DefaultReplicationFactor,
 		logStreamGCTimeout: DefaultLogStreamGCTimeout,
+		drainCheckInterval: DefaultDrainCheckInterval,
+		drains:             newDrainTracker(),
+		syncCheckInterval:  DefaultSyncCheckInterval,
+		leaderLease:        DefaultLeaderLease,
 		logger:             zap.NewNop(),
 	}
 
@@ -67,6 +81,18 @@ func (cfg config) validate() error {
 	if cfg.replicationFactor < 1 {
 		return errors.New("non-positive replication factor")
 	}
+	if cfg.drainCheckInterval <= 0 {
+		return errors.New("non-positive drain check interval")
+	}
+	if cfg.syncCheckInterval <= 0 {
+		return errors.New("non-positive sync check interval")
+	}
+	if cfg.enableLeaderElection && len(cfg.advertiseAddress) == 0 {
+		return errors.New("no advertise address for leader election")
+	}
+	if cfg.leaderLease <= 0 {
+		return errors.New("non-positive leader lease")
+	}
 	if cfg.mrmgr == nil {
 		return errors.New("mr manager is nil")
 	}
@@ -85,6 +111,7 @@ func (cfg *config) ensureDefault() error {
 		if err != nil {
 			return err
 		}
+		rs.excluded = cfg.drains.draining
 		cfg.snSelector = rs
 	}
 
@@ -144,6 +171,54 @@ func WithoutAutoLogStreamSync() Option {
 	})
 }
 
+// WithDrainCheckInterval sets the interval to check the progress of moving a
+// log stream replica while draining a storage node.
+func WithDrainCheckInterval(drainCheckInterval time.Duration) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.drainCheckInterval = drainCheckInterval
+	})
+}
+
+// WithSyncCheckInterval sets the interval to check the progress of sync
+// operations.
+func WithSyncCheckInterval(syncCheckInterval time.Duration) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.syncCheckInterval = syncCheckInterval
+	})
+}
+
+// WithOperationStorePath sets the path of the file that long-running
+// operations are persisted to. If it is not set, operations are kept only in
+// memory, thus, they are lost when the admin server restarts.
+func WithOperationStorePath(path string) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.operationStorePath = path
+	})
+}
+
+// WithLeaderElection enables leader election among admin servers. Only the
+// leader seals log streams on heartbeat timeout, unseals them automatically,
+// collects garbage log streams, and serves RPCs that change the cluster.
+// Followers reject such RPCs with an Unavailable error so that clients can
+// retry them on other admin servers.
+// The argument advertiseAddress is the address that clients can reach the
+// admin server by, and it identifies the holder of the lease.
+func WithLeaderElection(advertiseAddress string) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.enableLeaderElection = true
+		cfg.advertiseAddress = advertiseAddress
+	})
+}
+
+// WithLeaderLease sets the length of the lease for the leader. The leader
+// renews the lease at a third of the length. If the leader fails, another
+// admin server becomes the leader after the lease expires.
+func WithLeaderLease(leaderLease time.Duration) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.leaderLease = leaderLease
+	})
+}
+
 func WithAutoUnseal() Option {
 	return newFuncOption(func(cfg *config) {
 		cfg.enableAutoUnseal = true
@@ -0,0 +1,427 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/status"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"github.com/kakao/varlog/internal/admin/admerrors"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+	"github.com/kakao/varlog/proto/vmspb"
+)
+
+// drainTracker keeps the progress of storage nodes being drained.
+// Note that it is not persisted, thus, draining stops if the admin server
+// restarts. Draining the storage node again resumes it.
+type drainTracker struct {
+	drains map[types.StorageNodeID]*vmspb.DrainStatus
+	mu     sync.Mutex
+}
+
+func newDrainTracker() *drainTracker {
+	return &drainTracker{
+		drains: make(map[types.StorageNodeID]*vmspb.DrainStatus),
+	}
+}
+
+// start marks the storage node as draining. It returns false if the storage
+// node is already being drained.
+func (dt *drainTracker) start(snid types.StorageNodeID, totalReplicas int) bool {
+	dt.mu.Lock()
+	defer dt.mu.Unlock()
+	if ds, ok := dt.drains[snid]; ok && ds.State == vmspb.DrainStateDraining {
+		return false
+	}
+	now := time.Now().UTC()
+	dt.drains[snid] = &vmspb.DrainStatus{
+		State:         vmspb.DrainStateDraining,
+		TotalReplicas: int32(totalReplicas),
+		StartTime:     now,
+		UpdateTime:    now,
+	}
+	return true
+}
+
+// draining reports whether the storage node is draining or failed to drain.
+// Replicas of new log streams should not be placed on such a storage node.
+func (dt *drainTracker) draining(snid types.StorageNodeID) bool {
+	dt.mu.Lock()
+	defer dt.mu.Unlock()
+	_, ok := dt.drains[snid]
+	return ok
+}
+
+// get returns a copy of the progress of draining the storage node. It
+// returns nil if the storage node is not being drained.
+func (dt *drainTracker) get(snid types.StorageNodeID) *vmspb.DrainStatus {
+	dt.mu.Lock()
+	defer dt.mu.Unlock()
+	ds, ok := dt.drains[snid]
+	if !ok {
+		return nil
+	}
+	return proto.Clone(ds).(*vmspb.DrainStatus)
+}
+
+func (dt *drainTracker) update(snid types.StorageNodeID, f func(ds *vmspb.DrainStatus)) {
+	dt.mu.Lock()
+	defer dt.mu.Unlock()
+	ds, ok := dt.drains[snid]
+	if !ok {
+		return
+	}
+	f(ds)
+	ds.UpdateTime = time.Now().UTC()
+}
+
+func (dt *drainTracker) moving(snid types.StorageNodeID, lsid types.LogStreamID) {
+	dt.update(snid, func(ds *vmspb.DrainStatus) {
+		ds.LogStreamID = lsid
+	})
+}
+
+func (dt *drainTracker) moved(snid types.StorageNodeID) {
+	dt.update(snid, func(ds *vmspb.DrainStatus) {
+		ds.MovedReplicas++
+		ds.LogStreamID = types.LogStreamID(0)
+	})
+}
+
+func (dt *drainTracker) fail(snid types.StorageNodeID, err error) {
+	dt.update(snid, func(ds *vmspb.DrainStatus) {
+		ds.State = vmspb.DrainStateFailed
+		ds.Error = err.Error()
+	})
+}
+
+func (dt *drainTracker) remove(snid types.StorageNodeID) {
+	dt.mu.Lock()
+	defer dt.mu.Unlock()
+	delete(dt.drains, snid)
+}
+
+// drainStorageNode marks the storage node specified by the argument snid as
+// draining and starts moving its log stream replicas to other storage nodes
+// in the background. It returns the metadata of the storage node containing
+// the progress of draining.
+//
+// Draining a storage node that is being drained is okay. Draining a storage
+// node that failed to drain resumes moving the rest of replicas.
+func (adm *Admin) drainStorageNode(ctx context.Context, snid types.StorageNodeID) (*vmspb.StorageNodeMetadata, error) {
+	adm.mu.Lock()
+	md, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
+	if err != nil {
+		adm.mu.Unlock()
+		return nil, status.Errorf(codes.Unavailable, "drain storage node: cluster metadata not fetched")
+	}
+	if md.GetStorageNode(snid) == nil {
+		adm.mu.Unlock()
+		return nil, status.Errorf(codes.NotFound, "drain storage node: %d", int32(snid))
+	}
+	numReplicas := 0
+	for _, lsd := range md.LogStreams {
+		if lsd.IsReplica(snid) {
+			numReplicas++
+		}
+	}
+	started := adm.drains.start(snid, numReplicas)
+	adm.mu.Unlock()
+
+	if started {
+		if _, err := adm.runner.Run(func(ctx context.Context) {
+			adm.drain(ctx, snid)
+		}); err != nil {
+			adm.drains.fail(snid, err)
+			return nil, err
+		}
+	}
+	return adm.getStorageNode(ctx, snid)
+}
+
+// drain moves all log stream replicas in the storage node snid to other
+// storage nodes one by one, and then unregisters the storage node.
+func (adm *Admin) drain(ctx context.Context, snid types.StorageNodeID) {
+	logger := adm.logger.Named("drain").With(zap.Int32("snid", int32(snid)))
+	logger.Info("start draining")
+
+	for {
+		md, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
+		if err != nil {
+			adm.drains.fail(snid, err)
+			logger.Warn("could not drain", zap.Error(err))
+			return
+		}
+		lsd := nextLogStreamToDrain(md, snid)
+		if lsd == nil {
+			break
+		}
+
+		adm.drains.moving(snid, lsd.LogStreamID)
+		if err := adm.drainLogStream(ctx, snid, lsd.TopicID, lsd.LogStreamID); err != nil {
+			adm.drains.fail(snid, err)
+			logger.Warn("could not drain",
+				zap.Int32("tpid", int32(lsd.TopicID)),
+				zap.Int32("lsid", int32(lsd.LogStreamID)),
+				zap.Error(err),
+			)
+			return
+		}
+		adm.drains.moved(snid)
+		logger.Info("moved replica",
+			zap.Int32("tpid", int32(lsd.TopicID)),
+			zap.Int32("lsid", int32(lsd.LogStreamID)),
+		)
+	}
+
+	if err := adm.unregisterStorageNode(ctx, snid); err != nil {
+		adm.drains.fail(snid, err)
+		logger.Warn("could not unregister drained storage node", zap.Error(err))
+		return
+	}
+	adm.drains.remove(snid)
+	logger.Info("drained")
+}
+
+// nextLogStreamToDrain returns the log stream that has the lowest ID among log
+// streams having a replica in the storage node snid. It returns nil if there
+// is no such log stream.
+func nextLogStreamToDrain(md *varlogpb.MetadataDescriptor, snid types.StorageNodeID) *varlogpb.LogStreamDescriptor {
+	var ret *varlogpb.LogStreamDescriptor
+	for _, lsd := range md.LogStreams {
+		if !lsd.IsReplica(snid) {
+			continue
+		}
+		if ret == nil || lsd.LogStreamID < ret.LogStreamID {
+			ret = lsd
+		}
+	}
+	return ret
+}
+
+// drainLogStream moves the replica of the log stream in the storage node snid
+// to another storage node. It seals the log stream, replaces the replica,
+// waits for the new replica to be synchronized, and unseals the log stream.
+// Finally, it removes the old replica from the storage node snid.
+func (adm *Admin) drainLogStream(ctx context.Context, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID) error {
+	if _, _, err := adm.seal(ctx, tpid, lsid); err != nil {
+		return fmt.Errorf("seal: %w", err)
+	}
+
+	pushed, err := adm.moveReplica(ctx, snid, lsid)
+	if err != nil {
+		return fmt.Errorf("move replica: %w", err)
+	}
+
+	if err := adm.waitMovedReplica(ctx, tpid, lsid, pushed.StorageNodeID); err != nil {
+		return fmt.Errorf("sync replica: %w", err)
+	}
+
+	// The storage node being drained may be unreachable, for instance, its
+	// host has already been broken. Since the replica is no longer a member
+	// of the log stream, it is safe to leave it as garbage.
+	if err := adm.removeLogStreamReplica(ctx, snid, tpid, lsid); err != nil {
+		adm.logger.Warn("drain: could not remove replica",
+			zap.Int32("snid", int32(snid)),
+			zap.Int32("tpid", int32(tpid)),
+			zap.Int32("lsid", int32(lsid)),
+			zap.Error(err),
+		)
+	}
+	return nil
+}
+
+// moveReplica replaces the replica of the log stream lsid in the storage node
+// snid with a new replica in another storage node. The new replica is placed
+// at the end of the replicas, thus, the primary replica moves away from the
+// storage node snid.
+// It returns the new replica. If the replica has already been replaced, it
+// returns the last replica of the log stream.
+func (adm *Admin) moveReplica(ctx context.Context, snid types.StorageNodeID, lsid types.LogStreamID) (*varlogpb.ReplicaDescriptor, error) {
+	adm.mu.Lock()
+	defer adm.mu.Unlock()
+
+	adm.lockLogStreamStatus(lsid)
+	defer adm.unlockLogStreamStatus(lsid)
+
+	md, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	oldLSDesc, err := md.MustHaveLogStream(lsid)
+	if err != nil || oldLSDesc.Status.Deleted() {
+		return nil, admerrors.ErrNoSuchLogStream
+	}
+	if !oldLSDesc.IsReplica(snid) { // already moved
+		return oldLSDesc.Replicas[len(oldLSDesc.Replicas)-1], nil
+	}
+	if oldLSDesc.Status.Running() {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid log stream status %s", oldLSDesc.Status)
+	}
+	if len(oldLSDesc.Replicas) < 2 {
+		return nil, status.Errorf(codes.FailedPrecondition, "no other replica to copy log entries from")
+	}
+
+	pushed, err := adm.selectDrainTarget(md, oldLSDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	newLSDesc := proto.Clone(oldLSDesc).(*varlogpb.LogStreamDescriptor)
+	newLSDesc.Replicas = newLSDesc.Replicas[:0]
+	for _, rd := range oldLSDesc.Replicas {
+		if rd.StorageNodeID != snid {
+			newLSDesc.Replicas = append(newLSDesc.Replicas, proto.Clone(rd).(*varlogpb.ReplicaDescriptor))
+		}
+	}
+	newLSDesc.Replicas = append(newLSDesc.Replicas, pushed)
+
+	if !adm.hasSealedReplica(ctx, newLSDesc) {
+		return nil, status.Errorf(codes.FailedPrecondition, "no sealed replica")
+	}
+
+	lsrmd, err := adm.snmgr.AddLogStreamReplica(ctx, pushed.StorageNodeID, newLSDesc.TopicID, lsid, pushed.StorageNodePath)
+	if err != nil {
+		return nil, err
+	}
+	pushed.DataPath = lsrmd.Path
+
+	// To reset the status of the log stream, set it as LogStreamStatusRunning
+	defer func() {
+		adm.statRepository.SetLogStreamStatus(lsid, varlogpb.LogStreamStatusRunning)
+	}()
+
+	if err := adm.mrmgr.UpdateLogStream(ctx, newLSDesc); err != nil {
+		return nil, err
+	}
+	return pushed, nil
+}
+
+// selectDrainTarget chooses a storage node and its path for a new replica of
+// the log stream lsd. The storage node neither has a replica of the log
+// stream nor is being drained. It prefers the storage node that has the
+// lowest utilization.
+func (adm *Admin) selectDrainTarget(md *varlogpb.MetadataDescriptor, lsd *varlogpb.LogStreamDescriptor) (*varlogpb.ReplicaDescriptor, error) {
+	stats := newStorageNodeStats(md)
+	statsList := make([]storageNodeStat, 0, len(stats))
+	for snid, st := range stats {
+		if lsd.IsReplica(snid) || adm.drains.draining(snid) {
+			continue
+		}
+		statsList = append(statsList, st)
+	}
+	if len(statsList) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "no storage node to move replica to")
+	}
+	sortStorageNodeStats(statsList)
+
+	st := statsList[0]
+	snd := md.GetStorageNode(st.storageNodeID)
+	return &varlogpb.ReplicaDescriptor{
+		StorageNodeID:   st.storageNodeID,
+		StorageNodePath: st.selectPath(snd, rand.New(rand.NewSource(time.Now().UnixNano()))),
+	}, nil
+}
+
+// waitMovedReplica waits for the new replica in the storage node dstid to
+// have all log entries of the log stream and for the log stream to be
+// unsealed. If automatic log stream sync is disabled, it drives sync by
+// itself.
+func (adm *Admin) waitMovedReplica(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, dstid types.StorageNodeID) error {
+	ticker := time.NewTicker(adm.drainCheckInterval)
+	defer ticker.Stop()
+
+	logger := adm.logger.Named("drain").With(
+		zap.Int32("tpid", int32(tpid)),
+		zap.Int32("lsid", int32(lsid)),
+		zap.Int32("dst", int32(dstid)),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		md, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
+		if err != nil {
+			continue
+		}
+		lsd := md.GetLogStream(lsid)
+		if lsd == nil {
+			return admerrors.ErrNoSuchLogStream
+		}
+		if lsd.Status.Running() {
+			return nil
+		}
+
+		lsStat := adm.statRepository.GetLogStream(lsid).Copy()
+		replicas := lsStat.Replicas()
+		if len(replicas) > 0 && allReplicasSealed(replicas) {
+			if _, err := adm.unseal(ctx, tpid, lsid); err != nil {
+				logger.Debug("could not unseal", zap.Error(err))
+			}
+			continue
+		}
+
+		if !adm.disableAutoLogStreamSync {
+			// HandleReport copies log entries to the new replica.
+			continue
+		}
+		srcid, ok := sealedReplicaToSyncFrom(replicas, dstid)
+		if !ok {
+			continue
+		}
+		syncStatus, err := adm.sync(ctx, tpid, lsid, srcid, dstid)
+		if err != nil {
+			logger.Debug("could not sync", zap.Int32("src", int32(srcid)), zap.Error(err))
+			continue
+		}
+		logger.Debug("sync", zap.Int32("src", int32(srcid)), zap.String("status", syncStatus.String()))
+	}
+}
+
+func allReplicasSealed(replicas map[types.StorageNodeID]snpb.LogStreamReplicaMetadataDescriptor) bool {
+	for _, r := range replicas {
+		if r.Status != varlogpb.LogStreamStatusSealed {
+			return false
+		}
+	}
+	return true
+}
+
+// sealedReplicaToSyncFrom returns a sealed replica that has the highest
+// version except for the replica in the storage node dstid.
+func sealedReplicaToSyncFrom(replicas map[types.StorageNodeID]snpb.LogStreamReplicaMetadataDescriptor, dstid types.StorageNodeID) (types.StorageNodeID, bool) {
+	snids := make([]types.StorageNodeID, 0, len(replicas))
+	for snid := range replicas {
+		snids = append(snids, snid)
+	}
+	sort.Slice(snids, func(i, j int) bool { return snids[i] < snids[j] })
+
+	var (
+		src   types.StorageNodeID
+		found bool
+		max   types.Version
+	)
+	for _, snid := range snids {
+		r := replicas[snid]
+		if snid == dstid || r.Status != varlogpb.LogStreamStatusSealed {
+			continue
+		}
+		if !found || r.Version > max {
+			src, max, found = snid, r.Version, true
+		}
+	}
+	return src, found
+}
@@ -0,0 +1,209 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/vmspb"
+)
+
+// maxEvents is the maximum number of events kept by the event log. The
+// oldest ones are discarded first.
+const maxEvents = 4096
+
+// eventLog keeps recent events of the cluster in memory and notifies
+// watchers of new events.
+//
+// Identifiers of events are sequence numbers, that is, each identifier is
+// the previous one plus one. The sequence of an event log starts from the
+// time it is created in nanoseconds so that identifiers are likely to keep
+// increasing across the restart of the admin server or the change of the
+// leader; however, the wall clock is never read again, hence, the sequence is
+// not affected by the clock stepping back. Clients can resume watching by
+// using the identifier of the last event they received as a cursor, and a
+// cursor out of the sequence, which is issued by another event log, is
+// regarded as truncated.
+type eventLog struct {
+	mu     sync.Mutex
+	events []vmspb.Event
+	// subjects are the subjects of events, one for each of them.
+	subjects []string
+	// since is the identifier before which events are not kept by the
+	// event log. Events could have been missed if a cursor is less than
+	// it.
+	since  uint64
+	lastID uint64
+	// last is the last event published for each subject. It suppresses
+	// duplicated events, for instance, a heartbeat timeout reported
+	// repeatedly for the same storage node. It is pruned together with
+	// the events so that it does not grow without bound.
+	last map[string]lastEvent
+	// notify is closed and replaced whenever a new event is published.
+	notify chan struct{}
+}
+
+// lastEvent is the last event published for a subject. The event has neither
+// its identifier nor creation time so that it can be compared with new ones.
+type lastEvent struct {
+	event   vmspb.Event
+	eventID uint64
+}
+
+func newEventLog() *eventLog {
+	start := uint64(time.Now().UnixNano())
+	return &eventLog{
+		events:   make([]vmspb.Event, 0, maxEvents),
+		subjects: make([]string, 0, maxEvents),
+		since:    start,
+		lastID:   start,
+		last:     make(map[string]lastEvent),
+		notify:   make(chan struct{}),
+	}
+}
+
+// publish appends the event to the log. If the argument subject is not
+// empty and the last event published with the same subject is the same as
+// the argument ev, it drops the event.
+func (el *eventLog) publish(subject string, ev vmspb.Event) {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+
+	if len(subject) > 0 {
+		if last, ok := el.last[subject]; ok && last.event.Equal(&ev) {
+			return
+		}
+		el.last[subject] = lastEvent{event: ev, eventID: el.lastID + 1}
+	}
+
+	el.lastID++
+	ev.EventID = el.lastID
+	ev.CreateTime = time.Now().UTC()
+
+	if len(el.events) == maxEvents {
+		el.since = el.events[0].EventID
+		if subject := el.subjects[0]; len(subject) > 0 && el.last[subject].eventID == el.since {
+			delete(el.last, subject)
+		}
+		copy(el.events, el.events[1:])
+		el.events = el.events[:len(el.events)-1]
+		copy(el.subjects, el.subjects[1:])
+		el.subjects = el.subjects[:len(el.subjects)-1]
+	}
+	el.events = append(el.events, ev)
+	el.subjects = append(el.subjects, subject)
+
+	close(el.notify)
+	el.notify = make(chan struct{})
+}
+
+// forget clears the last event of the subject so that the next event with
+// the subject is not regarded as a duplicate.
+func (el *eventLog) forget(subject string) {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	delete(el.last, subject)
+}
+
+// read returns events whose identifiers are greater than the argument
+// after. It also returns a channel closed when a new event is published, and
+// whether events after the cursor could have been discarded. A cursor greater
+// than the last identifier is issued by another event log, thus, it returns
+// all events as truncated.
+func (el *eventLog) read(after uint64) (events []vmspb.Event, notify <-chan struct{}, truncated bool) {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+
+	truncated = after > 0 && (after < el.since || after > el.lastID)
+	if after > el.lastID {
+		after = 0
+	}
+	for i := range el.events {
+		if el.events[i].EventID > after {
+			events = make([]vmspb.Event, len(el.events)-i)
+			for j := range events {
+				events[j] = *proto.Clone(&el.events[i+j]).(*vmspb.Event)
+			}
+			break
+		}
+	}
+	return events, el.notify, truncated
+}
+
+// watch sends events whose identifiers are greater than the argument after
+// by calling the argument send. If the argument follow is true, it waits for
+// new events until the argument ctx is done.
+func (el *eventLog) watch(ctx context.Context, after uint64, follow bool, send func(*vmspb.WatchEventsResponse) error) error {
+	events, notify, truncated := el.read(after)
+	for {
+		for i := range events {
+			rsp := &vmspb.WatchEventsResponse{Event: events[i]}
+			// Truncation is reported only once with the first event.
+			rsp.Truncated, truncated = truncated, false
+			if err := send(rsp); err != nil {
+				return err
+			}
+			after = events[i].EventID
+		}
+
+		if !follow {
+			return nil
+		}
+		select {
+		case <-notify:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		events, notify, _ = el.read(after)
+	}
+}
+
+func storageNodeSubject(snid types.StorageNodeID) string {
+	return fmt.Sprintf("storagenode/%d", snid)
+}
+
+// logStreamSubject is the subject of events about the status of the log
+// stream. Sealing the log stream is retried until all replicas are sealed,
+// but it is published only once.
+func logStreamSubject(lsid types.LogStreamID) string {
+	return fmt.Sprintf("logstream/%d", lsid)
+}
+
+func syncSubject(lsid types.LogStreamID) string {
+	return fmt.Sprintf("logstream/%d/sync", lsid)
+}
+
+// publishSync publishes the progress of the sync. The same progress
+// reported repeatedly is published only once.
+func (adm *Admin) publishSync(tpid types.TopicID, lsid types.LogStreamID, srcid, dstid types.StorageNodeID, syncStatus *snpb.SyncStatus, err error) {
+	ev := vmspb.Event{
+		Kind:             vmspb.EventKindSyncProgress,
+		TopicID:          tpid,
+		LogStreamID:      lsid,
+		SrcStorageNodeID: srcid,
+		DstStorageNodeID: dstid,
+	}
+	switch {
+	case err != nil:
+		ev.Kind = vmspb.EventKindSyncFailed
+		ev.Message = err.Error()
+	case syncStatus.GetState() == snpb.SyncStateError:
+		ev.Kind = vmspb.EventKindSyncFailed
+	case syncStatus.GetState() == snpb.SyncStateComplete:
+		ev.Kind = vmspb.EventKindSyncCompleted
+	}
+	if syncStatus != nil {
+		progress := syncProgress(syncStatus)
+		ev.Progress = &progress
+	}
+	adm.events.publish(syncSubject(lsid), ev)
+}
+
+func (adm *Admin) watchEvents(ctx context.Context, after uint64, follow bool, send func(*vmspb.WatchEventsResponse) error) error {
+	return adm.events.watch(ctx, after, follow, send)
+}
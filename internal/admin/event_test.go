@@ -0,0 +1,51 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/vmspb"
+)
+
+func TestEventLog(t *testing.T) {
+	el := newEventLog()
+
+	// Identifiers are sequence numbers.
+	el.publish("", vmspb.Event{Kind: vmspb.EventKindLogStreamSealing})
+	el.publish("", vmspb.Event{Kind: vmspb.EventKindLogStreamUnsealed})
+	events, _, truncated := el.read(0)
+	require.False(t, truncated)
+	require.Len(t, events, 2)
+	require.Equal(t, events[0].EventID+1, events[1].EventID)
+
+	// A cursor issued by another event log is truncated.
+	events, _, truncated = el.read(events[1].EventID + 1)
+	require.True(t, truncated)
+	require.Len(t, events, 2)
+	events, _, truncated = el.read(1)
+	require.True(t, truncated)
+	require.Len(t, events, 2)
+
+	// The last events of subjects are pruned together with the events.
+	for i := 0; i < maxEvents; i++ {
+		el.publish(storageNodeSubject(types.StorageNodeID(i)), vmspb.Event{
+			Kind:          vmspb.EventKindHeartbeatTimeout,
+			StorageNodeID: types.StorageNodeID(i),
+		})
+	}
+	require.Len(t, el.last, maxEvents)
+	el.publish("", vmspb.Event{Kind: vmspb.EventKindLogStreamSealing})
+	require.Len(t, el.last, maxEvents-1)
+	require.NotContains(t, el.last, storageNodeSubject(0))
+	require.Contains(t, el.last, storageNodeSubject(1))
+
+	// A duplicated event is dropped while it is kept.
+	lastID := el.lastID
+	el.publish(storageNodeSubject(1), vmspb.Event{
+		Kind:          vmspb.EventKindHeartbeatTimeout,
+		StorageNodeID: 1,
+	})
+	require.Equal(t, lastID, el.lastID)
+}
@@ -2,6 +2,12 @@ package admin
 
 var (
 	grpcHandlerLogDenyList map[string]bool
+
+	// grpcFollowerAllowList is a set of RPCs of ClusterManager that
+	// followers can serve. They do not change the cluster. Note that RPCs
+	// for long-running operations are not in this list since each admin
+	// server keeps its own operations.
+	grpcFollowerAllowList map[string]bool
 )
 
 func init() {
@@ -12,4 +18,20 @@ func init() {
 	} {
 		grpcHandlerLogDenyList[method] = true
 	}
+
+	grpcFollowerAllowList = make(map[string]bool)
+	for _, method := range []string{
+		"GetStorageNode",
+		"ListStorageNodes",
+		"GetTopic",
+		"DescribeTopic",
+		"ListTopics",
+		"GetLogStream",
+		"ListLogStreams",
+		"GetMetadataRepositoryNode",
+		"ListMetadataRepositoryNodes",
+		"GetMRMembers",
+	} {
+		grpcFollowerAllowList[clusterManagerServicePrefix+method] = true
+	}
 }
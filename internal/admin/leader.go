@@ -0,0 +1,212 @@
+package admin
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/status"
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/kakao/varlog/internal/admin/mrmanager"
+	"github.com/kakao/varlog/pkg/rpc"
+	"github.com/kakao/varlog/proto/mrpb"
+)
+
+const clusterManagerServicePrefix = "/varlog.vmspb.ClusterManager/"
+
+// leaderElector elects the leader among admin servers by using the lease
+// stored in the metadata repository. Each admin server tries to acquire or
+// renew the lease periodically, and only the holder of an unexpired lease
+// acts as the leader.
+type leaderElector struct {
+	holder   string
+	duration time.Duration
+	mrmgr    mrmanager.MetadataRepositoryManager
+	// elected is called when the admin server becomes the leader.
+	elected func(ctx context.Context)
+	logger  *zap.Logger
+
+	mu     sync.RWMutex
+	lease  mrpb.AdminLease
+	leader bool
+	// expire is the time when the lease held by this admin server expires.
+	// It is measured by the local clock from the time of sending the
+	// request, thus, it comes before any metadata repository regards the
+	// lease as expired unless the rates of clocks differ a lot.
+	expire time.Time
+	// epoch is the epoch of the lease that this admin server has held most
+	// recently.
+	epoch uint64
+}
+
+func newLeaderElector(holder string, duration time.Duration, mrmgr mrmanager.MetadataRepositoryManager, elected func(context.Context), logger *zap.Logger) *leaderElector {
+	return &leaderElector{
+		holder:   holder,
+		duration: duration,
+		mrmgr:    mrmgr,
+		elected:  elected,
+		logger:   logger.Named("leader elector").With(zap.String("holder", holder)),
+	}
+}
+
+// run acquires and renews the lease until the argument ctx is canceled. It
+// releases the lease if it holds the lease when it returns.
+func (le *leaderElector) run(ctx context.Context) {
+	ticker := time.NewTicker(le.duration / 3)
+	defer ticker.Stop()
+
+	for {
+		le.renew(ctx)
+		select {
+		case <-ctx.Done():
+			le.release()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (le *leaderElector) renew(ctx context.Context) {
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(ctx, le.duration/3)
+	defer cancel()
+
+	lease, err := le.mrmgr.AcquireAdminLease(ctx, le.holder, le.duration)
+	if err != nil {
+		le.logger.Warn("could not acquire lease", zap.Error(err))
+		return
+	}
+
+	isLeader := lease.Holder == le.holder
+	le.mu.Lock()
+	le.lease = *lease
+	wasLeader := le.leader
+	le.leader = isLeader
+	if isLeader {
+		le.expire = start.Add(le.duration)
+		le.epoch = lease.Epoch
+	}
+	le.mu.Unlock()
+
+	switch {
+	case isLeader && !wasLeader:
+		le.logger.Info("became leader", zap.Uint64("epoch", lease.Epoch))
+		le.elected(ctx)
+	case !isLeader && wasLeader:
+		le.logger.Warn("lost leadership", zap.String("leader", lease.Holder), zap.Uint64("epoch", lease.Epoch))
+	}
+}
+
+func (le *leaderElector) release() {
+	if !le.isLeader() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), le.duration/3)
+	defer cancel()
+	if _, err := le.mrmgr.AcquireAdminLease(ctx, le.holder, 0); err != nil {
+		le.logger.Warn("could not release lease", zap.Error(err))
+	}
+
+	le.mu.Lock()
+	le.leader = false
+	le.mu.Unlock()
+}
+
+// isLeader returns true if this admin server holds an unexpired lease.
+func (le *leaderElector) isLeader() bool {
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+	return le.leader && time.Now().Before(le.expire)
+}
+
+// leaderEpoch returns the epoch of the lease that this admin server has held
+// most recently, and whether it still holds the lease. The epoch is zero if it
+// has never held the lease.
+func (le *leaderElector) leaderEpoch() (uint64, bool) {
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+	return le.epoch, le.leader && time.Now().Before(le.expire)
+}
+
+// leaderAddress returns the address of the admin server that held the lease
+// at the last renewal. It can be stale.
+func (le *leaderElector) leaderAddress() string {
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+	return le.lease.Holder
+}
+
+// isLeader returns true if the admin server is the leader. It is always the
+// leader if leader election is disabled.
+func (adm *Admin) isLeader() bool {
+	return adm.elector == nil || adm.elector.isLeader()
+}
+
+// leaderContext returns a context that sends the epoch of the lease with
+// requests to metadata repositories and storage nodes, and whether the admin
+// server is the leader. They reject requests having the epoch once another
+// admin server acquires the lease; therefore, work started by the leader
+// cannot change the cluster after it loses the lease, even if the work is
+// still running. The context is the argument ctx if leader election is
+// disabled.
+func (adm *Admin) leaderContext(ctx context.Context) (context.Context, bool) {
+	if adm.elector == nil {
+		return ctx, true
+	}
+	epoch, leader := adm.elector.leaderEpoch()
+	if epoch == 0 {
+		return ctx, leader
+	}
+	return rpc.WithAdminLeaseEpoch(ctx, epoch), leader
+}
+
+// becomeLeader prepares the admin server to act as the leader. Since the
+// previous leader could have changed the cluster, it refreshes the ID
+// generators.
+func (adm *Admin) becomeLeader(ctx context.Context) {
+	if err := adm.lsidGen.Refresh(ctx); err != nil {
+		adm.logger.Warn("could not refresh log stream id generator", zap.Error(err))
+	}
+	if err := adm.tpidGen.Refresh(ctx); err != nil {
+		adm.logger.Warn("could not refresh topic id generator", zap.Error(err))
+	}
+	adm.resumeOnce.Do(adm.resumeOperations)
+}
+
+// leaderUnaryServerInterceptor rejects RPCs of ClusterManager that only the
+// leader can serve if the admin server is not the leader. It returns an
+// Unavailable error so that clients can retry the RPC on another admin
+// server. Requests made while serving the RPC are fenced by the epoch of the
+// lease; see leaderContext.
+func (adm *Admin) leaderUnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if strings.HasPrefix(info.FullMethod, clusterManagerServicePrefix) &&
+		!grpcFollowerAllowList[info.FullMethod] {
+		var leader bool
+		if ctx, leader = adm.leaderContext(ctx); !leader {
+			return nil, status.Errorf(codes.Unavailable, "admin: not leader, leader=%s", adm.elector.leaderAddress())
+		}
+	}
+	return handler(ctx, req)
+}
+
+// leaderStreamServerInterceptor rejects streaming RPCs of ClusterManager
+// that only the leader can serve if the admin server is not the leader.
+func (adm *Admin) leaderStreamServerInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if strings.HasPrefix(info.FullMethod, clusterManagerServicePrefix) &&
+		!grpcFollowerAllowList[info.FullMethod] {
+		ctx, leader := adm.leaderContext(ss.Context())
+		if !leader {
+			return status.Errorf(codes.Unavailable, "admin: not leader, leader=%s", adm.elector.leaderAddress())
+		}
+		wrapped := grpcmiddleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		ss = wrapped
+	}
+	return handler(srv, ss)
+}
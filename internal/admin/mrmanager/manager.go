@@ -10,12 +10,16 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gogo/status"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
 
 	"github.com/kakao/varlog/pkg/mrc"
 	"github.com/kakao/varlog/pkg/mrc/mrconnector"
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/util/runner"
 	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/mrpb"
 	"github.com/kakao/varlog/proto/varlogpb"
@@ -51,7 +55,7 @@ type MetadataRepositoryManager interface {
 
 	UnregisterStorageNode(ctx context.Context, storageNodeID types.StorageNodeID) error
 
-	RegisterTopic(ctx context.Context, topicID types.TopicID) error
+	RegisterTopic(ctx context.Context, td *varlogpb.TopicDescriptor) error
 
 	UnregisterTopic(ctx context.Context, topicID types.TopicID) error
 
@@ -74,6 +78,15 @@ type MetadataRepositoryManager interface {
 
 	RemovePeer(ctx context.Context, nodeID types.NodeID) error
 
+	// TransferLeadership transfers the leadership of the metadata repository
+	// to the node specified by the argument nodeID.
+	TransferLeadership(ctx context.Context, nodeID types.NodeID) error
+
+	// AcquireAdminLease acquires or renews the lease for the leader of admin
+	// servers on behalf of the argument holder. It returns the current lease
+	// stored in the metadata repository.
+	AcquireAdminLease(ctx context.Context, holder string, duration time.Duration) (*mrpb.AdminLease, error)
+
 	NumberOfMR() int
 }
 
@@ -92,6 +105,19 @@ type mrManager struct {
 	dirty   bool
 	updated time.Time
 	meta    *varlogpb.MetadataDescriptor
+	// watching is true while the watch of the metadata repository delivers
+	// metadata. The cached metadata is not reloaded periodically while
+	// watching.
+	watching bool
+
+	// leaderMC is the management client connected to leaderEndpoint, which
+	// is the address of the leader of the metadata repository. It is kept
+	// across calls to GetClusterInfo.
+	leaderMC       mrc.MetadataRepositoryManagementClient
+	leaderEndpoint string
+
+	runner *runner.Runner
+	cancel context.CancelFunc
 }
 
 const (
@@ -124,10 +150,20 @@ func New(ctx context.Context, opts ...Option) (MetadataRepositoryManager, error)
 			time.Sleep(cfg.initialMRConnRetryBackoff)
 			continue
 		}
-		return &mrManager{
+		mrm := &mrManager{
+			config:    cfg,
 			dirty:     true,
 			connector: connector,
-		}, nil
+			runner:    runner.New("mr manager", cfg.logger),
+		}
+		wctx, cancel := mrm.runner.WithManagedCancel(context.Background())
+		if err := mrm.runner.RunC(wctx, mrm.watcher); err != nil {
+			cancel()
+			mrm.runner.Stop()
+			return nil, multierr.Append(err, connector.Close())
+		}
+		mrm.cancel = cancel
+		return mrm, nil
 	}
 	err = errors.WithMessagef(err, "mrmanager: tries = %d", tryCnt)
 	return nil, err
@@ -144,23 +180,34 @@ func (mrm *mrManager) mc() (mrc.MetadataRepositoryManagementClient, error) {
 }
 
 func (mrm *mrManager) Close() error {
+	mrm.cancel()
+	mrm.runner.Stop()
+
 	mrm.mu.Lock()
 	defer mrm.mu.Unlock()
 
-	return errors.Wrap(mrm.connector.Close(), "mrmanager")
+	err := mrm.closeLeaderMC()
+	return errors.Wrap(multierr.Append(err, mrm.connector.Close()), "mrmanager")
 }
 
 func (mrm *mrManager) ClusterMetadataView() ClusterMetadataView {
 	return mrm
 }
 
-func (mrm *mrManager) clusterMetadata(ctx context.Context) (*varlogpb.MetadataDescriptor, error) {
+// clusterMetadata fetches the metadata from the metadata repository. If the
+// argument linearizable is true, the metadata reflects all changes made
+// before the call even if the connected node lags behind the leader.
+func (mrm *mrManager) clusterMetadata(ctx context.Context, linearizable bool) (*varlogpb.MetadataDescriptor, error) {
 	cli, err := mrm.c()
 	if err != nil {
 		return nil, errors.WithMessage(err, "mrmanager: not accessible")
 	}
 
-	meta, err := cli.GetMetadata(ctx)
+	var opts []mrc.GetMetadataOption
+	if linearizable {
+		opts = append(opts, mrc.WithLinearizableRead())
+	}
+	meta, err := cli.GetMetadata(ctx, opts...)
 	if err != nil {
 		return nil, multierr.Append(err, cli.Close())
 	}
@@ -206,7 +253,7 @@ func (mrm *mrManager) UnregisterStorageNode(ctx context.Context, storageNodeID t
 	return err
 }
 
-func (mrm *mrManager) RegisterTopic(ctx context.Context, topicID types.TopicID) error {
+func (mrm *mrManager) RegisterTopic(ctx context.Context, td *varlogpb.TopicDescriptor) error {
 	mrm.mu.Lock()
 	defer func() {
 		mrm.dirty = true
@@ -218,7 +265,7 @@ func (mrm *mrManager) RegisterTopic(ctx context.Context, topicID types.TopicID)
 		return errors.WithMessage(err, "mrmanager: not accessible")
 	}
 
-	if err := cli.RegisterTopic(ctx, topicID); err != nil {
+	if err := cli.RegisterTopic(ctx, td); err != nil {
 		return multierr.Append(err, cli.Close())
 	}
 
@@ -349,7 +396,78 @@ func (mrm *mrManager) GetClusterInfo(ctx context.Context) (*mrpb.ClusterInfo, er
 	if err != nil {
 		return nil, multierr.Append(err, cli.Close())
 	}
-	return rsp.GetClusterInfo(), err
+
+	// Only the leader reports the replication progress of members, thus the
+	// cluster info is fetched from the leader if possible.
+	ci := rsp.GetClusterInfo()
+	if leaderCI, err := mrm.leaderClusterInfo(ctx, ci); err == nil {
+		ci = leaderCI
+	} else {
+		mrm.logger.Debug("could not get cluster info from the leader", zap.Error(err))
+	}
+	return ci, nil
+}
+
+// leaderClusterInfo returns the cluster info reported by the leader of the
+// metadata repository. It returns the argument ci if it is already reported
+// by the leader. The connection to the leader is reused until the leader
+// changes or the call fails. The caller should hold mu.
+func (mrm *mrManager) leaderClusterInfo(ctx context.Context, ci *mrpb.ClusterInfo) (*mrpb.ClusterInfo, error) {
+	if ci.GetNodeID() == ci.GetLeader() {
+		return ci, nil
+	}
+	leader, ok := ci.GetMembers()[ci.GetLeader()]
+	if !ok || leader.GetEndpoint() == "" {
+		return nil, errors.New("mrmanager: no leader")
+	}
+
+	if mrm.leaderMC != nil && mrm.leaderEndpoint != leader.GetEndpoint() {
+		_ = mrm.closeLeaderMC()
+	}
+	if mrm.leaderMC == nil {
+		cli, err := mrc.NewMetadataRepositoryManagementClient(ctx, leader.GetEndpoint())
+		if err != nil {
+			return nil, err
+		}
+		mrm.leaderMC = cli
+		mrm.leaderEndpoint = leader.GetEndpoint()
+	}
+
+	rsp, err := mrm.leaderMC.GetClusterInfo(ctx, mrm.cid)
+	if err != nil {
+		return nil, multierr.Append(err, mrm.closeLeaderMC())
+	}
+	return rsp.GetClusterInfo(), nil
+}
+
+// closeLeaderMC closes the management client connected to the leader if it
+// exists. The caller should hold mu.
+func (mrm *mrManager) closeLeaderMC() error {
+	if mrm.leaderMC == nil {
+		return nil
+	}
+	err := mrm.leaderMC.Close()
+	mrm.leaderMC = nil
+	mrm.leaderEndpoint = ""
+	return err
+}
+
+func (mrm *mrManager) TransferLeadership(ctx context.Context, nodeID types.NodeID) error {
+	mrm.mu.Lock()
+	defer mrm.mu.Unlock()
+
+	cli, err := mrm.mc()
+	if err != nil {
+		return errors.WithMessage(err, "mrmanager: not accessible")
+	}
+
+	if err := cli.TransferLeadership(ctx, mrm.cid, nodeID); err != nil {
+		if errors.Is(err, verrors.ErrNotExist) || errors.Is(err, verrors.ErrInvalid) {
+			return err
+		}
+		return multierr.Append(err, cli.Close())
+	}
+	return nil
 }
 
 func (mrm *mrManager) AddPeer(ctx context.Context, nodeID types.NodeID, peerURL, rpcURL string) error {
@@ -389,16 +507,93 @@ func (mrm *mrManager) RemovePeer(ctx context.Context, nodeID types.NodeID) error
 	return nil
 }
 
+func (mrm *mrManager) AcquireAdminLease(ctx context.Context, holder string, duration time.Duration) (*mrpb.AdminLease, error) {
+	mrm.mu.Lock()
+	defer mrm.mu.Unlock()
+
+	cli, err := mrm.c()
+	if err != nil {
+		return nil, errors.WithMessage(err, "mrmanager: not accessible")
+	}
+
+	lease, err := cli.AcquireAdminLease(ctx, holder, duration)
+	if err != nil {
+		return nil, multierr.Append(err, cli.Close())
+	}
+	return lease, nil
+}
+
+// watcher keeps the cached metadata up to date by watching the metadata
+// repository until the argument ctx is done. If the metadata repository does
+// not support the watch, it gives up, and ClusterMetadata falls back to
+// polling.
+func (mrm *mrManager) watcher(ctx context.Context) {
+	for {
+		err := mrm.watch(ctx)
+		mrm.mu.Lock()
+		mrm.watching = false
+		mrm.mu.Unlock()
+		if ctx.Err() != nil {
+			return
+		}
+		if status.Code(errors.Cause(err)) == codes.Unimplemented {
+			mrm.logger.Info("metadata repository does not support watch, fall back to polling")
+			return
+		}
+		mrm.logger.Debug("watch metadata failed", zap.Error(err))
+
+		select {
+		case <-time.After(ReloadInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (mrm *mrManager) watch(ctx context.Context) error {
+	cli, err := mrm.connector.Client(ctx)
+	if err != nil {
+		return err
+	}
+
+	mrm.mu.RLock()
+	appliedIndex := mrm.meta.GetAppliedIndex()
+	mrm.mu.RUnlock()
+
+	err = cli.WatchMetadata(ctx, appliedIndex, func(meta *varlogpb.MetadataDescriptor) error {
+		mrm.mu.Lock()
+		defer mrm.mu.Unlock()
+		mrm.watching = true
+		if meta.GetAppliedIndex() > mrm.meta.GetAppliedIndex() {
+			mrm.meta = meta
+			mrm.updated = time.Now()
+		}
+		return nil
+	})
+	if ctx.Err() == nil {
+		err = multierr.Append(err, cli.Close())
+	}
+	return err
+}
+
+// ClusterMetadata returns the cached metadata. It fetches the metadata from
+// the metadata repository if the metadata repository has been changed by
+// the mrManager. It also reloads the metadata periodically unless the watch
+// keeps it up to date.
 func (mrm *mrManager) ClusterMetadata(ctx context.Context) (*varlogpb.MetadataDescriptor, error) {
 	mrm.mu.Lock()
 	defer mrm.mu.Unlock()
 
-	if mrm.dirty || time.Since(mrm.updated) > ReloadInterval {
-		meta, err := mrm.clusterMetadata(ctx)
+	if mrm.dirty || (!mrm.watching && time.Since(mrm.updated) > ReloadInterval) {
+		// The metadata is dirty after changing it, thus it has to be read
+		// linearizably not to miss the change.
+		meta, err := mrm.clusterMetadata(ctx, mrm.dirty)
 		if err != nil {
 			return nil, fmt.Errorf("cluster metadata: %w", err)
 		}
-		mrm.meta = meta
+		if meta.GetAppliedIndex() >= mrm.meta.GetAppliedIndex() {
+			mrm.meta = meta
+		}
 		mrm.dirty = false
 		mrm.updated = time.Now()
 	}
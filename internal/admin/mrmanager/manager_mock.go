@@ -7,6 +7,7 @@ package mrmanager
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 
@@ -91,6 +92,21 @@ func (m *MockMetadataRepositoryManager) EXPECT() *MockMetadataRepositoryManagerM
 	return m.recorder
 }
 
+// AcquireAdminLease mocks base method.
+func (m *MockMetadataRepositoryManager) AcquireAdminLease(arg0 context.Context, arg1 string, arg2 time.Duration) (*mrpb.AdminLease, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireAdminLease", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*mrpb.AdminLease)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireAdminLease indicates an expected call of AcquireAdminLease.
+func (mr *MockMetadataRepositoryManagerMockRecorder) AcquireAdminLease(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireAdminLease", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).AcquireAdminLease), arg0, arg1, arg2)
+}
+
 // AddPeer mocks base method.
 func (m *MockMetadataRepositoryManager) AddPeer(arg0 context.Context, arg1 types.NodeID, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
@@ -191,7 +207,7 @@ func (mr *MockMetadataRepositoryManagerMockRecorder) RegisterStorageNode(arg0, a
 }
 
 // RegisterTopic mocks base method.
-func (m *MockMetadataRepositoryManager) RegisterTopic(arg0 context.Context, arg1 types.TopicID) error {
+func (m *MockMetadataRepositoryManager) RegisterTopic(arg0 context.Context, arg1 *varlogpb.TopicDescriptor) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RegisterTopic", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -233,6 +249,20 @@ func (mr *MockMetadataRepositoryManagerMockRecorder) Seal(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).Seal), arg0, arg1)
 }
 
+// TransferLeadership mocks base method.
+func (m *MockMetadataRepositoryManager) TransferLeadership(arg0 context.Context, arg1 types.NodeID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferLeadership", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferLeadership indicates an expected call of TransferLeadership.
+func (mr *MockMetadataRepositoryManagerMockRecorder) TransferLeadership(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeadership", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).TransferLeadership), arg0, arg1)
+}
+
 // UnregisterLogStream mocks base method.
 func (m *MockMetadataRepositoryManager) UnregisterLogStream(arg0 context.Context, arg1 types.LogStreamID) error {
 	m.ctrl.T.Helper()
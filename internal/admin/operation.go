@@ -0,0 +1,482 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/status"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/vmspb"
+)
+
+const (
+	// maxFinishedOperations is the maximum number of finished operations
+	// kept by the operation store. The oldest ones are removed first.
+	maxFinishedOperations = 1024
+
+	// operationResponseMargin is the time left before the deadline of an
+	// RPC when the admin server stops waiting for the operation and
+	// responds with its identifier.
+	operationResponseMargin = 500 * time.Millisecond
+)
+
+// operationStore keeps long-running operations issued to the admin server.
+// If its path is not empty, it persists operations to the file whenever they
+// change so that they survive the restart of the admin server.
+type operationStore struct {
+	path   string
+	logger *zap.Logger
+
+	mu      sync.Mutex
+	nextID  uint64
+	ops     map[uint64]*vmspb.Operation
+	cancels map[uint64]context.CancelFunc
+}
+
+// operationStoreFile is the format of the file persisting operations.
+type operationStoreFile struct {
+	NextOperationID uint64            `json:"nextOperationId"`
+	Operations      []vmspb.Operation `json:"operations"`
+}
+
+func newOperationStore(path string, logger *zap.Logger) (*operationStore, error) {
+	s := &operationStore{
+		path:    path,
+		logger:  logger,
+		nextID:  1,
+		ops:     make(map[uint64]*vmspb.Operation),
+		cancels: make(map[uint64]context.CancelFunc),
+	}
+	if len(path) == 0 {
+		return s, nil
+	}
+
+	buf, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("operation store: %w", err)
+	}
+	var f operationStoreFile
+	if err := json.Unmarshal(buf, &f); err != nil {
+		return nil, fmt.Errorf("operation store: %w", err)
+	}
+	for i := range f.Operations {
+		op := f.Operations[i]
+		s.ops[op.OperationID] = &op
+		if op.OperationID >= s.nextID {
+			s.nextID = op.OperationID + 1
+		}
+	}
+	if f.NextOperationID > s.nextID {
+		s.nextID = f.NextOperationID
+	}
+	return s, nil
+}
+
+// create registers a new running operation. The argument cancel is called
+// when the operation is canceled or finished. It returns a copy of the
+// operation.
+func (s *operationStore) create(op *vmspb.Operation, cancel context.CancelFunc) *vmspb.Operation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	op.OperationID = s.nextID
+	op.State = vmspb.OperationStateRunning
+	op.CreateTime = now
+	op.UpdateTime = now
+	s.nextID++
+	s.ops[op.OperationID] = op
+	if cancel != nil {
+		s.cancels[op.OperationID] = cancel
+	}
+	s.persist()
+	return proto.Clone(op).(*vmspb.Operation)
+}
+
+// resume sets the cancel function of the running operation loaded from the
+// file.
+func (s *operationStore) resume(id uint64, cancel context.CancelFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cancels[id] = cancel
+}
+
+func (s *operationStore) get(id uint64) (*vmspb.Operation, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op, ok := s.ops[id]
+	if !ok {
+		return nil, false
+	}
+	return proto.Clone(op).(*vmspb.Operation), true
+}
+
+// list returns all operations ordered by their identifiers.
+func (s *operationStore) list() []vmspb.Operation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ops := make([]vmspb.Operation, 0, len(s.ops))
+	for _, op := range s.ops {
+		ops = append(ops, *proto.Clone(op).(*vmspb.Operation))
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].OperationID < ops[j].OperationID
+	})
+	return ops
+}
+
+// findRunning returns a running operation satisfying the argument match.
+func (s *operationStore) findRunning(match func(op *vmspb.Operation) bool) (*vmspb.Operation, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, op := range s.ops {
+		if op.State == vmspb.OperationStateRunning && match(op) {
+			return proto.Clone(op).(*vmspb.Operation), true
+		}
+	}
+	return nil, false
+}
+
+func (s *operationStore) setProgress(id uint64, progress vmspb.OperationProgress) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op, ok := s.ops[id]
+	if !ok || op.State.Done() || op.Progress.Equal(&progress) {
+		return
+	}
+	op.Progress = progress
+	op.UpdateTime = time.Now().UTC()
+	s.persist()
+}
+
+// finish marks the operation as succeeded if the argument err is nil,
+// otherwise, failed. It does nothing if the operation has already been
+// finished, for instance, canceled.
+func (s *operationStore) finish(id uint64, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op, ok := s.ops[id]
+	if !ok || op.State.Done() {
+		return
+	}
+	op.State = vmspb.OperationStateSucceeded
+	if err != nil {
+		op.State = vmspb.OperationStateFailed
+		op.Error = err.Error()
+	}
+	op.UpdateTime = time.Now().UTC()
+	s.releaseCancel(id)
+	s.gc()
+	s.persist()
+}
+
+// cancel cancels the running operation. It returns a copy of the operation.
+func (s *operationStore) cancel(id uint64) (*vmspb.Operation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op, ok := s.ops[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "cancel operation: no such operation %d", id)
+	}
+	if !op.State.Done() {
+		op.State = vmspb.OperationStateCanceled
+		op.UpdateTime = time.Now().UTC()
+		s.releaseCancel(id)
+		s.gc()
+		s.persist()
+	}
+	return proto.Clone(op).(*vmspb.Operation), nil
+}
+
+func (s *operationStore) releaseCancel(id uint64) {
+	if cancel, ok := s.cancels[id]; ok {
+		cancel()
+		delete(s.cancels, id)
+	}
+}
+
+// gc removes the oldest finished operations if there are more than
+// maxFinishedOperations.
+func (s *operationStore) gc() {
+	finished := make([]uint64, 0, len(s.ops))
+	for id, op := range s.ops {
+		if op.State.Done() {
+			finished = append(finished, id)
+		}
+	}
+	if len(finished) <= maxFinishedOperations {
+		return
+	}
+	sort.Slice(finished, func(i, j int) bool { return finished[i] < finished[j] })
+	for _, id := range finished[:len(finished)-maxFinishedOperations] {
+		delete(s.ops, id)
+	}
+}
+
+// persist writes all operations to the file. It writes a temporary file and
+// renames it to replace the file atomically.
+func (s *operationStore) persist() {
+	if len(s.path) == 0 {
+		return
+	}
+	f := operationStoreFile{
+		NextOperationID: s.nextID,
+		Operations:      make([]vmspb.Operation, 0, len(s.ops)),
+	}
+	for _, op := range s.ops {
+		f.Operations = append(f.Operations, *op)
+	}
+	sort.Slice(f.Operations, func(i, j int) bool {
+		return f.Operations[i].OperationID < f.Operations[j].OperationID
+	})
+
+	err := func() error {
+		buf, err := json.Marshal(f)
+		if err != nil {
+			return err
+		}
+		tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = os.Remove(tmp.Name())
+		}()
+		if _, err := tmp.Write(buf); err != nil {
+			_ = tmp.Close()
+			return err
+		}
+		if err := tmp.Sync(); err != nil {
+			_ = tmp.Close()
+			return err
+		}
+		if err := tmp.Close(); err != nil {
+			return err
+		}
+		return os.Rename(tmp.Name(), s.path)
+	}()
+	if err != nil {
+		s.logger.Warn("operation store: could not persist operations", zap.Error(err))
+	}
+}
+
+// runOperation runs the argument f as a long-running operation. Since f runs
+// in the background, the operation keeps running even if the caller gives up
+// waiting for it, for instance, the RPC from a client times out. Users can
+// check the operation by its identifier.
+// It returns the identifier and the state of the operation, and the result of
+// f. If f does not finish before the argument ctx is about to be done, it
+// returns OperationStateRunning without waiting for f so that the caller can
+// still respond to the client with the identifier.
+func (adm *Admin) runOperation(ctx context.Context, op *vmspb.Operation, f func(ctx context.Context) error) (uint64, vmspb.OperationState, error) {
+	opCtx, _ := adm.leaderContext(context.Background())
+	opCtx, cancel := adm.runner.WithManagedCancel(opCtx)
+	op = adm.ops.create(op, cancel)
+
+	done := make(chan error, 1)
+	if err := adm.runner.RunC(opCtx, func(ctx context.Context) {
+		err := f(ctx)
+		adm.ops.finish(op.OperationID, err)
+		done <- err
+	}); err != nil {
+		adm.ops.finish(op.OperationID, err)
+		return op.OperationID, vmspb.OperationStateFailed, err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		margin := time.Until(deadline) / 2
+		if margin > operationResponseMargin {
+			margin = operationResponseMargin
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, deadline.Add(-margin))
+		defer cancel()
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return op.OperationID, vmspb.OperationStateFailed, err
+		}
+		return op.OperationID, vmspb.OperationStateSucceeded, nil
+	case <-ctx.Done():
+		return op.OperationID, vmspb.OperationStateRunning, nil
+	}
+}
+
+// startSync starts copying log entries from the replica in the storage node
+// srcid to the replica in the storage node dstid, and tracks the sync in the
+// background until it completes. If a sync operation for the same replicas
+// is running, it returns the operation rather than a new one.
+// It returns the current status of the sync, and the identifier and the state
+// of the operation.
+func (adm *Admin) startSync(ctx context.Context, op *vmspb.Operation) (*snpb.SyncStatus, uint64, vmspb.OperationState, error) {
+	running, ok := adm.ops.findRunning(func(running *vmspb.Operation) bool {
+		return running.Kind == vmspb.OperationKindSync &&
+			running.TopicID == op.TopicID &&
+			running.LogStreamID == op.LogStreamID &&
+			running.SrcStorageNodeID == op.SrcStorageNodeID &&
+			running.DstStorageNodeID == op.DstStorageNodeID
+	})
+	if ok {
+		syncStatus, err := adm.sync(ctx, op.TopicID, op.LogStreamID, op.SrcStorageNodeID, op.DstStorageNodeID)
+		return syncStatus, running.OperationID, running.State, err
+	}
+
+	syncStatus, err := adm.sync(ctx, op.TopicID, op.LogStreamID, op.SrcStorageNodeID, op.DstStorageNodeID)
+	if err != nil {
+		return nil, 0, vmspb.OperationStateUnknown, err
+	}
+
+	opCtx, _ := adm.leaderContext(context.Background())
+	opCtx, cancel := adm.runner.WithManagedCancel(opCtx)
+	op = adm.ops.create(op, cancel)
+	adm.ops.setProgress(op.OperationID, syncProgress(syncStatus))
+	if syncStatus.State == snpb.SyncStateComplete {
+		adm.ops.finish(op.OperationID, nil)
+		return syncStatus, op.OperationID, vmspb.OperationStateSucceeded, nil
+	}
+
+	if err := adm.runner.RunC(opCtx, func(ctx context.Context) {
+		adm.trackSync(ctx, op)
+	}); err != nil {
+		// The failure reason is recorded in the operation, and the caller
+		// is also told why the operation could not be tracked.
+		adm.ops.finish(op.OperationID, err)
+		return syncStatus, op.OperationID, vmspb.OperationStateFailed, err
+	}
+	return syncStatus, op.OperationID, vmspb.OperationStateRunning, nil
+}
+
+// trackSync checks the progress of the sync operation periodically until it
+// finishes.
+func (adm *Admin) trackSync(ctx context.Context, op *vmspb.Operation) {
+	ticker := time.NewTicker(adm.syncCheckInterval)
+	defer ticker.Stop()
+
+	// If the context is canceled, either the operation is canceled or the
+	// admin server is closing. In the latter case, the operation is still
+	// running, and it will be resumed after restart.
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		syncStatus, unsealed, err := adm.checkSync(ctx, op)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			adm.ops.finish(op.OperationID, err)
+			return
+		}
+		if unsealed {
+			adm.ops.finish(op.OperationID, nil)
+			return
+		}
+		adm.ops.setProgress(op.OperationID, syncProgress(syncStatus))
+		if syncStatus.State == snpb.SyncStateComplete {
+			adm.ops.finish(op.OperationID, nil)
+			return
+		}
+	}
+}
+
+// checkSync returns the status of the sync operation. Since the log stream
+// can be unsealed only after all replicas are sealed, the sync is regarded
+// as complete if the log stream has already been unsealed. Note that asking
+// the storage node for the status of sync seals the log stream; therefore, it
+// should not ask after the log stream is unsealed.
+func (adm *Admin) checkSync(ctx context.Context, op *vmspb.Operation) (syncStatus *snpb.SyncStatus, unsealed bool, err error) {
+	adm.lockLogStreamStatus(op.LogStreamID)
+	defer adm.unlockLogStreamStatus(op.LogStreamID)
+
+	md, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	lsd := md.GetLogStream(op.LogStreamID)
+	if lsd == nil {
+		return nil, false, fmt.Errorf("no such log stream %d", op.LogStreamID)
+	}
+	if lsd.Status.Running() {
+		return nil, true, nil
+	}
+	syncStatus, err = adm.syncInternal(ctx, op.TopicID, op.LogStreamID, op.SrcStorageNodeID, op.DstStorageNodeID)
+	return syncStatus, false, err
+}
+
+// syncProgress converts the status of sync into the progress of operation.
+func syncProgress(syncStatus *snpb.SyncStatus) vmspb.OperationProgress {
+	first, last, current := syncStatus.First.LLSN, syncStatus.Last.LLSN, syncStatus.Current.LLSN
+	if first.Invalid() || first > last {
+		return vmspb.OperationProgress{}
+	}
+	progress := vmspb.OperationProgress{
+		TotalEntries: uint64(last - first + 1),
+	}
+	switch {
+	case syncStatus.State == snpb.SyncStateComplete:
+		progress.DoneEntries = progress.TotalEntries
+	case current >= first:
+		progress.DoneEntries = uint64(current - first + 1)
+	}
+	return progress
+}
+
+// resumeOperations resumes tracking sync operations that were running before
+// the admin server restarted. Other kinds of running operations are marked as
+// failed since they are stopped by the restart.
+func (adm *Admin) resumeOperations() {
+	for _, op := range adm.ops.list() {
+		if op.State != vmspb.OperationStateRunning {
+			continue
+		}
+		if op.Kind != vmspb.OperationKindSync {
+			adm.ops.finish(op.OperationID, errors.New("interrupted by restart of admin server"))
+			continue
+		}
+		op := op
+		ctx, _ := adm.leaderContext(context.Background())
+		ctx, cancel := adm.runner.WithManagedCancel(ctx)
+		adm.ops.resume(op.OperationID, cancel)
+		if err := adm.runner.RunC(ctx, func(ctx context.Context) {
+			adm.trackSync(ctx, &op)
+		}); err != nil {
+			adm.ops.finish(op.OperationID, err)
+		}
+	}
+}
+
+func (adm *Admin) getOperation(_ context.Context, id uint64) (*vmspb.Operation, error) {
+	op, ok := adm.ops.get(id)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "get operation: no such operation %d", id)
+	}
+	return op, nil
+}
+
+func (adm *Admin) listOperations(context.Context) ([]vmspb.Operation, error) {
+	return adm.ops.list(), nil
+}
+
+func (adm *Admin) cancelOperation(_ context.Context, id uint64) (*vmspb.Operation, error) {
+	return adm.ops.cancel(id)
+}
@@ -30,6 +30,10 @@ type balancedReplicaSelector struct {
 	rng               *rand.Rand
 	cmView            mrmanager.ClusterMetadataView
 	replicationFactor int
+
+	// excluded reports whether the storage node should not be selected, for
+	// instance, it is being drained. It can be nil.
+	excluded func(types.StorageNodeID) bool
 }
 
 var _ ReplicaSelector = (*balancedReplicaSelector)(nil)
@@ -55,6 +59,49 @@ func (sel *balancedReplicaSelector) Select(ctx context.Context) ([]*varlogpb.Rep
 		return nil, errors.WithMessage(err, "replica selector")
 	}
 
+	stats := newStorageNodeStats(md)
+	statsList := make([]storageNodeStat, 0, len(stats))
+	for _, st := range stats {
+		if sel.excluded != nil && sel.excluded(st.storageNodeID) {
+			continue
+		}
+		statsList = append(statsList, st)
+	}
+	if len(statsList) < sel.replicationFactor {
+		return nil, errors.Wrapf(verrors.ErrInvalid, "replica selector: not enough storage nodes: %d", len(statsList))
+	}
+
+	sortStorageNodeStats(statsList)
+
+	statsList = statsList[:sel.replicationFactor]
+	sort.Slice(statsList, func(i, j int) bool {
+		st1, st2 := statsList[i], statsList[j]
+		return st1.primaryReplicas < st2.primaryReplicas
+	})
+
+	rds := make([]*varlogpb.ReplicaDescriptor, 0, sel.replicationFactor)
+	for _, st := range statsList {
+		snd := md.GetStorageNode(st.storageNodeID)
+		rds = append(rds, &varlogpb.ReplicaDescriptor{
+			StorageNodeID:   st.storageNodeID,
+			StorageNodePath: st.selectPath(snd, sel.rng),
+		})
+	}
+
+	return rds, nil
+}
+
+type storageNodeStat struct {
+	storageNodeID   types.StorageNodeID
+	replicas        int
+	primaryReplicas int
+	paths           map[string]struct{}
+	assignedPaths   map[string]struct{}
+}
+
+// newStorageNodeStats returns statistics of all storage nodes in the cluster
+// metadata md.
+func newStorageNodeStats(md *varlogpb.MetadataDescriptor) map[types.StorageNodeID]storageNodeStat {
 	snds := md.GetStorageNodes()
 	stats := make(map[types.StorageNodeID]storageNodeStat, len(snds))
 
@@ -87,12 +134,12 @@ func (sel *balancedReplicaSelector) Select(ctx context.Context) ([]*varlogpb.Rep
 			stats[storageNodeID] = st
 		}
 	}
+	return stats
+}
 
-	statsList := make([]storageNodeStat, 0, len(stats))
-	for _, st := range stats {
-		statsList = append(statsList, st)
-	}
-
+// sortStorageNodeStats sorts the argument statsList in ascending order of
+// utilization, the number of primary replicas, and the number of replicas.
+func sortStorageNodeStats(statsList []storageNodeStat) {
 	sort.Slice(statsList, func(i, j int) bool {
 		st1, st2 := statsList[i], statsList[j]
 		ut1, ut2 := st1.utilization(), st2.utilization()
@@ -106,45 +153,22 @@ func (sel *balancedReplicaSelector) Select(ctx context.Context) ([]*varlogpb.Rep
 		}
 		return st1.replicas < st2.replicas
 	})
-
-	statsList = statsList[:sel.replicationFactor]
-	sort.Slice(statsList, func(i, j int) bool {
-		st1, st2 := statsList[i], statsList[j]
-		return st1.primaryReplicas < st2.primaryReplicas
-	})
-
-	rds := make([]*varlogpb.ReplicaDescriptor, 0, sel.replicationFactor)
-	for _, st := range statsList {
-		snd := md.GetStorageNode(st.storageNodeID)
-		var path string
-		if len(st.paths) == len(st.assignedPaths) {
-			path = snd.Paths[sel.rng.Intn(len(snd.Paths))]
-		} else {
-			for p := range st.paths {
-				if _, ok := st.assignedPaths[path]; ok {
-					continue
-				}
-				path = p
-				break
-			}
-		}
-		rds = append(rds, &varlogpb.ReplicaDescriptor{
-			StorageNodeID:   st.storageNodeID,
-			StorageNodePath: path,
-		})
-	}
-
-	return rds, nil
-}
-
-type storageNodeStat struct {
-	storageNodeID   types.StorageNodeID
-	replicas        int
-	primaryReplicas int
-	paths           map[string]struct{}
-	assignedPaths   map[string]struct{}
 }
 
 func (s storageNodeStat) utilization() float64 {
 	return float64(s.replicas) / float64(len(s.paths))
 }
+
+// selectPath returns a path of the storage node snd that is not assigned to
+// any replica yet. If all paths are assigned, it chooses one randomly.
+func (s storageNodeStat) selectPath(snd *varlogpb.StorageNodeDescriptor, rng *rand.Rand) string {
+	if len(s.paths) == len(s.assignedPaths) {
+		return snd.Paths[rng.Intn(len(snd.Paths))]
+	}
+	for _, path := range snd.Paths {
+		if _, ok := s.assignedPaths[path]; !ok {
+			return path
+		}
+	}
+	return snd.Paths[rng.Intn(len(snd.Paths))]
+}
@@ -5,9 +5,12 @@ import (
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
 	"github.com/kakao/varlog/proto/vmspb"
 )
 
@@ -49,6 +52,40 @@ func (s *server) UnregisterStorageNode(ctx context.Context, req *vmspb.Unregiste
 	return &vmspb.UnregisterStorageNodeResponse{}, nil
 }
 
+func (s *server) DrainStorageNode(ctx context.Context, req *vmspb.DrainStorageNodeRequest) (*vmspb.DrainStorageNodeResponse, error) {
+	snm, err := s.admin.drainStorageNode(ctx, req.StorageNodeID)
+	if err != nil {
+		return nil, err
+	}
+	return &vmspb.DrainStorageNodeResponse{StorageNode: snm}, nil
+}
+
+func (s *server) SetStorageNodeSyncBandwidth(ctx context.Context, req *vmspb.SetStorageNodeSyncBandwidthRequest) (*vmspb.SetStorageNodeSyncBandwidthResponse, error) {
+	prev, err := s.admin.setStorageNodeSyncBandwidth(ctx, req.StorageNodeID, req.BytesPerSecond)
+	if err != nil {
+		return nil, err
+	}
+	return &vmspb.SetStorageNodeSyncBandwidthResponse{
+		StorageNodeID:      req.StorageNodeID,
+		PrevBytesPerSecond: prev,
+		BytesPerSecond:     req.BytesPerSecond,
+	}, nil
+}
+
+func (s *server) SetStorageNodeAppendQuota(ctx context.Context, req *vmspb.SetStorageNodeAppendQuotaRequest) (*vmspb.SetStorageNodeAppendQuotaResponse, error) {
+	prev, err := s.admin.setStorageNodeAppendQuota(ctx, req.StorageNodeID, req.TopicID, req.LogStreamID, req.Quota)
+	if err != nil {
+		return nil, err
+	}
+	return &vmspb.SetStorageNodeAppendQuotaResponse{
+		StorageNodeID: req.StorageNodeID,
+		TopicID:       req.TopicID,
+		LogStreamID:   req.LogStreamID,
+		PrevQuota:     prev,
+		Quota:         req.Quota,
+	}, nil
+}
+
 func (s *server) GetTopic(ctx context.Context, req *vmspb.GetTopicRequest) (*vmspb.GetTopicResponse, error) {
 	td, err := s.admin.getTopic(ctx, req.TopicID)
 	if err != nil {
@@ -66,7 +103,7 @@ func (s *server) ListTopics(ctx context.Context, req *vmspb.ListTopicsRequest) (
 }
 
 func (s *server) AddTopic(ctx context.Context, req *vmspb.AddTopicRequest) (*vmspb.AddTopicResponse, error) {
-	td, err := s.admin.addTopic(ctx)
+	td, err := s.admin.addTopic(ctx, req.Compacted)
 	if err != nil {
 		return nil, err
 	}
@@ -121,26 +158,125 @@ func (s *server) RemoveLogStreamReplica(ctx context.Context, req *vmspb.RemoveLo
 }
 
 func (s *server) UpdateLogStream(ctx context.Context, req *vmspb.UpdateLogStreamRequest) (*vmspb.UpdateLogStreamResponse, error) {
-	lsdesc, err := s.admin.updateLogStream(ctx, req.GetLogStreamID(), req.PoppedReplica, req.PushedReplica)
-	return &vmspb.UpdateLogStreamResponse{LogStream: lsdesc}, verrors.ToStatusError(err)
+	var lsdesc *varlogpb.LogStreamDescriptor
+	opid, state, err := s.admin.runOperation(ctx, &vmspb.Operation{
+		Kind:        vmspb.OperationKindUpdateLogStream,
+		TopicID:     req.GetTopicID(),
+		LogStreamID: req.GetLogStreamID(),
+	}, func(ctx context.Context) (err error) {
+		lsdesc, err = s.admin.updateLogStream(ctx, req.GetLogStreamID(), req.PoppedReplica, req.PushedReplica)
+		return err
+	})
+	if err != nil {
+		return nil, verrors.ToStatusError(err)
+	}
+	if state == vmspb.OperationStateRunning {
+		// The operation still writes its result, which is not ready yet.
+		return &vmspb.UpdateLogStreamResponse{OperationID: opid, OperationState: state}, nil
+	}
+	return &vmspb.UpdateLogStreamResponse{
+		LogStream:      lsdesc,
+		OperationID:    opid,
+		OperationState: state,
+	}, nil
 }
 
 func (s *server) Seal(ctx context.Context, req *vmspb.SealRequest) (*vmspb.SealResponse, error) {
-	lsmetas, sealedGLSN, err := s.admin.seal(ctx, req.GetTopicID(), req.GetLogStreamID())
+	var (
+		lsmetas    []snpb.LogStreamReplicaMetadataDescriptor
+		sealedGLSN types.GLSN
+	)
+	opid, state, err := s.admin.runOperation(ctx, &vmspb.Operation{
+		Kind:        vmspb.OperationKindSeal,
+		TopicID:     req.GetTopicID(),
+		LogStreamID: req.GetLogStreamID(),
+	}, func(ctx context.Context) (err error) {
+		lsmetas, sealedGLSN, err = s.admin.seal(ctx, req.GetTopicID(), req.GetLogStreamID())
+		return err
+	})
+	if err != nil {
+		return nil, verrors.ToStatusError(err)
+	}
+	if state == vmspb.OperationStateRunning {
+		return &vmspb.SealResponse{OperationID: opid, OperationState: state}, nil
+	}
 	return &vmspb.SealResponse{
-		LogStreams: lsmetas,
-		SealedGLSN: sealedGLSN,
-	}, verrors.ToStatusError(err)
+		LogStreams:     lsmetas,
+		SealedGLSN:     sealedGLSN,
+		OperationID:    opid,
+		OperationState: state,
+	}, nil
 }
 
 func (s *server) Sync(ctx context.Context, req *vmspb.SyncRequest) (*vmspb.SyncResponse, error) {
-	status, err := s.admin.sync(ctx, req.GetTopicID(), req.GetLogStreamID(), req.GetSrcStorageNodeID(), req.GetDstStorageNodeID())
-	return &vmspb.SyncResponse{Status: status}, verrors.ToStatusError(err)
+	status, opid, state, err := s.admin.startSync(ctx, &vmspb.Operation{
+		Kind:             vmspb.OperationKindSync,
+		TopicID:          req.GetTopicID(),
+		LogStreamID:      req.GetLogStreamID(),
+		SrcStorageNodeID: req.GetSrcStorageNodeID(),
+		DstStorageNodeID: req.GetDstStorageNodeID(),
+	})
+	return &vmspb.SyncResponse{
+		Status:         status,
+		OperationID:    opid,
+		OperationState: state,
+	}, verrors.ToStatusError(err)
 }
 
 func (s *server) Unseal(ctx context.Context, req *vmspb.UnsealRequest) (*vmspb.UnsealResponse, error) {
-	lsdesc, err := s.admin.unseal(ctx, req.GetTopicID(), req.GetLogStreamID())
-	return &vmspb.UnsealResponse{LogStream: lsdesc}, verrors.ToStatusError(err)
+	var lsdesc *varlogpb.LogStreamDescriptor
+	opid, state, err := s.admin.runOperation(ctx, &vmspb.Operation{
+		Kind:        vmspb.OperationKindUnseal,
+		TopicID:     req.GetTopicID(),
+		LogStreamID: req.GetLogStreamID(),
+	}, func(ctx context.Context) (err error) {
+		lsdesc, err = s.admin.unseal(ctx, req.GetTopicID(), req.GetLogStreamID())
+		return err
+	})
+	if err != nil {
+		return nil, verrors.ToStatusError(err)
+	}
+	if state == vmspb.OperationStateRunning {
+		return &vmspb.UnsealResponse{OperationID: opid, OperationState: state}, nil
+	}
+	return &vmspb.UnsealResponse{
+		LogStream:      lsdesc,
+		OperationID:    opid,
+		OperationState: state,
+	}, nil
+}
+
+func (s *server) GetOperation(ctx context.Context, req *vmspb.GetOperationRequest) (*vmspb.GetOperationResponse, error) {
+	op, err := s.admin.getOperation(ctx, req.OperationID)
+	if err != nil {
+		return nil, err
+	}
+	return &vmspb.GetOperationResponse{Operation: op}, nil
+}
+
+func (s *server) ListOperations(ctx context.Context, _ *vmspb.ListOperationsRequest) (*vmspb.ListOperationsResponse, error) {
+	ops, err := s.admin.listOperations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &vmspb.ListOperationsResponse{Operations: ops}, nil
+}
+
+func (s *server) CancelOperation(ctx context.Context, req *vmspb.CancelOperationRequest) (*vmspb.CancelOperationResponse, error) {
+	op, err := s.admin.cancelOperation(ctx, req.OperationID)
+	if err != nil {
+		return nil, err
+	}
+	return &vmspb.CancelOperationResponse{Operation: op}, nil
+}
+
+func (s *server) WatchEvents(req *vmspb.WatchEventsRequest, stream vmspb.ClusterManager_WatchEventsServer) error {
+	// Sending the header first lets clients know that the admin server
+	// accepted the stream before any event is published.
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	return s.admin.watchEvents(stream.Context(), req.AfterEventID, req.Follow, stream.Send)
 }
 
 func (s *server) GetMetadataRepositoryNode(ctx context.Context, req *vmspb.GetMetadataRepositoryNodeRequest) (*vmspb.GetMetadataRepositoryNodeResponse, error) {
@@ -190,7 +326,20 @@ func (s *server) RemoveMRPeer(ctx context.Context, req *vmspb.RemoveMRPeerReques
 	return &vmspb.RemoveMRPeerResponse{}, verrors.ToStatusError(err)
 }
 
+func (s *server) TransferMetadataRepositoryLeadership(ctx context.Context, req *vmspb.TransferMetadataRepositoryLeadershipRequest) (*vmspb.TransferMetadataRepositoryLeadershipResponse, error) {
+	err := s.admin.transferMetadataRepositoryLeadership(ctx, req.NodeID)
+	return &vmspb.TransferMetadataRepositoryLeadershipResponse{}, verrors.ToStatusError(err)
+}
+
 func (s *server) Trim(ctx context.Context, req *vmspb.TrimRequest) (*vmspb.TrimResponse, error) {
 	res, err := s.admin.trim(ctx, req.TopicID, req.LastGLSN)
 	return &vmspb.TrimResponse{Results: res}, verrors.ToStatusError(err)
 }
+
+func (s *server) CheckpointLogStreamReplica(ctx context.Context, req *vmspb.CheckpointLogStreamReplicaRequest) (*vmspb.CheckpointLogStreamReplicaResponse, error) {
+	cp, err := s.admin.checkpointLogStreamReplica(ctx, req.StorageNodeID, req.TopicID, req.LogStreamID, req.Path, req.Archive)
+	if err != nil {
+		return nil, err
+	}
+	return &vmspb.CheckpointLogStreamReplicaResponse{Checkpoint: cp}, nil
+}
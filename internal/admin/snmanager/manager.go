@@ -44,6 +44,7 @@ type StorageNodeManager interface {
 
 	// AddLogStreamReplica adds a new log stream replica to the storage node whose ID is the argument snid.
 	// The new log stream replica is identified by the argument tpid and lsid. The argument path is storage node path, for example `/data/cid_1_snid_1`.
+	// The replica is compacted if the topic is registered as compacted in the cluster metadata.
 	AddLogStreamReplica(ctx context.Context, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID, path string) (snpb.LogStreamReplicaMetadataDescriptor, error)
 
 	RemoveLogStreamReplica(ctx context.Context, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID) error
@@ -58,6 +59,22 @@ type StorageNodeManager interface {
 
 	Trim(ctx context.Context, topicID types.TopicID, lastGLSN types.GLSN) ([]vmspb.TrimResult, error)
 
+	// SetSyncBandwidth changes the limit of bandwidth for synchronization in
+	// the storage node identified by the argument snid, and returns the
+	// previous one.
+	SetSyncBandwidth(ctx context.Context, snid types.StorageNodeID, bytesPerSecond int64) (int64, error)
+
+	// SetAppendQuota changes the quota of appends to the topic or the log
+	// stream in the storage node identified by the argument snid, and
+	// returns the previous one. If the argument lsid is invalid, it changes
+	// the quota of the topic.
+	SetAppendQuota(ctx context.Context, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID, quota snpb.AppendQuota) (snpb.AppendQuota, error)
+
+	// CheckpointLogStreamReplica makes a consistent point-in-time checkpoint
+	// of the log stream replica in the storage node identified by the
+	// argument snid.
+	CheckpointLogStreamReplica(ctx context.Context, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID, path string, archive bool) (snpb.LogStreamReplicaCheckpoint, error)
+
 	Close() error
 }
 
@@ -184,7 +201,11 @@ func (sm *snManager) addLogStreamReplica(ctx context.Context, snid types.Storage
 		sm.refresh(ctx) //nolint:errcheck,revive // TODO: Handle an error returned.
 		return snpb.LogStreamReplicaMetadataDescriptor{}, errors.Wrap(verrors.ErrNotExist, "storage node")
 	}
-	return mc.AddLogStreamReplica(ctx, tpid, lsid, path)
+	md, err := sm.cmview.ClusterMetadata(ctx)
+	if err != nil {
+		return snpb.LogStreamReplicaMetadataDescriptor{}, err
+	}
+	return mc.AddLogStreamReplica(ctx, tpid, lsid, path, md.GetTopic(tpid).GetCompacted())
 }
 
 func (sm *snManager) AddLogStream(ctx context.Context, lsd *varlogpb.LogStreamDescriptor) (*varlogpb.LogStreamDescriptor, error) {
@@ -400,6 +421,45 @@ func (sm *snManager) Trim(ctx context.Context, tpid types.TopicID, lastGLSN type
 	return results, err
 }
 
+func (sm *snManager) SetSyncBandwidth(ctx context.Context, snid types.StorageNodeID, bytesPerSecond int64) (int64, error) {
+	mc, err := sm.clients.Get(snid)
+	if err != nil {
+		if !errors.Is(err, verrors.ErrClosed) {
+			_ = sm.refresh(ctx)
+			err = admerrors.ErrNoSuchStorageNode
+		}
+		return 0, errors.WithMessagef(err, "snmanager")
+	}
+	prev, err := mc.SetSyncBandwidth(ctx, bytesPerSecond)
+	return prev, errors.WithMessagef(err, "snmanager")
+}
+
+func (sm *snManager) SetAppendQuota(ctx context.Context, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID, quota snpb.AppendQuota) (snpb.AppendQuota, error) {
+	mc, err := sm.clients.Get(snid)
+	if err != nil {
+		if !errors.Is(err, verrors.ErrClosed) {
+			_ = sm.refresh(ctx)
+			err = admerrors.ErrNoSuchStorageNode
+		}
+		return snpb.AppendQuota{}, errors.WithMessagef(err, "snmanager")
+	}
+	prev, err := mc.SetAppendQuota(ctx, tpid, lsid, quota)
+	return prev, errors.WithMessagef(err, "snmanager")
+}
+
+func (sm *snManager) CheckpointLogStreamReplica(ctx context.Context, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID, path string, archive bool) (snpb.LogStreamReplicaCheckpoint, error) {
+	mc, err := sm.clients.Get(snid)
+	if err != nil {
+		if !errors.Is(err, verrors.ErrClosed) {
+			_ = sm.refresh(ctx)
+			err = admerrors.ErrNoSuchStorageNode
+		}
+		return snpb.LogStreamReplicaCheckpoint{}, errors.WithMessagef(err, "snmanager")
+	}
+	cp, err := mc.CheckpointLogStreamReplica(ctx, tpid, lsid, path, archive)
+	return cp, errors.WithMessagef(err, "snmanager")
+}
+
 func (sm *snManager) replicaDescriptors(ctx context.Context, lsid types.LogStreamID) ([]*varlogpb.ReplicaDescriptor, error) {
 	clusmeta, err := sm.cmview.ClusterMetadata(ctx)
 	if err != nil {
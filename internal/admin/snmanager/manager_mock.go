@@ -81,6 +81,21 @@ func (mr *MockStorageNodeManagerMockRecorder) AddStorageNode(arg0, arg1, arg2 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddStorageNode", reflect.TypeOf((*MockStorageNodeManager)(nil).AddStorageNode), arg0, arg1, arg2)
 }
 
+// CheckpointLogStreamReplica mocks base method.
+func (m *MockStorageNodeManager) CheckpointLogStreamReplica(arg0 context.Context, arg1 types.StorageNodeID, arg2 types.TopicID, arg3 types.LogStreamID, arg4 string, arg5 bool) (snpb.LogStreamReplicaCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckpointLogStreamReplica", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(snpb.LogStreamReplicaCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckpointLogStreamReplica indicates an expected call of CheckpointLogStreamReplica.
+func (mr *MockStorageNodeManagerMockRecorder) CheckpointLogStreamReplica(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckpointLogStreamReplica", reflect.TypeOf((*MockStorageNodeManager)(nil).CheckpointLogStreamReplica), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // Close mocks base method.
 func (m *MockStorageNodeManager) Close() error {
 	m.ctrl.T.Helper()
@@ -194,6 +209,36 @@ func (mr *MockStorageNodeManagerMockRecorder) Seal(arg0, arg1, arg2, arg3 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockStorageNodeManager)(nil).Seal), arg0, arg1, arg2, arg3)
 }
 
+// SetAppendQuota mocks base method.
+func (m *MockStorageNodeManager) SetAppendQuota(arg0 context.Context, arg1 types.StorageNodeID, arg2 types.TopicID, arg3 types.LogStreamID, arg4 snpb.AppendQuota) (snpb.AppendQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAppendQuota", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(snpb.AppendQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAppendQuota indicates an expected call of SetAppendQuota.
+func (mr *MockStorageNodeManagerMockRecorder) SetAppendQuota(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAppendQuota", reflect.TypeOf((*MockStorageNodeManager)(nil).SetAppendQuota), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SetSyncBandwidth mocks base method.
+func (m *MockStorageNodeManager) SetSyncBandwidth(arg0 context.Context, arg1 types.StorageNodeID, arg2 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSyncBandwidth", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSyncBandwidth indicates an expected call of SetSyncBandwidth.
+func (mr *MockStorageNodeManagerMockRecorder) SetSyncBandwidth(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSyncBandwidth", reflect.TypeOf((*MockStorageNodeManager)(nil).SetSyncBandwidth), arg0, arg1, arg2)
+}
+
 // Sync mocks base method.
 func (m *MockStorageNodeManager) Sync(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID, arg3, arg4 types.StorageNodeID, arg5 types.GLSN) (*snpb.SyncStatus, error) {
 	m.ctrl.T.Helper()
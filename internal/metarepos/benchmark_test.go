@@ -0,0 +1,152 @@
+package metarepos
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/util/testutil/ports"
+	"github.com/kakao/varlog/proto/mrpb"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+	"github.com/kakao/varlog/vtesting"
+)
+
+func BenchmarkRaftMetadataRepository_ApplyCommit(b *testing.B) {
+	tcs := []struct {
+		numTopics             int
+		numLogStreamsPerTopic int
+	}{
+		{numTopics: 1, numLogStreamsPerTopic: 4096},
+		{numTopics: 16, numLogStreamsPerTopic: 256},
+		{numTopics: 256, numLogStreamsPerTopic: 16},
+		{numTopics: 4096, numLogStreamsPerTopic: 1},
+	}
+
+	concurrencies := []int{1}
+	if procs := runtime.GOMAXPROCS(0); procs > 1 {
+		concurrencies = append(concurrencies, procs)
+	}
+
+	for _, tc := range tcs {
+		for _, concurrency := range concurrencies {
+			name := fmt.Sprintf("topics=%d_lss=%d_concurrency=%d", tc.numTopics, tc.numLogStreamsPerTopic, concurrency)
+			b.Run(name, func(b *testing.B) {
+				mr, cli, closer := newCommitTestMetadataRepository(b, tc.numTopics, tc.numLogStreamsPerTopic, concurrency)
+				defer closer()
+
+				b.ReportAllocs()
+				b.ResetTimer()
+
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					applyDummyReport(b, mr, cli)
+					b.StartTimer()
+
+					_ = mr.applyCommit(nil, uint64(i+1))
+
+					b.StopTimer()
+					deliverDummyCommit(b, mr, cli)
+					b.StartTimer()
+				}
+			})
+		}
+	}
+}
+
+// newCommitTestMetadataRepository creates a metadata repository that is not
+// started, and registers log streams of topics hosted by a dummy storage
+// node. It returns the metadata repository and the client of the dummy
+// storage node.
+func newCommitTestMetadataRepository(tb testing.TB, numTopics, numLogStreamsPerTopic, concurrency int) (*RaftMetadataRepository, *DummyStorageNodeClient, func()) {
+	const snID = types.MinStorageNodeID
+
+	portLease, err := ports.ReserveWeaklyWithRetry(10000)
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	// The dummy storage node hosts all log streams whose identifiers start
+	// from its identifier.
+	numLogStreams := numTopics * numLogStreamsPerTopic
+	sncf := NewDummyStorageNodeClientFactory(numLogStreams, false)
+
+	mr := NewRaftMetadataRepository(
+		WithClusterID(1),
+		WithReplicationFactor(1),
+		WithRaftAddress(fmt.Sprintf("http://127.0.0.1:%d", portLease.Base())),
+		WithRaftDirectory(vtesting.TestRaftDir()),
+		WithReporterClientFactory(sncf),
+		WithCommitConcurrency(concurrency),
+		WithTelemetryCollectorName("nop"),
+		WithLogger(zap.NewNop()),
+	)
+
+	err = mr.storage.registerStorageNode(&varlogpb.StorageNodeDescriptor{
+		StorageNode: varlogpb.StorageNode{StorageNodeID: snID},
+	})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	for i := 0; i < numLogStreams; i++ {
+		tpID := types.TopicID(i / numLogStreamsPerTopic)
+		if i%numLogStreamsPerTopic == 0 {
+			if err := mr.storage.registerTopic(&varlogpb.TopicDescriptor{TopicID: tpID}); err != nil {
+				tb.Fatal(err)
+			}
+		}
+		lsID := types.LogStreamID(snID) + types.LogStreamID(i)
+		if err := mr.storage.registerLogStream(makeLogStream(tpID, lsID, []types.StorageNodeID{snID})); err != nil {
+			tb.Fatal(err)
+		}
+	}
+
+	cli, err := sncf.getStorageNodeClient(context.Background(), snID)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	cli.SetReportDelay(0)
+	cli.SetCommitDelay(0)
+
+	return mr, cli, func() {
+		_ = portLease.Release()
+	}
+}
+
+// applyDummyReport applies a report of the dummy storage node, in which every
+// log stream has a new log entry.
+func applyDummyReport(tb testing.TB, mr *RaftMetadataRepository, cli *DummyStorageNodeClient) {
+	rsp, err := cli.GetReport()
+	if err != nil {
+		tb.Fatal(err)
+	}
+	_ = mr.applyReport(&mrpb.Reports{
+		Reports: []*mrpb.Report{{
+			StorageNodeID:  rsp.StorageNodeID,
+			UncommitReport: rsp.UncommitReports,
+		}},
+	})
+}
+
+// deliverDummyCommit delivers the last commit results to the dummy storage
+// node, and trims the commit history no longer necessary.
+func deliverDummyCommit(tb testing.TB, mr *RaftMetadataRepository, cli *DummyStorageNodeClient) {
+	crs := mr.storage.GetLastCommitResults()
+	results := make([]snpb.LogStreamCommitResult, len(crs.GetCommitResults()))
+	copy(results, crs.GetCommitResults())
+	for i := range results {
+		results[i].Version = crs.GetVersion()
+	}
+	err := cli.CommitBatch(snpb.CommitBatchRequest{
+		StorageNodeID: cli.storageNodeID,
+		CommitResults: results,
+	})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	mr.storage.trimLogStreamCommitHistory()
+}
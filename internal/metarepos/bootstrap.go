@@ -0,0 +1,127 @@
+package metarepos
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gogo/protobuf/proto"
+	"go.etcd.io/etcd/etcdserver/api/snap"
+	"go.etcd.io/etcd/pkg/fileutil"
+	"go.etcd.io/etcd/raft/raftpb"
+	"go.etcd.io/etcd/wal"
+	"go.etcd.io/etcd/wal/walpb"
+	"go.uber.org/zap"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/mrpb"
+)
+
+// bootstrapTerm is the raft term of the snapshot written by
+// BootstrapFromBackup.
+const bootstrapTerm = 1
+
+// BootstrapFromBackup writes the state machine exported by Backup into the
+// raft directory of a new node as a raft snapshot, and creates its WAL. A
+// metadata repository started with the raft directory and the raft address
+// becomes a single-node cluster having the restored state machine. Like
+// Restore, log streams in the restored state machine are sealing.
+//
+// The raft directory must not have the WAL or snapshots of the node.
+func BootstrapFromBackup(raftDir, raftAddress string, bak *mrpb.BackupResponse, logger *zap.Logger) error {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	nodeID := types.NewNodeIDFromURL(raftAddress)
+	if nodeID == types.InvalidNodeID {
+		return fmt.Errorf("bootstrap: invalid raft address %s: %w", raftAddress, verrors.ErrInvalid)
+	}
+
+	waldir := fmt.Sprintf("%s/wal/%d", raftDir, nodeID)
+	snapdir := fmt.Sprintf("%s/snap/%d", raftDir, nodeID)
+	if wal.Exist(waldir) {
+		return fmt.Errorf("bootstrap: wal %s: %w", waldir, verrors.ErrExist)
+	}
+	if fileutil.Exist(snapdir) {
+		if empty, err := isEmptyDir(snapdir); err != nil {
+			return err
+		} else if !empty {
+			return fmt.Errorf("bootstrap: snapshot %s: %w", snapdir, verrors.ErrExist)
+		}
+	}
+
+	stateMachine := proto.Clone(bak.GetStateMachine()).(*mrpb.MetadataRepositoryDescriptor)
+	if err := checkBackup(stateMachine); err != nil {
+		return err
+	}
+
+	// The raft log of the new cluster starts after the snapshot, whose index
+	// is the applied index of the backup.
+	index := bak.AppliedIndex
+	if index == 0 {
+		index = 1
+	}
+
+	// RecoverStateMachine prepares the state machine in the same way as
+	// restoring it into a running metadata repository.
+	ms := NewMetadataStorage(nil, 0, logger)
+	ms.origStateMachine.PeersMap.Peers[nodeID] = &mrpb.MetadataRepositoryDescriptor_PeerDescriptor{
+		URL: raftAddress,
+	}
+	ms.origStateMachine.PeersMap.AppliedIndex = index
+	if err := ms.RecoverStateMachine(stateMachine, index, 0, 0); err != nil {
+		return err
+	}
+
+	data, err := encodeSnapshot(ms.origStateMachine, snapshotEncoding{
+		compress:  DefaultSnapshotCompression,
+		chunkSize: DefaultSnapshotChunkSize,
+	})
+	if err != nil {
+		return err
+	}
+	snapshot := raftpb.Snapshot{
+		Data: data,
+		Metadata: raftpb.SnapshotMetadata{
+			ConfState: raftpb.ConfState{Voters: []uint64{uint64(nodeID)}},
+			Index:     index,
+			Term:      bootstrapTerm,
+		},
+	}
+
+	if err := os.MkdirAll(snapdir, 0750); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(waldir, 0750); err != nil {
+		return err
+	}
+
+	// As raftNode.saveSnap does, the snapshot index is saved to the WAL
+	// before the snapshot.
+	w, err := wal.Create(logger.Named("wal"), waldir, nil)
+	if err != nil {
+		return err
+	}
+	if err := w.SaveSnapshot(walpb.Snapshot{Index: index, Term: bootstrapTerm}); err != nil {
+		_ = w.Close()
+		return err
+	}
+	hs := raftpb.HardState{Term: bootstrapTerm, Commit: index}
+	if err := w.Save(hs, nil); err != nil {
+		_ = w.Close()
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return snap.New(logger.Named("snapshot"), snapdir).SaveSnap(snapshot)
+}
+
+func isEmptyDir(dir string) (bool, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false, err
+	}
+	return len(entries) == 0, nil
+}
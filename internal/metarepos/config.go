@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net"
 	"net/url"
+	"runtime"
 	"strconv"
 	"time"
 
@@ -23,6 +24,8 @@ const (
 	DefaultSnapshotCatchUpCount      uint64 = 10000
 	DefaultSnapshotPurgeCount        uint   = 10
 	DefaultWalPurgeCount             uint   = 10
+	DefaultSnapshotCompression              = true
+	DefaultSnapshotChunkSize                = 1 << 20 // 1MiB
 	DefaultLogReplicationFactor      int    = 1
 	DefaultProposeTimeout                   = 100 * time.Millisecond
 	DefaultRaftTick                         = 100 * time.Millisecond
@@ -41,6 +44,11 @@ const (
 	DefaultMaxLogStreamsCountPerTopic = -1
 
 	UnusedRequestIndex uint64 = 0
+
+	// minLogStreamsForParallelCommit is the minimum number of log streams to
+	// build commit results of topics in parallel. Spawning goroutines costs
+	// more than building commit results of a few log streams.
+	minLogStreamsForParallelCommit = 256
 )
 
 var (
@@ -62,6 +70,7 @@ type raftConfig struct {
 	snapCatchUpCount  uint64
 	maxSnapPurgeCount uint
 	maxWalPurgeCount  uint
+	snapDelta         bool          // send delta snapshots if possible
 	raftTick          time.Duration // raft tick
 	raftDir           string
 	peers             []string // raft bootstrap peer URLs
@@ -77,10 +86,14 @@ type config struct {
 	raftProposeTimeout             time.Duration
 	rpcTimeout                     time.Duration
 	commitTick                     time.Duration
+	commitConcurrency              int
+	snapCompression                bool
+	snapChunkSize                  int
 	promoteTick                    time.Duration
 	reporterClientFac              ReporterClientFactory
 	reportCommitterReadBufferSize  int
 	reportCommitterWriteBufferSize int
+	reportHeartbeatInterval        time.Duration
 	maxTopicsCount                 int32
 	maxLogStreamsCountPerTopic     int32
 	telemetryCollectorName         string
@@ -106,6 +119,9 @@ func newConfig(opts []Option) (config, error) {
 		raftProposeTimeout:             DefaultProposeTimeout,
 		rpcTimeout:                     DefaultRPCTimeout,
 		commitTick:                     DefaultCommitTick,
+		commitConcurrency:              runtime.GOMAXPROCS(0),
+		snapCompression:                DefaultSnapshotCompression,
+		snapChunkSize:                  DefaultSnapshotChunkSize,
 		promoteTick:                    DefaultPromoteTick,
 		reportCommitterReadBufferSize:  DefaultReportCommitterReadBufferSize,
 		reportCommitterWriteBufferSize: DefaultReportCommitterWriteBufferSize,
@@ -134,10 +150,15 @@ func (cfg *config) ensureDefault() {
 	cfg.nodeID = types.NewNodeIDFromURL(cfg.raftAddr)
 
 	if cfg.reporterClientFac == nil {
-		cfg.reporterClientFac = NewReporterClientFactory(
+		grpcDialOptions := []grpc.DialOption{
 			grpc.WithReadBufferSize(cfg.reportCommitterReadBufferSize),
 			grpc.WithWriteBufferSize(cfg.reportCommitterWriteBufferSize),
-		)
+		}
+		if cfg.reportHeartbeatInterval > 0 {
+			cfg.reporterClientFac = NewPushReporterClientFactory(cfg.reportHeartbeatInterval, grpcDialOptions...)
+		} else {
+			cfg.reporterClientFac = NewReporterClientFactory(grpcDialOptions...)
+		}
 	}
 
 	// FIXME(pharrell): Is this good or not? - add the missing local address in peers
@@ -167,6 +188,14 @@ func (cfg *config) ensureDefault() {
 		cfg.commitTick = DefaultCommitTick
 	}
 
+	if cfg.commitConcurrency <= 0 {
+		cfg.commitConcurrency = runtime.GOMAXPROCS(0)
+	}
+
+	if cfg.snapChunkSize <= 0 {
+		cfg.snapChunkSize = DefaultSnapshotChunkSize
+	}
+
 	if cfg.promoteTick == time.Duration(0) {
 		cfg.promoteTick = DefaultPromoteTick
 	}
@@ -213,6 +242,10 @@ func (cfg *config) validate() error {
 		return errors.New("reporterClientFac should not be nil")
 	}
 
+	if cfg.reportHeartbeatInterval < 0 {
+		return errors.New("reportHeartbeatInterval should not be negative")
+	}
+
 	return nil
 }
 
@@ -274,6 +307,15 @@ func JoinCluster() Option {
 	})
 }
 
+// WithCommitConcurrency sets the maximum number of goroutines that build
+// commit results of topics in parallel. It defaults to GOMAXPROCS, and one
+// makes topics be processed sequentially.
+func WithCommitConcurrency(commitConcurrency int) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.commitConcurrency = commitConcurrency
+	})
+}
+
 func WithReplicationFactor(replicationFactor int) Option {
 	return newFuncOption(func(cfg *config) {
 		cfg.replicationFactor = replicationFactor
@@ -304,6 +346,34 @@ func WithMaxWALPurgeCount(maxWalPurgeCount uint) Option {
 	})
 }
 
+// WithSnapshotCompression sets whether snapshots are compressed. Every member
+// of the cluster should be able to read compressed snapshots.
+func WithSnapshotCompression(compression bool) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.snapCompression = compression
+	})
+}
+
+// WithSnapshotChunkSize sets the size of a chunk of the commit history in a
+// snapshot. Each chunk is compressed separately, and a delta snapshot omits
+// chunks that the receiver already has. Chunking does not bound the memory
+// used by snapshots since the whole snapshot is still held in memory.
+func WithSnapshotChunkSize(chunkSize int) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.snapChunkSize = chunkSize
+	})
+}
+
+// WithSnapshotDelta makes the leader send a delta snapshot to a member that
+// has received a snapshot from the leader before. The delta snapshot omits
+// the commit history the member already has. If the member cannot restore
+// the full snapshot, the leader sends the full snapshot next time.
+func WithSnapshotDelta(delta bool) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.snapDelta = delta
+	})
+}
+
 func WithPeers(peers ...string) Option {
 	return newFuncOption(func(cfg *config) {
 		cfg.peers = peers
@@ -340,6 +410,18 @@ func WithReportCommitterWriteBufferSize(writeBufferSize int) Option {
 	})
 }
 
+// WithReportHeartbeatInterval makes storage nodes push reports when they
+// change rather than the metadata repository pulls them continuously. Storage
+// nodes send reports at least every heartbeatInterval even if nothing
+// changes. Reports are pulled if it is zero, which is the default.
+//
+// It is ignored if WithReporterClientFactory is set.
+func WithReportHeartbeatInterval(heartbeatInterval time.Duration) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.reportHeartbeatInterval = heartbeatInterval
+	})
+}
+
 func WithMaxTopicsCount(maxTopicsCount int32) Option {
 	return newFuncOption(func(cfg *config) {
 		cfg.maxTopicsCount = maxTopicsCount
@@ -0,0 +1,152 @@
+package metarepos
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// Paths of the debug server serving the status of the metadata repository
+// in JSON.
+const (
+	DebugRaftStatusPath    = "/debug/raft"
+	DebugReportStatusPath  = "/debug/reports"
+	DebugUncommitsPath     = "/debug/uncommits"
+	DebugCommitHistoryPath = "/debug/commits"
+)
+
+// RaftStatus is the status of the raft node.
+type RaftStatus struct {
+	NodeID         types.NodeID `json:"nodeId"`
+	Term           uint64       `json:"term"`
+	Leader         types.NodeID `json:"leader"`
+	State          string       `json:"state"`
+	AppliedIndex   uint64       `json:"appliedIndex"`
+	CommittedIndex uint64       `json:"committedIndex"`
+}
+
+// StorageNodeReportStatus shows how fresh reports from a storage node are.
+type StorageNodeReportStatus struct {
+	StorageNodeID types.StorageNodeID `json:"storageNodeId"`
+	Address       string              `json:"address"`
+	NumLogStreams int                 `json:"numLogStreams"`
+	// LastReportTime is when the last report was received. It is zero if
+	// no report has been received yet.
+	LastReportTime  time.Time `json:"lastReportTime"`
+	SinceLastReport string    `json:"sinceLastReport,omitempty"`
+	// LastError is the error of the last attempt to get a report. It is
+	// cleared once a report is received.
+	LastError string `json:"lastError,omitempty"`
+}
+
+// LogStreamUncommitStatus is the uncommit reports of a log stream applied to
+// the state machine.
+type LogStreamUncommitStatus struct {
+	LogStreamID types.LogStreamID        `json:"logStreamId"`
+	TopicID     types.TopicID            `json:"topicId"`
+	Status      varlogpb.LogStreamStatus `json:"status"`
+	Replicas    []ReplicaUncommitStatus  `json:"replicas"`
+}
+
+// ReplicaUncommitStatus is the uncommit report of a replica.
+type ReplicaUncommitStatus struct {
+	StorageNodeID         types.StorageNodeID `json:"storageNodeId"`
+	Version               types.Version       `json:"version"`
+	HighWatermark         types.GLSN          `json:"highWatermark"`
+	UncommittedLLSNOffset types.LLSN          `json:"uncommittedLlsnOffset"`
+	UncommittedLLSNLength uint64              `json:"uncommittedLlsnLength"`
+}
+
+// CommitHistoryStatus is the window of the commit history kept by the state
+// machine.
+type CommitHistoryStatus struct {
+	OldestVersion types.Version `json:"oldestVersion"`
+	LatestVersion types.Version `json:"latestVersion"`
+	TrimVersion   types.Version `json:"trimVersion"`
+	NumEntries    int           `json:"numEntries"`
+}
+
+func (mr *RaftMetadataRepository) registerDebugHandlers(httpMux *http.ServeMux) {
+	httpMux.HandleFunc(DebugRaftStatusPath, mr.serveDebug(func(*http.Request) (any, error) {
+		return mr.raftStatus(), nil
+	}))
+	httpMux.HandleFunc(DebugReportStatusPath, mr.serveDebug(func(*http.Request) (any, error) {
+		return mr.reportCollector.Status(), nil
+	}))
+	httpMux.HandleFunc(DebugUncommitsPath, mr.serveDebug(func(req *http.Request) (any, error) {
+		lsid := types.LogStreamID(0)
+		if s := req.URL.Query().Get("lsid"); len(s) > 0 {
+			id, err := strconv.ParseInt(s, 10, 32)
+			if err != nil {
+				return nil, err
+			}
+			lsid = types.LogStreamID(id)
+		}
+		return mr.uncommitStatus(lsid), nil
+	}))
+	httpMux.HandleFunc(DebugCommitHistoryPath, mr.serveDebug(func(*http.Request) (any, error) {
+		return mr.commitHistoryStatus(), nil
+	}))
+}
+
+// serveDebug returns a handler writing the value returned from f in JSON. It
+// responds with http.StatusServiceUnavailable until the metadata repository
+// finishes recovery.
+func (mr *RaftMetadataRepository) serveDebug(f func(*http.Request) (any, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-mr.listenNotifyC:
+		default:
+			http.Error(w, "not ready", http.StatusServiceUnavailable)
+			return
+		}
+
+		v, err := f(req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(v); err != nil {
+			mr.logger.Warn("could not write debug response", zap.String("path", req.URL.Path), zap.Error(err))
+		}
+	}
+}
+
+func (mr *RaftMetadataRepository) raftStatus() RaftStatus {
+	status := mr.raftNode.node.Status()
+	return RaftStatus{
+		NodeID:         mr.nodeID,
+		Term:           status.Term,
+		Leader:         types.NodeID(status.Lead),
+		State:          status.RaftState.String(),
+		AppliedIndex:   status.Applied,
+		CommittedIndex: status.Commit,
+	}
+}
+
+// uncommitStatus returns the uncommit reports of the log stream lsid, or of
+// all log streams if lsid is zero.
+func (mr *RaftMetadataRepository) uncommitStatus(lsid types.LogStreamID) []LogStreamUncommitStatus {
+	statuses := mr.storage.GetUncommitStatus(lsid)
+	for _, status := range statuses {
+		replicas := status.Replicas
+		sort.Slice(replicas, func(i, j int) bool {
+			return replicas[i].StorageNodeID < replicas[j].StorageNodeID
+		})
+	}
+	return statuses
+}
+
+func (mr *RaftMetadataRepository) commitHistoryStatus() CommitHistoryStatus {
+	return mr.storage.GetCommitHistoryStatus()
+}
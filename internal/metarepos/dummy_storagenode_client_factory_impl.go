@@ -45,7 +45,7 @@ func (rc *EmptyStorageNodeClient) GetMetadata(context.Context) (*snpb.StorageNod
 	panic("not implemented")
 }
 
-func (rc *EmptyStorageNodeClient) AddLogStreamReplica(context.Context, types.TopicID, types.LogStreamID, string) (snpb.LogStreamReplicaMetadataDescriptor, error) {
+func (rc *EmptyStorageNodeClient) AddLogStreamReplica(context.Context, types.TopicID, types.LogStreamID, string, bool) (snpb.LogStreamReplicaMetadataDescriptor, error) {
 	panic("not implemented")
 }
 
@@ -69,6 +69,18 @@ func (rc *EmptyStorageNodeClient) Trim(context.Context, types.TopicID, types.GLS
 	panic("not implemented")
 }
 
+func (rc *EmptyStorageNodeClient) SetSyncBandwidth(context.Context, int64) (int64, error) {
+	panic("not implemented")
+}
+
+func (rc *EmptyStorageNodeClient) SetAppendQuota(context.Context, types.TopicID, types.LogStreamID, snpb.AppendQuota) (snpb.AppendQuota, error) {
+	panic("not implemented")
+}
+
+func (rc *EmptyStorageNodeClient) CheckpointLogStreamReplica(context.Context, types.TopicID, types.LogStreamID, string, bool) (snpb.LogStreamReplicaCheckpoint, error) {
+	panic("not implemented")
+}
+
 type EmptyStorageNodeClientFactory struct {
 }
 
@@ -113,9 +125,32 @@ type DummyStorageNodeClient struct {
 	disableReport atomicutil.AtomicBool
 	disableCommit atomicutil.AtomicBool
 
+	// commitHook, if set, is called whenever a commit result is applied.
+	commitHook func(snpb.LogStreamCommitResult)
+
 	ref int
 }
 
+// NewDummyStorageNodeClient returns a standalone DummyStorageNodeClient
+// having replicas of the given log streams. Unlike clients created by
+// DummyStorageNodeClientFactory, log entries are appended to it only by
+// AppendUncommitted, and it does not delay reports and commits.
+func NewDummyStorageNodeClient(snID types.StorageNodeID, lsIDs []types.LogStreamID) *DummyStorageNodeClient {
+	uncommittedLLSNOffset := make([]types.LLSN, len(lsIDs))
+	for i := range uncommittedLLSNOffset {
+		uncommittedLLSNOffset[i] = types.MinLLSN
+	}
+	return &DummyStorageNodeClient{
+		manual:                true,
+		storageNodeID:         snID,
+		logStreamIDs:          lsIDs,
+		knownVersion:          make([]types.Version, len(lsIDs)),
+		uncommittedLLSNOffset: uncommittedLLSNOffset,
+		uncommittedLLSNLength: make([]uint64, len(lsIDs)),
+		status:                DummyStorageNodeClientStatusRunning,
+	}
+}
+
 func (r *DummyStorageNodeClient) incrRef() {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -247,6 +282,40 @@ func (r *DummyStorageNodeClient) SetCommitDelay(d time.Duration) {
 	r.commitDelay.Store(d)
 }
 
+// SetCommitHook sets the function called whenever a commit result is
+// applied. It should be set before the client is used.
+func (r *DummyStorageNodeClient) SetCommitHook(hook func(snpb.LogStreamCommitResult)) {
+	r.commitHook = hook
+}
+
+// AppendUncommitted appends n log entries to the replica of the log stream.
+// They are reported as uncommitted until the metadata repository commits
+// them.
+func (r *DummyStorageNodeClient) AppendUncommitted(lsID types.LogStreamID, n uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if idx := r.logStreamIndex(lsID); idx >= 0 {
+		r.uncommittedLLSNLength[idx] += n
+	}
+}
+
+// logStreamIndex returns the index of the log stream in logStreamIDs, or -1
+// if the storage node does not have it.
+func (r *DummyStorageNodeClient) logStreamIndex(lsID types.LogStreamID) int {
+	// Clients created by the factory have consecutive log streams starting
+	// from the storage node ID.
+	if idx := int(lsID - types.LogStreamID(r.storageNodeID)); idx >= 0 && idx < len(r.logStreamIDs) && r.logStreamIDs[idx] == lsID {
+		return idx
+	}
+	for idx, id := range r.logStreamIDs {
+		if id == lsID {
+			return idx
+		}
+	}
+	return -1
+}
+
 func (r *DummyStorageNodeClient) GetReport() (*snpb.GetReportResponse, error) {
 	if r.disableReport.Load() {
 		return &snpb.GetReportResponse{
@@ -295,36 +364,48 @@ func (r *DummyStorageNodeClient) Commit(cr snpb.CommitRequest) error {
 
 	time.Sleep(r.commitDelay.Load())
 
+	applied, err := r.commit(cr.CommitResult)
+	if applied && r.commitHook != nil {
+		r.commitHook(cr.CommitResult)
+	}
+	return err
+}
+
+func (r *DummyStorageNodeClient) commit(cr snpb.LogStreamCommitResult) (applied bool, err error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	if r.status == DummyStorageNodeClientStatusCrash {
-		return errors.New("crash")
+		return false, errors.New("crash")
 	} else if r.status == DummyStorageNodeClientStatusClosed {
-		return errors.New("closed")
+		return false, errors.New("closed")
 	}
 
-	idx := int(cr.CommitResult.LogStreamID - types.LogStreamID(r.storageNodeID))
-	if idx < 0 || idx >= len(r.logStreamIDs) {
-		return errors.New("invalid log stream ID")
+	idx := r.logStreamIndex(cr.LogStreamID)
+	if idx < 0 {
+		return false, errors.New("invalid log stream ID")
 	}
 
-	if r.uncommittedLLSNOffset[idx] != cr.CommitResult.CommittedLLSNOffset {
+	if r.uncommittedLLSNOffset[idx] != cr.CommittedLLSNOffset {
 		// continue
-		return nil
+		return false, nil
 	}
 
-	if r.knownVersion[idx] >= cr.CommitResult.Version {
+	if r.knownVersion[idx] >= cr.Version {
 		//continue
-		return nil
+		return false, nil
 	}
 
-	r.knownVersion[idx] = cr.CommitResult.Version
+	if r.uncommittedLLSNLength[idx] < cr.CommittedGLSNLength {
+		return false, errors.New("commit more than uncommitted")
+	}
 
-	r.uncommittedLLSNOffset[idx] += types.LLSN(cr.CommitResult.CommittedGLSNLength)
-	r.uncommittedLLSNLength[idx] -= cr.CommitResult.CommittedGLSNLength
+	r.knownVersion[idx] = cr.Version
 
-	return nil
+	r.uncommittedLLSNOffset[idx] += types.LLSN(cr.CommittedGLSNLength)
+	r.uncommittedLLSNLength[idx] -= cr.CommittedGLSNLength
+
+	return true, nil
 }
 
 func (r *DummyStorageNodeClient) CommitBatch(cbr snpb.CommitBatchRequest) error {
@@ -345,7 +426,9 @@ func (r *DummyStorageNodeClient) Close() error {
 
 	if r.status != DummyStorageNodeClientStatusCrash &&
 		r.ref == 0 {
-		r.factory.m.Delete(r.storageNodeID)
+		if r.factory != nil {
+			r.factory.m.Delete(r.storageNodeID)
+		}
 		r.status = DummyStorageNodeClientStatusClosed
 	}
 
@@ -490,7 +573,7 @@ func (r *DummyStorageNodeClient) GetMetadata(context.Context) (*snpb.StorageNode
 	return meta, nil
 }
 
-func (r *DummyStorageNodeClient) AddLogStreamReplica(context.Context, types.TopicID, types.LogStreamID, string) (snpb.LogStreamReplicaMetadataDescriptor, error) {
+func (r *DummyStorageNodeClient) AddLogStreamReplica(context.Context, types.TopicID, types.LogStreamID, string, bool) (snpb.LogStreamReplicaMetadataDescriptor, error) {
 	panic("not implemented")
 }
 
@@ -513,3 +596,15 @@ func (r *DummyStorageNodeClient) Sync(context.Context, types.TopicID, types.LogS
 func (r *DummyStorageNodeClient) Trim(context.Context, types.TopicID, types.GLSN) (map[types.LogStreamID]error, error) {
 	panic("not implemented")
 }
+
+func (r *DummyStorageNodeClient) SetSyncBandwidth(context.Context, int64) (int64, error) {
+	panic("not implemented")
+}
+
+func (r *DummyStorageNodeClient) SetAppendQuota(context.Context, types.TopicID, types.LogStreamID, snpb.AppendQuota) (snpb.AppendQuota, error) {
+	panic("not implemented")
+}
+
+func (r *DummyStorageNodeClient) CheckpointLogStreamReplica(context.Context, types.TopicID, types.LogStreamID, string, bool) (snpb.LogStreamReplicaCheckpoint, error) {
+	panic("not implemented")
+}
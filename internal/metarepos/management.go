@@ -11,4 +11,7 @@ type Management interface {
 	AddPeer(context.Context, types.ClusterID, types.NodeID, string) error
 	RemovePeer(context.Context, types.ClusterID, types.NodeID) error
 	GetClusterInfo(context.Context, types.ClusterID) (*mrpb.ClusterInfo, error)
+	TransferLeadership(context.Context, types.ClusterID, types.NodeID) error
+	Backup(context.Context, types.ClusterID) (*mrpb.BackupResponse, error)
+	Restore(context.Context, types.ClusterID, *mrpb.MetadataRepositoryDescriptor) error
 }
@@ -41,3 +41,17 @@ func (s *ManagementService) GetClusterInfo(ctx context.Context, req *mrpb.GetClu
 		ClusterInfo: cinfo,
 	}, err
 }
+
+func (s *ManagementService) TransferLeadership(ctx context.Context, req *mrpb.TransferLeadershipRequest) (*types.Empty, error) {
+	err := s.m.TransferLeadership(ctx, req.ClusterID, req.NodeID)
+	return &types.Empty{}, err
+}
+
+func (s *ManagementService) Backup(ctx context.Context, req *mrpb.BackupRequest) (*mrpb.BackupResponse, error) {
+	return s.m.Backup(ctx, req.ClusterID)
+}
+
+func (s *ManagementService) Restore(ctx context.Context, req *mrpb.RestoreRequest) (*types.Empty, error) {
+	err := s.m.Restore(ctx, req.ClusterID, req.StateMachine)
+	return &types.Empty{}, err
+}
@@ -2,21 +2,27 @@ package metarepos
 
 import (
 	"context"
+	"time"
 
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/mrpb"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 type MetadataRepository interface {
 	RegisterStorageNode(context.Context, *varlogpb.StorageNodeDescriptor) error
 	UnregisterStorageNode(context.Context, types.StorageNodeID) error
-	RegisterTopic(context.Context, types.TopicID) error
+	RegisterTopic(context.Context, *varlogpb.TopicDescriptor) error
 	UnregisterTopic(context.Context, types.TopicID) error
 	RegisterLogStream(context.Context, *varlogpb.LogStreamDescriptor) error
 	UnregisterLogStream(context.Context, types.LogStreamID) error
 	UpdateLogStream(context.Context, *varlogpb.LogStreamDescriptor) error
 	GetMetadata(context.Context) (*varlogpb.MetadataDescriptor, error)
+	WaitForMetadata(ctx context.Context, linearizable bool, appliedIndex uint64) error
 	Seal(context.Context, types.LogStreamID) (types.GLSN, error)
 	Unseal(context.Context, types.LogStreamID) error
+	AcquireAdminLease(ctx context.Context, holder string, duration time.Duration) (*mrpb.AdminLease, error)
+	WatchMetadata(ctx context.Context, appliedIndex uint64, send func(*varlogpb.MetadataDescriptor) error) error
+	LookupGLSN(ctx context.Context, topicID types.TopicID, glsn types.GLSN) (types.LogStreamID, types.LLSN, error)
 	Close() error
 }
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/kakao/varlog/proto/mrpb"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 type MetadataRepositoryService struct {
@@ -36,7 +37,10 @@ func (s *MetadataRepositoryService) UnregisterStorageNode(ctx context.Context, r
 }
 
 func (s *MetadataRepositoryService) RegisterTopic(ctx context.Context, req *mrpb.TopicRequest) (*types.Empty, error) {
-	err := s.metaRepos.RegisterTopic(ctx, req.TopicID)
+	err := s.metaRepos.RegisterTopic(ctx, &varlogpb.TopicDescriptor{
+		TopicID:   req.TopicID,
+		Compacted: req.Compacted,
+	})
 	return &types.Empty{}, err
 }
 
@@ -61,6 +65,12 @@ func (s *MetadataRepositoryService) UpdateLogStream(ctx context.Context, req *mr
 }
 
 func (s *MetadataRepositoryService) GetMetadata(ctx context.Context, req *mrpb.GetMetadataRequest) (*mrpb.GetMetadataResponse, error) {
+	if req.Linearizable || req.AppliedIndex > 0 {
+		if err := s.metaRepos.WaitForMetadata(ctx, req.Linearizable, req.AppliedIndex); err != nil {
+			return &mrpb.GetMetadataResponse{}, err
+		}
+	}
+
 	metadata, err := s.metaRepos.GetMetadata(ctx)
 	return &mrpb.GetMetadataResponse{
 		Metadata: metadata,
@@ -76,3 +86,29 @@ func (s *MetadataRepositoryService) Unseal(ctx context.Context, req *mrpb.Unseal
 	err := s.metaRepos.Unseal(ctx, req.GetLogStreamID())
 	return &mrpb.UnsealResponse{}, err
 }
+
+func (s *MetadataRepositoryService) AcquireAdminLease(ctx context.Context, req *mrpb.AcquireAdminLeaseRequest) (*mrpb.AcquireAdminLeaseResponse, error) {
+	lease, err := s.metaRepos.AcquireAdminLease(ctx, req.Holder, req.Duration)
+	if err != nil {
+		return nil, err
+	}
+	return &mrpb.AcquireAdminLeaseResponse{Lease: *lease}, nil
+}
+
+func (s *MetadataRepositoryService) WatchMetadata(req *mrpb.WatchMetadataRequest, stream mrpb.MetadataRepositoryService_WatchMetadataServer) error {
+	return s.metaRepos.WatchMetadata(stream.Context(), req.AppliedIndex, func(metadata *varlogpb.MetadataDescriptor) error {
+		return stream.Send(&mrpb.WatchMetadataResponse{Metadata: metadata})
+	})
+}
+
+func (s *MetadataRepositoryService) LookupGLSN(ctx context.Context, req *mrpb.LookupGLSNRequest) (*mrpb.LookupGLSNResponse, error) {
+	lsid, llsn, err := s.metaRepos.LookupGLSN(ctx, req.TopicID, req.GLSN)
+	if err != nil {
+		return nil, err
+	}
+	return &mrpb.LookupGLSNResponse{
+		TopicID:     req.TopicID,
+		LogStreamID: lsid,
+		LLSN:        llsn,
+	}, nil
+}
@@ -3,6 +3,7 @@ package metarepos
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -27,7 +28,6 @@ import (
 	vtypes "github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/netutil"
 	"github.com/kakao/varlog/pkg/util/runner"
-	"github.com/kakao/varlog/proto/mrpb"
 )
 
 // TODO: comments (old: A key-value stream backed by raft)
@@ -48,6 +48,16 @@ type raftNode struct {
 
 	snapshotIndex uint64
 	appliedIndex  uint64
+	// publishedIndex is the index of the last entry published to commitC.
+	publishedIndex uint64
+
+	// readIndexWaiters are channels to deliver the results of ReadIndex
+	// requests, and they are keyed by the request identifiers.
+	readIndexMu      sync.Mutex
+	readIndexSeq     uint64
+	readIndexWaiters map[uint64]chan uint64
+	// pendingReadStates are read states whose entries are not published yet.
+	pendingReadStates []raft.ReadState
 
 	// raft backing for the commit/error channel
 	node        raft.Node
@@ -56,6 +66,10 @@ type raftNode struct {
 
 	snapshotGetter SnapshotGetter
 	snapshotter    *snap.Snapshotter
+	// snapshotBases are the last commit versions of snapshots that peers
+	// have received successfully, and they are keyed by the peer IDs. They
+	// are the bases of delta snapshots.
+	snapshotBases sync.Map
 
 	transport *rafthttp.Transport
 
@@ -120,6 +134,8 @@ func newRaftNode(cfg raftConfig,
 		runner:         runner.New("raft-node", logger),
 		httprunner:     runner.New("http", logger),
 		tmStub:         tmStub,
+
+		readIndexWaiters: make(map[uint64]chan uint64),
 	}
 
 	return rc
@@ -241,6 +257,7 @@ func (rc *raftNode) publishEntries(ctx context.Context, ents []raftpb.Entry) boo
 			case <-ctx.Done():
 				return false
 			}
+			rc.publishedIndex = ents[i].Index
 		}
 
 		if shutdown {
@@ -514,12 +531,20 @@ func (rc *raftNode) transferLeadership(wait bool) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 50*rc.raftTick)
 	defer cancel()
 
-	rc.node.TransferLeadership(ctx, rc.membership.getLeader(), uint64(transferee))
+	return rc.transferLeadershipTo(ctx, uint64(transferee), wait)
+}
+
+// transferLeadershipTo transfers the leadership to the argument transferee.
+// It should be called by the leader since a follower cannot ask the leader to
+// transfer the leadership to another follower. If the argument wait is true,
+// it waits until the transferee becomes the leader.
+func (rc *raftNode) transferLeadershipTo(ctx context.Context, transferee uint64, wait bool) error {
+	rc.node.TransferLeadership(ctx, rc.membership.getLeader(), transferee)
 
 	timer := time.NewTimer(rc.raftTick)
 	defer timer.Stop()
 
-	for wait && rc.membership.getLeader() != uint64(transferee) {
+	for wait && rc.membership.getLeader() != transferee {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
@@ -531,6 +556,90 @@ func (rc *raftNode) transferLeadership(wait bool) error {
 	return nil
 }
 
+// readIndex confirms the commit index of the cluster by using RAFT ReadIndex.
+// It returns the index of the last entry published to commitC once all
+// entries up to the commit index are published. Hence, the state machine
+// reflects all entries committed before the call once it applies the returned
+// index.
+func (rc *raftNode) readIndex(ctx context.Context) (uint64, error) {
+	rc.readIndexMu.Lock()
+	rc.readIndexSeq++
+	id := rc.readIndexSeq
+	c := make(chan uint64, 1)
+	rc.readIndexWaiters[id] = c
+	rc.readIndexMu.Unlock()
+
+	defer func() {
+		rc.readIndexMu.Lock()
+		delete(rc.readIndexWaiters, id)
+		rc.readIndexMu.Unlock()
+	}()
+
+	rctx := make([]byte, 8)
+	binary.BigEndian.PutUint64(rctx, id)
+
+	timer := time.NewTimer(rc.raftTick)
+	defer timer.Stop()
+
+	for {
+		// The request can be dropped silently, for instance, if there is
+		// no leader. Hence, it is retried until the ctx is done.
+		if err := rc.node.ReadIndex(ctx, rctx); err != nil {
+			return 0, err
+		}
+
+		select {
+		case index := <-c:
+			return index, nil
+		case <-timer.C:
+			timer.Reset(rc.raftTick)
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+}
+
+// resolveReadStates notifies the waiters of ReadIndex requests whose entries
+// are published. Read states that are not resolved yet are kept until later
+// entries are published.
+func (rc *raftNode) resolveReadStates(readStates []raft.ReadState) {
+	rc.pendingReadStates = append(rc.pendingReadStates, readStates...)
+	if len(rc.pendingReadStates) == 0 {
+		return
+	}
+
+	rc.readIndexMu.Lock()
+	defer rc.readIndexMu.Unlock()
+
+	pending := rc.pendingReadStates[:0]
+	for _, rs := range rc.pendingReadStates {
+		if rs.Index > rc.appliedIndex {
+			pending = append(pending, rs)
+			continue
+		}
+		if len(rs.RequestCtx) != 8 {
+			continue
+		}
+		c, ok := rc.readIndexWaiters[binary.BigEndian.Uint64(rs.RequestCtx)]
+		if !ok {
+			continue
+		}
+		select {
+		case c <- rc.publishedIndex:
+		default:
+		}
+	}
+	rc.pendingReadStates = pending
+}
+
+// isActive returns true if this node is connected to the argument nodeID.
+func (rc *raftNode) isActive(nodeID vtypes.NodeID) bool {
+	if nodeID == rc.nodeID {
+		return true
+	}
+	return !rc.transport.ActiveSince(types.ID(nodeID)).IsZero()
+}
+
 func (rc *raftNode) stop(transfer bool) {
 	if transfer {
 		// for leader election test without transferring leader
@@ -582,21 +691,7 @@ func (rc *raftNode) processMessages(ms []raftpb.Message) []raftpb.Message {
 		if ms[i].Type == raftpb.MsgSnap {
 			snapshot, _ := rc.raftStorage.Snapshot()
 			if !raft.IsEmptySnap(snapshot) {
-				ms[i].Snapshot = snapshot
-				sm := snap.NewMessage(ms[i], snapReaderCloser{bytes.NewReader(nil)}, 0)
-
-				rc.logger.Info("send snapshot",
-					zap.Uint64("to", sm.To),
-					zap.Uint64("term", snapshot.Metadata.Term),
-					zap.Uint64("index", snapshot.Metadata.Index),
-					zap.Int("size", snapshot.Size()),
-				)
-
-				//TODO:: concurrency limit
-				rc.runner.Run(func(context.Context) { //nolint:errcheck,revive // TODO:: Handle an error returned.
-					rc.transport.SendSnapshot(*sm)
-				})
-
+				rc.sendSnapshot(ms[i], snapshot)
 				ms[i].To = 0
 			}
 		}
@@ -605,6 +700,54 @@ func (rc *raftNode) processMessages(ms []raftpb.Message) []raftpb.Message {
 	return ms
 }
 
+// sendSnapshot sends the snapshot to the peer. The snapshot data is streamed
+// as the body of the snapshot message rather than being embedded in the raft
+// message, and the receiver restores it in Process. If delta snapshots are
+// enabled and the peer has received a snapshot before, it sends a delta
+// snapshot.
+func (rc *raftNode) sendSnapshot(m raftpb.Message, snapshot raftpb.Snapshot) {
+	data := snapshot.Data
+	delta := false
+	if base, ok := rc.snapshotBases.Load(m.To); ok && rc.snapDelta {
+		if d, err := makeDeltaSnapshot(data, base.(vtypes.Version)); err == nil {
+			data, delta = d, true
+		}
+	}
+	lastVersion, err := snapshotLastVersion(snapshot.Data)
+	if err != nil {
+		rc.logger.Warn("invalid snapshot", zap.Error(err))
+	}
+
+	m.Snapshot = snapshot
+	m.Snapshot.Data = nil
+	sm := snap.NewMessage(m, snapReaderCloser{bytes.NewReader(data)}, int64(len(data)))
+
+	rc.logger.Info("send snapshot",
+		zap.Uint64("to", sm.To),
+		zap.Uint64("term", snapshot.Metadata.Term),
+		zap.Uint64("index", snapshot.Metadata.Index),
+		zap.Int("size", len(snapshot.Data)),
+		zap.Int("sent", len(data)),
+		zap.Bool("delta", delta),
+	)
+
+	//TODO:: concurrency limit
+	rc.runner.Run(func(ctx context.Context) { //nolint:errcheck,revive // TODO:: Handle an error returned.
+		rc.transport.SendSnapshot(*sm)
+		select {
+		case ok := <-sm.CloseNotify():
+			if ok && err == nil {
+				rc.snapshotBases.Store(sm.To, lastVersion)
+			} else {
+				// The peer might not have the base, thus, the next
+				// snapshot should be full.
+				rc.snapshotBases.Delete(sm.To)
+			}
+		case <-ctx.Done():
+		}
+	})
+}
+
 func (rc *raftNode) publishSnapshot(snapshotToSave raftpb.Snapshot) {
 	if raft.IsEmptySnap(snapshotToSave) {
 		return
@@ -627,6 +770,7 @@ func (rc *raftNode) publishSnapshot(snapshotToSave raftpb.Snapshot) {
 
 	atomic.StoreUint64(&rc.snapshotIndex, snapshotToSave.Metadata.Index)
 	rc.appliedIndex = snapshotToSave.Metadata.Index
+	rc.publishedIndex = snapshotToSave.Metadata.Index
 	rc.lastIndex = snapshotToSave.Metadata.Index
 
 	rc.logger.Info("finish snapshot",
@@ -763,6 +907,7 @@ func (rc *raftNode) processRaftEvent(ctx context.Context) {
 			rc.transport.Send(rc.processMessages(rd.Messages))
 			if ok := rc.publishEntries(ctx, rc.entriesToApply(rd.CommittedEntries)); ok {
 				rc.maybeTriggerSnapshot()
+				rc.resolveReadStates(rd.ReadStates)
 				rc.node.Advance()
 			}
 		case err := <-rc.transport.ErrorC:
@@ -841,9 +986,46 @@ func (rc *raftNode) runRaft(ctx context.Context) {
 }
 
 func (rc *raftNode) Process(ctx context.Context, m raftpb.Message) error {
+	if m.Type == raftpb.MsgSnap && len(m.Snapshot.Data) == 0 {
+		data, err := rc.receiveSnapshot(m.Snapshot.Metadata.Index)
+		if err != nil {
+			return err
+		}
+		m.Snapshot.Data = data
+	}
 	return rc.node.Step(ctx, m)
 }
 
+// receiveSnapshot reads the snapshot data streamed by the sender and saved
+// by the transport. If it is a delta snapshot, it is resolved into a full
+// snapshot by using the local commit history. The whole snapshot is read into
+// memory since raft steps the snapshot message with its data.
+func (rc *raftNode) receiveSnapshot(index uint64) ([]byte, error) {
+	path, err := rc.snapshotter.DBFilePath(index)
+	if err != nil {
+		return nil, err
+	}
+	// The transport does not overwrite the file, thus, it should be removed
+	// so that the snapshot of the same index can be received again.
+	defer func() {
+		_ = os.Remove(path)
+	}()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty snapshot at index %d", index)
+	}
+	data, err = rc.snapshotGetter.ResolveSnapshot(data)
+	if err != nil {
+		rc.logger.Warn("could not resolve snapshot", zap.Uint64("index", index), zap.Error(err))
+		return nil, err
+	}
+	return data, nil
+}
+
 func (rc *raftNode) IsIDRemoved(id uint64) bool {
 	return false
 }
@@ -872,8 +1054,7 @@ func (rc *raftNode) recoverMembership(snapshot raftpb.Snapshot) {
 		return
 	}
 
-	stateMachine := &mrpb.MetadataRepositoryDescriptor{}
-	err := stateMachine.Unmarshal(snapshot.Data)
+	stateMachine, err := decodeSnapshotState(snapshot.Data)
 	if err != nil {
 		rc.logger.Panic("invalid snapshot",
 			zap.String("err", err.Error()),
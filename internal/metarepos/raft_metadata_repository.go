@@ -22,6 +22,8 @@ import (
 	"google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/kakao/varlog/internal/reportcommitter"
+	"github.com/kakao/varlog/pkg/mrc"
+	"github.com/kakao/varlog/pkg/rpc"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/container/set"
 	"github.com/kakao/varlog/pkg/util/netutil"
@@ -58,6 +60,10 @@ type RaftMetadataRepository struct {
 	requestNum uint64
 	requestMap sync.Map
 
+	// applyMu is held while applying a committed entry. Holding it prevents
+	// the state machine from being changed.
+	applyMu sync.Mutex
+
 	// for raft
 	proposeC      chan *mrpb.RaftEntry
 	commitC       chan *committedEntry
@@ -89,6 +95,13 @@ type RaftMetadataRepository struct {
 	// commit helper
 	topicEndPos map[types.TopicID]int
 
+	// adminLeaseIndex is the applied index of the admin lease that this node
+	// has observed most recently, and adminLeaseRenewed is the time when it
+	// observed the lease on the local clock.
+	adminLeaseMu      sync.Mutex
+	adminLeaseIndex   uint64
+	adminLeaseRenewed time.Time
+
 	tmStub *telemetryStub
 }
 
@@ -120,6 +133,10 @@ func NewRaftMetadataRepository(opts ...Option) *RaftMetadataRepository {
 	mr.storage = NewMetadataStorage(mr.sendAck, cfg.snapCount, mr.logger.Named("storage"))
 	mr.storage.limits.maxTopicsCount = mr.maxTopicsCount
 	mr.storage.limits.maxLogStreamsCountPerTopic = mr.maxLogStreamsCountPerTopic
+	mr.storage.snapshotEncoding = snapshotEncoding{
+		compress:  mr.snapCompression,
+		chunkSize: mr.snapChunkSize,
+	}
 
 	mr.membership = mr.storage
 
@@ -237,6 +254,8 @@ func (mr *RaftMetadataRepository) runDebugServer(ctx context.Context) {
 	httpMux.Handle("/debug/pprof/threadcreate", pprof.Handler("threadcreate"))
 	httpMux.Handle("/debug/pprof/block", pprof.Handler("block"))
 
+	mr.registerDebugHandlers(httpMux)
+
 	lis, err := netutil.NewStoppableListener(ctx, mr.debugAddr)
 	if err != nil {
 		mr.logger.Panic("could not listen", zap.Error(err))
@@ -366,6 +385,7 @@ func (mr *RaftMetadataRepository) processCommit(context.Context) {
 	listenNoti := false
 
 	for c := range mr.commitC {
+		mr.applyMu.Lock()
 		if c == nil {
 			snap := mr.raftNode.loadSnapshot()
 			if snap != nil {
@@ -396,6 +416,7 @@ func (mr *RaftMetadataRepository) processCommit(context.Context) {
 		}
 
 		mr.apply(c)
+		mr.applyMu.Unlock()
 	}
 }
 
@@ -557,6 +578,12 @@ func (mr *RaftMetadataRepository) apply(c *committedEntry) {
 		e := c.entry
 		f := e.Request.GetValue()
 
+		if mr.fenced(e) {
+			mr.sendAck(e.NodeIndex, e.RequestIndex, verrors.ErrStaleAdminLease)
+			mr.storage.UpdateAppliedIndex(e.AppliedIndex)
+			return nil, nil
+		}
+
 		//nolint:errcheck,revive // TODO:: Handle an error returned.
 		switch r := f.(type) {
 		case *mrpb.RegisterStorageNode:
@@ -587,6 +614,10 @@ func (mr *RaftMetadataRepository) apply(c *committedEntry) {
 			mr.applyRemovePeer(r, c.confState, e.AppliedIndex)
 		case *mrpb.Endpoint:
 			mr.applyEndpoint(r, e.NodeIndex, e.RequestIndex)
+		case *mrpb.AcquireAdminLease:
+			mr.applyAcquireAdminLease(r, e.NodeIndex, e.RequestIndex, e.AppliedIndex)
+		case *mrpb.RecoverStateMachine:
+			mr.applyRecoverStateMachine(r, e.NodeIndex, e.RequestIndex, e.AppliedIndex)
 		}
 
 		mr.storage.UpdateAppliedIndex(e.AppliedIndex)
@@ -595,6 +626,26 @@ func (mr *RaftMetadataRepository) apply(c *committedEntry) {
 	})
 }
 
+// applyRecoverStateMachine replaces the state machine with the restored one.
+// It is ignored unless the state machine is empty since restoring could
+// discard the state of the running cluster.
+func (mr *RaftMetadataRepository) applyRecoverStateMachine(r *mrpb.RecoverStateMachine, nodeIndex, requestIndex, appliedIndex uint64) error {
+	if !mr.storage.IsEmpty() {
+		mr.sendAck(nodeIndex, requestIndex, verrors.ErrNotEmpty)
+		return verrors.ErrNotEmpty
+	}
+
+	mr.reportCollector.Reset()
+	if err := mr.storage.RecoverStateMachine(r.StateMachine, appliedIndex, nodeIndex, requestIndex); err != nil {
+		return err
+	}
+	return mr.reportCollector.Recover(
+		mr.storage.GetStorageNodes(),
+		mr.storage.GetLogStreams(),
+		mr.storage.GetFirstCommitResults().GetVersion(),
+	)
+}
+
 func (mr *RaftMetadataRepository) applyRegisterStorageNode(r *mrpb.RegisterStorageNode, nodeIndex, requestIndex uint64) error {
 	err := mr.storage.RegisterStorageNode(r.StorageNode, nodeIndex, requestIndex)
 	if err != nil {
@@ -619,7 +670,8 @@ func (mr *RaftMetadataRepository) applyUnregisterStorageNode(r *mrpb.UnregisterS
 
 func (mr *RaftMetadataRepository) applyRegisterTopic(r *mrpb.RegisterTopic, nodeIndex, requestIndex uint64) error {
 	topicDesc := &varlogpb.TopicDescriptor{
-		TopicID: r.TopicID,
+		TopicID:   r.TopicID,
+		Compacted: r.Compacted,
 	}
 	err := mr.storage.RegisterTopic(topicDesc, nodeIndex, requestIndex)
 	if err != nil {
@@ -807,6 +859,182 @@ func topicBoundary(topicLSIDs []TopicLSID, idx int) (begin bool, end bool) {
 	return
 }
 
+// topicRange is the range of log streams of a topic in the sorted list of
+// log streams, that is, [begin, end).
+type topicRange struct {
+	begin int
+	end   int
+	// hintPos is the position of the last commit result of the topic in the
+	// previous commit results. It is a hint to find the high watermark.
+	hintPos int
+}
+
+// topicCommitResults is the result of building commit results of a topic.
+type topicCommitResults struct {
+	totalCommitted   uint64
+	trimVer          types.Version
+	highWatermarkPos int
+	// sealed is the list of log streams that have to be sealed since they
+	// are sealing and all their logs are committed.
+	sealed []types.LogStreamID
+}
+
+// splitTopics splits the sorted list of log streams by topics.
+func (mr *RaftMetadataRepository) splitTopics(topicLSIDs []TopicLSID) []topicRange {
+	var topics []topicRange
+	for idx := range topicLSIDs {
+		beginTopic, endTopic := topicBoundary(topicLSIDs, idx)
+		if beginTopic {
+			topics = append(topics, topicRange{
+				begin:   idx,
+				hintPos: mr.topicEndPos[topicLSIDs[idx].TopicID],
+			})
+		}
+		if endTopic {
+			topics[len(topics)-1].end = idx + 1
+		}
+	}
+	return topics
+}
+
+// forEachTopic calls the argument f for each topic. If there are many log
+// streams, topics are processed concurrently by up to commitConcurrency
+// goroutines. The argument f must not change the state machine.
+func (mr *RaftMetadataRepository) forEachTopic(numTopics, numLogStreams int, f func(int)) {
+	concurrency := mr.commitConcurrency
+	if concurrency > numTopics {
+		concurrency = numTopics
+	}
+	if concurrency <= 1 || numLogStreams < minLogStreamsForParallelCommit {
+		for i := 0; i < numTopics; i++ {
+			f(i)
+		}
+		return
+	}
+
+	var wg sync.WaitGroup
+	next := int64(-1)
+	wg.Add(concurrency)
+	for w := 0; w < concurrency; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= numTopics {
+					return
+				}
+				f(i)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// buildTopicCommitResults builds commit results of log streams in the
+// argument topic into the argument commitResults at the same positions as
+// the log streams in the argument topicLSIDs. It only reads the state
+// machine, thus it can be called for several topics concurrently.
+func (mr *RaftMetadataRepository) buildTopicCommitResults(topicLSIDs []TopicLSID, topic topicRange, prevCommitResults *mrpb.LogStreamCommitResults, curVer types.Version, commitResults []snpb.LogStreamCommitResult) topicCommitResults {
+	res := topicCommitResults{
+		trimVer: types.MaxVersion,
+	}
+
+	var commitResultsMap map[types.Version]*mrpb.LogStreamCommitResults
+
+	committedOffset, hpos := prevCommitResults.LastHighWatermark(topicLSIDs[topic.begin].TopicID, topic.hintPos)
+	committedOffset += types.GLSN(1)
+	res.highWatermarkPos = hpos
+
+	for idx := topic.begin; idx < topic.end; idx++ {
+		topicLSID := topicLSIDs[idx]
+
+		reports := mr.storage.LookupUncommitReports(topicLSID.LogStreamID)
+		knownVer, minVer, knownHWM, nrUncommit := mr.calculateCommit(reports)
+		if reports.Status.Sealed() {
+			nrUncommit = 0
+		}
+
+		if reports.Status == varlogpb.LogStreamStatusSealed {
+			minVer = curVer
+		}
+
+		if reports.Status == varlogpb.LogStreamStatusSealing &&
+			mr.getLastCommitted(topicLSID.TopicID, topicLSID.LogStreamID, idx) <= knownHWM {
+			res.sealed = append(res.sealed, topicLSID.LogStreamID)
+		}
+
+		if minVer < res.trimVer {
+			res.trimVer = minVer
+		}
+
+		if nrUncommit > 0 {
+			if knownVer != curVer {
+				baseCommitResults, ok := commitResultsMap[knownVer]
+				if !ok {
+					baseCommitResults = mr.storage.lookupNextCommitResultsNoLock(knownVer)
+					if baseCommitResults == nil {
+						mr.logger.Panic("commit history should be exist",
+							zap.Any("ver", knownVer),
+							zap.Any("first", mr.storage.getFirstCommitResultsNoLock().GetVersion()),
+							zap.Any("last", mr.storage.getLastCommitResultsNoLock().GetVersion()),
+						)
+					}
+
+					if commitResultsMap == nil {
+						commitResultsMap = make(map[types.Version]*mrpb.LogStreamCommitResults)
+					}
+					commitResultsMap[knownVer] = baseCommitResults
+				}
+
+				nrCommitted := mr.numCommitSince(topicLSID.TopicID, topicLSID.LogStreamID, baseCommitResults, prevCommitResults, idx)
+				if nrCommitted > nrUncommit {
+					msg := fmt.Sprintf("# of uncommit should be bigger than # of commit:: lsID[%v] cur[%v] first[%v] last[%v] reports[%+v] nrCommitted[%v] nrUncommit[%v]",
+						topicLSID.LogStreamID, curVer,
+						mr.storage.getFirstCommitResultsNoLock().GetVersion(),
+						mr.storage.getLastCommitResultsNoLock().GetVersion(),
+						reports,
+						nrCommitted, nrUncommit,
+					)
+					mr.logger.Panic(msg)
+				}
+
+				nrUncommit -= nrCommitted
+			}
+		}
+
+		committedLLSNOffset := types.MinLLSN
+		prevCommitResult, _, ok := prevCommitResults.LookupCommitResult(topicLSID.TopicID, topicLSID.LogStreamID, idx)
+		if ok {
+			committedLLSNOffset = prevCommitResult.CommittedLLSNOffset + types.LLSN(prevCommitResult.CommittedGLSNLength)
+		}
+
+		commit := snpb.LogStreamCommitResult{
+			TopicID:             topicLSID.TopicID,
+			LogStreamID:         topicLSID.LogStreamID,
+			CommittedLLSNOffset: committedLLSNOffset,
+			CommittedGLSNOffset: committedOffset,
+			CommittedGLSNLength: nrUncommit,
+		}
+
+		if nrUncommit > 0 {
+			committedOffset += types.GLSN(commit.CommittedGLSNLength)
+		} else {
+			commit.CommittedGLSNOffset = mr.getLastCommitted(topicLSID.TopicID, topicLSID.LogStreamID, idx) + types.GLSN(1)
+			commit.CommittedGLSNLength = 0
+		}
+
+		// set highWatermark of topic
+		if idx == topic.end-1 {
+			commit.HighWatermark = committedOffset - types.MinGLSN
+		}
+
+		commitResults[idx] = commit
+		res.totalCommitted += nrUncommit
+	}
+
+	return res
+}
+
 func (mr *RaftMetadataRepository) applyCommit(r *mrpb.Commit, appliedIndex uint64) error {
 	if r != nil {
 		mr.tmStub.mb.Records("mr.raft.commit.delay").Record(context.TODO(),
@@ -849,106 +1077,31 @@ func (mr *RaftMetadataRepository) applyCommit(r *mrpb.Commit, appliedIndex uint6
 			st := time.Now()
 
 			topicLSIDs := mr.storage.GetSortedTopicLogStreamIDs()
-			crs.CommitResults = make([]snpb.LogStreamCommitResult, 0, len(topicLSIDs))
-
-			commitResultsMap := make(map[types.Version]*mrpb.LogStreamCommitResults)
-
-			committedOffset := types.InvalidGLSN
-
-			//TODO:: apply topic
-			for idx, topicLSID := range topicLSIDs {
-				beginTopic, endTopic := topicBoundary(topicLSIDs, idx)
-
-				if beginTopic {
-					hpos := mr.topicEndPos[topicLSID.TopicID]
-
-					committedOffset, hpos = prevCommitResults.LastHighWatermark(topicLSID.TopicID, hpos)
-					committedOffset += types.GLSN(1)
-
-					mr.topicEndPos[topicLSID.TopicID] = hpos
-				}
-
-				reports := mr.storage.LookupUncommitReports(topicLSID.LogStreamID)
-				knownVer, minVer, knownHWM, nrUncommit := mr.calculateCommit(reports)
-				if reports.Status.Sealed() {
-					nrUncommit = 0
-				}
+			crs.CommitResults = make([]snpb.LogStreamCommitResult, len(topicLSIDs))
+
+			// Topics are independent of each other, thus their commit results
+			// are built in parallel. They are merged in order of topics
+			// afterward to keep the state machine deterministic.
+			topics := mr.splitTopics(topicLSIDs)
+			results := make([]topicCommitResults, len(topics))
+			mr.forEachTopic(len(topics), len(topicLSIDs), func(i int) {
+				results[i] = mr.buildTopicCommitResults(topicLSIDs, topics[i], prevCommitResults, curVer, crs.CommitResults)
+			})
 
-				if reports.Status == varlogpb.LogStreamStatusSealed {
-					minVer = curVer
-				}
+			for i, res := range results {
+				mr.topicEndPos[topicLSIDs[topics[i].begin].TopicID] = res.highWatermarkPos
 
-				if reports.Status == varlogpb.LogStreamStatusSealing &&
-					mr.getLastCommitted(topicLSID.TopicID, topicLSID.LogStreamID, idx) <= knownHWM {
-					if err := mr.storage.SealLogStream(topicLSID.LogStreamID, 0, 0); err == nil {
-						mr.reportCollector.Seal(topicLSID.LogStreamID)
+				for _, lsID := range res.sealed {
+					if err := mr.storage.SealLogStream(lsID, 0, 0); err == nil {
+						mr.reportCollector.Seal(lsID)
 					}
 				}
 
-				if minVer < trimVer {
-					trimVer = minVer
-				}
-
-				if nrUncommit > 0 {
-					if knownVer != curVer {
-						baseCommitResults, ok := commitResultsMap[knownVer]
-						if !ok {
-							baseCommitResults = mr.storage.lookupNextCommitResultsNoLock(knownVer)
-							if baseCommitResults == nil {
-								mr.logger.Panic("commit history should be exist",
-									zap.Any("ver", knownVer),
-									zap.Any("first", mr.storage.getFirstCommitResultsNoLock().GetVersion()),
-									zap.Any("last", mr.storage.getLastCommitResultsNoLock().GetVersion()),
-								)
-							}
-
-							commitResultsMap[knownVer] = baseCommitResults
-						}
-
-						nrCommitted := mr.numCommitSince(topicLSID.TopicID, topicLSID.LogStreamID, baseCommitResults, prevCommitResults, idx)
-						if nrCommitted > nrUncommit {
-							msg := fmt.Sprintf("# of uncommit should be bigger than # of commit:: lsID[%v] cur[%v] first[%v] last[%v] reports[%+v] nrCommitted[%v] nrUncommit[%v]",
-								topicLSID.LogStreamID, curVer,
-								mr.storage.getFirstCommitResultsNoLock().GetVersion(),
-								mr.storage.getLastCommitResultsNoLock().GetVersion(),
-								reports,
-								nrCommitted, nrUncommit,
-							)
-							mr.logger.Panic(msg)
-						}
-
-						nrUncommit -= nrCommitted
-					}
+				if res.trimVer < trimVer {
+					trimVer = res.trimVer
 				}
 
-				committedLLSNOffset := types.MinLLSN
-				prevCommitResult, _, ok := prevCommitResults.LookupCommitResult(topicLSID.TopicID, topicLSID.LogStreamID, idx)
-				if ok {
-					committedLLSNOffset = prevCommitResult.CommittedLLSNOffset + types.LLSN(prevCommitResult.CommittedGLSNLength)
-				}
-
-				commit := snpb.LogStreamCommitResult{
-					TopicID:             topicLSID.TopicID,
-					LogStreamID:         topicLSID.LogStreamID,
-					CommittedLLSNOffset: committedLLSNOffset,
-					CommittedGLSNOffset: committedOffset,
-					CommittedGLSNLength: nrUncommit,
-				}
-
-				if nrUncommit > 0 {
-					committedOffset += types.GLSN(commit.CommittedGLSNLength)
-				} else {
-					commit.CommittedGLSNOffset = mr.getLastCommitted(topicLSID.TopicID, topicLSID.LogStreamID, idx) + types.GLSN(1)
-					commit.CommittedGLSNLength = 0
-				}
-
-				// set highWatermark of topic
-				if endTopic {
-					commit.HighWatermark = committedOffset - types.MinGLSN
-				}
-
-				crs.CommitResults = append(crs.CommitResults, commit)
-				totalCommitted += nrUncommit
+				totalCommitted += res.totalCommitted
 			}
 
 			mr.tmStub.mb.Records("mr.build_commit_results.pure.duration").Record(context.TODO(),
@@ -1034,6 +1187,40 @@ func (mr *RaftMetadataRepository) applyEndpoint(r *mrpb.Endpoint, nodeIndex, req
 	return nil
 }
 
+// fenced returns true if the entry e is requested by an admin server whose
+// lease epoch is older than that of the current lease. Since the lease is a
+// part of the state machine, all nodes reject the same entries.
+func (mr *RaftMetadataRepository) fenced(e *mrpb.RaftEntry) bool {
+	if e.AdminLeaseEpoch == 0 {
+		return false
+	}
+	lease := mr.storage.GetAdminLease()
+	return lease != nil && e.AdminLeaseEpoch < lease.Epoch
+}
+
+func (mr *RaftMetadataRepository) applyAcquireAdminLease(r *mrpb.AcquireAdminLease, nodeIndex, requestIndex, appliedIndex uint64) error {
+	if err := mr.storage.AcquireAdminLease(r, appliedIndex, nodeIndex, requestIndex); err != nil {
+		return err
+	}
+	mr.observeAdminLease(mr.storage.GetAdminLease())
+	return nil
+}
+
+// observeAdminLease returns the time when this node observed the grant or
+// renewal of the admin lease for the first time. It is measured by the local
+// clock so that the expiry of the lease does not depend on clocks of other
+// servers. A node that has not applied the lease, for instance, restored from
+// a snapshot, observes it now, which only delays the expiry.
+func (mr *RaftMetadataRepository) observeAdminLease(lease *mrpb.AdminLease) time.Time {
+	mr.adminLeaseMu.Lock()
+	defer mr.adminLeaseMu.Unlock()
+	if mr.adminLeaseRenewed.IsZero() || mr.adminLeaseIndex != lease.AppliedIndex {
+		mr.adminLeaseIndex = lease.AppliedIndex
+		mr.adminLeaseRenewed = time.Now()
+	}
+	return mr.adminLeaseRenewed
+}
+
 func (mr *RaftMetadataRepository) numCommitSince(topicID types.TopicID, lsID types.LogStreamID, base, latest *mrpb.LogStreamCommitResults, hintPos int) uint64 {
 	if latest == nil {
 		return 0
@@ -1168,6 +1355,7 @@ func (mr *RaftMetadataRepository) propose(ctx context.Context, r interface{}, gu
 	e.Request.SetValue(r)
 	e.NodeIndex = uint64(mr.nodeID)
 	e.RequestIndex = UnusedRequestIndex
+	e.AdminLeaseEpoch = rpc.AdminLeaseEpochFromIncomingContext(ctx)
 
 	if guarantee {
 		c := make(chan error, 1)
@@ -1243,9 +1431,10 @@ func (mr *RaftMetadataRepository) UnregisterStorageNode(ctx context.Context, snI
 	return nil
 }
 
-func (mr *RaftMetadataRepository) RegisterTopic(ctx context.Context, topicID types.TopicID) error {
+func (mr *RaftMetadataRepository) RegisterTopic(ctx context.Context, td *varlogpb.TopicDescriptor) error {
 	r := &mrpb.RegisterTopic{
-		TopicID: topicID,
+		TopicID:   td.TopicID,
+		Compacted: td.Compacted,
 	}
 
 	return mr.propose(ctx, r, true)
@@ -1311,6 +1500,60 @@ func (mr *RaftMetadataRepository) GetMetadata(context.Context) (*varlogpb.Metada
 	return m, nil
 }
 
+// WaitForMetadata waits until the metadata of this node is up to date enough
+// for read-after-write. If the argument linearizable is true, it waits until
+// the metadata reflects all entries committed before the call by using RAFT
+// ReadIndex. It also waits until the applied index of the metadata becomes
+// greater than or equal to the argument appliedIndex.
+func (mr *RaftMetadataRepository) WaitForMetadata(ctx context.Context, linearizable bool, appliedIndex uint64) error {
+	if !mr.IsMember() {
+		return verrors.ErrNotMember
+	}
+
+	if linearizable {
+		index, err := mr.raftNode.readIndex(ctx)
+		if err != nil {
+			return err
+		}
+		if err := mr.waitForAppliedIndex(ctx, index); err != nil {
+			return err
+		}
+		if metaAppliedIndex := mr.storage.GetMetaAppliedIndex(); metaAppliedIndex > appliedIndex {
+			appliedIndex = metaAppliedIndex
+		}
+	}
+
+	for {
+		m, changed := mr.storage.WatchMetadata()
+		if m.GetAppliedIndex() >= appliedIndex {
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// waitForAppliedIndex waits until the state machine applies the entry of the
+// argument index.
+func (mr *RaftMetadataRepository) waitForAppliedIndex(ctx context.Context, index uint64) error {
+	for {
+		appliedIndex, changed := mr.storage.WatchAppliedIndex()
+		if appliedIndex >= index {
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 func (mr *RaftMetadataRepository) Seal(ctx context.Context, lsID types.LogStreamID) (types.GLSN, error) {
 	r := &mrpb.Seal{
 		LogStreamID: lsID,
@@ -1347,6 +1590,64 @@ func (mr *RaftMetadataRepository) Unseal(ctx context.Context, lsID types.LogStre
 	return nil
 }
 
+func (mr *RaftMetadataRepository) AcquireAdminLease(ctx context.Context, holder string, duration time.Duration) (*mrpb.AdminLease, error) {
+	r := &mrpb.AcquireAdminLease{
+		Holder:   holder,
+		Duration: duration,
+	}
+	if lease := mr.storage.GetAdminLease(); lease != nil && lease.Holder != holder &&
+		time.Since(mr.observeAdminLease(lease)) >= lease.Duration {
+		r.ExpiredIndex = lease.AppliedIndex
+	}
+
+	if err := mr.propose(ctx, r, true); err != nil {
+		return nil, err
+	}
+
+	lease := mr.storage.GetAdminLease()
+	if lease == nil {
+		mr.logger.Panic("can't find admin lease")
+	}
+	return lease, nil
+}
+
+// LookupGLSN returns the log stream to which the log entry of the given GLSN
+// was committed, and its LLSN.
+func (mr *RaftMetadataRepository) LookupGLSN(_ context.Context, topicID types.TopicID, glsn types.GLSN) (types.LogStreamID, types.LLSN, error) {
+	if !mr.IsMember() {
+		return types.LogStreamID(0), types.InvalidLLSN, verrors.ErrNotMember
+	}
+	return mr.storage.LookupGLSN(topicID, glsn)
+}
+
+// WatchMetadata calls the argument send with the metadata whenever its
+// applied index becomes greater than the argument appliedIndex, which is
+// updated after each call. Since it sends the latest snapshot of the
+// metadata, changes made in a short period can be coalesced into one. It
+// returns when the argument ctx is done, send returns an error, or the node
+// is no longer a member of the cluster.
+func (mr *RaftMetadataRepository) WatchMetadata(ctx context.Context, appliedIndex uint64, send func(*varlogpb.MetadataDescriptor) error) error {
+	for {
+		if !mr.IsMember() {
+			return verrors.ErrNotMember
+		}
+
+		m, changed := mr.storage.WatchMetadata()
+		if m.GetAppliedIndex() > appliedIndex {
+			if err := send(m); err != nil {
+				return err
+			}
+			appliedIndex = m.GetAppliedIndex()
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 func (mr *RaftMetadataRepository) AddPeer(ctx context.Context, _ types.ClusterID, nodeID types.NodeID, url string) error {
 	if mr.membership.IsMember(nodeID) ||
 		mr.membership.IsLearner(nodeID) {
@@ -1435,6 +1736,10 @@ func (mr *RaftMetadataRepository) GetClusterInfo(context.Context, types.ClusterI
 		AppliedIndex:      peerMap.AppliedIndex,
 	}
 
+	// Only the leader tracks the progress of replication.
+	status := mr.raftNode.node.Status()
+	leaderMatch := status.Progress[uint64(mr.nodeID)].Match
+
 	if len(peerMap.Peers) > 0 {
 		clusterInfo.Members = make(map[types.NodeID]*mrpb.ClusterInfo_Member)
 
@@ -1443,6 +1748,14 @@ func (mr *RaftMetadataRepository) GetClusterInfo(context.Context, types.ClusterI
 				Peer:     peer.URL,
 				Endpoint: mr.storage.LookupEndpoint(nodeID),
 				Learner:  peer.IsLearner,
+				Active:   mr.raftNode.isActive(nodeID),
+			}
+			if pr, ok := status.Progress[uint64(nodeID)]; ok {
+				member.MatchIndex = pr.Match
+				if leaderMatch > pr.Match {
+					member.Lag = leaderMatch - pr.Match
+				}
+				member.ProgressState = pr.State.String()
 			}
 
 			clusterInfo.Members[nodeID] = member
@@ -1452,6 +1765,110 @@ func (mr *RaftMetadataRepository) GetClusterInfo(context.Context, types.ClusterI
 	return clusterInfo, nil
 }
 
+// TransferLeadership transfers the leadership to the argument nodeID, and
+// waits until it becomes the leader. If this node is not the leader, it
+// forwards the request to the leader.
+func (mr *RaftMetadataRepository) TransferLeadership(ctx context.Context, clusterID types.ClusterID, nodeID types.NodeID) error {
+	if !mr.IsMember() {
+		return verrors.ErrNotMember
+	}
+	if mr.membership.IsLearner(nodeID) {
+		return fmt.Errorf("transfer leadership: learner %v: %w", nodeID, verrors.ErrInvalid)
+	}
+	if !mr.membership.IsMember(nodeID) {
+		return verrors.ErrNotExist
+	}
+
+	leader := mr.membership.Leader()
+	if leader == nodeID {
+		return nil
+	}
+	if leader == mr.nodeID {
+		return mr.raftNode.transferLeadershipTo(ctx, uint64(nodeID), true)
+	}
+
+	endpoint := mr.storage.LookupEndpoint(leader)
+	if endpoint == "" {
+		return fmt.Errorf("transfer leadership: no endpoint of leader %v: %w", leader, verrors.ErrUnavailable)
+	}
+	cli, err := mrc.NewMetadataRepositoryManagementClient(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cli.Close()
+	}()
+	if err := cli.TransferLeadership(ctx, clusterID, nodeID); err != nil {
+		return err
+	}
+
+	// Wait until this node learns the new leader so that the caller reads
+	// the new leadership from this node.
+	timer := time.NewTimer(mr.raftTick)
+	defer timer.Stop()
+	for mr.membership.Leader() != nodeID {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			timer.Reset(mr.raftTick)
+		}
+	}
+	return nil
+}
+
+// Backup exports the state machine. It blocks applying committed entries
+// only while copying the state machine, thus, the exported state machine is
+// consistent.
+func (mr *RaftMetadataRepository) Backup(_ context.Context, _ types.ClusterID) (*mrpb.BackupResponse, error) {
+	if !mr.IsMember() {
+		return nil, verrors.ErrNotMember
+	}
+
+	mr.applyMu.Lock()
+	stateMachine, appliedIndex := mr.storage.Backup()
+	mr.applyMu.Unlock()
+
+	return &mrpb.BackupResponse{
+		ClusterID:    mr.clusterID,
+		AppliedIndex: appliedIndex,
+		StateMachine: stateMachine,
+	}, nil
+}
+
+// Restore replaces the state machine with the exported one by proposing it.
+// It fails with verrors.ErrNotEmpty unless the metadata repository is empty.
+func (mr *RaftMetadataRepository) Restore(ctx context.Context, _ types.ClusterID, stateMachine *mrpb.MetadataRepositoryDescriptor) error {
+	if !mr.IsMember() {
+		return verrors.ErrNotMember
+	}
+
+	if err := checkBackup(stateMachine); err != nil {
+		return err
+	}
+
+	r := &mrpb.RecoverStateMachine{
+		StateMachine: stateMachine,
+	}
+	return mr.propose(ctx, r, true)
+}
+
+// checkBackup checks whether the exported state machine can be restored.
+func checkBackup(stateMachine *mrpb.MetadataRepositoryDescriptor) error {
+	if stateMachine.GetMetadata() == nil || stateMachine.GetLogStream() == nil {
+		return fmt.Errorf("restore: no metadata: %w", verrors.ErrInvalid)
+	}
+	for _, ls := range stateMachine.Metadata.LogStreams {
+		if _, ok := stateMachine.LogStream.UncommitReports[ls.LogStreamID]; !ok {
+			return fmt.Errorf("restore: no reports of log stream %d: %w", ls.LogStreamID, verrors.ErrInvalid)
+		}
+	}
+	if stateMachine.LogStream.UncommitReports == nil {
+		stateMachine.LogStream.UncommitReports = make(map[types.LogStreamID]*mrpb.LogStreamUncommitReports)
+	}
+	return nil
+}
+
 func (mr *RaftMetadataRepository) GetServerAddr() string {
 	endpoint := mr.endpointAddr.Load()
 	if endpoint == nil {
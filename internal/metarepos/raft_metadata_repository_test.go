@@ -2,8 +2,11 @@ package metarepos
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"sync"
 	"sync/atomic"
@@ -18,6 +21,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/kakao/varlog/pkg/rpc"
@@ -198,8 +202,7 @@ func (clus *metadataRepoCluster) getMembersFromSnapshot(idx int) map[types.NodeI
 		return nil
 	}
 
-	stateMachine := &mrpb.MetadataRepositoryDescriptor{}
-	err := stateMachine.Unmarshal(snapshot.Data)
+	stateMachine, err := decodeSnapshotState(snapshot.Data)
 	if err != nil {
 		return nil
 	}
@@ -220,8 +223,7 @@ func (clus *metadataRepoCluster) getMetadataFromSnapshot(idx int) *varlogpb.Meta
 		return nil
 	}
 
-	stateMachine := &mrpb.MetadataRepositoryDescriptor{}
-	err := stateMachine.Unmarshal(snapshot.Data)
+	stateMachine, err := decodeSnapshotState(snapshot.Data)
 	if err != nil {
 		return nil
 	}
@@ -308,7 +310,7 @@ func (clus *metadataRepoCluster) closeNoErrors(t *testing.T) {
 
 func (clus *metadataRepoCluster) initDummyStorageNode(nrSN, nrTopic int) error {
 	for i := 0; i < nrTopic; i++ {
-		if err := clus.nodes[0].RegisterTopic(context.TODO(), types.TopicID(i%nrTopic)); err != nil {
+		if err := clus.nodes[0].RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: types.TopicID(i % nrTopic)}); err != nil {
 			return err
 		}
 	}
@@ -838,7 +840,7 @@ func TestMRSimpleReportNCommit(t *testing.T) {
 			return clus.reporterClientFac.(*DummyStorageNodeClientFactory).lookupClient(snID) != nil
 		}), ShouldBeTrue)
 
-		err = clus.nodes[0].RegisterTopic(context.TODO(), types.TopicID(1))
+		err = clus.nodes[0].RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: types.TopicID(1)})
 		So(err, ShouldBeNil)
 
 		ls := makeLogStream(types.TopicID(1), lsID, snIDs)
@@ -1403,7 +1405,7 @@ func TestMRUpdateLogStream(t *testing.T) {
 			So(err, ShouldBeNil)
 		}
 
-		err := mr.RegisterTopic(context.TODO(), types.TopicID(1))
+		err := mr.RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: types.TopicID(1)})
 		So(err, ShouldBeNil)
 
 		ls := makeLogStream(types.TopicID(1), lsID, snIDs[0:rep])
@@ -1480,7 +1482,7 @@ func TestMRUpdateLogStreamExclusive(t *testing.T) {
 			So(err, ShouldBeNil)
 		}
 
-		err := mr.RegisterTopic(context.TODO(), types.TopicID(1))
+		err := mr.RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: types.TopicID(1)})
 		So(err, ShouldBeNil)
 
 		ls := makeLogStream(types.TopicID(1), lsID, snIDs[0:rep])
@@ -1539,7 +1541,7 @@ func TestMRUpdateLogStreamUnsafe(t *testing.T) {
 			So(err, ShouldBeNil)
 		}
 
-		err := mr.RegisterTopic(context.TODO(), types.TopicID(1))
+		err := mr.RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: types.TopicID(1)})
 		So(err, ShouldBeNil)
 
 		ls := makeLogStream(types.TopicID(1), lsID, snIDs[0:rep])
@@ -1610,7 +1612,7 @@ func TestMRFailoverLeaderElection(t *testing.T) {
 			So(err, ShouldBeNil)
 		}
 
-		err := clus.nodes[0].RegisterTopic(context.TODO(), types.TopicID(1))
+		err := clus.nodes[0].RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: types.TopicID(1)})
 		So(err, ShouldBeNil)
 
 		lsID := types.MinLogStreamID
@@ -1647,6 +1649,134 @@ func TestMRFailoverLeaderElection(t *testing.T) {
 	})
 }
 
+func TestMRTransferLeadership(t *testing.T) {
+	Convey("Given MR cluster", t, func(ctx C) {
+		nrRep := 1
+		nrNode := 3
+
+		clus := newMetadataRepoCluster(nrNode, nrRep, false)
+		Reset(func() {
+			clus.closeNoErrors(t)
+		})
+		So(clus.Start(), ShouldBeNil)
+		So(testutil.CompareWaitN(10, func() bool {
+			return clus.healthCheckAll()
+		}), ShouldBeTrue)
+
+		leader := clus.leader()
+		So(leader, ShouldBeGreaterThanOrEqualTo, 0)
+
+		Convey("Then the leader should report progress of members", func(ctx C) {
+			So(testutil.CompareWaitN(50, func() bool {
+				ci, err := clus.nodes[leader].GetClusterInfo(context.TODO(), 0)
+				if err != nil || len(ci.Members) != nrNode {
+					return false
+				}
+				for _, member := range ci.Members {
+					if !member.Active || member.MatchIndex == 0 || member.ProgressState != "StateReplicate" {
+						return false
+					}
+				}
+				return true
+			}), ShouldBeTrue)
+		})
+
+		Convey("When the leadership is transferred through a follower", func(ctx C) {
+			follower := (leader + 1) % nrNode
+			target := (leader + 2) % nrNode
+			targetID := clus.nodes[target].nodeID
+
+			rctx, cancel := context.WithTimeout(context.Background(), vtesting.TimeoutUnitTimesFactor(50))
+			defer cancel()
+			err := clus.nodes[follower].TransferLeadership(rctx, 0, targetID)
+			So(err, ShouldBeNil)
+
+			Convey("Then the target should become the leader", func(ctx C) {
+				So(clus.leader(), ShouldEqual, target)
+				So(clus.nodes[follower].membership.Leader(), ShouldEqual, targetID)
+			})
+		})
+
+		Convey("When the leadership is transferred to a non-member", func(ctx C) {
+			err := clus.nodes[leader].TransferLeadership(context.TODO(), 0, types.NodeID(1))
+			So(err, ShouldEqual, verrors.ErrNotExist)
+		})
+	})
+}
+
+func TestMRLinearizableGetMetadata(t *testing.T) {
+	Convey("Given MR cluster", t, func(ctx C) {
+		nrRep := 1
+		nrNode := 3
+
+		clus := newMetadataRepoCluster(nrNode, nrRep, false)
+		Reset(func() {
+			clus.closeNoErrors(t)
+		})
+		So(clus.Start(), ShouldBeNil)
+		So(testutil.CompareWaitN(10, func() bool {
+			return clus.healthCheckAll()
+		}), ShouldBeTrue)
+
+		leader := clus.leader()
+		So(leader, ShouldBeGreaterThanOrEqualTo, 0)
+
+		Convey("When a storage node is registered through the leader", func(ctx C) {
+			snID := types.StorageNodeID(1)
+			sn := &varlogpb.StorageNodeDescriptor{
+				StorageNode: varlogpb.StorageNode{
+					StorageNodeID: snID,
+				},
+			}
+
+			rctx, cancel := context.WithTimeout(context.Background(), vtesting.TimeoutUnitTimesFactor(50))
+			defer cancel()
+			err := clus.nodes[leader].RegisterStorageNode(rctx, sn)
+			So(err, ShouldBeNil)
+
+			Convey("Then linearizable reads of all nodes should have it", func(ctx C) {
+				for _, node := range clus.nodes {
+					rctx, cancel := context.WithTimeout(context.Background(), vtesting.TimeoutUnitTimesFactor(50))
+					err := node.WaitForMetadata(rctx, true, 0)
+					cancel()
+					So(err, ShouldBeNil)
+
+					meta, err := node.GetMetadata(context.TODO())
+					So(err, ShouldBeNil)
+					So(meta.GetStorageNode(snID), ShouldNotBeNil)
+				}
+			})
+
+			Convey("Then reads waiting for the applied index of the leader should have it", func(ctx C) {
+				leaderMeta, err := clus.nodes[leader].GetMetadata(context.TODO())
+				So(err, ShouldBeNil)
+
+				for _, node := range clus.nodes {
+					rctx, cancel := context.WithTimeout(context.Background(), vtesting.TimeoutUnitTimesFactor(50))
+					err := node.WaitForMetadata(rctx, false, leaderMeta.AppliedIndex)
+					cancel()
+					So(err, ShouldBeNil)
+
+					meta, err := node.GetMetadata(context.TODO())
+					So(err, ShouldBeNil)
+					So(meta.AppliedIndex, ShouldBeGreaterThanOrEqualTo, leaderMeta.AppliedIndex)
+					So(meta.GetStorageNode(snID), ShouldNotBeNil)
+				}
+			})
+		})
+
+		Convey("When the applied index is not reached", func(ctx C) {
+			meta, err := clus.nodes[leader].GetMetadata(context.TODO())
+			So(err, ShouldBeNil)
+
+			rctx, cancel := context.WithTimeout(context.Background(), vtesting.TimeoutUnitTimesFactor(1))
+			defer cancel()
+			err = clus.nodes[leader].WaitForMetadata(rctx, false, meta.AppliedIndex+1)
+			So(err, ShouldResemble, context.DeadlineExceeded)
+		})
+	})
+}
+
 func TestMRFailoverJoinNewNode(t *testing.T) {
 	Convey("Given MR cluster", t, func(ctx C) {
 		nrRep := 1
@@ -1679,7 +1809,7 @@ func TestMRFailoverJoinNewNode(t *testing.T) {
 			So(err, ShouldBeNil)
 		}
 
-		err := clus.nodes[0].RegisterTopic(context.TODO(), types.TopicID(1))
+		err := clus.nodes[0].RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: types.TopicID(1)})
 		So(err, ShouldBeNil)
 
 		lsID := types.MinLogStreamID
@@ -2343,7 +2473,7 @@ func TestMRFailoverRecoverReportCollector(t *testing.T) {
 			return clus.nodes[leader].reportCollector.NumExecutors() == nrStorageNode*nrRep
 		}), ShouldBeTrue)
 
-		err := clus.nodes[0].RegisterTopic(context.TODO(), types.TopicID(1))
+		err := clus.nodes[0].RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: types.TopicID(1)})
 		So(err, ShouldBeNil)
 
 		for i := 0; i < nrLogStream; i++ {
@@ -2550,7 +2680,7 @@ func TestMRUnregisterTopic(t *testing.T) {
 			So(err, ShouldBeNil)
 		}
 
-		err := clus.nodes[0].RegisterTopic(context.TODO(), topicID)
+		err := clus.nodes[0].RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: topicID})
 		So(err, ShouldBeNil)
 
 		lsIDs := make([]types.LogStreamID, nrLS)
@@ -2575,6 +2705,63 @@ func TestMRUnregisterTopic(t *testing.T) {
 	})
 }
 
+func TestMRAdminLeaseFencing(t *testing.T) {
+	const lease = time.Minute
+
+	Convey("Given an admin lease", t, func(C) {
+		clus := newMetadataRepoCluster(1, 1, false)
+		Reset(func() {
+			clus.closeNoErrors(t)
+		})
+
+		So(clus.Start(), ShouldBeNil)
+		So(testutil.CompareWaitN(10, func() bool {
+			return clus.healthCheckAll()
+		}), ShouldBeTrue)
+
+		mr := clus.nodes[0]
+		withEpoch := func(epoch uint64) context.Context {
+			md, _ := metadata.FromOutgoingContext(rpc.WithAdminLeaseEpoch(context.Background(), epoch))
+			return metadata.NewIncomingContext(context.Background(), md)
+		}
+
+		acquired, err := mr.AcquireAdminLease(context.Background(), "admin1", lease)
+		So(err, ShouldBeNil)
+		So(acquired.Holder, ShouldEqual, "admin1")
+		So(acquired.Epoch, ShouldEqual, 1)
+
+		Convey("Another admin cannot acquire the unexpired lease", func(C) {
+			acquired, err := mr.AcquireAdminLease(context.Background(), "admin2", lease)
+			So(err, ShouldBeNil)
+			So(acquired.Holder, ShouldEqual, "admin1")
+		})
+
+		Convey("Requests of the previous holder are rejected once another admin acquires the lease", func(C) {
+			err := mr.RegisterTopic(withEpoch(1), &varlogpb.TopicDescriptor{TopicID: 1})
+			So(err, ShouldBeNil)
+
+			// release
+			_, err = mr.AcquireAdminLease(context.Background(), "admin1", 0)
+			So(err, ShouldBeNil)
+			acquired, err := mr.AcquireAdminLease(context.Background(), "admin2", lease)
+			So(err, ShouldBeNil)
+			So(acquired.Holder, ShouldEqual, "admin2")
+			So(acquired.Epoch, ShouldEqual, 2)
+
+			err = mr.RegisterTopic(withEpoch(1), &varlogpb.TopicDescriptor{TopicID: 2})
+			So(errors.Is(err, verrors.ErrStaleAdminLease), ShouldBeTrue)
+			So(mr.storage.lookupTopic(2), ShouldBeNil)
+
+			err = mr.RegisterTopic(withEpoch(2), &varlogpb.TopicDescriptor{TopicID: 2})
+			So(err, ShouldBeNil)
+
+			// not fenced
+			err = mr.RegisterTopic(context.Background(), &varlogpb.TopicDescriptor{TopicID: 3})
+			So(err, ShouldBeNil)
+		})
+	})
+}
+
 func TestMetadataRepository_MaxTopicsCount(t *testing.T) {
 	const numNodes = 1
 	const repFactor = 1
@@ -2595,32 +2782,288 @@ func TestMetadataRepository_MaxTopicsCount(t *testing.T) {
 
 		Convey("Limit is zero", func(C) {
 			mr.storage.limits.maxTopicsCount = 0
-			err := mr.RegisterTopic(context.Background(), 1)
+			err := mr.RegisterTopic(context.Background(), &varlogpb.TopicDescriptor{TopicID: 1})
 			So(err, ShouldNotBeNil)
 		})
 
 		Convey("Limit is one", func(C) {
 			mr.storage.limits.maxTopicsCount = 1
 
-			err := mr.RegisterTopic(context.Background(), 1)
+			err := mr.RegisterTopic(context.Background(), &varlogpb.TopicDescriptor{TopicID: 1})
 			So(err, ShouldBeNil)
 
-			err = mr.RegisterTopic(context.Background(), 2)
+			err = mr.RegisterTopic(context.Background(), &varlogpb.TopicDescriptor{TopicID: 2})
 			So(err, ShouldNotBeNil)
 			So(status.Code(err), ShouldEqual, codes.ResourceExhausted)
 
-			err = mr.RegisterTopic(context.Background(), 1)
+			err = mr.RegisterTopic(context.Background(), &varlogpb.TopicDescriptor{TopicID: 1})
 			So(err, ShouldBeNil)
 
 			err = mr.UnregisterTopic(context.TODO(), 1)
 			So(err, ShouldBeNil)
 
-			err = mr.RegisterTopic(context.Background(), 2)
+			err = mr.RegisterTopic(context.Background(), &varlogpb.TopicDescriptor{TopicID: 2})
 			So(err, ShouldBeNil)
 		})
 	})
 }
 
+func TestMRWatchMetadata(t *testing.T) {
+	const numNodes = 1
+	const repFactor = 1
+	const increaseUncommit = false
+
+	Convey("WatchMetadata", t, func(C) {
+		clus := newMetadataRepoCluster(numNodes, repFactor, increaseUncommit)
+		Reset(func() {
+			clus.closeNoErrors(t)
+		})
+
+		So(clus.Start(), ShouldBeNil)
+		So(testutil.CompareWaitN(10, func() bool {
+			return clus.healthCheckAll()
+		}), ShouldBeTrue)
+
+		mr := clus.nodes[0]
+		So(mr.RegisterTopic(context.Background(), &varlogpb.TopicDescriptor{TopicID: 1}), ShouldBeNil)
+		appliedIndex := mr.storage.GetMetadata().GetAppliedIndex()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mdC := make(chan *varlogpb.MetadataDescriptor, 1)
+		errC := make(chan error, 1)
+		go func() {
+			errC <- mr.WatchMetadata(ctx, 0, func(md *varlogpb.MetadataDescriptor) error {
+				mdC <- md
+				return nil
+			})
+		}()
+
+		// The current metadata is sent first.
+		md := <-mdC
+		So(md.GetAppliedIndex(), ShouldEqual, appliedIndex)
+		So(md.GetTopic(1), ShouldNotBeNil)
+
+		// Changes of the metadata are sent.
+		So(mr.RegisterTopic(context.Background(), &varlogpb.TopicDescriptor{TopicID: 2}), ShouldBeNil)
+		md = <-mdC
+		So(md.GetAppliedIndex(), ShouldBeGreaterThan, appliedIndex)
+		So(md.GetTopic(2), ShouldNotBeNil)
+
+		cancel()
+		So(<-errC, ShouldEqual, context.Canceled)
+	})
+}
+
+func TestMRBackupRestore(t *testing.T) {
+	Convey("Given a metadata repository having log streams", t, func(ctx C) {
+		clus := newMetadataRepoCluster(1, 1, false)
+		Reset(func() {
+			clus.closeNoErrors(t)
+		})
+		So(clus.Start(), ShouldBeNil)
+		So(testutil.CompareWaitN(10, func() bool {
+			return clus.healthCheckAll()
+		}), ShouldBeTrue)
+
+		mr := clus.nodes[0]
+		topicID := types.TopicID(1)
+		snIDs := []types.StorageNodeID{types.MinStorageNodeID}
+		lsID := types.MinLogStreamID
+
+		sn := &varlogpb.StorageNodeDescriptor{
+			StorageNode: varlogpb.StorageNode{
+				StorageNodeID: snIDs[0],
+			},
+		}
+		So(mr.RegisterStorageNode(context.TODO(), sn), ShouldBeNil)
+		So(testutil.CompareWaitN(50, func() bool {
+			return clus.reporterClientFac.(*DummyStorageNodeClientFactory).lookupClient(snIDs[0]) != nil
+		}), ShouldBeTrue)
+		So(mr.RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: topicID}), ShouldBeNil)
+		So(mr.RegisterLogStream(context.TODO(), makeLogStream(topicID, lsID, snIDs)), ShouldBeNil)
+
+		reporterClient := clus.reporterClientFac.(*DummyStorageNodeClientFactory).lookupClient(snIDs[0])
+		reporterClient.increaseUncommitted(0)
+		So(testutil.CompareWaitN(50, func() bool {
+			return reporterClient.numUncommitted(0) == 0
+		}), ShouldBeTrue)
+
+		Convey("When the state machine is backed up", func(ctx C) {
+			bak, err := mr.Backup(context.TODO(), mr.clusterID)
+			So(err, ShouldBeNil)
+			So(bak.AppliedIndex, ShouldBeGreaterThan, 0)
+			So(bak.StateMachine.Metadata.GetStorageNode(snIDs[0]), ShouldNotBeNil)
+			So(bak.StateMachine.Metadata.GetTopic(topicID), ShouldNotBeNil)
+			So(bak.StateMachine.Metadata.GetLogStream(lsID), ShouldNotBeNil)
+			So(bak.StateMachine.LogStream.CommitHistory, ShouldHaveLength, 1)
+			So(bak.StateMachine.LogStream.CommitHistory[0].Version, ShouldEqual, mr.storage.GetLastCommitVersion())
+			So(bak.StateMachine.PeersMap.Peers, ShouldBeEmpty)
+
+			// mrtool writes the backup in JSON.
+			buf, err := json.Marshal(bak)
+			So(err, ShouldBeNil)
+			bak = &mrpb.BackupResponse{}
+			So(json.Unmarshal(buf, bak), ShouldBeNil)
+
+			Convey("Then it should not be restored into a non-empty metadata repository", func(ctx C) {
+				err := mr.Restore(context.TODO(), mr.clusterID, bak.StateMachine)
+				So(err, ShouldEqual, verrors.ErrNotEmpty)
+			})
+
+			Convey("Then it should be restored into an empty metadata repository", func(ctx C) {
+				clus2 := newMetadataRepoCluster(1, 1, false)
+				Reset(func() {
+					clus2.closeNoErrors(t)
+				})
+				So(clus2.Start(), ShouldBeNil)
+				So(testutil.CompareWaitN(10, func() bool {
+					return clus2.healthCheckAll()
+				}), ShouldBeTrue)
+
+				mr2 := clus2.nodes[0]
+				So(mr2.Restore(context.TODO(), mr2.clusterID, bak.StateMachine), ShouldBeNil)
+
+				meta, err := mr2.GetMetadata(context.TODO())
+				So(err, ShouldBeNil)
+				So(meta.GetStorageNode(snIDs[0]), ShouldNotBeNil)
+				So(meta.GetTopic(topicID), ShouldNotBeNil)
+				So(meta.GetLogStream(lsID).GetStatus(), ShouldEqual, varlogpb.LogStreamStatusSealing)
+				So(mr2.storage.GetLastCommitVersion(), ShouldEqual, bak.StateMachine.LogStream.CommitHistory[0].Version)
+				So(mr2.IsMember(), ShouldBeTrue)
+
+				err = mr2.Restore(context.TODO(), mr2.clusterID, bak.StateMachine)
+				So(err, ShouldEqual, verrors.ErrNotEmpty)
+			})
+
+			Convey("Then it should bootstrap a new single-node metadata repository", func(ctx C) {
+				clus2 := newMetadataRepoCluster(1, 1, false)
+				Reset(func() {
+					clus2.closeNoErrors(t)
+				})
+				So(BootstrapFromBackup(vtesting.TestRaftDir(), clus2.peers[0], bak, zap.NewNop()), ShouldBeNil)
+				So(errors.Is(BootstrapFromBackup(vtesting.TestRaftDir(), clus2.peers[0], bak, zap.NewNop()), verrors.ErrExist), ShouldBeTrue)
+
+				So(clus2.Start(), ShouldBeNil)
+				So(testutil.CompareWaitN(10, func() bool {
+					return clus2.healthCheckAll()
+				}), ShouldBeTrue)
+
+				mr2 := clus2.nodes[0]
+				So(testutil.CompareWaitN(50, func() bool {
+					return mr2.IsMember()
+				}), ShouldBeTrue)
+
+				meta, err := mr2.GetMetadata(context.TODO())
+				So(err, ShouldBeNil)
+				So(meta.GetStorageNode(snIDs[0]), ShouldNotBeNil)
+				So(meta.GetTopic(topicID), ShouldNotBeNil)
+				So(meta.GetLogStream(lsID).GetStatus(), ShouldEqual, varlogpb.LogStreamStatusSealing)
+				So(mr2.storage.GetLastCommitVersion(), ShouldEqual, bak.StateMachine.LogStream.CommitHistory[0].Version)
+
+				So(mr2.RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: topicID + 1}), ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestMRDebugStatus(t *testing.T) {
+	Convey("Given a metadata repository having a log stream", t, func(ctx C) {
+		clus := newMetadataRepoCluster(1, 1, false)
+		Reset(func() {
+			clus.closeNoErrors(t)
+		})
+		So(clus.Start(), ShouldBeNil)
+		So(testutil.CompareWaitN(10, func() bool {
+			return clus.healthCheckAll()
+		}), ShouldBeTrue)
+
+		mr := clus.nodes[0]
+		topicID := types.TopicID(1)
+		snIDs := []types.StorageNodeID{types.MinStorageNodeID}
+		lsID := types.MinLogStreamID
+
+		sn := &varlogpb.StorageNodeDescriptor{
+			StorageNode: varlogpb.StorageNode{
+				StorageNodeID: snIDs[0],
+			},
+		}
+		So(mr.RegisterStorageNode(context.TODO(), sn), ShouldBeNil)
+		So(testutil.CompareWaitN(50, func() bool {
+			return clus.reporterClientFac.(*DummyStorageNodeClientFactory).lookupClient(snIDs[0]) != nil
+		}), ShouldBeTrue)
+		So(mr.RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: topicID}), ShouldBeNil)
+		So(mr.RegisterLogStream(context.TODO(), makeLogStream(topicID, lsID, snIDs)), ShouldBeNil)
+
+		reporterClient := clus.reporterClientFac.(*DummyStorageNodeClientFactory).lookupClient(snIDs[0])
+		reporterClient.increaseUncommitted(0)
+		So(testutil.CompareWaitN(50, func() bool {
+			return reporterClient.numUncommitted(0) == 0
+		}), ShouldBeTrue)
+
+		httpMux := http.NewServeMux()
+		mr.registerDebugHandlers(httpMux)
+		srv := httptest.NewServer(httpMux)
+		Reset(srv.Close)
+
+		get := func(path string, v any) int {
+			rsp, err := http.Get(srv.URL + path)
+			So(err, ShouldBeNil)
+			defer func() {
+				_ = rsp.Body.Close()
+			}()
+			if rsp.StatusCode == http.StatusOK {
+				So(json.NewDecoder(rsp.Body).Decode(v), ShouldBeNil)
+			}
+			return rsp.StatusCode
+		}
+
+		Convey("Then the raft status should show the leader", func(ctx C) {
+			var status RaftStatus
+			So(get(DebugRaftStatusPath, &status), ShouldEqual, http.StatusOK)
+			So(status.NodeID, ShouldEqual, mr.nodeID)
+			So(status.Leader, ShouldEqual, mr.nodeID)
+			So(status.State, ShouldEqual, "StateLeader")
+			So(status.Term, ShouldBeGreaterThan, 0)
+			So(status.CommittedIndex, ShouldBeGreaterThanOrEqualTo, status.AppliedIndex)
+		})
+
+		Convey("Then the report status should show the storage node", func(ctx C) {
+			var statuses []StorageNodeReportStatus
+			So(get(DebugReportStatusPath, &statuses), ShouldEqual, http.StatusOK)
+			So(statuses, ShouldHaveLength, 1)
+			So(statuses[0].StorageNodeID, ShouldEqual, snIDs[0])
+			So(statuses[0].NumLogStreams, ShouldEqual, 1)
+			So(statuses[0].LastReportTime.IsZero(), ShouldBeFalse)
+			So(statuses[0].LastError, ShouldBeEmpty)
+		})
+
+		Convey("Then the uncommit reports should show the log stream", func(ctx C) {
+			var statuses []LogStreamUncommitStatus
+			So(get(DebugUncommitsPath, &statuses), ShouldEqual, http.StatusOK)
+			So(statuses, ShouldHaveLength, 1)
+			So(statuses[0].LogStreamID, ShouldEqual, lsID)
+			So(statuses[0].TopicID, ShouldEqual, topicID)
+			So(statuses[0].Replicas, ShouldHaveLength, 1)
+			So(statuses[0].Replicas[0].StorageNodeID, ShouldEqual, snIDs[0])
+			So(statuses[0].Replicas[0].UncommittedLLSNOffset, ShouldBeGreaterThanOrEqualTo, types.MinLLSN)
+
+			So(get(fmt.Sprintf("%s?lsid=%d", DebugUncommitsPath, lsID+1), &statuses), ShouldEqual, http.StatusOK)
+			So(statuses, ShouldBeEmpty)
+
+			So(get(DebugUncommitsPath+"?lsid=foo", &statuses), ShouldEqual, http.StatusBadRequest)
+		})
+
+		Convey("Then the commit history should show the latest version", func(ctx C) {
+			var status CommitHistoryStatus
+			So(get(DebugCommitHistoryPath, &status), ShouldEqual, http.StatusOK)
+			So(status.LatestVersion, ShouldEqual, mr.storage.GetLastCommitVersion())
+			So(status.OldestVersion, ShouldBeLessThanOrEqualTo, status.LatestVersion)
+			So(status.NumEntries, ShouldBeGreaterThan, 0)
+		})
+	})
+}
+
 func TestMetadataRepository_MaxLogStreamsCountPerTopic(t *testing.T) {
 	const (
 		numNodes         = 1
@@ -2652,7 +3095,7 @@ func TestMetadataRepository_MaxLogStreamsCountPerTopic(t *testing.T) {
 		})
 		So(err, ShouldBeNil)
 
-		err = mr.RegisterTopic(ctx, tpid)
+		err = mr.RegisterTopic(ctx, &varlogpb.TopicDescriptor{TopicID: tpid})
 		So(err, ShouldBeNil)
 
 		Convey("Limit is zero", func(C) {
@@ -2876,6 +3319,38 @@ func TestMRTopicCatchup(t *testing.T) {
 	})
 }
 
+func TestMRParallelCommit(t *testing.T) {
+	Convey("Given metadata repositories committing sequentially and in parallel", t, func(ctx C) {
+		const (
+			numTopics             = 8
+			numLogStreamsPerTopic = minLogStreamsForParallelCommit / 4
+		)
+
+		seqMR, seqCli, seqCloser := newCommitTestMetadataRepository(t, numTopics, numLogStreamsPerTopic, 1)
+		defer seqCloser()
+		parMR, parCli, parCloser := newCommitTestMetadataRepository(t, numTopics, numLogStreamsPerTopic, 4)
+		defer parCloser()
+
+		Convey("Then their commit results should be the same", func(ctx C) {
+			for i := 0; i < 10; i++ {
+				applyDummyReport(t, seqMR, seqCli)
+				applyDummyReport(t, parMR, parCli)
+
+				So(seqMR.applyCommit(nil, uint64(i+1)), ShouldBeNil)
+				So(parMR.applyCommit(nil, uint64(i+1)), ShouldBeNil)
+
+				seqCRs := seqMR.storage.GetLastCommitResults()
+				parCRs := parMR.storage.GetLastCommitResults()
+				So(parCRs.GetVersion(), ShouldEqual, seqCRs.GetVersion())
+				So(parCRs.GetCommitResults(), ShouldResemble, seqCRs.GetCommitResults())
+
+				deliverDummyCommit(t, seqMR, seqCli)
+				deliverDummyCommit(t, parMR, parCli)
+			}
+		})
+	})
+}
+
 func TestMain(m *testing.M) {
 	goleak.VerifyTestMain(m,
 		goleak.IgnoreTopFunction(
@@ -62,6 +62,9 @@ type ReportCollector interface {
 	NumExecutors() int
 
 	NumCommitter() int
+
+	// Status returns how fresh reports from each storage node are.
+	Status() []StorageNodeReportStatus
 }
 
 type commitHelper interface {
@@ -100,6 +103,11 @@ type reportContext struct {
 	report   *mrpb.StorageNodeUncommitReport
 	reloadAt time.Time
 	mu       sync.RWMutex
+
+	// reportedAt is when the last report was received, and reportErr is
+	// the error of the last attempt to get a report.
+	reportedAt time.Time
+	reportErr  error
 }
 
 type storageNodeConnector struct {
@@ -472,6 +480,18 @@ func (rc *reportCollector) NumCommitter() int {
 	return num
 }
 
+func (rc *reportCollector) Status() []StorageNodeReportStatus {
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	now := time.Now()
+	statuses := make([]StorageNodeReportStatus, 0, len(rc.executors))
+	for _, executor := range rc.executors {
+		statuses = append(statuses, executor.status(now))
+	}
+	return statuses
+}
+
 func (rce *reportCollectExecutor) run() error {
 	ctx, cancel := rce.runner.WithManagedCancel(context.Background())
 	if err := rce.runner.RunC(ctx, rce.runReport); err != nil {
@@ -486,6 +506,13 @@ func (rce *reportCollectExecutor) run() error {
 }
 
 func (rce *reportCollectExecutor) stop() {
+	if rce.cancel != nil {
+		rce.cancel()
+	}
+	// Closing the client wakes up runReport waiting for reports pushed by
+	// the storage node, which may not come until the next heartbeat.
+	rce.closeClient(nil)
+
 	rce.runner.Stop()
 
 	rce.closeClient(nil)
@@ -626,14 +653,17 @@ func (rce *reportCollectExecutor) getReport(ctx context.Context) error {
 
 	cli, err := rce.getClient(ctx)
 	if err != nil {
+		rce.reportCtx.setReportError(err)
 		return err
 	}
 
 	response, err := cli.GetReport()
 	if err != nil {
+		rce.reportCtx.setReportError(err)
 		rce.closeClient(cli)
 		return err
 	}
+	rce.reportCtx.setReportedAt(time.Now())
 
 	report := rce.processReport(response)
 	if report.Len() > 0 {
@@ -760,6 +790,28 @@ func (rce *reportCollectExecutor) getReportedVersion(lsID types.LogStreamID) (ty
 	return r.Version, true
 }
 
+func (rce *reportCollectExecutor) status(now time.Time) StorageNodeReportStatus {
+	rce.snConnector.mu.RLock()
+	address := rce.snConnector.sn.GetAddress()
+	rce.snConnector.mu.RUnlock()
+
+	status := StorageNodeReportStatus{
+		StorageNodeID: rce.storageNodeID,
+		Address:       address,
+		NumLogStreams: rce.numCommitter(),
+	}
+
+	reportedAt, err := rce.reportCtx.getReportStatus()
+	if !reportedAt.IsZero() {
+		status.LastReportTime = reportedAt
+		status.SinceLastReport = now.Sub(reportedAt).String()
+	}
+	if err != nil {
+		status.LastError = err.Error()
+	}
+	return status
+}
+
 func (rce *reportCollectExecutor) getLastCommitResults() *mrpb.LogStreamCommitResults {
 	return rce.helper.GetLastCommitResults()
 }
@@ -932,6 +984,28 @@ func (rc *reportContext) getReport() *mrpb.StorageNodeUncommitReport {
 	return rc.report
 }
 
+func (rc *reportContext) setReportedAt(t time.Time) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.reportedAt = t
+	rc.reportErr = nil
+}
+
+func (rc *reportContext) setReportError(err error) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.reportErr = err
+}
+
+func (rc *reportContext) getReportStatus() (time.Time, error) {
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	return rc.reportedAt, rc.reportErr
+}
+
 func (rc *reportContext) reload() {
 	rc.reloadAt = time.Now()
 }
@@ -2,6 +2,7 @@ package metarepos
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc"
 
@@ -11,6 +12,9 @@ import (
 
 type reporterClientFactory struct {
 	grpcDialOptions []grpc.DialOption
+	// heartbeatInterval is the heartbeat interval of reports pushed by
+	// storage nodes. If it is zero, reports are pulled.
+	heartbeatInterval time.Duration
 }
 
 func NewReporterClientFactory(grpcDialOptions ...grpc.DialOption) *reporterClientFactory {
@@ -19,6 +23,18 @@ func NewReporterClientFactory(grpcDialOptions ...grpc.DialOption) *reporterClien
 	}
 }
 
+// NewPushReporterClientFactory returns a factory of clients that receive
+// reports pushed by storage nodes.
+func NewPushReporterClientFactory(heartbeatInterval time.Duration, grpcDialOptions ...grpc.DialOption) *reporterClientFactory {
+	return &reporterClientFactory{
+		grpcDialOptions:   grpcDialOptions,
+		heartbeatInterval: heartbeatInterval,
+	}
+}
+
 func (rcf *reporterClientFactory) GetReporterClient(ctx context.Context, sn *varlogpb.StorageNodeDescriptor) (reportcommitter.Client, error) {
+	if rcf.heartbeatInterval > 0 {
+		return reportcommitter.NewWatchClient(ctx, sn.Address, rcf.heartbeatInterval, rcf.grpcDialOptions...)
+	}
 	return reportcommitter.NewClient(ctx, sn.Address, rcf.grpcDialOptions...)
 }
@@ -0,0 +1,420 @@
+package metarepos
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/golang/snappy"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/mrpb"
+)
+
+// A snapshot of the metadata repository is encoded as a header followed by
+// sections:
+//
+//	header  := magic format flags firstVersion lastVersion
+//	section := kind firstVersion lastVersion rawLength dataLength data
+//
+// The first section has the state machine except for the commit history. The
+// commit history, which is the largest part of the state machine, is split
+// into chunks, and each chunk is a section. Each chunk has length-delimited
+// commit results whose versions are within the range of the section. If the
+// snapshot is compressed, data of each section is compressed separately.
+//
+// A delta snapshot omits chunks of commit results that the receiver already
+// has. The header of a delta snapshot keeps the range of the commit history
+// of the full snapshot so that the receiver can find commit results to fill
+// the omitted chunks.
+//
+// A snapshot not starting with the magic is a marshaled state machine written
+// by an older version.
+//
+// Chunks reduce neither the memory to build nor the memory to restore a
+// snapshot: raft keeps the data of a snapshot as one byte slice, thus, both
+// the sender and the receiver hold the whole encoded snapshot in memory.
+// Compression and delta snapshots reduce its size, and the snapshot is
+// streamed through the transport rather than embedded in a raft message.
+var snapshotMagic = []byte("VMRS")
+
+const (
+	snapshotFormatVersion byte = 1
+
+	snapshotFlagCompressed byte = 1 << 0
+	snapshotFlagDelta      byte = 1 << 1
+
+	snapshotSectionState         byte = 1
+	snapshotSectionCommitHistory byte = 2
+)
+
+// snapshotEncoding decides how a snapshot is encoded.
+type snapshotEncoding struct {
+	compress  bool
+	chunkSize int
+}
+
+var errNotDeltaSnapshot = errors.New("snapshot: not delta snapshot")
+
+type snapshotHeader struct {
+	flags        byte
+	firstVersion types.Version
+	lastVersion  types.Version
+}
+
+func (h snapshotHeader) compressed() bool {
+	return h.flags&snapshotFlagCompressed != 0
+}
+
+func (h snapshotHeader) delta() bool {
+	return h.flags&snapshotFlagDelta != 0
+}
+
+type snapshotSection struct {
+	kind         byte
+	firstVersion types.Version
+	lastVersion  types.Version
+	rawLength    uint64
+	data         []byte
+	// raw is the encoded section including its preamble.
+	raw []byte
+}
+
+func isLegacySnapshot(data []byte) bool {
+	return !bytes.HasPrefix(data, snapshotMagic)
+}
+
+// encodeSnapshot encodes the state machine. The state machine is not
+// modified.
+func encodeSnapshot(sm *mrpb.MetadataRepositoryDescriptor, enc snapshotEncoding) ([]byte, error) {
+	history := sm.GetLogStream().GetCommitHistory()
+
+	// The state section shares everything with the state machine except the
+	// commit history.
+	state := *sm
+	if sm.LogStream != nil {
+		ls := *sm.LogStream
+		ls.CommitHistory = nil
+		state.LogStream = &ls
+	}
+	stateBuf, err := state.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	h := snapshotHeader{}
+	if enc.compress {
+		h.flags |= snapshotFlagCompressed
+	}
+	if len(history) > 0 {
+		h.firstVersion = history[0].Version
+		h.lastVersion = history[len(history)-1].Version
+	}
+
+	buf := appendSnapshotHeader(make([]byte, 0, len(stateBuf)), h)
+	buf = appendSnapshotSection(buf, snapshotSectionState, 0, 0, stateBuf, enc.compress)
+
+	return appendCommitHistorySections(buf, history, enc)
+}
+
+// appendCommitHistorySections appends the commit results to the buffer as
+// sections of commit history chunks.
+func appendCommitHistorySections(buf []byte, history []*mrpb.LogStreamCommitResults, enc snapshotEncoding) ([]byte, error) {
+	chunkSize := enc.chunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultSnapshotChunkSize
+	}
+	chunk := make([]byte, 0, chunkSize)
+	var first, last types.Version
+	for _, cr := range history {
+		if len(chunk) == 0 {
+			first = cr.Version
+		}
+		size := cr.ProtoSize()
+		chunk = binary.AppendUvarint(chunk, uint64(size))
+		n := len(chunk)
+		chunk = append(chunk, make([]byte, size)...)
+		if _, err := cr.MarshalToSizedBuffer(chunk[n:]); err != nil {
+			return nil, err
+		}
+		last = cr.Version
+		if len(chunk) >= chunkSize {
+			buf = appendSnapshotSection(buf, snapshotSectionCommitHistory, first, last, chunk, enc.compress)
+			chunk = chunk[:0]
+		}
+	}
+	if len(chunk) > 0 {
+		buf = appendSnapshotSection(buf, snapshotSectionCommitHistory, first, last, chunk, enc.compress)
+	}
+	return buf, nil
+}
+
+func appendSnapshotHeader(buf []byte, h snapshotHeader) []byte {
+	buf = append(buf, snapshotMagic...)
+	buf = append(buf, snapshotFormatVersion, h.flags)
+	buf = binary.AppendUvarint(buf, uint64(h.firstVersion))
+	buf = binary.AppendUvarint(buf, uint64(h.lastVersion))
+	return buf
+}
+
+func appendSnapshotSection(buf []byte, kind byte, first, last types.Version, raw []byte, compress bool) []byte {
+	data := raw
+	if compress {
+		data = snappy.Encode(nil, raw)
+	}
+	buf = append(buf, kind)
+	buf = binary.AppendUvarint(buf, uint64(first))
+	buf = binary.AppendUvarint(buf, uint64(last))
+	buf = binary.AppendUvarint(buf, uint64(len(raw)))
+	buf = binary.AppendUvarint(buf, uint64(len(data)))
+	return append(buf, data...)
+}
+
+// parseSnapshot parses the header and sections of the encoded snapshot
+// without decoding data of sections.
+func parseSnapshot(data []byte) (snapshotHeader, []snapshotSection, error) {
+	var h snapshotHeader
+	if isLegacySnapshot(data) {
+		return h, nil, errors.New("snapshot: no magic")
+	}
+	r := data[len(snapshotMagic):]
+	if len(r) < 2 {
+		return h, nil, errors.New("snapshot: truncated header")
+	}
+	if r[0] != snapshotFormatVersion {
+		return h, nil, fmt.Errorf("snapshot: unknown format %d", r[0])
+	}
+	h.flags = r[1]
+	r = r[2:]
+
+	var vals [4]uint64
+	readUvarints := func(n int) error {
+		for i := 0; i < n; i++ {
+			v, l := binary.Uvarint(r)
+			if l <= 0 {
+				return errors.New("snapshot: malformed")
+			}
+			vals[i] = v
+			r = r[l:]
+		}
+		return nil
+	}
+
+	if err := readUvarints(2); err != nil {
+		return h, nil, err
+	}
+	h.firstVersion, h.lastVersion = types.Version(vals[0]), types.Version(vals[1])
+
+	var sections []snapshotSection
+	for len(r) > 0 {
+		begin := r
+		kind := r[0]
+		r = r[1:]
+		if err := readUvarints(4); err != nil {
+			return h, nil, err
+		}
+		if uint64(len(r)) < vals[3] {
+			return h, nil, errors.New("snapshot: truncated section")
+		}
+		s := snapshotSection{
+			kind:         kind,
+			firstVersion: types.Version(vals[0]),
+			lastVersion:  types.Version(vals[1]),
+			rawLength:    vals[2],
+			data:         r[:vals[3]],
+		}
+		r = r[vals[3]:]
+		s.raw = begin[:len(begin)-len(r)]
+		sections = append(sections, s)
+	}
+	return h, sections, nil
+}
+
+func (s snapshotSection) decode(compressed bool) ([]byte, error) {
+	if !compressed {
+		return s.data, nil
+	}
+	n, err := snappy.DecodedLen(s.data)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: %w", err)
+	}
+	if uint64(n) != s.rawLength {
+		return nil, fmt.Errorf("snapshot: section length mismatch: %d, expected %d", n, s.rawLength)
+	}
+	raw, err := snappy.Decode(make([]byte, n), s.data)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: %w", err)
+	}
+	return raw, nil
+}
+
+// decodeSnapshot decodes the snapshot into the state machine. If the
+// snapshot is a delta snapshot, the commit history of the state machine has
+// only commit results in the snapshot.
+func decodeSnapshot(data []byte) (*mrpb.MetadataRepositoryDescriptor, snapshotHeader, error) {
+	return decodeSnapshotSections(data, true)
+}
+
+// decodeSnapshotState decodes the snapshot into the state machine without
+// the commit history.
+func decodeSnapshotState(data []byte) (*mrpb.MetadataRepositoryDescriptor, error) {
+	sm, _, err := decodeSnapshotSections(data, false)
+	return sm, err
+}
+
+func decodeSnapshotSections(data []byte, withHistory bool) (*mrpb.MetadataRepositoryDescriptor, snapshotHeader, error) {
+	sm := &mrpb.MetadataRepositoryDescriptor{}
+	if isLegacySnapshot(data) {
+		if err := sm.Unmarshal(data); err != nil {
+			return nil, snapshotHeader{}, err
+		}
+		if !withHistory && sm.LogStream != nil {
+			sm.LogStream.CommitHistory = nil
+		}
+		return sm, snapshotHeader{}, nil
+	}
+
+	h, sections, err := parseSnapshot(data)
+	if err != nil {
+		return nil, h, err
+	}
+
+	var history []*mrpb.LogStreamCommitResults
+	for _, s := range sections {
+		if s.kind == snapshotSectionCommitHistory && !withHistory {
+			continue
+		}
+		raw, err := s.decode(h.compressed())
+		if err != nil {
+			return nil, h, err
+		}
+		switch s.kind {
+		case snapshotSectionState:
+			if err := sm.Unmarshal(raw); err != nil {
+				return nil, h, err
+			}
+		case snapshotSectionCommitHistory:
+			for len(raw) > 0 {
+				size, l := binary.Uvarint(raw)
+				if l <= 0 || uint64(len(raw)-l) < size {
+					return nil, h, errors.New("snapshot: malformed commit history")
+				}
+				cr := &mrpb.LogStreamCommitResults{}
+				if err := cr.Unmarshal(raw[l : l+int(size)]); err != nil {
+					return nil, h, err
+				}
+				history = append(history, cr)
+				raw = raw[l+int(size):]
+			}
+		default:
+			return nil, h, fmt.Errorf("snapshot: unknown section %d", s.kind)
+		}
+	}
+
+	if len(history) > 0 {
+		if sm.LogStream == nil {
+			sm.LogStream = &mrpb.MetadataRepositoryDescriptor_LogStreamDescriptor{}
+		}
+		sm.LogStream.CommitHistory = history
+	}
+	return sm, h, nil
+}
+
+// snapshotLastVersion returns the version of the last commit results in the
+// snapshot.
+func snapshotLastVersion(data []byte) (types.Version, error) {
+	if isLegacySnapshot(data) {
+		sm := &mrpb.MetadataRepositoryDescriptor{}
+		if err := sm.Unmarshal(data); err != nil {
+			return types.InvalidVersion, err
+		}
+		history := sm.GetLogStream().GetCommitHistory()
+		if len(history) == 0 {
+			return types.InvalidVersion, nil
+		}
+		return history[len(history)-1].Version, nil
+	}
+	h, _, err := parseSnapshot(data)
+	if err != nil {
+		return types.InvalidVersion, err
+	}
+	return h.lastVersion, nil
+}
+
+// makeDeltaSnapshot returns a delta snapshot of the full snapshot for a
+// receiver having commit results up to the base version. It omits chunks of
+// commit results whose versions are all less than or equal to the base
+// version.
+func makeDeltaSnapshot(data []byte, baseVersion types.Version) ([]byte, error) {
+	h, sections, err := parseSnapshot(data)
+	if err != nil {
+		return nil, err
+	}
+	if h.delta() {
+		return nil, errors.New("snapshot: already delta snapshot")
+	}
+
+	h.flags |= snapshotFlagDelta
+	buf := appendSnapshotHeader(nil, h)
+	for _, s := range sections {
+		if s.kind == snapshotSectionCommitHistory && s.lastVersion <= baseVersion {
+			continue
+		}
+		buf = append(buf, s.raw...)
+	}
+	return buf, nil
+}
+
+// resolveDeltaSnapshot restores the full snapshot from the delta snapshot.
+// The function lookup should return commit results of versions in the range
+// [begin, end), which are omitted by the delta snapshot. Sections in the
+// delta snapshot are copied as they are, and only the omitted commit results
+// are encoded, thus, the commit history in the delta snapshot is neither
+// decoded nor re-encoded.
+func resolveDeltaSnapshot(data []byte, chunkSize int, lookup func(begin, end types.Version) ([]*mrpb.LogStreamCommitResults, error)) ([]byte, error) {
+	if isLegacySnapshot(data) {
+		return nil, errNotDeltaSnapshot
+	}
+	h, sections, err := parseSnapshot(data)
+	if err != nil {
+		return nil, err
+	}
+	if !h.delta() {
+		return nil, errNotDeltaSnapshot
+	}
+
+	end := h.lastVersion + 1
+	idx := len(sections)
+	for i, s := range sections {
+		if s.kind == snapshotSectionCommitHistory {
+			end, idx = s.firstVersion, i
+			break
+		}
+	}
+
+	var omitted []*mrpb.LogStreamCommitResults
+	if h.lastVersion != types.InvalidVersion && end > h.firstVersion {
+		omitted, err = lookup(h.firstVersion, end)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	h.flags &^= snapshotFlagDelta
+	buf := appendSnapshotHeader(make([]byte, 0, len(data)), h)
+	for _, s := range sections[:idx] {
+		buf = append(buf, s.raw...)
+	}
+	buf, err = appendCommitHistorySections(buf, omitted, snapshotEncoding{
+		compress:  h.compressed(),
+		chunkSize: chunkSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range sections[idx:] {
+		buf = append(buf, s.raw...)
+	}
+	return buf, nil
+}
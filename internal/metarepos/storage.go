@@ -26,6 +26,13 @@ import (
 	"github.com/kakao/varlog/proto/varlogpb"
 )
 
+// maxCommitIndexRanges is the maximum number of ranges kept in the commit
+// index of each topic. It is not configurable since the commit index is a
+// part of the replicated state machine and should be identical in all
+// members. The oldest ranges beyond it are dropped, and LookupGLSN returns
+// verrors.ErrTrimmed for GLSNs in them.
+const maxCommitIndexRanges = 1 << 16
+
 type jobSnapshot struct {
 	appliedIndex uint64
 }
@@ -49,6 +56,11 @@ type SnapshotGetter interface {
 	GetSnapshotIndex() uint64
 
 	GetSnapshot() ([]byte, *raftpb.ConfState, uint64)
+
+	// ResolveSnapshot returns the full snapshot of the given snapshot. If it
+	// is a delta snapshot, omitted commit results are filled with ones
+	// stored locally.
+	ResolveSnapshot([]byte) ([]byte, error)
 }
 
 type Membership interface {
@@ -102,9 +114,13 @@ type MetadataStorage struct {
 
 	// the largest index of the entry already applied to the stateMachine
 	appliedIndex uint64
+	// appliedIndexC is closed and replaced whenever appliedIndex is updated.
+	appliedIndexC chan struct{}
 
 	// immutable metadata cache for client request
 	metaCache *varlogpb.MetadataDescriptor
+	// metaCacheC is closed and replaced whenever metaCache is replaced.
+	metaCacheC chan struct{}
 	// change of metadata sequence number
 	metaAppliedIndex uint64
 	// callback after cache is completed
@@ -117,11 +133,12 @@ type MetadataStorage struct {
 	nrRunning           int64
 	nrUpdateSinceCommit uint64
 
-	lsMu sync.RWMutex // mutex for GlobalLogStream
+	lsMu sync.RWMutex // mutex for GlobalLogStream and UncommitReports
 	mtMu sync.RWMutex // mutex for Metadata
 	prMu sync.RWMutex // mutex for Peers
 	ssMu sync.RWMutex // mutex for Snapshot
 	mcMu sync.RWMutex // mutex for Metadata Cache
+	aiMu sync.RWMutex // mutex for appliedIndex watched by other goroutines
 
 	// async job (snapshot, cache)
 	jobC chan *storageAsyncJob
@@ -134,6 +151,8 @@ type MetadataStorage struct {
 		maxLogStreamsCountPerTopic int32
 	}
 
+	snapshotEncoding snapshotEncoding
+
 	logger *zap.Logger
 }
 
@@ -150,6 +169,10 @@ func NewMetadataStorage(cb func(uint64, uint64, error), snapCount uint64, logger
 	ms.snapCount = snapCount
 	ms.limits.maxTopicsCount = DefaultMaxTopicsCount
 	ms.limits.maxLogStreamsCountPerTopic = DefaultMaxLogStreamsCountPerTopic
+	ms.snapshotEncoding = snapshotEncoding{
+		compress:  DefaultSnapshotCompression,
+		chunkSize: DefaultSnapshotChunkSize,
+	}
 
 	ms.origStateMachine = &mrpb.MetadataRepositoryDescriptor{}
 	ms.origStateMachine.Metadata = &varlogpb.MetadataDescriptor{}
@@ -168,6 +191,8 @@ func NewMetadataStorage(cb func(uint64, uint64, error), snapCount uint64, logger
 	ms.diffStateMachine.Endpoints = make(map[types.NodeID]string)
 
 	ms.metaCache = &varlogpb.MetadataDescriptor{}
+	ms.metaCacheC = make(chan struct{})
+	ms.appliedIndexC = make(chan struct{})
 
 	ms.jobC = make(chan *storageAsyncJob, 4096)
 	ms.running.Store(false)
@@ -476,7 +501,9 @@ func (ms *MetadataStorage) registerLogStream(ls *varlogpb.LogStreamDescriptor) e
 		}
 	}
 
+	ms.lsMu.Lock()
 	cur.LogStream.UncommitReports[ls.LogStreamID] = lm
+	ms.lsMu.Unlock()
 	ms.insertSortedLSIDs(ls.TopicID, ls.LogStreamID)
 
 	topic = proto.Clone(topic).(*varlogpb.TopicDescriptor)
@@ -520,7 +547,10 @@ func (ms *MetadataStorage) unregisterLogStream(lsID types.LogStreamID) error {
 	defer ms.mtMu.Unlock()
 
 	cur.Metadata.DeleteLogStream(lsID) //nolint:errcheck,revive // TODO:: Handle an error returned.
+
+	ms.lsMu.Lock()
 	delete(cur.LogStream.UncommitReports, lsID)
+	ms.lsMu.Unlock()
 
 	if pre != cur {
 		deleted := &varlogpb.LogStreamDescriptor{
@@ -534,7 +564,9 @@ func (ms *MetadataStorage) unregisterLogStream(lsID types.LogStreamID) error {
 			Status: varlogpb.LogStreamStatusDeleted,
 		}
 
+		ms.lsMu.Lock()
 		cur.LogStream.UncommitReports[lsID] = lm
+		ms.lsMu.Unlock()
 	}
 
 	ms.deleteSortedLSIDs(ls.TopicID, lsID)
@@ -742,6 +774,9 @@ func (ms *MetadataStorage) unregisterTopic(topicID types.TopicID) error {
 func (ms *MetadataStorage) updateUncommitReport(ls *varlogpb.LogStreamDescriptor) error {
 	pre, cur := ms.getStateMachine()
 
+	ms.lsMu.Lock()
+	defer ms.lsMu.Unlock()
+
 	newReports := &mrpb.LogStreamUncommitReports{
 		Replicas: make(map[types.StorageNodeID]snpb.LogStreamUncommitReport, len(ls.Replicas)),
 	}
@@ -836,6 +871,9 @@ func (ms *MetadataStorage) updateLogStreamDescStatus(lsID types.LogStreamID, sta
 func (ms *MetadataStorage) updateUncommitReportStatus(lsID types.LogStreamID, status varlogpb.LogStreamStatus) error {
 	pre, cur := ms.getStateMachine()
 
+	ms.lsMu.Lock()
+	defer ms.lsMu.Unlock()
+
 	lls, ok := cur.LogStream.UncommitReports[lsID]
 	if !ok {
 		o, ok := pre.LogStream.UncommitReports[lsID]
@@ -1088,6 +1126,62 @@ func (ms *MetadataStorage) LookupEndpoint(nodeID types.NodeID) string {
 	return ""
 }
 
+// AcquireAdminLease grants the lease to the holder of the argument r if no
+// one has held the lease, the holder already holds it, or the lease has not
+// been renewed since the proposer of r regarded it as expired. Otherwise, the
+// lease does not change. Since the decision depends only on the state machine
+// and r, all metadata repositories make the same one.
+func (ms *MetadataStorage) AcquireAdminLease(r *mrpb.AcquireAdminLease, appliedIndex, nodeIndex, requestIndex uint64) error {
+	ms.prMu.Lock()
+	defer ms.prMu.Unlock()
+
+	lease := ms.lookupAdminLeaseNoLock()
+	if lease == nil || lease.Holder == r.Holder || (r.ExpiredIndex != 0 && r.ExpiredIndex == lease.AppliedIndex) {
+		acquired := &mrpb.AdminLease{
+			Holder:       r.Holder,
+			Epoch:        1,
+			Duration:     r.Duration,
+			AppliedIndex: appliedIndex,
+		}
+		if lease != nil {
+			acquired.Epoch = lease.Epoch
+			if lease.Holder != r.Holder {
+				acquired.Epoch++
+			}
+		}
+		_, cur := ms.getStateMachine()
+		cur.AdminLease = acquired
+	}
+
+	if ms.cacheCompleteCB != nil {
+		ms.cacheCompleteCB(nodeIndex, requestIndex, nil)
+	}
+
+	return nil
+}
+
+// GetAdminLease returns the lease for the leader of admin servers. It returns
+// nil if no admin server has ever acquired the lease.
+func (ms *MetadataStorage) GetAdminLease() *mrpb.AdminLease {
+	ms.prMu.RLock()
+	defer ms.prMu.RUnlock()
+
+	lease := ms.lookupAdminLeaseNoLock()
+	if lease == nil {
+		return nil
+	}
+	ret := *lease
+	return &ret
+}
+
+func (ms *MetadataStorage) lookupAdminLeaseNoLock() *mrpb.AdminLease {
+	pre, cur := ms.getStateMachine()
+	if cur.AdminLease != nil {
+		return cur.AdminLease
+	}
+	return pre.AdminLease
+}
+
 func (ms *MetadataStorage) lookupNextCommitResultsNoLock(ver types.Version) *mrpb.LogStreamCommitResults {
 	pre, cur := ms.getStateMachine()
 	if pre != cur {
@@ -1112,6 +1206,23 @@ func (ms *MetadataStorage) lookupCommitResultsNoLock(ver types.Version) *mrpb.Lo
 	return pre.LookupCommitResults(ver)
 }
 
+// lookupCommitResultsRangeNoLock returns commit results of versions in the
+// range [begin, end). It returns an error if any of them does not exist.
+func (ms *MetadataStorage) lookupCommitResultsRangeNoLock(begin, end types.Version) ([]*mrpb.LogStreamCommitResults, error) {
+	if begin >= end {
+		return nil, nil
+	}
+	crs := make([]*mrpb.LogStreamCommitResults, 0, end-begin)
+	for ver := begin; ver < end; ver++ {
+		cr := ms.lookupCommitResultsNoLock(ver)
+		if cr == nil {
+			return nil, fmt.Errorf("no commit results of version %v: %w", ver, verrors.ErrNotExist)
+		}
+		crs = append(crs, cr)
+	}
+	return crs, nil
+}
+
 func (ms *MetadataStorage) getLastCommitResultsNoLock() *mrpb.LogStreamCommitResults {
 	gls := ms.diffStateMachine.GetLastCommitResults()
 	if gls != nil {
@@ -1194,6 +1305,9 @@ func (ms *MetadataStorage) verifyUncommitReport(s snpb.LogStreamUncommitReport)
 func (ms *MetadataStorage) UpdateUncommitReport(lsID types.LogStreamID, snID types.StorageNodeID, s snpb.LogStreamUncommitReport) {
 	pre, cur := ms.getStateMachine()
 
+	ms.lsMu.Lock()
+	defer ms.lsMu.Unlock()
+
 	lm, ok := cur.LogStream.UncommitReports[lsID]
 	if !ok {
 		o, ok := pre.LogStream.UncommitReports[lsID]
@@ -1270,7 +1384,7 @@ func (ms *MetadataStorage) TrimLogStreamCommitHistory(ver types.Version) error {
 }
 
 func (ms *MetadataStorage) UpdateAppliedIndex(appliedIndex uint64) {
-	ms.appliedIndex = appliedIndex
+	ms.setAppliedIndex(appliedIndex)
 
 	// make sure to merge before trigger snapshop
 	// it makes orig have entry with appliedIndex
@@ -1332,6 +1446,64 @@ func (ms *MetadataStorage) GetLastCommitResults() *mrpb.LogStreamCommitResults {
 	return ms.getLastCommitResultsNoLock()
 }
 
+// LookupGLSN returns the log stream to which the log entry of the given
+// GLSN was committed, and its LLSN. It looks up the commit history first and
+// then the commit index if the GLSN has already been trimmed from the
+// history. It returns verrors.ErrTrimmed if the GLSN has also been dropped
+// from the commit index, which keeps at most maxCommitIndexRanges ranges, and
+// verrors.ErrNotExist if the GLSN is unknown.
+func (ms *MetadataStorage) LookupGLSN(topicID types.TopicID, glsn types.GLSN) (types.LogStreamID, types.LLSN, error) {
+	ms.lsMu.RLock()
+	defer ms.lsMu.RUnlock()
+
+	histories := [][]*mrpb.LogStreamCommitResults{
+		ms.diffStateMachine.LogStream.CommitHistory,
+		ms.origStateMachine.LogStream.CommitHistory,
+	}
+	for _, history := range histories {
+		if cr, ok := lookupGLSNInHistory(history, topicID, glsn); ok {
+			return cr.LogStreamID, cr.CommittedLLSNOffset + types.LLSN(glsn-cr.CommittedGLSNOffset), nil
+		}
+	}
+
+	ci := ms.origStateMachine.LogStream.CommitIndex[topicID]
+	if r, ok := ci.Lookup(glsn); ok {
+		return r.LogStreamID, r.LLSN(glsn), nil
+	}
+	if ci.Trimmed(glsn) {
+		return types.LogStreamID(0), types.InvalidLLSN, verrors.ErrTrimmed
+	}
+	return types.LogStreamID(0), types.InvalidLLSN, verrors.ErrNotExist
+}
+
+// lookupGLSNInHistory finds the commit result containing the given GLSN from
+// the commit history. Since the high watermark of the topic never decreases,
+// the commit result is in the first commit results whose high watermark is
+// not less than the GLSN.
+func lookupGLSNInHistory(history []*mrpb.LogStreamCommitResults, topicID types.TopicID, glsn types.GLSN) (snpb.LogStreamCommitResult, bool) {
+	i := sort.Search(len(history), func(i int) bool {
+		return topicHighWatermark(history, topicID, i) >= glsn
+	})
+	if i < len(history) {
+		return history[i].LookupGLSN(topicID, glsn)
+	}
+	return snpb.InvalidLogStreamCommitResult, false
+}
+
+// topicHighWatermark returns the high watermark of the topic in the i-th
+// commit results of the history. If the topic is missing from them, for
+// instance, since all of its log streams were unregistered, it returns the
+// high watermark in the closest preceding commit results having the topic so
+// that the value never decreases along the history.
+func topicHighWatermark(history []*mrpb.LogStreamCommitResults, topicID types.TopicID, i int) types.GLSN {
+	for ; i >= 0; i-- {
+		if hwm, _ := history[i].LastHighWatermark(topicID, -1); !hwm.Invalid() {
+			return hwm
+		}
+	}
+	return types.InvalidGLSN
+}
+
 func (ms *MetadataStorage) GetMetadata() *varlogpb.MetadataDescriptor {
 	ms.mcMu.RLock()
 	defer ms.mcMu.RUnlock()
@@ -1339,6 +1511,44 @@ func (ms *MetadataStorage) GetMetadata() *varlogpb.MetadataDescriptor {
 	return ms.metaCache
 }
 
+// setAppliedIndex updates the applied index and wakes up watchers of it. Only
+// the goroutine applying entries calls it.
+func (ms *MetadataStorage) setAppliedIndex(appliedIndex uint64) {
+	ms.aiMu.Lock()
+	defer ms.aiMu.Unlock()
+
+	ms.appliedIndex = appliedIndex
+	close(ms.appliedIndexC)
+	ms.appliedIndexC = make(chan struct{})
+}
+
+// WatchAppliedIndex returns the index of the last entry applied to the state
+// machine and a channel closed when the index is updated.
+func (ms *MetadataStorage) WatchAppliedIndex() (uint64, <-chan struct{}) {
+	ms.aiMu.RLock()
+	defer ms.aiMu.RUnlock()
+
+	return ms.appliedIndex, ms.appliedIndexC
+}
+
+// GetMetaAppliedIndex returns the applied index of the metadata, which the
+// metadata cache reflects eventually.
+func (ms *MetadataStorage) GetMetaAppliedIndex() uint64 {
+	ms.mtMu.RLock()
+	defer ms.mtMu.RUnlock()
+
+	return ms.metaAppliedIndex
+}
+
+// WatchMetadata returns the metadata cache and a channel closed when the
+// cache is replaced by newer one.
+func (ms *MetadataStorage) WatchMetadata() (*varlogpb.MetadataDescriptor, <-chan struct{}) {
+	ms.mcMu.RLock()
+	defer ms.mcMu.RUnlock()
+
+	return ms.metaCache, ms.metaCacheC
+}
+
 func (ms *MetadataStorage) GetLogStreamCommitResults() []*mrpb.LogStreamCommitResults {
 	ver := ms.origStateMachine.LogStream.TrimVersion
 	if ms.origStateMachine.LogStream.TrimVersion < ms.diffStateMachine.LogStream.TrimVersion {
@@ -1360,6 +1570,61 @@ func (ms *MetadataStorage) GetLogStreamCommitResults() []*mrpb.LogStreamCommitRe
 	return crs
 }
 
+// GetCommitHistoryStatus returns the window of the commit history. It should
+// not be called while applying committed entries.
+// GetUncommitStatus returns the uncommit reports of the log stream lsID, or
+// of all log streams if lsID is zero.
+func (ms *MetadataStorage) GetUncommitStatus(lsID types.LogStreamID) []LogStreamUncommitStatus {
+	ms.mtMu.RLock()
+	defer ms.mtMu.RUnlock()
+	ms.lsMu.RLock()
+	defer ms.lsMu.RUnlock()
+
+	var statuses []LogStreamUncommitStatus
+	for _, ls := range ms.GetLogStreams() {
+		if lsID != 0 && ls.LogStreamID != lsID {
+			continue
+		}
+		lm := ms.LookupUncommitReports(ls.LogStreamID)
+		if lm == nil {
+			continue
+		}
+		status := LogStreamUncommitStatus{
+			LogStreamID: ls.LogStreamID,
+			TopicID:     ls.TopicID,
+			Status:      lm.Status,
+			Replicas:    make([]ReplicaUncommitStatus, 0, len(lm.Replicas)),
+		}
+		for snID, r := range lm.Replicas {
+			status.Replicas = append(status.Replicas, ReplicaUncommitStatus{
+				StorageNodeID:         snID,
+				Version:               r.Version,
+				HighWatermark:         r.HighWatermark,
+				UncommittedLLSNOffset: r.UncommittedLLSNOffset,
+				UncommittedLLSNLength: r.UncommittedLLSNLength,
+			})
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+func (ms *MetadataStorage) GetCommitHistoryStatus() CommitHistoryStatus {
+	ms.lsMu.RLock()
+	defer ms.lsMu.RUnlock()
+
+	trimVersion := ms.origStateMachine.LogStream.TrimVersion
+	if trimVersion < ms.diffStateMachine.LogStream.TrimVersion {
+		trimVersion = ms.diffStateMachine.LogStream.TrimVersion
+	}
+	return CommitHistoryStatus{
+		OldestVersion: ms.getFirstCommitResultsNoLock().GetVersion(),
+		LatestVersion: ms.getLastCommitResultsNoLock().GetVersion(),
+		TrimVersion:   trimVersion,
+		NumEntries:    len(ms.origStateMachine.LogStream.CommitHistory) + len(ms.diffStateMachine.LogStream.CommitHistory),
+	}
+}
+
 func (ms *MetadataStorage) getSnapshotConfState() *raftpb.ConfState {
 	f := ms.snapConfState.Load()
 	if f == nil {
@@ -1384,16 +1649,30 @@ func (ms *MetadataStorage) GetSnapshot() ([]byte, *raftpb.ConfState, uint64) {
 	return ms.snap, ms.getSnapshotConfState(), ms.getSnapshotIndex()
 }
 
+func (ms *MetadataStorage) ResolveSnapshot(snap []byte) ([]byte, error) {
+	resolved, err := resolveDeltaSnapshot(snap, ms.snapshotEncoding.chunkSize, func(begin, end types.Version) ([]*mrpb.LogStreamCommitResults, error) {
+		ms.lsMu.RLock()
+		defer ms.lsMu.RUnlock()
+		return ms.lookupCommitResultsRangeNoLock(begin, end)
+	})
+	if errors.Is(err, errNotDeltaSnapshot) {
+		return snap, nil
+	}
+	return resolved, err
+}
+
 func (ms *MetadataStorage) ApplySnapshot(snap []byte, snapConfState *raftpb.ConfState, snapIndex uint64) error {
 	if snapIndex < ms.appliedIndex {
 		return errors.New("outdated snapshot")
 	}
 
-	stateMachine := &mrpb.MetadataRepositoryDescriptor{}
-	err := stateMachine.Unmarshal(snap)
+	stateMachine, header, err := decodeSnapshot(snap)
 	if err != nil {
 		return err
 	}
+	if header.delta() {
+		return errors.New("unresolved delta snapshot")
+	}
 
 	if stateMachine.Metadata == nil {
 		stateMachine.Metadata = &varlogpb.MetadataDescriptor{}
@@ -1431,7 +1710,7 @@ func (ms *MetadataStorage) ApplySnapshot(snap []byte, snapConfState *raftpb.Conf
 
 	// make nrUpdateSinceCommit > 0 to enable commit
 	ms.nrUpdateSinceCommit = 1
-	ms.appliedIndex = snapIndex
+	ms.setAppliedIndex(snapIndex)
 	ms.origConfState = snapConfState
 
 	ms.jobC = make(chan *storageAsyncJob, 4096)
@@ -1459,6 +1738,7 @@ func (ms *MetadataStorage) RecoverStateMachine(stateMachine *mrpb.MetadataReposi
 
 	stateMachine.Endpoints = ms.origStateMachine.Endpoints
 	stateMachine.PeersMap = ms.origStateMachine.PeersMap
+	stateMachine.AdminLease = ms.origStateMachine.AdminLease
 
 	ms.recoverLogStreams(stateMachine)
 	ms.recoverCache(stateMachine, appliedIndex)
@@ -1479,6 +1759,69 @@ func (ms *MetadataStorage) RecoverStateMachine(stateMachine *mrpb.MetadataReposi
 	return nil
 }
 
+// Backup returns a copy of the state machine. The caller should prevent raft
+// entries from being applied while copying, but not while encoding the copy.
+// Changes kept in diffStateMachine are applied to the copy without being
+// merged into origStateMachine. The copy has metadata, reports of log stream
+// replicas, the latest commit results and the commit index, but not members
+// of the cluster. It also returns the applied index at which the state
+// machine is copied.
+func (ms *MetadataStorage) Backup() (*mrpb.MetadataRepositoryDescriptor, uint64) {
+	orig, diff := ms.origStateMachine, ms.diffStateMachine
+
+	ms.mtMu.RLock()
+	metadata := proto.Clone(orig.Metadata).(*varlogpb.MetadataDescriptor)
+	applyMetadataDiff(metadata, proto.Clone(diff.Metadata).(*varlogpb.MetadataDescriptor))
+	ms.mtMu.RUnlock()
+
+	stateMachine := &mrpb.MetadataRepositoryDescriptor{
+		Metadata: metadata,
+		LogStream: &mrpb.MetadataRepositoryDescriptor_LogStreamDescriptor{
+			UncommitReports: make(map[types.LogStreamID]*mrpb.LogStreamUncommitReports, len(orig.LogStream.UncommitReports)),
+		},
+	}
+	for lsID, lm := range orig.LogStream.UncommitReports {
+		stateMachine.LogStream.UncommitReports[lsID] = proto.Clone(lm).(*mrpb.LogStreamUncommitReports)
+	}
+	for lsID, lm := range diff.LogStream.UncommitReports {
+		if lm.Status.Deleted() {
+			delete(stateMachine.LogStream.UncommitReports, lsID)
+		} else {
+			stateMachine.LogStream.UncommitReports[lsID] = proto.Clone(lm).(*mrpb.LogStreamUncommitReports)
+		}
+	}
+
+	ms.lsMu.RLock()
+	defer ms.lsMu.RUnlock()
+
+	if crs := ms.getLastCommitResultsNoLock(); crs != nil {
+		stateMachine.LogStream.CommitHistory = []*mrpb.LogStreamCommitResults{
+			proto.Clone(crs).(*mrpb.LogStreamCommitResults),
+		}
+	}
+	if len(orig.LogStream.CommitIndex) > 0 {
+		stateMachine.LogStream.CommitIndex = make(map[types.TopicID]*mrpb.CommitIndex, len(orig.LogStream.CommitIndex))
+		for topicID, ci := range orig.LogStream.CommitIndex {
+			stateMachine.LogStream.CommitIndex[topicID] = proto.Clone(ci).(*mrpb.CommitIndex)
+		}
+	}
+	return stateMachine, ms.appliedIndex
+}
+
+// IsEmpty returns true if the state machine has neither storage nodes,
+// topics, log streams nor commit results.
+func (ms *MetadataStorage) IsEmpty() bool {
+	for _, s := range []*mrpb.MetadataRepositoryDescriptor{ms.origStateMachine, ms.diffStateMachine} {
+		if len(s.Metadata.GetStorageNodes()) > 0 ||
+			len(s.Metadata.GetTopics()) > 0 ||
+			len(s.Metadata.GetLogStreams()) > 0 ||
+			len(s.LogStream.GetCommitHistory()) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
 func (ms *MetadataStorage) recoverLogStreams(stateMachine *mrpb.MetadataRepositoryDescriptor) {
 	commitResults := stateMachine.GetLastCommitResults()
 
@@ -1516,7 +1859,7 @@ func (ms *MetadataStorage) recoverCache(stateMachine *mrpb.MetadataRepositoryDes
 	cache.AppliedIndex = appliedIndex
 
 	ms.mcMu.Lock()
-	ms.metaCache = cache
+	ms.setMetaCacheNoLock(cache)
 	ms.mcMu.Unlock()
 }
 
@@ -1535,7 +1878,7 @@ func (ms *MetadataStorage) recoverStateMachine(stateMachine *mrpb.MetadataReposi
 	ms.diffStateMachine.Endpoints = make(map[types.NodeID]string)
 
 	ms.metaAppliedIndex = appliedIndex
-	ms.appliedIndex = appliedIndex
+	ms.setAppliedIndex(appliedIndex)
 
 	ms.sortedTopicLSIDs = nil
 
@@ -1664,7 +2007,11 @@ func (ms *MetadataStorage) getStateMachine() (*mrpb.MetadataRepositoryDescriptor
 func (ms *MetadataStorage) createSnapshot(job *jobSnapshot) {
 	ms.logger.Info("create snapshot", zap.Uint64("index", job.appliedIndex))
 
-	b, _ := ms.origStateMachine.Marshal()
+	b, err := encodeSnapshot(ms.origStateMachine, ms.snapshotEncoding)
+	if err != nil {
+		ms.logger.Warn("could not create snapshot", zap.Uint64("index", job.appliedIndex), zap.Error(err))
+		return
+	}
 
 	ms.ssMu.Lock()
 	defer ms.ssMu.Unlock()
@@ -1694,36 +2041,49 @@ func (ms *MetadataStorage) createMetadataCache(job *jobMetadataCache) {
 	ms.mtMu.RLock()
 	defer ms.mtMu.RUnlock()
 
-	for _, sn := range ms.diffStateMachine.Metadata.StorageNodes {
+	applyMetadataDiff(cache, ms.diffStateMachine.Metadata)
+
+	ms.mcMu.Lock()
+	defer ms.mcMu.Unlock()
+
+	cache.AppliedIndex = ms.metaAppliedIndex
+	ms.setMetaCacheNoLock(cache)
+}
+
+// applyMetadataDiff applies changes kept in diff to the metadata md.
+func applyMetadataDiff(md, diff *varlogpb.MetadataDescriptor) {
+	for _, sn := range diff.StorageNodes {
 		//TODO:: UpdateStorageNode
 		if sn.Status.Deleted() {
-			cache.DeleteStorageNode(sn.StorageNodeID) //nolint:errcheck,revive // TODO:: Handle an error returned.
+			md.DeleteStorageNode(sn.StorageNodeID) //nolint:errcheck,revive // TODO:: Handle an error returned.
 		} else {
-			cache.InsertStorageNode(sn) //nolint:errcheck,revive // TODO:: Handle an error returned.
+			md.InsertStorageNode(sn) //nolint:errcheck,revive // TODO:: Handle an error returned.
 		}
 	}
 
-	for _, topic := range ms.diffStateMachine.Metadata.Topics {
+	for _, topic := range diff.Topics {
 		if topic.Status.Deleted() {
-			cache.DeleteTopic(topic.TopicID) //nolint:errcheck,revive // TODO:: Handle an error returned.
-		} else if cache.InsertTopic(topic) != nil {
-			cache.UpdateTopic(topic) //nolint:errcheck,revive // TODO:: Handle an error returned.
+			md.DeleteTopic(topic.TopicID) //nolint:errcheck,revive // TODO:: Handle an error returned.
+		} else if md.InsertTopic(topic) != nil {
+			md.UpdateTopic(topic) //nolint:errcheck,revive // TODO:: Handle an error returned.
 		}
 	}
 
-	for _, ls := range ms.diffStateMachine.Metadata.LogStreams {
+	for _, ls := range diff.LogStreams {
 		if ls.Status.Deleted() {
-			cache.DeleteLogStream(ls.LogStreamID) //nolint:errcheck,revive // TODO:: Handle an error returned.
-		} else if cache.InsertLogStream(ls) != nil {
-			cache.UpdateLogStream(ls) //nolint:errcheck,revive // TODO:: Handle an error returned.
+			md.DeleteLogStream(ls.LogStreamID) //nolint:errcheck,revive // TODO:: Handle an error returned.
+		} else if md.InsertLogStream(ls) != nil {
+			md.UpdateLogStream(ls) //nolint:errcheck,revive // TODO:: Handle an error returned.
 		}
 	}
+}
 
-	ms.mcMu.Lock()
-	defer ms.mcMu.Unlock()
-
-	cache.AppliedIndex = ms.metaAppliedIndex
+// setMetaCacheNoLock replaces the metadata cache and wakes up watchers of the
+// metadata. The caller should hold mcMu.
+func (ms *MetadataStorage) setMetaCacheNoLock(cache *varlogpb.MetadataDescriptor) {
 	ms.metaCache = cache
+	close(ms.metaCacheC)
+	ms.metaCacheC = make(chan struct{})
 }
 
 func (ms *MetadataStorage) mergeMetadata() {
@@ -1765,6 +2125,9 @@ func (ms *MetadataStorage) mergeMetadata() {
 }
 
 func (ms *MetadataStorage) mergeLogStream() {
+	ms.lsMu.Lock()
+	defer ms.lsMu.Unlock()
+
 	for lsID, lm := range ms.diffStateMachine.LogStream.UncommitReports {
 		if lm.Status.Deleted() {
 			delete(ms.origStateMachine.LogStream.UncommitReports, lsID)
@@ -1777,9 +2140,6 @@ func (ms *MetadataStorage) mergeLogStream() {
 		ms.diffStateMachine.LogStream.UncommitReports = make(map[types.LogStreamID]*mrpb.LogStreamUncommitReports)
 	}
 
-	ms.lsMu.Lock()
-	defer ms.lsMu.Unlock()
-
 	if ms.origStateMachine.LogStream.TrimVersion < ms.diffStateMachine.LogStream.TrimVersion {
 		ms.origStateMachine.LogStream.TrimVersion = ms.diffStateMachine.LogStream.TrimVersion
 	}
@@ -1815,9 +2175,14 @@ func (ms *MetadataStorage) mergePeers() {
 		ms.diffStateMachine.PeersMap.AppliedIndex,
 	)
 
+	if ms.diffStateMachine.AdminLease != nil {
+		ms.origStateMachine.AdminLease = ms.diffStateMachine.AdminLease
+	}
+
 	ms.diffStateMachine.PeersMap.AppliedIndex = 0
 	ms.diffStateMachine.PeersMap.Peers = make(map[types.NodeID]*mrpb.MetadataRepositoryDescriptor_PeerDescriptor)
 	ms.diffStateMachine.Endpoints = make(map[types.NodeID]string)
+	ms.diffStateMachine.AdminLease = nil
 }
 
 func (ms *MetadataStorage) mergeConfState() {
@@ -1845,10 +2210,43 @@ func (ms *MetadataStorage) trimLogStreamCommitHistory() {
 		i < len(s.LogStream.CommitHistory) &&
 		s.LogStream.CommitHistory[i].Version == s.LogStream.TrimVersion {
 		ms.logger.Info("trim", zap.Uint64("ver", uint64(s.LogStream.TrimVersion)))
+		ms.indexCommitHistory(s.LogStream.CommitHistory[:i-1])
 		s.LogStream.CommitHistory = s.LogStream.CommitHistory[i-1:]
 	}
 }
 
+// indexCommitHistory adds commit results that are about to be trimmed to the
+// commit index so that their GLSNs can still be looked up. It then trims the
+// commit index of each topic: indexes of deleted topics are removed, and the
+// oldest ranges are dropped if there are more than maxCommitIndexRanges.
+func (ms *MetadataStorage) indexCommitHistory(history []*mrpb.LogStreamCommitResults) {
+	s := ms.origStateMachine
+	if s.LogStream.CommitIndex == nil {
+		s.LogStream.CommitIndex = make(map[types.TopicID]*mrpb.CommitIndex)
+	}
+	for _, crs := range history {
+		for _, cr := range crs.CommitResults {
+			if cr.CommittedGLSNLength == 0 {
+				continue
+			}
+			ci, ok := s.LogStream.CommitIndex[cr.TopicID]
+			if !ok {
+				ci = &mrpb.CommitIndex{}
+				s.LogStream.CommitIndex[cr.TopicID] = ci
+			}
+			ci.Append(cr)
+		}
+	}
+
+	for topicID, ci := range s.LogStream.CommitIndex {
+		if topic := s.Metadata.GetTopic(topicID); topic == nil || topic.Status.Deleted() {
+			delete(s.LogStream.CommitIndex, topicID)
+			continue
+		}
+		ci.Trim(maxCommitIndexRanges)
+	}
+}
+
 func (ms *MetadataStorage) mergeStateMachine() {
 	if atomic.LoadInt64(&ms.nrRunning) != 0 {
 		return
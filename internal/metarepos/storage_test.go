@@ -2,6 +2,7 @@ package metarepos
 
 import (
 	"bytes"
+	"fmt"
 	"math/rand"
 	"sort"
 	"sync/atomic"
@@ -853,6 +854,144 @@ func TestStorageTrim(t *testing.T) {
 	})
 }
 
+func TestStorageLookupGLSN(t *testing.T) {
+	Convey("Given MetadataStorage having commit history", t, func(ctx C) {
+		const (
+			topicID = types.TopicID(1)
+			lsID1   = types.LogStreamID(1)
+			lsID2   = types.LogStreamID(2)
+			numVers = 128
+		)
+
+		ms := NewMetadataStorage(nil, DefaultSnapshotCount, zap.NewNop())
+		So(ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: topicID}), ShouldBeNil)
+
+		// Log stream 1 commits two log entries in every version, and log
+		// stream 2 commits one log entry in every odd version.
+		type position struct {
+			lsID types.LogStreamID
+			llsn types.LLSN
+		}
+		positions := make(map[types.GLSN]position)
+		glsn := types.MinGLSN
+		llsns := map[types.LogStreamID]types.LLSN{lsID1: types.MinLLSN, lsID2: types.MinLLSN}
+		for ver := types.MinVersion; ver <= numVers; ver++ {
+			lengths := map[types.LogStreamID]uint64{lsID1: 2, lsID2: uint64(ver % 2)}
+			crs := &mrpb.LogStreamCommitResults{Version: ver}
+			for _, lsID := range []types.LogStreamID{lsID1, lsID2} {
+				cr := snpb.LogStreamCommitResult{
+					TopicID:             topicID,
+					LogStreamID:         lsID,
+					CommittedLLSNOffset: llsns[lsID],
+					CommittedGLSNOffset: glsn,
+					CommittedGLSNLength: lengths[lsID],
+					Version:             ver,
+				}
+				for i := uint64(0); i < lengths[lsID]; i++ {
+					positions[glsn] = position{lsID: lsID, llsn: llsns[lsID]}
+					glsn++
+					llsns[lsID]++
+				}
+				crs.CommitResults = append(crs.CommitResults, cr)
+			}
+			for i := range crs.CommitResults {
+				crs.CommitResults[i].HighWatermark = glsn - 1
+			}
+			ms.AppendLogStreamCommitHistory(crs)
+		}
+
+		lookupAll := func(ms *MetadataStorage) {
+			for glsn, pos := range positions {
+				lsID, llsn, err := ms.LookupGLSN(topicID, glsn)
+				So(err, ShouldBeNil)
+				So(lsID, ShouldEqual, pos.lsID)
+				So(llsn, ShouldEqual, pos.llsn)
+			}
+
+			_, _, err := ms.LookupGLSN(topicID, glsn)
+			So(err, ShouldEqual, verrors.ErrNotExist)
+
+			_, _, err = ms.LookupGLSN(topicID+1, types.MinGLSN)
+			So(err, ShouldEqual, verrors.ErrNotExist)
+		}
+
+		Convey("Then GLSNs should be found in the commit history", func(ctx C) {
+			lookupAll(ms)
+		})
+
+		Convey("When the topic is missing from the later commit results", func(ctx C) {
+			for i := types.Version(1); i <= numVers; i++ {
+				ms.AppendLogStreamCommitHistory(&mrpb.LogStreamCommitResults{
+					Version: numVers + i,
+					CommitResults: []snpb.LogStreamCommitResult{{
+						TopicID:             topicID + 1,
+						LogStreamID:         lsID2 + 1,
+						CommittedLLSNOffset: types.MinLLSN,
+						CommittedGLSNOffset: types.MinGLSN,
+						HighWatermark:       types.InvalidGLSN,
+						Version:             numVers + i,
+					}},
+				})
+			}
+
+			Convey("Then GLSNs should be found in the commit history", func(ctx C) {
+				lookupAll(ms)
+			})
+		})
+
+		Convey("When the commit history is trimmed", func(ctx C) {
+			ms.TrimLogStreamCommitHistory(numVers / 2) //nolint:errcheck,revive // TODO:: Handle an error returned.
+			ms.trimLogStreamCommitHistory()
+			So(ms.GetFirstCommitResults().GetVersion(), ShouldEqual, numVers/2-1)
+
+			Convey("Then trimmed GLSNs should be found in the commit index", func(ctx C) {
+				lookupAll(ms)
+			})
+
+			Convey("Then the commit index should be compacted", func(ctx C) {
+				ranges := ms.origStateMachine.LogStream.CommitIndex[topicID].GetRanges()
+				So(len(ranges), ShouldBeLessThan, 2*numVers/2)
+			})
+
+			Convey("Then the commit index should survive restoring snapshot", func(ctx C) {
+				snap, err := ms.origStateMachine.Marshal()
+				So(err, ShouldBeNil)
+
+				loaded := NewMetadataStorage(nil, DefaultSnapshotCount, zap.NewNop())
+				So(loaded.ApplySnapshot(snap, &raftpb.ConfState{}, 1), ShouldBeNil)
+				lookupAll(loaded)
+			})
+
+			Convey("Then GLSNs dropped from the commit index should be reported as trimmed", func(ctx C) {
+				ci := ms.origStateMachine.LogStream.CommitIndex[topicID]
+				ci.Trim(1)
+				last := ci.Ranges[0]
+				So(ci.TrimmedGLSN, ShouldEqual, last.GLSNBegin-1)
+
+				_, _, err := ms.LookupGLSN(topicID, types.MinGLSN)
+				So(err, ShouldEqual, verrors.ErrTrimmed)
+				_, _, err = ms.LookupGLSN(topicID, ci.TrimmedGLSN)
+				So(err, ShouldEqual, verrors.ErrTrimmed)
+
+				lsID, llsn, err := ms.LookupGLSN(topicID, last.GLSNBegin)
+				So(err, ShouldBeNil)
+				So(lsID, ShouldEqual, positions[last.GLSNBegin].lsID)
+				So(llsn, ShouldEqual, positions[last.GLSNBegin].llsn)
+
+				_, _, err = ms.LookupGLSN(topicID, glsn)
+				So(err, ShouldEqual, verrors.ErrNotExist)
+			})
+
+			Convey("Then the commit index should be removed after the topic is unregistered", func(ctx C) {
+				So(ms.unregisterTopic(topicID), ShouldBeNil)
+				ms.TrimLogStreamCommitHistory(numVers) //nolint:errcheck,revive // TODO:: Handle an error returned.
+				ms.trimLogStreamCommitHistory()
+				So(ms.origStateMachine.LogStream.CommitIndex, ShouldNotContainKey, topicID)
+			})
+		})
+	})
+}
+
 func TestStorageReport(t *testing.T) {
 	Convey("storage should not apply report if not registered LS", t, func(ctx C) {
 		ms := NewMetadataStorage(nil, DefaultSnapshotCount, zap.NewNop())
@@ -1453,8 +1592,7 @@ func TestStorageSnapshot(t *testing.T) {
 			So(snap, ShouldNotBeNil)
 			So(snapIndex, ShouldEqual, appliedIndex)
 
-			u := &mrpb.MetadataRepositoryDescriptor{}
-			err = u.Unmarshal(snap)
+			u, _, err := decodeSnapshot(snap)
 			So(err, ShouldBeNil)
 
 			So(u.Metadata.GetStorageNode(snID), ShouldNotBeNil)
@@ -2046,3 +2184,204 @@ func TestStorageSortedTopicLogStreamIDs(t *testing.T) {
 		}), ShouldBeTrue)
 	})
 }
+
+func TestStorageAcquireAdminLease(t *testing.T) {
+	const lease = 10 * time.Second
+
+	ms := NewMetadataStorage(nil, DefaultSnapshotCount, zaptest.NewLogger(t))
+	require.Nil(t, ms.GetAdminLease())
+
+	// acquire
+	err := ms.AcquireAdminLease(&mrpb.AcquireAdminLease{
+		Holder:   "admin1",
+		Duration: lease,
+	}, 1, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, "admin1", ms.GetAdminLease().Holder)
+	require.Equal(t, uint64(1), ms.GetAdminLease().Epoch)
+	require.Equal(t, uint64(1), ms.GetAdminLease().AppliedIndex)
+
+	// held by another admin
+	ms.setCopyOnWrite()
+	err = ms.AcquireAdminLease(&mrpb.AcquireAdminLease{
+		Holder:   "admin2",
+		Duration: lease,
+	}, 2, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, "admin1", ms.GetAdminLease().Holder)
+
+	// renew
+	err = ms.AcquireAdminLease(&mrpb.AcquireAdminLease{
+		Holder:   "admin1",
+		Duration: lease,
+	}, 3, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, "admin1", ms.GetAdminLease().Holder)
+	require.Equal(t, uint64(3), ms.GetAdminLease().AppliedIndex)
+	require.Equal(t, uint64(1), ms.GetAdminLease().Epoch)
+
+	ms.mergeStateMachine()
+	require.False(t, ms.isCopyOnWrite())
+	require.Equal(t, "admin1", ms.GetAdminLease().Holder)
+
+	// expired, but renewed before applied
+	err = ms.AcquireAdminLease(&mrpb.AcquireAdminLease{
+		Holder:       "admin2",
+		Duration:     lease,
+		ExpiredIndex: 1,
+	}, 4, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, "admin1", ms.GetAdminLease().Holder)
+
+	// expired
+	err = ms.AcquireAdminLease(&mrpb.AcquireAdminLease{
+		Holder:       "admin2",
+		Duration:     lease,
+		ExpiredIndex: 3,
+	}, 5, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, "admin2", ms.GetAdminLease().Holder)
+	require.Equal(t, uint64(2), ms.GetAdminLease().Epoch)
+
+	// release
+	err = ms.AcquireAdminLease(&mrpb.AcquireAdminLease{
+		Holder:   "admin2",
+		Duration: 0,
+	}, 6, 0, 0)
+	require.NoError(t, err)
+	require.Zero(t, ms.GetAdminLease().Duration)
+	err = ms.AcquireAdminLease(&mrpb.AcquireAdminLease{
+		Holder:       "admin1",
+		Duration:     lease,
+		ExpiredIndex: 6,
+	}, 7, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, "admin1", ms.GetAdminLease().Holder)
+	require.Equal(t, uint64(3), ms.GetAdminLease().Epoch)
+}
+
+func TestStorageSnapshotEncoding(t *testing.T) {
+	const (
+		numLogStreams = 16
+		numVersions   = 256
+		chunkSize     = 1024
+	)
+
+	newCommitHistory := func(begin, end types.Version) []*mrpb.LogStreamCommitResults {
+		history := make([]*mrpb.LogStreamCommitResults, 0, end-begin)
+		for ver := begin; ver < end; ver++ {
+			crs := &mrpb.LogStreamCommitResults{Version: ver}
+			for i := 0; i < numLogStreams; i++ {
+				crs.CommitResults = append(crs.CommitResults, snpb.LogStreamCommitResult{
+					TopicID:             types.TopicID(1),
+					LogStreamID:         types.LogStreamID(i + 1),
+					CommittedLLSNOffset: types.LLSN(ver),
+					CommittedGLSNOffset: types.GLSN(uint64(ver)*numLogStreams + uint64(i)),
+					CommittedGLSNLength: 1,
+					HighWatermark:       types.GLSN(uint64(ver+1) * numLogStreams),
+					Version:             ver,
+				})
+			}
+			history = append(history, crs)
+		}
+		return history
+	}
+
+	ms := NewMetadataStorage(nil, DefaultSnapshotCount, zap.NewNop())
+	require.NoError(t, ms.registerStorageNode(&varlogpb.StorageNodeDescriptor{
+		StorageNode: varlogpb.StorageNode{StorageNodeID: types.StorageNodeID(1)},
+	}))
+	for _, crs := range newCommitHistory(types.MinVersion, numVersions+1) {
+		ms.AppendLogStreamCommitHistory(crs)
+	}
+	ms.mergeStateMachine()
+	sm := ms.origStateMachine
+
+	t.Run("Legacy", func(t *testing.T) {
+		data, err := sm.Marshal()
+		require.NoError(t, err)
+
+		decoded, header, err := decodeSnapshot(data)
+		require.NoError(t, err)
+		require.False(t, header.delta())
+		require.Equal(t, sm.Metadata, decoded.Metadata)
+		require.Equal(t, sm.LogStream.CommitHistory, decoded.LogStream.CommitHistory)
+
+		lastVersion, err := snapshotLastVersion(data)
+		require.NoError(t, err)
+		require.EqualValues(t, numVersions, lastVersion)
+	})
+
+	var sizes [2]int
+	for i, compress := range []bool{false, true} {
+		compress := compress
+		enc := snapshotEncoding{compress: compress, chunkSize: chunkSize}
+		data, err := encodeSnapshot(sm, enc)
+		require.NoError(t, err)
+		sizes[i] = len(data)
+
+		t.Run(fmt.Sprintf("Compress=%t", compress), func(t *testing.T) {
+			header, sections, err := parseSnapshot(data)
+			require.NoError(t, err)
+			require.Equal(t, compress, header.compressed())
+			require.EqualValues(t, types.MinVersion, header.firstVersion)
+			require.EqualValues(t, numVersions, header.lastVersion)
+			require.Greater(t, len(sections), 2)
+
+			decoded, _, err := decodeSnapshot(data)
+			require.NoError(t, err)
+			require.Equal(t, sm.Metadata, decoded.Metadata)
+			require.Equal(t, sm.LogStream.CommitHistory, decoded.LogStream.CommitHistory)
+
+			state, err := decodeSnapshotState(data)
+			require.NoError(t, err)
+			require.Equal(t, sm.Metadata, state.Metadata)
+			require.Empty(t, state.LogStream.CommitHistory)
+
+			const baseVersion = numVersions / 2
+			delta, err := makeDeltaSnapshot(data, baseVersion)
+			require.NoError(t, err)
+			require.Less(t, len(delta), len(data))
+
+			_, err = makeDeltaSnapshot(delta, baseVersion)
+			require.Error(t, err)
+
+			// The receiver having commit results up to the base version
+			// can restore the full snapshot.
+			receiver := NewMetadataStorage(nil, DefaultSnapshotCount, zap.NewNop())
+			receiver.snapshotEncoding = enc
+			for _, crs := range newCommitHistory(types.MinVersion, baseVersion+1) {
+				receiver.AppendLogStreamCommitHistory(crs)
+			}
+			resolved, err := receiver.ResolveSnapshot(delta)
+			require.NoError(t, err)
+			// Sections in the delta snapshot are kept, and omitted chunks
+			// are encoded again in the same way.
+			require.Equal(t, data, resolved)
+			decoded, header, err = decodeSnapshot(resolved)
+			require.NoError(t, err)
+			require.False(t, header.delta())
+			require.Equal(t, sm.LogStream.CommitHistory, decoded.LogStream.CommitHistory)
+			require.NoError(t, receiver.ApplySnapshot(resolved, &raftpb.ConfState{}, 1))
+			require.EqualValues(t, numVersions, receiver.GetLastCommitVersion())
+
+			// The full snapshot is resolved as it is.
+			resolved, err = receiver.ResolveSnapshot(data)
+			require.NoError(t, err)
+			require.Equal(t, data, resolved)
+
+			// The receiver lacking commit results cannot restore the
+			// full snapshot.
+			receiver = NewMetadataStorage(nil, DefaultSnapshotCount, zap.NewNop())
+			for _, crs := range newCommitHistory(types.MinVersion, baseVersion/2) {
+				receiver.AppendLogStreamCommitHistory(crs)
+			}
+			_, err = receiver.ResolveSnapshot(delta)
+			require.ErrorIs(t, err, verrors.ErrNotExist)
+
+			// The delta snapshot cannot be applied without resolving.
+			require.Error(t, receiver.ApplySnapshot(delta, &raftpb.ConfState{}, 1))
+		})
+	}
+	require.Less(t, sizes[1], sizes[0])
+}
@@ -0,0 +1,236 @@
+package mrsimulator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kakao/varlog/pkg/types"
+)
+
+const (
+	DefaultClusterID         = types.ClusterID(1)
+	DefaultNumStorageNodes   = 3
+	DefaultNumTopics         = 1
+	DefaultNumLogStreams     = 3
+	DefaultReplicationFactor = 1
+	DefaultListenHost        = "127.0.0.1"
+	DefaultAppendInterval    = 10 * time.Millisecond
+	DefaultBatchSize         = 1
+	DefaultDuration          = 1 * time.Minute
+	DefaultReportInterval    = 3 * time.Second
+	DefaultRPCTimeout        = 3 * time.Second
+)
+
+type config struct {
+	cid               types.ClusterID
+	mraddrs           []string
+	debugAddrs        []string
+	numStorageNodes   int
+	numTopics         int
+	numLogStreams     int
+	replicationFactor int
+	listenHost        string
+	appendInterval    time.Duration
+	batchSize         int
+	duration          time.Duration
+	reportInterval    time.Duration
+	rpcTimeout        time.Duration
+	reportHandler     func(Report)
+	logger            *zap.Logger
+}
+
+func newConfig(opts []Option) (config, error) {
+	cfg := config{
+		cid:               DefaultClusterID,
+		numStorageNodes:   DefaultNumStorageNodes,
+		numTopics:         DefaultNumTopics,
+		numLogStreams:     DefaultNumLogStreams,
+		replicationFactor: DefaultReplicationFactor,
+		listenHost:        DefaultListenHost,
+		appendInterval:    DefaultAppendInterval,
+		batchSize:         DefaultBatchSize,
+		duration:          DefaultDuration,
+		reportInterval:    DefaultReportInterval,
+		rpcTimeout:        DefaultRPCTimeout,
+		reportHandler:     printReport,
+		logger:            zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt.apply(&cfg)
+	}
+	if err := cfg.validate(); err != nil {
+		return config{}, err
+	}
+	return cfg, nil
+}
+
+func (cfg *config) validate() error {
+	if len(cfg.mraddrs) == 0 {
+		return errors.New("no metadata repository address")
+	}
+	if cfg.numStorageNodes < 1 {
+		return fmt.Errorf("invalid number of storage nodes: %d", cfg.numStorageNodes)
+	}
+	if cfg.numTopics < 1 {
+		return fmt.Errorf("invalid number of topics: %d", cfg.numTopics)
+	}
+	if cfg.numLogStreams < cfg.numTopics {
+		return fmt.Errorf("number of log streams %d less than number of topics %d", cfg.numLogStreams, cfg.numTopics)
+	}
+	if cfg.replicationFactor < 1 || cfg.replicationFactor > cfg.numStorageNodes {
+		return fmt.Errorf("invalid replication factor %d for %d storage nodes", cfg.replicationFactor, cfg.numStorageNodes)
+	}
+	if cfg.appendInterval <= 0 {
+		return fmt.Errorf("invalid append interval: %v", cfg.appendInterval)
+	}
+	if cfg.batchSize < 0 {
+		return fmt.Errorf("invalid batch size: %d", cfg.batchSize)
+	}
+	if cfg.reportInterval <= 0 {
+		return fmt.Errorf("invalid report interval: %v", cfg.reportInterval)
+	}
+	if cfg.reportHandler == nil {
+		return errors.New("nil report handler")
+	}
+	if cfg.logger == nil {
+		return errors.New("nil logger")
+	}
+	return nil
+}
+
+type Option interface {
+	apply(*config)
+}
+
+type funcOption struct {
+	f func(*config)
+}
+
+func newFuncOption(f func(*config)) *funcOption {
+	return &funcOption{f: f}
+}
+
+func (fo *funcOption) apply(cfg *config) {
+	fo.f(cfg)
+}
+
+func WithClusterID(cid types.ClusterID) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.cid = cid
+	})
+}
+
+// WithMetadataRepositoryAddress sets RPC addresses of the metadata repository
+// nodes to which fake storage nodes are registered.
+func WithMetadataRepositoryAddress(mraddrs ...string) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.mraddrs = mraddrs
+	})
+}
+
+// WithDebugAddress sets debug addresses of the metadata repository nodes. The
+// simulator reads memory usage of the nodes from their heap profiles. If it
+// is not set, the simulator does not report memory usage.
+func WithDebugAddress(debugAddrs ...string) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.debugAddrs = debugAddrs
+	})
+}
+
+func WithNumStorageNodes(numStorageNodes int) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.numStorageNodes = numStorageNodes
+	})
+}
+
+func WithNumTopics(numTopics int) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.numTopics = numTopics
+	})
+}
+
+// WithNumLogStreams sets the total number of log streams. They are spread
+// over topics and storage nodes in a round-robin manner.
+func WithNumLogStreams(numLogStreams int) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.numLogStreams = numLogStreams
+	})
+}
+
+// WithReplicationFactor sets the number of replicas of each log stream. It
+// should be the same as the replication factor of the metadata repository.
+func WithReplicationFactor(replicationFactor int) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.replicationFactor = replicationFactor
+	})
+}
+
+// WithListenHost sets the host on which fake storage nodes listen. It should
+// be reachable from the metadata repository.
+func WithListenHost(listenHost string) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.listenHost = listenHost
+	})
+}
+
+// WithAppendInterval sets how often every log stream appends a batch of log
+// entries.
+func WithAppendInterval(appendInterval time.Duration) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.appendInterval = appendInterval
+	})
+}
+
+// WithBatchSize sets the number of log entries appended to each log stream
+// every append interval.
+func WithBatchSize(batchSize int) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.batchSize = batchSize
+	})
+}
+
+func WithDuration(duration time.Duration) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.duration = duration
+	})
+}
+
+func WithReportInterval(reportInterval time.Duration) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.reportInterval = reportInterval
+	})
+}
+
+func WithRPCTimeout(rpcTimeout time.Duration) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.rpcTimeout = rpcTimeout
+	})
+}
+
+// WithReportHandler sets a function called with the report of every report
+// interval. By default, reports are printed to the standard output in JSON.
+func WithReportHandler(reportHandler func(Report)) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.reportHandler = reportHandler
+	})
+}
+
+func WithLogger(logger *zap.Logger) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.logger = logger
+	})
+}
+
+func printReport(rpt Report) {
+	buf, err := json.Marshal(rpt)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return
+	}
+	fmt.Println(string(buf))
+}
@@ -0,0 +1,68 @@
+package mrsimulator
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Metrics collects what fake storage nodes observe between two reports of
+// the simulator.
+type Metrics struct {
+	mu        sync.Mutex
+	reports   int64
+	commits   int64
+	committed uint64
+	latencies []time.Duration
+}
+
+func (m *Metrics) observeReport() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reports++
+}
+
+func (m *Metrics) observeCommit(committed uint64, latencies []time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.commits++
+	m.committed += committed
+	m.latencies = append(m.latencies, latencies...)
+}
+
+// Flush returns the report of metrics collected during the interval and
+// resets them.
+func (m *Metrics) Flush(interval time.Duration) Report {
+	m.mu.Lock()
+	reports, commits, committed, latencies := m.reports, m.commits, m.committed, m.latencies
+	m.reports, m.commits, m.committed, m.latencies = 0, 0, 0, nil
+	m.mu.Unlock()
+
+	var rpt Report
+	if itv := interval.Seconds(); itv > 0 {
+		rpt.ReportsPerSecond = float64(reports) / itv
+		rpt.CommitsPerSecond = float64(commits) / itv
+		rpt.CommittedLogsPerSecond = float64(committed) / itv
+	}
+	rpt.CommitLatency = newLatencyReport(latencies)
+	return rpt
+}
+
+func newLatencyReport(latencies []time.Duration) LatencyReport {
+	if len(latencies) == 0 {
+		return LatencyReport{}
+	}
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i] < latencies[j]
+	})
+	percentile := func(p float64) float64 {
+		idx := int(float64(len(latencies)-1) * p)
+		return float64(latencies[idx]) / float64(time.Millisecond)
+	}
+	return LatencyReport{
+		P50: percentile(0.5),
+		P90: percentile(0.9),
+		P99: percentile(0.99),
+		Max: percentile(1),
+	}
+}
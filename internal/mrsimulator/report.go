@@ -0,0 +1,44 @@
+package mrsimulator
+
+// LatencyReport is the distribution of latencies in milliseconds.
+type LatencyReport struct {
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P99 float64 `json:"p99"`
+	Max float64 `json:"max"`
+}
+
+// MemoryReport is the memory usage of a metadata repository node read from
+// its heap profile.
+type MemoryReport struct {
+	Address   string `json:"address"`
+	HeapInuse uint64 `json:"heapInuse"`
+	Sys       uint64 `json:"sys"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Report is what the simulator measures during a report interval.
+type Report struct {
+	// Elapsed is the time in seconds since the simulation started.
+	Elapsed float64 `json:"elapsed"`
+
+	// ReportsPerSecond is the rate of reports sent by fake storage nodes.
+	ReportsPerSecond float64 `json:"reportsPerSecond"`
+	// CommitsPerSecond is the rate of commit results received by fake
+	// storage nodes.
+	CommitsPerSecond float64 `json:"commitsPerSecond"`
+	// CommittedLogsPerSecond is the rate of log entries committed.
+	CommittedLogsPerSecond float64 `json:"committedLogsPerSecond"`
+	// CommitLatency is the latency from appending a batch of log entries to
+	// a log stream to committing all of them.
+	CommitLatency LatencyReport `json:"commitLatency"`
+
+	// RaftIndex is the last index of the raft log of the metadata
+	// repository leader.
+	RaftIndex uint64 `json:"raftIndex"`
+	// RaftEntriesPerSecond is the rate of raft entries, that is, proposals
+	// accepted by the metadata repository.
+	RaftEntriesPerSecond float64 `json:"raftEntriesPerSecond"`
+
+	Memory []MemoryReport `json:"memory,omitempty"`
+}
@@ -0,0 +1,348 @@
+package mrsimulator
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/kakao/varlog/pkg/mrc"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/mrpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// Simulator puts load on a metadata repository cluster by using fake storage
+// nodes. Fake storage nodes serve only reports and commits, thus, the
+// simulator can register far more storage nodes and log streams than a
+// machine can run real storage nodes.
+type Simulator struct {
+	config
+	logStreams   []*logStream
+	storageNodes []*storageNode
+	metrics      Metrics
+
+	mcl  mrc.MetadataRepositoryClient
+	mmcl mrc.MetadataRepositoryManagementClient
+}
+
+// New creates fake storage nodes listening on the configured host. Users must
+// call Close to release resources if it returns successfully.
+func New(opts ...Option) (sim *Simulator, err error) {
+	cfg, err := newConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	sim = &Simulator{config: cfg}
+	defer func() {
+		if err != nil {
+			_ = sim.Close()
+		}
+	}()
+
+	sim.logStreams = make([]*logStream, cfg.numLogStreams)
+	replicas := make([][]*logStream, cfg.numStorageNodes)
+	for i := range sim.logStreams {
+		tpid := types.MinTopicID + types.TopicID(i%cfg.numTopics)
+		lsid := types.MinLogStreamID + types.LogStreamID(i)
+		ls := newLogStream(tpid, lsid)
+		sim.logStreams[i] = ls
+		for _, idx := range sim.replicaIndexes(i) {
+			replicas[idx] = append(replicas[idx], ls)
+		}
+	}
+
+	for i := 0; i < cfg.numStorageNodes; i++ {
+		snid := types.MinStorageNodeID + types.StorageNodeID(i)
+		sn, err := newStorageNode(snid, cfg.listenHost, replicas[i], &sim.metrics, cfg.logger)
+		if err != nil {
+			return sim, err
+		}
+		sim.storageNodes = append(sim.storageNodes, sn)
+	}
+	return sim, nil
+}
+
+// replicaIndexes returns indexes of storage nodes having replicas of the i-th
+// log stream.
+func (sim *Simulator) replicaIndexes(i int) []int {
+	idxs := make([]int, sim.replicationFactor)
+	for r := range idxs {
+		idxs[r] = (i + r) % sim.numStorageNodes
+	}
+	return idxs
+}
+
+// Run registers fake storage nodes, topics and log streams to the metadata
+// repository, and then appends log entries to the log streams until the
+// duration elapses or the context is canceled. The metadata repository should
+// be dedicated to the simulation since the simulator does not unregister
+// them.
+func (sim *Simulator) Run(ctx context.Context) error {
+	if err := sim.connect(ctx); err != nil {
+		return err
+	}
+	if err := sim.register(ctx); err != nil {
+		return err
+	}
+
+	// Reports query the metadata repository with the parent context so that
+	// the report at the end of the duration is not canceled.
+	runCtx, cancel := context.WithTimeout(ctx, sim.duration)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		sim.appendLoop(runCtx)
+	}()
+	defer wg.Wait()
+
+	reportTick := time.NewTicker(sim.reportInterval)
+	defer reportTick.Stop()
+
+	startTime := time.Now()
+	lastTime := startTime
+	lastRaftIndex := sim.raftIndex(ctx)
+	for {
+		select {
+		case <-runCtx.Done():
+			return nil
+		case now := <-reportTick.C:
+			rpt := sim.metrics.Flush(now.Sub(lastTime))
+			rpt.Elapsed = now.Sub(startTime).Seconds()
+			rpt.RaftIndex = sim.raftIndex(ctx)
+			if rpt.RaftIndex >= lastRaftIndex {
+				rpt.RaftEntriesPerSecond = float64(rpt.RaftIndex-lastRaftIndex) / now.Sub(lastTime).Seconds()
+			}
+			rpt.Memory = sim.memory(ctx)
+			sim.reportHandler(rpt)
+			lastTime, lastRaftIndex = now, rpt.RaftIndex
+		}
+	}
+}
+
+func (sim *Simulator) connect(ctx context.Context) (err error) {
+	for _, addr := range sim.mraddrs {
+		var errConn error
+		func() {
+			ctx, cancel := context.WithTimeout(ctx, sim.rpcTimeout)
+			defer cancel()
+			sim.mcl, errConn = mrc.NewMetadataRepositoryClient(ctx, addr)
+			if errConn != nil {
+				return
+			}
+			sim.mmcl, errConn = mrc.NewMetadataRepositoryManagementClient(ctx, addr)
+			if errConn != nil {
+				_ = sim.mcl.Close()
+				sim.mcl = nil
+			}
+		}()
+		if errConn == nil {
+			return nil
+		}
+		err = multierr.Append(err, fmt.Errorf("%s: %w", addr, errConn))
+	}
+	return err
+}
+
+func (sim *Simulator) register(ctx context.Context) error {
+	call := func(f func(context.Context) error) error {
+		ctx, cancel := context.WithTimeout(ctx, sim.rpcTimeout)
+		defer cancel()
+		return f(ctx)
+	}
+
+	for _, sn := range sim.storageNodes {
+		snd := sn.descriptor()
+		if err := call(func(ctx context.Context) error {
+			return sim.mcl.RegisterStorageNode(ctx, snd)
+		}); err != nil {
+			return fmt.Errorf("register storage node %d: %w", snd.StorageNodeID, err)
+		}
+	}
+
+	for i := 0; i < sim.numTopics; i++ {
+		tpid := types.MinTopicID + types.TopicID(i)
+		if err := call(func(ctx context.Context) error {
+			return sim.mcl.RegisterTopic(ctx, &varlogpb.TopicDescriptor{TopicID: tpid})
+		}); err != nil {
+			return fmt.Errorf("register topic %d: %w", tpid, err)
+		}
+	}
+
+	for i, ls := range sim.logStreams {
+		lsd := &varlogpb.LogStreamDescriptor{
+			TopicID:     ls.tpid,
+			LogStreamID: ls.lsid,
+			Status:      varlogpb.LogStreamStatusRunning,
+		}
+		for _, idx := range sim.replicaIndexes(i) {
+			lsd.Replicas = append(lsd.Replicas, &varlogpb.ReplicaDescriptor{
+				StorageNodeID:   sim.storageNodes[idx].snid,
+				StorageNodePath: fakeStoragePath,
+			})
+		}
+		if err := call(func(ctx context.Context) error {
+			return sim.mcl.RegisterLogStream(ctx, lsd)
+		}); err != nil {
+			return fmt.Errorf("register log stream %d: %w", lsd.LogStreamID, err)
+		}
+	}
+
+	sim.logger.Info("registered",
+		zap.Int("storage nodes", len(sim.storageNodes)),
+		zap.Int("topics", sim.numTopics),
+		zap.Int("log streams", len(sim.logStreams)),
+	)
+	return nil
+}
+
+func (sim *Simulator) appendLoop(ctx context.Context) {
+	tick := time.NewTicker(sim.appendInterval)
+	defer tick.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-tick.C:
+			for i, ls := range sim.logStreams {
+				ls.append(sim.batchSize, now)
+				for _, idx := range sim.replicaIndexes(i) {
+					sim.storageNodes[idx].append(ls.lsid, sim.batchSize)
+				}
+			}
+			for _, sn := range sim.storageNodes {
+				sn.notify()
+			}
+		}
+	}
+}
+
+// raftIndex returns the last index of the raft log. Since only the leader
+// knows the progress of replication, it asks the leader if the connected
+// node is a follower. It returns zero if it fails.
+func (sim *Simulator) raftIndex(ctx context.Context) uint64 {
+	ctx, cancel := context.WithTimeout(ctx, sim.rpcTimeout)
+	defer cancel()
+
+	rsp, err := sim.mmcl.GetClusterInfo(ctx, sim.cid)
+	if err != nil {
+		sim.logger.Debug("could not get cluster info", zap.Error(err))
+		return 0
+	}
+	ci := rsp.GetClusterInfo()
+	if idx := lastRaftIndex(ci); idx > 0 {
+		return idx
+	}
+
+	leader, ok := ci.GetMembers()[ci.GetLeader()]
+	if !ok || leader == nil || len(leader.GetEndpoint()) == 0 {
+		return 0
+	}
+	mmcl, err := mrc.NewMetadataRepositoryManagementClient(ctx, leader.GetEndpoint())
+	if err != nil {
+		sim.logger.Debug("could not connect to leader", zap.Error(err))
+		return 0
+	}
+	defer func() {
+		_ = mmcl.Close()
+	}()
+	rsp, err = mmcl.GetClusterInfo(ctx, sim.cid)
+	if err != nil {
+		sim.logger.Debug("could not get cluster info from leader", zap.Error(err))
+		return 0
+	}
+	return lastRaftIndex(rsp.GetClusterInfo())
+}
+
+func lastRaftIndex(ci *mrpb.ClusterInfo) uint64 {
+	var idx uint64
+	for _, member := range ci.GetMembers() {
+		if member.GetMatchIndex() > idx {
+			idx = member.GetMatchIndex()
+		}
+	}
+	return idx
+}
+
+// memory reads memory usage of metadata repository nodes from heap profiles
+// served by their debug servers.
+func (sim *Simulator) memory(ctx context.Context) []MemoryReport {
+	if len(sim.debugAddrs) == 0 {
+		return nil
+	}
+	rpts := make([]MemoryReport, len(sim.debugAddrs))
+	for i, addr := range sim.debugAddrs {
+		rpts[i].Address = addr
+		if err := readMemStats(ctx, addr, sim.rpcTimeout, &rpts[i]); err != nil {
+			rpts[i].Error = err.Error()
+		}
+	}
+	return rpts
+}
+
+func readMemStats(ctx context.Context, addr string, timeout time.Duration, rpt *MemoryReport) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	url := "http://" + addr + "/debug/pprof/heap?debug=1"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = rsp.Body.Close()
+	}()
+	if rsp.StatusCode != http.StatusOK {
+		return fmt.Errorf("heap profile: %s", rsp.Status)
+	}
+
+	// The heap profile in the legacy text format ends with runtime.MemStats,
+	// for instance, "# HeapInuse = 1234".
+	scanner := bufio.NewScanner(rsp.Body)
+	for scanner.Scan() {
+		name, value, ok := strings.Cut(strings.TrimPrefix(scanner.Text(), "# "), " = ")
+		if !ok {
+			continue
+		}
+		switch name {
+		case "HeapInuse":
+			rpt.HeapInuse, err = strconv.ParseUint(value, 10, 64)
+		case "Sys":
+			rpt.Sys, err = strconv.ParseUint(value, 10, 64)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// Close stops fake storage nodes and closes connections to the metadata
+// repository.
+func (sim *Simulator) Close() (err error) {
+	for _, sn := range sim.storageNodes {
+		sn.close()
+	}
+	if sim.mcl != nil {
+		err = multierr.Append(err, sim.mcl.Close())
+	}
+	if sim.mmcl != nil {
+		err = multierr.Append(err, sim.mmcl.Close())
+	}
+	return err
+}
@@ -0,0 +1,178 @@
+package mrsimulator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/kakao/varlog/internal/metarepos"
+	"github.com/kakao/varlog/pkg/rpc"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/util/testutil/ports"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/vtesting"
+)
+
+func TestLogStream(t *testing.T) {
+	ls := newLogStream(types.MinTopicID, types.MinLogStreamID)
+	now := time.Now()
+
+	ls.append(2, now)
+	ls.append(3, now.Add(time.Millisecond))
+
+	// Only the first batch is committed.
+	cr := snpb.LogStreamCommitResult{
+		LogStreamID:         types.MinLogStreamID,
+		TopicID:             types.MinTopicID,
+		CommittedLLSNOffset: types.MinLLSN,
+		CommittedGLSNOffset: types.MinGLSN,
+		CommittedGLSNLength: 2,
+		Version:             1,
+		HighWatermark:       2,
+	}
+	committed, latencies := ls.commit(cr, now.Add(10*time.Millisecond))
+	require.EqualValues(t, 2, committed)
+	require.Equal(t, []time.Duration{10 * time.Millisecond}, latencies)
+
+	// The same commit result applied to another replica is ignored.
+	committed, latencies = ls.commit(cr, now.Add(10*time.Millisecond))
+	require.Zero(t, committed)
+	require.Empty(t, latencies)
+
+	committed, latencies = ls.commit(snpb.LogStreamCommitResult{
+		LogStreamID:         types.MinLogStreamID,
+		TopicID:             types.MinTopicID,
+		CommittedLLSNOffset: types.MinLLSN + 2,
+		CommittedGLSNOffset: types.MinGLSN + 2,
+		CommittedGLSNLength: 3,
+		Version:             2,
+		HighWatermark:       5,
+	}, now.Add(20*time.Millisecond))
+	require.EqualValues(t, 3, committed)
+	require.Equal(t, []time.Duration{19 * time.Millisecond}, latencies)
+}
+
+func TestStorageNode(t *testing.T) {
+	var metrics Metrics
+	ls := newLogStream(types.MinTopicID, types.MinLogStreamID)
+	sn, err := newStorageNode(types.MinStorageNodeID, "127.0.0.1", []*logStream{ls}, &metrics, zap.NewNop())
+	require.NoError(t, err)
+	defer sn.close()
+
+	ls.append(5, time.Now())
+	sn.append(ls.lsid, 5)
+	rsp, err := sn.dummy.GetReport()
+	require.NoError(t, err)
+	require.Equal(t, []snpb.LogStreamUncommitReport{{
+		LogStreamID:           types.MinLogStreamID,
+		UncommittedLLSNOffset: types.MinLLSN,
+		UncommittedLLSNLength: 5,
+	}}, rsp.UncommitReports)
+
+	require.NoError(t, sn.dummy.Commit(snpb.CommitRequest{
+		StorageNodeID: sn.snid,
+		CommitResult: snpb.LogStreamCommitResult{
+			LogStreamID:         types.MinLogStreamID,
+			TopicID:             types.MinTopicID,
+			CommittedLLSNOffset: types.MinLLSN,
+			CommittedGLSNOffset: types.MinGLSN,
+			CommittedGLSNLength: 5,
+			Version:             1,
+			HighWatermark:       5,
+		},
+	}))
+	rsp, err = sn.dummy.GetReport()
+	require.NoError(t, err)
+	require.Equal(t, []snpb.LogStreamUncommitReport{{
+		LogStreamID:           types.MinLogStreamID,
+		Version:               1,
+		UncommittedLLSNOffset: types.MinLLSN + 5,
+	}}, rsp.UncommitReports)
+
+	rpt := metrics.Flush(time.Second)
+	require.EqualValues(t, 5, rpt.CommittedLogsPerSecond)
+}
+
+func TestSimulator(t *testing.T) {
+	portLease, err := ports.ReserveWeaklyWithRetry(10000)
+	require.NoError(t, err)
+	defer func() {
+		_ = portLease.Release()
+	}()
+
+	const replicationFactor = 2
+	rpcAddr := fmt.Sprintf("127.0.0.1:%d", portLease.Base()+1)
+	mr := metarepos.NewRaftMetadataRepository(
+		metarepos.WithClusterID(DefaultClusterID),
+		metarepos.WithReplicationFactor(replicationFactor),
+		metarepos.WithRPCTimeout(time.Second),
+		metarepos.WithRPCAddress(rpcAddr),
+		metarepos.WithRaftAddress(fmt.Sprintf("http://127.0.0.1:%d", portLease.Base())),
+		metarepos.WithDebugAddress(fmt.Sprintf("127.0.0.1:%d", portLease.Base()+2)),
+		metarepos.WithRaftDirectory(t.TempDir()),
+		metarepos.WithRaftTick(vtesting.TestRaftTick()),
+	)
+	mr.Run()
+	defer func() {
+		assert.NoError(t, mr.Close())
+	}()
+	require.Eventually(t, func() bool {
+		conn, err := rpc.NewConn(context.Background(), rpcAddr)
+		if err != nil {
+			return false
+		}
+		defer func() {
+			_ = conn.Close()
+		}()
+		rsp, err := grpc_health_v1.NewHealthClient(conn.Conn).Check(
+			context.Background(), &grpc_health_v1.HealthCheckRequest{},
+		)
+		return err == nil && rsp.GetStatus() == grpc_health_v1.HealthCheckResponse_SERVING
+	}, 10*time.Second, 100*time.Millisecond)
+
+	var rpts []Report
+	sim, err := New(
+		WithMetadataRepositoryAddress(rpcAddr),
+		WithDebugAddress(fmt.Sprintf("127.0.0.1:%d", portLease.Base()+2)),
+		WithNumStorageNodes(3),
+		WithNumTopics(2),
+		WithNumLogStreams(4),
+		WithReplicationFactor(replicationFactor),
+		WithDuration(3*time.Second),
+		WithReportInterval(time.Second),
+		WithReportHandler(func(rpt Report) {
+			rpts = append(rpts, rpt)
+		}),
+	)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, sim.Close())
+	}()
+
+	require.NoError(t, sim.Run(context.Background()))
+	require.NotEmpty(t, rpts)
+
+	last := rpts[len(rpts)-1]
+	require.Positive(t, last.ReportsPerSecond)
+	require.Positive(t, last.CommittedLogsPerSecond)
+	require.Positive(t, last.CommitLatency.Max)
+	require.Positive(t, last.RaftIndex)
+	require.Len(t, last.Memory, 1)
+	require.Empty(t, last.Memory[0].Error)
+	require.Positive(t, last.Memory[0].HeapInuse)
+}
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m,
+		goleak.IgnoreTopFunction(
+			"go.etcd.io/etcd/pkg/logutil.(*MergeLogger).outputLoop",
+		),
+	)
+}
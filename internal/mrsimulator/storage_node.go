@@ -0,0 +1,280 @@
+package mrsimulator
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/kakao/varlog/internal/metarepos"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// fakeStoragePath is the path of storage registered for fake storage nodes.
+// Fake storage nodes store nothing.
+const fakeStoragePath = "/fake"
+
+// appendBatch is a batch of log entries appended to a log stream, but not
+// committed yet.
+type appendBatch struct {
+	end        types.LLSN
+	appendTime time.Time
+}
+
+// logStream tracks batches appended to a log stream to measure their commit
+// latencies. Replicas of a log stream share it, whereas each fake storage
+// node keeps the uncommitted log entries of its replicas.
+type logStream struct {
+	tpid types.TopicID
+	lsid types.LogStreamID
+
+	mu             sync.Mutex
+	version        types.Version
+	uncommittedEnd types.LLSN
+	batches        []appendBatch
+}
+
+func newLogStream(tpid types.TopicID, lsid types.LogStreamID) *logStream {
+	return &logStream{
+		tpid:           tpid,
+		lsid:           lsid,
+		uncommittedEnd: types.MinLLSN,
+	}
+}
+
+func (ls *logStream) append(n int, now time.Time) {
+	if n <= 0 {
+		return
+	}
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	ls.uncommittedEnd += types.LLSN(n)
+	ls.batches = append(ls.batches, appendBatch{
+		end:        ls.uncommittedEnd,
+		appendTime: now,
+	})
+}
+
+// commit observes the commit result applied to a replica of the log stream.
+// It returns the number of log entries newly committed and the latencies of
+// batches completely committed. Since every replica applies the same commit
+// results, only the first commit result of each version is counted.
+func (ls *logStream) commit(cr snpb.LogStreamCommitResult, now time.Time) (committed uint64, latencies []time.Duration) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	if cr.Version <= ls.version {
+		return 0, nil
+	}
+	ls.version = cr.Version
+
+	committedEnd := cr.CommittedLLSNOffset + types.LLSN(cr.CommittedGLSNLength)
+	i := 0
+	for ; i < len(ls.batches) && ls.batches[i].end <= committedEnd; i++ {
+		latencies = append(latencies, now.Sub(ls.batches[i].appendTime))
+	}
+	ls.batches = ls.batches[i:]
+	return cr.CommittedGLSNLength, latencies
+}
+
+// storageNode is a fake storage node that serves only the LogStreamReporter
+// service over metarepos.DummyStorageNodeClient. It reports log entries
+// appended by the simulator, and applies commit results sent by the metadata
+// repository.
+type storageNode struct {
+	snid    types.StorageNodeID
+	address string
+	dummy   *metarepos.DummyStorageNodeClient
+	lsMap   map[types.LogStreamID]*logStream
+	metrics *Metrics
+	// changedC wakes up the stream watching reports. The metadata repository
+	// watches reports of a storage node through only one stream at a time.
+	changedC chan struct{}
+
+	lis    net.Listener
+	server *grpc.Server
+	wg     sync.WaitGroup
+	logger *zap.Logger
+}
+
+var _ snpb.LogStreamReporterServer = (*storageNode)(nil)
+
+func newStorageNode(snid types.StorageNodeID, host string, logStreams []*logStream, metrics *Metrics, logger *zap.Logger) (*storageNode, error) {
+	lis, err := net.Listen("tcp", net.JoinHostPort(host, "0"))
+	if err != nil {
+		return nil, fmt.Errorf("storage node %d: %w", snid, err)
+	}
+
+	lsids := make([]types.LogStreamID, 0, len(logStreams))
+	for _, ls := range logStreams {
+		lsids = append(lsids, ls.lsid)
+	}
+
+	sn := &storageNode{
+		snid:     snid,
+		address:  lis.Addr().String(),
+		dummy:    metarepos.NewDummyStorageNodeClient(snid, lsids),
+		lsMap:    make(map[types.LogStreamID]*logStream, len(logStreams)),
+		metrics:  metrics,
+		changedC: make(chan struct{}, 1),
+		lis:      lis,
+		server:   grpc.NewServer(),
+		logger:   logger.Named("storage node").With(zap.Int32("snid", int32(snid))),
+	}
+	for _, ls := range logStreams {
+		sn.lsMap[ls.lsid] = ls
+	}
+	sn.dummy.SetCommitHook(sn.observeCommit)
+	snpb.RegisterLogStreamReporterServer(sn.server, sn)
+
+	sn.wg.Add(1)
+	go func() {
+		defer sn.wg.Done()
+		if err := sn.server.Serve(sn.lis); err != nil {
+			sn.logger.Warn("stopped serving", zap.Error(err))
+		}
+	}()
+	return sn, nil
+}
+
+func (sn *storageNode) descriptor() *varlogpb.StorageNodeDescriptor {
+	return &varlogpb.StorageNodeDescriptor{
+		StorageNode: varlogpb.StorageNode{
+			StorageNodeID: sn.snid,
+			Address:       sn.address,
+		},
+		Status: varlogpb.StorageNodeStatusRunning,
+		Paths:  []string{fakeStoragePath},
+	}
+}
+
+// append appends n log entries to the replica of the log stream.
+func (sn *storageNode) append(lsid types.LogStreamID, n int) {
+	if n <= 0 {
+		return
+	}
+	sn.dummy.AppendUncommitted(lsid, uint64(n))
+}
+
+func (sn *storageNode) GetReport(stream snpb.LogStreamReporter_GetReportServer) error {
+	req := &snpb.GetReportRequest{}
+	for {
+		err := stream.RecvMsg(req)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		rsp, err := sn.dummy.GetReport()
+		if err != nil {
+			return err
+		}
+		if err := stream.SendMsg(rsp); err != nil {
+			return err
+		}
+		sn.metrics.observeReport()
+	}
+}
+
+func (sn *storageNode) WatchReport(req *snpb.WatchReportRequest, stream snpb.LogStreamReporter_WatchReportServer) error {
+	heartbeatInterval := req.HeartbeatInterval
+	if heartbeatInterval <= 0 {
+		heartbeatInterval = time.Second
+	}
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		rsp, err := sn.dummy.GetReport()
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(rsp); err != nil {
+			return err
+		}
+		sn.metrics.observeReport()
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-sn.changedC:
+		case <-heartbeat.C:
+		}
+	}
+}
+
+// notify wakes up the stream watching reports.
+func (sn *storageNode) notify() {
+	select {
+	case sn.changedC <- struct{}{}:
+	default:
+	}
+}
+
+func (sn *storageNode) Commit(stream snpb.LogStreamReporter_CommitServer) (err error) {
+	defer func() {
+		err = multierr.Append(err, stream.SendAndClose(&snpb.CommitResponse{}))
+	}()
+
+	req := &snpb.CommitRequest{}
+	for {
+		err = stream.RecvMsg(req)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = sn.dummy.Commit(*req); err != nil {
+			return err
+		}
+	}
+}
+
+func (sn *storageNode) CommitBatch(stream snpb.LogStreamReporter_CommitBatchServer) (err error) {
+	defer func() {
+		err = multierr.Append(err, stream.SendAndClose(&snpb.CommitBatchResponse{}))
+	}()
+
+	req := &snpb.CommitBatchRequest{}
+	for {
+		err = stream.RecvMsg(req)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = sn.dummy.CommitBatch(*req); err != nil {
+			return err
+		}
+	}
+}
+
+// observeCommit is called whenever the dummy client applies a commit result.
+func (sn *storageNode) observeCommit(cr snpb.LogStreamCommitResult) {
+	ls, ok := sn.lsMap[cr.LogStreamID]
+	if !ok {
+		return
+	}
+	committed, latencies := ls.commit(cr, time.Now())
+	sn.metrics.observeCommit(committed, latencies)
+	if cr.CommittedGLSNLength > 0 {
+		sn.notify()
+	}
+}
+
+func (sn *storageNode) close() {
+	sn.server.Stop()
+	sn.wg.Wait()
+	_ = sn.dummy.Close()
+}
@@ -6,9 +6,12 @@ import (
 	"context"
 	"io"
 	"sync"
+	"time"
 
 	"go.uber.org/multierr"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/kakao/varlog/pkg/rpc"
 	"github.com/kakao/varlog/proto/snpb"
@@ -34,6 +37,11 @@ type client struct {
 	muReportStream sync.Mutex
 	getReportReq   snpb.GetReportRequest
 
+	// watchStream receives reports pushed by the storage node. If it is
+	// nil, reports are pulled by reportStream.
+	watchStream snpb.LogStreamReporter_WatchReportClient
+	watchCancel context.CancelFunc
+
 	commitStream      snpb.LogStreamReporter_CommitClient
 	commitBatchStream snpb.LogStreamReporter_CommitBatchClient
 	muCommitStream    sync.Mutex
@@ -55,6 +63,39 @@ func NewClient(ctx context.Context, address string, grpcDialOptions ...grpc.Dial
 	return cl, err
 }
 
+// NewWatchClient creates a client whose GetReport returns reports pushed by the
+// storage node rather than pulling them. The storage node sends reports only
+// when they change or heartbeatInterval elapses. If the storage node does not
+// support pushing reports, the client falls back to pulling them.
+func NewWatchClient(ctx context.Context, address string, heartbeatInterval time.Duration, grpcDialOptions ...grpc.DialOption) (cl Client, err error) {
+	rpcConn, err := rpc.NewConn(ctx, address, grpcDialOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			err = multierr.Append(err, rpcConn.Close())
+		}
+	}()
+
+	cl, err = NewClientWithConn(context.Background(), rpcConn)
+	if err != nil {
+		return nil, err
+	}
+	c := cl.(*client)
+	watchCtx, watchCancel := context.WithCancel(context.Background())
+	c.watchStream, err = c.rpcClient.WatchReport(watchCtx, &snpb.WatchReportRequest{
+		HeartbeatInterval: heartbeatInterval,
+	})
+	if err != nil {
+		watchCancel()
+		return nil, err
+	}
+	c.watchCancel = watchCancel
+	return c, nil
+}
+
 // Clients connecting the same SN can use this method to multiplex streams. By doing that, it can
 // decrease the use of channel buffer.
 func NewClientWithConn(ctx context.Context, rpcConn *rpc.Conn) (Client, error) {
@@ -91,6 +132,14 @@ func (c *client) GetReport() (*snpb.GetReportResponse, error) {
 	c.muReportStream.Lock()
 	defer c.muReportStream.Unlock()
 
+	if c.watchStream != nil {
+		rsp, err := c.watchStream.Recv()
+		if status.Code(err) != codes.Unimplemented {
+			return rsp, err
+		}
+		c.watchStream = nil
+	}
+
 	if err := c.reportStream.Send(&c.getReportReq); err != nil {
 		return nil, err
 	}
@@ -130,5 +179,8 @@ func (c *client) CommitBatch(cr snpb.CommitBatchRequest) (err error) {
 }
 
 func (c *client) Close() error {
+	if c.watchCancel != nil {
+		c.watchCancel()
+	}
 	return c.rpcConn.Close()
 }
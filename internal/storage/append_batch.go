@@ -5,6 +5,7 @@ import (
 
 	"github.com/cockroachdb/pebble"
 
+	"github.com/kakao/varlog/internal/storage/encryption"
 	"github.com/kakao/varlog/pkg/types"
 )
 
@@ -14,36 +15,53 @@ var appendBatchPool = sync.Pool{
 			dk: make([]byte, dataKeyLength),
 			ck: make([]byte, commitKeyLength),
 			cc: make([]byte, commitContextLength),
+			rk: make([]byte, recordKeyLength),
 		}
 	},
 }
 
 // AppendBatch is a batch to put one or more log entries.
 type AppendBatch struct {
-	batch     *pebble.Batch
+	batch     *prefixedBatch
 	writeOpts *pebble.WriteOptions
+	keyIndex  bool
+	keyring   *encryption.Keyring
+	buf       []byte
 	dk        []byte
 	ck        []byte
 	cc        []byte
+	rk        []byte
 }
 
-func newAppendBatch(batch *pebble.Batch, writeOpts *pebble.WriteOptions) *AppendBatch {
+func newAppendBatch(batch *prefixedBatch, writeOpts *pebble.WriteOptions, keyIndex bool, keyring *encryption.Keyring) *AppendBatch {
 	ab := appendBatchPool.Get().(*AppendBatch)
 	ab.batch = batch
 	ab.writeOpts = writeOpts
+	ab.keyIndex = keyIndex
+	ab.keyring = keyring
 	return ab
 }
 
 func (ab *AppendBatch) release() {
 	ab.batch = nil
 	ab.writeOpts = nil
+	ab.keyIndex = false
+	ab.keyring = nil
 	appendBatchPool.Put(ab)
 }
 
-// SetLogEntry inserts a log entry.
-func (ab *AppendBatch) SetLogEntry(llsn types.LLSN, glsn types.GLSN, data []byte) error {
+// SetLogEntry inserts a log entry. The data is encrypted if the storage is
+// encrypted.
+func (ab *AppendBatch) SetLogEntry(llsn types.LLSN, glsn types.GLSN, data []byte) (err error) {
 	dk := encodeDataKeyInternal(llsn, ab.dk)
 	ck := encodeCommitKeyInternal(glsn, ab.ck)
+	data, err = encryptValue(ab.keyring, ab.buf, data, dk)
+	if err != nil {
+		return err
+	}
+	if ab.keyring != nil {
+		ab.buf = data
+	}
 	if err := ab.batch.Set(dk, data, nil); err != nil {
 		return err
 	}
@@ -53,6 +71,30 @@ func (ab *AppendBatch) SetLogEntry(llsn types.LLSN, glsn types.GLSN, data []byte
 	return nil
 }
 
+// SetCompactedLogEntry inserts a commit of the log entry removed by
+// compaction. It has no data.
+func (ab *AppendBatch) SetCompactedLogEntry(llsn types.LLSN, glsn types.GLSN) error {
+	ck := encodeCommitKeyInternal(glsn, ab.ck)
+	return ab.batch.Set(ck, encodeCompactedDataKeyInternal(llsn, ab.dk), nil)
+}
+
+// SetKey inserts the record key of the log entry and indexes it. Since the
+// log entry is already committed, the key index is updated immediately. It
+// does nothing if the key index is disabled.
+func (ab *AppendBatch) SetKey(llsn types.LLSN, glsn types.GLSN, key []byte) error {
+	if !ab.keyIndex {
+		return nil
+	}
+	rk := encodeRecordKeyInternal(llsn, ab.rk)
+	if len(key) == 0 {
+		return ab.batch.Delete(rk, nil)
+	}
+	if err := ab.batch.Set(rk, key, nil); err != nil {
+		return err
+	}
+	return ab.batch.Set(encodeKeyIndexKey(key, glsn), encodeDataKeyInternal(llsn, ab.dk), nil)
+}
+
 // SetCommitContext inserts a commit context.
 func (ab *AppendBatch) SetCommitContext(cc CommitContext) error {
 	return ab.batch.Set(commitContextKey, encodeCommitContext(cc, ab.cc), nil)
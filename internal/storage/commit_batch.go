@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"errors"
 	"sync"
 
 	"github.com/cockroachdb/pebble"
@@ -14,33 +15,59 @@ var commitBatchPool = sync.Pool{
 			cc: make([]byte, commitContextLength),
 			ck: make([]byte, commitKeyLength),
 			dk: make([]byte, dataKeyLength),
+			rk: make([]byte, recordKeyLength),
 		}
 	},
 }
 
 type CommitBatch struct {
-	batch     *pebble.Batch
+	batch     *prefixedBatch
 	writeOpts *pebble.WriteOptions
-	cc        []byte
-	ck        []byte
-	dk        []byte
+	// db is used to read record keys if the key index is enabled.
+	// Otherwise, it is nil.
+	db *prefixedDB
+	cc []byte
+	ck []byte
+	dk []byte
+	rk []byte
 }
 
-func newCommitBatch(batch *pebble.Batch, writeOpts *pebble.WriteOptions) *CommitBatch {
+func newCommitBatch(batch *prefixedBatch, writeOpts *pebble.WriteOptions, db *prefixedDB) *CommitBatch {
 	cb := commitBatchPool.Get().(*CommitBatch)
 	cb.batch = batch
 	cb.writeOpts = writeOpts
+	cb.db = db
 	return cb
 }
 
 func (cb *CommitBatch) release() {
 	cb.batch = nil
 	cb.writeOpts = nil
+	cb.db = nil
 	commitBatchPool.Put(cb)
 }
 
+// Set commits the log entry at the llsn with the glsn. If the key index is
+// enabled and the log entry has a record key, it also indexes the key.
 func (cb *CommitBatch) Set(llsn types.LLSN, glsn types.GLSN) error {
-	return cb.batch.Set(encodeCommitKeyInternal(glsn, cb.ck), encodeDataKeyInternal(llsn, cb.dk), nil)
+	dk := encodeDataKeyInternal(llsn, cb.dk)
+	if err := cb.batch.Set(encodeCommitKeyInternal(glsn, cb.ck), dk, nil); err != nil {
+		return err
+	}
+	if cb.db == nil {
+		return nil
+	}
+	key, closer, err := cb.db.Get(encodeRecordKeyInternal(llsn, cb.rk))
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	defer func() {
+		_ = closer.Close()
+	}()
+	return cb.batch.Set(encodeKeyIndexKey(key, glsn), dk, nil)
 }
 
 func (cb *CommitBatch) Apply() error {
@@ -0,0 +1,137 @@
+package storage
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/kakao/varlog/pkg/types"
+)
+
+// compactionBatchSize is the size of a batch that compaction writes at once.
+const compactionBatchSize = 4 << 20
+
+// compactionScanLimit is the number of entries of the key index that a call
+// of Compact scans at most, excluding those of the last record key.
+var compactionScanLimit = 1 << 16
+
+// Compact removes log entries superseded by newer log entries having the same
+// record key. Only log entries whose GLSNs are less than or equal to the
+// argument glsn are considered; hence, a log entry is removed only if a newer
+// one of the same record key is also within the range. The latest log entry
+// of each record key always survives, and it is also true for a tombstone,
+// which is a log entry having a record key but no data.
+//
+// Compaction keeps the commits of removed log entries, but marks them as
+// compacted. Scanning by GLSN returns them with only GLSN and LLSN, so that
+// readers can tell a gap made by compaction from a log entry not arrived yet.
+// Scanning by LLSN skips them.
+//
+// A call of Compact handles a bounded part of the key index that starts from
+// the argument begin, which is a record key, or from the start if it is nil.
+// It returns the record key to resume from, or nil if it reaches the end of
+// the key index. Callers can release their locks between calls.
+//
+// It returns the number of removed log entries. It needs the key index, and
+// it must not run concurrently with Trim.
+func (s *Storage) Compact(glsn types.GLSN, begin []byte) (numCompacted int, next []byte, err error) {
+	if !s.keyIndex {
+		return 0, nil, ErrNoKeyIndex
+	}
+	if s.readOnly {
+		return 0, nil, errors.New("storage: read-only")
+	}
+
+	lower := []byte{keyIndexPrefix}
+	if len(begin) > 0 {
+		lower = encodeKeyIndexPrefix(begin)
+	}
+	it := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: lower,
+		UpperBound: []byte{keyIndexPrefix + 1},
+	})
+	defer func() {
+		_ = it.Close()
+	}()
+
+	batch := s.db.NewBatch()
+	defer func() {
+		_ = batch.Close()
+	}()
+
+	var prevKey, prevValue, prevPrefix []byte
+	numScanned := 0
+	for it.First(); it.Valid(); it.Next() {
+		key := it.Key()
+		prefix := key[:len(key)-types.GLSNLen]
+		if !bytes.Equal(prevPrefix, prefix) {
+			if numScanned >= compactionScanLimit {
+				next = append([]byte(nil), prefix[keyIndexKeyLength-types.GLSNLen:]...)
+				break
+			}
+			prevPrefix = append(prevPrefix[:0], prefix...)
+			prevKey = prevKey[:0]
+		}
+		numScanned++
+
+		if decodeKeyIndexKey(key) > glsn {
+			continue
+		}
+		if len(prevKey) > 0 {
+			compacted, err := s.compactLogEntry(batch, prevKey, prevValue)
+			if err != nil {
+				return numCompacted, nil, err
+			}
+			if compacted {
+				numCompacted++
+			}
+		}
+		prevKey = append(prevKey[:0], key...)
+		prevValue = append(prevValue[:0], it.Value()...)
+
+		if batch.Len() < compactionBatchSize {
+			continue
+		}
+		if err := batch.Commit(s.writeOpts); err != nil {
+			return numCompacted, nil, err
+		}
+		batch.Reset()
+	}
+	if err := it.Error(); err != nil {
+		return numCompacted, nil, err
+	}
+	return numCompacted, next, batch.Commit(s.writeOpts)
+}
+
+// compactLogEntry removes the data and the record key of the log entry
+// indexed by the key index entry, and marks its commit as compacted. If the
+// log entry was already trimmed, it removes only the key index entry. It
+// returns true if it removes the log entry.
+func (s *Storage) compactLogEntry(batch *prefixedBatch, indexKey, dataKey []byte) (bool, error) {
+	if err := batch.Delete(indexKey, nil); err != nil {
+		return false, err
+	}
+
+	_, closer, err := s.db.Get(dataKey)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	_ = closer.Close()
+
+	llsn := decodeDataKey(dataKey)
+	ck := encodeCommitKeyInternal(decodeKeyIndexKey(indexKey), make([]byte, commitKeyLength))
+	if err := batch.Set(ck, encodeCompactedDataKeyInternal(llsn, make([]byte, dataKeyLength)), nil); err != nil {
+		return false, err
+	}
+	if err := batch.Delete(dataKey, nil); err != nil {
+		return false, err
+	}
+	if err := batch.Delete(encodeRecordKeyInternal(llsn, make([]byte, recordKeyLength)), nil); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/kakao/varlog/internal/storage/encryption"
 	"github.com/kakao/varlog/pkg/types"
 )
 
@@ -33,7 +34,15 @@ type config struct {
 	verbose                     bool
 	logger                      *zap.Logger
 
-	readOnly bool
+	readOnly  bool
+	keyIndex  bool
+	compacted bool
+
+	keyProvider encryption.KeyProvider
+
+	sharedDB *SharedDB
+	tpid     types.TopicID
+	lsid     types.LogStreamID
 }
 
 func newConfig(opts []Option) (config, error) {
@@ -68,6 +77,9 @@ func (cfg config) validate() error {
 	if cfg.sync && !cfg.wal {
 		return errors.New("storage: sync, but wal disabled")
 	}
+	if cfg.sharedDB != nil && cfg.readOnly {
+		return errors.New("storage: read-only shared db")
+	}
 	return nil
 }
 
@@ -167,6 +179,56 @@ func ReadOnly() Option {
 	})
 }
 
+// WithKeyIndex makes the storage maintain the key index, which maps record
+// keys to GLSNs of log entries. Record keys are written with log entries, and
+// the index is updated when they are committed. A storage created with the
+// key index keeps it even if it is opened without this option, and the option
+// fails to open a storage having data written without the key index.
+func WithKeyIndex() Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.keyIndex = true
+	})
+}
+
+// WithCompaction makes the storage maintain the key index as WithKeyIndex
+// does, and records that its log entries are compacted so that the owner of
+// the storage can compact it again after reopening it. It is recorded only
+// when the key index is enabled for the first time.
+func WithCompaction() Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.keyIndex = true
+		cfg.compacted = true
+	})
+}
+
+// WithEncryption makes the storage encrypt data of log entries by using
+// envelope encryption. A new storage creates a keyring having its data key
+// wrapped by the key provider kp in its path, and an encrypted storage cannot
+// be opened without a key provider that can unwrap it. An existing storage
+// not encrypted is left as it is.
+//
+// Only data of log entries are encrypted, and each of them is bound to its
+// LLSN. Record keys are not encrypted since the key index needs them in the
+// keys of the database to look up log entries, and neither are metadata such
+// as commits, GLSNs and LLSNs.
+func WithEncryption(kp encryption.KeyProvider) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.keyProvider = kp
+	})
+}
+
+// WithSharedDB makes the storage keep its data in the shared database sdb
+// under the prefix of the topic tpid and log stream lsid. Options for pebble,
+// such as WithMemTableSize, are ignored since the shared database has its own
+// options.
+func WithSharedDB(sdb *SharedDB, tpid types.TopicID, lsid types.LogStreamID) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.sharedDB = sdb
+		cfg.tpid = tpid
+		cfg.lsid = lsid
+	})
+}
+
 /*
 func errInvalidLevelOptions(kv string, err error) error {
 	return fmt.Errorf("storage: level options: invalid option %s: %w", kv, err)
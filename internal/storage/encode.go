@@ -12,15 +12,47 @@ const (
 	dataKeySentinelPrefix = byte('e')
 	dataKeyLength         = 9 // prefix(1) + LLSN(8)
 
+	// compactedDataKeyPrefix replaces the prefix of the data key in the
+	// value of a commit whose data was removed by compaction.
+	compactedDataKeyPrefix = byte('x')
+
 	commitKeyPrefix         = byte('c')
 	commitKeySentinelPrefix = byte('d')
 	commitKeyLength         = 9 // prefix(1) + GLSN(8)
 
 	commitContextKeyMarker = byte('b')
 	commitContextLength    = 40
+
+	logStreamKeyPrefix       = byte('l')
+	logStreamKeyPrefixLength = 9 // prefix(1) + TopicID(4) + LogStreamID(4)
+
+	recordKeyPrefix = byte('k')
+	recordKeyLength = 9 // prefix(1) + LLSN(8)
+
+	keyIndexPrefix = byte('i')
+	// keyIndexMarker is the key telling that the storage maintains the key
+	// index. Its value is keyIndexCompacted if the storage is compacted.
+	keyIndexMarker    = byte('m')
+	keyIndexCompacted = byte('c')
+	// keyIndexKeyLength is the length of the key of the key index excluding
+	// the record key.
+	keyIndexKeyLength = 13 // prefix(1) + length of record key(4) + GLSN(8)
 )
 
-var commitContextKey = []byte{commitContextKeyMarker}
+var (
+	commitContextKey = []byte{commitContextKeyMarker}
+	keyIndexKey      = []byte{keyIndexMarker}
+)
+
+// encodeLogStreamKeyPrefix returns the prefix of keys of the log stream in a
+// shared database.
+func encodeLogStreamKeyPrefix(tpid types.TopicID, lsid types.LogStreamID) []byte {
+	prefix := make([]byte, logStreamKeyPrefixLength)
+	prefix[0] = logStreamKeyPrefix
+	binary.BigEndian.PutUint32(prefix[1:5], uint32(tpid))
+	binary.BigEndian.PutUint32(prefix[5:], uint32(lsid))
+	return prefix
+}
 
 func encodeDataKeyInternal(llsn types.LLSN, key []byte) []byte {
 	key[0] = dataKeyPrefix
@@ -35,6 +67,21 @@ func decodeDataKey(k []byte) types.LLSN {
 	return types.LLSN(binary.BigEndian.Uint64(k[1:]))
 }
 
+func encodeCompactedDataKeyInternal(llsn types.LLSN, key []byte) []byte {
+	key[0] = compactedDataKeyPrefix
+	binary.BigEndian.PutUint64(key[1:], uint64(llsn))
+	return key
+}
+
+// decodeCommitValue returns the LLSN of the value of a commit. The argument
+// compacted is true if the data of the commit was removed by compaction.
+func decodeCommitValue(v []byte) (llsn types.LLSN, compacted bool) {
+	if len(v) == dataKeyLength && v[0] == compactedDataKeyPrefix {
+		return types.LLSN(binary.BigEndian.Uint64(v[1:])), true
+	}
+	return decodeDataKey(v), false
+}
+
 func encodeCommitKeyInternal(glsn types.GLSN, key []byte) []byte {
 	key[0] = commitKeyPrefix
 	binary.BigEndian.PutUint64(key[1:], uint64(glsn))
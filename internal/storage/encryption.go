@@ -0,0 +1,142 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/kakao/varlog/internal/storage/encryption"
+)
+
+var (
+	// ErrNoKeyProvider is returned when an encrypted storage is opened
+	// without the option WithEncryption.
+	ErrNoKeyProvider = errors.New("storage: encrypted, but no key provider")
+
+	// ErrNotEncrypted is returned when rotating the data key of a storage
+	// that is not encrypted.
+	ErrNotEncrypted = errors.New("storage: not encrypted")
+)
+
+// initEncryption opens the keyring of the storage if it exists. Otherwise, it
+// creates a keyring only if the storage has no data yet, so that a storage is
+// either encrypted entirely or not at all. If the key provider has rotated the
+// key-encryption key, the data keys are wrapped again by the new one.
+func (s *Storage) initEncryption() error {
+	ctx := context.Background()
+
+	exists, err := encryption.KeyringExists(s.path)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if s.keyProvider == nil {
+			return ErrNoKeyProvider
+		}
+		kr, err := encryption.OpenKeyring(ctx, s.path, s.keyProvider)
+		if err != nil {
+			return err
+		}
+		if !s.readOnly {
+			rewrapped, err := kr.Rewrap(ctx)
+			if err != nil {
+				return err
+			}
+			if rewrapped {
+				s.logger.Info("rewrapped data keys with the current key-encryption key")
+			}
+		}
+		s.keyring = kr
+		return nil
+	}
+
+	if s.keyProvider == nil || s.readOnly {
+		return nil
+	}
+	empty, err := s.empty()
+	if err != nil {
+		return err
+	}
+	if !empty {
+		s.logger.Warn("storage not encrypted since it has data written without encryption", zap.String("path", s.path))
+		return nil
+	}
+	kr, err := encryption.CreateKeyring(ctx, s.path, s.keyProvider)
+	if err != nil {
+		return err
+	}
+	s.keyring = kr
+	return nil
+}
+
+// empty returns true if the storage has no data at all.
+func (s *Storage) empty() (bool, error) {
+	it := s.db.NewIter(nil)
+	defer func() {
+		_ = it.Close()
+	}()
+	if it.First() {
+		return false, nil
+	}
+	return true, it.Error()
+}
+
+// Encrypted returns true if the storage encrypts data of log entries.
+func (s *Storage) Encrypted() bool {
+	return s.keyring != nil
+}
+
+// RotateDataKey generates a new data key to encrypt data of log entries
+// written after it. Data written before are still readable since the keyring
+// keeps the previous data keys. Before that, it wraps the data keys again if
+// the key provider has rotated the key-encryption key, so that the previous
+// one can be retired without reopening the storage. It is safe to call while
+// the storage is in use. It returns the identifier of the new data key.
+func (s *Storage) RotateDataKey(ctx context.Context) (uint32, error) {
+	if s.keyring == nil {
+		return 0, ErrNotEncrypted
+	}
+	if s.readOnly {
+		return 0, errors.New("storage: read-only")
+	}
+	rewrapped, err := s.keyring.Rewrap(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if rewrapped {
+		s.logger.Info("rewrapped data keys with the current key-encryption key")
+	}
+	return s.keyring.Rotate(ctx)
+}
+
+// encryptValue returns the value to be written for the data of a log entry
+// at the data key dk. The data key, which has the LLSN of the log entry, is
+// authenticated together, so that the value cannot be moved to another log
+// entry without being detected. It returns the data as it is if the storage
+// is not encrypted.
+func encryptValue(kr *encryption.Keyring, buf, data, dk []byte) ([]byte, error) {
+	if kr == nil {
+		return data, nil
+	}
+	return kr.Encrypt(buf[:0], data, dk)
+}
+
+// decryptValue returns a copy of the data of a log entry read from the data
+// key dk of the storage. It returns nil if the data is empty.
+func (s *Storage) decryptValue(value, dk []byte) ([]byte, error) {
+	if s.keyring == nil {
+		if len(value) == 0 {
+			return nil, nil
+		}
+		return append([]byte(nil), value...), nil
+	}
+	data, err := s.keyring.Decrypt(nil, value, dk)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return data, nil
+}
@@ -0,0 +1,215 @@
+package encryption
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyfileProvider(t *testing.T) {
+	tcs := []struct {
+		name    string
+		content string
+	}{
+		{name: "Empty", content: ""},
+		{name: "OnlyComments", content: "# comment\n\n"},
+		{name: "Malformed", content: "kek1\n"},
+		{name: "NotBase64", content: "kek1 !!!\n"},
+		{name: "InvalidKeySize", content: "kek1 AAAA\n"},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "keyfile")
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), keyfileMode))
+			_, err := NewKeyfileProvider(path)
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("NoKeyfile", func(t *testing.T) {
+		_, err := NewKeyfileProvider(filepath.Join(t.TempDir(), "keyfile"))
+		require.Error(t, err)
+	})
+
+	t.Run("WrapUnwrap", func(t *testing.T) {
+		ctx := context.Background()
+		path := filepath.Join(t.TempDir(), "keyfile")
+		require.NoError(t, GenerateKEK(path, "kek1"))
+		require.Error(t, GenerateKEK(path, "kek1"))
+		require.Error(t, GenerateKEK(path, "invalid id"))
+
+		kp1, err := NewKeyfileProvider(path)
+		require.NoError(t, err)
+		kekID, err := kp1.CurrentKEKID(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "kek1", kekID)
+
+		dataKey := []byte("0123456789abcdef0123456789abcdef")
+		wk, err := kp1.WrapKey(ctx, dataKey)
+		require.NoError(t, err)
+		require.Equal(t, "kek1", wk.KEKID)
+		require.NotContains(t, string(wk.Ciphertext), string(dataKey))
+
+		// rotate kek
+		require.NoError(t, GenerateKEK(path, "kek2"))
+		kp2, err := NewKeyfileProvider(path)
+		require.NoError(t, err)
+		kekID, err = kp2.CurrentKEKID(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "kek2", kekID)
+
+		unwrapped, err := kp2.UnwrapKey(ctx, wk)
+		require.NoError(t, err)
+		require.Equal(t, dataKey, unwrapped)
+
+		_, err = kp2.UnwrapKey(ctx, WrappedKey{KEKID: "kek3", Ciphertext: wk.Ciphertext})
+		require.ErrorIs(t, err, ErrUnknownKEK)
+
+		_, err = kp2.UnwrapKey(ctx, WrappedKey{KEKID: "kek2", Ciphertext: wk.Ciphertext})
+		require.Error(t, err)
+
+		// reload rotated kek
+		require.NoError(t, kp1.Reload())
+		kekID, err = kp1.CurrentKEKID(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "kek2", kekID)
+
+		// A failed reload keeps the loaded keks.
+		require.NoError(t, os.WriteFile(path, []byte("malformed\n"), keyfileMode))
+		require.Error(t, kp1.Reload())
+		unwrapped, err = kp1.UnwrapKey(ctx, wk)
+		require.NoError(t, err)
+		require.Equal(t, dataKey, unwrapped)
+	})
+}
+
+func TestKeyring(t *testing.T) {
+	ctx := context.Background()
+	keyfile := filepath.Join(t.TempDir(), "keyfile")
+	require.NoError(t, GenerateKEK(keyfile, "kek1"))
+	kp, err := NewKeyfileProvider(keyfile)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	exists, err := KeyringExists(dir)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	_, err = OpenKeyring(ctx, dir, kp)
+	require.Error(t, err)
+
+	kr, err := CreateKeyring(ctx, dir, kp)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, kr.ActiveKeyID())
+
+	exists, err = KeyringExists(dir)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	_, err = CreateKeyring(ctx, dir, kp)
+	require.Error(t, err)
+
+	plaintext := []byte("plaintext")
+	ad := []byte("key")
+	encrypted1, err := kr.Encrypt(nil, plaintext, ad)
+	require.NoError(t, err)
+	require.NotContains(t, string(encrypted1), string(plaintext))
+
+	encrypted2, err := kr.Encrypt(nil, plaintext, ad)
+	require.NoError(t, err)
+	require.NotEqual(t, encrypted1, encrypted2)
+
+	empty, err := kr.Encrypt(nil, nil, ad)
+	require.NoError(t, err)
+	decrypted, err := kr.Decrypt(nil, empty, ad)
+	require.NoError(t, err)
+	require.Empty(t, decrypted)
+
+	// rotate data key
+	id, err := kr.Rotate(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, id)
+	encrypted3, err := kr.Encrypt([]byte("prefix"), plaintext, ad)
+	require.NoError(t, err)
+	require.Equal(t, "prefix", string(encrypted3[:6]))
+	encrypted3 = encrypted3[6:]
+
+	// rotate kek, then reopen
+	require.NoError(t, GenerateKEK(keyfile, "kek2"))
+	kp, err = NewKeyfileProvider(keyfile)
+	require.NoError(t, err)
+	kr, err = OpenKeyring(ctx, dir, kp)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, kr.ActiveKeyID())
+
+	for _, encrypted := range [][]byte{encrypted1, encrypted2, encrypted3} {
+		decrypted, err := kr.Decrypt(nil, encrypted, ad)
+		require.NoError(t, err)
+		require.Equal(t, plaintext, decrypted)
+	}
+
+	tampered := append([]byte(nil), encrypted3...)
+	tampered[len(tampered)-1] ^= 0xff
+	_, err = kr.Decrypt(nil, tampered, ad)
+	require.Error(t, err)
+
+	unknown := append([]byte(nil), encrypted3...)
+	unknown[1+keyIDLength-1] = 0xff
+	_, err = kr.Decrypt(nil, unknown, ad)
+	require.True(t, errors.Is(err, ErrUnknownDataKey))
+
+	_, err = kr.Decrypt(nil, []byte("short"), ad)
+	require.Error(t, err)
+
+	// The value is bound to its associated data.
+	_, err = kr.Decrypt(nil, encrypted3, []byte("another key"))
+	require.Error(t, err)
+	_, err = kr.Decrypt(nil, encrypted3, nil)
+	require.Error(t, err)
+
+	// Values of the first format authenticate only the header.
+	v1 := make([]byte, valueHeaderLength)
+	v1[0] = valueFormatVersionV1
+	binary.BigEndian.PutUint32(v1[1:], kr.ActiveKeyID())
+	v1 = kr.active.Seal(v1, v1[1+keyIDLength:], plaintext, v1[:1+keyIDLength])
+	decrypted, err = kr.Decrypt(nil, v1, ad)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, decrypted)
+
+	// rewrap data keys with the new kek, then retire the old kek
+	rewrapped, err := kr.Rewrap(ctx)
+	require.NoError(t, err)
+	require.True(t, rewrapped)
+	rewrapped, err = kr.Rewrap(ctx)
+	require.NoError(t, err)
+	require.False(t, rewrapped)
+
+	buf, err := os.ReadFile(keyfile)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(buf)), "\n")
+	require.Len(t, lines, 2)
+	require.NoError(t, os.WriteFile(keyfile, []byte(lines[1]+"\n"), keyfileMode))
+	kp, err = NewKeyfileProvider(keyfile)
+	require.NoError(t, err)
+	kr, err = OpenKeyring(ctx, dir, kp)
+	require.NoError(t, err)
+	decrypted, err = kr.Decrypt(nil, encrypted1, ad)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, decrypted)
+
+	// copy keyring
+	copied := t.TempDir()
+	require.NoError(t, kr.Save(copied))
+	kr, err = OpenKeyring(ctx, copied, kp)
+	require.NoError(t, err)
+	decrypted, err = kr.Decrypt(nil, encrypted3, ad)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, decrypted)
+}
@@ -0,0 +1,203 @@
+package encryption
+
+import (
+	"bufio"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+const (
+	// keySize is the size of both key-encryption keys and data keys. Keys
+	// are used by AES-256.
+	keySize = 32
+
+	keyfileMode = os.FileMode(0600)
+)
+
+// KeyfileProvider is a KeyProvider that keeps key-encryption keys in a local
+// file. Each line of the file has the identifier of a key-encryption key and
+// the base64-encoded key separated by whitespace. The last line is the
+// current key-encryption key; hence, appending a new line rotates it, while
+// the previous lines still unwrap data keys wrapped before the rotation.
+// Empty lines and lines starting with '#' are ignored.
+//
+// The keyfile should be readable only by the owner of the storage node.
+//
+// Reload reads the keyfile again, thus, a key-encryption key appended to the
+// keyfile can be made current without restarting the storage node.
+type KeyfileProvider struct {
+	path string
+
+	mu      sync.RWMutex
+	keks    map[string]cipher.AEAD
+	current string
+}
+
+var (
+	_ KeyProvider = (*KeyfileProvider)(nil)
+	_ Reloader    = (*KeyfileProvider)(nil)
+)
+
+// NewKeyfileProvider loads key-encryption keys from the keyfile at the path.
+func NewKeyfileProvider(path string) (*KeyfileProvider, error) {
+	kp := &KeyfileProvider{path: path}
+	if err := kp.Reload(); err != nil {
+		return nil, err
+	}
+	return kp, nil
+}
+
+// Reload reads the key-encryption keys from the keyfile again. The keys
+// loaded before are kept if it fails.
+func (kp *KeyfileProvider) Reload() error {
+	keks, current, err := readKeyfile(kp.path)
+	if err != nil {
+		return err
+	}
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	kp.keks, kp.current = keks, current
+	return nil
+}
+
+func readKeyfile(path string) (keks map[string]cipher.AEAD, current string, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	keks = make(map[string]cipher.AEAD)
+	scanner := bufio.NewScanner(f)
+	for lineno := 1; scanner.Scan(); lineno++ {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, "", fmt.Errorf("encryption: keyfile %s: line %d: malformed", path, lineno)
+		}
+		id, encoded := fields[0], fields[1]
+		if _, ok := keks[id]; ok {
+			return nil, "", fmt.Errorf("encryption: keyfile %s: line %d: duplicated kek %s", path, lineno, id)
+		}
+		kek, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, "", fmt.Errorf("encryption: keyfile %s: line %d: %w", path, lineno, err)
+		}
+		aead, err := newAEAD(kek)
+		if err != nil {
+			return nil, "", fmt.Errorf("encryption: keyfile %s: line %d: %w", path, lineno, err)
+		}
+		keks[id] = aead
+		current = id
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, "", err
+	}
+	if len(current) == 0 {
+		return nil, "", fmt.Errorf("encryption: keyfile %s: no kek", path)
+	}
+	return keks, current, nil
+}
+
+// GenerateKEK appends a new random key-encryption key identified by the
+// argument id to the keyfile at the path, which makes it current. The
+// keyfile is created if it does not exist.
+func GenerateKEK(path, id string) error {
+	if len(id) == 0 || strings.ContainsAny(id, " \t\r\n") || strings.HasPrefix(id, "#") {
+		return fmt.Errorf("encryption: invalid kek id %q", id)
+	}
+	if keks, _, err := readKeyfile(path); err == nil {
+		if _, ok := keks[id]; ok {
+			return fmt.Errorf("encryption: keyfile %s: duplicated kek %s", path, id)
+		}
+	}
+
+	kek := make([]byte, keySize)
+	if _, err := io.ReadFull(rand.Reader, kek); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, keyfileMode)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(f, "%s %s\n", id, base64.StdEncoding.EncodeToString(kek))
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Path returns the path to the keyfile.
+func (kp *KeyfileProvider) Path() string {
+	return kp.path
+}
+
+func (kp *KeyfileProvider) CurrentKEKID(context.Context) (string, error) {
+	kp.mu.RLock()
+	defer kp.mu.RUnlock()
+	return kp.current, nil
+}
+
+func (kp *KeyfileProvider) WrapKey(_ context.Context, dataKey []byte) (WrappedKey, error) {
+	kp.mu.RLock()
+	current := kp.current
+	aead := kp.keks[current]
+	kp.mu.RUnlock()
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(dataKey)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return WrappedKey{}, err
+	}
+	return WrappedKey{
+		KEKID:      current,
+		Ciphertext: aead.Seal(nonce, nonce, dataKey, []byte(current)),
+	}, nil
+}
+
+func (kp *KeyfileProvider) UnwrapKey(_ context.Context, wk WrappedKey) ([]byte, error) {
+	kp.mu.RLock()
+	aead, ok := kp.keks[wk.KEKID]
+	kp.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKEK, wk.KEKID)
+	}
+	if len(wk.Ciphertext) < aead.NonceSize() {
+		return nil, errors.New("encryption: malformed wrapped key")
+	}
+	nonce, ciphertext := wk.Ciphertext[:aead.NonceSize()], wk.Ciphertext[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, ciphertext, []byte(wk.KEKID))
+	if err != nil {
+		return nil, fmt.Errorf("encryption: unwrap key: %w", err)
+	}
+	return dataKey, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != keySize {
+		return nil, fmt.Errorf("encryption: invalid key size %d, expected %d", len(key), keySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,349 @@
+package encryption
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	// KeyringFileName is the name of the file keeping wrapped data keys in
+	// a data directory.
+	KeyringFileName = "KEYRING"
+
+	keyringFileMode = os.FileMode(0600)
+
+	// valueFormatVersionV1 is the format of values whose additional
+	// authenticated data is only the header. It is still decrypted, but
+	// new values are written in valueFormatVersion.
+	valueFormatVersionV1 = byte(1)
+	// valueFormatVersion is the format of values whose additional
+	// authenticated data also has the associated data given by the caller,
+	// for instance, the storage key of the value.
+	valueFormatVersion = byte(2)
+	keyIDLength        = 4
+	nonceLength        = 12
+	valueHeaderLength  = 1 + keyIDLength + nonceLength
+)
+
+// keyringFile is the persistent form of a keyring.
+type keyringFile struct {
+	ActiveKeyID uint32         `json:"activeKeyId"`
+	Keys        []keyringEntry `json:"keys"`
+}
+
+type keyringEntry struct {
+	ID         uint32     `json:"id"`
+	WrappedKey WrappedKey `json:"wrappedKey"`
+}
+
+// Keyring keeps the data keys of a data directory. A value is encrypted by the
+// active data key with AES-256-GCM, and the encrypted value has the
+// identifier of the data key; thus, values encrypted by data keys retired by
+// rotation can still be decrypted. The header and the associated data given
+// by the caller are authenticated together with the value, so that an
+// encrypted value moved to another storage key fails to be decrypted. The
+// encrypted value is formatted as below:
+//
+//	+---------+--------+-------+------------+-----+
+//	| version | key id | nonce | ciphertext | tag |
+//	+---------+--------+-------+------------+-----+
+//	|    1    |   4    |  12   |    ...     | 16  |
+//	+---------+--------+-------+------------+-----+
+//
+// A keyring is safe for concurrent use.
+type Keyring struct {
+	dir      string
+	provider KeyProvider
+
+	mu     sync.RWMutex
+	file   keyringFile
+	aeads  map[uint32]cipher.AEAD
+	active cipher.AEAD
+}
+
+// KeyringExists returns true if the directory dir has a keyring file.
+func KeyringExists(dir string) (bool, error) {
+	_, err := os.Stat(filepath.Join(dir, KeyringFileName))
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	return false, err
+}
+
+// CreateKeyring generates a data key wrapped by the provider and saves it to
+// a new keyring file in the directory dir.
+func CreateKeyring(ctx context.Context, dir string, provider KeyProvider) (*Keyring, error) {
+	if exists, err := KeyringExists(dir); err != nil {
+		return nil, err
+	} else if exists {
+		return nil, fmt.Errorf("encryption: keyring already exists in %s", dir)
+	}
+
+	kr := &Keyring{
+		dir:      dir,
+		provider: provider,
+		aeads:    make(map[uint32]cipher.AEAD),
+	}
+	if _, err := kr.Rotate(ctx); err != nil {
+		return nil, err
+	}
+	return kr, nil
+}
+
+// OpenKeyring loads the keyring file in the directory dir and unwraps its data
+// keys by using the provider.
+func OpenKeyring(ctx context.Context, dir string, provider KeyProvider) (*Keyring, error) {
+	buf, err := os.ReadFile(filepath.Join(dir, KeyringFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	kr := &Keyring{
+		dir:      dir,
+		provider: provider,
+		aeads:    make(map[uint32]cipher.AEAD),
+	}
+	if err := json.Unmarshal(buf, &kr.file); err != nil {
+		return nil, fmt.Errorf("encryption: keyring %s: %w", dir, err)
+	}
+	for _, entry := range kr.file.Keys {
+		dataKey, err := provider.UnwrapKey(ctx, entry.WrappedKey)
+		if err != nil {
+			return nil, fmt.Errorf("encryption: keyring %s: data key %d: %w", dir, entry.ID, err)
+		}
+		aead, err := newAEAD(dataKey)
+		if err != nil {
+			return nil, fmt.Errorf("encryption: keyring %s: data key %d: %w", dir, entry.ID, err)
+		}
+		kr.aeads[entry.ID] = aead
+	}
+	active, ok := kr.aeads[kr.file.ActiveKeyID]
+	if !ok {
+		return nil, fmt.Errorf("encryption: keyring %s: %w: active %d", dir, ErrUnknownDataKey, kr.file.ActiveKeyID)
+	}
+	kr.active = active
+	return kr, nil
+}
+
+// ActiveKeyID returns the identifier of the data key encrypting new values.
+func (kr *Keyring) ActiveKeyID() uint32 {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+	return kr.file.ActiveKeyID
+}
+
+// Rotate generates a new data key and makes it active. Values encrypted by the
+// previous data keys are not re-encrypted, and they can still be decrypted.
+// It returns the identifier of the new data key.
+func (kr *Keyring) Rotate(ctx context.Context) (uint32, error) {
+	dataKey := make([]byte, keySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return 0, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return 0, err
+	}
+	wk, err := kr.provider.WrapKey(ctx, dataKey)
+	if err != nil {
+		return 0, err
+	}
+
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	var id uint32
+	for _, entry := range kr.file.Keys {
+		if entry.ID > id {
+			id = entry.ID
+		}
+	}
+	id++
+
+	file := kr.file
+	file.ActiveKeyID = id
+	file.Keys = append(append([]keyringEntry(nil), kr.file.Keys...), keyringEntry{ID: id, WrappedKey: wk})
+	if err := saveKeyringFile(kr.dir, file); err != nil {
+		return 0, err
+	}
+	kr.file = file
+	kr.aeads[id] = aead
+	kr.active = aead
+	return id, nil
+}
+
+// Rewrap wraps again the data keys wrapped by key-encryption keys other than
+// the current one of the provider. It is necessary after rotating the
+// key-encryption key so that the previous one can be retired. It returns true
+// if any data keys are wrapped again.
+func (kr *Keyring) Rewrap(ctx context.Context) (bool, error) {
+	kekID, err := kr.provider.CurrentKEKID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	file := kr.file
+	file.Keys = append([]keyringEntry(nil), kr.file.Keys...)
+	rewrapped := false
+	for i := range file.Keys {
+		entry := &file.Keys[i]
+		if entry.WrappedKey.KEKID == kekID {
+			continue
+		}
+		dataKey, err := kr.provider.UnwrapKey(ctx, entry.WrappedKey)
+		if err != nil {
+			return false, err
+		}
+		entry.WrappedKey, err = kr.provider.WrapKey(ctx, dataKey)
+		if err != nil {
+			return false, err
+		}
+		rewrapped = true
+	}
+	if !rewrapped {
+		return false, nil
+	}
+	if err := saveKeyringFile(kr.dir, file); err != nil {
+		return false, err
+	}
+	kr.file = file
+	return true, nil
+}
+
+// Save writes the keyring file to the directory dir. It is used to copy the
+// keyring, for instance, into a checkpoint.
+func (kr *Keyring) Save(dir string) error {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+	return saveKeyringFile(dir, kr.file)
+}
+
+// Encrypt appends the plaintext encrypted by the active data key to dst and
+// returns the extended buffer. The associated data ad is authenticated but
+// not stored, thus, the same one must be given to Decrypt.
+func (kr *Keyring) Encrypt(dst, plaintext, ad []byte) ([]byte, error) {
+	kr.mu.RLock()
+	id, aead := kr.file.ActiveKeyID, kr.active
+	kr.mu.RUnlock()
+
+	offset := len(dst)
+	size := valueHeaderLength + len(plaintext) + aead.Overhead()
+	if cap(dst)-offset < size {
+		buf := make([]byte, offset, offset+size)
+		copy(buf, dst)
+		dst = buf
+	}
+	dst = dst[:offset+valueHeaderLength]
+	header := dst[offset:]
+	header[0] = valueFormatVersion
+	binary.BigEndian.PutUint32(header[1:], id)
+	nonce := header[1+keyIDLength:]
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(dst, nonce, plaintext, additionalData(header[:1+keyIDLength], ad)), nil
+}
+
+// Decrypt appends the plaintext of the encrypted value to dst and returns the
+// extended buffer. The associated data ad must be the same as the one given
+// to Encrypt. It returns ErrUnknownDataKey if the keyring does not have the
+// data key that encrypted the value.
+func (kr *Keyring) Decrypt(dst, value, ad []byte) ([]byte, error) {
+	if len(value) < valueHeaderLength {
+		return nil, errors.New("encryption: malformed value")
+	}
+	switch value[0] {
+	case valueFormatVersion:
+	case valueFormatVersionV1:
+		ad = nil
+	default:
+		return nil, fmt.Errorf("encryption: unknown value format %d", value[0])
+	}
+	id := binary.BigEndian.Uint32(value[1:])
+
+	kr.mu.RLock()
+	aead, ok := kr.aeads[id]
+	kr.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownDataKey, id)
+	}
+
+	nonce := value[1+keyIDLength : valueHeaderLength]
+	plaintext, err := aead.Open(dst, nonce, value[valueHeaderLength:], additionalData(value[:1+keyIDLength], ad))
+	if err != nil {
+		return nil, fmt.Errorf("encryption: decrypt: %w", err)
+	}
+	return plaintext, nil
+}
+
+// additionalData returns the additional authenticated data of a value, which
+// is the header followed by the associated data ad.
+func additionalData(header, ad []byte) []byte {
+	if len(ad) == 0 {
+		return header
+	}
+	return append(append(make([]byte, 0, len(header)+len(ad)), header...), ad...)
+}
+
+// saveKeyringFile writes the keyring file atomically by renaming a temporary
+// file.
+func saveKeyringFile(dir string, file keyringFile) (err error) {
+	buf, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(dir, KeyringFileName+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmp)
+		}
+	}()
+	if _, err = f.Write(buf); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Chmod(keyringFileMode); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, filepath.Join(dir, KeyringFileName)); err != nil {
+		return err
+	}
+
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = d.Sync()
+	if closeErr := d.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
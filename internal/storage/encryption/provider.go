@@ -0,0 +1,60 @@
+// Package encryption provides envelope encryption for data at rest.
+//
+// Each data directory has its own data keys that encrypt values written to
+// the storage. Data keys are never stored in plaintext; they are wrapped by a
+// key-encryption key (KEK) managed by a KeyProvider, and the wrapped data keys
+// are kept in the keyring file of the data directory. The local keyfile
+// provider keeps KEKs in a file, and other providers, for instance, backed by
+// an external key management service, can be plugged in by implementing the
+// KeyProvider interface.
+package encryption
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrUnknownKEK is returned when a key provider does not have the KEK
+	// that wrapped a data key.
+	ErrUnknownKEK = errors.New("encryption: unknown key-encryption key")
+
+	// ErrUnknownDataKey is returned when a keyring does not have the data
+	// key that encrypted a value.
+	ErrUnknownDataKey = errors.New("encryption: unknown data key")
+)
+
+// WrappedKey is a data key encrypted by a key-encryption key.
+type WrappedKey struct {
+	// KEKID identifies the key-encryption key that wrapped the data key.
+	KEKID string `json:"kekId"`
+	// Ciphertext is the encrypted data key.
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// KeyProvider wraps and unwraps data keys by using key-encryption keys. It
+// resembles the interface of a key management service: the provider never
+// reveals its key-encryption keys, and it can keep old key-encryption keys to
+// unwrap data keys wrapped before rotation.
+type KeyProvider interface {
+	// CurrentKEKID returns the identifier of the key-encryption key used
+	// to wrap new data keys.
+	CurrentKEKID(ctx context.Context) (string, error)
+
+	// WrapKey encrypts the data key by using the current key-encryption
+	// key.
+	WrapKey(ctx context.Context, dataKey []byte) (WrappedKey, error)
+
+	// UnwrapKey decrypts the wrapped data key. It returns ErrUnknownKEK if
+	// the provider does not have the key-encryption key.
+	UnwrapKey(ctx context.Context, wk WrappedKey) ([]byte, error)
+}
+
+// Reloader is implemented by key providers that can load key-encryption keys
+// added after they were created, for instance, by rotation of the
+// key-encryption key. Storage nodes reload them periodically so that data
+// keys can be wrapped by the new key-encryption key without restart.
+type Reloader interface {
+	// Reload loads the key-encryption keys again.
+	Reload() error
+}
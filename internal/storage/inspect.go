@@ -0,0 +1,183 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// maxConsistencyProblems is the maximum number of problems that
+// CheckConsistency reports. It prevents a badly broken storage from making a
+// huge report.
+const maxConsistencyProblems = 100
+
+// ConsistencyReport is the result of CheckConsistency.
+type ConsistencyReport struct {
+	// NumCommits and NumData are the number of commit and data keys,
+	// respectively. NumCommits includes commits whose data were removed by
+	// compaction.
+	NumCommits int `json:"numCommits"`
+	NumData    int `json:"numData"`
+	// FirstCommitted and LastCommitted are the first and last committed log
+	// entries. They are zero if there are no committed log entries.
+	FirstCommitted varlogpb.LogSequenceNumber `json:"firstCommitted"`
+	LastCommitted  varlogpb.LogSequenceNumber `json:"lastCommitted"`
+	// UncommittedBegin and UncommittedEnd are the range of LLSNs of log
+	// entries written but not committed, that is, [UncommittedBegin,
+	// UncommittedEnd). It is normal for a replica to have an uncommitted tail
+	// after crashing.
+	UncommittedBegin types.LLSN `json:"uncommittedBegin"`
+	UncommittedEnd   types.LLSN `json:"uncommittedEnd"`
+	// Problems are inconsistencies between data and commits. At most
+	// maxConsistencyProblems problems are reported.
+	Problems []string `json:"problems"`
+}
+
+// Consistent returns true if there are no problems.
+func (r *ConsistencyReport) Consistent() bool {
+	return len(r.Problems) == 0
+}
+
+func (r *ConsistencyReport) addProblem(format string, args ...any) {
+	if len(r.Problems) < maxConsistencyProblems {
+		r.Problems = append(r.Problems, fmt.Sprintf(format, args...))
+	}
+}
+
+// CheckConsistency checks whether data and commits in the storage are
+// consistent with each other and with the commit context. It reads the whole
+// storage, hence, it is intended for offline inspection.
+// It returns an error only if it cannot read the storage, and inconsistencies
+// are reported in ConsistencyReport.Problems.
+func (s *Storage) CheckConsistency() (report ConsistencyReport, err error) {
+	report.Problems = []string{}
+
+	cit := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{commitKeyPrefix},
+		UpperBound: []byte{commitKeySentinelPrefix},
+	})
+	defer func() {
+		_ = cit.Close()
+	}()
+
+	var prev varlogpb.LogSequenceNumber
+	for cit.First(); cit.Valid(); cit.Next() {
+		glsn := decodeCommitKey(cit.Key())
+		if len(cit.Value()) != dataKeyLength || (cit.Value()[0] != dataKeyPrefix && cit.Value()[0] != compactedDataKeyPrefix) {
+			report.addProblem("commit %d: invalid data key", glsn)
+			continue
+		}
+		llsn, compacted := decodeCommitValue(cit.Value())
+		if report.NumCommits == 0 {
+			report.FirstCommitted = varlogpb.LogSequenceNumber{LLSN: llsn, GLSN: glsn}
+		} else if llsn != prev.LLSN+1 {
+			report.addProblem("commit %d: llsn %d not contiguous to llsn %d of commit %d", glsn, llsn, prev.LLSN, prev.GLSN)
+		}
+		prev = varlogpb.LogSequenceNumber{LLSN: llsn, GLSN: glsn}
+		report.NumCommits++
+		if compacted {
+			continue
+		}
+
+		_, closer, err := s.db.Get(cit.Value())
+		if err != nil {
+			if !errors.Is(err, pebble.ErrNotFound) {
+				return report, err
+			}
+			report.addProblem("commit %d: no data at llsn %d", glsn, llsn)
+			continue
+		}
+		_ = closer.Close()
+	}
+	if err := cit.Error(); err != nil {
+		return report, err
+	}
+	report.LastCommitted = prev
+
+	dit := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{dataKeyPrefix},
+		UpperBound: []byte{dataKeySentinelPrefix},
+	})
+	defer func() {
+		_ = dit.Close()
+	}()
+
+	for dit.First(); dit.Valid(); dit.Next() {
+		llsn := decodeDataKey(dit.Key())
+		report.NumData++
+		if report.NumCommits > 0 && llsn < report.FirstCommitted.LLSN {
+			report.addProblem("data %d: before the first committed llsn %d", llsn, report.FirstCommitted.LLSN)
+			continue
+		}
+		if report.NumCommits > 0 && llsn <= report.LastCommitted.LLSN {
+			continue
+		}
+		if report.UncommittedBegin.Invalid() {
+			report.UncommittedBegin = llsn
+			if llsn != report.LastCommitted.LLSN+1 && report.NumCommits > 0 {
+				report.addProblem("data %d: gap after the last committed llsn %d", llsn, report.LastCommitted.LLSN)
+			}
+		} else if llsn != report.UncommittedEnd {
+			report.addProblem("data %d: gap in uncommitted data after llsn %d", llsn, report.UncommittedEnd-1)
+		}
+		report.UncommittedEnd = llsn + 1
+	}
+	if err := dit.Error(); err != nil {
+		return report, err
+	}
+
+	cc, err := s.ReadCommitContext()
+	if err != nil {
+		if !errors.Is(err, pebble.ErrNotFound) {
+			return report, err
+		}
+		if report.NumCommits > 0 {
+			report.addProblem("no commit context, but %d commits", report.NumCommits)
+		}
+		return report, nil
+	}
+	if report.NumCommits > 0 && !cc.Empty() && report.LastCommitted.GLSN < cc.CommittedGLSNEnd-1 {
+		report.addProblem("commit context ends at glsn %d, but the last commit is %d", cc.CommittedGLSNEnd-1, report.LastCommitted.GLSN)
+	}
+	return report, nil
+}
+
+// DeleteUncommittedLogEntries deletes log entries written but not committed,
+// that is, whose LLSNs are greater than the last committed one. It returns the
+// range of deleted LLSNs, [begin, end). It never deletes committed log entries
+// and commits; thus, it is safe to call it for a replica that crashed while
+// writing log entries. It refuses to delete anything if the storage is not
+// consistent since the last committed log entry might be wrong. It is intended
+// for offline repair; hence, it should not be called while the replica is
+// running.
+func (s *Storage) DeleteUncommittedLogEntries() (begin, end types.LLSN, err error) {
+	if s.readOnly {
+		return types.InvalidLLSN, types.InvalidLLSN, errors.New("storage: read-only")
+	}
+
+	report, err := s.CheckConsistency()
+	if err != nil {
+		return types.InvalidLLSN, types.InvalidLLSN, err
+	}
+	if !report.Consistent() {
+		return types.InvalidLLSN, types.InvalidLLSN, fmt.Errorf("storage: %w: %s", ErrInconsistentWriteCommitState, report.Problems[0])
+	}
+	if report.UncommittedBegin.Invalid() {
+		return types.InvalidLLSN, types.InvalidLLSN, nil
+	}
+
+	lower := make([]byte, dataKeyLength)
+	err = s.db.DeleteRange(
+		encodeDataKeyInternal(report.UncommittedBegin, lower),
+		[]byte{dataKeySentinelPrefix},
+		pebble.Sync,
+	)
+	if err != nil {
+		return types.InvalidLLSN, types.InvalidLLSN, err
+	}
+	return report.UncommittedBegin, report.UncommittedEnd, nil
+}
@@ -0,0 +1,237 @@
+package storage
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/cockroachdb/pebble"
+	"go.uber.org/multierr"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// ErrNoKeyIndex is returned if the key index is disabled.
+var ErrNoKeyIndex = errors.New("storage: no key index")
+
+func encodeRecordKeyInternal(llsn types.LLSN, key []byte) []byte {
+	key[0] = recordKeyPrefix
+	binary.BigEndian.PutUint64(key[1:], uint64(llsn))
+	return key
+}
+
+func decodeRecordKey(k []byte) types.LLSN {
+	if k[0] != recordKeyPrefix || len(k) != recordKeyLength {
+		panic("storage: invalid key type")
+	}
+	return types.LLSN(binary.BigEndian.Uint64(k[1:]))
+}
+
+// encodeKeyIndexPrefix returns the prefix of keys of the key index for the
+// record key. The length of the record key is a part of the prefix so that
+// the prefix of a record key never matches that of another.
+func encodeKeyIndexPrefix(recordKey []byte) []byte {
+	prefix := make([]byte, keyIndexKeyLength-types.GLSNLen+len(recordKey), keyIndexKeyLength+len(recordKey))
+	prefix[0] = keyIndexPrefix
+	binary.BigEndian.PutUint32(prefix[1:5], uint32(len(recordKey)))
+	copy(prefix[5:], recordKey)
+	return prefix
+}
+
+func encodeKeyIndexKey(recordKey []byte, glsn types.GLSN) []byte {
+	key := encodeKeyIndexPrefix(recordKey)
+	key = key[:len(key)+types.GLSNLen]
+	binary.BigEndian.PutUint64(key[len(key)-types.GLSNLen:], uint64(glsn))
+	return key
+}
+
+func decodeKeyIndexKey(k []byte) types.GLSN {
+	if len(k) < keyIndexKeyLength || k[0] != keyIndexPrefix {
+		panic("storage: invalid key type")
+	}
+	return types.GLSN(binary.BigEndian.Uint64(k[len(k)-types.GLSNLen:]))
+}
+
+// initKeyIndex makes the storage maintain the key index if it has been
+// created with the key index, regardless of the option WithKeyIndex. A new
+// storage opened with the option records that it maintains the key index and
+// whether it is compacted.
+// Since log entries written without the key index have no record keys, it
+// fails to enable the key index of a storage having them.
+func (s *Storage) initKeyIndex() error {
+	value, closer, err := s.db.Get(keyIndexKey)
+	if err == nil {
+		s.keyIndex = true
+		s.compacted = len(value) > 0 && value[0] == keyIndexCompacted
+		_ = closer.Close()
+		return nil
+	}
+	if !errors.Is(err, pebble.ErrNotFound) {
+		return err
+	}
+	if !s.keyIndex || s.readOnly {
+		return nil
+	}
+	empty, err := s.empty()
+	if err != nil {
+		return err
+	}
+	if !empty {
+		return errors.New("storage: key index on a storage having data written without it")
+	}
+	value = nil
+	if s.compacted {
+		value = []byte{keyIndexCompacted}
+	}
+	return s.db.Set(keyIndexKey, value, s.writeOpts)
+}
+
+// KeyIndex tells whether the storage maintains the key index.
+func (s *Storage) KeyIndex() bool {
+	return s.keyIndex
+}
+
+// Compacted tells whether the storage has been created by the option
+// WithCompaction.
+func (s *Storage) Compacted() bool {
+	return s.compacted
+}
+
+// RecordKey returns the record key of the log entry at the llsn. It returns
+// nil if the log entry has no record key.
+func (s *Storage) RecordKey(llsn types.LLSN) ([]byte, error) {
+	if !s.keyIndex {
+		return nil, ErrNoKeyIndex
+	}
+	key, closer, err := s.db.Get(encodeRecordKeyInternal(llsn, make([]byte, recordKeyLength)))
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer func() {
+		_ = closer.Close()
+	}()
+	return append([]byte(nil), key...), nil
+}
+
+// LookupByKey returns committed log entries having the record key in
+// ascending order of GLSN. If the argument latest is true, it returns only the
+// latest one.
+func (s *Storage) LookupByKey(recordKey []byte, latest bool) (les []varlogpb.LogEntry, err error) {
+	if !s.keyIndex {
+		return nil, ErrNoKeyIndex
+	}
+
+	prefix := encodeKeyIndexPrefix(recordKey)
+	it := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: prefixEnd(prefix),
+	})
+	defer func() {
+		_ = it.Close()
+	}()
+
+	first, next := it.First, it.Next
+	if latest {
+		first, next = it.Last, it.Prev
+	}
+	for valid := first(); valid; valid = next() {
+		data, closer, err := s.db.Get(it.Value())
+		if err != nil {
+			if errors.Is(err, pebble.ErrNotFound) {
+				// compacted or trimmed concurrently
+				continue
+			}
+			return nil, err
+		}
+		le := varlogpb.LogEntry{
+			LogEntryMeta: varlogpb.LogEntryMeta{
+				GLSN: decodeKeyIndexKey(it.Key()),
+				LLSN: decodeDataKey(it.Value()),
+			},
+			Key: append([]byte(nil), recordKey...),
+		}
+		le.Data, err = s.decryptValue(data, it.Value())
+		_ = closer.Close()
+		if err != nil {
+			return nil, err
+		}
+		les = append(les, le)
+		if latest {
+			break
+		}
+	}
+	return les, it.Error()
+}
+
+// trimBatchSize is the size of a batch that Trim writes at once when it
+// deletes the entries of the key index.
+var trimBatchSize = 4 << 20
+
+// trimKeyIndex deletes the entries of the key index for the log entries to be
+// trimmed, whose GLSNs and LLSNs are less than or equal to the arguments
+// trimGLSN and trimLLSN respectively. Since both GLSN and LLSN increase
+// monotonically, it walks the record keys and the commits together to find
+// the GLSN of each record key.
+//
+// The key index can be much larger than a batch should be, thus, it commits
+// the deletions in batches of bounded size. Each batch also trims the log
+// entries up to the last one whose key index entry it deletes, so that the
+// storage is always trimmed up to a prefix of the log, even if it stops
+// midway.
+func (s *Storage) trimKeyIndex(trimGLSN types.GLSN, trimLLSN types.LLSN) error {
+	rkIt := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: encodeRecordKeyInternal(types.MinLLSN, make([]byte, recordKeyLength)),
+		UpperBound: encodeRecordKeyInternal(trimLLSN+1, make([]byte, recordKeyLength)),
+	})
+	defer func() {
+		_ = rkIt.Close()
+	}()
+	ckIt := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: encodeCommitKeyInternal(types.MinGLSN, make([]byte, commitKeyLength)),
+		UpperBound: encodeCommitKeyInternal(trimGLSN+1, make([]byte, commitKeyLength)),
+	})
+	defer func() {
+		_ = ckIt.Close()
+	}()
+
+	batch := s.db.NewBatch()
+	defer func() {
+		_ = batch.Close()
+	}()
+
+	ckValid := ckIt.First()
+	for rkValid := rkIt.First(); rkValid; rkValid = rkIt.Next() {
+		llsn := decodeRecordKey(rkIt.Key())
+		for ; ckValid; ckValid = ckIt.Next() {
+			if commitLLSN, _ := decodeCommitValue(ckIt.Value()); commitLLSN >= llsn {
+				break
+			}
+		}
+		if !ckValid {
+			break
+		}
+		if commitLLSN, _ := decodeCommitValue(ckIt.Value()); commitLLSN != llsn {
+			continue
+		}
+		glsn := decodeCommitKey(ckIt.Key())
+		if err := batch.Delete(encodeKeyIndexKey(rkIt.Value(), glsn), nil); err != nil {
+			return err
+		}
+
+		if batch.Len() < trimBatchSize {
+			continue
+		}
+		s.trimRange(batch, glsn, llsn)
+		if err := batch.Commit(s.writeOpts); err != nil {
+			return err
+		}
+		batch.Reset()
+	}
+	if err := multierr.Append(rkIt.Error(), ckIt.Error()); err != nil {
+		return err
+	}
+	return batch.Commit(s.writeOpts)
+}
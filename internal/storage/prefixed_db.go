@@ -0,0 +1,129 @@
+package storage
+
+import (
+	"io"
+
+	"github.com/cockroachdb/pebble"
+)
+
+// prefixEnd returns the smallest key greater than all keys having the
+// prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// prefixedDB is a view of a pebble database in which all keys have the same
+// prefix. Keys given to or returned from it do not have the prefix, thus,
+// codes using it are not aware of the prefix. If the prefix is empty, it is
+// the whole database.
+type prefixedDB struct {
+	db     *pebble.DB
+	prefix []byte
+}
+
+func (pdb *prefixedDB) key(k []byte) []byte {
+	return prefixKey(pdb.prefix, k)
+}
+
+func prefixKey(prefix, k []byte) []byte {
+	if len(prefix) == 0 {
+		return k
+	}
+	buf := make([]byte, len(prefix)+len(k))
+	copy(buf, prefix)
+	copy(buf[len(prefix):], k)
+	return buf
+}
+
+func (pdb *prefixedDB) Get(k []byte) ([]byte, io.Closer, error) {
+	return pdb.db.Get(pdb.key(k))
+}
+
+func (pdb *prefixedDB) Set(k, v []byte, opts *pebble.WriteOptions) error {
+	return pdb.db.Set(pdb.key(k), v, opts)
+}
+
+func (pdb *prefixedDB) Delete(k []byte, opts *pebble.WriteOptions) error {
+	return pdb.db.Delete(pdb.key(k), opts)
+}
+
+func (pdb *prefixedDB) DeleteRange(start, end []byte, opts *pebble.WriteOptions) error {
+	return pdb.db.DeleteRange(pdb.key(start), pdb.key(end), opts)
+}
+
+// NewIter returns an iterator whose bounds are limited to the prefix. Nil
+// bounds are replaced with the boundaries of the prefix.
+func (pdb *prefixedDB) NewIter(opts *pebble.IterOptions) *prefixedIterator {
+	if len(pdb.prefix) == 0 {
+		return &prefixedIterator{Iterator: pdb.db.NewIter(opts)}
+	}
+	var o pebble.IterOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.LowerBound == nil {
+		o.LowerBound = pdb.prefix
+	} else {
+		o.LowerBound = pdb.key(o.LowerBound)
+	}
+	if o.UpperBound == nil {
+		o.UpperBound = prefixEnd(pdb.prefix)
+	} else {
+		o.UpperBound = pdb.key(o.UpperBound)
+	}
+	return &prefixedIterator{
+		Iterator: pdb.db.NewIter(&o),
+		prefix:   pdb.prefix,
+	}
+}
+
+func (pdb *prefixedDB) NewBatch() *prefixedBatch {
+	return &prefixedBatch{
+		Batch:  pdb.db.NewBatch(),
+		prefix: pdb.prefix,
+	}
+}
+
+// prefixedIterator is an iterator of prefixedDB. Only methods overridden by
+// it are aware of the prefix, and the others of pebble.Iterator must not be
+// called with keys.
+type prefixedIterator struct {
+	*pebble.Iterator
+	prefix []byte
+}
+
+func (it *prefixedIterator) Key() []byte {
+	return it.Iterator.Key()[len(it.prefix):]
+}
+
+func (it *prefixedIterator) SeekGE(k []byte) bool {
+	return it.Iterator.SeekGE(prefixKey(it.prefix, k))
+}
+
+// prefixedBatch is a batch of prefixedDB. Only methods overridden by it are
+// aware of the prefix, and the others of pebble.Batch must not be called with
+// keys.
+type prefixedBatch struct {
+	*pebble.Batch
+	prefix []byte
+}
+
+func (b *prefixedBatch) Set(k, v []byte, opts *pebble.WriteOptions) error {
+	return b.Batch.Set(prefixKey(b.prefix, k), v, opts)
+}
+
+func (b *prefixedBatch) Delete(k []byte, opts *pebble.WriteOptions) error {
+	return b.Batch.Delete(prefixKey(b.prefix, k), opts)
+}
+
+func (b *prefixedBatch) DeleteRange(start, end []byte, opts *pebble.WriteOptions) error {
+	return b.Batch.DeleteRange(prefixKey(b.prefix, start), prefixKey(b.prefix, end), opts)
+}
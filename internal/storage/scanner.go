@@ -25,7 +25,7 @@ var scannerPool = sync.Pool{
 type Scanner struct {
 	scanConfig
 	stg *Storage
-	it  *pebble.Iterator
+	it  *prefixedIterator
 	cks struct {
 		lower []byte
 		upper []byte
@@ -68,30 +68,50 @@ func (s *Scanner) Close() error {
 func (s *Scanner) valueByGLSN() (le varlogpb.LogEntry, err error) {
 	ck := s.it.Key()
 	dk := s.it.Value()
+	le.GLSN = decodeCommitKey(ck)
+	if llsn, compacted := decodeCommitValue(dk); compacted {
+		le.LLSN = llsn
+		le.Compacted = true
+		return le, nil
+	}
 	data, closer, err := s.stg.db.Get(dk)
 	if err != nil {
 		if err == pebble.ErrNotFound {
+			// The data can be removed by compaction after the iterator
+			// is created.
+			if s.compactedAfterScan(ck) {
+				le.LLSN = decodeDataKey(dk)
+				le.Compacted = true
+				return le, nil
+			}
 			return le, fmt.Errorf("%s: %w", s.stg.path, ErrInconsistentWriteCommitState)
 		}
 		return le, err
 	}
-	le.GLSN = decodeCommitKey(ck)
 	le.LLSN = decodeDataKey(dk)
-	if len(data) > 0 {
-		le.Data = make([]byte, len(data))
-		copy(le.Data, data)
-	}
+	le.Data, err = s.stg.decryptValue(data, dk)
 	_ = closer.Close()
-	return le, nil
+	return le, err
+}
+
+// compactedAfterScan tells whether the commit ck was compacted after the
+// scanner is created.
+func (s *Scanner) compactedAfterScan(ck []byte) bool {
+	v, closer, err := s.stg.db.Get(ck)
+	if err != nil {
+		return false
+	}
+	defer func() {
+		_ = closer.Close()
+	}()
+	_, compacted := decodeCommitValue(v)
+	return compacted
 }
 
 func (s *Scanner) valueByLLSN() (le varlogpb.LogEntry, err error) {
 	le.LLSN = decodeDataKey(s.it.Key())
-	if len(s.it.Value()) > 0 {
-		le.Data = make([]byte, len(s.it.Value()))
-		copy(le.Data, s.it.Value())
-	}
-	return le, nil
+	le.Data, err = s.stg.decryptValue(s.it.Value(), s.it.Key())
+	return le, err
 }
 
 func (s *Scanner) release() {
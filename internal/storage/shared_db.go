@@ -0,0 +1,227 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	"go.uber.org/multierr"
+
+	"github.com/kakao/varlog/pkg/types"
+)
+
+const (
+	sharedStorageDirMode = os.FileMode(0700)
+
+	// copyBatchSize is the size of a batch used to copy log entries from a
+	// shared database to a standalone one.
+	copyBatchSize = 4 << 20
+)
+
+var errSharedDBClosed = errors.New("storage: shared db: closed")
+
+// SharedDB is a pebble database shared by storages of log stream replicas,
+// usually, in the same volume. Each storage keeps its data under the prefix
+// of its topic and log stream; thus, the storages share the WAL, memtables,
+// block cache and compactions of the database, while trim, synchronization
+// and removal are still done per log stream.
+//
+// A storage in the shared database is opened by New with the option
+// WithSharedDB. SharedDB should be closed after all its storages are closed.
+type SharedDB struct {
+	path       string
+	db         *pebble.DB
+	pebbleOpts *pebble.Options
+	writeOpts  *pebble.WriteOptions
+
+	mu     sync.Mutex
+	opened map[string]struct{}
+	closed bool
+}
+
+// OpenSharedDB opens a shared database in the path given by the option
+// WithPath. Options for pebble, such as WithMemTableSize, are applied to the
+// shared database.
+func OpenSharedDB(opts ...Option) (*SharedDB, error) {
+	cfg, err := newConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.sharedDB != nil || cfg.readOnly {
+		return nil, errors.New("storage: shared db: invalid options")
+	}
+
+	pebbleOpts := newPebbleOptions(cfg)
+	db, err := pebble.Open(cfg.path, pebbleOpts)
+	if err != nil {
+		return nil, err
+	}
+	return &SharedDB{
+		path:       cfg.path,
+		db:         db,
+		pebbleOpts: pebbleOpts,
+		writeOpts:  &pebble.WriteOptions{Sync: cfg.sync},
+		opened:     make(map[string]struct{}),
+	}, nil
+}
+
+// Path returns the path to the shared database.
+func (sdb *SharedDB) Path() string {
+	return sdb.path
+}
+
+func (sdb *SharedDB) acquire(prefix []byte) error {
+	sdb.mu.Lock()
+	defer sdb.mu.Unlock()
+	if sdb.closed {
+		return errSharedDBClosed
+	}
+	if _, ok := sdb.opened[string(prefix)]; ok {
+		return errors.New("storage: shared db: log stream already opened")
+	}
+	sdb.opened[string(prefix)] = struct{}{}
+	return nil
+}
+
+func (sdb *SharedDB) release(prefix []byte) {
+	sdb.mu.Lock()
+	defer sdb.mu.Unlock()
+	delete(sdb.opened, string(prefix))
+}
+
+// DeleteLogStream deletes all data of the log stream from the shared
+// database. The storage of the log stream must be closed.
+func (sdb *SharedDB) DeleteLogStream(tpid types.TopicID, lsid types.LogStreamID) error {
+	prefix := encodeLogStreamKeyPrefix(tpid, lsid)
+
+	sdb.mu.Lock()
+	defer sdb.mu.Unlock()
+	if sdb.closed {
+		return errSharedDBClosed
+	}
+	if _, ok := sdb.opened[string(prefix)]; ok {
+		return fmt.Errorf("storage: shared db: log stream %d in topic %d is opened", lsid, tpid)
+	}
+	return sdb.db.DeleteRange(prefix, prefixEnd(prefix), sdb.writeOpts)
+}
+
+// Close closes the shared database. It returns an error if there are storages
+// not closed yet, but it closes the database anyway.
+func (sdb *SharedDB) Close() (err error) {
+	sdb.mu.Lock()
+	defer sdb.mu.Unlock()
+	if sdb.closed {
+		return nil
+	}
+	sdb.closed = true
+	if len(sdb.opened) > 0 {
+		err = fmt.Errorf("storage: shared db: %d storages not closed", len(sdb.opened))
+	}
+	err = multierr.Append(err, sdb.db.Flush())
+	return multierr.Append(err, sdb.db.Close())
+}
+
+// newSharedStorage creates a storage in the shared database. The path of the
+// storage marks its existence. If the path does not exist, data of the log
+// stream left in the shared database, for instance, by a removal interrupted
+// by a crash, are stale; thus, they are deleted before creating the path.
+func newSharedStorage(cfg config) (*Storage, error) {
+	sdb := cfg.sharedDB
+	prefix := encodeLogStreamKeyPrefix(cfg.tpid, cfg.lsid)
+	if err := sdb.acquire(prefix); err != nil {
+		return nil, err
+	}
+
+	_, err := os.Stat(cfg.path)
+	if errors.Is(err, os.ErrNotExist) {
+		err = sdb.db.DeleteRange(prefix, prefixEnd(prefix), sdb.writeOpts)
+		if err == nil {
+			err = os.MkdirAll(cfg.path, sharedStorageDirMode)
+		}
+	}
+	if err != nil {
+		sdb.release(prefix)
+		return nil, err
+	}
+
+	return &Storage{
+		config:     cfg,
+		db:         &prefixedDB{db: sdb.db, prefix: prefix},
+		pebbleOpts: sdb.pebbleOpts,
+		writeOpts:  &pebble.WriteOptions{Sync: cfg.sync},
+	}, nil
+}
+
+// hasStandaloneDB returns true if the path has its own pebble database.
+func hasStandaloneDB(path string) (bool, error) {
+	des, err := os.ReadDir(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	for _, de := range des {
+		if strings.HasPrefix(de.Name(), "MANIFEST-") {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// copyToStandaloneDB copies all data of the storage in the shared database
+// into a new standalone database in the directory dir, which must not exist.
+// Keys in the new database do not have the prefix of the log stream; thus, it
+// can be opened by New without the option WithSharedDB.
+func (s *Storage) copyToStandaloneDB(dir string) (err error) {
+	if _, err := os.Stat(dir); err == nil {
+		return fmt.Errorf("storage: checkpoint: %s already exists", dir)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	cfg := s.config
+	cfg.path = dir
+	cfg.sharedDB = nil
+	cfg.verbose = false
+	db, err := pebble.Open(dir, newPebbleOptions(cfg))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, db.Close())
+	}()
+
+	// An iterator reads a consistent point-in-time view of the storage.
+	it := s.db.NewIter(nil)
+	defer func() {
+		err = multierr.Append(err, it.Close())
+	}()
+
+	batch := db.NewBatch()
+	defer func() {
+		err = multierr.Append(err, batch.Close())
+	}()
+	for it.First(); it.Valid(); it.Next() {
+		if err := batch.Set(it.Key(), it.Value(), nil); err != nil {
+			return err
+		}
+		if batch.Len() < copyBatchSize {
+			continue
+		}
+		if err := batch.Commit(pebble.NoSync); err != nil {
+			return err
+		}
+		batch.Reset()
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if err := batch.Commit(pebble.NoSync); err != nil {
+		return err
+	}
+	return db.Flush()
+}
@@ -0,0 +1,250 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/sstable"
+	"github.com/cockroachdb/pebble/vfs"
+	"go.uber.org/multierr"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+var (
+	errSSTablesEncrypted  = errors.New("storage: sstables of encrypted storage")
+	errSSTablesKeyIndexed = errors.New("storage: sstables of key-indexed storage")
+)
+
+// SSTableSegment is a set of SSTables having log entries in the range
+// [First, Last]. Each segment can be ingested independently; thus, a
+// synchronization shipping segments can resume from the last ingested one.
+type SSTableSegment struct {
+	First varlogpb.LogSequenceNumber
+	Last  varlogpb.LogSequenceNumber
+	Paths []string
+}
+
+func createSSTable(path string, opts sstable.WriterOptions) (*sstable.Writer, error) {
+	f, err := vfs.Default.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	return sstable.NewWriter(f, opts), nil
+}
+
+// ExportSSTables writes log entries whose positions are in the range [first,
+// last] into segments of SSTables in the directory dir, and calls the
+// function f for each segment in order. Each segment has two SSTables for
+// data and commits, respectively, and the size of data in a segment is
+// limited to targetSegmentSize roughly. The SSTables do not contain the
+// commit context, and they can be ingested into another storage by
+// IngestSSTables. The function f can remove the files of the segment.
+//
+// The caller should guarantee that no one changes the log entries in the
+// range while exporting them.
+//
+// An encrypted storage cannot export SSTables since its data keys are not
+// shared with other storages. Neither can a storage having the key index,
+// since SSTables carry neither record keys nor compacted commits.
+func (s *Storage) ExportSSTables(dir string, first, last varlogpb.LogSequenceNumber, targetSegmentSize int64, f func(SSTableSegment) error) (err error) {
+	if s.keyring != nil {
+		return errSSTablesEncrypted
+	}
+	if s.keyIndex {
+		return errSSTablesKeyIndexed
+	}
+	if first.LLSN > last.LLSN || first.GLSN > last.GLSN {
+		return fmt.Errorf("storage: export: invalid range [%+v, %+v]", first, last)
+	}
+
+	lower, upper := make([]byte, dataKeyLength), make([]byte, dataKeyLength)
+	dataIt := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: encodeDataKeyInternal(first.LLSN, lower),
+		UpperBound: encodeDataKeyInternal(last.LLSN+1, upper),
+	})
+	defer func() {
+		err = multierr.Append(err, dataIt.Close())
+	}()
+
+	lower, upper = make([]byte, commitKeyLength), make([]byte, commitKeyLength)
+	commitIt := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: encodeCommitKeyInternal(first.GLSN, lower),
+		UpperBound: encodeCommitKeyInternal(last.GLSN+1, upper),
+	})
+	defer func() {
+		err = multierr.Append(err, commitIt.Close())
+	}()
+
+	opts := s.pebbleOpts.MakeWriterOptions(0, s.db.db.FormatMajorVersion().MaxTableFormat())
+	dataIt.First()
+	commitIt.First()
+	var exported varlogpb.LogSequenceNumber
+	nextLLSN := first.LLSN
+	for idx := 0; dataIt.Valid(); idx++ {
+		seg, err := exportSegment(dir, idx, opts, dataIt, commitIt, nextLLSN, uint64(targetSegmentSize))
+		if err != nil {
+			return err
+		}
+		if err := f(seg); err != nil {
+			return err
+		}
+		exported = seg.Last
+		nextLLSN = seg.Last.LLSN + 1
+	}
+	if err := multierr.Append(dataIt.Error(), commitIt.Error()); err != nil {
+		return err
+	}
+	if exported != last {
+		return fmt.Errorf("%w: export: expected last log entry %+v, but %+v", ErrInconsistentWriteCommitState, last, exported)
+	}
+	return nil
+}
+
+// exportSegment writes data from the current position of dataIt until the
+// data SSTable reaches the targetSize, and then writes the commits of them.
+// The first log entry of the segment should be at the nextLLSN.
+func exportSegment(dir string, idx int, opts sstable.WriterOptions, dataIt, commitIt *prefixedIterator, nextLLSN types.LLSN, targetSize uint64) (seg SSTableSegment, err error) {
+	dataPath := filepath.Join(dir, fmt.Sprintf("%06d.data.sst", idx))
+	dataWriter, err := createSSTable(dataPath, opts)
+	if err != nil {
+		return seg, err
+	}
+	seg.Paths = append(seg.Paths, dataPath)
+	lastLLSN := nextLLSN - 1
+	for ; dataIt.Valid() && dataWriter.EstimatedSize() < targetSize; dataIt.Next() {
+		llsn := decodeDataKey(dataIt.Key())
+		if llsn != lastLLSN+1 {
+			_ = dataWriter.Close()
+			return seg, fmt.Errorf("%w: export: expected data of %d, but %d", ErrInconsistentWriteCommitState, lastLLSN+1, llsn)
+		}
+		if err := dataWriter.Set(dataIt.Key(), dataIt.Value()); err != nil {
+			_ = dataWriter.Close()
+			return seg, err
+		}
+		lastLLSN = llsn
+	}
+	if err := dataWriter.Close(); err != nil {
+		return seg, err
+	}
+
+	commitPath := filepath.Join(dir, fmt.Sprintf("%06d.commit.sst", idx))
+	commitWriter, err := createSSTable(commitPath, opts)
+	if err != nil {
+		return seg, err
+	}
+	seg.Paths = append(seg.Paths, commitPath)
+	expectedLLSN := nextLLSN
+	for ; commitIt.Valid(); commitIt.Next() {
+		llsn := decodeDataKey(commitIt.Value())
+		if llsn > lastLLSN {
+			break
+		}
+		if llsn != expectedLLSN {
+			_ = commitWriter.Close()
+			return seg, fmt.Errorf("%w: export: expected commit of %d, but %d", ErrInconsistentWriteCommitState, expectedLLSN, llsn)
+		}
+		if err := commitWriter.Set(commitIt.Key(), commitIt.Value()); err != nil {
+			_ = commitWriter.Close()
+			return seg, err
+		}
+		lsn := varlogpb.LogSequenceNumber{LLSN: llsn, GLSN: decodeCommitKey(commitIt.Key())}
+		if llsn == nextLLSN {
+			seg.First = lsn
+		}
+		seg.Last = lsn
+		expectedLLSN = llsn + 1
+	}
+	if err := commitWriter.Close(); err != nil {
+		return seg, err
+	}
+	if expectedLLSN != lastLLSN+1 {
+		return seg, fmt.Errorf("%w: export: no commit of %d", ErrInconsistentWriteCommitState, expectedLLSN)
+	}
+	return seg, nil
+}
+
+// IngestSSTables ingests SSTables of a segment made by ExportSSTables
+// atomically. The ingested log entries overwrite existing ones that have the
+// same positions. The given files are moved into the storage if possible.
+//
+// SSTables made by ExportSSTables do not have the prefix of the log stream
+// even if the storage is in a shared database. Hence, a storage in a shared
+// database ingests copies of them whose keys have the prefix.
+func (s *Storage) IngestSSTables(paths []string) (err error) {
+	if s.keyring != nil {
+		return errSSTablesEncrypted
+	}
+	if s.keyIndex {
+		return errSSTablesKeyIndexed
+	}
+	if len(s.db.prefix) == 0 {
+		return s.db.db.Ingest(paths)
+	}
+
+	// Ingest moves the files, so they remain only if it fails.
+	prefixedPaths := make([]string, 0, len(paths))
+	defer func() {
+		for _, path := range prefixedPaths {
+			if rerr := os.Remove(path); rerr != nil && !errors.Is(rerr, os.ErrNotExist) {
+				err = multierr.Append(err, rerr)
+			}
+		}
+	}()
+	for _, path := range paths {
+		prefixedPath := path + ".prefixed"
+		if err := s.prefixSSTable(path, prefixedPath); err != nil {
+			return err
+		}
+		prefixedPaths = append(prefixedPaths, prefixedPath)
+	}
+	return s.db.db.Ingest(prefixedPaths)
+}
+
+// prefixSSTable copies the SSTable src into dst, prepending the prefix of the
+// log stream to all keys.
+func (s *Storage) prefixSSTable(src, dst string) (err error) {
+	f, err := vfs.Default.Open(src)
+	if err != nil {
+		return err
+	}
+	r, err := sstable.NewReader(f, s.pebbleOpts.MakeReaderOptions())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, r.Close())
+	}()
+	it, err := r.NewIter(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, it.Close())
+	}()
+
+	w, err := createSSTable(dst, s.pebbleOpts.MakeWriterOptions(0, s.db.db.FormatMajorVersion().MaxTableFormat()))
+	if err != nil {
+		return err
+	}
+	for k, lv := it.First(); k != nil; k, lv = it.Next() {
+		v, _, err := lv.Value(nil)
+		if err != nil {
+			_ = w.Close()
+			return err
+		}
+		if err := w.Set(prefixKey(s.db.prefix, k.UserKey), v); err != nil {
+			_ = w.Close()
+			return err
+		}
+	}
+	if err := it.Error(); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cockroachdb/pebble/bloom"
 	"go.uber.org/multierr"
 
+	"github.com/kakao/varlog/internal/storage/encryption"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
@@ -20,17 +21,62 @@ var (
 type Storage struct {
 	config
 
-	db        *pebble.DB
-	writeOpts *pebble.WriteOptions
+	db         *prefixedDB
+	pebbleOpts *pebble.Options
+	writeOpts  *pebble.WriteOptions
+	keyring    *encryption.Keyring
 }
 
-// New creates a new storage.
+// New creates a new storage. If the option WithSharedDB is given, the
+// storage keeps its data in the shared database, and the path is used only as
+// a marker of the storage. However, if the path already has its own database,
+// for instance, created before using the shared database or restored from a
+// checkpoint, the storage opens it rather than the shared one.
 func New(opts ...Option) (*Storage, error) {
 	cfg, err := newConfig(opts)
 	if err != nil {
 		return nil, err
 	}
 
+	s, err := open(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.initEncryption(); err != nil {
+		return nil, multierr.Append(err, s.Close())
+	}
+	if err := s.initKeyIndex(); err != nil {
+		return nil, multierr.Append(err, s.Close())
+	}
+	return s, nil
+}
+
+func open(cfg config) (*Storage, error) {
+	if cfg.sharedDB != nil {
+		standalone, err := hasStandaloneDB(cfg.path)
+		if err != nil {
+			return nil, err
+		}
+		if !standalone {
+			return newSharedStorage(cfg)
+		}
+		cfg.sharedDB = nil
+	}
+
+	pebbleOpts := newPebbleOptions(cfg)
+	db, err := pebble.Open(cfg.path, pebbleOpts)
+	if err != nil {
+		return nil, err
+	}
+	return &Storage{
+		config:     cfg,
+		db:         &prefixedDB{db: db},
+		pebbleOpts: pebbleOpts,
+		writeOpts:  &pebble.WriteOptions{Sync: cfg.sync},
+	}, nil
+}
+
+func newPebbleOptions(cfg config) *pebble.Options {
 	pebbleOpts := &pebble.Options{
 		DisableWAL:                  !cfg.wal,
 		L0CompactionThreshold:       cfg.l0CompactionThreshold,
@@ -69,26 +115,21 @@ func New(opts ...Option) (*Storage, error) {
 	if cfg.readOnly {
 		pebbleOpts.ReadOnly = true
 	}
-
-	db, err := pebble.Open(cfg.path, pebbleOpts)
-	if err != nil {
-		return nil, err
-	}
-	return &Storage{
-		config:    cfg,
-		db:        db,
-		writeOpts: &pebble.WriteOptions{Sync: cfg.sync},
-	}, nil
+	return pebbleOpts
 }
 
 // NewWriteBatch creates a batch for write operations.
 func (s *Storage) NewWriteBatch() *WriteBatch {
-	return newWriteBatch(s.db.NewBatch(), s.writeOpts)
+	return newWriteBatch(s.db.NewBatch(), s.writeOpts, s.keyIndex, s.keyring)
 }
 
 // NewCommitBatch creates a batch for commit operations.
 func (s *Storage) NewCommitBatch(cc CommitContext) (*CommitBatch, error) {
-	cb := newCommitBatch(s.db.NewBatch(), s.writeOpts)
+	var db *prefixedDB
+	if s.keyIndex {
+		db = s.db
+	}
+	cb := newCommitBatch(s.db.NewBatch(), s.writeOpts, db)
 	if err := cb.batch.Set(commitContextKey, encodeCommitContext(cc, cb.cc), nil); err != nil {
 		_ = cb.Close()
 		return nil, err
@@ -99,7 +140,7 @@ func (s *Storage) NewCommitBatch(cc CommitContext) (*CommitBatch, error) {
 // NewAppendBatch creates a batch for appending log entries. It does not put
 // commit context.
 func (s *Storage) NewAppendBatch() *AppendBatch {
-	return newAppendBatch(s.db.NewBatch(), s.writeOpts)
+	return newAppendBatch(s.db.NewBatch(), s.writeOpts, s.keyIndex, s.keyring)
 }
 
 // NewScanner creates a scanner for the given key range.
@@ -152,7 +193,7 @@ func (s *Storage) readLLSN(llsn types.LLSN) (le varlogpb.LogEntry, err error) {
 	ck := make([]byte, commitKeyLength)
 	it.First()
 	for it.Valid() {
-		currLLSN := decodeDataKey(it.Value())
+		currLLSN, _ := decodeCommitValue(it.Value())
 		if currLLSN > llsn {
 			break
 		}
@@ -182,7 +223,8 @@ func (s *Storage) ReadCommitContext() (cc CommitContext, err error) {
 
 // Trim deletes log entries whose GLSNs are less than or equal to the argument
 // glsn. Internally, it removes records for both data and commits but does not
-// remove the commit context.
+// remove the commit context. If the key index is enabled, it also removes the
+// record keys and the entries of the key index for the trimmed log entries.
 // It returns the ErrNoLogEntry if there are no logs to delete.
 func (s *Storage) Trim(glsn types.GLSN) error {
 	lem, err := s.findLTE(glsn)
@@ -192,11 +234,24 @@ func (s *Storage) Trim(glsn types.GLSN) error {
 
 	trimGLSN, trimLLSN := lem.GLSN, lem.LLSN
 
+	if s.keyIndex {
+		if err := s.trimKeyIndex(trimGLSN, trimLLSN); err != nil {
+			return err
+		}
+	}
+
 	batch := s.db.NewBatch()
 	defer func() {
 		_ = batch.Close()
 	}()
+	s.trimRange(batch, trimGLSN, trimLLSN)
+	return batch.Commit(s.writeOpts)
+}
 
+// trimRange adds to the batch the deletions of the commits, data and record
+// keys of log entries whose GLSNs and LLSNs are less than or equal to the
+// arguments trimGLSN and trimLLSN respectively.
+func (s *Storage) trimRange(batch *prefixedBatch, trimGLSN types.GLSN, trimLLSN types.LLSN) {
 	// commit
 	ckBegin := make([]byte, commitKeyLength)
 	ckBegin = encodeCommitKeyInternal(types.MinGLSN, ckBegin)
@@ -211,7 +266,12 @@ func (s *Storage) Trim(glsn types.GLSN) error {
 	dkEnd = encodeDataKeyInternal(trimLLSN+1, dkEnd)
 	_ = batch.DeleteRange(dkBegin, dkEnd, nil)
 
-	return batch.Commit(s.writeOpts)
+	// record key
+	if s.keyIndex {
+		rkBegin := encodeRecordKeyInternal(types.MinLLSN, make([]byte, recordKeyLength))
+		rkEnd := encodeRecordKeyInternal(trimLLSN+1, make([]byte, recordKeyLength))
+		_ = batch.DeleteRange(rkBegin, rkEnd, nil)
+	}
 }
 
 func (s *Storage) findLTE(glsn types.GLSN) (lem varlogpb.LogEntryMeta, err error) {
@@ -234,7 +294,7 @@ func (s *Storage) findLTE(glsn types.GLSN) (lem varlogpb.LogEntryMeta, err error
 		return lem, ErrNoLogEntry
 	}
 	lem.GLSN = decodeCommitKey(it.Key())
-	lem.LLSN = decodeDataKey(it.Value())
+	lem.LLSN, _ = decodeCommitValue(it.Value())
 	return lem, nil
 }
 
@@ -244,13 +304,53 @@ func (s *Storage) Path() string {
 }
 
 func (s *Storage) DiskUsage() uint64 {
-	return s.db.Metrics().DiskSpaceUsage()
+	if s.sharedDB != nil {
+		usage, _ := s.db.db.EstimateDiskUsage(s.db.prefix, prefixEnd(s.db.prefix))
+		return usage
+	}
+	return s.db.db.Metrics().DiskSpaceUsage()
 }
 
-// Close closes the storage.
+// Checkpoint makes a consistent point-in-time checkpoint of the storage in the
+// directory dir, which must not exist. The checkpoint can be opened by New as
+// another storage. Files of the checkpoint are hard links to those of the
+// storage if possible. If the storage is in a shared database, its log
+// entries are copied into a new standalone database. The keyring of an
+// encrypted storage is also copied into the checkpoint; thus, the checkpoint
+// can be opened with the same key provider.
+func (s *Storage) Checkpoint(dir string) error {
+	if err := s.checkpoint(dir); err != nil {
+		return err
+	}
+	if s.keyring != nil {
+		return s.keyring.Save(dir)
+	}
+	return nil
+}
+
+func (s *Storage) checkpoint(dir string) error {
+	if s.sharedDB != nil {
+		return s.copyToStandaloneDB(dir)
+	}
+	if s.pebbleOpts.DisableWAL {
+		// Without WAL, data in memtables are not in the checkpoint
+		// unless flushed.
+		if err := s.db.db.Flush(); err != nil {
+			return err
+		}
+	}
+	return s.db.db.Checkpoint(dir, pebble.WithFlushedWAL())
+}
+
+// Close closes the storage. A storage in a shared database does not close the
+// shared database.
 func (s *Storage) Close() (err error) {
+	if s.sharedDB != nil {
+		s.sharedDB.release(s.db.prefix)
+		return nil
+	}
 	if !s.readOnly {
-		err = s.db.Flush()
+		err = s.db.db.Flush()
 	}
-	return multierr.Append(err, s.db.Close())
+	return multierr.Append(err, s.db.db.Close())
 }
@@ -1,7 +1,12 @@
 package storage
 
 import (
+	"context"
 	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/cockroachdb/pebble"
@@ -10,6 +15,7 @@ import (
 	"go.uber.org/goleak"
 	"go.uber.org/zap"
 
+	"github.com/kakao/varlog/internal/storage/encryption"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
@@ -70,13 +76,48 @@ func TestStorageCommitContext(t *testing.T) {
 	assert.False(t, cc1.Equal(cc2))
 }
 
-func testStorage(t *testing.T, f func(testing.TB, *Storage)) {
-	stg := TestNewStorage(t)
-	defer func() {
-		err := stg.Close()
-		assert.NoError(t, err)
-	}()
-	f(t, stg)
+func testStorage(t *testing.T, f func(testing.TB, *Storage), opts ...Option) {
+	t.Run("Standalone", func(t *testing.T) {
+		stg := TestNewStorage(t, opts...)
+		defer func() {
+			err := stg.Close()
+			assert.NoError(t, err)
+		}()
+		f(t, stg)
+	})
+
+	t.Run("SharedDB", func(t *testing.T) {
+		sdb, err := OpenSharedDB(WithPath(t.TempDir()), WithoutSync())
+		require.NoError(t, err)
+		defer func() {
+			err := sdb.Close()
+			assert.NoError(t, err)
+		}()
+
+		// A neighbor log stream in the shared database should be invisible.
+		neighbor, err := New(WithPath(filepath.Join(t.TempDir(), "neighbor")), WithoutSync(), WithSharedDB(sdb, 1, 2))
+		require.NoError(t, err)
+		TestAppendLogEntryWithoutCommitContext(t, neighbor, 1, 1, []byte("neighbor"))
+		TestSetCommitContext(t, neighbor, CommitContext{
+			Version:            1,
+			HighWatermark:      1,
+			CommittedGLSNBegin: 1,
+			CommittedGLSNEnd:   2,
+			CommittedLLSNBegin: 1,
+		})
+		defer func() {
+			err := neighbor.Close()
+			assert.NoError(t, err)
+		}()
+
+		stg, err := New(append([]Option{WithPath(filepath.Join(t.TempDir(), "stg")), WithoutSync(), WithSharedDB(sdb, 1, 1)}, opts...)...)
+		require.NoError(t, err)
+		defer func() {
+			err := stg.Close()
+			assert.NoError(t, err)
+		}()
+		f(t, stg)
+	})
 }
 
 func TestStorage(t *testing.T) {
@@ -371,6 +412,376 @@ func TestStorage_WriteCommit(t *testing.T) {
 	})
 }
 
+func TestStorage_KeyIndex(t *testing.T) {
+	stg := TestNewStorage(t)
+	_, err := stg.LookupByKey([]byte("a"), false)
+	assert.ErrorIs(t, err, ErrNoKeyIndex)
+	assert.NoError(t, stg.Close())
+
+	testStorage(t, func(t testing.TB, stg *Storage) {
+		keys := [][]byte{[]byte("a"), []byte("ab"), []byte("a"), nil}
+		wb := stg.NewWriteBatch()
+		for i, key := range keys {
+			llsn := types.LLSN(i + 1)
+			assert.NoError(t, wb.Set(llsn, []byte(strconv.Itoa(int(llsn)))))
+			assert.NoError(t, wb.SetKey(llsn, key))
+		}
+		assert.NoError(t, wb.Apply())
+		assert.NoError(t, wb.Close())
+
+		// Keys are not indexed until committed.
+		les, err := stg.LookupByKey([]byte("a"), false)
+		assert.NoError(t, err)
+		assert.Empty(t, les)
+
+		cb, err := stg.NewCommitBatch(CommitContext{
+			Version:            1,
+			HighWatermark:      14,
+			CommittedGLSNBegin: 11,
+			CommittedGLSNEnd:   15,
+			CommittedLLSNBegin: 1,
+		})
+		assert.NoError(t, err)
+		for i := range keys {
+			assert.NoError(t, cb.Set(types.LLSN(i+1), types.GLSN(i+11)))
+		}
+		assert.NoError(t, cb.Apply())
+		assert.NoError(t, cb.Close())
+
+		lookup := func(key string, latest bool) []types.GLSN {
+			les, err := stg.LookupByKey([]byte(key), latest)
+			assert.NoError(t, err)
+			var glsns []types.GLSN
+			for _, le := range les {
+				assert.Equal(t, key, string(le.Key))
+				assert.Equal(t, strconv.Itoa(int(le.LLSN)), string(le.Data))
+				glsns = append(glsns, le.GLSN)
+			}
+			return glsns
+		}
+		assert.Equal(t, []types.GLSN{11, 13}, lookup("a", false))
+		assert.Equal(t, []types.GLSN{13}, lookup("a", true))
+		assert.Equal(t, []types.GLSN{12}, lookup("ab", false))
+		assert.Empty(t, lookup("b", false))
+
+		key, err := stg.RecordKey(2)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("ab"), key)
+		key, err = stg.RecordKey(4)
+		assert.NoError(t, err)
+		assert.Nil(t, key)
+
+		// Trimmed log entries are not returned, and they are removed from the
+		// key index.
+		assert.NoError(t, stg.Trim(12))
+		assert.Equal(t, []types.GLSN{13}, lookup("a", false))
+		assert.Empty(t, lookup("ab", false))
+		it := stg.db.NewIter(&pebble.IterOptions{
+			LowerBound: []byte{keyIndexPrefix},
+			UpperBound: []byte{keyIndexPrefix + 1},
+		})
+		var indexed []types.GLSN
+		for it.First(); it.Valid(); it.Next() {
+			indexed = append(indexed, decodeKeyIndexKey(it.Key()))
+		}
+		assert.NoError(t, it.Close())
+		assert.Equal(t, []types.GLSN{13}, indexed)
+		key, err = stg.RecordKey(1)
+		assert.NoError(t, err)
+		assert.Nil(t, key)
+
+		// Log entries copied by synchronization are indexed immediately.
+		ab := stg.NewAppendBatch()
+		assert.NoError(t, ab.SetLogEntry(5, 15, []byte("5")))
+		assert.NoError(t, ab.SetKey(5, 15, []byte("a")))
+		assert.NoError(t, ab.Apply())
+		assert.NoError(t, ab.Close())
+		assert.Equal(t, []types.GLSN{13, 15}, lookup("a", false))
+		assert.Equal(t, []types.GLSN{15}, lookup("a", true))
+	}, WithKeyIndex())
+}
+
+func TestStorage_TrimKeyIndexInBatches(t *testing.T) {
+	defer func(size int) {
+		trimBatchSize = size
+	}(trimBatchSize)
+	trimBatchSize = 1
+
+	testStorage(t, func(t testing.TB, stg *Storage) {
+		// GLSN: 11  12  13  14  15
+		// key:  a   b   -   a   b
+		keys := [][]byte{[]byte("a"), []byte("b"), nil, []byte("a"), []byte("b")}
+		wb := stg.NewWriteBatch()
+		for i, key := range keys {
+			llsn := types.LLSN(i + 1)
+			assert.NoError(t, wb.Set(llsn, []byte(strconv.Itoa(int(llsn)))))
+			assert.NoError(t, wb.SetKey(llsn, key))
+		}
+		assert.NoError(t, wb.Apply())
+		assert.NoError(t, wb.Close())
+
+		cb, err := stg.NewCommitBatch(CommitContext{
+			Version:            1,
+			HighWatermark:      15,
+			CommittedGLSNBegin: 11,
+			CommittedGLSNEnd:   16,
+			CommittedLLSNBegin: 1,
+		})
+		assert.NoError(t, err)
+		for i := range keys {
+			assert.NoError(t, cb.Set(types.LLSN(i+1), types.GLSN(i+11)))
+		}
+		assert.NoError(t, cb.Apply())
+		assert.NoError(t, cb.Close())
+
+		// Each entry of the key index is deleted in its own batch.
+		assert.NoError(t, stg.Trim(14))
+
+		it := stg.db.NewIter(&pebble.IterOptions{
+			LowerBound: []byte{keyIndexPrefix},
+			UpperBound: []byte{keyIndexPrefix + 1},
+		})
+		var indexed []types.GLSN
+		for it.First(); it.Valid(); it.Next() {
+			indexed = append(indexed, decodeKeyIndexKey(it.Key()))
+		}
+		assert.NoError(t, it.Close())
+		assert.Equal(t, []types.GLSN{15}, indexed)
+
+		for llsn := types.LLSN(1); llsn <= 4; llsn++ {
+			key, err := stg.RecordKey(llsn)
+			assert.NoError(t, err)
+			assert.Nil(t, key)
+		}
+		les, err := stg.LookupByKey([]byte("b"), false)
+		assert.NoError(t, err)
+		assert.Len(t, les, 1)
+		assert.Equal(t, types.GLSN(15), les[0].GLSN)
+
+		scanner := stg.NewScanner(WithGLSN(types.MinGLSN, types.MaxGLSN))
+		var glsns []types.GLSN
+		for scanner.Valid() {
+			le, err := scanner.Value()
+			assert.NoError(t, err)
+			glsns = append(glsns, le.GLSN)
+			scanner.Next()
+		}
+		assert.NoError(t, scanner.Close())
+		assert.Equal(t, []types.GLSN{15}, glsns)
+	}, WithKeyIndex())
+}
+
+func TestStorage_KeyIndexPersisted(t *testing.T) {
+	// A storage created with the key index keeps it without the option.
+	dir := t.TempDir()
+	stg, err := New(WithPath(dir), WithKeyIndex())
+	require.NoError(t, err)
+	require.True(t, stg.KeyIndex())
+	require.NoError(t, stg.Close())
+	stg, err = New(WithPath(dir))
+	require.NoError(t, err)
+	require.True(t, stg.KeyIndex())
+	require.False(t, stg.Compacted())
+	require.NoError(t, stg.Close())
+
+	// A compacted storage keeps it without the option.
+	dir = t.TempDir()
+	stg, err = New(WithPath(dir), WithCompaction())
+	require.NoError(t, err)
+	require.True(t, stg.Compacted())
+	require.NoError(t, stg.Close())
+	stg, err = New(WithPath(dir))
+	require.NoError(t, err)
+	require.True(t, stg.KeyIndex())
+	require.True(t, stg.Compacted())
+	require.NoError(t, stg.Close())
+
+	// A storage having data written without the key index cannot enable it.
+	dir = t.TempDir()
+	stg, err = New(WithPath(dir))
+	require.NoError(t, err)
+	TestAppendLogEntryWithoutCommitContext(t, stg, 1, 1, []byte("foo"))
+	require.NoError(t, stg.Close())
+	_, err = New(WithPath(dir), WithKeyIndex())
+	require.Error(t, err)
+	stg, err = New(WithPath(dir))
+	require.NoError(t, err)
+	require.False(t, stg.KeyIndex())
+	require.NoError(t, stg.Close())
+}
+
+func TestStorage_Compact(t *testing.T) {
+	stg := TestNewStorage(t)
+	_, _, err := stg.Compact(types.MaxGLSN, nil)
+	assert.ErrorIs(t, err, ErrNoKeyIndex)
+	assert.NoError(t, stg.Close())
+
+	testStorage(t, func(t testing.TB, stg *Storage) {
+		// GLSN: 11  12  13  14  15  16
+		// key:  a   b   a   -   b   a
+		// data: 1   2   3   4   5   (tombstone)
+		keys := [][]byte{[]byte("a"), []byte("b"), []byte("a"), nil, []byte("b"), []byte("a")}
+		wb := stg.NewWriteBatch()
+		for i, key := range keys {
+			llsn := types.LLSN(i + 1)
+			var data []byte
+			if i < 5 {
+				data = []byte(strconv.Itoa(int(llsn)))
+			}
+			assert.NoError(t, wb.Set(llsn, data))
+			assert.NoError(t, wb.SetKey(llsn, key))
+		}
+		assert.NoError(t, wb.Apply())
+		assert.NoError(t, wb.Close())
+
+		cb, err := stg.NewCommitBatch(CommitContext{
+			Version:            1,
+			HighWatermark:      16,
+			CommittedGLSNBegin: 11,
+			CommittedGLSNEnd:   17,
+			CommittedLLSNBegin: 1,
+		})
+		assert.NoError(t, err)
+		for i := range keys {
+			assert.NoError(t, cb.Set(types.LLSN(i+1), types.GLSN(i+11)))
+		}
+		assert.NoError(t, cb.Apply())
+		assert.NoError(t, cb.Close())
+
+		scan := func(opt ScanOption) (survivors, compacted []types.GLSN) {
+			scanner := stg.NewScanner(opt)
+			defer func() {
+				_ = scanner.Close()
+			}()
+			for scanner.Valid() {
+				le, err := scanner.Value()
+				assert.NoError(t, err)
+				if le.Compacted {
+					assert.Empty(t, le.Data)
+					compacted = append(compacted, le.GLSN)
+				} else {
+					survivors = append(survivors, le.GLSN)
+				}
+				scanner.Next()
+			}
+			return survivors, compacted
+		}
+
+		// Only log entries up to the GLSN are considered.
+		n, next, err := stg.Compact(13, nil)
+		assert.NoError(t, err)
+		assert.Nil(t, next)
+		assert.Equal(t, 1, n)
+		survivors, compacted := scan(WithGLSN(11, 17))
+		assert.Equal(t, []types.GLSN{12, 13, 14, 15, 16}, survivors)
+		assert.Equal(t, []types.GLSN{11}, compacted)
+
+		n, next, err = stg.Compact(types.MaxGLSN, nil)
+		assert.NoError(t, err)
+		assert.Nil(t, next)
+		assert.Equal(t, 2, n)
+		survivors, compacted = scan(WithGLSN(11, 17))
+		assert.Equal(t, []types.GLSN{14, 15, 16}, survivors)
+		assert.Equal(t, []types.GLSN{11, 12, 13}, compacted)
+
+		// Scanning by LLSN skips compacted log entries.
+		var llsns []types.LLSN
+		scanner := stg.NewScanner(WithLLSN(1, 7))
+		for scanner.Valid() {
+			le, err := scanner.Value()
+			assert.NoError(t, err)
+			llsns = append(llsns, le.LLSN)
+			scanner.Next()
+		}
+		assert.NoError(t, scanner.Close())
+		assert.Equal(t, []types.LLSN{4, 5, 6}, llsns)
+
+		// The tombstone survives.
+		les, err := stg.LookupByKey([]byte("a"), false)
+		assert.NoError(t, err)
+		assert.Len(t, les, 1)
+		assert.Equal(t, types.GLSN(16), les[0].GLSN)
+		assert.Empty(t, les[0].Data)
+
+		// Compacted log entries keep their sequence numbers.
+		le, err := stg.Read(AtLLSN(2))
+		assert.NoError(t, err)
+		assert.True(t, le.Compacted)
+		assert.Equal(t, types.GLSN(12), le.GLSN)
+
+		report, err := stg.CheckConsistency()
+		assert.NoError(t, err)
+		assert.True(t, report.Consistent(), report.Problems)
+		assert.Equal(t, 6, report.NumCommits)
+		assert.Equal(t, 3, report.NumData)
+
+		n, _, err = stg.Compact(types.MaxGLSN, nil)
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+	}, WithKeyIndex())
+}
+
+func TestStorage_CompactResume(t *testing.T) {
+	defer func(limit int) {
+		compactionScanLimit = limit
+	}(compactionScanLimit)
+	compactionScanLimit = 1
+
+	testStorage(t, func(t testing.TB, stg *Storage) {
+		// GLSN: 11  12  13  14  15  16
+		// key:  a   b   a   b   a   c
+		keys := [][]byte{[]byte("a"), []byte("b"), []byte("a"), []byte("b"), []byte("a"), []byte("c")}
+		wb := stg.NewWriteBatch()
+		for i, key := range keys {
+			llsn := types.LLSN(i + 1)
+			assert.NoError(t, wb.Set(llsn, []byte(strconv.Itoa(int(llsn)))))
+			assert.NoError(t, wb.SetKey(llsn, key))
+		}
+		assert.NoError(t, wb.Apply())
+		assert.NoError(t, wb.Close())
+
+		cb, err := stg.NewCommitBatch(CommitContext{
+			Version:            1,
+			HighWatermark:      16,
+			CommittedGLSNBegin: 11,
+			CommittedGLSNEnd:   17,
+			CommittedLLSNBegin: 1,
+		})
+		assert.NoError(t, err)
+		for i := range keys {
+			assert.NoError(t, cb.Set(types.LLSN(i+1), types.GLSN(i+11)))
+		}
+		assert.NoError(t, cb.Apply())
+		assert.NoError(t, cb.Close())
+
+		// Each call handles all entries of a record key even if they exceed
+		// the limit.
+		var (
+			calls, total int
+			begin        []byte
+		)
+		for {
+			n, next, err := stg.Compact(types.MaxGLSN, begin)
+			assert.NoError(t, err)
+			calls++
+			total += n
+			if next == nil {
+				break
+			}
+			begin = next
+		}
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, 3, total)
+
+		for key, want := range map[string]types.GLSN{"a": 15, "b": 14, "c": 16} {
+			les, err := stg.LookupByKey([]byte(key), false)
+			assert.NoError(t, err)
+			assert.Len(t, les, 1)
+			assert.Equal(t, want, les[0].GLSN)
+		}
+	}, WithKeyIndex())
+}
+
 func TestStorageRead(t *testing.T) {
 	testStorage(t, func(t testing.TB, stg *Storage) {
 		// no logs
@@ -860,3 +1271,574 @@ func TestStorage_Trim(t *testing.T) {
 		})
 	}
 }
+
+func TestStorage_ExportIngestSSTables(t *testing.T) {
+	const numLogs = 100
+
+	src := TestNewStorage(t)
+	dst := TestNewStorage(t)
+	defer func() {
+		assert.NoError(t, src.Close())
+		assert.NoError(t, dst.Close())
+	}()
+
+	data := make([]byte, 1<<10)
+	for i := 1; i <= numLogs; i++ {
+		llsn, glsn := types.LLSN(i), types.GLSN(i*2)
+		TestAppendLogEntryWithoutCommitContext(t, src, llsn, glsn, data)
+	}
+
+	noop := func(SSTableSegment) error { return nil }
+	err := src.ExportSSTables(t.TempDir(),
+		varlogpb.LogSequenceNumber{LLSN: 2, GLSN: 4},
+		varlogpb.LogSequenceNumber{LLSN: 1, GLSN: 2},
+		1<<20, noop,
+	)
+	require.Error(t, err)
+
+	// Small target segment size makes several segments.
+	first := varlogpb.LogSequenceNumber{LLSN: 11, GLSN: 22}
+	last := varlogpb.LogSequenceNumber{LLSN: 90, GLSN: 180}
+	var segs []SSTableSegment
+	err = src.ExportSSTables(t.TempDir(), first, last, 8<<10, func(seg SSTableSegment) error {
+		segs = append(segs, seg)
+		return dst.IngestSSTables(seg.Paths)
+	})
+	require.NoError(t, err)
+	require.Greater(t, len(segs), 1)
+	require.Equal(t, first, segs[0].First)
+	require.Equal(t, last, segs[len(segs)-1].Last)
+	for i := 1; i < len(segs); i++ {
+		require.Equal(t, segs[i-1].Last.LLSN+1, segs[i].First.LLSN)
+		require.Equal(t, segs[i-1].Last.GLSN+2, segs[i].First.GLSN)
+	}
+
+	for i := 1; i <= numLogs; i++ {
+		llsn, glsn := types.LLSN(i), types.GLSN(i*2)
+		le, err := dst.Read(AtGLSN(glsn))
+		if llsn < first.LLSN || llsn > last.LLSN {
+			require.ErrorIs(t, err, ErrNoLogEntry)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, llsn, le.LLSN)
+		require.Equal(t, data, le.Data)
+	}
+
+	_, err = dst.ReadCommitContext()
+	require.ErrorIs(t, err, pebble.ErrNotFound)
+
+	// A log entry without data and commit cannot be exported.
+	TestDeleteLogEntry(t, src, varlogpb.LogSequenceNumber{LLSN: 50, GLSN: 100})
+	err = src.ExportSSTables(t.TempDir(), first, last, 8<<10, noop)
+	require.ErrorIs(t, err, ErrInconsistentWriteCommitState)
+}
+
+func TestStorage_ExportIngestSSTablesKeyIndex(t *testing.T) {
+	stg := TestNewStorage(t, WithKeyIndex())
+	defer func() {
+		assert.NoError(t, stg.Close())
+	}()
+
+	const numLogs = 4
+	wb := stg.NewWriteBatch()
+	for i := 1; i <= numLogs; i++ {
+		llsn := types.LLSN(i)
+		require.NoError(t, wb.Set(llsn, []byte(strconv.Itoa(i))))
+		require.NoError(t, wb.SetKey(llsn, []byte("a")))
+	}
+	require.NoError(t, wb.Apply())
+	require.NoError(t, wb.Close())
+	cb, err := stg.NewCommitBatch(CommitContext{
+		Version:            1,
+		HighWatermark:      numLogs,
+		CommittedGLSNBegin: 1,
+		CommittedGLSNEnd:   numLogs + 1,
+		CommittedLLSNBegin: 1,
+	})
+	require.NoError(t, err)
+	for i := 1; i <= numLogs; i++ {
+		require.NoError(t, cb.Set(types.LLSN(i), types.GLSN(i)))
+	}
+	require.NoError(t, cb.Apply())
+	require.NoError(t, cb.Close())
+	n, _, err := stg.Compact(numLogs, nil)
+	require.NoError(t, err)
+	require.Equal(t, numLogs-1, n)
+
+	// SSTables carry neither record keys nor compacted commits.
+	err = stg.ExportSSTables(t.TempDir(),
+		varlogpb.LogSequenceNumber{LLSN: 1, GLSN: 1},
+		varlogpb.LogSequenceNumber{LLSN: numLogs, GLSN: numLogs},
+		1<<20, func(SSTableSegment) error { return nil },
+	)
+	require.ErrorIs(t, err, errSSTablesKeyIndexed)
+	require.ErrorIs(t, stg.IngestSSTables(nil), errSSTablesKeyIndexed)
+}
+
+func TestStorage_SyncCheckpoint(t *testing.T) {
+	testStorage(t, func(t testing.TB, stg *Storage) {
+		cp := SyncCheckpoint{
+			Peer:   2,
+			First:  varlogpb.LogSequenceNumber{LLSN: 1, GLSN: 10},
+			Last:   varlogpb.LogSequenceNumber{LLSN: 100, GLSN: 1000},
+			Cursor: varlogpb.LogSequenceNumber{LLSN: 50, GLSN: 500},
+		}
+
+		_, err := stg.ReadSyncSourceCheckpoint(cp.Peer)
+		require.ErrorIs(t, err, ErrNoSyncCheckpoint)
+		require.NoError(t, stg.WriteSyncSourceCheckpoint(cp))
+		actual, err := stg.ReadSyncSourceCheckpoint(cp.Peer)
+		require.NoError(t, err)
+		require.Equal(t, cp, actual)
+		_, err = stg.ReadSyncSourceCheckpoint(cp.Peer + 1)
+		require.ErrorIs(t, err, ErrNoSyncCheckpoint)
+		require.NoError(t, stg.DeleteSyncSourceCheckpoint(cp.Peer))
+		_, err = stg.ReadSyncSourceCheckpoint(cp.Peer)
+		require.ErrorIs(t, err, ErrNoSyncCheckpoint)
+
+		_, err = stg.ReadSyncDestinationCheckpoint()
+		require.ErrorIs(t, err, ErrNoSyncCheckpoint)
+		batch := stg.NewAppendBatch()
+		require.NoError(t, batch.SetLogEntry(50, 500, nil))
+		require.NoError(t, batch.SetSyncDestinationCheckpoint(cp))
+		require.NoError(t, batch.Apply())
+		require.NoError(t, batch.Close())
+		actual, err = stg.ReadSyncDestinationCheckpoint()
+		require.NoError(t, err)
+		require.Equal(t, cp, actual)
+
+		// Checkpoints are invisible to log entries.
+		rp, err := stg.ReadRecoveryPoints()
+		require.NoError(t, err)
+		require.EqualValues(t, 50, rp.CommittedLogEntry.First.LLSN)
+		require.EqualValues(t, 50, rp.CommittedLogEntry.Last.LLSN)
+
+		batch = stg.NewAppendBatch()
+		require.NoError(t, batch.DeleteSyncDestinationCheckpoint())
+		require.NoError(t, batch.Apply())
+		require.NoError(t, batch.Close())
+		_, err = stg.ReadSyncDestinationCheckpoint()
+		require.ErrorIs(t, err, ErrNoSyncCheckpoint)
+	})
+}
+
+func TestStorage_Checkpoint(t *testing.T) {
+	testStorage(t, func(t testing.TB, stg *Storage) {
+		const numLogs = 10
+
+		for i := 1; i <= numLogs; i++ {
+			TestAppendLogEntryWithoutCommitContext(t, stg, types.LLSN(i), types.GLSN(i), []byte("foo"))
+		}
+		cc := CommitContext{
+			Version:            1,
+			HighWatermark:      numLogs,
+			CommittedGLSNBegin: 1,
+			CommittedGLSNEnd:   numLogs + 1,
+			CommittedLLSNBegin: 1,
+		}
+		TestSetCommitContext(t, stg, cc)
+		require.NoError(t, stg.WriteSyncSourceCheckpoint(SyncCheckpoint{Peer: 2}))
+
+		dir := filepath.Join(t.TempDir(), "checkpoint")
+		require.NoError(t, stg.Checkpoint(dir))
+		require.Error(t, stg.Checkpoint(dir))
+
+		// Log entries appended after the checkpoint are not in it.
+		TestAppendLogEntryWithoutCommitContext(t, stg, numLogs+1, numLogs+1, []byte("foo"))
+
+		cp, err := New(WithPath(dir))
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, cp.Close())
+		}()
+
+		rp, err := cp.ReadRecoveryPoints()
+		require.NoError(t, err)
+		require.NotNil(t, rp.LastCommitContext)
+		require.Equal(t, cc, *rp.LastCommitContext)
+		require.EqualValues(t, 1, rp.CommittedLogEntry.First.LLSN)
+		require.EqualValues(t, numLogs, rp.CommittedLogEntry.Last.LLSN)
+
+		_, err = cp.ReadSyncSourceCheckpoint(2)
+		require.NoError(t, err)
+		require.NoError(t, cp.DeleteSyncCheckpoints())
+		_, err = cp.ReadSyncSourceCheckpoint(2)
+		require.ErrorIs(t, err, ErrNoSyncCheckpoint)
+	})
+}
+
+func TestStorage_Encryption(t *testing.T) {
+	keyfile := filepath.Join(t.TempDir(), "keyfile")
+	require.NoError(t, encryption.GenerateKEK(keyfile, "kek1"))
+	kp, err := encryption.NewKeyfileProvider(keyfile)
+	require.NoError(t, err)
+
+	testStorage(t, func(t testing.TB, stg *Storage) {
+		require.True(t, stg.Encrypted())
+
+		write := func(llsn types.LLSN, glsn types.GLSN, data string) {
+			wb := stg.NewWriteBatch()
+			require.NoError(t, wb.Set(llsn, []byte(data)))
+			require.NoError(t, wb.SetKey(llsn, []byte("key")))
+			require.NoError(t, wb.Apply())
+			require.NoError(t, wb.Close())
+
+			cb, err := stg.NewCommitBatch(CommitContext{
+				Version:            types.Version(glsn),
+				HighWatermark:      glsn,
+				CommittedGLSNBegin: glsn,
+				CommittedGLSNEnd:   glsn + 1,
+				CommittedLLSNBegin: llsn,
+			})
+			require.NoError(t, err)
+			require.NoError(t, cb.Set(llsn, glsn))
+			require.NoError(t, cb.Apply())
+			require.NoError(t, cb.Close())
+		}
+		write(1, 1, "foo")
+		_, err := stg.RotateDataKey(context.Background())
+		require.NoError(t, err)
+		write(2, 2, "bar")
+		TestAppendLogEntryWithoutCommitContext(t, stg, 3, 3, nil)
+
+		// Data are not written in plaintext.
+		for llsn, data := range map[types.LLSN]string{1: "foo", 2: "bar"} {
+			buf, closer, err := stg.db.Get(encodeDataKeyInternal(llsn, make([]byte, dataKeyLength)))
+			require.NoError(t, err)
+			require.NotContains(t, string(buf), data)
+			require.NoError(t, closer.Close())
+		}
+
+		for _, opt := range []ScanOption{WithGLSN(1, 4), WithLLSN(1, 4)} {
+			scanner := stg.NewScanner(opt)
+			var datas []string
+			for scanner.Valid() {
+				le, err := scanner.Value()
+				require.NoError(t, err)
+				datas = append(datas, string(le.Data))
+				scanner.Next()
+			}
+			require.NoError(t, scanner.Close())
+			require.Equal(t, []string{"foo", "bar", ""}, datas)
+		}
+
+		les, err := stg.LookupByKey([]byte("key"), false)
+		require.NoError(t, err)
+		require.Len(t, les, 2)
+		require.Equal(t, "foo", string(les[0].Data))
+		require.Equal(t, "bar", string(les[1].Data))
+
+		err = stg.ExportSSTables(t.TempDir(), varlogpb.LogSequenceNumber{LLSN: 1, GLSN: 1}, varlogpb.LogSequenceNumber{LLSN: 2, GLSN: 2}, 1<<20, func(SSTableSegment) error {
+			return nil
+		})
+		require.Error(t, err)
+
+		// The checkpoint has the keyring.
+		dir := filepath.Join(t.TempDir(), "checkpoint")
+		require.NoError(t, stg.Checkpoint(dir))
+		_, err = New(WithPath(dir), ReadOnly())
+		require.ErrorIs(t, err, ErrNoKeyProvider)
+		cp, err := New(WithPath(dir), ReadOnly(), WithEncryption(kp))
+		require.NoError(t, err)
+		le, err := cp.Read(AtGLSN(2))
+		require.NoError(t, err)
+		require.Equal(t, "bar", string(le.Data))
+		require.NoError(t, cp.Close())
+
+		// Data moved to another log entry cannot be decrypted.
+		dk1 := encodeDataKeyInternal(1, make([]byte, dataKeyLength))
+		buf, closer, err := stg.db.Get(dk1)
+		require.NoError(t, err)
+		moved := append([]byte(nil), buf...)
+		require.NoError(t, closer.Close())
+		require.NoError(t, stg.db.Set(encodeDataKeyInternal(2, make([]byte, dataKeyLength)), moved, pebble.Sync))
+		_, err = stg.Read(AtGLSN(2))
+		require.Error(t, err)
+	}, WithEncryption(kp), WithKeyIndex())
+
+	t.Run("RotateKEKOnline", func(t *testing.T) {
+		newKeyfile := filepath.Join(t.TempDir(), "keyfile")
+		buf, err := os.ReadFile(keyfile)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(newKeyfile, buf, 0600))
+		newKP, err := encryption.NewKeyfileProvider(newKeyfile)
+		require.NoError(t, err)
+
+		path := t.TempDir()
+		stg, err := New(WithPath(path), WithoutSync(), WithEncryption(newKP))
+		require.NoError(t, err)
+		TestAppendLogEntryWithoutCommitContext(t, stg, 1, 1, []byte("foo"))
+
+		// Rotating the data key of the running storage wraps the data
+		// keys by the kek reloaded.
+		require.NoError(t, encryption.GenerateKEK(newKeyfile, "kek2"))
+		require.NoError(t, newKP.Reload())
+		id, err := stg.RotateDataKey(context.Background())
+		require.NoError(t, err)
+		require.EqualValues(t, 2, id)
+		TestAppendLogEntryWithoutCommitContext(t, stg, 2, 2, []byte("bar"))
+		require.NoError(t, stg.Close())
+
+		buf, err = os.ReadFile(newKeyfile)
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(buf)), "\n")
+		require.NoError(t, os.WriteFile(newKeyfile, []byte(lines[len(lines)-1]), 0600))
+		newKP, err = encryption.NewKeyfileProvider(newKeyfile)
+		require.NoError(t, err)
+		stg, err = New(WithPath(path), WithoutSync(), WithEncryption(newKP))
+		require.NoError(t, err)
+		for glsn, data := range map[types.GLSN]string{1: "foo", 2: "bar"} {
+			le, err := stg.Read(AtGLSN(glsn))
+			require.NoError(t, err)
+			require.Equal(t, data, string(le.Data))
+		}
+		require.NoError(t, stg.Close())
+	})
+
+	t.Run("ReopenAfterKEKRotation", func(t *testing.T) {
+		path := t.TempDir()
+		stg, err := New(WithPath(path), WithoutSync(), WithEncryption(kp))
+		require.NoError(t, err)
+		TestAppendLogEntryWithoutCommitContext(t, stg, 1, 1, []byte("foo"))
+		require.NoError(t, stg.Close())
+
+		_, err = New(WithPath(path), WithoutSync())
+		require.ErrorIs(t, err, ErrNoKeyProvider)
+
+		newKeyfile := filepath.Join(t.TempDir(), "keyfile")
+		buf, err := os.ReadFile(keyfile)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(newKeyfile, buf, 0600))
+		require.NoError(t, encryption.GenerateKEK(newKeyfile, "kek2"))
+		newKP, err := encryption.NewKeyfileProvider(newKeyfile)
+		require.NoError(t, err)
+
+		stg, err = New(WithPath(path), WithoutSync(), WithEncryption(newKP))
+		require.NoError(t, err)
+		require.NoError(t, stg.Close())
+
+		// The old kek is no longer necessary since data keys are
+		// rewrapped by the new one.
+		buf, err = os.ReadFile(newKeyfile)
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(buf)), "\n")
+		require.NoError(t, os.WriteFile(newKeyfile, []byte(lines[len(lines)-1]), 0600))
+		newKP, err = encryption.NewKeyfileProvider(newKeyfile)
+		require.NoError(t, err)
+		stg, err = New(WithPath(path), WithoutSync(), WithEncryption(newKP))
+		require.NoError(t, err)
+		le, err := stg.Read(AtGLSN(1))
+		require.NoError(t, err)
+		require.Equal(t, "foo", string(le.Data))
+		require.NoError(t, stg.Close())
+
+		// An unknown kek cannot unwrap data keys.
+		require.NoError(t, os.WriteFile(newKeyfile, nil, 0600))
+		require.NoError(t, encryption.GenerateKEK(newKeyfile, "kek3"))
+		newKP, err = encryption.NewKeyfileProvider(newKeyfile)
+		require.NoError(t, err)
+		_, err = New(WithPath(path), WithoutSync(), WithEncryption(newKP))
+		require.ErrorIs(t, err, encryption.ErrUnknownKEK)
+	})
+
+	t.Run("PlaintextStorage", func(t *testing.T) {
+		path := t.TempDir()
+		stg, err := New(WithPath(path), WithoutSync())
+		require.NoError(t, err)
+		TestAppendLogEntryWithoutCommitContext(t, stg, 1, 1, []byte("foo"))
+		require.NoError(t, stg.Close())
+
+		stg, err = New(WithPath(path), WithoutSync(), WithEncryption(kp))
+		require.NoError(t, err)
+		require.False(t, stg.Encrypted())
+		le, err := stg.Read(AtGLSN(1))
+		require.NoError(t, err)
+		require.Equal(t, "foo", string(le.Data))
+		_, err = stg.RotateDataKey(context.Background())
+		require.ErrorIs(t, err, ErrNotEncrypted)
+		require.NoError(t, stg.Close())
+	})
+}
+
+func TestStorage_CheckConsistency(t *testing.T) {
+	testStorage(t, func(t testing.TB, stg *Storage) {
+		report, err := stg.CheckConsistency()
+		require.NoError(t, err)
+		require.True(t, report.Consistent())
+		require.Zero(t, report.NumCommits)
+		require.Zero(t, report.NumData)
+
+		// LLSN: 1 2 3 4 5
+		// GLSN: 1 2 3 - -
+		cb, err := stg.NewCommitBatch(CommitContext{
+			Version:            1,
+			HighWatermark:      3,
+			CommittedGLSNBegin: 1,
+			CommittedGLSNEnd:   4,
+			CommittedLLSNBegin: 1,
+		})
+		require.NoError(t, err)
+		for i := 1; i <= 3; i++ {
+			require.NoError(t, cb.Set(types.LLSN(i), types.GLSN(i)))
+		}
+		require.NoError(t, cb.Apply())
+		require.NoError(t, cb.Close())
+		wb := stg.NewWriteBatch()
+		for i := 1; i <= 5; i++ {
+			require.NoError(t, wb.Set(types.LLSN(i), []byte("foo")))
+		}
+		require.NoError(t, wb.Apply())
+		require.NoError(t, wb.Close())
+
+		report, err = stg.CheckConsistency()
+		require.NoError(t, err)
+		require.True(t, report.Consistent(), report.Problems)
+		require.Equal(t, 3, report.NumCommits)
+		require.Equal(t, 5, report.NumData)
+		require.Equal(t, varlogpb.LogSequenceNumber{LLSN: 1, GLSN: 1}, report.FirstCommitted)
+		require.Equal(t, varlogpb.LogSequenceNumber{LLSN: 3, GLSN: 3}, report.LastCommitted)
+		require.EqualValues(t, 4, report.UncommittedBegin)
+		require.EqualValues(t, 6, report.UncommittedEnd)
+
+		// A commit without data is inconsistent.
+		require.NoError(t, stg.db.Delete(encodeDataKeyInternal(2, make([]byte, dataKeyLength)), pebble.Sync))
+		report, err = stg.CheckConsistency()
+		require.NoError(t, err)
+		require.False(t, report.Consistent())
+
+		_, _, err = stg.DeleteUncommittedLogEntries()
+		require.ErrorIs(t, err, ErrInconsistentWriteCommitState)
+	})
+}
+
+func TestStorage_DeleteUncommittedLogEntries(t *testing.T) {
+	testStorage(t, func(t testing.TB, stg *Storage) {
+		begin, end, err := stg.DeleteUncommittedLogEntries()
+		require.NoError(t, err)
+		require.True(t, begin.Invalid())
+		require.True(t, end.Invalid())
+
+		for i := 1; i <= 3; i++ {
+			TestAppendLogEntryWithoutCommitContext(t, stg, types.LLSN(i), types.GLSN(i), []byte("foo"))
+		}
+		TestSetCommitContext(t, stg, CommitContext{
+			Version:            1,
+			HighWatermark:      3,
+			CommittedGLSNBegin: 1,
+			CommittedGLSNEnd:   4,
+			CommittedLLSNBegin: 1,
+		})
+		wb := stg.NewWriteBatch()
+		for i := 4; i <= 5; i++ {
+			require.NoError(t, wb.Set(types.LLSN(i), []byte("bar")))
+		}
+		require.NoError(t, wb.Apply())
+		require.NoError(t, wb.Close())
+
+		begin, end, err = stg.DeleteUncommittedLogEntries()
+		require.NoError(t, err)
+		require.EqualValues(t, 4, begin)
+		require.EqualValues(t, 6, end)
+
+		report, err := stg.CheckConsistency()
+		require.NoError(t, err)
+		require.True(t, report.Consistent())
+		require.Equal(t, 3, report.NumData)
+		require.True(t, report.UncommittedBegin.Invalid())
+		for i := 1; i <= 3; i++ {
+			_, err := stg.Read(AtLLSN(types.LLSN(i)))
+			require.NoError(t, err)
+		}
+	})
+}
+
+func TestSharedDB(t *testing.T) {
+	sdb, err := OpenSharedDB(WithPath(t.TempDir()), WithoutSync())
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, sdb.Close())
+	}()
+
+	lsPath := filepath.Join(t.TempDir(), "lse")
+	stg, err := New(WithPath(lsPath), WithoutSync(), WithSharedDB(sdb, 1, 1))
+	require.NoError(t, err)
+	require.DirExists(t, lsPath)
+
+	// A log stream cannot be opened twice.
+	_, err = New(WithPath(lsPath), WithoutSync(), WithSharedDB(sdb, 1, 1))
+	require.Error(t, err)
+	require.Error(t, sdb.DeleteLogStream(1, 1))
+
+	for i := 1; i <= 10; i++ {
+		TestAppendLogEntryWithoutCommitContext(t, stg, types.LLSN(i), types.GLSN(i), []byte("foo"))
+	}
+	require.NoError(t, stg.Close())
+
+	// Data survive reopening.
+	stg, err = New(WithPath(lsPath), WithoutSync(), WithSharedDB(sdb, 1, 1))
+	require.NoError(t, err)
+	rp, err := stg.ReadRecoveryPoints()
+	require.NoError(t, err)
+	require.EqualValues(t, 10, rp.CommittedLogEntry.Last.LLSN)
+	require.NoError(t, TestGetUnderlyingDB(t, stg).Flush())
+	require.Positive(t, stg.DiskUsage())
+
+	// Log entries exported from the shared database can be ingested into a
+	// standalone storage, and vice versa.
+	standalone := TestNewStorage(t)
+	last := varlogpb.LogSequenceNumber{LLSN: 10, GLSN: 10}
+	err = stg.ExportSSTables(t.TempDir(), varlogpb.LogSequenceNumber{LLSN: 1, GLSN: 1}, last, 1<<20, func(seg SSTableSegment) error {
+		return standalone.IngestSSTables(seg.Paths)
+	})
+	require.NoError(t, err)
+	le, err := standalone.Read(AtGLSN(10))
+	require.NoError(t, err)
+	require.Equal(t, []byte("foo"), le.Data)
+
+	other, err := New(WithPath(filepath.Join(t.TempDir(), "other")), WithoutSync(), WithSharedDB(sdb, 1, 2))
+	require.NoError(t, err)
+	err = standalone.ExportSSTables(t.TempDir(), varlogpb.LogSequenceNumber{LLSN: 1, GLSN: 1}, last, 1<<20, func(seg SSTableSegment) error {
+		return other.IngestSSTables(seg.Paths)
+	})
+	require.NoError(t, err)
+	le, err = other.Read(AtLLSN(5))
+	require.NoError(t, err)
+	require.EqualValues(t, 5, le.GLSN)
+	require.NoError(t, other.Close())
+	require.NoError(t, standalone.Close())
+
+	// Removing a log stream deletes only its data.
+	require.NoError(t, stg.Close())
+	require.NoError(t, sdb.DeleteLogStream(1, 1))
+	stg, err = New(WithPath(lsPath), WithoutSync(), WithSharedDB(sdb, 1, 1))
+	require.NoError(t, err)
+	_, err = stg.Read(AtGLSN(1))
+	require.ErrorIs(t, err, ErrNoLogEntry)
+	TestAppendLogEntryWithoutCommitContext(t, stg, 1, 1, []byte("foo"))
+	require.NoError(t, stg.Close())
+
+	// Data left without the path are stale.
+	require.NoError(t, os.RemoveAll(lsPath))
+	stg, err = New(WithPath(lsPath), WithoutSync(), WithSharedDB(sdb, 1, 1))
+	require.NoError(t, err)
+	_, err = stg.Read(AtGLSN(1))
+	require.ErrorIs(t, err, ErrNoLogEntry)
+	require.NoError(t, stg.Close())
+
+	other, err = New(WithPath(filepath.Join(t.TempDir(), "other")), WithoutSync(), WithSharedDB(sdb, 1, 2))
+	require.NoError(t, err)
+	require.NoError(t, other.Close())
+
+	// A path having its own database is opened as a standalone storage.
+	standalonePath := t.TempDir()
+	standalone = TestNewStorage(t, WithPath(standalonePath))
+	TestAppendLogEntryWithoutCommitContext(t, standalone, 1, 1, []byte("bar"))
+	require.NoError(t, standalone.Close())
+	stg, err = New(WithPath(standalonePath), WithoutSync(), WithSharedDB(sdb, 1, 3))
+	require.NoError(t, err)
+	le, err = stg.Read(AtGLSN(1))
+	require.NoError(t, err)
+	require.Equal(t, []byte("bar"), le.Data)
+	require.NoError(t, stg.Close())
+}
@@ -0,0 +1,146 @@
+package storage
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+const (
+	syncCheckpointKeyPrefix = byte('s')
+	syncRoleSource          = byte('s')
+	syncRoleDestination     = byte('d')
+
+	syncSourceCheckpointKeyLength = 6  // prefix(1) + role(1) + StorageNodeID(4)
+	syncCheckpointLength          = 52 // StorageNodeID(4) + 3 * LogSequenceNumber(16)
+)
+
+var (
+	ErrNoSyncCheckpoint = errors.New("storage: no sync checkpoint")
+
+	syncDestinationCheckpointKey = []byte{syncCheckpointKeyPrefix, syncRoleDestination}
+)
+
+// SyncCheckpoint is the progress of synchronization between log stream
+// replicas. Both the source and destination replicas persist it so that they
+// can resume the synchronization after failures.
+type SyncCheckpoint struct {
+	// Peer is the storage node on the other side of the synchronization.
+	Peer types.StorageNodeID
+	// First and Last are the range of log entries to copy.
+	First varlogpb.LogSequenceNumber
+	Last  varlogpb.LogSequenceNumber
+	// Cursor is the last log entry copied.
+	Cursor varlogpb.LogSequenceNumber
+}
+
+func encodeSyncSourceCheckpointKey(dst types.StorageNodeID) []byte {
+	key := make([]byte, syncSourceCheckpointKeyLength)
+	key[0] = syncCheckpointKeyPrefix
+	key[1] = syncRoleSource
+	binary.BigEndian.PutUint32(key[2:], uint32(dst))
+	return key
+}
+
+func encodeSyncCheckpoint(cp SyncCheckpoint) []byte {
+	buf := make([]byte, syncCheckpointLength)
+	binary.BigEndian.PutUint32(buf[0:], uint32(cp.Peer))
+	offset := 4
+	for _, lsn := range []varlogpb.LogSequenceNumber{cp.First, cp.Last, cp.Cursor} {
+		binary.BigEndian.PutUint64(buf[offset:], uint64(lsn.LLSN))
+		binary.BigEndian.PutUint64(buf[offset+8:], uint64(lsn.GLSN))
+		offset += 16
+	}
+	return buf
+}
+
+func decodeSyncCheckpoint(buf []byte) (cp SyncCheckpoint) {
+	if len(buf) != syncCheckpointLength {
+		panic("storage: invalid sync checkpoint")
+	}
+	cp.Peer = types.StorageNodeID(binary.BigEndian.Uint32(buf[0:]))
+	offset := 4
+	for _, lsn := range []*varlogpb.LogSequenceNumber{&cp.First, &cp.Last, &cp.Cursor} {
+		lsn.LLSN = types.LLSN(binary.BigEndian.Uint64(buf[offset:]))
+		lsn.GLSN = types.GLSN(binary.BigEndian.Uint64(buf[offset+8:]))
+		offset += 16
+	}
+	return cp
+}
+
+func (s *Storage) readSyncCheckpoint(key []byte) (cp SyncCheckpoint, err error) {
+	buf, closer, err := s.db.Get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			err = ErrNoSyncCheckpoint
+		}
+		return cp, err
+	}
+	defer func() {
+		_ = closer.Close()
+	}()
+	return decodeSyncCheckpoint(buf), nil
+}
+
+// ReadSyncSourceCheckpoint returns the checkpoint of synchronization from
+// this replica to the destination dst. It returns ErrNoSyncCheckpoint if
+// there is no checkpoint.
+func (s *Storage) ReadSyncSourceCheckpoint(dst types.StorageNodeID) (SyncCheckpoint, error) {
+	return s.readSyncCheckpoint(encodeSyncSourceCheckpointKey(dst))
+}
+
+// WriteSyncSourceCheckpoint stores the checkpoint of synchronization from
+// this replica to the destination denoted by cp.Peer.
+func (s *Storage) WriteSyncSourceCheckpoint(cp SyncCheckpoint) error {
+	return s.db.Set(encodeSyncSourceCheckpointKey(cp.Peer), encodeSyncCheckpoint(cp), s.writeOpts)
+}
+
+// DeleteSyncSourceCheckpoint removes the checkpoint of synchronization from
+// this replica to the destination dst.
+func (s *Storage) DeleteSyncSourceCheckpoint(dst types.StorageNodeID) error {
+	return s.db.Delete(encodeSyncSourceCheckpointKey(dst), s.writeOpts)
+}
+
+// ReadSyncDestinationCheckpoint returns the checkpoint of synchronization to
+// this replica. A replica has at most one checkpoint as a destination since
+// it copies log entries from only one source at a time. It returns
+// ErrNoSyncCheckpoint if there is no checkpoint.
+func (s *Storage) ReadSyncDestinationCheckpoint() (SyncCheckpoint, error) {
+	return s.readSyncCheckpoint(syncDestinationCheckpointKey)
+}
+
+// WriteSyncDestinationCheckpoint stores the checkpoint of synchronization to
+// this replica.
+func (s *Storage) WriteSyncDestinationCheckpoint(cp SyncCheckpoint) error {
+	return s.db.Set(syncDestinationCheckpointKey, encodeSyncCheckpoint(cp), s.writeOpts)
+}
+
+// DeleteSyncDestinationCheckpoint removes the checkpoint of synchronization
+// to this replica.
+func (s *Storage) DeleteSyncDestinationCheckpoint() error {
+	return s.db.Delete(syncDestinationCheckpointKey, s.writeOpts)
+}
+
+// SetSyncDestinationCheckpoint inserts the checkpoint of synchronization to
+// the replica. It makes the checkpoint consistent with copied log entries in
+// the same batch.
+func (ab *AppendBatch) SetSyncDestinationCheckpoint(cp SyncCheckpoint) error {
+	return ab.batch.Set(syncDestinationCheckpointKey, encodeSyncCheckpoint(cp), nil)
+}
+
+// DeleteSyncDestinationCheckpoint removes the checkpoint of synchronization
+// to the replica, for instance, when the batch completes the synchronization.
+func (ab *AppendBatch) DeleteSyncDestinationCheckpoint() error {
+	return ab.batch.Delete(syncDestinationCheckpointKey, nil)
+}
+
+// DeleteSyncCheckpoints removes all checkpoints of synchronization regardless
+// of their roles. A replica restored from a checkpoint of another replica
+// should not inherit the progress of synchronization of the origin.
+func (s *Storage) DeleteSyncCheckpoints() error {
+	return s.db.DeleteRange([]byte{syncCheckpointKeyPrefix}, []byte{syncCheckpointKeyPrefix + 1}, s.writeOpts)
+}
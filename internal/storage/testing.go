@@ -26,7 +26,7 @@ func TestNewStorage(tb testing.TB, opts ...Option) *Storage {
 func TestGetUnderlyingDB(tb testing.TB, stg *Storage) *pebble.DB {
 	require.NotNil(tb, stg)
 	require.NotNil(tb, stg.db)
-	return stg.db
+	return stg.db.db
 }
 
 // TestAppendLogEntryWithoutCommitContext stores log entries without commit
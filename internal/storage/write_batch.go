@@ -5,6 +5,7 @@ import (
 
 	"github.com/cockroachdb/pebble"
 
+	"github.com/kakao/varlog/internal/storage/encryption"
 	"github.com/kakao/varlog/pkg/types"
 )
 
@@ -12,27 +13,36 @@ var writeBatchPool = sync.Pool{
 	New: func() interface{} {
 		return &WriteBatch{
 			dk: make([]byte, dataKeyLength),
+			rk: make([]byte, recordKeyLength),
 		}
 	},
 }
 
 // WriteBatch is a batch of writes to storage.
 type WriteBatch struct {
-	batch     *pebble.Batch
+	batch     *prefixedBatch
 	writeOpts *pebble.WriteOptions
+	keyIndex  bool
+	keyring   *encryption.Keyring
+	buf       []byte
 	dk        []byte
+	rk        []byte
 }
 
-func newWriteBatch(batch *pebble.Batch, writeOpts *pebble.WriteOptions) *WriteBatch {
+func newWriteBatch(batch *prefixedBatch, writeOpts *pebble.WriteOptions, keyIndex bool, keyring *encryption.Keyring) *WriteBatch {
 	wb := writeBatchPool.Get().(*WriteBatch)
 	wb.batch = batch
 	wb.writeOpts = writeOpts
+	wb.keyIndex = keyIndex
+	wb.keyring = keyring
 	return wb
 }
 
 func (wb *WriteBatch) release() {
 	wb.batch = nil
 	wb.writeOpts = nil
+	wb.keyIndex = false
+	wb.keyring = nil
 	writeBatchPool.Put(wb)
 }
 
@@ -56,9 +66,33 @@ func (wb *WriteBatch) Deferred(poolIdx int) *DeferredWriteBatch {
 //	return nil
 //}
 
-// Set writes the given LLSN and data to the batch.
-func (wb *WriteBatch) Set(llsn types.LLSN, data []byte) error {
-	return wb.batch.Set(encodeDataKeyInternal(llsn, wb.dk), data, nil)
+// Set writes the given LLSN and data to the batch. The data is encrypted if
+// the storage is encrypted.
+func (wb *WriteBatch) Set(llsn types.LLSN, data []byte) (err error) {
+	dk := encodeDataKeyInternal(llsn, wb.dk)
+	data, err = encryptValue(wb.keyring, wb.buf, data, dk)
+	if err != nil {
+		return err
+	}
+	if wb.keyring != nil {
+		wb.buf = data
+	}
+	return wb.batch.Set(dk, data, nil)
+}
+
+// SetKey writes the record key of the log entry at the given LLSN to the
+// batch. It is indexed when the log entry is committed. If the key is empty,
+// it removes the record key left at the LLSN, for instance, by an uncommitted
+// log entry that was discarded. It does nothing if the key index is disabled.
+func (wb *WriteBatch) SetKey(llsn types.LLSN, key []byte) error {
+	if !wb.keyIndex {
+		return nil
+	}
+	rk := encodeRecordKeyInternal(llsn, wb.rk)
+	if len(key) == 0 {
+		return wb.batch.Delete(rk, nil)
+	}
+	return wb.batch.Set(rk, key, nil)
 }
 
 // SetDeferred writes the given LLSN and data to the batch.
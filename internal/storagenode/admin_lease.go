@@ -0,0 +1,48 @@
+package storagenode
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/kakao/varlog/pkg/rpc"
+	"github.com/kakao/varlog/pkg/verrors"
+)
+
+// adminLeaseFence rejects RPCs changing the storage node if they are sent by
+// an admin server whose lease epoch is older than the latest one the storage
+// node has seen. Hence, an admin server that has lost its lease cannot change
+// the storage node once the new leader has sent a request. RPCs without
+// epochs are not fenced.
+type adminLeaseFence struct {
+	epoch atomic.Uint64
+}
+
+func (f *adminLeaseFence) unaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if grpcAdminLeaseFenceList[info.FullMethod] {
+		if err := f.check(rpc.AdminLeaseEpochFromIncomingContext(ctx)); err != nil {
+			return nil, verrors.ToStatusErrorWithCode(err, codes.FailedPrecondition)
+		}
+	}
+	return handler(ctx, req)
+}
+
+// check returns an error if the argument epoch is older than the latest one.
+// Otherwise, it makes the epoch the latest one.
+func (f *adminLeaseFence) check(epoch uint64) error {
+	if epoch == 0 {
+		return nil
+	}
+	for {
+		latest := f.epoch.Load()
+		if epoch < latest {
+			return fmt.Errorf("storage node: admin lease epoch %d older than %d: %w", epoch, latest, verrors.ErrStaleAdminLease)
+		}
+		if epoch == latest || f.epoch.CompareAndSwap(latest, epoch) {
+			return nil
+		}
+	}
+}
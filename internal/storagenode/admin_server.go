@@ -21,7 +21,7 @@ func (as *adminServer) GetMetadata(ctx context.Context, _ *snpb.GetMetadataReque
 }
 
 func (as *adminServer) AddLogStreamReplica(ctx context.Context, req *snpb.AddLogStreamReplicaRequest) (*snpb.AddLogStreamReplicaResponse, error) {
-	lsrmd, err := as.sn.addLogStreamReplica(ctx, req.TopicID, req.LogStreamID, req.StorageNodePath)
+	lsrmd, err := as.sn.addLogStreamReplica(ctx, req.TopicID, req.LogStreamID, req.StorageNodePath, req.Compacted)
 	if err != nil {
 		return nil, err
 	}
@@ -67,3 +67,18 @@ func (as *adminServer) Trim(ctx context.Context, req *snpb.TrimRequest) (*snpb.T
 	results := as.sn.trim(ctx, req.TopicID, req.LastGLSN)
 	return &snpb.TrimResponse{Results: results}, nil
 }
+
+func (as *adminServer) SetSyncBandwidth(_ context.Context, req *snpb.SetSyncBandwidthRequest) (*snpb.SetSyncBandwidthResponse, error) {
+	prev, err := as.sn.setSyncBandwidth(req.BytesPerSecond)
+	return &snpb.SetSyncBandwidthResponse{BytesPerSecond: prev}, err
+}
+
+func (as *adminServer) SetAppendQuota(_ context.Context, req *snpb.SetAppendQuotaRequest) (*snpb.SetAppendQuotaResponse, error) {
+	prev, err := as.sn.setAppendQuota(req.TopicID, req.LogStreamID, req.Quota)
+	return &snpb.SetAppendQuotaResponse{Quota: prev}, err
+}
+
+func (as *adminServer) CheckpointLogStreamReplica(ctx context.Context, req *snpb.CheckpointLogStreamReplicaRequest) (*snpb.CheckpointLogStreamReplicaResponse, error) {
+	cp, err := as.sn.checkpointLogStreamReplica(ctx, req)
+	return &snpb.CheckpointLogStreamReplicaResponse{Checkpoint: cp}, err
+}
@@ -0,0 +1,415 @@
+package storagenode
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kakao/varlog/internal/storage"
+	"github.com/kakao/varlog/internal/storagenode/volume"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+const (
+	// checkpointDataDirName is the directory in the checkpoint having the
+	// data of the log stream replica.
+	checkpointDataDirName = "data"
+	// checkpointManifestName is the file in the checkpoint describing it.
+	checkpointManifestName = "checkpoint.json"
+
+	checkpointDirMode  = os.FileMode(0700)
+	checkpointFileMode = os.FileMode(0600)
+)
+
+// checkpointLogStreamReplica makes a consistent point-in-time checkpoint of
+// the log stream replica in the path. The checkpoint is a directory having the
+// data and manifest, or a gzipped tar file of the directory if the argument
+// archive is true. The storage node is locked only to look up the replica,
+// thus, making and archiving the checkpoint block neither closing the storage
+// node nor adding and removing replicas.
+func (sn *StorageNode) checkpointLogStreamReplica(_ context.Context, req *snpb.CheckpointLogStreamReplicaRequest) (snpb.LogStreamReplicaCheckpoint, error) {
+	if req.ClusterID != sn.cid || req.StorageNodeID != sn.snid {
+		return snpb.LogStreamReplicaCheckpoint{}, status.Errorf(codes.InvalidArgument, "storage node: checkpoint: unexpected cluster %d or storage node %d", req.ClusterID, req.StorageNodeID)
+	}
+	if !filepath.IsAbs(req.Path) {
+		return snpb.LogStreamReplicaCheckpoint{}, status.Errorf(codes.InvalidArgument, "storage node: checkpoint: not absolute path %s", req.Path)
+	}
+	cpPath := filepath.Clean(req.Path)
+	if _, err := os.Lstat(cpPath); err == nil {
+		return snpb.LogStreamReplicaCheckpoint{}, status.Errorf(codes.AlreadyExists, "storage node: checkpoint: %s already exists", cpPath)
+	} else if !os.IsNotExist(err) {
+		return snpb.LogStreamReplicaCheckpoint{}, fmt.Errorf("storage node: checkpoint: %w", err)
+	}
+
+	sn.mu.RLock()
+	if sn.closed {
+		sn.mu.RUnlock()
+		return snpb.LogStreamReplicaCheckpoint{}, errors.New("storage node: closed")
+	}
+	lse, loaded := sn.executors.Load(req.TopicID, req.LogStreamID)
+	sn.mu.RUnlock()
+	if !loaded {
+		return snpb.LogStreamReplicaCheckpoint{}, status.Errorf(codes.NotFound, "storage node: checkpoint: no log stream %d", req.LogStreamID)
+	}
+
+	cpDir := cpPath
+	if req.Archive {
+		tmpDir, err := os.MkdirTemp(filepath.Dir(cpPath), "."+filepath.Base(cpPath)+"-")
+		if err != nil {
+			return snpb.LogStreamReplicaCheckpoint{}, fmt.Errorf("storage node: checkpoint: %w", err)
+		}
+		defer func() {
+			_ = os.RemoveAll(tmpDir)
+		}()
+		cpDir = tmpDir
+	} else if err := os.Mkdir(cpDir, checkpointDirMode); err != nil {
+		return snpb.LogStreamReplicaCheckpoint{}, fmt.Errorf("storage node: checkpoint: %w", err)
+	}
+
+	cp, err := makeCheckpoint(lse.Path(), cpDir, lse.Checkpoint, sn.encryptionOptions()...)
+	if err == nil && req.Archive {
+		err = archiveCheckpoint(cpDir, cpPath)
+	}
+	if err != nil {
+		_ = os.RemoveAll(cpPath)
+		return snpb.LogStreamReplicaCheckpoint{}, fmt.Errorf("storage node: checkpoint: %w", err)
+	}
+	cp.Path = cpPath
+
+	sn.logger.Info("checkpoint log stream replica",
+		zap.Int32("tpid", int32(cp.TopicID)),
+		zap.Int32("lsid", int32(cp.LogStreamID)),
+		zap.String("path", cp.Path),
+		zap.String("local_lwm", cp.LocalLowWatermark.String()),
+		zap.String("local_hwm", cp.LocalHighWatermark.String()),
+	)
+	return cp, nil
+}
+
+// makeCheckpoint makes a checkpoint of the replica stored in the lsPath into
+// the directory cpDir by using the function checkpoint, and then writes its
+// manifest. The storage options stgOpts are used to read the checkpoint.
+func makeCheckpoint(lsPath, cpDir string, checkpoint func(dir string) error, stgOpts ...storage.Option) (snpb.LogStreamReplicaCheckpoint, error) {
+	dd, err := volume.ParseDataDir(lsPath)
+	if err != nil {
+		return snpb.LogStreamReplicaCheckpoint{}, err
+	}
+
+	dataDir := filepath.Join(cpDir, checkpointDataDirName)
+	if err := checkpoint(dataDir); err != nil {
+		return snpb.LogStreamReplicaCheckpoint{}, err
+	}
+
+	cp := snpb.LogStreamReplicaCheckpoint{
+		ClusterID:     dd.ClusterID,
+		StorageNodeID: dd.StorageNodeID,
+		TopicID:       dd.TopicID,
+		LogStreamID:   dd.LogStreamID,
+		CreateTime:    time.Now().UTC(),
+	}
+	if err := readCheckpointRecoveryPoints(dataDir, &cp, append(stgOpts, storage.ReadOnly())...); err != nil {
+		return snpb.LogStreamReplicaCheckpoint{}, err
+	}
+
+	buf, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return snpb.LogStreamReplicaCheckpoint{}, err
+	}
+	if err := os.WriteFile(filepath.Join(cpDir, checkpointManifestName), buf, checkpointFileMode); err != nil {
+		return snpb.LogStreamReplicaCheckpoint{}, err
+	}
+	return cp, nil
+}
+
+// readCheckpointRecoveryPoints fills the commit context and local watermarks
+// of the checkpoint cp by reading the data of the checkpoint in the dataDir.
+func readCheckpointRecoveryPoints(dataDir string, cp *snpb.LogStreamReplicaCheckpoint, opts ...storage.Option) (err error) {
+	stg, err := storage.New(append([]storage.Option{storage.WithPath(dataDir)}, opts...)...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, stg.Close())
+	}()
+
+	rp, err := stg.ReadRecoveryPoints()
+	if err != nil {
+		return err
+	}
+	if cc := rp.LastCommitContext; cc != nil {
+		cp.CommitContext = &varlogpb.CommitContext{
+			Version:            cc.Version,
+			HighWatermark:      cc.HighWatermark,
+			CommittedGLSNBegin: cc.CommittedGLSNBegin,
+			CommittedGLSNEnd:   cc.CommittedGLSNEnd,
+			CommittedLLSNBegin: cc.CommittedLLSNBegin,
+		}
+	}
+	if first := rp.CommittedLogEntry.First; first != nil {
+		cp.LocalLowWatermark = varlogpb.LogSequenceNumber{LLSN: first.LLSN, GLSN: first.GLSN}
+	}
+	if last := rp.CommittedLogEntry.Last; last != nil {
+		cp.LocalHighWatermark = varlogpb.LogSequenceNumber{LLSN: last.LLSN, GLSN: last.GLSN}
+	}
+	return nil
+}
+
+// archiveCheckpoint writes the checkpoint directory cpDir into a gzipped tar
+// file named archivePath.
+func archiveCheckpoint(cpDir, archivePath string) (err error) {
+	f, err := os.OpenFile(archivePath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, checkpointFileMode)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, f.Close())
+	}()
+
+	zw := gzip.NewWriter(f)
+	tw := tar.NewWriter(zw)
+	err = filepath.Walk(cpDir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(cpDir, path)
+		if err != nil || name == "." {
+			return err
+		}
+		hdr, err := tar.FileInfoHeader(fi, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(name)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+		src, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(tw, src)
+		return multierr.Append(err, src.Close())
+	})
+	if err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// extractCheckpoint extracts the gzipped tar file archivePath into the
+// directory dir.
+func extractCheckpoint(archivePath, dir string) (err error) {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, f.Close())
+	}()
+
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, checkpointDirMode); err != nil {
+		return err
+	}
+	tr := tar.NewReader(zr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := filepath.Clean(filepath.FromSlash(hdr.Name))
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("invalid entry %s", hdr.Name)
+		}
+		path := filepath.Join(dir, name)
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, checkpointDirMode); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := copyFile(path, tr); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unexpected entry %s", hdr.Name)
+		}
+	}
+}
+
+// copyDir copies regular files in the directory src into the directory dst,
+// which is created if not exist.
+func copyDir(dst, src string) error {
+	return filepath.Walk(src, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, name)
+		if fi.IsDir() {
+			return os.MkdirAll(target, checkpointDirMode)
+		}
+		if !fi.Mode().IsRegular() {
+			return fmt.Errorf("unexpected file %s", path)
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		return multierr.Append(copyFile(target, f), f.Close())
+	})
+}
+
+func copyFile(path string, r io.Reader) (err error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, checkpointFileMode)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, f.Close())
+	}()
+	if _, err := io.Copy(f, r); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// ReadLogStreamReplicaCheckpoint reads the manifest of the checkpoint in the
+// directory cpDir.
+func ReadLogStreamReplicaCheckpoint(cpDir string) (cp snpb.LogStreamReplicaCheckpoint, err error) {
+	buf, err := os.ReadFile(filepath.Join(cpDir, checkpointManifestName))
+	if err != nil {
+		return cp, err
+	}
+	err = json.Unmarshal(buf, &cp)
+	return cp, err
+}
+
+// RestoreLogStreamReplica restores a checkpoint made by the
+// CheckpointLogStreamReplica RPC into a data directory of the storage node
+// snid in the volume. The checkpoint can be either a directory or a gzipped
+// tar file. It returns the data directory of the restored replica that the
+// storage node loads when it starts, or the admin registers it by adding the
+// log stream replica. The restored replica catches up to the other replicas by
+// synchronization.
+//
+// The storage options stgOpts are used to open the restored replica. A
+// checkpoint of an encrypted replica needs storage.WithEncryption with a key
+// provider that can unwrap its data keys.
+func RestoreLogStreamReplica(cpPath, vol string, cid types.ClusterID, snid types.StorageNodeID, stgOpts ...storage.Option) (volume.DataDir, error) {
+	if !filepath.IsAbs(vol) {
+		return volume.DataDir{}, fmt.Errorf("storage node: restore: volume %s not absolute", vol)
+	}
+	snPaths, err := volume.CreateStorageNodePaths([]string{vol}, cid, snid)
+	if err != nil {
+		return volume.DataDir{}, fmt.Errorf("storage node: restore: %w", err)
+	}
+
+	fi, err := os.Stat(cpPath)
+	if err != nil {
+		return volume.DataDir{}, fmt.Errorf("storage node: restore: %w", err)
+	}
+
+	// Data are restored into a temporary directory in the same volume at
+	// first, and then it is renamed to the data directory so that the
+	// storage node never loads a partially restored replica.
+	tmpDir, err := os.MkdirTemp(snPaths[0], ".restore-")
+	if err != nil {
+		return volume.DataDir{}, fmt.Errorf("storage node: restore: %w", err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmpDir)
+	}()
+
+	cpDir := cpPath
+	if !fi.IsDir() {
+		cpDir = filepath.Join(tmpDir, "checkpoint")
+		if err := extractCheckpoint(cpPath, cpDir); err != nil {
+			return volume.DataDir{}, fmt.Errorf("storage node: restore: %w", err)
+		}
+	}
+
+	cp, err := ReadLogStreamReplicaCheckpoint(cpDir)
+	if err != nil {
+		return volume.DataDir{}, fmt.Errorf("storage node: restore: %w", err)
+	}
+	if cp.ClusterID != cid {
+		return volume.DataDir{}, fmt.Errorf("storage node: restore: unexpected cluster id %d", cp.ClusterID)
+	}
+
+	dd := volume.DataDir{
+		Volume:        filepath.Clean(vol),
+		ClusterID:     cid,
+		StorageNodeID: snid,
+		TopicID:       cp.TopicID,
+		LogStreamID:   cp.LogStreamID,
+	}
+	if _, err := os.Stat(dd.String()); err == nil {
+		return volume.DataDir{}, fmt.Errorf("storage node: restore: %s already exists", dd.String())
+	}
+
+	dataDir := filepath.Join(tmpDir, checkpointDataDirName)
+	if err := copyDir(dataDir, filepath.Join(cpDir, checkpointDataDirName)); err != nil {
+		return volume.DataDir{}, fmt.Errorf("storage node: restore: %w", err)
+	}
+
+	var restored snpb.LogStreamReplicaCheckpoint
+	if err := readCheckpointRecoveryPoints(dataDir, &restored, stgOpts...); err != nil {
+		return volume.DataDir{}, fmt.Errorf("storage node: restore: %w", err)
+	}
+	if restored.LocalHighWatermark != cp.LocalHighWatermark {
+		return volume.DataDir{}, fmt.Errorf("storage node: restore: local high watermark %s, expected %s", restored.LocalHighWatermark.String(), cp.LocalHighWatermark.String())
+	}
+	if err := deleteSyncCheckpoints(dataDir, stgOpts...); err != nil {
+		return volume.DataDir{}, fmt.Errorf("storage node: restore: %w", err)
+	}
+
+	if err := os.Rename(dataDir, dd.String()); err != nil {
+		return volume.DataDir{}, fmt.Errorf("storage node: restore: %w", err)
+	}
+	return dd, nil
+}
+
+// deleteSyncCheckpoints removes the progress of synchronization inherited from
+// the origin of the checkpoint.
+func deleteSyncCheckpoints(dataDir string, opts ...storage.Option) (err error) {
+	stg, err := storage.New(append([]storage.Option{storage.WithPath(dataDir)}, opts...)...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, stg.Close())
+	}()
+	return stg.DeleteSyncCheckpoints()
+}
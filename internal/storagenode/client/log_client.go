@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/kakao/varlog/pkg/rpc"
 	"github.com/kakao/varlog/pkg/types"
@@ -42,11 +43,18 @@ func (c *LogClient) reset(rpcConn *rpc.Conn, _ types.ClusterID, target varlogpb.
 // The backup indicates the storage nodes that have backup replicas of that log stream.
 // It returns valid GLSN if the append completes successfully.
 func (c *LogClient) Append(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, data [][]byte, backups ...varlogpb.StorageNode) ([]snpb.AppendResult, error) {
+	return c.AppendWithKeys(ctx, tpid, lsid, data, nil, backups...)
+}
+
+// AppendWithKeys is similar to Append, but it also stores the record keys of
+// the data. The keys should be either empty or as many as the data.
+func (c *LogClient) AppendWithKeys(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, data, keys [][]byte, backups ...varlogpb.StorageNode) ([]snpb.AppendResult, error) {
 	req := &snpb.AppendRequest{
 		TopicID:     tpid,
 		LogStreamID: lsid,
 		Payload:     data,
 		Backups:     backups,
+		Keys:        keys,
 	}
 	rsp, err := c.rpcClient.Append(ctx, req)
 	if err != nil {
@@ -55,8 +63,46 @@ func (c *LogClient) Append(ctx context.Context, tpid types.TopicID, lsid types.L
 	return rsp.Results, nil
 }
 
+// AppendChunkGroup appends the chunks to the log stream as a group. Each chunk
+// is sent in a separate message so that messages do not exceed the maximum
+// message size. The chunks get consecutive LLSNs, and nothing is appended if
+// it fails to send all of them.
+func (c *LogClient) AppendChunkGroup(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, chunks [][]byte) ([]snpb.AppendResult, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.rpcClient.AppendChunkGroup(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("logclient: %w", verrors.FromStatusError(err))
+	}
+	req := &snpb.AppendRequest{
+		TopicID:     tpid,
+		LogStreamID: lsid,
+		Payload:     make([][]byte, 1),
+	}
+	for _, chunk := range chunks {
+		req.Payload[0] = chunk
+		if err := stream.Send(req); err != nil {
+			if err != io.EOF {
+				// Canceling the context aborts the stream, thus, no
+				// chunk is appended.
+				return nil, fmt.Errorf("logclient: %w", verrors.FromStatusError(err))
+			}
+			// The server has closed the stream, and CloseAndRecv
+			// returns the reason.
+			break
+		}
+	}
+	rsp, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, fmt.Errorf("logclient: %w", verrors.FromStatusError(err))
+	}
+	return rsp.Results, nil
+}
+
 // Subscribe gets log entries continuously from the storage node. It guarantees that LLSNs of log
-// entries taken are sequential.
+// entries taken are sequential. Log entries removed by compaction are also
+// delivered with only their GLSNs and LLSNs, and they are marked as compacted.
 func (c *LogClient) Subscribe(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, begin, end types.GLSN) (<-chan SubscribeResult, error) {
 	if begin >= end {
 		return nil, errors.New("logclient: invalid argument")
@@ -88,7 +134,8 @@ func (c *LogClient) Subscribe(ctx context.Context, tpid types.TopicID, lsid type
 						GLSN: rsp.GetGLSN(),
 						LLSN: rsp.GetLLSN(),
 					},
-					Data: rsp.GetPayload(),
+					Data:      rsp.GetPayload(),
+					Compacted: rsp.GetCompacted(),
 				}
 			}
 			select {
@@ -168,6 +215,22 @@ func (c *LogClient) LogStreamReplicaMetadata(ctx context.Context, tpid types.Top
 	return rsp.LogStreamReplica, nil
 }
 
+// LookupByKey returns log entries having the record key in the log stream in
+// ascending order of GLSN. If the argument latest is true, it returns only the
+// latest one.
+func (c *LogClient) LookupByKey(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, key []byte, latest bool) ([]varlogpb.LogEntry, error) {
+	rsp, err := c.rpcClient.LookupByKey(ctx, &snpb.LookupByKeyRequest{
+		TopicID:     tpid,
+		LogStreamID: lsid,
+		Key:         key,
+		Latest:      latest,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("logclient: %w", verrors.FromStatusError(err))
+	}
+	return rsp.LogEntries, nil
+}
+
 // Target returns connected storage node.
 func (c *LogClient) Target() varlogpb.StorageNode {
 	return c.target
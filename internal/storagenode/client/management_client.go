@@ -20,12 +20,15 @@ import (
 type StorageNodeManagementClient interface {
 	Target() varlogpb.StorageNode
 	GetMetadata(ctx context.Context) (*snpb.StorageNodeMetadataDescriptor, error)
-	AddLogStreamReplica(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, snpath string) (snpb.LogStreamReplicaMetadataDescriptor, error)
+	AddLogStreamReplica(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, snpath string, compacted bool) (snpb.LogStreamReplicaMetadataDescriptor, error)
 	RemoveLogStream(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID) error
 	Seal(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, lastCommittedGLSN types.GLSN) (varlogpb.LogStreamStatus, types.GLSN, error)
 	Unseal(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, replicas []varlogpb.LogStreamReplica) error
 	Sync(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, backupStorageNodeID types.StorageNodeID, backupAddress string, lastGLSN types.GLSN) (*snpb.SyncStatus, error)
 	Trim(ctx context.Context, topicID types.TopicID, lastGLSN types.GLSN) (map[types.LogStreamID]error, error)
+	SetSyncBandwidth(ctx context.Context, bytesPerSecond int64) (int64, error)
+	SetAppendQuota(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, quota snpb.AppendQuota) (snpb.AppendQuota, error)
+	CheckpointLogStreamReplica(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, path string, archive bool) (snpb.LogStreamReplicaCheckpoint, error)
 	Close() error
 }
 
@@ -83,7 +86,7 @@ func (c *ManagementClient) GetMetadata(ctx context.Context) (*snpb.StorageNodeMe
 	)
 }
 
-func (c *ManagementClient) AddLogStreamReplica(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, snpath string) (snpb.LogStreamReplicaMetadataDescriptor, error) {
+func (c *ManagementClient) AddLogStreamReplica(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, snpath string, compacted bool) (snpb.LogStreamReplicaMetadataDescriptor, error) {
 	if stringsutil.Empty(snpath) {
 		return snpb.LogStreamReplicaMetadataDescriptor{}, errors.New("snmcl: empty path")
 	}
@@ -94,6 +97,7 @@ func (c *ManagementClient) AddLogStreamReplica(ctx context.Context, tpid types.T
 		TopicID:         tpid,
 		LogStreamID:     lsid,
 		StorageNodePath: snpath,
+		Compacted:       compacted,
 	})
 	if err != nil {
 		return snpb.LogStreamReplicaMetadataDescriptor{}, err
@@ -165,6 +169,48 @@ func (c *ManagementClient) Trim(ctx context.Context, topicID types.TopicID, last
 	return ret, errors.WithStack(verrors.FromStatusError(err))
 }
 
+// SetSyncBandwidth changes the limit of bandwidth for synchronization in the
+// storage node, and returns the previous one. Zero means unlimited.
+func (c *ManagementClient) SetSyncBandwidth(ctx context.Context, bytesPerSecond int64) (int64, error) {
+	rsp, err := c.rpcClient.SetSyncBandwidth(ctx, &snpb.SetSyncBandwidthRequest{
+		ClusterID:      c.cid,
+		StorageNodeID:  c.target.StorageNodeID,
+		BytesPerSecond: bytesPerSecond,
+	})
+	return rsp.GetBytesPerSecond(), errors.Wrap(verrors.FromStatusError(err), "snmcl")
+}
+
+// SetAppendQuota changes the quota of appends to the log stream in the storage
+// node, and returns the previous one. If the logStreamID is invalid, it
+// changes the quota of the topic. Zero means unlimited.
+func (c *ManagementClient) SetAppendQuota(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, quota snpb.AppendQuota) (snpb.AppendQuota, error) {
+	rsp, err := c.rpcClient.SetAppendQuota(ctx, &snpb.SetAppendQuotaRequest{
+		ClusterID:     c.cid,
+		StorageNodeID: c.target.StorageNodeID,
+		TopicID:       topicID,
+		LogStreamID:   logStreamID,
+		Quota:         quota,
+	})
+	return rsp.GetQuota(), errors.Wrap(verrors.FromStatusError(err), "snmcl")
+}
+
+// CheckpointLogStreamReplica makes a consistent point-in-time checkpoint of
+// the log stream replica in the path of the storage node.
+func (c *ManagementClient) CheckpointLogStreamReplica(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, path string, archive bool) (snpb.LogStreamReplicaCheckpoint, error) {
+	rsp, err := c.rpcClient.CheckpointLogStreamReplica(ctx, &snpb.CheckpointLogStreamReplicaRequest{
+		ClusterID:     c.cid,
+		StorageNodeID: c.target.StorageNodeID,
+		TopicID:       topicID,
+		LogStreamID:   logStreamID,
+		Path:          path,
+		Archive:       archive,
+	})
+	if err != nil {
+		return snpb.LogStreamReplicaCheckpoint{}, errors.Wrap(verrors.FromStatusError(err), "snmcl")
+	}
+	return rsp.Checkpoint, nil
+}
+
 // Close closes connection to the storage node.
 // Deprecated: Use `Manager[*ManagementClient]`.
 func (c *ManagementClient) Close() error {
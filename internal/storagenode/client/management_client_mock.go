@@ -39,18 +39,33 @@ func (m *MockStorageNodeManagementClient) EXPECT() *MockStorageNodeManagementCli
 }
 
 // AddLogStreamReplica mocks base method.
-func (m *MockStorageNodeManagementClient) AddLogStreamReplica(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID, arg3 string) (snpb.LogStreamReplicaMetadataDescriptor, error) {
+func (m *MockStorageNodeManagementClient) AddLogStreamReplica(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID, arg3 string, arg4 bool) (snpb.LogStreamReplicaMetadataDescriptor, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddLogStreamReplica", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "AddLogStreamReplica", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(snpb.LogStreamReplicaMetadataDescriptor)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddLogStreamReplica indicates an expected call of AddLogStreamReplica.
-func (mr *MockStorageNodeManagementClientMockRecorder) AddLogStreamReplica(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockStorageNodeManagementClientMockRecorder) AddLogStreamReplica(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLogStreamReplica", reflect.TypeOf((*MockStorageNodeManagementClient)(nil).AddLogStreamReplica), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLogStreamReplica", reflect.TypeOf((*MockStorageNodeManagementClient)(nil).AddLogStreamReplica), arg0, arg1, arg2, arg3, arg4)
+}
+
+// CheckpointLogStreamReplica mocks base method.
+func (m *MockStorageNodeManagementClient) CheckpointLogStreamReplica(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID, arg3 string, arg4 bool) (snpb.LogStreamReplicaCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckpointLogStreamReplica", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(snpb.LogStreamReplicaCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckpointLogStreamReplica indicates an expected call of CheckpointLogStreamReplica.
+func (mr *MockStorageNodeManagementClientMockRecorder) CheckpointLogStreamReplica(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckpointLogStreamReplica", reflect.TypeOf((*MockStorageNodeManagementClient)(nil).CheckpointLogStreamReplica), arg0, arg1, arg2, arg3, arg4)
 }
 
 // Close mocks base method.
@@ -112,6 +127,36 @@ func (mr *MockStorageNodeManagementClientMockRecorder) Seal(arg0, arg1, arg2, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockStorageNodeManagementClient)(nil).Seal), arg0, arg1, arg2, arg3)
 }
 
+// SetAppendQuota mocks base method.
+func (m *MockStorageNodeManagementClient) SetAppendQuota(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID, arg3 snpb.AppendQuota) (snpb.AppendQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAppendQuota", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(snpb.AppendQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAppendQuota indicates an expected call of SetAppendQuota.
+func (mr *MockStorageNodeManagementClientMockRecorder) SetAppendQuota(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAppendQuota", reflect.TypeOf((*MockStorageNodeManagementClient)(nil).SetAppendQuota), arg0, arg1, arg2, arg3)
+}
+
+// SetSyncBandwidth mocks base method.
+func (m *MockStorageNodeManagementClient) SetSyncBandwidth(arg0 context.Context, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSyncBandwidth", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSyncBandwidth indicates an expected call of SetSyncBandwidth.
+func (mr *MockStorageNodeManagementClientMockRecorder) SetSyncBandwidth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSyncBandwidth", reflect.TypeOf((*MockStorageNodeManagementClient)(nil).SetSyncBandwidth), arg0, arg1)
+}
+
 // Sync mocks base method.
 func (m *MockStorageNodeManagementClient) Sync(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID, arg3 types.StorageNodeID, arg4 string, arg5 types.GLSN) (*snpb.SyncStatus, error) {
 	m.ctrl.T.Helper()
@@ -4,14 +4,18 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/kakao/varlog/internal/storage"
+	"github.com/kakao/varlog/internal/storage/encryption"
 	"github.com/kakao/varlog/internal/storagenode/logstream"
 	"github.com/kakao/varlog/internal/storagenode/pprof"
 	"github.com/kakao/varlog/internal/storagenode/volume"
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 const (
@@ -21,6 +25,9 @@ const (
 	DefaultReplicateClientReadBufferSize  = 32 << 10
 	DefaultReplicateClientWriteBufferSize = 32 << 10
 	DefaultMaxLogStreamReplicasCount      = -1
+	DefaultCompactionInterval             = time.Minute
+	DefaultMaxChunkGroupSize              = 64 << 20
+	DefaultMaxChunkGroupChunks            = 16 << 10
 )
 
 type config struct {
@@ -35,10 +42,19 @@ type config struct {
 	replicateClientReadBufferSize   int64
 	replicateClientWriteBufferSize  int64
 	maxLogStreamReplicasCount       int32
+	syncBandwidth                   int64
 	volumes                         []string
 	defaultLogStreamExecutorOptions []logstream.ExecutorOption
 	pprofOpts                       []pprof.Option
 	defaultStorageOptions           []storage.Option
+	sharedStorage                   bool
+	compactionInterval              time.Duration
+	maxChunkGroupSize               int64
+	maxChunkGroupChunks             int
+	keyProvider                     encryption.KeyProvider
+	dataKeyRotationInterval         time.Duration
+	topicAppendQuotas               map[types.TopicID]snpb.AppendQuota
+	logStreamAppendQuotas           map[varlogpb.TopicLogStream]snpb.AppendQuota
 	logger                          *zap.Logger
 }
 
@@ -50,6 +66,9 @@ func newConfig(opts []Option) (config, error) {
 		replicateClientReadBufferSize:  DefaultReplicateClientReadBufferSize,
 		replicateClientWriteBufferSize: DefaultReplicateClientWriteBufferSize,
 		maxLogStreamReplicasCount:      DefaultMaxLogStreamReplicasCount,
+		compactionInterval:             DefaultCompactionInterval,
+		maxChunkGroupSize:              DefaultMaxChunkGroupSize,
+		maxChunkGroupChunks:            DefaultMaxChunkGroupChunks,
 		logger:                         zap.NewNop(),
 	}
 	for _, opt := range opts {
@@ -73,9 +92,31 @@ func (cfg *config) validate() error {
 	if cfg.logger == nil {
 		return errors.New("storage node: no logger")
 	}
+	if cfg.syncBandwidth < 0 {
+		return fmt.Errorf("storage node: negative sync bandwidth %d", cfg.syncBandwidth)
+	}
 	if err := cfg.validateVolumes(); err != nil {
 		return fmt.Errorf("storage node: invalid volume: %w", err)
 	}
+	if cfg.compactionInterval <= 0 {
+		return fmt.Errorf("storage node: non-positive compaction interval %v", cfg.compactionInterval)
+	}
+	if cfg.dataKeyRotationInterval < 0 {
+		return fmt.Errorf("storage node: negative data key rotation interval %v", cfg.dataKeyRotationInterval)
+	}
+	if cfg.maxChunkGroupSize <= 0 || cfg.maxChunkGroupChunks <= 0 {
+		return fmt.Errorf("storage node: non-positive chunk group limit %d bytes, %d chunks", cfg.maxChunkGroupSize, cfg.maxChunkGroupChunks)
+	}
+	for tpid, quota := range cfg.topicAppendQuotas {
+		if quota.BytesPerSecond < 0 || quota.RecordsPerSecond < 0 {
+			return fmt.Errorf("storage node: negative append quota of topic %d", tpid)
+		}
+	}
+	for tpls, quota := range cfg.logStreamAppendQuotas {
+		if quota.BytesPerSecond < 0 || quota.RecordsPerSecond < 0 {
+			return fmt.Errorf("storage node: negative append quota of log stream %d in topic %d", tpls.LogStreamID, tpls.TopicID)
+		}
+	}
 	return nil
 }
 
@@ -191,6 +232,16 @@ func WithMaxLogStreamReplicasCount(maxLogStreamReplicasCount int32) Option {
 	})
 }
 
+// WithSyncBandwidth sets the limit of bandwidth for synchronization of all
+// log stream replicas in the storage node in bytes per second. Zero means
+// unlimited, which is the default. It can be changed at runtime by the
+// SetSyncBandwidth RPC.
+func WithSyncBandwidth(bytesPerSecond int64) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.syncBandwidth = bytesPerSecond
+	})
+}
+
 func WithVolumes(volumes ...string) Option {
 	return newFuncOption(func(cfg *config) {
 		cfg.volumes = volumes
@@ -209,6 +260,91 @@ func WithDefaultStorageOptions(defaultStorageOpts ...storage.Option) Option {
 	})
 }
 
+// WithSharedStorage makes log stream replicas in the same volume share a
+// storage database rather than having their own databases. It reduces memory
+// usage and fsync calls when the storage node has many log stream replicas.
+// Replicas that already have their own databases are still opened
+// separately.
+func WithSharedStorage() Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.sharedStorage = true
+	})
+}
+
+// WithCompactionInterval sets the interval of compaction of log stream
+// replicas of compacted topics. Compaction keeps only the latest log entry for
+// each record key, and a log entry having a record key but no data is a
+// tombstone that supersedes older ones. Whether a topic is compacted is
+// decided when it is added through the admin. The default is
+// DefaultCompactionInterval.
+func WithCompactionInterval(interval time.Duration) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.compactionInterval = interval
+	})
+}
+
+// WithMaxChunkGroupSize sets the limits of a chunk group appended by the
+// AppendChunkGroup RPC, the total size of its chunks in bytes and the number
+// of them. The storage node buffers a chunk group until it is received
+// entirely, thus, a chunk group exceeding either of them is rejected while it
+// is being received. The defaults are DefaultMaxChunkGroupSize and
+// DefaultMaxChunkGroupChunks.
+func WithMaxChunkGroupSize(bytes int64, chunks int) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.maxChunkGroupSize = bytes
+		cfg.maxChunkGroupChunks = chunks
+	})
+}
+
+// WithEncryptionKeyProvider makes storages of new log stream replicas
+// encrypted by data keys wrapped by the key provider kp. Replicas already
+// encrypted need it to be loaded, and those not encrypted are left as they
+// are.
+func WithEncryptionKeyProvider(kp encryption.KeyProvider) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.keyProvider = kp
+	})
+}
+
+// WithDataKeyRotationInterval makes the storage node generate new data keys
+// of encrypted log stream replicas every interval while it is running. Before
+// that, it reloads the key-encryption keys if the key provider implements
+// encryption.Reloader, and it wraps the data keys again if the key-encryption
+// key has been rotated; hence, neither rotation needs a restart. Zero, which
+// is the default, disables it.
+func WithDataKeyRotationInterval(interval time.Duration) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.dataKeyRotationInterval = interval
+	})
+}
+
+// WithTopicAppendQuota limits appends to all log stream replicas of the topic
+// in the storage node. Appends exceeding the quota fail with
+// verrors.ErrThrottled, and an append larger than the quota of a second fails
+// with codes.InvalidArgument. Zero in the quota means unlimited, which is the
+// default. It can be changed at runtime by the SetAppendQuota RPC.
+func WithTopicAppendQuota(tpid types.TopicID, quota snpb.AppendQuota) Option {
+	return newFuncOption(func(cfg *config) {
+		if cfg.topicAppendQuotas == nil {
+			cfg.topicAppendQuotas = make(map[types.TopicID]snpb.AppendQuota)
+		}
+		cfg.topicAppendQuotas[tpid] = quota
+	})
+}
+
+// WithLogStreamAppendQuota limits appends to the log stream replica in the
+// storage node. It applies in addition to the quota of the topic. Zero in the
+// quota means unlimited, which is the default. It can be changed at runtime by
+// the SetAppendQuota RPC.
+func WithLogStreamAppendQuota(tpid types.TopicID, lsid types.LogStreamID, quota snpb.AppendQuota) Option {
+	return newFuncOption(func(cfg *config) {
+		if cfg.logStreamAppendQuotas == nil {
+			cfg.logStreamAppendQuotas = make(map[varlogpb.TopicLogStream]snpb.AppendQuota)
+		}
+		cfg.logStreamAppendQuotas[varlogpb.TopicLogStream{TopicID: tpid, LogStreamID: lsid}] = quota
+	})
+}
+
 func WithLogger(logger *zap.Logger) Option {
 	return newFuncOption(func(cfg *config) {
 		cfg.logger = logger
@@ -3,11 +3,15 @@ package storagenode
 var (
 	grpcHandlerLogAllowList map[string]bool
 	grpcPayloadLogAllowList map[string]bool
+	// grpcAdminLeaseFenceList is a set of RPCs that change the storage node,
+	// thus, they are rejected if sent by admin servers having stale leases.
+	grpcAdminLeaseFenceList map[string]bool
 )
 
 func init() {
 	grpcHandlerLogAllowList = make(map[string]bool)
 	grpcPayloadLogAllowList = make(map[string]bool)
+	grpcAdminLeaseFenceList = make(map[string]bool)
 
 	for _, method := range []string{
 		"/varlog.snpb.Management/AddLogStreamReplica",
@@ -16,6 +20,7 @@ func init() {
 		"/varlog.snpb.Management/Unseal",
 		"/varlog.snpb.Management/Sync",
 		"/varlog.snpb.Management/Trim",
+		"/varlog.snpb.Management/CheckpointLogStreamReplica",
 		"/varlog.snpb.LogIO/Read",
 		"/varlog.snpb.LogIO/Subscribe",
 		"/varlog.snpb.LogIO/SubscribeTo",
@@ -38,4 +43,17 @@ func init() {
 	} {
 		grpcPayloadLogAllowList[method] = true
 	}
+
+	for _, method := range []string{
+		"/varlog.snpb.Management/AddLogStreamReplica",
+		"/varlog.snpb.Management/RemoveLogStream",
+		"/varlog.snpb.Management/Seal",
+		"/varlog.snpb.Management/Unseal",
+		"/varlog.snpb.Management/Sync",
+		"/varlog.snpb.Management/Trim",
+		"/varlog.snpb.Management/SetSyncBandwidth",
+		"/varlog.snpb.Management/SetAppendQuota",
+	} {
+		grpcAdminLeaseFenceList[method] = true
+	}
 }
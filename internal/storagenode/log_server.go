@@ -3,9 +3,12 @@ package storagenode
 import (
 	"context"
 	"errors"
+	"io"
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.uber.org/multierr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/kakao/varlog/internal/storagenode/logstream"
 	"github.com/kakao/varlog/pkg/types"
@@ -26,13 +29,80 @@ func (ls logServer) Append(ctx context.Context, req *snpb.AppendRequest) (*snpb.
 	if !loaded {
 		return nil, errors.New("storage node: no such logstream")
 	}
-	res, err := lse.Append(ctx, payload)
+	res, err := lse.Append(ctx, payload, req.Keys...)
 	if err != nil {
-		return nil, err
+		return nil, appendStatusError(err)
 	}
 	return &snpb.AppendResponse{Results: res}, nil
 }
 
+// AppendChunkGroup receives chunks of a record until the client closes the
+// stream, and then appends them to the log stream as a group. Nothing is
+// appended if the stream fails before it is closed. Since the chunks are
+// buffered until then, the stream fails with ResourceExhausted as soon as the
+// chunk group exceeds the limits set by WithMaxChunkGroupSize.
+func (ls logServer) AppendChunkGroup(stream snpb.LogIO_AppendChunkGroupServer) error {
+	var (
+		tpid    types.TopicID
+		lsid    types.LogStreamID
+		payload [][]byte
+		size    int64
+	)
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if len(payload) == 0 {
+			tpid, lsid = req.TopicID, req.LogStreamID
+		} else if req.TopicID != tpid || req.LogStreamID != lsid {
+			return status.Errorf(codes.InvalidArgument, "storage node: chunk group: unexpected log stream %d of topic %d", req.LogStreamID, req.TopicID)
+		}
+		if len(req.Keys) > 0 {
+			return status.Error(codes.InvalidArgument, "storage node: chunk group: keys")
+		}
+		for _, chunk := range req.Payload {
+			size += int64(len(chunk))
+		}
+		payload = append(payload, req.Payload...)
+		if len(payload) > ls.sn.maxChunkGroupChunks {
+			return status.Errorf(codes.ResourceExhausted, "storage node: chunk group: more than %d chunks", ls.sn.maxChunkGroupChunks)
+		}
+		if size > ls.sn.maxChunkGroupSize {
+			return status.Errorf(codes.ResourceExhausted, "storage node: chunk group: larger than %d bytes", ls.sn.maxChunkGroupSize)
+		}
+	}
+	if len(payload) == 0 {
+		return status.Error(codes.InvalidArgument, "storage node: chunk group: no payload")
+	}
+
+	lse, loaded := ls.sn.executors.Load(tpid, lsid)
+	if !loaded {
+		return errors.New("storage node: no such logstream")
+	}
+	res, err := lse.AppendGroup(stream.Context(), payload)
+	if err != nil {
+		return appendStatusError(err)
+	}
+	return stream.SendAndClose(&snpb.AppendResponse{Results: res})
+}
+
+// appendStatusError converts errors of appends exceeding the quota into
+// status errors. A throttled append can be retried later, but an append
+// larger than the quota cannot.
+func appendStatusError(err error) error {
+	switch {
+	case errors.Is(err, verrors.ErrThrottled):
+		return verrors.ToStatusErrorWithCode(err, codes.ResourceExhausted)
+	case errors.Is(err, verrors.ErrInvalid):
+		return verrors.ToStatusErrorWithCode(err, codes.InvalidArgument)
+	}
+	return err
+}
+
 func (ls logServer) Read(context.Context, *snpb.ReadRequest) (*snpb.ReadResponse, error) {
 	panic("not implemented")
 }
@@ -64,6 +134,7 @@ Loop:
 			rsp.GLSN = le.GLSN
 			rsp.LLSN = le.LLSN
 			rsp.Payload = le.Data
+			rsp.Compacted = le.Compacted
 			err = stream.SendMsg(rsp)
 			if err != nil {
 				break Loop
@@ -132,3 +203,16 @@ func (ls logServer) LogStreamReplicaMetadata(_ context.Context, req *snpb.LogStr
 	}
 	return &snpb.LogStreamReplicaMetadataResponse{LogStreamReplica: lsrmd}, nil
 }
+
+func (ls logServer) LookupByKey(ctx context.Context, req *snpb.LookupByKeyRequest) (*snpb.LookupByKeyResponse, error) {
+	lse, loaded := ls.sn.executors.Load(req.TopicID, req.LogStreamID)
+	if !loaded {
+		return nil, errors.New("storage: no such logstream")
+	}
+
+	les, err := lse.LookupByKey(ctx, req.Key, req.Latest)
+	if err != nil {
+		return nil, verrors.ToStatusError(err)
+	}
+	return &snpb.LookupByKeyResponse{LogEntries: les}, nil
+}
@@ -3,6 +3,7 @@ package logstream
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync/atomic"
 	"time"
 
@@ -18,8 +19,23 @@ type appendContext struct {
 	totalBytes int64
 }
 
-// Append appends a batch of logs to the log stream.
-func (lse *Executor) Append(ctx context.Context, dataBatch [][]byte) ([]snpb.AppendResult, error) {
+// Append appends a batch of logs to the log stream. The optional argument
+// keyBatch has record keys of the logs; if given, its length should be the
+// same as that of the dataBatch.
+func (lse *Executor) Append(ctx context.Context, dataBatch [][]byte, keyBatch ...[]byte) ([]snpb.AppendResult, error) {
+	return lse.append(ctx, dataBatch, keyBatch, false)
+}
+
+// AppendGroup appends a batch of logs to the log stream as a group. Unlike
+// Append, the logs get consecutive LLSNs, that is, logs appended by other
+// calls are not interleaved with them. Each log is sequenced and replicated
+// separately so that messages to backup replicas do not carry more than one
+// log of the group.
+func (lse *Executor) AppendGroup(ctx context.Context, dataBatch [][]byte) ([]snpb.AppendResult, error) {
+	return lse.append(ctx, dataBatch, nil, true)
+}
+
+func (lse *Executor) append(ctx context.Context, dataBatch, keyBatch [][]byte, group bool) ([]snpb.AppendResult, error) {
 	atomic.AddInt64(&lse.inflight, 1)
 	atomic.AddInt64(&lse.inflightAppend, 1)
 
@@ -39,6 +55,20 @@ func (lse *Executor) Append(ctx context.Context, dataBatch [][]byte) ([]snpb.App
 		return nil, errors.New("log stream: not primary")
 	}
 
+	if len(keyBatch) > 0 && len(keyBatch) != len(dataBatch) {
+		return nil, fmt.Errorf("log stream: %d keys for %d logs: %w", len(keyBatch), len(dataBatch), verrors.ErrInvalid)
+	}
+
+	if len(lse.appendLimiters) > 0 {
+		numBytes := 0
+		for _, data := range dataBatch {
+			numBytes += len(data)
+		}
+		if err := allowAppend(lse.appendLimiters, len(dataBatch), numBytes); err != nil {
+			return nil, err
+		}
+	}
+
 	startTime := time.Now()
 	var preparationDuration time.Duration
 	dataBatchLen := len(dataBatch)
@@ -59,9 +89,21 @@ func (lse *Executor) Append(ctx context.Context, dataBatch [][]byte) ([]snpb.App
 		atomic.AddInt64(&lse.lsm.AppendPreparationMicro, preparationDuration.Microseconds())
 	}()
 
-	lse.prepareAppendContext(dataBatch, &apc)
-	preparationDuration = time.Since(startTime)
-	lse.sendSequenceTasks(ctx, apc.sts)
+	if group {
+		for i := range dataBatch {
+			lse.prepareAppendContextInternal(dataBatch, nil, i, i+1, 0, &apc)
+		}
+		preparationDuration = time.Since(startTime)
+		lse.muSequence.Lock()
+		lse.sendSequenceTasks(ctx, apc.sts)
+		lse.muSequence.Unlock()
+	} else {
+		lse.prepareAppendContext(dataBatch, keyBatch, &apc)
+		preparationDuration = time.Since(startTime)
+		lse.muSequence.RLock()
+		lse.sendSequenceTasks(ctx, apc.sts)
+		lse.muSequence.RUnlock()
+	}
 	res, err := lse.waitForCompletionOfAppends(ctx, dataBatchLen, apc.awgs)
 	if err == nil {
 		for i := range apc.wwgs {
@@ -71,7 +113,7 @@ func (lse *Executor) Append(ctx context.Context, dataBatch [][]byte) ([]snpb.App
 	return res, err
 }
 
-func (lse *Executor) prepareAppendContext(dataBatch [][]byte, apc *appendContext) {
+func (lse *Executor) prepareAppendContext(dataBatch, keyBatch [][]byte, apc *appendContext) {
 	begin, end := 0, len(dataBatch)
 	for begin < end {
 		batchletClassIdx, batchletLen := batchlet.SelectLengthClass(end - begin)
@@ -80,20 +122,25 @@ func (lse *Executor) prepareAppendContext(dataBatch [][]byte, apc *appendContext
 			batchletEndIdx = end
 		}
 
-		lse.prepareAppendContextInternal(dataBatch, begin, batchletEndIdx, batchletClassIdx, apc)
+		lse.prepareAppendContextInternal(dataBatch, keyBatch, begin, batchletEndIdx, batchletClassIdx, apc)
 		begin = batchletEndIdx
 	}
 }
 
-func (lse *Executor) prepareAppendContextInternal(dataBatch [][]byte, begin, end, batchletClassIdx int, apc *appendContext) {
+func (lse *Executor) prepareAppendContextInternal(dataBatch, keyBatch [][]byte, begin, end, batchletClassIdx int, apc *appendContext) {
 	numBackups := len(lse.primaryBackups) - 1
 	batchletData := dataBatch[begin:end]
+	var batchletKeys [][]byte
+	if len(keyBatch) > 0 {
+		batchletKeys = keyBatch[begin:end]
+	}
 
 	st := newSequenceTask()
 	apc.sts = append(apc.sts, st)
 
 	// data batch
 	st.dataBatch = batchletData
+	st.keyBatch = batchletKeys
 
 	// replicate tasks
 	st.rts = newReplicateTaskSlice()
@@ -102,6 +149,7 @@ func (lse *Executor) prepareAppendContextInternal(dataBatch [][]byte, begin, end
 		rt.tpid = lse.tpid
 		rt.lsid = lse.lsid
 		rt.dataList = batchletData
+		rt.keyList = batchletKeys
 		st.rts = append(st.rts, rt)
 	}
 
@@ -109,6 +109,7 @@ func (bw *backupWriter) writeLoopInternal(_ context.Context, bwt *backupWriteTas
 			zap.Uint64("cas_new", uint64(newLLSN)),
 		)
 	}
+	bw.lse.notifyReport()
 }
 
 func (bw *backupWriter) waitForDrainage(forceDrain bool) {
@@ -0,0 +1,230 @@
+package logstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/kakao/varlog/internal/storage"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+const (
+	// bulkSyncDirPattern is the pattern of temporary directories keeping
+	// SSTables for bulk synchronization. They are made under the directory
+	// of the storage so that the destination can ingest SSTables by hard
+	// links rather than copies.
+	bulkSyncDirPattern = "sync-*"
+
+	bulkSyncTargetSegmentSize = 64 << 20
+	bulkSyncChunkSize         = 1 << 20
+)
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// syncFiles ships log entries in the sync range as segments of SSTables. It
+// builds SSTables in a temporary directory, and then sends them in chunks with
+// checksums. The last chunk of each segment tells the destination the range
+// of the segment so that the destination can ingest it and the
+// synchronization can resume from the next segment after failures.
+func (lse *Executor) syncFiles(ctx context.Context, sc *syncClient, stream snpb.Replicator_SyncReplicateStreamClient, req *snpb.SyncReplicateRequest, st *syncTracker) (err error) {
+	dir, err := os.MkdirTemp(lse.stg.Path(), bulkSyncDirPattern)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, os.RemoveAll(dir))
+	}()
+
+	buf := make([]byte, bulkSyncChunkSize)
+	numSegments := 0
+	err = lse.stg.ExportSSTables(dir, st.syncRange.first, st.syncRange.last, bulkSyncTargetSegmentSize, func(seg storage.SSTableSegment) (err error) {
+		defer func() {
+			for _, path := range seg.Paths {
+				err = multierr.Append(err, os.Remove(path))
+			}
+		}()
+		for i, path := range seg.Paths {
+			var segRange *snpb.SyncRange
+			if i == len(seg.Paths)-1 {
+				segRange = &snpb.SyncRange{FirstLLSN: seg.First.LLSN, LastLLSN: seg.Last.LLSN}
+			}
+			if err := lse.syncFile(ctx, stream, req, path, segRange, buf); err != nil {
+				return fmt.Errorf("sync file %s: %w", filepath.Base(path), err)
+			}
+		}
+		st.setCursor(varlogpb.LogEntryMeta{
+			TopicID:     lse.tpid,
+			LogStreamID: lse.lsid,
+			LLSN:        seg.Last.LLSN,
+			GLSN:        seg.Last.GLSN,
+		})
+		lse.saveSyncCheckpoint(sc.dstReplica.StorageNodeID, st)
+		numSegments++
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+	lse.logger.Info("sync files: shipped", zap.Int("segments", numSegments))
+	return nil
+}
+
+// syncFile sends the file at the path in chunks. If segRange is not nil, the
+// file is the last one of the segment, and its last chunk has the range.
+func (lse *Executor) syncFile(ctx context.Context, stream snpb.Replicator_SyncReplicateStreamClient, req *snpb.SyncReplicateRequest, path string, segRange *snpb.SyncRange, buf []byte) (err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, f.Close())
+	}()
+
+	name := filepath.Base(path)
+	fileCRC := crc32.New(crc32cTable)
+	var offset int64
+	for {
+		n, err := io.ReadFull(f, buf)
+		eof := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !eof {
+			return err
+		}
+		data := buf[:n]
+		_, _ = fileCRC.Write(data)
+		chunk := &snpb.SyncFileChunk{
+			Name:     name,
+			Offset:   offset,
+			Data:     data,
+			Checksum: crc32.Checksum(data, crc32cTable),
+			EOF:      eof,
+		}
+		if eof {
+			chunk.FileChecksum = fileCRC.Sum32()
+			chunk.Segment = segRange
+		}
+		if err := lse.waitSyncBandwidth(ctx, n); err != nil {
+			return err
+		}
+		req.Payload = snpb.SyncPayload{FileChunk: chunk}
+		if err := stream.SendMsg(req); err != nil {
+			return err
+		}
+		if eof {
+			return nil
+		}
+		offset += int64(n)
+	}
+}
+
+// syncFileReceiver writes chunks of SSTables shipped by the source replica
+// into a temporary directory.
+type syncFileReceiver struct {
+	dir   string
+	paths []string
+
+	f       *os.File
+	name    string
+	offset  int64
+	fileCRC hash.Hash32
+}
+
+func newSyncFileReceiver(parent string) (*syncFileReceiver, error) {
+	dir, err := os.MkdirTemp(parent, bulkSyncDirPattern)
+	if err != nil {
+		return nil, err
+	}
+	return &syncFileReceiver{
+		dir:     dir,
+		fileCRC: crc32.New(crc32cTable),
+	}, nil
+}
+
+func (sfr *syncFileReceiver) write(chunk *snpb.SyncFileChunk) error {
+	if crc32.Checksum(chunk.Data, crc32cTable) != chunk.Checksum {
+		return fmt.Errorf("file %s: checksum mismatch at offset %d", chunk.Name, chunk.Offset)
+	}
+
+	if sfr.f == nil {
+		if chunk.Name == "" || chunk.Name != filepath.Base(chunk.Name) {
+			return fmt.Errorf("invalid file name %q", chunk.Name)
+		}
+		path := filepath.Join(sfr.dir, chunk.Name)
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err != nil {
+			return err
+		}
+		sfr.f, sfr.name, sfr.offset = f, chunk.Name, 0
+		sfr.fileCRC.Reset()
+		sfr.paths = append(sfr.paths, path)
+	}
+	if chunk.Name != sfr.name || chunk.Offset != sfr.offset {
+		return fmt.Errorf("file %s: unexpected chunk: expected %s at offset %d, actual %s at offset %d",
+			sfr.name, sfr.name, sfr.offset, chunk.Name, chunk.Offset)
+	}
+
+	if _, err := sfr.f.Write(chunk.Data); err != nil {
+		return err
+	}
+	_, _ = sfr.fileCRC.Write(chunk.Data)
+	sfr.offset += int64(len(chunk.Data))
+	if !chunk.EOF {
+		return nil
+	}
+
+	if sum := sfr.fileCRC.Sum32(); sum != chunk.FileChecksum {
+		return fmt.Errorf("file %s: file checksum mismatch: expected %d, actual %d", sfr.name, chunk.FileChecksum, sum)
+	}
+	err := sfr.f.Sync()
+	err = multierr.Append(err, sfr.f.Close())
+	sfr.f = nil
+	return err
+}
+
+// files returns the paths of received files. It returns an error if a file is
+// still being received.
+func (sfr *syncFileReceiver) files() ([]string, error) {
+	if sfr.f != nil {
+		return nil, fmt.Errorf("file %s: incomplete", sfr.name)
+	}
+	return sfr.paths, nil
+}
+
+// clear removes received files, for instance, after ingesting them.
+func (sfr *syncFileReceiver) clear() (err error) {
+	for _, path := range sfr.paths {
+		err = multierr.Append(err, os.Remove(path))
+	}
+	sfr.paths = nil
+	return err
+}
+
+func (sfr *syncFileReceiver) close() (err error) {
+	if sfr.f != nil {
+		err = sfr.f.Close()
+		sfr.f = nil
+	}
+	return multierr.Append(err, os.RemoveAll(sfr.dir))
+}
+
+// removeBulkSyncDirs removes temporary directories for bulk synchronization
+// left by crashes.
+func removeBulkSyncDirs(parent string) error {
+	dirs, err := filepath.Glob(filepath.Join(parent, bulkSyncDirPattern))
+	if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		err = multierr.Append(err, os.RemoveAll(dir))
+	}
+	return err
+}
@@ -307,6 +307,7 @@ func (cm *committer) commitInternal(cc storage.CommitContext, requireCommitWaitT
 	cm.lse.decider.change(func() {
 		cm.lse.lsc.storeReportCommitBase(cc.Version, cc.HighWatermark, uncommittedBegin, false)
 	})
+	cm.lse.notifyReport()
 
 	for _, cwt := range committedTasks {
 		cwt.awg.commitDone(nil)
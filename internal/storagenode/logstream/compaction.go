@@ -0,0 +1,92 @@
+package logstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+)
+
+// Compact removes committed log entries superseded by newer ones having the
+// same record key. Survivors keep their GLSNs and LLSNs, and subscribers of
+// the log stream receive the removed log entries as compacted ones to skip
+// them. A learning replica refuses it since its data are being replaced by
+// synchronization.
+// It compacts the storage in bounded batches and holds muAdmin only while it
+// compacts a batch, so that it does not block Seal, Trim and so on for long.
+// It returns the number of removed log entries.
+func (lse *Executor) Compact(_ context.Context) (numCompacted int, err error) {
+	atomic.AddInt64(&lse.inflight, 1)
+	defer atomic.AddInt64(&lse.inflight, -1)
+
+	var (
+		glsn types.GLSN
+		next []byte
+	)
+	for first := true; first || next != nil; first = false {
+		var n int
+		n, next, err = lse.compactBatch(&glsn, next)
+		numCompacted += n
+		if err != nil {
+			return numCompacted, fmt.Errorf("log stream: compact: %w", err)
+		}
+	}
+	return numCompacted, nil
+}
+
+// compactBatch compacts a batch of the storage starting from the record key
+// begin. The first call decides the GLSN up to which log entries are
+// compacted by reading the local high watermark.
+func (lse *Executor) compactBatch(glsn *types.GLSN, begin []byte) (int, []byte, error) {
+	lse.muAdmin.Lock()
+	defer lse.muAdmin.Unlock()
+
+	switch lse.esm.load() {
+	case executorStateLearning:
+		return 0, nil, errors.New("learning")
+	case executorStateClosed:
+		return 0, nil, verrors.ErrClosed
+	}
+
+	if glsn.Invalid() {
+		*glsn = lse.lsc.localHighWatermark().GLSN
+		if glsn.Invalid() {
+			return 0, nil, nil
+		}
+	}
+	return lse.stg.Compact(*glsn, begin)
+}
+
+func (lse *Executor) compactionLoop(ctx context.Context) {
+	ticker := time.NewTicker(lse.compactionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		startTime := time.Now()
+		numCompacted, err := lse.Compact(ctx)
+		if err != nil {
+			if !errors.Is(err, verrors.ErrClosed) {
+				lse.logger.Warn("could not compact", zap.Error(err))
+			}
+			continue
+		}
+		if numCompacted > 0 {
+			lse.logger.Info("compacted",
+				zap.Int("num_compacted", numCompacted),
+				zap.Duration("duration", time.Since(startTime)),
+			)
+		}
+	}
+}
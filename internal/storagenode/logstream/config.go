@@ -1,9 +1,11 @@
 package logstream
 
 import (
+	"fmt"
 	"time"
 
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
 
 	"github.com/kakao/varlog/internal/storage"
@@ -34,6 +36,11 @@ type executorConfig struct {
 	logger                       *zap.Logger
 	lsm                          *telemetry.LogStreamMetrics
 	syncTimeout                  time.Duration
+	bulkSync                     bool
+	syncRateLimiter              *rate.Limiter
+	reportNotifier               func()
+	compactionInterval           time.Duration
+	appendLimiters               []*AppendLimiter
 }
 
 func newExecutorConfig(opts []ExecutorOption) (executorConfig, error) {
@@ -44,6 +51,7 @@ func newExecutorConfig(opts []ExecutorOption) (executorConfig, error) {
 		replicateClientQueueCapacity: DefaultReplicateClientQueueCapacity,
 		logger:                       zap.NewNop(),
 		syncTimeout:                  DefaultSyncTimeout,
+		bulkSync:                     true,
 	}
 	for _, opt := range opts {
 		opt.applyExecutor(&cfg)
@@ -77,6 +85,12 @@ func (cfg executorConfig) validate() error {
 	if cfg.logger == nil {
 		return errLoggerIsNil
 	}
+	if cfg.compactionInterval < 0 {
+		return fmt.Errorf("log stream: negative compaction interval %v", cfg.compactionInterval)
+	}
+	if cfg.compactionInterval > 0 && !cfg.stg.KeyIndex() {
+		return fmt.Errorf("log stream: compaction: %w", storage.ErrNoKeyIndex)
+	}
 	return nil
 }
 
@@ -174,6 +188,15 @@ func WithLogStreamMetrics(lsm *telemetry.LogStreamMetrics) ExecutorOption {
 	})
 }
 
+// WithReportNotifier sets a function called whenever the report of the log
+// stream may change, for instance, when log entries are written or committed.
+// It must not block.
+func WithReportNotifier(reportNotifier func()) ExecutorOption {
+	return newFuncExecutorOption(func(cfg *executorConfig) {
+		cfg.reportNotifier = reportNotifier
+	})
+}
+
 // WithSyncTimeout sets timeout for synchronization in the destination replica.
 // If the destination replica doesn't receive the SyncReplicate RPC within
 // syncTimeout, other SyncInit RPC can cancel the synchronization.
@@ -182,3 +205,48 @@ func WithSyncTimeout(syncTimeout time.Duration) ExecutorOption {
 		cfg.syncTimeout = syncTimeout
 	})
 }
+
+// WithBulkSync sets whether the replica ships or accepts SSTables during
+// synchronization. Bulk synchronization is used only if both the source and
+// destination replicas enable it; otherwise, log entries are copied one by
+// one. It is enabled by default.
+func WithBulkSync(bulkSync bool) ExecutorOption {
+	return newFuncExecutorOption(func(cfg *executorConfig) {
+		cfg.bulkSync = bulkSync
+	})
+}
+
+// WithSyncRateLimiter sets a limiter of bandwidth for synchronization in bytes
+// per second. Replicas in a storage node can share the limiter so that the
+// limit applies to the storage node. If it is nil, the bandwidth is not
+// limited.
+func WithSyncRateLimiter(syncRateLimiter *rate.Limiter) ExecutorOption {
+	return newFuncExecutorOption(func(cfg *executorConfig) {
+		cfg.syncRateLimiter = syncRateLimiter
+	})
+}
+
+// WithCompactionInterval makes the replica compact its log entries every
+// compactionInterval. Compaction removes log entries superseded by newer ones
+// having the same record key; thus, the storage should maintain the key
+// index. If it is zero, which is the default, the replica is not compacted.
+func WithCompactionInterval(compactionInterval time.Duration) ExecutorOption {
+	return newFuncExecutorOption(func(cfg *executorConfig) {
+		cfg.compactionInterval = compactionInterval
+	})
+}
+
+// WithAppendLimiters sets limiters of appends to the replica. An append is
+// rejected with verrors.ErrThrottled if any of the limiters disallows it.
+// Replicas can share limiters, for instance, to limit appends to a topic in a
+// storage node. Nil limiters are ignored.
+func WithAppendLimiters(appendLimiters ...*AppendLimiter) ExecutorOption {
+	return newFuncExecutorOption(func(cfg *executorConfig) {
+		cfg.appendLimiters = cfg.appendLimiters[:0]
+		for _, al := range appendLimiters {
+			if al != nil {
+				cfg.appendLimiters = append(cfg.appendLimiters, al)
+			}
+		}
+	})
+}
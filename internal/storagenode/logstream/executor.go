@@ -48,8 +48,11 @@ type Executor struct {
 		glsn types.GLSN
 	}
 
-	// muAdmin makes Seal, Unseal, SyncInit and SyncReplicate, Trim methods run mutually exclusively.
+	// muAdmin makes Seal, Unseal, SyncInit and SyncReplicate, Trim, Checkpoint and each batch of Compact run mutually exclusively.
 	muAdmin sync.Mutex
+	// muSequence makes AppendGroup send its sequence tasks to the sequencer
+	// exclusively so that the logs of the group get consecutive LLSNs.
+	muSequence sync.RWMutex
 	// primaryBackups is a slice of replicas of a log stream.
 	// It is updated by Unseal and is read by many codes.
 	primaryBackups []varlogpb.LogStreamReplica
@@ -60,10 +63,19 @@ type Executor struct {
 		// canceled by another SyncInit.
 		lastSyncTime time.Time
 		srcReplica   types.StorageNodeID
+		// files receives SSTables if the source replica ships them. It is
+		// created lazily by SyncReplicate.
+		files *syncFileReceiver
+		// checkpoint is the last checkpoint persisted by the replica.
+		checkpoint storage.SyncCheckpoint
 	}
 	sts        map[types.StorageNodeID]*syncTracker
 	syncRunner *runner.Runner
 
+	// compactionRunner runs compaction periodically. It is nil if the
+	// compaction is disabled.
+	compactionRunner *runner.Runner
+
 	// replica connector for replication
 	rcs *replicateClients
 
@@ -99,6 +111,15 @@ func NewExecutor(opts ...ExecutorOption) (lse *Executor, err error) {
 
 	lse.syncRunner = runner.New("sync", lse.logger.Named("sync"))
 
+	// SSTables carry neither record keys nor data keys of encryption.
+	if lse.stg.KeyIndex() || lse.stg.Encrypted() {
+		lse.bulkSync = false
+	}
+
+	if err := removeBulkSyncDirs(lse.stg.Path()); err != nil {
+		return nil, err
+	}
+
 	rp, err := lse.stg.ReadRecoveryPoints()
 	if err != nil {
 		return nil, err
@@ -147,11 +168,19 @@ func NewExecutor(opts ...ExecutorOption) (lse *Executor, err error) {
 		lse:           lse,
 		logger:        lse.logger.Named("backup writer"),
 	})
+	if err != nil {
+		return
+	}
+
+	if lse.compactionInterval > 0 {
+		lse.compactionRunner = runner.New("compaction", lse.logger.Named("compaction"))
+		_, err = lse.compactionRunner.Run(lse.compactionLoop)
+	}
 
 	return lse, err
 }
 
-func (lse *Executor) Replicate(ctx context.Context, llsnList []types.LLSN, dataList [][]byte) error {
+func (lse *Executor) Replicate(ctx context.Context, llsnList []types.LLSN, dataList [][]byte, keyList ...[]byte) error {
 	atomic.AddInt64(&lse.inflight, 1)
 	defer atomic.AddInt64(&lse.inflight, -1)
 
@@ -166,6 +195,10 @@ func (lse *Executor) Replicate(ctx context.Context, llsnList []types.LLSN, dataL
 		return errors.New("log stream: not backup")
 	}
 
+	if len(keyList) > 0 && len(keyList) != len(llsnList) {
+		return fmt.Errorf("log stream: %d keys for %d logs: %w", len(keyList), len(llsnList), verrors.ErrInvalid)
+	}
+
 	var preparationDuration time.Duration
 	startTime := time.Now()
 	dataBytes := int64(0)
@@ -186,6 +219,11 @@ func (lse *Executor) Replicate(ctx context.Context, llsnList []types.LLSN, dataL
 	cwts := newListQueue()
 	for i := 0; i < len(llsnList); i++ {
 		_ = wb.Set(llsnList[i], dataList[i])
+		var key []byte
+		if len(keyList) > 0 {
+			key = keyList[i]
+		}
+		_ = wb.SetKey(llsnList[i], key)
 		dataBytes += int64(len(dataList[i]))
 		cwts.PushFront(newCommitWaitTask(nil))
 	}
@@ -363,6 +401,7 @@ func (lse *Executor) resetInternalState(lastCommittedLLSN types.LLSN, discardCom
 
 	// log stream context
 	lse.lsc.uncommittedLLSNEnd.Store(lastCommittedLLSN + 1)
+	lse.notifyReport()
 }
 
 func (lse *Executor) Report(_ context.Context) (report snpb.LogStreamUncommitReport, err error) {
@@ -405,6 +444,13 @@ func (lse *Executor) Report(_ context.Context) (report snpb.LogStreamUncommitRep
 	return report, nil
 }
 
+// notifyReport tells that the report of the log stream may have changed.
+func (lse *Executor) notifyReport() {
+	if lse.reportNotifier != nil {
+		lse.reportNotifier()
+	}
+}
+
 func (lse *Executor) Commit(ctx context.Context, commitResult snpb.LogStreamCommitResult) error {
 	atomic.AddInt64(&lse.inflight, 1)
 	defer atomic.AddInt64(&lse.inflight, -1)
@@ -497,6 +543,28 @@ func (lse *Executor) metadataDescriptor(state executorState) snpb.LogStreamRepli
 	}
 }
 
+// LookupByKey returns committed log entries having the record key in
+// ascending order of GLSN. If the argument latest is true, it returns only the
+// latest one. It fails if the storage does not maintain the key index.
+func (lse *Executor) LookupByKey(_ context.Context, key []byte, latest bool) ([]varlogpb.LogEntry, error) {
+	atomic.AddInt64(&lse.inflight, 1)
+	defer atomic.AddInt64(&lse.inflight, -1)
+
+	if lse.esm.load() == executorStateClosed {
+		return nil, verrors.ErrClosed
+	}
+
+	les, err := lse.stg.LookupByKey(key, latest)
+	if err != nil {
+		return nil, fmt.Errorf("log stream: lookup: %w", err)
+	}
+	for i := range les {
+		les[i].TopicID = lse.tpid
+		les[i].LogStreamID = lse.lsid
+	}
+	return les, nil
+}
+
 func (lse *Executor) Trim(_ context.Context, glsn types.GLSN) error {
 	atomic.AddInt64(&lse.inflight, 1)
 	defer atomic.AddInt64(&lse.inflight, -1)
@@ -563,6 +631,51 @@ func (lse *Executor) Trim(_ context.Context, glsn types.GLSN) error {
 	return nil
 }
 
+// Checkpoint makes a consistent point-in-time checkpoint of the replica in the
+// directory dir, which must not exist. A learning replica refuses it since its
+// data are being replaced by synchronization.
+func (lse *Executor) Checkpoint(dir string) error {
+	atomic.AddInt64(&lse.inflight, 1)
+	defer atomic.AddInt64(&lse.inflight, -1)
+
+	lse.muAdmin.Lock()
+	defer lse.muAdmin.Unlock()
+
+	switch lse.esm.load() {
+	case executorStateLearning:
+		return errors.New("log stream: checkpoint: learning")
+	case executorStateClosed:
+		return verrors.ErrClosed
+	}
+
+	if err := lse.stg.Checkpoint(dir); err != nil {
+		return fmt.Errorf("log stream: checkpoint: %w", err)
+	}
+	return nil
+}
+
+// RotateDataKey generates a new data key of the encrypted storage of the
+// replica while it keeps running, and wraps the data keys again if the
+// key-encryption key has been rotated. It returns storage.ErrNotEncrypted if
+// the storage is not encrypted.
+func (lse *Executor) RotateDataKey(ctx context.Context) (uint32, error) {
+	atomic.AddInt64(&lse.inflight, 1)
+	defer atomic.AddInt64(&lse.inflight, -1)
+
+	lse.muAdmin.Lock()
+	defer lse.muAdmin.Unlock()
+
+	if lse.esm.load() == executorStateClosed {
+		return 0, verrors.ErrClosed
+	}
+
+	id, err := lse.stg.RotateDataKey(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("log stream: rotate data key: %w", err)
+	}
+	return id, nil
+}
+
 // Path returns the data directory where the replica stores its data.
 func (lse *Executor) Path() string {
 	return lse.stg.Path()
@@ -574,6 +687,9 @@ func (lse *Executor) Metrics() *telemetry.LogStreamMetrics {
 
 func (lse *Executor) Close() (err error) {
 	lse.esm.store(executorStateClosed)
+	if lse.compactionRunner != nil {
+		lse.compactionRunner.Stop()
+	}
 	lse.rcs.close()
 	if lse.cm != nil {
 		lse.cm.stop()
@@ -588,7 +704,10 @@ func (lse *Executor) Close() (err error) {
 		lse.bw.stop()
 	}
 	if lse.stg != nil {
+		// Checkpoint holds muAdmin while it reads the storage.
+		lse.muAdmin.Lock()
 		err = lse.stg.Close()
+		lse.muAdmin.Unlock()
 	}
 	lse.decider.destroy()
 	lse.waitForDrainage()
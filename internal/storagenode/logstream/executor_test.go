@@ -3,6 +3,7 @@ package logstream
 import (
 	"context"
 	"flag"
+	"path/filepath"
 	"strconv"
 	"sync"
 	"testing"
@@ -50,6 +51,13 @@ func TestExecutor_InvalidConfig(t *testing.T) {
 
 	_, err = NewExecutor(WithLogger(nil), WithStorage(stg))
 	assert.Error(t, err)
+
+	_, err = NewExecutor(WithCompactionInterval(-1), WithStorage(stg))
+	assert.Error(t, err)
+
+	// compaction without the key index
+	_, err = NewExecutor(WithCompactionInterval(time.Second), WithStorage(stg))
+	assert.ErrorIs(t, err, storage.ErrNoKeyIndex)
 }
 
 func TestExecutor_Closed(t *testing.T) {
@@ -573,6 +581,88 @@ func TestExecutor_Append(t *testing.T) {
 	}
 }
 
+func TestExecutor_AppendGroup(t *testing.T) {
+	const (
+		groupLen   = 40
+		batchLen   = 40
+		numBatches = 4
+		numLogs    = groupLen + batchLen*numBatches
+	)
+
+	lse := testNewPrimaryExecutor(t)
+	defer func() {
+		err := lse.Close()
+		assert.NoError(t, err)
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < numBatches; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			batch := TestNewBatchData(t, batchLen, 1)
+			_, err := lse.Append(context.Background(), batch)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		group := make([][]byte, groupLen)
+		for i := range group {
+			group[i] = []byte{'g'}
+		}
+		res, err := lse.AppendGroup(context.Background(), group)
+		assert.NoError(t, err)
+		assert.Len(t, res, groupLen)
+	}()
+
+	var (
+		lastLLSN    = types.InvalidLLSN
+		lastGLSN    = types.InvalidGLSN
+		lastVersion = types.InvalidVersion
+	)
+	assert.Eventually(t, func() bool {
+		rpt, err := lse.Report(context.Background())
+		assert.NoError(t, err)
+		if rpt.Version == lastVersion+1 {
+			lastVersion++
+			lastLLSN = rpt.UncommittedLLSNOffset - 1
+			lastGLSN = rpt.HighWatermark
+		}
+		if lastLLSN == numLogs {
+			return true
+		}
+		if n := rpt.UncommittedLLSNEnd() - (lastLLSN + 1); n > 0 && rpt.Version == lastVersion {
+			_ = lse.Commit(context.Background(), snpb.LogStreamCommitResult{
+				TopicID:             lse.tpid,
+				LogStreamID:         lse.lsid,
+				CommittedLLSNOffset: lastLLSN + 1,
+				CommittedGLSNOffset: lastGLSN + 1,
+				CommittedGLSNLength: uint64(n),
+				Version:             lastVersion + 1,
+				HighWatermark:       lastGLSN + types.GLSN(n),
+			})
+		}
+		return false
+	}, 5*time.Second, 10*time.Millisecond)
+	wg.Wait()
+
+	// The logs of the group have consecutive LLSNs.
+	sr, err := lse.SubscribeWithLLSN(types.MinLLSN, numLogs+1)
+	require.NoError(t, err)
+	var groupLLSNs []types.LLSN
+	for le := range sr.Result() {
+		if string(le.Data) == "g" {
+			groupLLSNs = append(groupLLSNs, le.LLSN)
+		}
+	}
+	sr.Stop()
+	require.NoError(t, sr.Err())
+	require.Len(t, groupLLSNs, groupLen)
+	require.Equal(t, groupLLSNs[0]+groupLen-1, groupLLSNs[groupLen-1])
+}
+
 func TestExecutor_Replicate(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -2010,6 +2100,130 @@ func TestExecutorSyncReplicate(t *testing.T) {
 				require.Equal(t, types.GLSN(lastCommittedLSN), localHWM)
 			},
 		},
+		{
+			name: "ResumeAfterFailure",
+			testf: func(t *testing.T, dst *Executor, src varlogpb.LogStreamReplica) {
+				const lastCommittedLSN = numLogs + 10
+				makeLearningState(t, dst, src, lastCommittedLSN, snpb.SyncRange{
+					FirstLLSN: 1,
+					LastLLSN:  lastCommittedLSN,
+				}, snpb.SyncRange{
+					FirstLLSN: numLogs + 1,
+					LastLLSN:  lastCommittedLSN,
+				})
+				for lsn := numLogs + 1; lsn <= numLogs+3; lsn++ {
+					err := dst.SyncReplicate(context.Background(), src, snpb.SyncPayload{
+						LogEntry: &varlogpb.LogEntry{
+							LogEntryMeta: varlogpb.LogEntryMeta{
+								TopicID:     dst.tpid,
+								LogStreamID: dst.lsid,
+								LLSN:        types.LLSN(lsn),
+								GLSN:        types.GLSN(lsn),
+							},
+						},
+					})
+					require.NoError(t, err)
+				}
+				cp, err := dst.stg.ReadSyncDestinationCheckpoint()
+				require.NoError(t, err)
+				require.Equal(t, src.StorageNodeID, cp.Peer)
+				require.Equal(t, varlogpb.LogSequenceNumber{LLSN: numLogs + 3, GLSN: numLogs + 3}, cp.Cursor)
+
+				// broken stream
+				err = dst.SyncReplicate(context.Background(), src, snpb.SyncPayload{})
+				require.Error(t, err)
+				require.Equal(t, executorStateSealing, dst.esm.load())
+
+				// The next synchronization resumes after the last copied log entry.
+				syncRange, err := dst.SyncInit(context.Background(), src, snpb.SyncRange{
+					FirstLLSN: 1,
+					LastLLSN:  lastCommittedLSN,
+				})
+				require.NoError(t, err)
+				require.Equal(t, snpb.SyncRange{FirstLLSN: numLogs + 4, LastLLSN: lastCommittedLSN}, syncRange)
+
+				for lsn := numLogs + 4; lsn <= lastCommittedLSN; lsn++ {
+					err := dst.SyncReplicate(context.Background(), src, snpb.SyncPayload{
+						LogEntry: &varlogpb.LogEntry{
+							LogEntryMeta: varlogpb.LogEntryMeta{
+								TopicID:     dst.tpid,
+								LogStreamID: dst.lsid,
+								LLSN:        types.LLSN(lsn),
+								GLSN:        types.GLSN(lsn),
+							},
+						},
+					})
+					require.NoError(t, err)
+				}
+				err = dst.SyncReplicate(context.Background(), src, snpb.SyncPayload{
+					CommitContext: &varlogpb.CommitContext{
+						Version:            types.Version(2),
+						HighWatermark:      lastCommittedLSN,
+						CommittedGLSNBegin: numLogs + 1,
+						CommittedGLSNEnd:   lastCommittedLSN + 1,
+						CommittedLLSNBegin: numLogs + 1,
+					},
+				})
+				require.NoError(t, err)
+
+				_, err = dst.stg.ReadSyncDestinationCheckpoint()
+				require.ErrorIs(t, err, storage.ErrNoSyncCheckpoint)
+			},
+		},
+		{
+			name: "ResumeFromCheckpoint",
+			testf: func(t *testing.T, dst *Executor, src varlogpb.LogStreamReplica) {
+				const lastCommittedLSN = numLogs + 10
+
+				// Log entries copied by the previous synchronization
+				// that the replica does not know.
+				batch := dst.stg.NewAppendBatch()
+				for lsn := numLogs + 1; lsn <= numLogs+5; lsn++ {
+					require.NoError(t, batch.SetLogEntry(types.LLSN(lsn), types.GLSN(lsn), nil))
+				}
+				require.NoError(t, batch.SetSyncDestinationCheckpoint(storage.SyncCheckpoint{
+					Peer:   src.StorageNodeID,
+					First:  varlogpb.LogSequenceNumber{LLSN: numLogs + 1},
+					Last:   varlogpb.LogSequenceNumber{LLSN: lastCommittedLSN},
+					Cursor: varlogpb.LogSequenceNumber{LLSN: numLogs + 5, GLSN: numLogs + 5},
+				}))
+				require.NoError(t, batch.Apply())
+				require.NoError(t, batch.Close())
+
+				makeLearningState(t, dst, src, lastCommittedLSN, snpb.SyncRange{
+					FirstLLSN: 1,
+					LastLLSN:  lastCommittedLSN,
+				}, snpb.SyncRange{
+					FirstLLSN: numLogs + 6,
+					LastLLSN:  lastCommittedLSN,
+				})
+				_, _, uncommittedBegin, _ := dst.lsc.reportCommitBase()
+				require.Equal(t, varlogpb.LogSequenceNumber{LLSN: numLogs + 6, GLSN: numLogs + 6}, uncommittedBegin)
+			},
+		},
+		{
+			name: "IgnoreStaleCheckpoint",
+			testf: func(t *testing.T, dst *Executor, src varlogpb.LogStreamReplica) {
+				const lastCommittedLSN = numLogs + 10
+
+				// The checkpoint points to a log entry that does not
+				// exist.
+				require.NoError(t, dst.stg.WriteSyncDestinationCheckpoint(storage.SyncCheckpoint{
+					Peer:   src.StorageNodeID,
+					First:  varlogpb.LogSequenceNumber{LLSN: numLogs + 1},
+					Last:   varlogpb.LogSequenceNumber{LLSN: lastCommittedLSN},
+					Cursor: varlogpb.LogSequenceNumber{LLSN: numLogs + 5, GLSN: numLogs + 5},
+				}))
+
+				makeLearningState(t, dst, src, lastCommittedLSN, snpb.SyncRange{
+					FirstLLSN: 1,
+					LastLLSN:  lastCommittedLSN,
+				}, snpb.SyncRange{
+					FirstLLSN: numLogs + 1,
+					LastLLSN:  lastCommittedLSN,
+				})
+			},
+		},
 	}
 
 	for _, tc := range tcs {
@@ -2087,6 +2301,22 @@ func TestExecutorSync_InvalidState(t *testing.T) {
 	}
 }
 
+func TestExecutor_Checkpoint(t *testing.T) {
+	lse := testNewPrimaryExecutor(t)
+	defer func() {
+		require.NoError(t, lse.Close())
+	}()
+
+	dir := filepath.Join(t.TempDir(), "checkpoint")
+	require.NoError(t, lse.Checkpoint(dir))
+	require.Error(t, lse.Checkpoint(dir))
+
+	// A learning replica refuses to make a checkpoint.
+	lse.esm.store(executorStateLearning)
+	require.Error(t, lse.Checkpoint(filepath.Join(t.TempDir(), "checkpoint")))
+	lse.esm.store(executorStateSealing)
+}
+
 func TestExecutor_Trim(t *testing.T) {
 	const (
 		numLogs   = 10
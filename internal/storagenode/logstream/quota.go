@@ -0,0 +1,127 @@
+package logstream
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/snpb"
+)
+
+// AppendLimiter limits appends by the total size of data and the number of
+// log entries per second. Log stream replicas can share a limiter, for
+// instance, the limiter of a topic in a storage node. Each limit allows a
+// burst of its one second's worth, and appends exceeding it are rejected
+// rather than delayed so that clients can back off. An append larger than the
+// burst is never allowed.
+type AppendLimiter struct {
+	mu      sync.Mutex
+	quota   snpb.AppendQuota
+	bytes   *rate.Limiter
+	records *rate.Limiter
+}
+
+// NewAppendLimiter creates a limiter with the quota. Zero in the quota means
+// unlimited.
+func NewAppendLimiter(quota snpb.AppendQuota) (*AppendLimiter, error) {
+	if err := validateAppendQuota(quota); err != nil {
+		return nil, err
+	}
+	return &AppendLimiter{
+		quota:   quota,
+		bytes:   newAppendRateLimiter(quota.BytesPerSecond),
+		records: newAppendRateLimiter(quota.RecordsPerSecond),
+	}, nil
+}
+
+// Quota returns the current quota of the limiter.
+func (al *AppendLimiter) Quota() snpb.AppendQuota {
+	al.mu.Lock()
+	defer al.mu.Unlock()
+	return al.quota
+}
+
+// SetQuota changes the quota of the limiter and returns the previous one.
+// Appends are allowed up to the burst of the new quota right after the
+// change.
+func (al *AppendLimiter) SetQuota(quota snpb.AppendQuota) (snpb.AppendQuota, error) {
+	if err := validateAppendQuota(quota); err != nil {
+		return snpb.AppendQuota{}, err
+	}
+	al.mu.Lock()
+	defer al.mu.Unlock()
+	prev := al.quota
+	al.quota = quota
+	al.bytes = newAppendRateLimiter(quota.BytesPerSecond)
+	al.records = newAppendRateLimiter(quota.RecordsPerSecond)
+	return prev, nil
+}
+
+// reserve takes tokens for the append of numRecords log entries having
+// numBytes bytes in total. It appends the reservations to rs so that the
+// caller can cancel them, and it returns an error if the append exceeds the
+// quota. An append larger than the burst is rejected as an invalid argument
+// since it could never be allowed.
+func (al *AppendLimiter) reserve(now time.Time, rs []*rate.Reservation, numRecords, numBytes int) ([]*rate.Reservation, error) {
+	al.mu.Lock()
+	defer al.mu.Unlock()
+	for _, x := range []struct {
+		lim  *rate.Limiter
+		n    int
+		unit string
+	}{
+		{lim: al.records, n: numRecords, unit: "records"},
+		{lim: al.bytes, n: numBytes, unit: "bytes"},
+	} {
+		if x.lim.Limit() == rate.Inf {
+			continue
+		}
+		if burst := x.lim.Burst(); x.n > burst {
+			return rs, fmt.Errorf("log stream: append of %d %s larger than the quota of %d %s/s: %w", x.n, x.unit, burst, x.unit, verrors.ErrInvalid)
+		}
+		r := x.lim.ReserveN(now, x.n)
+		rs = append(rs, r)
+		if !r.OK() || r.DelayFrom(now) > 0 {
+			return rs, fmt.Errorf("log stream: append quota exceeded: %w", verrors.ErrThrottled)
+		}
+	}
+	return rs, nil
+}
+
+// allowAppend returns nil if all limiters allow the append of numRecords log
+// entries having numBytes bytes in total. Otherwise, it takes no tokens from
+// any of them and returns an error wrapping verrors.ErrThrottled, or
+// verrors.ErrInvalid if the append is larger than the burst of a limiter.
+func allowAppend(limiters []*AppendLimiter, numRecords, numBytes int) error {
+	now := time.Now()
+	rs := make([]*rate.Reservation, 0, len(limiters)*2)
+	for _, al := range limiters {
+		var err error
+		rs, err = al.reserve(now, rs, numRecords, numBytes)
+		if err == nil {
+			continue
+		}
+		for i := len(rs) - 1; i >= 0; i-- {
+			rs[i].CancelAt(now)
+		}
+		return err
+	}
+	return nil
+}
+
+func newAppendRateLimiter(perSecond int64) *rate.Limiter {
+	if perSecond == 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	return rate.NewLimiter(rate.Limit(perSecond), int(perSecond))
+}
+
+func validateAppendQuota(quota snpb.AppendQuota) error {
+	if quota.BytesPerSecond < 0 || quota.RecordsPerSecond < 0 {
+		return fmt.Errorf("log stream: negative append quota (%d bytes/s, %d records/s): %w", quota.BytesPerSecond, quota.RecordsPerSecond, verrors.ErrInvalid)
+	}
+	return nil
+}
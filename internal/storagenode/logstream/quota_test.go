@@ -0,0 +1,81 @@
+package logstream
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/snpb"
+)
+
+func TestAppendLimiter(t *testing.T) {
+	_, err := NewAppendLimiter(snpb.AppendQuota{BytesPerSecond: -1})
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	unlimited, err := NewAppendLimiter(snpb.AppendQuota{})
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, allowAppend([]*AppendLimiter{unlimited}, 1000, 1<<20))
+	}
+
+	records, err := NewAppendLimiter(snpb.AppendQuota{RecordsPerSecond: 10})
+	require.NoError(t, err)
+	require.NoError(t, allowAppend([]*AppendLimiter{records}, 6, 0))
+	err = allowAppend([]*AppendLimiter{records}, 6, 0)
+	require.ErrorIs(t, err, verrors.ErrThrottled)
+	require.NoError(t, allowAppend([]*AppendLimiter{records}, 4, 0))
+
+	// A batch larger than the burst is never allowed, and it takes no
+	// tokens.
+	bytes, err := NewAppendLimiter(snpb.AppendQuota{BytesPerSecond: 100})
+	require.NoError(t, err)
+	err = allowAppend([]*AppendLimiter{bytes}, 1, 1000)
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+	require.NotErrorIs(t, err, verrors.ErrThrottled)
+	require.NoError(t, allowAppend([]*AppendLimiter{bytes}, 1, 100))
+	require.ErrorIs(t, allowAppend([]*AppendLimiter{bytes}, 1, 1), verrors.ErrThrottled)
+
+	// A rejected append takes no tokens from any limiters.
+	topic, err := NewAppendLimiter(snpb.AppendQuota{RecordsPerSecond: 10})
+	require.NoError(t, err)
+	logStream, err := NewAppendLimiter(snpb.AppendQuota{RecordsPerSecond: 5})
+	require.NoError(t, err)
+	require.NoError(t, allowAppend([]*AppendLimiter{logStream}, 3, 0))
+	require.ErrorIs(t, allowAppend([]*AppendLimiter{topic, logStream}, 3, 0), verrors.ErrThrottled)
+	require.NoError(t, allowAppend([]*AppendLimiter{topic}, 10, 0))
+
+	// SetQuota
+	prev, err := records.SetQuota(snpb.AppendQuota{RecordsPerSecond: -1})
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+	require.Zero(t, prev)
+	require.Equal(t, snpb.AppendQuota{RecordsPerSecond: 10}, records.Quota())
+
+	prev, err = records.SetQuota(snpb.AppendQuota{})
+	require.NoError(t, err)
+	require.Equal(t, snpb.AppendQuota{RecordsPerSecond: 10}, prev)
+	require.NoError(t, allowAppend([]*AppendLimiter{records}, 1000, 0))
+
+	prev, err = records.SetQuota(snpb.AppendQuota{RecordsPerSecond: 1})
+	require.NoError(t, err)
+	require.Equal(t, snpb.AppendQuota{}, prev)
+	require.NoError(t, allowAppend([]*AppendLimiter{records}, 1, 0))
+	require.ErrorIs(t, allowAppend([]*AppendLimiter{records}, 1, 0), verrors.ErrThrottled)
+}
+
+func TestExecutor_AppendThrottled(t *testing.T) {
+	al, err := NewAppendLimiter(snpb.AppendQuota{RecordsPerSecond: 1})
+	require.NoError(t, err)
+	lse := testNewPrimaryExecutor(t, WithAppendLimiters(al, nil))
+	defer func() {
+		assert.NoError(t, lse.Close())
+	}()
+
+	// Other replicas sharing the limiter exhaust the quota.
+	require.NoError(t, allowAppend([]*AppendLimiter{al}, 1, 0))
+
+	_, err = lse.Append(context.Background(), TestNewBatchData(t, 1, 0))
+	require.ErrorIs(t, err, verrors.ErrThrottled)
+}
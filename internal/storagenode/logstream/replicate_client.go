@@ -138,6 +138,7 @@ func (rc *replicateClient) sendLoopInternal(_ context.Context, rt *replicateTask
 	copy(req.LLSN, rt.llsnList)
 	//req.LLSN = rt.llsnList
 	req.Data = rt.dataList
+	req.Keys = rt.keyList
 	rt.release()
 	err := rc.streamClient.Send(req)
 	inflight := atomic.AddInt64(&rc.inflight, -1)
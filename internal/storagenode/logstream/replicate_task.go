@@ -13,6 +13,7 @@ type replicateTask struct {
 	lsid     types.LogStreamID
 	llsnList []types.LLSN
 	dataList [][]byte
+	keyList  [][]byte
 
 	poolIdx int
 }
@@ -30,6 +31,7 @@ func (rt *replicateTask) release() {
 	rt.lsid = 0
 	rt.llsnList = rt.llsnList[0:0]
 	rt.dataList = nil
+	rt.keyList = nil
 	replicateTaskPools[rt.poolIdx].Put(rt)
 }
 
@@ -112,6 +112,14 @@ func (sq *sequencer) sequenceLoopInternal(ctx context.Context, st *sequenceTask)
 		if err := st.wb.Set(sq.llsn, st.dataBatch[dataIdx]); err != nil {
 			// TODO: handle error
 		}
+		var key []byte
+		if len(st.keyBatch) > 0 {
+			key = st.keyBatch[dataIdx]
+		}
+		//nolint:staticcheck
+		if err := st.wb.SetKey(sq.llsn, key); err != nil {
+			// TODO: handle error
+		}
 		// st.dwb.SetLLSN(dataIdx, sq.llsn)
 	}
 
@@ -247,6 +255,7 @@ type sequenceTask struct {
 	// dwb  *storage.DeferredWriteBatch
 	wb        *storage.WriteBatch
 	dataBatch [][]byte
+	keyBatch  [][]byte
 	cwts      *listQueue
 	rts       []*replicateTask
 }
@@ -272,6 +281,7 @@ func (st *sequenceTask) release() {
 	// st.dwb = nil
 	st.wb = nil
 	st.dataBatch = nil
+	st.keyBatch = nil
 	st.cwts = nil
 	st.rts = nil
 	sequenceTaskPool.Put(st)
@@ -194,7 +194,9 @@ func (lse *Executor) scanWithLLSN(ctx context.Context, begin, end types.LLSN, sr
 			_ = scanner.Next()
 		}
 		_ = scanner.Close()
-		if lastLLSN == end-1 {
+		// Compaction can leave a gap at the end of the range; thus, the
+		// scan finishes if it reaches the end.
+		if lastLLSN == end-1 || scanEnd == end {
 			return nil
 		}
 		if !lastLLSN.Invalid() {
@@ -10,6 +10,7 @@ import (
 
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 
 	"github.com/kakao/varlog/internal/storage"
 	"github.com/kakao/varlog/pkg/rpc"
@@ -19,6 +20,10 @@ import (
 	"github.com/kakao/varlog/proto/varlogpb"
 )
 
+// syncCheckpointInterval is the number of log entries copied between
+// checkpoints of the source replica.
+const syncCheckpointInterval = 1024
+
 type syncTracker struct {
 	syncRange struct {
 		first varlogpb.LogSequenceNumber
@@ -138,6 +143,20 @@ func (lse *Executor) Sync(ctx context.Context, dstReplica varlogpb.LogStreamRepl
 		LLSN: first.LogEntryMeta.LLSN,
 		GLSN: first.LogEntryMeta.GLSN,
 	}, localHWM)
+	// The previous synchronization to the destination stopped halfway. If
+	// the destination resumes where the checkpoint points, the tracker
+	// continues from it.
+	if cp, err := lse.stg.ReadSyncSourceCheckpoint(dstReplica.StorageNodeID); err == nil {
+		if cp.Last == localHWM && cp.Cursor.LLSN+1 == syncRange.FirstLLSN {
+			st.setCursor(varlogpb.LogEntryMeta{
+				TopicID:     lse.tpid,
+				LogStreamID: lse.lsid,
+				LLSN:        cp.Cursor.LLSN,
+				GLSN:        cp.Cursor.GLSN,
+			})
+			lse.logger.Info("sync: resume", zap.String("checkpoint", fmt.Sprintf("%+v", cp)))
+		}
+	}
 	lse.sts[dstReplica.StorageNodeID] = st
 	_, _ = lse.syncRunner.Run(func(ctx context.Context) {
 		snid := sc.dstReplica.StorageNodeID
@@ -151,12 +170,11 @@ func (lse *Executor) Sync(ctx context.Context, dstReplica varlogpb.LogStreamRepl
 	return st.toSyncStatus(), nil
 }
 
-func (lse *Executor) syncLoop(_ context.Context, sc *syncClient, st *syncTracker) {
+func (lse *Executor) syncLoop(ctx context.Context, sc *syncClient, st *syncTracker) {
 	var (
 		err    error
 		stream snpb.Replicator_SyncReplicateStreamClient
 		cc     storage.CommitContext
-		sr     *SubscribeResult
 	)
 
 	defer func() {
@@ -167,8 +185,12 @@ func (lse *Executor) syncLoop(_ context.Context, sc *syncClient, st *syncTracker
 
 		if err == nil {
 			lse.logger.Info("sync completed", zap.String("status", st.toSyncStatus().String()))
+			if errCP := lse.stg.DeleteSyncSourceCheckpoint(sc.dstReplica.StorageNodeID); errCP != nil {
+				lse.logger.Warn("could not delete sync checkpoint", zap.Error(errCP))
+			}
 		} else {
 			lse.logger.Error("could not sync", zap.Error(err))
+			lse.saveSyncCheckpoint(sc.dstReplica.StorageNodeID, st)
 		}
 		_ = sc.close()
 	}()
@@ -187,27 +209,17 @@ func (lse *Executor) syncLoop(_ context.Context, sc *syncClient, st *syncTracker
 	// the syncRange.first is invalid because Sync does not look at the
 	// syncRange.first.
 	if !st.syncRange.first.Invalid() && st.syncRange.first.GLSN <= st.syncRange.last.GLSN {
-		sr, err = lse.SubscribeWithGLSN(st.syncRange.first.GLSN, st.syncRange.last.GLSN+1)
-		if err != nil {
-			err = fmt.Errorf("scan: %w", err)
-			return
-		}
-		for le := range sr.Result() {
-			req.Payload.LogEntry = &le
-			// TODO: Configure syncReplicate timeout
-			err = stream.SendMsg(req)
+		if sc.bulkSync {
+			err = lse.syncFiles(ctx, sc, stream, req, st)
+			if err != nil {
+				err = fmt.Errorf("sync files: %w", err)
+				return
+			}
+		} else {
+			err = lse.syncLogEntries(ctx, sc, stream, req, st)
 			if err != nil {
-				err = fmt.Errorf("sync replicate: log entry %+v: %w", le.LogEntryMeta, err)
-				sr.Stop()
 				return
 			}
-			st.setCursor(le.LogEntryMeta)
-		}
-		sr.Stop()
-		err = sr.Err()
-		if err != nil {
-			err = fmt.Errorf("scan: %w", err)
-			return
 		}
 	}
 
@@ -225,17 +237,75 @@ func (lse *Executor) syncLoop(_ context.Context, sc *syncClient, st *syncTracker
 	if err != nil {
 		return
 	}
-	req.Payload.LogEntry = nil
-	req.Payload.CommitContext = &varlogpb.CommitContext{
-		Version:            cc.Version,
-		HighWatermark:      cc.HighWatermark,
-		CommittedGLSNBegin: cc.CommittedGLSNBegin,
-		CommittedGLSNEnd:   cc.CommittedGLSNEnd,
-		CommittedLLSNBegin: cc.CommittedLLSNBegin,
+	req.Payload = snpb.SyncPayload{
+		CommitContext: &varlogpb.CommitContext{
+			Version:            cc.Version,
+			HighWatermark:      cc.HighWatermark,
+			CommittedGLSNBegin: cc.CommittedGLSNBegin,
+			CommittedGLSNEnd:   cc.CommittedGLSNEnd,
+			CommittedLLSNBegin: cc.CommittedLLSNBegin,
+		},
 	}
 	err = stream.SendMsg(req)
 }
 
+// syncLogEntries copies log entries in the sync range one by one.
+func (lse *Executor) syncLogEntries(ctx context.Context, sc *syncClient, stream snpb.Replicator_SyncReplicateStreamClient, req *snpb.SyncReplicateRequest, st *syncTracker) error {
+	sr, err := lse.SubscribeWithGLSN(st.syncRange.first.GLSN, st.syncRange.last.GLSN+1)
+	if err != nil {
+		return fmt.Errorf("scan: %w", err)
+	}
+	defer sr.Stop()
+
+	numCopied := 0
+	for le := range sr.Result() {
+		if err := lse.waitSyncBandwidth(ctx, len(le.Data)); err != nil {
+			return fmt.Errorf("sync replicate: %w", err)
+		}
+		if lse.stg.KeyIndex() && !le.Compacted {
+			le.Key, err = lse.stg.RecordKey(le.LLSN)
+			if err != nil {
+				return fmt.Errorf("sync replicate: log entry %+v: %w", le.LogEntryMeta, err)
+			}
+		}
+		req.Payload.LogEntry = &le
+		// TODO: Configure syncReplicate timeout
+		err = stream.SendMsg(req)
+		if err != nil {
+			return fmt.Errorf("sync replicate: log entry %+v: %w", le.LogEntryMeta, err)
+		}
+		st.setCursor(le.LogEntryMeta)
+		numCopied++
+		if numCopied%syncCheckpointInterval == 0 {
+			lse.saveSyncCheckpoint(sc.dstReplica.StorageNodeID, st)
+		}
+	}
+	sr.Stop()
+	if err := sr.Err(); err != nil {
+		return fmt.Errorf("scan: %w", err)
+	}
+	return nil
+}
+
+// saveSyncCheckpoint persists the progress of synchronization to the
+// destination dst. The checkpoint is advisory in the source replica since
+// the destination decides where to resume; hence, it only logs failures.
+func (lse *Executor) saveSyncCheckpoint(dst types.StorageNodeID, st *syncTracker) {
+	status := st.toSyncStatus()
+	if status.Current.LLSN.Invalid() {
+		return
+	}
+	err := lse.stg.WriteSyncSourceCheckpoint(storage.SyncCheckpoint{
+		Peer:   dst,
+		First:  st.syncRange.first,
+		Last:   st.syncRange.last,
+		Cursor: varlogpb.LogSequenceNumber{LLSN: status.Current.LLSN, GLSN: status.Current.GLSN},
+	})
+	if err != nil {
+		lse.logger.Warn("could not save sync checkpoint", zap.Error(err))
+	}
+}
+
 func (lse *Executor) SyncInit(_ context.Context, srcReplica varlogpb.LogStreamReplica, srcRange snpb.SyncRange) (syncRange snpb.SyncRange, err error) {
 	atomic.AddInt64(&lse.inflight, 1)
 	defer atomic.AddInt64(&lse.inflight, -1)
@@ -264,6 +334,10 @@ func (lse *Executor) SyncInit(_ context.Context, srcReplica varlogpb.LogStreamRe
 	_, _, uncommittedBegin, invalid := lse.lsc.reportCommitBase()
 	uncommittedLLSNBegin, uncommittedGLSNBegin := uncommittedBegin.LLSN, uncommittedBegin.GLSN
 	lastCommittedLLSN := uncommittedLLSNBegin - 1
+	if cursor, ok := lse.syncResumePoint(lastCommittedLLSN, srcRange); ok {
+		lastCommittedLLSN = cursor.LLSN
+		uncommittedGLSNBegin = cursor.GLSN + 1
+	}
 	if lastCommittedLLSN > srcRange.LastLLSN {
 		lse.logger.Panic("sync init: destination of sync has too many logs",
 			zap.String("src_range", srcRange.String()),
@@ -373,15 +447,91 @@ func (lse *Executor) SyncInit(_ context.Context, srcReplica varlogpb.LogStreamRe
 
 	// learning
 	lse.resetInternalState(lastCommittedLLSN, !lse.isPrimary())
+	lse.closeSyncFiles()
+	cp := storage.SyncCheckpoint{
+		Peer:  srcReplica.StorageNodeID,
+		First: varlogpb.LogSequenceNumber{LLSN: syncRange.FirstLLSN},
+		Last:  varlogpb.LogSequenceNumber{LLSN: syncRange.LastLLSN},
+	}
+	if !uncommittedGLSNBegin.Invalid() {
+		cp.Cursor = varlogpb.LogSequenceNumber{LLSN: lastCommittedLLSN, GLSN: uncommittedGLSNBegin - 1}
+	}
+	if err = lse.stg.WriteSyncDestinationCheckpoint(cp); err != nil {
+		err = fmt.Errorf("log stream: sync init: checkpoint: %w", err)
+		lse.esm.store(executorStateSealing)
+		return
+	}
+	lse.dstSyncInfo.checkpoint = cp
 	lse.dstSyncInfo.lastSyncTime = time.Now()
 	lse.dstSyncInfo.srcReplica = srcReplica.StorageNodeID
 	return syncRange, nil
 }
 
-func (lse *Executor) SyncReplicate(_ context.Context, srcReplica varlogpb.LogStreamReplica, payload snpb.SyncPayload) (err error) {
+// syncResumePoint returns the last log entry copied by the previous
+// synchronization if the replica can resume from it. The checkpoint is valid
+// only if it goes beyond lastCommittedLLSN, it is in the range of the source
+// replica, and the log entry it points to exists.
+func (lse *Executor) syncResumePoint(lastCommittedLLSN types.LLSN, srcRange snpb.SyncRange) (varlogpb.LogSequenceNumber, bool) {
+	cp, err := lse.stg.ReadSyncDestinationCheckpoint()
+	if err != nil {
+		if !errors.Is(err, storage.ErrNoSyncCheckpoint) {
+			lse.logger.Warn("sync init: could not read checkpoint", zap.Error(err))
+		}
+		return varlogpb.LogSequenceNumber{}, false
+	}
+	if cp.Cursor.LLSN.Invalid() || cp.Cursor.LLSN <= lastCommittedLLSN || cp.Cursor.LLSN > srcRange.LastLLSN {
+		return varlogpb.LogSequenceNumber{}, false
+	}
+	le, err := lse.stg.Read(storage.AtLLSN(cp.Cursor.LLSN))
+	if err != nil || le.GLSN != cp.Cursor.GLSN {
+		lse.logger.Warn("sync init: ignore stale checkpoint", zap.String("checkpoint", fmt.Sprintf("%+v", cp)), zap.Error(err))
+		return varlogpb.LogSequenceNumber{}, false
+	}
+	lse.logger.Info("sync init: resume", zap.String("checkpoint", fmt.Sprintf("%+v", cp)))
+	return cp.Cursor, true
+}
+
+// waitSyncBandwidth blocks until the limiter of synchronization allows n
+// bytes. Since the limiter cannot permit more bytes than its burst at once, it
+// waits in pieces.
+func (lse *Executor) waitSyncBandwidth(ctx context.Context, n int) error {
+	lim := lse.syncRateLimiter
+	if lim == nil {
+		return nil
+	}
+	for n > 0 {
+		if lim.Limit() == rate.Inf {
+			return nil
+		}
+		m := n
+		if burst := lim.Burst(); burst > 0 && m > burst {
+			m = burst
+		}
+		if err := lim.WaitN(ctx, m); err != nil {
+			return err
+		}
+		n -= m
+	}
+	return nil
+}
+
+// BulkSync tells whether the replica ships or accepts SSTables during
+// synchronization. It is disabled if the storage maintains the key index since
+// SSTables do not have the index, or if the storage is encrypted since data in
+// SSTables are encrypted by data keys of the source. Log entries shipped one
+// by one are encrypted by the destination when written.
+func (lse *Executor) BulkSync() bool {
+	return lse.bulkSync
+}
+
+func (lse *Executor) SyncReplicate(ctx context.Context, srcReplica varlogpb.LogStreamReplica, payload snpb.SyncPayload) (err error) {
 	atomic.AddInt64(&lse.inflight, 1)
 	defer atomic.AddInt64(&lse.inflight, -1)
 
+	if err := lse.waitSyncBandwidth(ctx, payload.ProtoSize()); err != nil {
+		return fmt.Errorf("log stream: sync replicate: %w", err)
+	}
+
 	lse.muAdmin.Lock()
 	defer lse.muAdmin.Unlock()
 
@@ -396,17 +546,36 @@ func (lse *Executor) SyncReplicate(_ context.Context, srcReplica varlogpb.LogStr
 		return fmt.Errorf("log stream: sync replicate: incorrect source replica: %s", srcReplica.String())
 	}
 
-	if payload.LogEntry == nil && payload.CommitContext == nil {
+	if payload.LogEntry == nil && payload.CommitContext == nil && payload.FileChunk == nil {
 		lse.esm.store(executorStateSealing)
+		lse.closeSyncFiles()
 		return fmt.Errorf("log stream: sync replicate: empty payload")
 	}
 
+	if chunk := payload.FileChunk; chunk != nil {
+		if err := lse.receiveSyncFileChunk(chunk); err != nil {
+			lse.esm.store(executorStateSealing)
+			lse.closeSyncFiles()
+			return fmt.Errorf("log stream: sync replicate: %w", err)
+		}
+		if chunk.Segment != nil {
+			if err := lse.ingestSyncSegment(*chunk.Segment); err != nil {
+				lse.esm.store(executorStateSealing)
+				lse.closeSyncFiles()
+				return fmt.Errorf("log stream: sync replicate: %w", err)
+			}
+		}
+		lse.dstSyncInfo.lastSyncTime = time.Now()
+		return nil
+	}
+
 	done := false
 	batch := lse.stg.NewAppendBatch()
 	defer func() {
 		_ = batch.Close()
 		if err != nil || done {
 			lse.esm.store(executorStateSealing)
+			lse.closeSyncFiles()
 		}
 	}()
 
@@ -421,7 +590,14 @@ func (lse *Executor) SyncReplicate(_ context.Context, srcReplica varlogpb.LogStr
 			return err
 		}
 
-		err = batch.SetLogEntry(entry.LLSN, entry.GLSN, entry.Data)
+		if entry.Compacted {
+			err = batch.SetCompactedLogEntry(entry.LLSN, entry.GLSN)
+		} else {
+			err = batch.SetLogEntry(entry.LLSN, entry.GLSN, entry.Data)
+			if err == nil {
+				err = batch.SetKey(entry.LLSN, entry.GLSN, entry.Key)
+			}
+		}
 		if err != nil {
 			return err
 		}
@@ -434,6 +610,18 @@ func (lse *Executor) SyncReplicate(_ context.Context, srcReplica varlogpb.LogStr
 			LLSN:        entry.LLSN,
 			GLSN:        entry.GLSN,
 		}
+
+		cp := lse.dstSyncInfo.checkpoint
+		cp.Cursor = varlogpb.LogSequenceNumber{LLSN: entry.LLSN, GLSN: entry.GLSN}
+		err = batch.SetSyncDestinationCheckpoint(cp)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err == nil {
+				lse.dstSyncInfo.checkpoint = cp
+			}
+		}()
 	}
 	if cc := payload.CommitContext; cc != nil {
 		lastLLSN := cc.CommittedLLSNBegin + types.LLSN(cc.CommittedGLSNEnd-cc.CommittedGLSNBegin) - 1
@@ -452,6 +640,10 @@ func (lse *Executor) SyncReplicate(_ context.Context, srcReplica varlogpb.LogStr
 		if err != nil {
 			return err
 		}
+		err = batch.DeleteSyncDestinationCheckpoint()
+		if err != nil {
+			return err
+		}
 		lse.logger.Info("log stream: sync replicate: copy", zap.String("commit context", cc.String()))
 
 		ver = cc.Version
@@ -476,5 +668,85 @@ func (lse *Executor) SyncReplicate(_ context.Context, srcReplica varlogpb.LogStr
 	}, invalid)
 	lse.lsc.uncommittedLLSNEnd.Store(uncommittedLLSNBegin)
 	lse.dstSyncInfo.lastSyncTime = time.Now()
+	lse.notifyReport()
+	return nil
+}
+
+func (lse *Executor) receiveSyncFileChunk(chunk *snpb.SyncFileChunk) (err error) {
+	if !lse.bulkSync {
+		return errors.New("bulk sync disabled")
+	}
+	if lse.dstSyncInfo.files == nil {
+		lse.dstSyncInfo.files, err = newSyncFileReceiver(lse.stg.Path())
+		if err != nil {
+			return err
+		}
+	}
+	return lse.dstSyncInfo.files.write(chunk)
+}
+
+// ingestSyncSegment ingests a segment of SSTables received from the source
+// replica. The segment should have log entries in the range segRange, which
+// follow the log entries the replica already has. After ingesting it, the
+// replica advances its checkpoint to the end of the segment.
+func (lse *Executor) ingestSyncSegment(segRange snpb.SyncRange) error {
+	_, _, uncommittedBegin, _ := lse.lsc.reportCommitBase()
+	if segRange.FirstLLSN != uncommittedBegin.LLSN || segRange.FirstLLSN > segRange.LastLLSN {
+		return fmt.Errorf("ingest: unexpected segment %s: expected_first_llsn=%v", segRange.String(), uncommittedBegin.LLSN)
+	}
+	paths, err := lse.dstSyncInfo.files.files()
+	if err != nil {
+		return err
+	}
+	if err := lse.stg.IngestSSTables(paths); err != nil {
+		return fmt.Errorf("ingest: %w", err)
+	}
+	if err := lse.dstSyncInfo.files.clear(); err != nil {
+		lse.logger.Warn("could not remove ingested files", zap.Error(err))
+	}
+
+	last, err := lse.stg.Read(storage.AtLLSN(segRange.LastLLSN))
+	if err != nil {
+		return fmt.Errorf("ingest: last log entry %d: %w", segRange.LastLLSN, err)
+	}
+	first, err := lse.stg.Read(storage.AtLLSN(segRange.FirstLLSN))
+	if err != nil {
+		return fmt.Errorf("ingest: first log entry %d: %w", segRange.FirstLLSN, err)
+	}
+
+	cp := lse.dstSyncInfo.checkpoint
+	cp.Cursor = varlogpb.LogSequenceNumber{LLSN: last.LLSN, GLSN: last.GLSN}
+	if err := lse.stg.WriteSyncDestinationCheckpoint(cp); err != nil {
+		return fmt.Errorf("ingest: checkpoint: %w", err)
+	}
+	lse.dstSyncInfo.checkpoint = cp
+
+	lse.logger.Info("log stream: sync replicate: ingested",
+		zap.Int("files", len(paths)),
+		zap.String("first", first.LogEntryMeta.String()),
+		zap.String("last", last.LogEntryMeta.String()),
+	)
+
+	lse.lsc.localLWM.CompareAndSwap(varlogpb.LogSequenceNumber{}, varlogpb.LogSequenceNumber{
+		LLSN: first.LLSN,
+		GLSN: first.GLSN,
+	})
+	ver, hwm, _, invalid := lse.lsc.reportCommitBase()
+	lse.lsc.storeReportCommitBase(ver, hwm, varlogpb.LogSequenceNumber{
+		LLSN: last.LLSN + 1,
+		GLSN: last.GLSN + 1,
+	}, invalid)
+	lse.lsc.uncommittedLLSNEnd.Store(last.LLSN + 1)
+	lse.notifyReport()
 	return nil
 }
+
+func (lse *Executor) closeSyncFiles() {
+	if lse.dstSyncInfo.files == nil {
+		return
+	}
+	if err := lse.dstSyncInfo.files.close(); err != nil {
+		lse.logger.Warn("could not remove received files", zap.Error(err))
+	}
+	lse.dstSyncInfo.files = nil
+}
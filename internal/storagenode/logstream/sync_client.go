@@ -14,6 +14,9 @@ type syncClient struct {
 	syncClientConfig
 	srcReplica varlogpb.LogStreamReplica
 	rpcClient  snpb.ReplicatorClient
+	// bulkSync is true if the destination accepts SSTables. It is decided
+	// by syncInit.
+	bulkSync bool
 }
 
 func newSyncClient(cfg syncClientConfig) *syncClient {
@@ -38,7 +41,9 @@ func (sc *syncClient) syncInit(ctx context.Context, srcRange snpb.SyncRange) (sy
 		Source:      sc.srcReplica,
 		Destination: sc.dstReplica,
 		Range:       srcRange,
+		BulkSync:    sc.lse.bulkSync,
 	})
+	sc.bulkSync = sc.lse.bulkSync && rsp.GetBulkSync()
 	return rsp.GetRange(), err
 }
 
@@ -1,9 +1,15 @@
 package logstream
 
 import (
+	"context"
+	"hash/crc32"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
 
 	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
@@ -21,3 +27,108 @@ func TestExecutor_SyncTracker(t *testing.T) {
 	current = st.toSyncStatus().Current
 	assert.Equal(t, snpb.SyncPosition{LLSN: 1, GLSN: 1}, current)
 }
+
+func TestExecutor_WaitSyncBandwidth(t *testing.T) {
+	const (
+		bytesPerSecond = 100 << 10
+		burst          = 10 << 10
+	)
+
+	lse := &Executor{}
+	require.NoError(t, lse.waitSyncBandwidth(context.Background(), 1<<30))
+
+	lse.syncRateLimiter = rate.NewLimiter(rate.Inf, 0)
+	require.NoError(t, lse.waitSyncBandwidth(context.Background(), 1<<30))
+
+	// It waits in pieces since n is greater than the burst.
+	lse.syncRateLimiter = rate.NewLimiter(bytesPerSecond, burst)
+	start := time.Now()
+	require.NoError(t, lse.waitSyncBandwidth(context.Background(), burst*3))
+	require.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Error(t, lse.waitSyncBandwidth(ctx, burst))
+}
+
+func TestSyncFileReceiver(t *testing.T) {
+	chunk := func(name string, offset int64, data []byte, eof bool, file []byte) *snpb.SyncFileChunk {
+		return &snpb.SyncFileChunk{
+			Name:         name,
+			Offset:       offset,
+			Data:         data,
+			Checksum:     crc32.Checksum(data, crc32cTable),
+			EOF:          eof,
+			FileChecksum: crc32.Checksum(file, crc32cTable),
+		}
+	}
+
+	t.Run("Receive", func(t *testing.T) {
+		sfr, err := newSyncFileReceiver(t.TempDir())
+		require.NoError(t, err)
+		defer func() {
+			assert.NoError(t, sfr.close())
+			assert.NoDirExists(t, sfr.dir)
+		}()
+
+		require.NoError(t, sfr.write(chunk("000000.sst", 0, []byte("foo"), false, nil)))
+		_, err = sfr.files()
+		require.Error(t, err)
+		require.NoError(t, sfr.write(chunk("000000.sst", 3, []byte("bar"), true, []byte("foobar"))))
+		require.NoError(t, sfr.write(chunk("000001.sst", 0, []byte("baz"), true, []byte("baz"))))
+
+		paths, err := sfr.files()
+		require.NoError(t, err)
+		require.Len(t, paths, 2)
+		data, err := os.ReadFile(paths[0])
+		require.NoError(t, err)
+		require.Equal(t, "foobar", string(data))
+	})
+
+	tcs := []struct {
+		name  string
+		chunk *snpb.SyncFileChunk
+	}{
+		{
+			name: "ChunkChecksumMismatch",
+			chunk: func() *snpb.SyncFileChunk {
+				c := chunk("000000.sst", 3, []byte("bar"), false, nil)
+				c.Checksum++
+				return c
+			}(),
+		},
+		{
+			name:  "FileChecksumMismatch",
+			chunk: chunk("000000.sst", 3, []byte("bar"), true, []byte("foobaz")),
+		},
+		{
+			name:  "UnexpectedOffset",
+			chunk: chunk("000000.sst", 4, []byte("bar"), false, nil),
+		},
+		{
+			name:  "UnexpectedName",
+			chunk: chunk("000001.sst", 3, []byte("bar"), false, nil),
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			sfr, err := newSyncFileReceiver(t.TempDir())
+			require.NoError(t, err)
+			defer func() {
+				assert.NoError(t, sfr.close())
+			}()
+
+			require.NoError(t, sfr.write(chunk("000000.sst", 0, []byte("foo"), false, nil)))
+			require.Error(t, sfr.write(tc.chunk))
+		})
+	}
+
+	t.Run("InvalidName", func(t *testing.T) {
+		sfr, err := newSyncFileReceiver(t.TempDir())
+		require.NoError(t, err)
+		defer func() {
+			assert.NoError(t, sfr.close())
+		}()
+		require.Error(t, sfr.write(chunk("../000000.sst", 0, []byte("foo"), true, []byte("foo"))))
+	})
+}
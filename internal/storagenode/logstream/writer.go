@@ -122,6 +122,7 @@ func (w *writer) writeLoopInternal(_ context.Context, st *sequenceTask) {
 			zap.Uint64("cas_new", uint64(newLLSN)),
 		)
 	}
+	w.lse.notifyReport()
 }
 
 // waitForDrainage waits for writeTasks being drained.
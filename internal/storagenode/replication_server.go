@@ -41,7 +41,10 @@ func (rs *replicationServer) SyncInit(ctx context.Context, req *snpb.SyncInitReq
 		return nil, fmt.Errorf("replication server: no log stream %v", req.Destination.LogStreamID)
 	}
 	syncRange, err := lse.SyncInit(ctx, req.Source, req.Range)
-	return &snpb.SyncInitResponse{Range: syncRange}, err
+	return &snpb.SyncInitResponse{
+		Range:    syncRange,
+		BulkSync: req.BulkSync && lse.BulkSync(),
+	}, err
 }
 
 func (rs *replicationServer) SyncReplicate(ctx context.Context, req *snpb.SyncReplicateRequest) (*snpb.SyncReplicateResponse, error) {
@@ -167,7 +170,7 @@ func (rs *replicationServer) replicate(ctx context.Context, requestC <-chan *rep
 
 			atomic.AddInt64(&lse.Metrics().ReplicateServerOperations, 1)
 
-			err = lse.Replicate(ctx, rst.req.LLSN, rst.req.Data)
+			err = lse.Replicate(ctx, rst.req.LLSN, rst.req.Data, rst.req.Keys...)
 			if err != nil {
 				rst.release()
 				return
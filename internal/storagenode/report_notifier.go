@@ -0,0 +1,37 @@
+package storagenode
+
+import "sync"
+
+// reportNotifier wakes up report streams waiting for changes of reports. A
+// waiter gets a channel by calling wait and the channel is closed by the next
+// call of notify.
+type reportNotifier struct {
+	mu sync.Mutex
+	c  chan struct{}
+	// waited is true if someone has the channel c. Since notify is called
+	// whenever log entries are written or committed, it avoids allocating a
+	// new channel if nobody waits.
+	waited bool
+}
+
+func newReportNotifier() *reportNotifier {
+	return &reportNotifier{c: make(chan struct{})}
+}
+
+func (rn *reportNotifier) wait() <-chan struct{} {
+	rn.mu.Lock()
+	defer rn.mu.Unlock()
+	rn.waited = true
+	return rn.c
+}
+
+func (rn *reportNotifier) notify() {
+	rn.mu.Lock()
+	defer rn.mu.Unlock()
+	if !rn.waited {
+		return
+	}
+	close(rn.c)
+	rn.c = make(chan struct{})
+	rn.waited = false
+}
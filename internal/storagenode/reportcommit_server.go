@@ -1,8 +1,10 @@
 package storagenode
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
@@ -12,7 +14,13 @@ import (
 	"github.com/kakao/varlog/proto/snpb"
 )
 
-const defaultReportsCapacity = 32
+const (
+	defaultReportsCapacity = 32
+
+	// defaultReportHeartbeatInterval is used if the metadata repository
+	// watches reports without a heartbeat interval.
+	defaultReportHeartbeatInterval = time.Second
+)
 
 type reportCommitServer struct {
 	sn *StorageNode
@@ -40,16 +48,7 @@ func (rcs reportCommitServer) GetReport(stream snpb.LogStreamReporter_GetReportS
 			return err
 		}
 
-		rsp.UncommitReports = rsp.UncommitReports[0:0]
-		// NOTE: Each connection of GetReport is paired with only one log stream replica.
-		// So we can cache log stream executor.
-		rcs.sn.executors.Range(func(_ types.LogStreamID, _ types.TopicID, lse *logstream.Executor) bool {
-			if report, err := lse.Report(ctx); err == nil {
-				rsp.UncommitReports = append(rsp.UncommitReports, report)
-			}
-			return true
-		})
-
+		rsp.UncommitReports = rcs.reports(ctx, rsp.UncommitReports[0:0])
 		err = stream.SendMsg(rsp)
 		if err != nil {
 			return err
@@ -57,6 +56,83 @@ func (rcs reportCommitServer) GetReport(stream snpb.LogStreamReporter_GetReportS
 	}
 }
 
+// WatchReport pushes reports whenever they change. If nothing changes, it
+// still sends reports every heartbeat interval so that the metadata repository
+// can tell the storage node is alive and recover lost reports.
+func (rcs reportCommitServer) WatchReport(req *snpb.WatchReportRequest, stream snpb.LogStreamReporter_WatchReportServer) (err error) {
+	defer func() {
+		rcs.sn.logger.Info("report commit server: closed watch report stream", zap.Error(err))
+	}()
+
+	heartbeatInterval := req.HeartbeatInterval
+	if heartbeatInterval <= 0 {
+		heartbeatInterval = defaultReportHeartbeatInterval
+	}
+	heartbeat := time.NewTimer(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	rsp := &snpb.GetReportResponse{
+		StorageNodeID:   rcs.sn.snid,
+		UncommitReports: make([]snpb.LogStreamUncommitReport, 0, defaultReportsCapacity),
+	}
+	sent := make([]snpb.LogStreamUncommitReport, 0, defaultReportsCapacity)
+	ctx := stream.Context()
+	force := true
+	for {
+		// It should get the channel before making reports not to miss
+		// changes made while making reports.
+		changed := rcs.sn.reportNotifier.wait()
+
+		rsp.UncommitReports = rcs.reports(ctx, rsp.UncommitReports[0:0])
+		if force || !equalReports(sent, rsp.UncommitReports) {
+			if err := stream.Send(rsp); err != nil {
+				return err
+			}
+			sent = append(sent[0:0], rsp.UncommitReports...)
+			if !heartbeat.Stop() {
+				select {
+				case <-heartbeat.C:
+				default:
+				}
+			}
+			heartbeat.Reset(heartbeatInterval)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+			force = false
+		case <-heartbeat.C:
+			force = true
+		}
+	}
+}
+
+// reports appends reports of all log stream replicas to the argument
+// reports.
+func (rcs reportCommitServer) reports(ctx context.Context, reports []snpb.LogStreamUncommitReport) []snpb.LogStreamUncommitReport {
+	rcs.sn.executors.Range(func(_ types.LogStreamID, _ types.TopicID, lse *logstream.Executor) bool {
+		if report, err := lse.Report(ctx); err == nil {
+			reports = append(reports, report)
+		}
+		return true
+	})
+	return reports
+}
+
+func equalReports(a, b []snpb.LogStreamUncommitReport) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (rcs reportCommitServer) Commit(stream snpb.LogStreamReporter_CommitServer) (err error) {
 	defer func() {
 		err = multierr.Append(err, stream.SendAndClose(&snpb.CommitResponse{}))
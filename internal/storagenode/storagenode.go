@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -21,6 +22,7 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
@@ -28,6 +30,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/kakao/varlog/internal/storage"
+	"github.com/kakao/varlog/internal/storage/encryption"
 	"github.com/kakao/varlog/internal/storagenode/executorsmap"
 	"github.com/kakao/varlog/internal/storagenode/logstream"
 	"github.com/kakao/varlog/internal/storagenode/pprof"
@@ -44,14 +47,24 @@ import (
 const (
 	hintNumExecutors        = 32
 	singleflightKeyMetadata = "metadata"
+
+	// sharedStorageDirName is the name of the directory of the storage
+	// database shared by log stream replicas in the storage node path. It is
+	// hidden so as not to be read as a log stream directory.
+	sharedStorageDirName = ".shared"
 )
 
 type StorageNode struct {
 	config
 	ballast []byte
 	snPaths []string
+	// sharedDBs are storage databases shared by log stream replicas, keyed
+	// by storage node paths. It is empty unless the option WithSharedStorage
+	// is set.
+	sharedDBs map[string]*storage.SharedDB
 
-	executors *executorsmap.ExecutorsMap
+	executors      *executorsmap.ExecutorsMap
+	reportNotifier *reportNotifier
 
 	mu           sync.RWMutex
 	lis          net.Listener
@@ -74,6 +87,20 @@ type StorageNode struct {
 	limits struct {
 		logStreamReplicasCount atomic.Int32
 	}
+
+	// syncRateLimiter limits bandwidth for synchronization of all log
+	// stream replicas in the storage node.
+	syncRateLimiter *rate.Limiter
+	muSyncBandwidth sync.Mutex
+
+	// appendLimiters limit appends to topics and log streams in the storage
+	// node. They are created lazily and kept after removing log stream
+	// replicas so that quotas changed at runtime are not lost.
+	appendLimiters struct {
+		mu         sync.Mutex
+		topics     map[types.TopicID]*logstream.AppendLimiter
+		logStreams map[varlogpb.TopicLogStream]*logstream.AppendLimiter
+	}
 }
 
 func NewStorageNode(opts ...Option) (*StorageNode, error) {
@@ -99,8 +126,9 @@ func NewStorageNode(opts ...Option) (*StorageNode, error) {
 	if err != nil {
 		return nil, err
 	}
-	dataDirs = filterValidDataDirectories(dataDirs, cfg.cid, cfg.snid, cfg.logger)
+	dataDirs = filterValidDataDirectories(dataDirs, cfg.cid, cfg.snid, cfg.keyProvider != nil, cfg.logger)
 
+	fence := &adminLeaseFence{}
 	grpcServer := grpc.NewServer(
 		grpc.ReadBufferSize(int(cfg.grpcServerReadBufferSize)),
 		grpc.WriteBufferSize(int(cfg.grpcServerWriteBufferSize)),
@@ -115,23 +143,34 @@ func NewStorageNode(opts ...Option) (*StorageNode, error) {
 			grpczap.PayloadUnaryServerInterceptor(cfg.logger, func(_ context.Context, fullMethodName string, _ any) bool {
 				return grpcPayloadLogAllowList[fullMethodName]
 			}),
+			fence.unaryServerInterceptor,
 		),
 	)
 
 	sn := &StorageNode{
-		config:       cfg,
-		executors:    executorsmap.New(hintNumExecutors),
-		server:       grpcServer,
-		healthServer: health.NewServer(),
-		closedC:      make(chan struct{}),
-		snPaths:      snPaths,
-		pprofServer:  pprof.New(cfg.pprofOpts...),
-		metrics:      metrics,
-		startTime:    time.Now().UTC(),
-	}
+		config:         cfg,
+		executors:      executorsmap.New(hintNumExecutors),
+		reportNotifier: newReportNotifier(),
+		server:         grpcServer,
+		healthServer:   health.NewServer(),
+		closedC:        make(chan struct{}),
+		snPaths:        snPaths,
+		sharedDBs:      make(map[string]*storage.SharedDB, len(snPaths)),
+		pprofServer:    pprof.New(cfg.pprofOpts...),
+		metrics:        metrics,
+		startTime:      time.Now().UTC(),
+	}
+	sn.syncRateLimiter = rate.NewLimiter(syncRateLimit(cfg.syncBandwidth), int(cfg.syncBandwidth))
+	sn.appendLimiters.topics = make(map[types.TopicID]*logstream.AppendLimiter)
+	sn.appendLimiters.logStreams = make(map[varlogpb.TopicLogStream]*logstream.AppendLimiter)
 	if sn.ballastSize > 0 {
 		sn.ballast = make([]byte, sn.ballastSize)
 	}
+	if sn.sharedStorage {
+		if err := sn.openSharedDBs(); err != nil {
+			return nil, err
+		}
+	}
 	if err := sn.loadLogStreamReplicas(dataDirs); err != nil {
 		return nil, err
 	}
@@ -139,24 +178,116 @@ func NewStorageNode(opts ...Option) (*StorageNode, error) {
 	return sn, nil
 }
 
-func filterValidDataDirectories(dataDirs []volume.DataDir, cid types.ClusterID, snid types.StorageNodeID, logger *zap.Logger) []volume.DataDir {
+// filterValidDataDirectories returns data directories that the storage node
+// can load. Encrypted data directories are ignored if the storage node has no
+// key provider; they are left untouched so that the storage node can load
+// them after restarting with the key provider.
+func filterValidDataDirectories(dataDirs []volume.DataDir, cid types.ClusterID, snid types.StorageNodeID, hasKeyProvider bool, logger *zap.Logger) []volume.DataDir {
 	ret := make([]volume.DataDir, 0, len(dataDirs))
 	for _, dd := range dataDirs {
 		if err := dd.Valid(cid, snid); err != nil {
 			logger.Info("ignore incorrect data directory", zap.String("dir", dd.String()))
 			continue
 		}
+		if encrypted, err := dd.Encrypted(); err != nil {
+			logger.Warn("ignore data directory", zap.String("dir", dd.String()), zap.Error(err))
+			continue
+		} else if encrypted && !hasKeyProvider {
+			logger.Warn("ignore encrypted data directory since no key provider", zap.String("dir", dd.String()))
+			continue
+		}
 		ret = append(ret, dd)
 	}
 	return ret
 }
 
+// encryptionOptions returns storage options to encrypt storages of log stream
+// replicas.
+func (sn *StorageNode) encryptionOptions() []storage.Option {
+	if sn.keyProvider == nil {
+		return nil
+	}
+	return []storage.Option{storage.WithEncryption(sn.keyProvider)}
+}
+
+// dataKeyRotationLoop rotates data keys of encrypted log stream replicas every
+// dataKeyRotationInterval until the storage node is closed.
+func (sn *StorageNode) dataKeyRotationLoop() {
+	ticker := time.NewTicker(sn.dataKeyRotationInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-sn.closedC:
+			return
+		case <-ticker.C:
+		}
+		sn.rotateDataKeys(context.Background())
+	}
+}
+
+// rotateDataKeys reloads the key-encryption keys if possible, and then
+// rotates data keys of encrypted log stream replicas. Replicas that are not
+// encrypted are skipped.
+func (sn *StorageNode) rotateDataKeys(ctx context.Context) {
+	if reloader, ok := sn.keyProvider.(encryption.Reloader); ok {
+		if err := reloader.Reload(); err != nil {
+			sn.logger.Warn("could not reload key-encryption keys", zap.Error(err))
+		}
+	}
+	sn.executors.Range(func(lsid types.LogStreamID, tpid types.TopicID, lse *logstream.Executor) bool {
+		id, err := lse.RotateDataKey(ctx)
+		switch {
+		case errors.Is(err, storage.ErrNotEncrypted) || errors.Is(err, verrors.ErrClosed):
+		case err != nil:
+			sn.logger.Warn("could not rotate data key", zap.Int32("tpid", int32(tpid)), zap.Int32("lsid", int32(lsid)), zap.Error(err))
+		default:
+			sn.logger.Info("rotated data key", zap.Int32("tpid", int32(tpid)), zap.Int32("lsid", int32(lsid)), zap.Uint32("data_key", id))
+		}
+		return true
+	})
+}
+
+func (sn *StorageNode) openSharedDBs() error {
+	for _, snPath := range sn.snPaths {
+		stgOpts := make([]storage.Option, len(sn.defaultStorageOptions))
+		copy(stgOpts, sn.defaultStorageOptions)
+		sdbPath := filepath.Join(snPath, sharedStorageDirName)
+		stgOpts = append(stgOpts,
+			storage.WithPath(sdbPath),
+			storage.WithLogger(sn.logger.Named("storage").With(zap.String("path", sdbPath))),
+		)
+		sdb, err := storage.OpenSharedDB(stgOpts...)
+		if err != nil {
+			_ = sn.closeSharedDBs()
+			return err
+		}
+		sn.sharedDBs[filepath.Clean(snPath)] = sdb
+	}
+	return nil
+}
+
+func (sn *StorageNode) closeSharedDBs() (err error) {
+	for snPath, sdb := range sn.sharedDBs {
+		err = multierr.Append(err, sdb.Close())
+		delete(sn.sharedDBs, snPath)
+	}
+	return err
+}
+
+// sharedDB returns the shared storage database for the log stream replica
+// whose data directory is lsPath. It returns nil if there is no shared
+// database.
+func (sn *StorageNode) sharedDB(lsPath string) *storage.SharedDB {
+	return sn.sharedDBs[filepath.Dir(filepath.Clean(lsPath))]
+}
+
 func (sn *StorageNode) loadLogStreamReplicas(dataDirs []volume.DataDir) error {
 	var g errgroup.Group
 	for i := range dataDirs {
 		dataDir := dataDirs[i]
 		g.Go(func() error {
-			_, err := sn.runLogStreamReplica(context.Background(), dataDir.TopicID, dataDir.LogStreamID, dataDir.String())
+			_, err := sn.runLogStreamReplica(context.Background(), dataDir.TopicID, dataDir.LogStreamID, dataDir.String(), false)
 			return err
 		})
 	}
@@ -216,6 +347,12 @@ func (sn *StorageNode) Serve() error {
 		}
 		return err
 	})
+	if sn.keyProvider != nil && sn.dataKeyRotationInterval > 0 {
+		g.Go(func() error {
+			sn.dataKeyRotationLoop()
+			return nil
+		})
+	}
 	g.Go(func() error {
 		err := sn.mux.Serve()
 		if err != nil && !strings.Contains(err.Error(), "use of closed") {
@@ -244,6 +381,7 @@ func (sn *StorageNode) Close() (err error) {
 		return true
 	})
 	sn.server.Stop() // TODO: sn.server.GracefulStop() -> need not to use mutex
+	err = multierr.Append(err, sn.closeSharedDBs())
 	sn.logger.Info("closed")
 	return err
 }
@@ -289,7 +427,7 @@ func (sn *StorageNode) getMetadata(_ context.Context) (*snpb.StorageNodeMetadata
 	return ret.(*snpb.StorageNodeMetadataDescriptor), nil
 }
 
-func (sn *StorageNode) addLogStreamReplica(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, snPath string) (snpb.LogStreamReplicaMetadataDescriptor, error) {
+func (sn *StorageNode) addLogStreamReplica(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, snPath string, compacted bool) (snpb.LogStreamReplicaMetadataDescriptor, error) {
 	sn.mu.RLock()
 	defer sn.mu.RUnlock()
 	if sn.closed {
@@ -299,7 +437,7 @@ func (sn *StorageNode) addLogStreamReplica(ctx context.Context, tpid types.Topic
 	lsDirName := volume.LogStreamDirName(tpid, lsid)
 	lsPath := path.Join(snPath, lsDirName)
 
-	lse, err := sn.runLogStreamReplica(ctx, tpid, lsid, lsPath)
+	lse, err := sn.runLogStreamReplica(ctx, tpid, lsid, lsPath, compacted)
 	if err != nil {
 		return snpb.LogStreamReplicaMetadataDescriptor{}, err
 	}
@@ -307,7 +445,11 @@ func (sn *StorageNode) addLogStreamReplica(ctx context.Context, tpid types.Topic
 	return lse.Metadata()
 }
 
-func (sn *StorageNode) runLogStreamReplica(_ context.Context, tpid types.TopicID, lsid types.LogStreamID, lsPath string) (*logstream.Executor, error) {
+// runLogStreamReplica runs the log stream replica whose data directory is
+// lsPath. A new replica is compacted if the argument compacted is true, and an
+// existing one is compacted if its storage has been created so, regardless of
+// the argument.
+func (sn *StorageNode) runLogStreamReplica(_ context.Context, tpid types.TopicID, lsid types.LogStreamID, lsPath string, compacted bool) (*logstream.Executor, error) {
 	if added := sn.limits.logStreamReplicasCount.Add(1); sn.maxLogStreamReplicasCount >= 0 && added > sn.maxLogStreamReplicasCount {
 		sn.limits.logStreamReplicasCount.Add(-1)
 		return nil, status.Errorf(codes.ResourceExhausted, "storagenode: too many logstream replicas (tpid=%d, lsid=%d)", tpid, lsid)
@@ -324,6 +466,13 @@ func (sn *StorageNode) runLogStreamReplica(_ context.Context, tpid types.TopicID
 		storage.WithPath(lsPath),
 		storage.WithLogger(sn.logger.Named("storage").With(zap.String("path", lsPath))),
 	)
+	if sdb := sn.sharedDB(lsPath); sdb != nil {
+		stgOpts = append(stgOpts, storage.WithSharedDB(sdb, tpid, lsid))
+	}
+	if compacted {
+		stgOpts = append(stgOpts, storage.WithCompaction())
+	}
+	stgOpts = append(stgOpts, sn.encryptionOptions()...)
 	stg, err := storage.New(stgOpts...)
 	if err != nil {
 		return nil, err
@@ -342,7 +491,13 @@ func (sn *StorageNode) runLogStreamReplica(_ context.Context, tpid types.TopicID
 			grpc.WithWriteBufferSize(int(sn.replicateClientWriteBufferSize)),
 		),
 		logstream.WithLogStreamMetrics(lsm),
+		logstream.WithReportNotifier(sn.reportNotifier.notify),
+		logstream.WithSyncRateLimiter(sn.syncRateLimiter),
+		logstream.WithAppendLimiters(sn.topicAppendLimiter(tpid), sn.logStreamAppendLimiter(tpid, lsid)),
 	)
+	if stg.Compacted() {
+		lseOpts = append(lseOpts, logstream.WithCompactionInterval(sn.compactionInterval))
+	}
 
 	lse, err := logstream.NewExecutor(lseOpts...)
 	if err != nil {
@@ -353,6 +508,7 @@ func (sn *StorageNode) runLogStreamReplica(_ context.Context, tpid types.TopicID
 		_ = lse.Close()
 		return nil, errors.New("storage node: logstream already exists")
 	}
+	sn.reportNotifier.notify()
 	return lse, nil
 }
 
@@ -367,6 +523,7 @@ func (sn *StorageNode) removeLogStreamReplica(_ context.Context, tpid types.Topi
 	if !loaded {
 		return verrors.ErrNotExist
 	}
+	sn.reportNotifier.notify()
 	if err := lse.Close(); err != nil {
 		sn.logger.Warn("error while closing log stream replica")
 	}
@@ -374,6 +531,13 @@ func (sn *StorageNode) removeLogStreamReplica(_ context.Context, tpid types.Topi
 	if err := os.RemoveAll(lse.Path()); err != nil {
 		sn.logger.Warn("error while removing log stream path")
 	}
+	if sdb := sn.sharedDB(lse.Path()); sdb != nil {
+		// Data left by failure are deleted when the log stream replica
+		// is added again since its path has been removed.
+		if err := sdb.DeleteLogStream(tpid, lsid); err != nil {
+			sn.logger.Warn("error while deleting log stream from shared storage", zap.Error(err))
+		}
+	}
 	sn.limits.logStreamReplicasCount.Add(-1)
 	return nil
 }
@@ -423,6 +587,89 @@ func (sn *StorageNode) sync(ctx context.Context, tpid types.TopicID, lsid types.
 	return lse.Sync(ctx, dst)
 }
 
+// setSyncBandwidth changes the limit of bandwidth for synchronization, and
+// returns the previous one. Zero means unlimited.
+func (sn *StorageNode) setSyncBandwidth(bytesPerSecond int64) (int64, error) {
+	if bytesPerSecond < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "storage node: negative sync bandwidth %d", bytesPerSecond)
+	}
+	sn.muSyncBandwidth.Lock()
+	defer sn.muSyncBandwidth.Unlock()
+	prev := sn.syncBandwidth
+	// The burst should be updated before the limit since a limiter
+	// having a finite limit and zero burst permits nothing.
+	sn.syncRateLimiter.SetBurst(int(bytesPerSecond))
+	sn.syncRateLimiter.SetLimit(syncRateLimit(bytesPerSecond))
+	sn.syncBandwidth = bytesPerSecond
+	sn.logger.Info("set sync bandwidth", zap.Int64("prev", prev), zap.Int64("bytes_per_second", bytesPerSecond))
+	return prev, nil
+}
+
+// setAppendQuota changes the quota of appends to the log stream, and returns
+// the previous one. If the log stream is invalid, it changes the quota of the
+// topic.
+func (sn *StorageNode) setAppendQuota(tpid types.TopicID, lsid types.LogStreamID, quota snpb.AppendQuota) (snpb.AppendQuota, error) {
+	if tpid.Invalid() {
+		return snpb.AppendQuota{}, status.Errorf(codes.InvalidArgument, "storage node: invalid topic %d", tpid)
+	}
+	if quota.BytesPerSecond < 0 || quota.RecordsPerSecond < 0 {
+		return snpb.AppendQuota{}, status.Errorf(codes.InvalidArgument, "storage node: negative append quota (%d bytes/s, %d records/s)", quota.BytesPerSecond, quota.RecordsPerSecond)
+	}
+	al := sn.topicAppendLimiter(tpid)
+	if !lsid.Invalid() {
+		al = sn.logStreamAppendLimiter(tpid, lsid)
+	}
+	prev, err := al.SetQuota(quota)
+	if err != nil {
+		return snpb.AppendQuota{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sn.logger.Info("set append quota",
+		zap.Int32("tpid", int32(tpid)),
+		zap.Int32("lsid", int32(lsid)),
+		zap.Int64("prev_bytes_per_second", prev.BytesPerSecond),
+		zap.Int64("prev_records_per_second", prev.RecordsPerSecond),
+		zap.Int64("bytes_per_second", quota.BytesPerSecond),
+		zap.Int64("records_per_second", quota.RecordsPerSecond),
+	)
+	return prev, nil
+}
+
+// topicAppendLimiter returns the limiter of appends to the topic. It creates
+// the limiter with the configured quota if it does not exist.
+func (sn *StorageNode) topicAppendLimiter(tpid types.TopicID) *logstream.AppendLimiter {
+	sn.appendLimiters.mu.Lock()
+	defer sn.appendLimiters.mu.Unlock()
+	al, ok := sn.appendLimiters.topics[tpid]
+	if !ok {
+		// The quota is already validated by the config.
+		al, _ = logstream.NewAppendLimiter(sn.topicAppendQuotas[tpid])
+		sn.appendLimiters.topics[tpid] = al
+	}
+	return al
+}
+
+// logStreamAppendLimiter returns the limiter of appends to the log stream. It
+// creates the limiter with the configured quota if it does not exist.
+func (sn *StorageNode) logStreamAppendLimiter(tpid types.TopicID, lsid types.LogStreamID) *logstream.AppendLimiter {
+	tpls := varlogpb.TopicLogStream{TopicID: tpid, LogStreamID: lsid}
+	sn.appendLimiters.mu.Lock()
+	defer sn.appendLimiters.mu.Unlock()
+	al, ok := sn.appendLimiters.logStreams[tpls]
+	if !ok {
+		// The quota is already validated by the config.
+		al, _ = logstream.NewAppendLimiter(sn.logStreamAppendQuotas[tpls])
+		sn.appendLimiters.logStreams[tpls] = al
+	}
+	return al
+}
+
+func syncRateLimit(bytesPerSecond int64) rate.Limit {
+	if bytesPerSecond == 0 {
+		return rate.Inf
+	}
+	return rate.Limit(bytesPerSecond)
+}
+
 func (sn *StorageNode) trim(ctx context.Context, topicID types.TopicID, lastGLSN types.GLSN) map[types.LogStreamID]string {
 	ret := make(map[types.LogStreamID]string)
 	sn.executors.Range(func(lsid types.LogStreamID, tpid types.TopicID, lse *logstream.Executor) bool {
@@ -2,24 +2,30 @@ package storagenode
 
 import (
 	"context"
+	"fmt"
 	"io/fs"
 	"math/rand"
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/kakao/varlog/internal/reportcommitter"
 	"github.com/kakao/varlog/internal/storage"
+	"github.com/kakao/varlog/internal/storage/encryption"
 	"github.com/kakao/varlog/internal/storagenode/client"
 	"github.com/kakao/varlog/internal/storagenode/logstream"
+	"github.com/kakao/varlog/internal/storagenode/volume"
+	"github.com/kakao/varlog/pkg/rpc"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/snpb"
@@ -414,6 +420,24 @@ func TestStorageNode_InvalidConfig(t *testing.T) {
 		WithVolumes(badVolume, badVolume),
 	)
 	assert.Error(t, err)
+
+	// negative sync bandwidth
+	_, err = NewStorageNode(
+		WithStorageNodeID(1),
+		WithListenAddress("127.0.0.1:0"),
+		WithVolumes(t.TempDir()),
+		WithSyncBandwidth(-1),
+	)
+	assert.Error(t, err)
+
+	// non-positive chunk group limit
+	_, err = NewStorageNode(
+		WithStorageNodeID(1),
+		WithListenAddress("127.0.0.1:0"),
+		WithVolumes(t.TempDir()),
+		WithMaxChunkGroupSize(0, DefaultMaxChunkGroupChunks),
+	)
+	assert.Error(t, err)
 }
 
 func TestStorageNode_MakeVolumesAbsolute(t *testing.T) {
@@ -453,7 +477,7 @@ func TestStorageNode_RemoveLogStreamReplica(t *testing.T) {
 			name: "Succeed",
 			testf: func(t *testing.T, snpath string, mc *client.ManagementClient) {
 				ctx := context.Background()
-				lsrmd, err := mc.AddLogStreamReplica(ctx, tpid, lsid, snpath)
+				lsrmd, err := mc.AddLogStreamReplica(ctx, tpid, lsid, snpath, false)
 				require.NoError(t, err)
 				_, err = os.ReadDir(lsrmd.Path)
 				require.NoError(t, err)
@@ -578,12 +602,303 @@ func TestStorageNode_Report(t *testing.T) {
 			mc, mcClose := TestNewManagementClient(t, sn.cid, sn.snid, addr)
 			defer mcClose()
 
-			_, err := mc.AddLogStreamReplica(context.Background(), tpid, lsid, sn.snPaths[0])
+			_, err := mc.AddLogStreamReplica(context.Background(), tpid, lsid, sn.snPaths[0], false)
 			require.NoError(t, err)
 		})
 	}
 }
 
+func TestStorageNode_WatchReport(t *testing.T) {
+	const (
+		tpid = types.TopicID(1)
+		lsid = types.LogStreamID(1)
+	)
+
+	sn := TestNewSimpleStorageNode(t)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = sn.Serve()
+	}()
+	defer func() {
+		assert.NoError(t, sn.Close())
+		wg.Wait()
+	}()
+
+	addr := TestGetAdvertiseAddress(t, sn)
+	mc, mcClose := TestNewManagementClient(t, sn.cid, sn.snid, addr)
+	defer mcClose()
+
+	_, err := mc.AddLogStreamReplica(context.Background(), tpid, lsid, sn.snPaths[0], false)
+	require.NoError(t, err)
+
+	const heartbeatInterval = time.Minute
+	client, err := reportcommitter.NewWatchClient(context.Background(), addr, heartbeatInterval)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, client.Close())
+	}()
+
+	// The first report is sent immediately.
+	rsp, err := client.GetReport()
+	require.NoError(t, err)
+	require.Equal(t, sn.snid, rsp.StorageNodeID)
+	require.Len(t, rsp.UncommitReports, 1)
+	require.Equal(t, lsid, rsp.UncommitReports[0].LogStreamID)
+
+	// A new log stream replica changes reports, thus, a report is sent
+	// before the heartbeat interval elapses.
+	startTime := time.Now()
+	_, err = mc.AddLogStreamReplica(context.Background(), tpid, lsid+1, sn.snPaths[0], false)
+	require.NoError(t, err)
+
+	rsp, err = client.GetReport()
+	require.NoError(t, err)
+	require.Len(t, rsp.UncommitReports, 2)
+	require.Less(t, time.Since(startTime), heartbeatInterval)
+}
+
+func TestStorageNode_SetSyncBandwidth(t *testing.T) {
+	sn := TestNewSimpleStorageNode(t)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = sn.Serve()
+	}()
+	defer func() {
+		assert.NoError(t, sn.Close())
+		wg.Wait()
+	}()
+
+	addr := TestGetAdvertiseAddress(t, sn)
+	mc, mcClose := TestNewManagementClient(t, sn.cid, sn.snid, addr)
+	defer mcClose()
+
+	require.Equal(t, rate.Inf, sn.syncRateLimiter.Limit())
+
+	prev, err := mc.SetSyncBandwidth(context.Background(), 1<<20)
+	require.NoError(t, err)
+	require.Zero(t, prev)
+	require.Equal(t, rate.Limit(1<<20), sn.syncRateLimiter.Limit())
+	require.Equal(t, 1<<20, sn.syncRateLimiter.Burst())
+
+	_, err = mc.SetSyncBandwidth(context.Background(), -1)
+	require.Error(t, err)
+
+	prev, err = mc.SetSyncBandwidth(context.Background(), 0)
+	require.NoError(t, err)
+	require.EqualValues(t, 1<<20, prev)
+	require.Equal(t, rate.Inf, sn.syncRateLimiter.Limit())
+}
+
+func TestStorageNode_AdminLeaseFence(t *testing.T) {
+	sn := TestNewSimpleStorageNode(t)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = sn.Serve()
+	}()
+	defer func() {
+		assert.NoError(t, sn.Close())
+		wg.Wait()
+	}()
+
+	addr := TestGetAdvertiseAddress(t, sn)
+	mc, mcClose := TestNewManagementClient(t, sn.cid, sn.snid, addr)
+	defer mcClose()
+
+	// not fenced
+	_, err := mc.SetSyncBandwidth(context.Background(), 0)
+	require.NoError(t, err)
+
+	_, err = mc.SetSyncBandwidth(rpc.WithAdminLeaseEpoch(context.Background(), 2), 0)
+	require.NoError(t, err)
+
+	// stale
+	_, err = mc.SetSyncBandwidth(rpc.WithAdminLeaseEpoch(context.Background(), 1), 0)
+	require.ErrorIs(t, err, verrors.ErrStaleAdminLease)
+
+	// It does not fence RPCs that do not change the storage node.
+	_, err = mc.GetMetadata(rpc.WithAdminLeaseEpoch(context.Background(), 1))
+	require.NoError(t, err)
+
+	_, err = mc.SetSyncBandwidth(rpc.WithAdminLeaseEpoch(context.Background(), 3), 0)
+	require.NoError(t, err)
+	_, err = mc.SetSyncBandwidth(rpc.WithAdminLeaseEpoch(context.Background(), 2), 0)
+	require.ErrorIs(t, err, verrors.ErrStaleAdminLease)
+}
+
+func TestStorageNode_AppendQuota(t *testing.T) {
+	const (
+		cid     = types.ClusterID(1)
+		snid    = types.StorageNodeID(1)
+		tpid    = types.TopicID(1)
+		lsid    = types.LogStreamID(1)
+		numLogs = 5
+	)
+
+	sn := TestNewSimpleStorageNode(t,
+		WithClusterID(cid),
+		WithStorageNodeID(snid),
+		WithTopicAppendQuota(tpid, snpb.AppendQuota{RecordsPerSecond: 1}),
+	)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = sn.Serve()
+	}()
+	defer func() {
+		assert.NoError(t, sn.Close())
+		wg.Wait()
+	}()
+	TestWaitForStartingOfServe(t, sn)
+
+	replicas := []varlogpb.LogStreamReplica{{
+		StorageNode: varlogpb.StorageNode{
+			StorageNodeID: snid,
+			Address:       sn.advertise,
+		},
+		TopicLogStream: varlogpb.TopicLogStream{
+			TopicID:     tpid,
+			LogStreamID: lsid,
+		},
+	}}
+	TestAddLogStreamReplica(t, cid, snid, tpid, lsid, sn.snPaths[0], sn.advertise)
+	lss, _ := TestSealLogStreamReplica(t, cid, snid, tpid, lsid, types.InvalidGLSN, sn.advertise)
+	require.Equal(t, varlogpb.LogStreamStatusSealed, lss)
+	TestUnsealLogStreamReplica(t, cid, snid, tpid, lsid, replicas, sn.advertise)
+
+	lc, lcClose := TestNewLogIOClient(t, snid, sn.advertise)
+	defer lcClose()
+
+	// A batch larger than the burst is never allowed.
+	_, err := lc.Append(context.Background(), tpid, lsid, logstream.TestNewBatchData(t, numLogs, 0))
+	require.ErrorContains(t, err, "larger than the quota")
+	require.NotErrorIs(t, err, verrors.ErrThrottled)
+
+	var appendWg sync.WaitGroup
+	appendWg.Add(1)
+	go func() {
+		defer appendWg.Done()
+		res := TestAppend(t, tpid, lsid, logstream.TestNewBatchData(t, 1, 0), replicas)
+		assert.Len(t, res, 1)
+	}()
+	require.Eventually(t, func() bool {
+		reportcommitter.TestCommit(t, sn.advertise, snpb.CommitRequest{
+			StorageNodeID: snid,
+			CommitResult: snpb.LogStreamCommitResult{
+				TopicID:             tpid,
+				LogStreamID:         lsid,
+				CommittedLLSNOffset: types.MinLLSN,
+				CommittedGLSNOffset: types.MinGLSN,
+				CommittedGLSNLength: 1,
+				Version:             types.MinVersion,
+				HighWatermark:       1,
+			},
+		})
+		reports := reportcommitter.TestGetReport(t, sn.advertise)
+		return len(reports) == 1 && reports[0].Version == types.MinVersion
+	}, 5*time.Second, 10*time.Millisecond)
+	appendWg.Wait()
+
+	// The quota is used up.
+	_, err = lc.Append(context.Background(), tpid, lsid, logstream.TestNewBatchData(t, 1, 0))
+	require.ErrorIs(t, err, verrors.ErrThrottled)
+
+	mc, mcClose := TestNewManagementClient(t, cid, snid, sn.advertise)
+	defer mcClose()
+
+	_, err = mc.SetAppendQuota(context.Background(), types.TopicID(0), lsid, snpb.AppendQuota{})
+	require.Error(t, err)
+	_, err = mc.SetAppendQuota(context.Background(), tpid, lsid, snpb.AppendQuota{BytesPerSecond: -1})
+	require.Error(t, err)
+
+	prev, err := mc.SetAppendQuota(context.Background(), tpid, types.LogStreamID(0), snpb.AppendQuota{})
+	require.NoError(t, err)
+	require.Equal(t, snpb.AppendQuota{RecordsPerSecond: 1}, prev)
+	require.Equal(t, snpb.AppendQuota{}, sn.topicAppendLimiter(tpid).Quota())
+
+	prev, err = mc.SetAppendQuota(context.Background(), tpid, lsid, snpb.AppendQuota{BytesPerSecond: 1 << 20})
+	require.NoError(t, err)
+	require.Equal(t, snpb.AppendQuota{}, prev)
+	require.Equal(t, snpb.AppendQuota{BytesPerSecond: 1 << 20}, sn.logStreamAppendLimiter(tpid, lsid).Quota())
+}
+
+func TestStorageNode_MaxChunkGroupSize(t *testing.T) {
+	const (
+		cid  = types.ClusterID(1)
+		snid = types.StorageNodeID(1)
+		tpid = types.TopicID(1)
+		lsid = types.LogStreamID(1)
+	)
+
+	sn := TestNewSimpleStorageNode(t,
+		WithClusterID(cid),
+		WithStorageNodeID(snid),
+		WithMaxChunkGroupSize(10, 3),
+	)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = sn.Serve()
+	}()
+	defer func() {
+		assert.NoError(t, sn.Close())
+		wg.Wait()
+	}()
+	TestWaitForStartingOfServe(t, sn)
+
+	lc, lcClose := TestNewLogIOClient(t, snid, sn.advertise)
+	defer lcClose()
+
+	// too many chunks
+	_, err := lc.AppendChunkGroup(context.Background(), tpid, lsid, [][]byte{{1}, {2}, {3}, {4}})
+	require.ErrorContains(t, err, "more than 3 chunks")
+
+	// too large
+	_, err = lc.AppendChunkGroup(context.Background(), tpid, lsid, [][]byte{make([]byte, 6), make([]byte, 6)})
+	require.ErrorContains(t, err, "larger than 10 bytes")
+}
+
+func TestStorageNode_WatchReportHeartbeat(t *testing.T) {
+	sn := TestNewSimpleStorageNode(t)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = sn.Serve()
+	}()
+	defer func() {
+		assert.NoError(t, sn.Close())
+		wg.Wait()
+	}()
+
+	addr := TestGetAdvertiseAddress(t, sn)
+	const heartbeatInterval = 100 * time.Millisecond
+	client, err := reportcommitter.NewWatchClient(context.Background(), addr, heartbeatInterval)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, client.Close())
+	}()
+
+	_, err = client.GetReport()
+	require.NoError(t, err)
+
+	// Nothing changes, but reports are sent every heartbeat interval.
+	for i := 0; i < 3; i++ {
+		startTime := time.Now()
+		rsp, err := client.GetReport()
+		require.NoError(t, err)
+		require.Empty(t, rsp.UncommitReports)
+		require.GreaterOrEqual(t, time.Since(startTime), heartbeatInterval/2)
+	}
+}
+
 func TestStorageNode_Sync(t *testing.T) {
 	const (
 		cid  = types.ClusterID(1)
@@ -1089,48 +1404,52 @@ func TestStorageNode_Sync(t *testing.T) {
 		},
 	}
 
-	for _, tc := range tcs {
-		t.Run(tc.name, func(t *testing.T) {
-			var wg sync.WaitGroup
-			defer func() {
-				wg.Wait()
-			}()
-			nodes := make([]*StorageNode, 2)
-			for i := range nodes {
-				sn := TestNewSimpleStorageNode(t,
-					WithClusterID(cid),
-					WithStorageNodeID(types.StorageNodeID(i+1)),
-					WithDefaultLogStreamExecutorOptions(
-						logstream.WithSyncTimeout(syncTimeout),
-					),
-				)
-				nodes[i] = sn
-			}
-			defer func() {
-				for _, sn := range nodes {
-					_ = sn.Close()
+	for _, bulkSync := range []bool{false, true} {
+		for _, tc := range tcs {
+			t.Run(fmt.Sprintf("%s/bulkSync=%t", tc.name, bulkSync), func(t *testing.T) {
+				var wg sync.WaitGroup
+				defer func() {
+					wg.Wait()
+				}()
+				nodes := make([]*StorageNode, 2)
+				for i := range nodes {
+					sn := TestNewSimpleStorageNode(t,
+						WithClusterID(cid),
+						WithStorageNodeID(types.StorageNodeID(i+1)),
+						WithDefaultLogStreamExecutorOptions(
+							logstream.WithSyncTimeout(syncTimeout),
+							logstream.WithBulkSync(bulkSync),
+						),
+						WithSyncBandwidth(64<<20),
+					)
+					nodes[i] = sn
 				}
-			}()
-			for i := range nodes {
-				wg.Add(1)
-				sn := nodes[i]
-				go func() {
-					defer wg.Done()
-					_ = sn.Serve()
+				defer func() {
+					for _, sn := range nodes {
+						_ = sn.Close()
+					}
 				}()
-				TestWaitForStartingOfServe(t, sn)
-
-				TestAddLogStreamReplica(t, cid, sn.snid, tpid, lsid, sn.snPaths[0], sn.advertise)
-
-				status, localHWM := TestSealLogStreamReplica(t, cid, sn.snid, tpid, lsid, types.InvalidGLSN, sn.advertise)
-				require.Equal(t, varlogpb.LogStreamStatusSealed, status)
-				require.Equal(t, types.InvalidGLSN, localHWM)
-
-				TestUnsealLogStreamReplica(t, cid, sn.snid, tpid, lsid, makeReplicas(sn), sn.advertise)
-			}
+				for i := range nodes {
+					wg.Add(1)
+					sn := nodes[i]
+					go func() {
+						defer wg.Done()
+						_ = sn.Serve()
+					}()
+					TestWaitForStartingOfServe(t, sn)
+
+					TestAddLogStreamReplica(t, cid, sn.snid, tpid, lsid, sn.snPaths[0], sn.advertise)
+
+					status, localHWM := TestSealLogStreamReplica(t, cid, sn.snid, tpid, lsid, types.InvalidGLSN, sn.advertise)
+					require.Equal(t, varlogpb.LogStreamStatusSealed, status)
+					require.Equal(t, types.InvalidGLSN, localHWM)
+
+					TestUnsealLogStreamReplica(t, cid, sn.snid, tpid, lsid, makeReplicas(sn), sn.advertise)
+				}
 
-			tc.testf(t, nodes[0], nodes[1])
-		})
+				tc.testf(t, nodes[0], nodes[1])
+			})
+		}
 	}
 }
 
@@ -1146,17 +1465,17 @@ func TestStorageNode_MaxLogStreamReplicasCount(t *testing.T) {
 			name:                      "LimitOne",
 			maxLogStreamReplicasCount: 1,
 			testf: func(t *testing.T, snpath string, mc *client.ManagementClient) {
-				_, err := mc.AddLogStreamReplica(ctx, 1, 1, snpath)
+				_, err := mc.AddLogStreamReplica(ctx, 1, 1, snpath, false)
 				require.NoError(t, err)
 
-				_, err = mc.AddLogStreamReplica(ctx, 1, 2, snpath)
+				_, err = mc.AddLogStreamReplica(ctx, 1, 2, snpath, false)
 				require.Error(t, err)
 				require.Equal(t, codes.ResourceExhausted, status.Code(err))
 
 				err = mc.RemoveLogStream(ctx, 1, 1)
 				require.NoError(t, err)
 
-				_, err = mc.AddLogStreamReplica(ctx, 1, 2, snpath)
+				_, err = mc.AddLogStreamReplica(ctx, 1, 2, snpath, false)
 				require.NoError(t, err)
 			},
 		},
@@ -1164,7 +1483,7 @@ func TestStorageNode_MaxLogStreamReplicasCount(t *testing.T) {
 			name:                      "LimitZero",
 			maxLogStreamReplicasCount: 0,
 			testf: func(t *testing.T, snpath string, mc *client.ManagementClient) {
-				_, err := mc.AddLogStreamReplica(ctx, 1, 1, snpath)
+				_, err := mc.AddLogStreamReplica(ctx, 1, 1, snpath, false)
 				require.Error(t, err)
 				require.Equal(t, codes.ResourceExhausted, status.Code(err))
 			},
@@ -1193,3 +1512,402 @@ func TestStorageNode_MaxLogStreamReplicasCount(t *testing.T) {
 		})
 	}
 }
+
+func TestStorageNode_CheckpointAndRestoreLogStreamReplica(t *testing.T) {
+	const (
+		cid      = types.ClusterID(1)
+		snid1    = types.StorageNodeID(1)
+		snid2    = types.StorageNodeID(2)
+		tpid     = types.TopicID(1)
+		lsid     = types.LogStreamID(1)
+		numLogs  = 5
+		dataSize = 32
+	)
+
+	var wg sync.WaitGroup
+	sn1 := TestNewSimpleStorageNode(t,
+		WithClusterID(cid),
+		WithStorageNodeID(snid1),
+		WithVolumes(t.TempDir()),
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = sn1.Serve()
+	}()
+	defer func() {
+		assert.NoError(t, sn1.Close())
+		wg.Wait()
+	}()
+	TestWaitForStartingOfServe(t, sn1)
+
+	replicas := []varlogpb.LogStreamReplica{{
+		StorageNode: varlogpb.StorageNode{
+			StorageNodeID: snid1,
+			Address:       sn1.advertise,
+		},
+		TopicLogStream: varlogpb.TopicLogStream{
+			TopicID:     tpid,
+			LogStreamID: lsid,
+		},
+	}}
+	TestAddLogStreamReplica(t, cid, snid1, tpid, lsid, sn1.snPaths[0], sn1.advertise)
+	lss, _ := TestSealLogStreamReplica(t, cid, snid1, tpid, lsid, types.InvalidGLSN, sn1.advertise)
+	require.Equal(t, varlogpb.LogStreamStatusSealed, lss)
+	TestUnsealLogStreamReplica(t, cid, snid1, tpid, lsid, replicas, sn1.advertise)
+
+	var appendWg sync.WaitGroup
+	for i := 0; i < numLogs; i++ {
+		appendWg.Add(1)
+		go func() {
+			defer appendWg.Done()
+			res := TestAppend(t, tpid, lsid, [][]byte{make([]byte, dataSize)}, replicas)
+			assert.Len(t, res, 1)
+		}()
+	}
+	require.Eventually(t, func() bool {
+		reportcommitter.TestCommit(t, sn1.advertise, snpb.CommitRequest{
+			StorageNodeID: snid1,
+			CommitResult: snpb.LogStreamCommitResult{
+				TopicID:             tpid,
+				LogStreamID:         lsid,
+				CommittedLLSNOffset: types.MinLLSN,
+				CommittedGLSNOffset: types.MinGLSN,
+				CommittedGLSNLength: numLogs,
+				Version:             types.MinVersion,
+				HighWatermark:       numLogs,
+			},
+		})
+		reports := reportcommitter.TestGetReport(t, sn1.advertise)
+		return len(reports) == 1 && reports[0].Version == types.MinVersion
+	}, 5*time.Second, 10*time.Millisecond)
+	appendWg.Wait()
+
+	mc, mcClose := TestNewManagementClient(t, cid, snid1, sn1.advertise)
+	defer mcClose()
+
+	cpRoot := t.TempDir()
+
+	// invalid arguments
+	_, err := mc.CheckpointLogStreamReplica(context.Background(), tpid, lsid, "relative", false)
+	require.Error(t, err)
+	_, err = mc.CheckpointLogStreamReplica(context.Background(), tpid, lsid, cpRoot, false)
+	require.Error(t, err)
+	_, err = mc.CheckpointLogStreamReplica(context.Background(), tpid, lsid+1, filepath.Join(cpRoot, "none"), false)
+	require.Error(t, err)
+
+	expectedLWM := varlogpb.LogSequenceNumber{LLSN: types.MinLLSN, GLSN: types.MinGLSN}
+	expectedHWM := varlogpb.LogSequenceNumber{LLSN: numLogs, GLSN: numLogs}
+
+	for _, archive := range []bool{false, true} {
+		cpPath := filepath.Join(cpRoot, fmt.Sprintf("checkpoint-%t", archive))
+		cp, err := mc.CheckpointLogStreamReplica(context.Background(), tpid, lsid, cpPath, archive)
+		require.NoError(t, err)
+		require.Equal(t, cid, cp.ClusterID)
+		require.Equal(t, snid1, cp.StorageNodeID)
+		require.Equal(t, tpid, cp.TopicID)
+		require.Equal(t, lsid, cp.LogStreamID)
+		require.Equal(t, cpPath, cp.Path)
+		require.Equal(t, expectedLWM, cp.LocalLowWatermark)
+		require.Equal(t, expectedHWM, cp.LocalHighWatermark)
+		require.NotNil(t, cp.CommitContext)
+		require.Equal(t, types.MinVersion, cp.CommitContext.Version)
+
+		fi, err := os.Stat(cpPath)
+		require.NoError(t, err)
+		require.Equal(t, !archive, fi.IsDir())
+
+		// The checkpoint is restored into another storage node.
+		vol := t.TempDir()
+		_, err = RestoreLogStreamReplica(cpPath, vol, cid+1, snid2)
+		require.Error(t, err)
+		dd, err := RestoreLogStreamReplica(cpPath, vol, cid, snid2)
+		require.NoError(t, err)
+		require.Equal(t, snid2, dd.StorageNodeID)
+		require.Equal(t, tpid, dd.TopicID)
+		require.Equal(t, lsid, dd.LogStreamID)
+		_, err = RestoreLogStreamReplica(cpPath, vol, cid, snid2)
+		require.Error(t, err)
+
+		sn2 := TestNewSimpleStorageNode(t,
+			WithClusterID(cid),
+			WithStorageNodeID(snid2),
+			WithVolumes(vol),
+		)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = sn2.Serve()
+		}()
+		TestWaitForStartingOfServe(t, sn2)
+		snmd, err := sn2.getMetadata(context.Background())
+		require.NoError(t, err)
+		require.Len(t, snmd.LogStreamReplicas, 1)
+		lsrmd := snmd.LogStreamReplicas[0]
+		require.Equal(t, dd.String(), lsrmd.Path)
+		require.Equal(t, expectedLWM, lsrmd.LocalLowWatermark)
+		require.Equal(t, expectedHWM, lsrmd.LocalHighWatermark)
+		require.NoError(t, sn2.Close())
+	}
+}
+
+func TestStorageNode_SharedStorage(t *testing.T) {
+	const (
+		cid     = types.ClusterID(1)
+		snid    = types.StorageNodeID(1)
+		tpid    = types.TopicID(1)
+		lsid1   = types.LogStreamID(1)
+		lsid2   = types.LogStreamID(2)
+		numLogs = 5
+	)
+
+	vol := t.TempDir()
+	runStorageNode := func(t *testing.T) (*StorageNode, func()) {
+		sn := TestNewSimpleStorageNode(t,
+			WithClusterID(cid),
+			WithStorageNodeID(snid),
+			WithVolumes(vol),
+			WithSharedStorage(),
+		)
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = sn.Serve()
+		}()
+		TestWaitForStartingOfServe(t, sn)
+		return sn, func() {
+			assert.NoError(t, sn.Close())
+			wg.Wait()
+		}
+	}
+
+	sn, closeSN := runStorageNode(t)
+	require.DirExists(t, filepath.Join(sn.snPaths[0], sharedStorageDirName))
+
+	replicas := []varlogpb.LogStreamReplica{{
+		StorageNode: varlogpb.StorageNode{
+			StorageNodeID: snid,
+			Address:       sn.advertise,
+		},
+		TopicLogStream: varlogpb.TopicLogStream{
+			TopicID:     tpid,
+			LogStreamID: lsid1,
+		},
+	}}
+	TestAddLogStreamReplica(t, cid, snid, tpid, lsid1, sn.snPaths[0], sn.advertise)
+	TestAddLogStreamReplica(t, cid, snid, tpid, lsid2, sn.snPaths[0], sn.advertise)
+	lss, _ := TestSealLogStreamReplica(t, cid, snid, tpid, lsid1, types.InvalidGLSN, sn.advertise)
+	require.Equal(t, varlogpb.LogStreamStatusSealed, lss)
+	TestUnsealLogStreamReplica(t, cid, snid, tpid, lsid1, replicas, sn.advertise)
+
+	var appendWg sync.WaitGroup
+	appendWg.Add(1)
+	go func() {
+		defer appendWg.Done()
+		res := TestAppend(t, tpid, lsid1, make([][]byte, numLogs), replicas)
+		assert.Len(t, res, numLogs)
+	}()
+	require.Eventually(t, func() bool {
+		reportcommitter.TestCommit(t, sn.advertise, snpb.CommitRequest{
+			StorageNodeID: snid,
+			CommitResult: snpb.LogStreamCommitResult{
+				TopicID:             tpid,
+				LogStreamID:         lsid1,
+				CommittedLLSNOffset: types.MinLLSN,
+				CommittedGLSNOffset: types.MinGLSN,
+				CommittedGLSNLength: numLogs,
+				Version:             types.MinVersion,
+				HighWatermark:       numLogs,
+			},
+		})
+		reports := reportcommitter.TestGetReport(t, sn.advertise)
+		sort.Slice(reports, func(i, j int) bool {
+			return reports[i].LogStreamID < reports[j].LogStreamID
+		})
+		return len(reports) == 2 && reports[0].Version == types.MinVersion
+	}, 5*time.Second, 10*time.Millisecond)
+	appendWg.Wait()
+
+	// Log stream directories are only markers.
+	des, err := os.ReadDir(filepath.Join(sn.snPaths[0], "tpid_1_lsid_1"))
+	require.NoError(t, err)
+	require.Empty(t, des)
+
+	// A checkpoint of a log stream replica in the shared storage is a
+	// standalone storage.
+	mc, mcClose := TestNewManagementClient(t, cid, snid, sn.advertise)
+	cpPath := filepath.Join(t.TempDir(), "checkpoint")
+	_, err = mc.CheckpointLogStreamReplica(context.Background(), tpid, lsid1, cpPath, false)
+	require.NoError(t, err)
+	stg, err := storage.New(storage.WithPath(filepath.Join(cpPath, checkpointDataDirName)), storage.ReadOnly())
+	require.NoError(t, err)
+	rp, err := stg.ReadRecoveryPoints()
+	require.NoError(t, err)
+	require.NoError(t, stg.Close())
+	require.NotNil(t, rp.CommittedLogEntry.Last)
+	require.EqualValues(t, numLogs, rp.CommittedLogEntry.Last.LLSN)
+
+	require.NoError(t, mc.RemoveLogStream(context.Background(), tpid, lsid2))
+	mcClose()
+	closeSN()
+
+	// Log entries survive restarting the storage node.
+	sn, closeSN = runStorageNode(t)
+	defer closeSN()
+	mc, mcClose = TestNewManagementClient(t, cid, snid, sn.advertise)
+	defer mcClose()
+	snmd, err := mc.GetMetadata(context.Background())
+	require.NoError(t, err)
+	require.Len(t, snmd.LogStreamReplicas, 1)
+	require.Equal(t, lsid1, snmd.LogStreamReplicas[0].LogStreamID)
+	require.EqualValues(t, numLogs, snmd.LogStreamReplicas[0].LocalHighWatermark.LLSN)
+
+	// A removed log stream replica added again has no log entries.
+	lsrmd, err := mc.AddLogStreamReplica(context.Background(), tpid, lsid2, sn.snPaths[0], false)
+	require.NoError(t, err)
+	require.True(t, lsrmd.LocalHighWatermark.LLSN.Invalid())
+}
+
+func TestStorageNode_Encryption(t *testing.T) {
+	const (
+		cid     = types.ClusterID(1)
+		snid    = types.StorageNodeID(1)
+		tpid    = types.TopicID(1)
+		lsid    = types.LogStreamID(1)
+		numLogs = 5
+	)
+
+	keyfile := filepath.Join(t.TempDir(), "keyfile")
+	require.NoError(t, encryption.GenerateKEK(keyfile, "kek1"))
+	kp, err := encryption.NewKeyfileProvider(keyfile)
+	require.NoError(t, err)
+
+	vol := t.TempDir()
+	runStorageNode := func(t *testing.T, opts ...Option) (*StorageNode, func()) {
+		sn := TestNewSimpleStorageNode(t, append([]Option{
+			WithClusterID(cid),
+			WithStorageNodeID(snid),
+			WithVolumes(vol),
+		}, opts...)...)
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = sn.Serve()
+		}()
+		TestWaitForStartingOfServe(t, sn)
+		return sn, func() {
+			assert.NoError(t, sn.Close())
+			wg.Wait()
+		}
+	}
+
+	sn, closeSN := runStorageNode(t, WithEncryptionKeyProvider(kp))
+	replicas := []varlogpb.LogStreamReplica{{
+		StorageNode: varlogpb.StorageNode{
+			StorageNodeID: snid,
+			Address:       sn.advertise,
+		},
+		TopicLogStream: varlogpb.TopicLogStream{
+			TopicID:     tpid,
+			LogStreamID: lsid,
+		},
+	}}
+	TestAddLogStreamReplica(t, cid, snid, tpid, lsid, sn.snPaths[0], sn.advertise)
+	lss, _ := TestSealLogStreamReplica(t, cid, snid, tpid, lsid, types.InvalidGLSN, sn.advertise)
+	require.Equal(t, varlogpb.LogStreamStatusSealed, lss)
+	TestUnsealLogStreamReplica(t, cid, snid, tpid, lsid, replicas, sn.advertise)
+
+	var appendWg sync.WaitGroup
+	appendWg.Add(1)
+	go func() {
+		defer appendWg.Done()
+		dataBatch := make([][]byte, numLogs)
+		for i := range dataBatch {
+			dataBatch[i] = []byte("plaintext")
+		}
+		res := TestAppend(t, tpid, lsid, dataBatch, replicas)
+		assert.Len(t, res, numLogs)
+	}()
+	require.Eventually(t, func() bool {
+		reportcommitter.TestCommit(t, sn.advertise, snpb.CommitRequest{
+			StorageNodeID: snid,
+			CommitResult: snpb.LogStreamCommitResult{
+				TopicID:             tpid,
+				LogStreamID:         lsid,
+				CommittedLLSNOffset: types.MinLLSN,
+				CommittedGLSNOffset: types.MinGLSN,
+				CommittedGLSNLength: numLogs,
+				Version:             types.MinVersion,
+				HighWatermark:       numLogs,
+			},
+		})
+		reports := reportcommitter.TestGetReport(t, sn.advertise)
+		return len(reports) == 1 && reports[0].Version == types.MinVersion
+	}, 5*time.Second, 10*time.Millisecond)
+	appendWg.Wait()
+
+	dd, err := volume.ParseDataDir(filepath.Join(sn.snPaths[0], volume.LogStreamDirName(tpid, lsid)))
+	require.NoError(t, err)
+	encrypted, err := dd.Encrypted()
+	require.NoError(t, err)
+	require.True(t, encrypted)
+
+	mc, mcClose := TestNewManagementClient(t, cid, snid, sn.advertise)
+	cpPath := filepath.Join(t.TempDir(), "checkpoint")
+	_, err = mc.CheckpointLogStreamReplica(context.Background(), tpid, lsid, cpPath, false)
+	require.NoError(t, err)
+	mcClose()
+	closeSN()
+
+	// The encrypted replica is not loaded without the key provider.
+	sn, closeSN = runStorageNode(t)
+	snmd, err := sn.getMetadata(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, snmd.LogStreamReplicas)
+	closeSN()
+
+	// The encrypted replica is loaded with the key provider.
+	sn, closeSN = runStorageNode(t, WithEncryptionKeyProvider(kp))
+	snmd, err = sn.getMetadata(context.Background())
+	require.NoError(t, err)
+	require.Len(t, snmd.LogStreamReplicas, 1)
+	require.EqualValues(t, numLogs, snmd.LogStreamReplicas[0].LocalHighWatermark.LLSN)
+	les := TestSubscribe(t, tpid, lsid, types.MinGLSN, numLogs+1, snid, sn.advertise)
+	require.Len(t, les, numLogs)
+	for _, le := range les {
+		require.Equal(t, "plaintext", string(le.Data))
+	}
+	closeSN()
+
+	// The running storage node picks up the rotated kek and rotates data
+	// keys without restart.
+	sn, closeSN = runStorageNode(t, WithEncryptionKeyProvider(kp), WithDataKeyRotationInterval(10*time.Millisecond))
+	require.NoError(t, encryption.GenerateKEK(keyfile, "kek2"))
+	kek2File := filepath.Join(t.TempDir(), "keyfile")
+	buf, err := os.ReadFile(keyfile)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(buf)), "\n")
+	require.NoError(t, os.WriteFile(kek2File, []byte(lines[len(lines)-1]), 0600))
+	kek2, err := encryption.NewKeyfileProvider(kek2File)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		kr, err := encryption.OpenKeyring(context.Background(), dd.String(), kek2)
+		return err == nil && kr.ActiveKeyID() > 1
+	}, 5*time.Second, 10*time.Millisecond)
+	les = TestSubscribe(t, tpid, lsid, types.MinGLSN, numLogs+1, snid, sn.advertise)
+	require.Len(t, les, numLogs)
+	closeSN()
+
+	// The checkpoint of the encrypted replica needs the key provider to be
+	// restored.
+	restoreVol := t.TempDir()
+	_, err = RestoreLogStreamReplica(cpPath, restoreVol, cid, snid+1)
+	require.ErrorIs(t, err, storage.ErrNoKeyProvider)
+	dd, err = RestoreLogStreamReplica(cpPath, restoreVol, cid, snid+1, storage.WithEncryption(kp))
+	require.NoError(t, err)
+	encrypted, err = dd.Encrypted()
+	require.NoError(t, err)
+	require.True(t, encrypted)
+}
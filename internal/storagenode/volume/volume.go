@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/kakao/varlog/internal/storage/encryption"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/fputil"
 )
@@ -55,6 +56,13 @@ func (dd *DataDir) Valid(cid types.ClusterID, snid types.StorageNodeID) error {
 	return WritableDirectory(dd.String())
 }
 
+// Encrypted returns true if the data directory has a storage encrypted at
+// rest. An encrypted storage can be opened only with a key provider that can
+// unwrap its data keys.
+func (dd *DataDir) Encrypted() (bool, error) {
+	return encryption.KeyringExists(dd.String())
+}
+
 // ParseDataDir parses data directory into DataDir struct only by lexical
 // processing. The argument dataDir should be absolute path.
 func ParseDataDir(dataDir string) (dd DataDir, err error) {
@@ -191,7 +199,7 @@ func readVolume(vol string) ([]DataDir, error) {
 
 	var dataPaths []DataDir
 	for _, de := range des {
-		if !de.IsDir() {
+		if !de.IsDir() || isHidden(de.Name()) {
 			continue
 		}
 		cid, snid, err := parseStorageNodeDirName(de.Name())
@@ -205,7 +213,7 @@ func readVolume(vol string) ([]DataDir, error) {
 			return nil, err
 		}
 		for _, de2 := range des2 {
-			if !de2.IsDir() {
+			if !de2.IsDir() || isHidden(de2.Name()) {
 				continue
 			}
 			tpid, lsid, err := parseLogStreamDirName(de2.Name())
@@ -225,6 +233,13 @@ func readVolume(vol string) ([]DataDir, error) {
 	return dataPaths, nil
 }
 
+// isHidden returns true if the name of a directory starts with a dot. Hidden
+// directories are neither storage node nor log stream directories, for
+// instance, a shared storage database or a temporary directory.
+func isHidden(name string) bool {
+	return strings.HasPrefix(name, ".")
+}
+
 // CreateStorageNodePaths creates the directory structure for the storage node
 // data. The directory structure is composed of the volume, cluster ID and
 // storage node ID, for example, "/volume/cid_1_snid_1/".
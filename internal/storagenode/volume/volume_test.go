@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/kakao/varlog/internal/storage/encryption"
 	"github.com/kakao/varlog/pkg/types"
 )
 
@@ -99,6 +100,16 @@ func TestVolume_ReadVolumes(t *testing.T) {
 	// not directory
 	dataDirs, err = ReadVolumes([]string{"./testdata/volume4"})
 	assert.Errorf(t, err, "%v", dataDirs)
+
+	// hidden directories
+	vol := t.TempDir()
+	snPath := filepath.Join(vol, StorageNodeDirName(1, 1))
+	assert.NoError(t, os.MkdirAll(filepath.Join(snPath, LogStreamDirName(1, 1)), volumeFileMode))
+	assert.NoError(t, os.MkdirAll(filepath.Join(snPath, ".shared"), volumeFileMode))
+	assert.NoError(t, os.MkdirAll(filepath.Join(vol, ".hidden"), volumeFileMode))
+	dataDirs, err = ReadVolumes([]string{vol})
+	assert.NoError(t, err)
+	assert.Len(t, dataDirs, 1)
 }
 
 func TestVolume_ParseDataDir(t *testing.T) {
@@ -331,6 +342,26 @@ func TestVolume_DataDir(t *testing.T) {
 	}
 }
 
+func TestVolume_DataDirEncrypted(t *testing.T) {
+	dd := DataDir{
+		Volume:        t.TempDir(),
+		ClusterID:     1,
+		StorageNodeID: 1,
+		TopicID:       1,
+		LogStreamID:   1,
+	}
+	require.NoError(t, os.MkdirAll(dd.String(), volumeFileMode))
+
+	encrypted, err := dd.Encrypted()
+	require.NoError(t, err)
+	require.False(t, encrypted)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dd.String(), encryption.KeyringFileName), nil, 0600))
+	encrypted, err = dd.Encrypted()
+	require.NoError(t, err)
+	require.True(t, encrypted)
+}
+
 func TestVolume_GetValidDataDirectories_NonStrict(t *testing.T) {
 	const (
 		cid  = types.ClusterID(1)
@@ -0,0 +1,214 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/kakao/varlog/internal/varlogctl"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/varlog"
+)
+
+// ActionKind is the kind of action to reconcile the cluster with the spec.
+type ActionKind string
+
+const (
+	ActionAddMetadataRepositoryNode ActionKind = "addMetadataRepositoryNode"
+	ActionAddStorageNode            ActionKind = "addStorageNode"
+	ActionAddTopic                  ActionKind = "addTopic"
+	ActionAddLogStream              ActionKind = "addLogStream"
+)
+
+// Action is a step of the plan.
+type Action struct {
+	Kind          ActionKind          `json:"kind"`
+	RaftURL       string              `json:"raftUrl,omitempty"`
+	RPCAddress    string              `json:"rpcAddress,omitempty"`
+	StorageNodeID types.StorageNodeID `json:"storageNodeId,omitempty"`
+	Address       string              `json:"address,omitempty"`
+	TopicID       types.TopicID       `json:"topicId,omitempty"`
+}
+
+// Plan is a list of actions that make the cluster match the spec.
+// Reconciling only adds what the spec has but the cluster does not have. It
+// never removes anything from the cluster; instead, the plan warns about what
+// the cluster has but the spec does not have.
+type Plan struct {
+	Actions  []Action `json:"actions"`
+	Warnings []string `json:"warnings"`
+	// Applied is true if the actions have been executed.
+	Applied bool `json:"applied"`
+}
+
+// Apply returns a function that diffs the spec against the cluster and
+// returns the plan. If the argument apply is true, it also executes the
+// plan. Since it plans from the current state of the cluster, applying the
+// same spec again does nothing.
+//
+// The cluster issues identifiers of new topics one greater than the greatest
+// one. Hence, topics to be added should have the identifiers following those
+// of the cluster; otherwise, planning fails. If the cluster nevertheless
+// issues an unexpected identifier, for instance, since topics were
+// unregistered recently, Apply unregisters the topic and fails before adding
+// log streams to it.
+func Apply(spec *Spec, apply bool) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		plan, err := makePlan(ctx, adm, spec)
+		if err != nil {
+			return nil, err
+		}
+		if !apply {
+			return plan, nil
+		}
+		for _, action := range plan.Actions {
+			if err := execute(ctx, adm, action); err != nil {
+				return nil, fmt.Errorf("apply: %s: %w", action.Kind, err)
+			}
+		}
+		plan.Applied = true
+		return plan, nil
+	}
+}
+
+func makePlan(ctx context.Context, adm varlog.Admin, spec *Spec) (*Plan, error) {
+	plan := &Plan{
+		Actions:  []Action{},
+		Warnings: []string{},
+	}
+
+	mrns, err := adm.ListMetadataRepositoryNodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("plan: %w", err)
+	}
+	mrnMap := make(map[types.NodeID]string, len(mrns))
+	for _, mrn := range mrns {
+		mrnMap[mrn.NodeID] = mrn.RaftURL
+	}
+	for _, mrn := range spec.MetadataRepositoryNodes {
+		nid := types.NewNodeIDFromURL(mrn.RaftURL)
+		if _, ok := mrnMap[nid]; ok {
+			delete(mrnMap, nid)
+			continue
+		}
+		plan.Actions = append(plan.Actions, Action{
+			Kind:       ActionAddMetadataRepositoryNode,
+			RaftURL:    mrn.RaftURL,
+			RPCAddress: mrn.RPCAddress,
+		})
+	}
+	for _, mrn := range mrns {
+		if _, ok := mrnMap[mrn.NodeID]; ok {
+			plan.Warnings = append(plan.Warnings, fmt.Sprintf("metadata repository node %s not in spec", mrn.RaftURL))
+		}
+	}
+
+	snms, err := adm.ListStorageNodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("plan: %w", err)
+	}
+	snMap := make(map[types.StorageNodeID]string, len(snms))
+	for _, snm := range snms {
+		snMap[snm.StorageNode.StorageNodeID] = snm.StorageNode.Address
+	}
+	for _, sn := range spec.StorageNodes {
+		addr, ok := snMap[sn.StorageNodeID]
+		if !ok {
+			plan.Actions = append(plan.Actions, Action{
+				Kind:          ActionAddStorageNode,
+				StorageNodeID: sn.StorageNodeID,
+				Address:       sn.Address,
+			})
+			continue
+		}
+		if addr != sn.Address {
+			return nil, fmt.Errorf("plan: storage node %d: address %s in spec differs from %s", sn.StorageNodeID, sn.Address, addr)
+		}
+		delete(snMap, sn.StorageNodeID)
+	}
+	for _, snm := range snms {
+		if _, ok := snMap[snm.StorageNode.StorageNodeID]; ok {
+			plan.Warnings = append(plan.Warnings, fmt.Sprintf("storage node %d not in spec", snm.StorageNode.StorageNodeID))
+		}
+	}
+
+	tds, err := adm.ListTopics(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("plan: %w", err)
+	}
+	numLogStreams := make(map[types.TopicID]int, len(tds))
+	maxTopicID := types.TopicID(0)
+	for _, td := range tds {
+		numLogStreams[td.TopicID] = len(td.LogStreams)
+		if td.TopicID > maxTopicID {
+			maxTopicID = td.TopicID
+		}
+	}
+	topics := make([]TopicSpec, len(spec.Topics))
+	copy(topics, spec.Topics)
+	sort.Slice(topics, func(i, j int) bool {
+		return topics[i].TopicID < topics[j].TopicID
+	})
+	for _, tp := range topics {
+		cur, ok := numLogStreams[tp.TopicID]
+		if !ok {
+			if tp.TopicID != maxTopicID+1 {
+				return nil, fmt.Errorf("plan: topic %d: cannot be added since the cluster issues topic %d next, update the spec", tp.TopicID, maxTopicID+1)
+			}
+			maxTopicID = tp.TopicID
+			plan.Actions = append(plan.Actions, Action{
+				Kind:    ActionAddTopic,
+				TopicID: tp.TopicID,
+			})
+		}
+		delete(numLogStreams, tp.TopicID)
+		for i := cur; i < tp.LogStreams; i++ {
+			plan.Actions = append(plan.Actions, Action{
+				Kind:    ActionAddLogStream,
+				TopicID: tp.TopicID,
+			})
+		}
+		if cur > tp.LogStreams {
+			plan.Warnings = append(plan.Warnings, fmt.Sprintf("topic %d has %d log streams more than spec", tp.TopicID, cur-tp.LogStreams))
+		}
+	}
+	for _, td := range tds {
+		if _, ok := numLogStreams[td.TopicID]; ok {
+			plan.Warnings = append(plan.Warnings, fmt.Sprintf("topic %d not in spec", td.TopicID))
+		}
+	}
+
+	return plan, nil
+}
+
+// execute runs the action. If the cluster adds a topic whose identifier
+// differs from that of the action, it unregisters the topic and returns an
+// error.
+func execute(ctx context.Context, adm varlog.Admin, action Action) error {
+	switch action.Kind {
+	case ActionAddMetadataRepositoryNode:
+		_, err := adm.AddMetadataRepositoryNode(ctx, action.RaftURL, action.RPCAddress)
+		return err
+	case ActionAddStorageNode:
+		_, err := adm.AddStorageNode(ctx, action.StorageNodeID, action.Address)
+		return err
+	case ActionAddTopic:
+		td, err := adm.AddTopic(ctx)
+		if err != nil {
+			return err
+		}
+		if td.TopicID == action.TopicID {
+			return nil
+		}
+		err = fmt.Errorf("topic %d added instead of topic %d, update the spec", td.TopicID, action.TopicID)
+		if uerr := adm.UnregisterTopic(ctx, td.TopicID); uerr != nil {
+			err = fmt.Errorf("%w: unregister topic %d: %v", err, td.TopicID, uerr)
+		}
+		return err
+	case ActionAddLogStream:
+		_, err := adm.AddLogStream(ctx, action.TopicID, nil)
+		return err
+	default:
+		return fmt.Errorf("unknown action %s", action.Kind)
+	}
+}
@@ -0,0 +1,119 @@
+package cluster
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/kakao/varlog/pkg/types"
+)
+
+// Spec is the desired state of a cluster. It is written in YAML, for
+// instance:
+//
+//	metadataRepositoryNodes:
+//	  - raftUrl: http://127.0.1.1:10000
+//	    rpcAddress: 127.0.1.1:10001
+//	storageNodes:
+//	  - storageNodeId: 1
+//	    address: 127.0.0.1:10000
+//	topics:
+//	  - topicId: 1
+//	    logStreams: 2
+type Spec struct {
+	MetadataRepositoryNodes []MetadataRepositoryNodeSpec `json:"metadataRepositoryNodes,omitempty"`
+	StorageNodes            []StorageNodeSpec            `json:"storageNodes,omitempty"`
+	Topics                  []TopicSpec                  `json:"topics,omitempty"`
+}
+
+// MetadataRepositoryNodeSpec is the desired state of a metadata repository
+// node. It is identified by its raft URL.
+type MetadataRepositoryNodeSpec struct {
+	RaftURL    string `json:"raftUrl"`
+	RPCAddress string `json:"rpcAddress"`
+}
+
+// StorageNodeSpec is the desired state of a storage node.
+type StorageNodeSpec struct {
+	StorageNodeID types.StorageNodeID `json:"storageNodeId"`
+	Address       string              `json:"address"`
+}
+
+// TopicSpec is the desired state of a topic. Since the cluster issues topic
+// IDs, a topic to be added should have the ID following the greatest one in
+// the cluster; see Apply.
+type TopicSpec struct {
+	TopicID types.TopicID `json:"topicId"`
+	// LogStreams is the desired number of log streams in the topic.
+	LogStreams int `json:"logStreams"`
+}
+
+// ReadSpec reads the spec from the file specified by the argument path.
+func ReadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cluster spec: %w", err)
+	}
+	return ParseSpec(data)
+}
+
+// ParseSpec parses the spec written in YAML or JSON. It returns an error if
+// the spec has unknown fields or is invalid.
+func ParseSpec(data []byte) (*Spec, error) {
+	spec := &Spec{}
+	if err := yaml.UnmarshalStrict(data, spec); err != nil {
+		return nil, fmt.Errorf("cluster spec: %w", err)
+	}
+	if err := spec.validate(); err != nil {
+		return nil, fmt.Errorf("cluster spec: %w", err)
+	}
+	return spec, nil
+}
+
+func (spec *Spec) validate() error {
+	nids := make(map[types.NodeID]bool, len(spec.MetadataRepositoryNodes))
+	for _, mrn := range spec.MetadataRepositoryNodes {
+		if len(mrn.RaftURL) == 0 || len(mrn.RPCAddress) == 0 {
+			return errors.New("metadata repository node: no raft url or rpc address")
+		}
+		nid := types.NewNodeIDFromURL(mrn.RaftURL)
+		if nid == types.InvalidNodeID {
+			return fmt.Errorf("metadata repository node: invalid raft url %s", mrn.RaftURL)
+		}
+		if nids[nid] {
+			return fmt.Errorf("metadata repository node: duplicated raft url %s", mrn.RaftURL)
+		}
+		nids[nid] = true
+	}
+
+	snids := make(map[types.StorageNodeID]bool, len(spec.StorageNodes))
+	for _, sn := range spec.StorageNodes {
+		if sn.StorageNodeID.Invalid() {
+			return fmt.Errorf("storage node: invalid id %d", sn.StorageNodeID)
+		}
+		if len(sn.Address) == 0 {
+			return fmt.Errorf("storage node %d: no address", sn.StorageNodeID)
+		}
+		if snids[sn.StorageNodeID] {
+			return fmt.Errorf("storage node %d: duplicated", sn.StorageNodeID)
+		}
+		snids[sn.StorageNodeID] = true
+	}
+
+	tpids := make(map[types.TopicID]bool, len(spec.Topics))
+	for _, tp := range spec.Topics {
+		if tp.TopicID.Invalid() {
+			return fmt.Errorf("topic: invalid id %d", tp.TopicID)
+		}
+		if tp.LogStreams < 0 {
+			return fmt.Errorf("topic %d: negative number of log streams", tp.TopicID)
+		}
+		if tpids[tp.TopicID] {
+			return fmt.Errorf("topic %d: duplicated", tp.TopicID)
+		}
+		tpids[tp.TopicID] = true
+	}
+	return nil
+}
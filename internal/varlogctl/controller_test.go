@@ -3,21 +3,27 @@ package varlogctl_test
 import (
 	"context"
 	"flag"
+	"io"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/goleak"
 
 	"github.com/kakao/varlog/internal/varlogctl"
+	"github.com/kakao/varlog/internal/varlogctl/cluster"
+	"github.com/kakao/varlog/internal/varlogctl/event"
 	"github.com/kakao/varlog/internal/varlogctl/logstream"
 	"github.com/kakao/varlog/internal/varlogctl/metarepos"
+	"github.com/kakao/varlog/internal/varlogctl/operation"
 	"github.com/kakao/varlog/internal/varlogctl/storagenode"
 	"github.com/kakao/varlog/internal/varlogctl/topic"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/varlog"
+	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
 	"github.com/kakao/varlog/proto/vmspb"
@@ -148,6 +154,71 @@ var (
 		LastHeartbeatTime: time.Date(2022, time.November, 1, 11, 37, 19, 0, time.UTC),
 	}
 
+	snm3 = &vmspb.StorageNodeMetadata{
+		StorageNodeMetadataDescriptor: snpb.StorageNodeMetadataDescriptor{
+			ClusterID: cid,
+			StorageNode: varlogpb.StorageNode{
+				StorageNodeID: snid1,
+				Address:       addr1,
+			},
+			Storages: []varlogpb.StorageDescriptor{
+				{
+					Path:  "/tmp1",
+					Used:  32 << 10,
+					Total: 1 << 20,
+				},
+			},
+			LogStreamReplicas: []snpb.LogStreamReplicaMetadataDescriptor{*lsrmd1},
+			StartTime:         time.Date(2022, time.October, 1, 3, 23, 21, 0, time.UTC),
+		},
+		CreateTime:        time.Date(2022, time.September, 27, 17, 46, 40, 0, time.UTC),
+		LastHeartbeatTime: time.Date(2022, time.November, 1, 11, 37, 19, 0, time.UTC),
+		DrainStatus: &vmspb.DrainStatus{
+			State:         vmspb.DrainStateDraining,
+			TotalReplicas: 2,
+			MovedReplicas: 1,
+			LogStreamID:   lsid1,
+			StartTime:     time.Date(2022, time.November, 2, 9, 0, 0, 0, time.UTC),
+			UpdateTime:    time.Date(2022, time.November, 2, 9, 1, 30, 0, time.UTC),
+		},
+	}
+
+	op1 = &vmspb.Operation{
+		OperationID:      1,
+		Kind:             vmspb.OperationKindSync,
+		State:            vmspb.OperationStateRunning,
+		TopicID:          tpid1,
+		LogStreamID:      lsid1,
+		SrcStorageNodeID: snid1,
+		DstStorageNodeID: snid2,
+		Progress: vmspb.OperationProgress{
+			TotalEntries: 10,
+			DoneEntries:  5,
+		},
+		CreateTime: time.Date(2022, time.November, 2, 9, 0, 0, 0, time.UTC),
+		UpdateTime: time.Date(2022, time.November, 2, 9, 1, 30, 0, time.UTC),
+	}
+
+	ev1 = vmspb.Event{
+		EventID:       1667379600000000000,
+		Kind:          vmspb.EventKindHeartbeatTimeout,
+		CreateTime:    time.Date(2022, time.November, 2, 9, 0, 0, 0, time.UTC),
+		StorageNodeID: snid1,
+	}
+	ev2 = vmspb.Event{
+		EventID:          1667379601000000000,
+		Kind:             vmspb.EventKindSyncProgress,
+		CreateTime:       time.Date(2022, time.November, 2, 9, 0, 1, 0, time.UTC),
+		TopicID:          tpid1,
+		LogStreamID:      lsid1,
+		SrcStorageNodeID: snid1,
+		DstStorageNodeID: snid2,
+		Progress: &vmspb.OperationProgress{
+			TotalEntries: 10,
+			DoneEntries:  5,
+		},
+	}
+
 	td1 = &varlogpb.TopicDescriptor{
 		TopicID: tpid1,
 		Status:  varlogpb.TopicStatusRunning,
@@ -179,15 +250,27 @@ var (
 	}
 
 	mrnode1 = &varlogpb.MetadataRepositoryNode{
-		NodeID:  types.NewNodeIDFromURL(rafturl1),
-		RaftURL: rafturl1,
-		RPCAddr: rpcaddr1,
-		Leader:  true,
-		Learner: false,
+		NodeID:        types.NewNodeIDFromURL(rafturl1),
+		RaftURL:       rafturl1,
+		RPCAddr:       rpcaddr1,
+		Leader:        true,
+		Learner:       false,
+		MatchIndex:    10,
+		ProgressState: "StateReplicate",
+		Active:        true,
 	}
 )
 
 func TestController(t *testing.T) {
+	spec, err := cluster.ReadSpec(testdata.Path("varlogctl/cluster.yaml"))
+	require.NoError(t, err)
+
+	initApplyMock := func(adm *varlog.MockAdmin) {
+		adm.EXPECT().ListMetadataRepositoryNodes(gomock.Any()).Return([]varlogpb.MetadataRepositoryNode{}, nil)
+		adm.EXPECT().ListStorageNodes(gomock.Any()).Return([]vmspb.StorageNodeMetadata{*snm1}, nil)
+		adm.EXPECT().ListTopics(gomock.Any()).Return([]varlogpb.TopicDescriptor{*td1}, nil)
+	}
+
 	tcs := []struct {
 		name        string
 		golden      string
@@ -251,6 +334,52 @@ func TestController(t *testing.T) {
 				).Return(nil)
 			},
 		},
+		{
+			name:        "DrainStorageNode0",
+			golden:      "varlogctl/drainstoragenode.0.golden.json",
+			executeFunc: storagenode.Drain(snm3.StorageNode.StorageNodeID),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().DrainStorageNode(gomock.Any(), snm3.StorageNode.StorageNodeID).Return(snm3, nil)
+			},
+		},
+		{
+			name:        "SetStorageNodeSyncBandwidth0",
+			golden:      "varlogctl/setstoragenodesyncbandwidth.0.golden.json",
+			executeFunc: storagenode.SetSyncBandwidth(snid1, 32<<20),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().SetStorageNodeSyncBandwidth(gomock.Any(), snid1, int64(32<<20)).Return(
+					&vmspb.SetStorageNodeSyncBandwidthResponse{
+						StorageNodeID:      snid1,
+						PrevBytesPerSecond: 0,
+						BytesPerSecond:     32 << 20,
+					}, nil,
+				)
+			},
+		},
+		{
+			name:   "SetStorageNodeAppendQuota0",
+			golden: "varlogctl/setstoragenodeappendquota.0.golden.json",
+			executeFunc: storagenode.SetAppendQuota(snid1, tpid1, lsid1, snpb.AppendQuota{
+				BytesPerSecond:   8 << 20,
+				RecordsPerSecond: 1000,
+			}),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().SetStorageNodeAppendQuota(gomock.Any(), snid1, tpid1, lsid1, snpb.AppendQuota{
+					BytesPerSecond:   8 << 20,
+					RecordsPerSecond: 1000,
+				}).Return(
+					&vmspb.SetStorageNodeAppendQuotaResponse{
+						StorageNodeID: snid1,
+						TopicID:       tpid1,
+						LogStreamID:   lsid1,
+						Quota: snpb.AppendQuota{
+							BytesPerSecond:   8 << 20,
+							RecordsPerSecond: 1000,
+						},
+					}, nil,
+				)
+			},
+		},
 		{
 			name:        "GetTopic0",
 			golden:      "varlogctl/gettopic.0.golden.json",
@@ -278,7 +407,7 @@ func TestController(t *testing.T) {
 		{
 			name:        "AddTopic",
 			golden:      "varlogctl/addtopic.0.golden.json",
-			executeFunc: topic.Add(),
+			executeFunc: topic.Add(false),
 			initMock: func(adm *varlog.MockAdmin) {
 				adm.EXPECT().AddTopic(gomock.Any()).Return(td1, nil)
 			},
@@ -373,6 +502,89 @@ func TestController(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:        "CheckpointLogStreamReplica",
+			golden:      "varlogctl/checkpointlogstreamreplica.0.golden.json",
+			executeFunc: logstream.Checkpoint(tpid1, lsid1, snid1, "/backup/checkpoint.tar.gz", true),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().CheckpointLogStreamReplica(gomock.Any(), snid1, tpid1, lsid1, "/backup/checkpoint.tar.gz", true).Return(
+					&snpb.LogStreamReplicaCheckpoint{
+						ClusterID:     types.ClusterID(1),
+						StorageNodeID: snid1,
+						TopicID:       tpid1,
+						LogStreamID:   lsid1,
+						CommitContext: &varlogpb.CommitContext{
+							Version:            types.Version(2),
+							HighWatermark:      types.GLSN(20),
+							CommittedGLSNBegin: types.GLSN(6),
+							CommittedGLSNEnd:   types.GLSN(11),
+							CommittedLLSNBegin: types.LLSN(6),
+						},
+						LocalLowWatermark: varlogpb.LogSequenceNumber{
+							LLSN: types.LLSN(1),
+							GLSN: types.GLSN(1),
+						},
+						LocalHighWatermark: varlogpb.LogSequenceNumber{
+							LLSN: types.LLSN(10),
+							GLSN: types.GLSN(10),
+						},
+						CreateTime: time.Date(2022, time.November, 2, 9, 0, 0, 0, time.UTC),
+						Path:       "/backup/checkpoint.tar.gz",
+					}, nil,
+				)
+			},
+		},
+		{
+			name:        "GetOperation",
+			golden:      "varlogctl/getoperation.0.golden.json",
+			executeFunc: operation.Describe(op1.OperationID),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().GetOperation(gomock.Any(), op1.OperationID).Return(op1, nil)
+			},
+		},
+		{
+			name:        "ListOperations",
+			golden:      "varlogctl/listoperations.0.golden.json",
+			executeFunc: operation.Describe(),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().ListOperations(gomock.Any()).Return([]vmspb.Operation{*op1}, nil)
+			},
+		},
+		{
+			name:        "ListEvents",
+			golden:      "varlogctl/events.0.golden.json",
+			executeFunc: event.Describe(0),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().WatchEvents(gomock.Any(), uint64(0), false, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ uint64, _ bool, onEvent func(*vmspb.WatchEventsResponse) error, _ ...varlog.AdminCallOption) error {
+						_ = onEvent(&vmspb.WatchEventsResponse{Event: ev1})
+						return onEvent(&vmspb.WatchEventsResponse{Event: ev2})
+					},
+				)
+			},
+		},
+		{
+			name:        "FollowEvents",
+			golden:      "varlogctl/events.1.golden.json",
+			executeFunc: event.Follow(ev1.EventID, io.Discard),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().WatchEvents(gomock.Any(), ev1.EventID, true, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ uint64, _ bool, onEvent func(*vmspb.WatchEventsResponse) error, _ ...varlog.AdminCallOption) error {
+						return onEvent(&vmspb.WatchEventsResponse{Event: ev2})
+					},
+				)
+			},
+		},
+		{
+			name:        "CancelOperation",
+			golden:      "varlogctl/canceloperation.0.golden.json",
+			executeFunc: operation.Cancel(op1.OperationID),
+			initMock: func(adm *varlog.MockAdmin) {
+				canceled := *op1
+				canceled.State = vmspb.OperationStateCanceled
+				adm.EXPECT().CancelOperation(gomock.Any(), op1.OperationID).Return(&canceled, nil)
+			},
+		},
 		/*
 			{
 				name: "Trim",
@@ -414,6 +626,49 @@ func TestController(t *testing.T) {
 				adm.EXPECT().DeleteMetadataRepositoryNode(gomock.Any(), types.NewNodeIDFromURL(rafturl1)).Return(nil)
 			},
 		},
+		{
+			name:        "TransferMetadataRepositoryLeadership",
+			golden:      "varlogctl/transfermetadatarepositoryleadership.0.golden.json",
+			executeFunc: metarepos.TransferLeadership(rafturl1),
+			initMock: func(adm *varlog.MockAdmin) {
+				nid := types.NewNodeIDFromURL(rafturl1)
+				gomock.InOrder(
+					adm.EXPECT().TransferMetadataRepositoryLeadership(gomock.Any(), nid).Return(nil),
+					adm.EXPECT().GetMetadataRepositoryNode(gomock.Any(), nid).Return(mrnode1, nil),
+				)
+			},
+		},
+		{
+			name:        "MetadataRepositoryHealth",
+			golden:      "varlogctl/metadatarepositoryhealth.0.golden.json",
+			executeFunc: metarepos.Health(0),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().ListMetadataRepositoryNodes(gomock.Any()).Return(
+					[]varlogpb.MetadataRepositoryNode{*mrnode1}, nil,
+				)
+			},
+		},
+		{
+			name:        "ApplyPlan",
+			golden:      "varlogctl/apply.0.golden.json",
+			executeFunc: cluster.Apply(spec, false),
+			initMock:    initApplyMock,
+		},
+		{
+			name:        "Apply",
+			golden:      "varlogctl/apply.1.golden.json",
+			executeFunc: cluster.Apply(spec, true),
+			initMock: func(adm *varlog.MockAdmin) {
+				initApplyMock(adm)
+				gomock.InOrder(
+					adm.EXPECT().AddMetadataRepositoryNode(gomock.Any(), rafturl1, rpcaddr1).Return(mrnode1, nil),
+					adm.EXPECT().AddStorageNode(gomock.Any(), snid2, addr2).Return(snm2, nil),
+					adm.EXPECT().AddLogStream(gomock.Any(), tpid1, nil).Return(lsd1, nil),
+					adm.EXPECT().AddTopic(gomock.Any()).Return(&varlogpb.TopicDescriptor{TopicID: tpid1 + 1}, nil),
+					adm.EXPECT().AddLogStream(gomock.Any(), tpid1+1, nil).Return(lsd1, nil),
+				)
+			},
+		},
 	}
 
 	for _, tc := range tcs {
@@ -459,6 +714,169 @@ func TestController(t *testing.T) {
 	}
 }
 
+func TestClusterApply(t *testing.T) {
+	spec, err := cluster.ReadSpec(testdata.Path("varlogctl/cluster.yaml"))
+	require.NoError(t, err)
+
+	// newAdmin returns a mock admin that behaves like a cluster having the
+	// storage node snid1 and the topic tpid1 of two log streams. The cluster
+	// issues the identifier tpid to the next topic.
+	newAdmin := func(t *testing.T, tpid types.TopicID) *varlog.MockAdmin {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		var (
+			mrns []varlogpb.MetadataRepositoryNode
+			snms = []vmspb.StorageNodeMetadata{*snm1}
+			tds  = []varlogpb.TopicDescriptor{{
+				TopicID:    tpid1,
+				Status:     varlogpb.TopicStatusRunning,
+				LogStreams: []types.LogStreamID{lsid1, lsid2},
+			}}
+		)
+		adm := varlog.NewMockAdmin(ctrl)
+		adm.EXPECT().ListMetadataRepositoryNodes(gomock.Any()).DoAndReturn(
+			func(context.Context, ...varlog.AdminCallOption) ([]varlogpb.MetadataRepositoryNode, error) {
+				return mrns, nil
+			},
+		).AnyTimes()
+		adm.EXPECT().ListStorageNodes(gomock.Any()).DoAndReturn(
+			func(context.Context, ...varlog.AdminCallOption) ([]vmspb.StorageNodeMetadata, error) {
+				return snms, nil
+			},
+		).AnyTimes()
+		adm.EXPECT().ListTopics(gomock.Any()).DoAndReturn(
+			func(context.Context, ...varlog.AdminCallOption) ([]varlogpb.TopicDescriptor, error) {
+				return tds, nil
+			},
+		).AnyTimes()
+		adm.EXPECT().AddMetadataRepositoryNode(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, raftURL, rpcAddr string, _ ...varlog.AdminCallOption) (*varlogpb.MetadataRepositoryNode, error) {
+				mrns = append(mrns, varlogpb.MetadataRepositoryNode{
+					NodeID:  types.NewNodeIDFromURL(raftURL),
+					RaftURL: raftURL,
+					RPCAddr: rpcAddr,
+				})
+				return &mrns[len(mrns)-1], nil
+			},
+		).AnyTimes()
+		adm.EXPECT().AddStorageNode(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, snid types.StorageNodeID, addr string, _ ...varlog.AdminCallOption) (*vmspb.StorageNodeMetadata, error) {
+				snm := vmspb.StorageNodeMetadata{}
+				snm.StorageNode = varlogpb.StorageNode{StorageNodeID: snid, Address: addr}
+				snms = append(snms, snm)
+				return &snm, nil
+			},
+		).AnyTimes()
+		adm.EXPECT().AddTopic(gomock.Any()).DoAndReturn(
+			func(context.Context, ...varlog.AdminCallOption) (*varlogpb.TopicDescriptor, error) {
+				tds = append(tds, varlogpb.TopicDescriptor{TopicID: tpid, Status: varlogpb.TopicStatusRunning})
+				tpid++
+				return &tds[len(tds)-1], nil
+			},
+		).AnyTimes()
+		adm.EXPECT().UnregisterTopic(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, tpid types.TopicID, _ ...varlog.AdminCallOption) error {
+				for i := range tds {
+					if tds[i].TopicID == tpid {
+						tds = append(tds[:i], tds[i+1:]...)
+						return nil
+					}
+				}
+				return verrors.ErrNotExist
+			},
+		).AnyTimes()
+		adm.EXPECT().AddLogStream(gomock.Any(), gomock.Any(), gomock.Nil()).DoAndReturn(
+			func(_ context.Context, tpid types.TopicID, _ []*varlogpb.ReplicaDescriptor, _ ...varlog.AdminCallOption) (*varlogpb.LogStreamDescriptor, error) {
+				for i := range tds {
+					if tds[i].TopicID == tpid {
+						lsid := lsid2 + types.LogStreamID(len(tds[i].LogStreams))
+						tds[i].LogStreams = append(tds[i].LogStreams, lsid)
+						return &varlogpb.LogStreamDescriptor{TopicID: tpid, LogStreamID: lsid}, nil
+					}
+				}
+				return nil, verrors.ErrNotExist
+			},
+		).AnyTimes()
+		return adm
+	}
+
+	t.Run("Twice", func(t *testing.T) {
+		adm := newAdmin(t, tpid1+1)
+
+		res, err := cluster.Apply(spec, true)(context.Background(), adm)
+		require.NoError(t, err)
+		plan := res.(*cluster.Plan)
+		require.True(t, plan.Applied)
+		require.Len(t, plan.Actions, 5)
+
+		// Applying the same spec again does nothing.
+		res, err = cluster.Apply(spec, true)(context.Background(), adm)
+		require.NoError(t, err)
+		plan = res.(*cluster.Plan)
+		require.True(t, plan.Applied)
+		require.Empty(t, plan.Actions)
+		require.Empty(t, plan.Warnings)
+
+		tds, err := adm.ListTopics(context.Background())
+		require.NoError(t, err)
+		require.Len(t, tds, 2)
+		require.Len(t, tds[0].LogStreams, 3)
+		require.Len(t, tds[1].LogStreams, 1)
+	})
+
+	t.Run("UnexpectedTopicID", func(t *testing.T) {
+		// The cluster issues a topic ID different from the spec, for
+		// instance, after a topic is unregistered.
+		adm := newAdmin(t, tpid1+2)
+
+		_, err := cluster.Apply(spec, true)(context.Background(), adm)
+		require.Error(t, err)
+
+		// The unexpected topic is unregistered, and no log streams are
+		// added to it.
+		tds, err := adm.ListTopics(context.Background())
+		require.NoError(t, err)
+		require.Len(t, tds, 1)
+		require.Equal(t, tpid1, tds[0].TopicID)
+	})
+}
+
+func TestClusterSpec(t *testing.T) {
+	tcs := []struct {
+		name string
+		spec string
+	}{
+		{
+			name: "UnknownField",
+			spec: "topics:\n  - topicId: 1\n    replicationFactor: 3\n",
+		},
+		{
+			name: "DuplicatedTopic",
+			spec: "topics:\n  - topicId: 1\n  - topicId: 1\n",
+		},
+		{
+			name: "NegativeLogStreams",
+			spec: "topics:\n  - topicId: 1\n    logStreams: -1\n",
+		},
+		{
+			name: "NoStorageNodeAddress",
+			spec: "storageNodes:\n  - storageNodeId: 1\n",
+		},
+		{
+			name: "InvalidRaftURL",
+			spec: "metadataRepositoryNodes:\n  - raftUrl: foo\n    rpcAddress: 127.0.0.1:10000\n",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := cluster.ParseSpec([]byte(tc.spec))
+			require.Error(t, err)
+		})
+	}
+}
+
 /*
 func testController(t *testing.T, admin varlog.Admin, executeFunc varlogctl.ExecuteFunc, resultCheck func(result result.Result)) {
 	vc, err := varlogctl.New(
@@ -623,7 +1041,7 @@ func TestTopic(t *testing.T) {
 	// Add
 	td.LogStreams = nil
 	admin.EXPECT().AddTopic(gomock.Any()).Return(td, nil)
-	testController(t, admin, topic.Add(), func(res result.Result) {
+	testController(t, admin, topic.Add(false), func(res result.Result) {
 		require.NoError(t, res.Err())
 		require.Equal(t, 1, res.NumberOfDataItem())
 		item, ok := res.GetDataItem(0)
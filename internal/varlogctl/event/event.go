@@ -0,0 +1,55 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/kakao/varlog/internal/varlogctl"
+	"github.com/kakao/varlog/pkg/varlog"
+	"github.com/kakao/varlog/proto/vmspb"
+)
+
+// Cursor is the position in the event stream to resume watching.
+type Cursor struct {
+	AfterEventID uint64 `json:"afterEventId"`
+}
+
+// Describe returns a function to list events kept by the admin server whose
+// identifiers are greater than the argument after.
+func Describe(after uint64) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		rsps := []vmspb.WatchEventsResponse{}
+		err := adm.WatchEvents(ctx, after, false, func(rsp *vmspb.WatchEventsResponse) error {
+			rsps = append(rsps, *rsp)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return rsps, nil
+	}
+}
+
+// Follow returns a function to watch events whose identifiers are greater
+// than the argument after. It writes each event to the argument w as a line
+// of JSON until the context is done, and then returns the cursor to resume
+// watching.
+func Follow(after uint64, w io.Writer) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		enc := json.NewEncoder(w)
+		err := adm.WatchEvents(ctx, after, true, func(rsp *vmspb.WatchEventsResponse) error {
+			if err := enc.Encode(rsp); err != nil {
+				return err
+			}
+			after = rsp.Event.EventID
+			return nil
+		})
+		// Watching stops by canceling the context, for instance,
+		// interrupting the command, which is not an error.
+		if err != nil && ctx.Err() == nil {
+			return nil, err
+		}
+		return Cursor{AfterEventID: after}, nil
+	}
+}
@@ -40,3 +40,9 @@ func Sync(tpid types.TopicID, lsid types.LogStreamID, src, dst types.StorageNode
 		return adm.Sync(ctx, tpid, lsid, src, dst)
 	}
 }
+
+func Checkpoint(tpid types.TopicID, lsid types.LogStreamID, snid types.StorageNodeID, path string, archive bool) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		return adm.CheckpointLogStreamReplica(ctx, snid, tpid, lsid, path, archive)
+	}
+}
@@ -2,6 +2,7 @@ package metarepos
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/golang/protobuf/ptypes/empty"
 
@@ -32,3 +33,37 @@ func Remove(raftURL string) varlogctl.ExecuteFunc {
 		return &empty.Empty{}, adm.DeleteMetadataRepositoryNode(ctx, nid)
 	}
 }
+
+// TransferLeadership transfers the leadership of the metadata repository to
+// the node specified by the argument raftURL. It returns the node after the
+// transfer.
+func TransferLeadership(raftURL string) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		nid := types.NewNodeIDFromURL(raftURL)
+		if err := adm.TransferMetadataRepositoryLeadership(ctx, nid); err != nil {
+			return nil, err
+		}
+		return adm.GetMetadataRepositoryNode(ctx, nid)
+	}
+}
+
+// Health returns the replication progress of all metadata repository nodes.
+// It fails if any of them is inactive or lags behind the leader by more than
+// the argument maxLag entries.
+func Health(maxLag uint64) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		nodes, err := adm.ListMetadataRepositoryNodes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			if !node.Active {
+				return nodes, fmt.Errorf("metadata repository %v: inactive", node.NodeID)
+			}
+			if node.Lag > maxLag {
+				return nodes, fmt.Errorf("metadata repository %v: lag %d > %d", node.NodeID, node.Lag, maxLag)
+			}
+		}
+		return nodes, nil
+	}
+}
@@ -0,0 +1,27 @@
+package operation
+
+import (
+	"context"
+
+	"github.com/kakao/varlog/internal/varlogctl"
+	"github.com/kakao/varlog/pkg/varlog"
+)
+
+// Describe returns a function to list long-running operations or to get the
+// operation identified with opid.
+func Describe(opid ...uint64) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		if len(opid) > 0 {
+			return adm.GetOperation(ctx, opid[0])
+		}
+		return adm.ListOperations(ctx)
+	}
+}
+
+// Cancel returns a function to cancel the long-running operation identified
+// with opid.
+func Cancel(opid uint64) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		return adm.CancelOperation(ctx, opid)
+	}
+}
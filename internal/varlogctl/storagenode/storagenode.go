@@ -53,4 +53,29 @@ func Remove(addr string, snid types.StorageNodeID) varlogctl.ExecuteFunc {
 	}
 }
 
+func Drain(snid types.StorageNodeID) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		snm, err := adm.DrainStorageNode(ctx, snid)
+		if err != nil {
+			return nil, err
+		}
+		ensureEmptyFields(snm)
+		return snm, nil
+	}
+}
+
+func SetSyncBandwidth(snid types.StorageNodeID, bytesPerSecond int64) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		return adm.SetStorageNodeSyncBandwidth(ctx, snid, bytesPerSecond)
+	}
+}
+
+// SetAppendQuota changes the quota of appends to the topic tpid, or the log
+// stream lsid if it is not zero, in the storage node snid.
+func SetAppendQuota(snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID, quota snpb.AppendQuota) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		return adm.SetStorageNodeAppendQuota(ctx, snid, tpid, lsid, quota)
+	}
+}
+
 // TODO: Unregister log stream replica
@@ -10,9 +10,13 @@ import (
 	"github.com/kakao/varlog/pkg/varlog"
 )
 
-// Add returns a function to add a new topic.
-func Add() varlogctl.ExecuteFunc {
+// Add returns a function to add a new topic. The topic is compacted if the
+// argument compacted is true.
+func Add(compacted bool) varlogctl.ExecuteFunc {
 	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		if compacted {
+			return adm.AddCompactedTopic(ctx)
+		}
 		return adm.AddTopic(ctx)
 	}
 }
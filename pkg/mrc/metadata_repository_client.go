@@ -4,6 +4,7 @@ package mrc
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
@@ -21,14 +22,35 @@ import (
 type MetadataRepositoryClient interface {
 	RegisterStorageNode(context.Context, *varlogpb.StorageNodeDescriptor) error
 	UnregisterStorageNode(context.Context, types.StorageNodeID) error
-	RegisterTopic(context.Context, types.TopicID) error
+	RegisterTopic(context.Context, *varlogpb.TopicDescriptor) error
 	UnregisterTopic(context.Context, types.TopicID) error
 	RegisterLogStream(context.Context, *varlogpb.LogStreamDescriptor) error
 	UnregisterLogStream(context.Context, types.LogStreamID) error
 	UpdateLogStream(context.Context, *varlogpb.LogStreamDescriptor) error
-	GetMetadata(context.Context) (*varlogpb.MetadataDescriptor, error)
+	// GetMetadata returns the metadata of the cluster. By default, it returns
+	// the metadata of the metadata repository node connected, which can be
+	// stale if the node lags behind. The argument opts can make it wait for
+	// up-to-date metadata, for instance, WithLinearizableRead and
+	// WithMinAppliedIndex.
+	GetMetadata(ctx context.Context, opts ...GetMetadataOption) (*varlogpb.MetadataDescriptor, error)
 	Seal(context.Context, types.LogStreamID) (types.GLSN, error)
 	Unseal(context.Context, types.LogStreamID) error
+	// AcquireAdminLease acquires or renews the lease for the leader of admin
+	// servers on behalf of the argument holder. It returns the current
+	// lease, which may be held by another admin server.
+	AcquireAdminLease(ctx context.Context, holder string, duration time.Duration) (*mrpb.AdminLease, error)
+	// WatchMetadata calls the argument onMetadata with the metadata whenever
+	// its applied index becomes greater than the argument appliedIndex. It
+	// blocks until the argument ctx is done, onMetadata returns an error, or
+	// the stream is broken. It returns an error whose code is Unimplemented
+	// if the metadata repository does not support watching metadata.
+	WatchMetadata(ctx context.Context, appliedIndex uint64, onMetadata func(*varlogpb.MetadataDescriptor) error) error
+	// LookupGLSN returns the log stream to which the log entry of the given
+	// GLSN was committed, and its LLSN. It returns an error of
+	// verrors.ErrNotExist if the GLSN has not been committed yet, and
+	// verrors.ErrTrimmed if the GLSN is too old to be looked up since the
+	// metadata repository keeps a bounded index of old commits.
+	LookupGLSN(ctx context.Context, topicID types.TopicID, glsn types.GLSN) (*mrpb.LookupGLSNResponse, error)
 	Close() error
 }
 
@@ -96,9 +118,10 @@ func (c *metadataRepositoryClient) UnregisterStorageNode(ctx context.Context, sn
 	return verrors.FromStatusError(errors.WithStack(err))
 }
 
-func (c *metadataRepositoryClient) RegisterTopic(ctx context.Context, topicID types.TopicID) error {
+func (c *metadataRepositoryClient) RegisterTopic(ctx context.Context, td *varlogpb.TopicDescriptor) error {
 	req := &mrpb.TopicRequest{
-		TopicID: topicID,
+		TopicID:   td.TopicID,
+		Compacted: td.Compacted,
 	}
 
 	_, err := c.client.RegisterTopic(ctx, req)
@@ -154,8 +177,32 @@ func (c *metadataRepositoryClient) UpdateLogStream(ctx context.Context, ls *varl
 	return verrors.FromStatusError(errors.WithStack(err))
 }
 
-func (c *metadataRepositoryClient) GetMetadata(ctx context.Context) (*varlogpb.MetadataDescriptor, error) {
-	rsp, err := c.client.GetMetadata(ctx, &mrpb.GetMetadataRequest{})
+// GetMetadataOption configures a request of GetMetadata.
+type GetMetadataOption func(*mrpb.GetMetadataRequest)
+
+// WithLinearizableRead makes GetMetadata return metadata reflecting all
+// changes completed before the call, regardless of which metadata repository
+// node serves it.
+func WithLinearizableRead() GetMetadataOption {
+	return func(req *mrpb.GetMetadataRequest) {
+		req.Linearizable = true
+	}
+}
+
+// WithMinAppliedIndex makes GetMetadata return metadata whose applied index is
+// greater than or equal to the argument appliedIndex.
+func WithMinAppliedIndex(appliedIndex uint64) GetMetadataOption {
+	return func(req *mrpb.GetMetadataRequest) {
+		req.AppliedIndex = appliedIndex
+	}
+}
+
+func (c *metadataRepositoryClient) GetMetadata(ctx context.Context, opts ...GetMetadataOption) (*varlogpb.MetadataDescriptor, error) {
+	req := &mrpb.GetMetadataRequest{}
+	for _, opt := range opts {
+		opt(req)
+	}
+	rsp, err := c.client.GetMetadata(ctx, req)
 	if err != nil {
 		return nil, verrors.FromStatusError(errors.WithStack(err))
 	}
@@ -178,3 +225,43 @@ func (c *metadataRepositoryClient) Unseal(ctx context.Context, lsID types.LogStr
 	}
 	return nil
 }
+
+func (c *metadataRepositoryClient) AcquireAdminLease(ctx context.Context, holder string, duration time.Duration) (*mrpb.AdminLease, error) {
+	rsp, err := c.client.AcquireAdminLease(ctx, &mrpb.AcquireAdminLeaseRequest{
+		Holder:   holder,
+		Duration: duration,
+	})
+	if err != nil {
+		return nil, verrors.FromStatusError(errors.WithStack(err))
+	}
+	return &rsp.Lease, nil
+}
+
+func (c *metadataRepositoryClient) WatchMetadata(ctx context.Context, appliedIndex uint64, onMetadata func(*varlogpb.MetadataDescriptor) error) error {
+	stream, err := c.client.WatchMetadata(ctx, &mrpb.WatchMetadataRequest{
+		AppliedIndex: appliedIndex,
+	})
+	if err != nil {
+		return verrors.FromStatusError(err)
+	}
+	for {
+		rsp, err := stream.Recv()
+		if err != nil {
+			return verrors.FromStatusError(err)
+		}
+		if err := onMetadata(rsp.GetMetadata()); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *metadataRepositoryClient) LookupGLSN(ctx context.Context, topicID types.TopicID, glsn types.GLSN) (*mrpb.LookupGLSNResponse, error) {
+	rsp, err := c.client.LookupGLSN(ctx, &mrpb.LookupGLSNRequest{
+		TopicID: topicID,
+		GLSN:    glsn,
+	})
+	if err != nil {
+		return nil, verrors.FromStatusError(errors.WithStack(err))
+	}
+	return rsp, nil
+}
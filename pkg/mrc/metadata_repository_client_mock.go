@@ -7,10 +7,12 @@ package mrc
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 
 	types "github.com/kakao/varlog/pkg/types"
+	mrpb "github.com/kakao/varlog/proto/mrpb"
 	varlogpb "github.com/kakao/varlog/proto/varlogpb"
 )
 
@@ -37,6 +39,21 @@ func (m *MockMetadataRepositoryClient) EXPECT() *MockMetadataRepositoryClientMoc
 	return m.recorder
 }
 
+// AcquireAdminLease mocks base method.
+func (m *MockMetadataRepositoryClient) AcquireAdminLease(arg0 context.Context, arg1 string, arg2 time.Duration) (*mrpb.AdminLease, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireAdminLease", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*mrpb.AdminLease)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireAdminLease indicates an expected call of AcquireAdminLease.
+func (mr *MockMetadataRepositoryClientMockRecorder) AcquireAdminLease(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireAdminLease", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).AcquireAdminLease), arg0, arg1, arg2)
+}
+
 // Close mocks base method.
 func (m *MockMetadataRepositoryClient) Close() error {
 	m.ctrl.T.Helper()
@@ -52,18 +69,38 @@ func (mr *MockMetadataRepositoryClientMockRecorder) Close() *gomock.Call {
 }
 
 // GetMetadata mocks base method.
-func (m *MockMetadataRepositoryClient) GetMetadata(arg0 context.Context) (*varlogpb.MetadataDescriptor, error) {
+func (m *MockMetadataRepositoryClient) GetMetadata(arg0 context.Context, arg1 ...GetMetadataOption) (*varlogpb.MetadataDescriptor, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMetadata", arg0)
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMetadata", varargs...)
 	ret0, _ := ret[0].(*varlogpb.MetadataDescriptor)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMetadata indicates an expected call of GetMetadata.
-func (mr *MockMetadataRepositoryClientMockRecorder) GetMetadata(arg0 interface{}) *gomock.Call {
+func (mr *MockMetadataRepositoryClientMockRecorder) GetMetadata(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).GetMetadata), varargs...)
+}
+
+// LookupGLSN mocks base method.
+func (m *MockMetadataRepositoryClient) LookupGLSN(arg0 context.Context, arg1 types.TopicID, arg2 types.GLSN) (*mrpb.LookupGLSNResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupGLSN", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*mrpb.LookupGLSNResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupGLSN indicates an expected call of LookupGLSN.
+func (mr *MockMetadataRepositoryClientMockRecorder) LookupGLSN(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).GetMetadata), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupGLSN", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).LookupGLSN), arg0, arg1, arg2)
 }
 
 // RegisterLogStream mocks base method.
@@ -95,7 +132,7 @@ func (mr *MockMetadataRepositoryClientMockRecorder) RegisterStorageNode(arg0, ar
 }
 
 // RegisterTopic mocks base method.
-func (m *MockMetadataRepositoryClient) RegisterTopic(arg0 context.Context, arg1 types.TopicID) error {
+func (m *MockMetadataRepositoryClient) RegisterTopic(arg0 context.Context, arg1 *varlogpb.TopicDescriptor) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RegisterTopic", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -192,3 +229,17 @@ func (mr *MockMetadataRepositoryClientMockRecorder) UpdateLogStream(arg0, arg1 i
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLogStream", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).UpdateLogStream), arg0, arg1)
 }
+
+// WatchMetadata mocks base method.
+func (m *MockMetadataRepositoryClient) WatchMetadata(arg0 context.Context, arg1 uint64, arg2 func(*varlogpb.MetadataDescriptor) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchMetadata", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchMetadata indicates an expected call of WatchMetadata.
+func (mr *MockMetadataRepositoryClientMockRecorder) WatchMetadata(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchMetadata", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).WatchMetadata), arg0, arg1, arg2)
+}
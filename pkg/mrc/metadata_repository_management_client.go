@@ -19,6 +19,15 @@ type MetadataRepositoryManagementClient interface {
 	AddPeer(ctx context.Context, clusterID types.ClusterID, nodeID types.NodeID, url string) error
 	RemovePeer(ctx context.Context, clusterID types.ClusterID, nodeID types.NodeID) error
 	GetClusterInfo(ctx context.Context, clusterID types.ClusterID) (*mrpb.GetClusterInfoResponse, error)
+	// TransferLeadership transfers the leadership of the metadata repository
+	// cluster to the argument nodeID. It returns after the node becomes the
+	// leader.
+	TransferLeadership(ctx context.Context, clusterID types.ClusterID, nodeID types.NodeID) error
+	// Backup exports the state machine of the metadata repository.
+	Backup(ctx context.Context, clusterID types.ClusterID) (*mrpb.BackupResponse, error)
+	// Restore replaces the state machine of the empty metadata repository
+	// with the exported one.
+	Restore(ctx context.Context, clusterID types.ClusterID, stateMachine *mrpb.MetadataRepositoryDescriptor) error
 	Close() error
 }
 
@@ -101,3 +110,40 @@ func (c *metadataRepositoryManagementClient) GetClusterInfo(ctx context.Context,
 	rsp, err := c.client.GetClusterInfo(ctx, req)
 	return rsp, errors.Wrap(verrors.FromStatusError(err), "mrmcl")
 }
+
+func (c *metadataRepositoryManagementClient) TransferLeadership(ctx context.Context, clusterID types.ClusterID, nodeID types.NodeID) error {
+	if nodeID == types.InvalidNodeID {
+		return errors.Wrap(verrors.ErrInvalid, "mrmcl")
+	}
+
+	req := &mrpb.TransferLeadershipRequest{
+		ClusterID: clusterID,
+		NodeID:    nodeID,
+	}
+
+	_, err := c.client.TransferLeadership(ctx, req)
+	return errors.Wrap(verrors.FromStatusError(err), "mrmcl")
+}
+
+func (c *metadataRepositoryManagementClient) Backup(ctx context.Context, clusterID types.ClusterID) (*mrpb.BackupResponse, error) {
+	req := &mrpb.BackupRequest{
+		ClusterID: clusterID,
+	}
+
+	rsp, err := c.client.Backup(ctx, req)
+	return rsp, errors.Wrap(verrors.FromStatusError(err), "mrmcl")
+}
+
+func (c *metadataRepositoryManagementClient) Restore(ctx context.Context, clusterID types.ClusterID, stateMachine *mrpb.MetadataRepositoryDescriptor) error {
+	if stateMachine == nil {
+		return errors.Wrap(verrors.ErrInvalid, "mrmcl")
+	}
+
+	req := &mrpb.RestoreRequest{
+		ClusterID:    clusterID,
+		StateMachine: stateMachine,
+	}
+
+	_, err := c.client.Restore(ctx, req)
+	return errors.Wrap(verrors.FromStatusError(err), "mrmcl")
+}
@@ -51,6 +51,21 @@ func (mr *MockMetadataRepositoryManagementClientMockRecorder) AddPeer(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPeer", reflect.TypeOf((*MockMetadataRepositoryManagementClient)(nil).AddPeer), arg0, arg1, arg2, arg3)
 }
 
+// Backup mocks base method.
+func (m *MockMetadataRepositoryManagementClient) Backup(arg0 context.Context, arg1 types.ClusterID) (*mrpb.BackupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backup", arg0, arg1)
+	ret0, _ := ret[0].(*mrpb.BackupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockMetadataRepositoryManagementClientMockRecorder) Backup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockMetadataRepositoryManagementClient)(nil).Backup), arg0, arg1)
+}
+
 // Close mocks base method.
 func (m *MockMetadataRepositoryManagementClient) Close() error {
 	m.ctrl.T.Helper()
@@ -93,3 +108,31 @@ func (mr *MockMetadataRepositoryManagementClientMockRecorder) RemovePeer(arg0, a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePeer", reflect.TypeOf((*MockMetadataRepositoryManagementClient)(nil).RemovePeer), arg0, arg1, arg2)
 }
+
+// Restore mocks base method.
+func (m *MockMetadataRepositoryManagementClient) Restore(arg0 context.Context, arg1 types.ClusterID, arg2 *mrpb.MetadataRepositoryDescriptor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockMetadataRepositoryManagementClientMockRecorder) Restore(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockMetadataRepositoryManagementClient)(nil).Restore), arg0, arg1, arg2)
+}
+
+// TransferLeadership mocks base method.
+func (m *MockMetadataRepositoryManagementClient) TransferLeadership(arg0 context.Context, arg1 types.ClusterID, arg2 types.NodeID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferLeadership", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferLeadership indicates an expected call of TransferLeadership.
+func (mr *MockMetadataRepositoryManagementClientMockRecorder) TransferLeadership(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeadership", reflect.TypeOf((*MockMetadataRepositoryManagementClient)(nil).TransferLeadership), arg0, arg1, arg2)
+}
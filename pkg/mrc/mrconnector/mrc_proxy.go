@@ -6,6 +6,7 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"go.uber.org/multierr"
 
@@ -76,7 +77,7 @@ func (m *mrProxy) UnregisterStorageNode(ctx context.Context, id types.StorageNod
 	return m.cl.UnregisterStorageNode(ctx, id)
 }
 
-func (m *mrProxy) RegisterTopic(ctx context.Context, id types.TopicID) error {
+func (m *mrProxy) RegisterTopic(ctx context.Context, td *varlogpb.TopicDescriptor) error {
 	m.mu.RLock()
 	defer func() {
 		atomic.AddInt64(&m.inflight, -1)
@@ -85,7 +86,7 @@ func (m *mrProxy) RegisterTopic(ctx context.Context, id types.TopicID) error {
 	}()
 	atomic.AddInt64(&m.inflight, 1)
 
-	return m.cl.RegisterTopic(ctx, id)
+	return m.cl.RegisterTopic(ctx, td)
 }
 
 func (m *mrProxy) UnregisterTopic(ctx context.Context, id types.TopicID) error {
@@ -136,7 +137,7 @@ func (m *mrProxy) UpdateLogStream(ctx context.Context, descriptor *varlogpb.LogS
 	return m.cl.UpdateLogStream(ctx, descriptor)
 }
 
-func (m *mrProxy) GetMetadata(ctx context.Context) (*varlogpb.MetadataDescriptor, error) {
+func (m *mrProxy) GetMetadata(ctx context.Context, opts ...mrc.GetMetadataOption) (*varlogpb.MetadataDescriptor, error) {
 	m.mu.RLock()
 	defer func() {
 		atomic.AddInt64(&m.inflight, -1)
@@ -145,7 +146,7 @@ func (m *mrProxy) GetMetadata(ctx context.Context) (*varlogpb.MetadataDescriptor
 	}()
 	atomic.AddInt64(&m.inflight, 1)
 
-	return m.cl.GetMetadata(ctx)
+	return m.cl.GetMetadata(ctx, opts...)
 }
 
 func (m *mrProxy) Seal(ctx context.Context, id types.LogStreamID) (types.GLSN, error) {
@@ -172,6 +173,37 @@ func (m *mrProxy) Unseal(ctx context.Context, id types.LogStreamID) error {
 	return m.cl.Unseal(ctx, id)
 }
 
+func (m *mrProxy) AcquireAdminLease(ctx context.Context, holder string, duration time.Duration) (*mrpb.AdminLease, error) {
+	m.mu.RLock()
+	defer func() {
+		atomic.AddInt64(&m.inflight, -1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	atomic.AddInt64(&m.inflight, 1)
+
+	return m.cl.AcquireAdminLease(ctx, holder, duration)
+}
+
+// WatchMetadata does not count the stream as an inflight RPC since it can
+// last long and Close would wait for it. Instead, closing the proxy closes
+// the connection, and the stream breaks.
+func (m *mrProxy) WatchMetadata(ctx context.Context, appliedIndex uint64, onMetadata func(*varlogpb.MetadataDescriptor) error) error {
+	return m.cl.WatchMetadata(ctx, appliedIndex, onMetadata)
+}
+
+func (m *mrProxy) LookupGLSN(ctx context.Context, topicID types.TopicID, glsn types.GLSN) (*mrpb.LookupGLSNResponse, error) {
+	m.mu.RLock()
+	defer func() {
+		atomic.AddInt64(&m.inflight, -1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	atomic.AddInt64(&m.inflight, 1)
+
+	return m.cl.LookupGLSN(ctx, topicID, glsn)
+}
+
 func (m *mrProxy) AddPeer(ctx context.Context, clusterID types.ClusterID, nodeID types.NodeID, url string) error {
 	m.mu.RLock()
 	defer func() {
@@ -208,6 +240,42 @@ func (m *mrProxy) GetClusterInfo(ctx context.Context, clusterID types.ClusterID)
 	return m.mcl.GetClusterInfo(ctx, clusterID)
 }
 
+func (m *mrProxy) TransferLeadership(ctx context.Context, clusterID types.ClusterID, nodeID types.NodeID) error {
+	m.mu.RLock()
+	defer func() {
+		atomic.AddInt64(&m.inflight, -1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	atomic.AddInt64(&m.inflight, 1)
+
+	return m.mcl.TransferLeadership(ctx, clusterID, nodeID)
+}
+
+func (m *mrProxy) Backup(ctx context.Context, clusterID types.ClusterID) (*mrpb.BackupResponse, error) {
+	m.mu.RLock()
+	defer func() {
+		atomic.AddInt64(&m.inflight, -1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	atomic.AddInt64(&m.inflight, 1)
+
+	return m.mcl.Backup(ctx, clusterID)
+}
+
+func (m *mrProxy) Restore(ctx context.Context, clusterID types.ClusterID, stateMachine *mrpb.MetadataRepositoryDescriptor) error {
+	m.mu.RLock()
+	defer func() {
+		atomic.AddInt64(&m.inflight, -1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	atomic.AddInt64(&m.inflight, 1)
+
+	return m.mcl.Restore(ctx, clusterID, stateMachine)
+}
+
 func (m *mrProxy) String() string {
 	var sb strings.Builder
 	fmt.Fprintf(&sb, "proxy{nodeID:%d inflight:%d}", m.nodeID, atomic.LoadInt64(&m.inflight))
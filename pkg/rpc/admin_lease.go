@@ -0,0 +1,39 @@
+package rpc
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// adminLeaseEpochKey is the key of gRPC metadata having the epoch of the
+// admin lease held by the admin server sending the request.
+const adminLeaseEpochKey = "varlog-admin-lease-epoch"
+
+// WithAdminLeaseEpoch returns a context that sends the epoch of the admin
+// lease with outgoing requests. Metadata repositories and storage nodes
+// reject requests having epochs older than the latest one they have seen,
+// thus, an admin server that has lost its lease cannot change the cluster.
+func WithAdminLeaseEpoch(ctx context.Context, epoch uint64) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, adminLeaseEpochKey, strconv.FormatUint(epoch, 10))
+}
+
+// AdminLeaseEpochFromIncomingContext returns the epoch of the admin lease
+// sent with the incoming request. It returns zero if the request has no
+// epoch, for example, when leader election of admin servers is disabled.
+func AdminLeaseEpochFromIncomingContext(ctx context.Context) uint64 {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0
+	}
+	values := md.Get(adminLeaseEpochKey)
+	if len(values) == 0 {
+		return 0
+	}
+	epoch, err := strconv.ParseUint(values[len(values)-1], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return epoch
+}
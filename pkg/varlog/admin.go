@@ -5,13 +5,14 @@ package varlog
 import (
 	"context"
 	stderrors "errors"
+	"io"
+	"strings"
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/gogo/status"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 
-	"github.com/kakao/varlog/pkg/rpc"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/snpb"
@@ -61,6 +62,30 @@ type Admin interface {
 	// If the storage node still has running log stream replicas, it
 	// returns an error.
 	UnregisterStorageNode(ctx context.Context, snid types.StorageNodeID, opts ...AdminCallOption) error
+	// DrainStorageNode starts draining a storage node identified by the
+	// argument snid. Draining moves all log stream replicas in the storage
+	// node to other storage nodes, and then unregisters the storage node.
+	// It returns the metadata of the storage node, and its field
+	// DrainStatus shows the progress of draining. Users can watch the
+	// progress by calling GetStorageNode.
+	// It is okay to call DrainStorageNode more than one time for the same
+	// storage node. If the previous drain failed, it resumes draining.
+	// It returns the ErrNotExist error if the storage node does not exist.
+	DrainStorageNode(ctx context.Context, snid types.StorageNodeID, opts ...AdminCallOption) (*vmspb.StorageNodeMetadata, error)
+	// SetStorageNodeSyncBandwidth changes the limit of bandwidth for
+	// synchronization in the storage node identified by the argument snid.
+	// The argument bytesPerSecond is the new limit, and zero means
+	// unlimited. The change lasts until the storage node restarts.
+	// It returns the ErrNotExist error if the storage node does not exist.
+	SetStorageNodeSyncBandwidth(ctx context.Context, snid types.StorageNodeID, bytesPerSecond int64, opts ...AdminCallOption) (*vmspb.SetStorageNodeSyncBandwidthResponse, error)
+	// SetStorageNodeAppendQuota changes the quota of appends to the topic
+	// tpid or the log stream lsid in the storage node identified by the
+	// argument snid. If the argument lsid is zero, it changes the quota of
+	// the topic shared by all log stream replicas of the topic in the
+	// storage node. Zero in the quota means unlimited. The change lasts
+	// until the storage node restarts.
+	// It returns the ErrNotExist error if the storage node does not exist.
+	SetStorageNodeAppendQuota(ctx context.Context, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID, quota snpb.AppendQuota, opts ...AdminCallOption) (*vmspb.SetStorageNodeAppendQuotaResponse, error)
 
 	// GetTopic returns the metadata of the topic specified by the argument
 	// tpid.
@@ -78,6 +103,10 @@ type Admin interface {
 	// It returns an error if rejected by the metadata repository due to
 	// redundant topic ID or something else, and users can retry this RPC.
 	AddTopic(ctx context.Context, opts ...AdminCallOption) (*varlogpb.TopicDescriptor, error)
+	// AddCompactedTopic adds a new topic as AddTopic does, but log stream
+	// replicas of the topic keep only the latest log entry for each record
+	// key. It cannot be changed after the topic is added.
+	AddCompactedTopic(ctx context.Context, opts ...AdminCallOption) (*varlogpb.TopicDescriptor, error)
 	// UnregisterTopic removes a topic identified by the argument tpid from
 	// the cluster.
 	// It is okay to delete not existed topic.
@@ -121,6 +150,10 @@ type Admin interface {
 	// added to the log stream. Note that
 	// `proto/varlogpb.(ReplicaDescriptor).StorageNodePath` in the
 	// poppedReplica and pushedReplica should be set.
+	// It issues a long-running operation whose identifier can be obtained
+	// by WithOperationID. If the operation does not finish before the
+	// deadline of the call, it returns an error wrapping
+	// verrors.ErrInprogress while the operation keeps running.
 	UpdateLogStream(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, poppedReplica varlogpb.ReplicaDescriptor, pushedReplica varlogpb.ReplicaDescriptor, opts ...AdminCallOption) (*varlogpb.LogStreamDescriptor, error)
 	// UnregisterLogStream unregisters a log stream from the cluster.
 	UnregisterLogStream(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, opts ...AdminCallOption) error
@@ -130,18 +163,63 @@ type Admin interface {
 	RemoveLogStreamReplica(ctx context.Context, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID, opts ...AdminCallOption) error
 
 	// Seal seals the log stream identified by the argument tpid and lsid.
+	// Like UpdateLogStream, it issues a long-running operation.
 	Seal(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, opts ...AdminCallOption) (*vmspb.SealResponse, error)
 	// Unseal unseals the log stream identified by the argument tpid and
 	// lsid.
+	// Like UpdateLogStream, it issues a long-running operation.
 	Unseal(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, opts ...AdminCallOption) (*varlogpb.LogStreamDescriptor, error)
 	// Sync copies logs of the log stream identified by the argument tpid
 	// and lsid from the source storage node to the destination storage
 	// node.
+	// It returns the current status of the sync. The admin server tracks
+	// the sync as a long-running operation until it completes, and users
+	// can watch its progress by calling GetOperation with the identifier
+	// obtained by WithOperationID.
 	Sync(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, srcid, dstid types.StorageNodeID, opts ...AdminCallOption) (*snpb.SyncStatus, error)
 	// Trim deletes logs whose GLSNs are less than or equal to the argument
 	// lastGLSN.
 	// Note that the return type of this method can be changed soon.
 	Trim(ctx context.Context, tpid types.TopicID, lastGLSN types.GLSN, opts ...AdminCallOption) (map[types.LogStreamID]map[types.StorageNodeID]error, error)
+	// CheckpointLogStreamReplica makes a consistent point-in-time checkpoint
+	// of the log stream replica in the storage node identified by the
+	// argument snid. The checkpoint is made in the argument path of the
+	// storage node, which must be absolute and must not exist. If the
+	// argument archive is true, the checkpoint is a gzipped tar file.
+	// It returns the ErrNotExist error if the storage node or the log stream
+	// replica does not exist.
+	CheckpointLogStreamReplica(ctx context.Context, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID, path string, archive bool, opts ...AdminCallOption) (*snpb.LogStreamReplicaCheckpoint, error)
+
+	// GetOperation returns the long-running operation identified by the
+	// argument opid. Seal, Unseal, Sync and UpdateLogStream issue
+	// long-running operations, and they keep running even if the client
+	// gives up waiting for them.
+	// It returns the ErrNotExist error if the operation does not exist.
+	GetOperation(ctx context.Context, opid uint64, opts ...AdminCallOption) (*vmspb.Operation, error)
+	// ListOperations returns a list of long-running operations.
+	ListOperations(ctx context.Context, opts ...AdminCallOption) ([]vmspb.Operation, error)
+	// CancelOperation cancels the long-running operation identified by the
+	// argument opid. It does not roll back what the operation has done.
+	// Canceling a sync operation only stops the admin server from tracking
+	// it, and the storage nodes keep copying log entries.
+	// Canceling an operation already finished does nothing.
+	// It returns the ErrNotExist error if the operation does not exist.
+	CancelOperation(ctx context.Context, opid uint64, opts ...AdminCallOption) (*vmspb.Operation, error)
+
+	// WatchEvents streams events of the cluster, for instance, sealing log
+	// streams and heartbeat timeouts of storage nodes. It calls the
+	// argument onEvent for each event whose identifier is greater than the
+	// argument afterEventID in order. Identifiers of events increase
+	// monotonically, thus, the identifier of the last event received can
+	// be used to resume watching.
+	// If the argument follow is false, it returns after receiving events
+	// kept by the admin server. Otherwise, it waits for new events until
+	// the argument ctx is done or onEvent returns an error.
+	// Truncated in the first response is true if some events after the
+	// argument afterEventID were discarded by the admin server.
+	// It returns the ErrUnavailable if no admin server can serve it, for
+	// instance, none of them is the leader.
+	WatchEvents(ctx context.Context, afterEventID uint64, follow bool, onEvent func(*vmspb.WatchEventsResponse) error, opts ...AdminCallOption) error
 
 	GetMetadataRepositoryNode(ctx context.Context, nid types.NodeID, opts ...AdminCallOption) (*varlogpb.MetadataRepositoryNode, error)
 	ListMetadataRepositoryNodes(ctx context.Context, opts ...AdminCallOption) ([]varlogpb.MetadataRepositoryNode, error)
@@ -153,6 +231,10 @@ type Admin interface {
 	DeleteMetadataRepositoryNode(ctx context.Context, nid types.NodeID, opts ...AdminCallOption) error
 	// RemoveMRPeer unregisters the metadata repository from the cluster.
 	RemoveMRPeer(ctx context.Context, raftURL string, opts ...AdminCallOption) error
+	// TransferMetadataRepositoryLeadership transfers the leadership of the
+	// metadata repository to the node specified by the argument nid. It
+	// waits until the node becomes the leader.
+	TransferMetadataRepositoryLeadership(ctx context.Context, nid types.NodeID, opts ...AdminCallOption) error
 
 	// Close closes a connection to the admin server.
 	// Once this method is called, the Client can't be used anymore.
@@ -164,27 +246,30 @@ var _ Admin = (*admin)(nil)
 type admin struct {
 	adminConfig
 	address   string
-	rpcConn   *rpc.Conn
+	conns     *adminConns
 	rpcClient vmspb.ClusterManagerClient
 }
 
 // NewAdmin creates Admin that connects to admin server by using the argument addr.
+// The argument addr can have addresses of several admin servers separated by
+// commas. In that case, if an admin server is unavailable or is not the
+// leader, Admin retries the RPC on the other admin servers.
 func NewAdmin(ctx context.Context, addr string, opts ...AdminOption) (Admin, error) {
-	rpcConn, err := rpc.NewConn(ctx, addr)
+	conns, err := newAdminConns(ctx, strings.Split(addr, ","))
 	if err != nil {
 		return nil, err
 	}
 	cli := &admin{
 		adminConfig: newAdminConfig(opts),
 		address:     addr,
-		rpcConn:     rpcConn,
-		rpcClient:   vmspb.NewClusterManagerClient(rpcConn.Conn),
+		conns:       conns,
+		rpcClient:   vmspb.NewClusterManagerClient(conns.conns[0].Conn),
 	}
 	return cli, nil
 }
 
 func (c *admin) Close() error {
-	return c.rpcConn.Close()
+	return c.conns.close()
 }
 
 func (c *admin) GetStorageNode(ctx context.Context, snid types.StorageNodeID, opts ...AdminCallOption) (*vmspb.StorageNodeMetadata, error) {
@@ -264,6 +349,61 @@ func (c *admin) UnregisterStorageNode(ctx context.Context, storageNodeID types.S
 	return err
 }
 
+func (c *admin) DrainStorageNode(ctx context.Context, snid types.StorageNodeID, opts ...AdminCallOption) (*vmspb.StorageNodeMetadata, error) {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	rsp, err := c.rpcClient.DrainStorageNode(ctx, &vmspb.DrainStorageNodeRequest{
+		StorageNodeID: snid,
+	})
+	if err != nil {
+		if st := status.Convert(err); st.Code() == codes.NotFound {
+			err = verrors.ErrNotExist
+		}
+		return nil, errors.WithMessage(err, "admin: drain storage node")
+	}
+	return rsp.GetStorageNode(), nil
+}
+
+func (c *admin) SetStorageNodeSyncBandwidth(ctx context.Context, snid types.StorageNodeID, bytesPerSecond int64, opts ...AdminCallOption) (*vmspb.SetStorageNodeSyncBandwidthResponse, error) {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	rsp, err := c.rpcClient.SetStorageNodeSyncBandwidth(ctx, &vmspb.SetStorageNodeSyncBandwidthRequest{
+		StorageNodeID:  snid,
+		BytesPerSecond: bytesPerSecond,
+	})
+	if err != nil {
+		if st := status.Convert(err); st.Code() == codes.NotFound {
+			err = verrors.ErrNotExist
+		}
+		return nil, errors.WithMessage(err, "admin: set storage node sync bandwidth")
+	}
+	return rsp, nil
+}
+
+func (c *admin) SetStorageNodeAppendQuota(ctx context.Context, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID, quota snpb.AppendQuota, opts ...AdminCallOption) (*vmspb.SetStorageNodeAppendQuotaResponse, error) {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	rsp, err := c.rpcClient.SetStorageNodeAppendQuota(ctx, &vmspb.SetStorageNodeAppendQuotaRequest{
+		StorageNodeID: snid,
+		TopicID:       tpid,
+		LogStreamID:   lsid,
+		Quota:         quota,
+	})
+	if err != nil {
+		if st := status.Convert(err); st.Code() == codes.NotFound {
+			err = verrors.ErrNotExist
+		}
+		return nil, errors.WithMessage(err, "admin: set storage node append quota")
+	}
+	return rsp, nil
+}
+
 func (c *admin) GetTopic(ctx context.Context, tpid types.TopicID, opts ...AdminCallOption) (*varlogpb.TopicDescriptor, error) {
 	cfg := newAdminCallConfig(c.adminCallOptions, opts)
 	ctx, cancel := cfg.withTimeoutContext(ctx)
@@ -298,11 +438,19 @@ func (c *admin) ListTopics(ctx context.Context, opts ...AdminCallOption) ([]varl
 }
 
 func (c *admin) AddTopic(ctx context.Context, opts ...AdminCallOption) (*varlogpb.TopicDescriptor, error) {
+	return c.addTopic(ctx, false, opts)
+}
+
+func (c *admin) AddCompactedTopic(ctx context.Context, opts ...AdminCallOption) (*varlogpb.TopicDescriptor, error) {
+	return c.addTopic(ctx, true, opts)
+}
+
+func (c *admin) addTopic(ctx context.Context, compacted bool, opts []AdminCallOption) (*varlogpb.TopicDescriptor, error) {
 	cfg := newAdminCallConfig(c.adminCallOptions, opts)
 	ctx, cancel := cfg.withTimeoutContext(ctx)
 	defer cancel()
 
-	rsp, err := c.rpcClient.AddTopic(ctx, &vmspb.AddTopicRequest{})
+	rsp, err := c.rpcClient.AddTopic(ctx, &vmspb.AddTopicRequest{Compacted: compacted})
 	if err != nil {
 		return nil, err
 	}
@@ -387,6 +535,10 @@ func (c *admin) UpdateLogStream(ctx context.Context, topicID types.TopicID, logS
 		PushedReplica: pushedReplica,
 	})
 	if err == nil {
+		cfg.setOperationID(rsp.OperationID)
+		if rsp.OperationState == vmspb.OperationStateRunning {
+			return nil, errors.WithMessagef(verrors.ErrInprogress, "admin: update log stream: operation %d", rsp.OperationID)
+		}
 		return rsp.LogStream, nil
 	}
 	// TODO: Use gRPC's code to decide if the error is retriable or not.
@@ -427,7 +579,14 @@ func (c *admin) Seal(ctx context.Context, topicID types.TopicID, logStreamID typ
 		TopicID:     topicID,
 		LogStreamID: logStreamID,
 	})
-	return rsp, err
+	if err != nil {
+		return nil, err
+	}
+	cfg.setOperationID(rsp.OperationID)
+	if rsp.OperationState == vmspb.OperationStateRunning {
+		return nil, errors.WithMessagef(verrors.ErrInprogress, "admin: seal: operation %d", rsp.OperationID)
+	}
+	return rsp, nil
 }
 
 func (c *admin) Unseal(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, opts ...AdminCallOption) (*varlogpb.LogStreamDescriptor, error) {
@@ -439,7 +598,14 @@ func (c *admin) Unseal(ctx context.Context, topicID types.TopicID, logStreamID t
 		TopicID:     topicID,
 		LogStreamID: logStreamID,
 	})
-	return rsp.GetLogStream(), err
+	if err != nil {
+		return nil, err
+	}
+	cfg.setOperationID(rsp.OperationID)
+	if rsp.OperationState == vmspb.OperationStateRunning {
+		return nil, errors.WithMessagef(verrors.ErrInprogress, "admin: unseal: operation %d", rsp.OperationID)
+	}
+	return rsp.GetLogStream(), nil
 }
 
 func (c *admin) Sync(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, srcStorageNodeID, dstStorageNodeID types.StorageNodeID, opts ...AdminCallOption) (*snpb.SyncStatus, error) {
@@ -453,6 +619,7 @@ func (c *admin) Sync(ctx context.Context, topicID types.TopicID, logStreamID typ
 		SrcStorageNodeID: srcStorageNodeID,
 		DstStorageNodeID: dstStorageNodeID,
 	})
+	cfg.setOperationID(rsp.GetOperationID())
 	return rsp.GetStatus(), err
 }
 
@@ -483,6 +650,105 @@ func (c *admin) Trim(ctx context.Context, topicID types.TopicID, lastGLSN types.
 	return ret, nil
 }
 
+func (c *admin) CheckpointLogStreamReplica(ctx context.Context, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID, path string, archive bool, opts ...AdminCallOption) (*snpb.LogStreamReplicaCheckpoint, error) {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	rsp, err := c.rpcClient.CheckpointLogStreamReplica(ctx, &vmspb.CheckpointLogStreamReplicaRequest{
+		StorageNodeID: snid,
+		TopicID:       tpid,
+		LogStreamID:   lsid,
+		Path:          path,
+		Archive:       archive,
+	})
+	if err != nil {
+		if st := status.Convert(err); st.Code() == codes.NotFound {
+			err = verrors.ErrNotExist
+		}
+		return nil, errors.WithMessage(err, "admin: checkpoint log stream replica")
+	}
+	return &rsp.Checkpoint, nil
+}
+
+func (c *admin) GetOperation(ctx context.Context, opid uint64, opts ...AdminCallOption) (*vmspb.Operation, error) {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	rsp, err := c.rpcClient.GetOperation(ctx, &vmspb.GetOperationRequest{
+		OperationID: opid,
+	})
+	if err != nil {
+		if st := status.Convert(err); st.Code() == codes.NotFound {
+			err = verrors.ErrNotExist
+		}
+		return nil, errors.WithMessage(err, "admin: get operation")
+	}
+	return rsp.GetOperation(), nil
+}
+
+func (c *admin) ListOperations(ctx context.Context, opts ...AdminCallOption) ([]vmspb.Operation, error) {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	rsp, err := c.rpcClient.ListOperations(ctx, &vmspb.ListOperationsRequest{})
+	if err != nil {
+		return nil, errors.WithMessage(err, "admin: list operations")
+	}
+	if len(rsp.Operations) > 0 {
+		return rsp.Operations, nil
+	}
+	return []vmspb.Operation{}, nil
+}
+
+func (c *admin) CancelOperation(ctx context.Context, opid uint64, opts ...AdminCallOption) (*vmspb.Operation, error) {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	rsp, err := c.rpcClient.CancelOperation(ctx, &vmspb.CancelOperationRequest{
+		OperationID: opid,
+	})
+	if err != nil {
+		if st := status.Convert(err); st.Code() == codes.NotFound {
+			err = verrors.ErrNotExist
+		}
+		return nil, errors.WithMessage(err, "admin: cancel operation")
+	}
+	return rsp.GetOperation(), nil
+}
+
+func (c *admin) WatchEvents(ctx context.Context, afterEventID uint64, follow bool, onEvent func(*vmspb.WatchEventsResponse) error, opts ...AdminCallOption) error {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	stream, err := c.conns.watchEvents(ctx, &vmspb.WatchEventsRequest{
+		AfterEventID: afterEventID,
+		Follow:       follow,
+	})
+	if err != nil {
+		if status.Code(err) == codes.Unavailable {
+			err = verrors.ErrUnavailable
+		}
+		return errors.WithMessage(err, "admin: watch events")
+	}
+	for {
+		rsp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.WithMessage(err, "admin: watch events")
+		}
+		if err := onEvent(rsp); err != nil {
+			return err
+		}
+	}
+}
+
 func (c *admin) GetMetadataRepositoryNode(ctx context.Context, nid types.NodeID, opts ...AdminCallOption) (*varlogpb.MetadataRepositoryNode, error) {
 	cfg := newAdminCallConfig(c.adminCallOptions, opts)
 	ctx, cancel := cfg.withTimeoutContext(ctx)
@@ -559,3 +825,14 @@ func (c *admin) RemoveMRPeer(ctx context.Context, raftURL string, opts ...AdminC
 	_, err := c.rpcClient.RemoveMRPeer(ctx, &vmspb.RemoveMRPeerRequest{RaftURL: raftURL})
 	return err
 }
+
+func (c *admin) TransferMetadataRepositoryLeadership(ctx context.Context, nid types.NodeID, opts ...AdminCallOption) error {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	_, err := c.rpcClient.TransferMetadataRepositoryLeadership(ctx, &vmspb.TransferMetadataRepositoryLeadershipRequest{
+		NodeID: nid,
+	})
+	return err
+}
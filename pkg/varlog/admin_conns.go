@@ -0,0 +1,113 @@
+package varlog
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+
+	"github.com/gogo/status"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/kakao/varlog/pkg/rpc"
+	"github.com/kakao/varlog/proto/vmspb"
+)
+
+// adminConns is a set of connections to admin servers. It sends an RPC to
+// the admin server that served the last RPC successfully, and fails over to
+// the next admin server if the admin server is unavailable or is not the
+// leader.
+type adminConns struct {
+	conns []*rpc.Conn
+	// current is the index of the connection to the admin server that
+	// served the last RPC.
+	current int32
+}
+
+type adminFailoverKey struct{}
+
+func newAdminConns(ctx context.Context, addrs []string) (*adminConns, error) {
+	ac := &adminConns{}
+	for _, addr := range addrs {
+		addr = strings.TrimSpace(addr)
+		if len(addr) == 0 {
+			continue
+		}
+		conn, err := rpc.NewConn(ctx, addr, grpc.WithUnaryInterceptor(ac.intercept))
+		if err != nil {
+			return nil, multierr.Append(err, ac.close())
+		}
+		ac.conns = append(ac.conns, conn)
+	}
+	if len(ac.conns) == 0 {
+		return nil, errors.New("admin: no address")
+	}
+	return ac, nil
+}
+
+// intercept invokes the RPC through the connection to the current admin
+// server rather than the argument cc. If the admin server returns an
+// Unavailable error, it tries the next admin server.
+func (ac *adminConns) intercept(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if len(ac.conns) == 1 || ctx.Value(adminFailoverKey{}) != nil {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	ctx = context.WithValue(ctx, adminFailoverKey{}, struct{}{})
+	start := int(atomic.LoadInt32(&ac.current))
+	var err error
+	for i := 0; i < len(ac.conns); i++ {
+		idx := (start + i) % len(ac.conns)
+		err = ac.conns[idx].Conn.Invoke(ctx, method, req, reply, opts...)
+		if status.Code(err) != codes.Unavailable {
+			atomic.StoreInt32(&ac.current, int32(idx))
+			return err
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return err
+}
+
+// watchEvents opens the stream of WatchEvents to the current admin server.
+// Since the admin server sends the header as soon as it accepts the stream,
+// it waits for the header to check whether the admin server rejected the
+// stream. If the admin server is unavailable or is not the leader, it tries
+// the next admin server.
+func (ac *adminConns) watchEvents(ctx context.Context, req *vmspb.WatchEventsRequest) (vmspb.ClusterManager_WatchEventsClient, error) {
+	start := int(atomic.LoadInt32(&ac.current))
+	var err error
+	for i := 0; i < len(ac.conns); i++ {
+		idx := (start + i) % len(ac.conns)
+		var stream vmspb.ClusterManager_WatchEventsClient
+		stream, err = vmspb.NewClusterManagerClient(ac.conns[idx].Conn).WatchEvents(ctx, req)
+		if err == nil {
+			var md metadata.MD
+			md, err = stream.Header()
+			if err == nil && md == nil {
+				// The stream finished without the header, and
+				// Recv returns its status.
+				_, err = stream.Recv()
+			}
+			if err == nil {
+				atomic.StoreInt32(&ac.current, int32(idx))
+				return stream, nil
+			}
+		}
+		if status.Code(err) != codes.Unavailable || ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, err
+}
+
+func (ac *adminConns) close() (err error) {
+	for _, conn := range ac.conns {
+		err = multierr.Append(err, conn.Close())
+	}
+	return err
+}
@@ -39,6 +39,26 @@ func (m *MockAdmin) EXPECT() *MockAdminMockRecorder {
 	return m.recorder
 }
 
+// AddCompactedTopic mocks base method.
+func (m *MockAdmin) AddCompactedTopic(arg0 context.Context, arg1 ...AdminCallOption) (*varlogpb.TopicDescriptor, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddCompactedTopic", varargs...)
+	ret0, _ := ret[0].(*varlogpb.TopicDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCompactedTopic indicates an expected call of AddCompactedTopic.
+func (mr *MockAdminMockRecorder) AddCompactedTopic(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCompactedTopic", reflect.TypeOf((*MockAdmin)(nil).AddCompactedTopic), varargs...)
+}
+
 // AddLogStream mocks base method.
 func (m *MockAdmin) AddLogStream(arg0 context.Context, arg1 types.TopicID, arg2 []*varlogpb.ReplicaDescriptor, arg3 ...AdminCallOption) (*varlogpb.LogStreamDescriptor, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +159,46 @@ func (mr *MockAdminMockRecorder) AddTopic(arg0 interface{}, arg1 ...interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTopic", reflect.TypeOf((*MockAdmin)(nil).AddTopic), varargs...)
 }
 
+// CancelOperation mocks base method.
+func (m *MockAdmin) CancelOperation(arg0 context.Context, arg1 uint64, arg2 ...AdminCallOption) (*vmspb.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelOperation", varargs...)
+	ret0, _ := ret[0].(*vmspb.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelOperation indicates an expected call of CancelOperation.
+func (mr *MockAdminMockRecorder) CancelOperation(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOperation", reflect.TypeOf((*MockAdmin)(nil).CancelOperation), varargs...)
+}
+
+// CheckpointLogStreamReplica mocks base method.
+func (m *MockAdmin) CheckpointLogStreamReplica(arg0 context.Context, arg1 types.StorageNodeID, arg2 types.TopicID, arg3 types.LogStreamID, arg4 string, arg5 bool, arg6 ...AdminCallOption) (*snpb.LogStreamReplicaCheckpoint, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3, arg4, arg5}
+	for _, a := range arg6 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckpointLogStreamReplica", varargs...)
+	ret0, _ := ret[0].(*snpb.LogStreamReplicaCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckpointLogStreamReplica indicates an expected call of CheckpointLogStreamReplica.
+func (mr *MockAdminMockRecorder) CheckpointLogStreamReplica(arg0, arg1, arg2, arg3, arg4, arg5 interface{}, arg6 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3, arg4, arg5}, arg6...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckpointLogStreamReplica", reflect.TypeOf((*MockAdmin)(nil).CheckpointLogStreamReplica), varargs...)
+}
+
 // Close mocks base method.
 func (m *MockAdmin) Close() error {
 	m.ctrl.T.Helper()
@@ -192,6 +252,26 @@ func (mr *MockAdminMockRecorder) DescribeTopic(arg0, arg1 interface{}, arg2 ...i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTopic", reflect.TypeOf((*MockAdmin)(nil).DescribeTopic), varargs...)
 }
 
+// DrainStorageNode mocks base method.
+func (m *MockAdmin) DrainStorageNode(arg0 context.Context, arg1 types.StorageNodeID, arg2 ...AdminCallOption) (*vmspb.StorageNodeMetadata, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DrainStorageNode", varargs...)
+	ret0, _ := ret[0].(*vmspb.StorageNodeMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DrainStorageNode indicates an expected call of DrainStorageNode.
+func (mr *MockAdminMockRecorder) DrainStorageNode(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrainStorageNode", reflect.TypeOf((*MockAdmin)(nil).DrainStorageNode), varargs...)
+}
+
 // GetLogStream mocks base method.
 func (m *MockAdmin) GetLogStream(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID, arg3 ...AdminCallOption) (*varlogpb.LogStreamDescriptor, error) {
 	m.ctrl.T.Helper()
@@ -252,6 +332,26 @@ func (mr *MockAdminMockRecorder) GetMetadataRepositoryNode(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadataRepositoryNode", reflect.TypeOf((*MockAdmin)(nil).GetMetadataRepositoryNode), varargs...)
 }
 
+// GetOperation mocks base method.
+func (m *MockAdmin) GetOperation(arg0 context.Context, arg1 uint64, arg2 ...AdminCallOption) (*vmspb.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOperation", varargs...)
+	ret0, _ := ret[0].(*vmspb.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperation indicates an expected call of GetOperation.
+func (mr *MockAdminMockRecorder) GetOperation(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperation", reflect.TypeOf((*MockAdmin)(nil).GetOperation), varargs...)
+}
+
 // GetStorageNode mocks base method.
 func (m *MockAdmin) GetStorageNode(arg0 context.Context, arg1 types.StorageNodeID, arg2 ...AdminCallOption) (*vmspb.StorageNodeMetadata, error) {
 	m.ctrl.T.Helper()
@@ -352,6 +452,26 @@ func (mr *MockAdminMockRecorder) ListMetadataRepositoryNodes(arg0 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMetadataRepositoryNodes", reflect.TypeOf((*MockAdmin)(nil).ListMetadataRepositoryNodes), varargs...)
 }
 
+// ListOperations mocks base method.
+func (m *MockAdmin) ListOperations(arg0 context.Context, arg1 ...AdminCallOption) ([]vmspb.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOperations", varargs...)
+	ret0, _ := ret[0].([]vmspb.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOperations indicates an expected call of ListOperations.
+func (mr *MockAdminMockRecorder) ListOperations(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOperations", reflect.TypeOf((*MockAdmin)(nil).ListOperations), varargs...)
+}
+
 // ListStorageNodes mocks base method.
 func (m *MockAdmin) ListStorageNodes(arg0 context.Context, arg1 ...AdminCallOption) ([]vmspb.StorageNodeMetadata, error) {
 	m.ctrl.T.Helper()
@@ -450,6 +570,46 @@ func (mr *MockAdminMockRecorder) Seal(arg0, arg1, arg2 interface{}, arg3 ...inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockAdmin)(nil).Seal), varargs...)
 }
 
+// SetStorageNodeAppendQuota mocks base method.
+func (m *MockAdmin) SetStorageNodeAppendQuota(arg0 context.Context, arg1 types.StorageNodeID, arg2 types.TopicID, arg3 types.LogStreamID, arg4 snpb.AppendQuota, arg5 ...AdminCallOption) (*vmspb.SetStorageNodeAppendQuotaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3, arg4}
+	for _, a := range arg5 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetStorageNodeAppendQuota", varargs...)
+	ret0, _ := ret[0].(*vmspb.SetStorageNodeAppendQuotaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStorageNodeAppendQuota indicates an expected call of SetStorageNodeAppendQuota.
+func (mr *MockAdminMockRecorder) SetStorageNodeAppendQuota(arg0, arg1, arg2, arg3, arg4 interface{}, arg5 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3, arg4}, arg5...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageNodeAppendQuota", reflect.TypeOf((*MockAdmin)(nil).SetStorageNodeAppendQuota), varargs...)
+}
+
+// SetStorageNodeSyncBandwidth mocks base method.
+func (m *MockAdmin) SetStorageNodeSyncBandwidth(arg0 context.Context, arg1 types.StorageNodeID, arg2 int64, arg3 ...AdminCallOption) (*vmspb.SetStorageNodeSyncBandwidthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetStorageNodeSyncBandwidth", varargs...)
+	ret0, _ := ret[0].(*vmspb.SetStorageNodeSyncBandwidthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStorageNodeSyncBandwidth indicates an expected call of SetStorageNodeSyncBandwidth.
+func (mr *MockAdminMockRecorder) SetStorageNodeSyncBandwidth(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageNodeSyncBandwidth", reflect.TypeOf((*MockAdmin)(nil).SetStorageNodeSyncBandwidth), varargs...)
+}
+
 // Sync mocks base method.
 func (m *MockAdmin) Sync(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID, arg3, arg4 types.StorageNodeID, arg5 ...AdminCallOption) (*snpb.SyncStatus, error) {
 	m.ctrl.T.Helper()
@@ -470,6 +630,25 @@ func (mr *MockAdminMockRecorder) Sync(arg0, arg1, arg2, arg3, arg4 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockAdmin)(nil).Sync), varargs...)
 }
 
+// TransferMetadataRepositoryLeadership mocks base method.
+func (m *MockAdmin) TransferMetadataRepositoryLeadership(arg0 context.Context, arg1 types.NodeID, arg2 ...AdminCallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TransferMetadataRepositoryLeadership", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferMetadataRepositoryLeadership indicates an expected call of TransferMetadataRepositoryLeadership.
+func (mr *MockAdminMockRecorder) TransferMetadataRepositoryLeadership(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferMetadataRepositoryLeadership", reflect.TypeOf((*MockAdmin)(nil).TransferMetadataRepositoryLeadership), varargs...)
+}
+
 // Trim mocks base method.
 func (m *MockAdmin) Trim(arg0 context.Context, arg1 types.TopicID, arg2 types.GLSN, arg3 ...AdminCallOption) (map[types.LogStreamID]map[types.StorageNodeID]error, error) {
 	m.ctrl.T.Helper()
@@ -586,3 +765,22 @@ func (mr *MockAdminMockRecorder) UpdateLogStream(arg0, arg1, arg2, arg3, arg4 in
 	varargs := append([]interface{}{arg0, arg1, arg2, arg3, arg4}, arg5...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLogStream", reflect.TypeOf((*MockAdmin)(nil).UpdateLogStream), varargs...)
 }
+
+// WatchEvents mocks base method.
+func (m *MockAdmin) WatchEvents(arg0 context.Context, arg1 uint64, arg2 bool, arg3 func(*vmspb.WatchEventsResponse) error, arg4 ...AdminCallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchEvents", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchEvents indicates an expected call of WatchEvents.
+func (mr *MockAdminMockRecorder) WatchEvents(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchEvents", reflect.TypeOf((*MockAdmin)(nil).WatchEvents), varargs...)
+}
@@ -0,0 +1,163 @@
+package varlog
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"hash/crc32"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// A record larger than the chunk size is split into a chunk group, which is a
+// series of chunks appended to the same log stream atomically. Each chunk is
+// stored as a log entry whose data has the chunk header followed by a piece of
+// the record:
+//
+//	magic(4) + version(1) + group id(8) + index(4) + count(4) + checksum(4)
+//
+// The checksum is CRC32 of the preceding fields of the header. Log entries
+// that are not chunks are rarely mistaken for chunks since both the magic and
+// the checksum should match.
+//
+// The storage node appends the chunks of a group only after receiving all of
+// them, and they get consecutive LLSNs. Hence, no other log entries are
+// interleaved with chunks of a group in the log stream. Only a few failures,
+// for instance, sealing the log stream while appending the group, can leave
+// a prefix of the group, which is followed by other log entries.
+//
+// Readers reassemble chunks of a group into a logical log entry whose
+// LogEntryMeta is that of the last chunk. That is, the GLSN of the last chunk
+// identifies the logical log entry, and a logical log entry is delivered in
+// order of it. Readers buffer at most one group per log stream, and they skip
+// a group if its first chunks are missing, for instance, trimmed or out of the
+// subscribed range, or if another log entry follows it before its last chunk.
+const (
+	chunkHeaderLength = 25
+	chunkVersion      = 1
+)
+
+var chunkMagic = []byte{0xc5, 'v', 'l', 'c'}
+
+type chunkHeader struct {
+	groupID uint64
+	index   uint32
+	count   uint32
+}
+
+func encodeChunkHeader(hdr chunkHeader, buf []byte) {
+	copy(buf[0:4], chunkMagic)
+	buf[4] = chunkVersion
+	binary.BigEndian.PutUint64(buf[5:13], hdr.groupID)
+	binary.BigEndian.PutUint32(buf[13:17], hdr.index)
+	binary.BigEndian.PutUint32(buf[17:21], hdr.count)
+	binary.BigEndian.PutUint32(buf[21:25], crc32.ChecksumIEEE(buf[:21]))
+}
+
+// decodeChunkHeader returns the header of the chunk and its payload. It
+// returns false if the data is not a chunk.
+func decodeChunkHeader(data []byte) (hdr chunkHeader, payload []byte, ok bool) {
+	if len(data) < chunkHeaderLength || !bytes.Equal(data[0:4], chunkMagic) || data[4] != chunkVersion {
+		return hdr, nil, false
+	}
+	if binary.BigEndian.Uint32(data[21:25]) != crc32.ChecksumIEEE(data[:21]) {
+		return hdr, nil, false
+	}
+	hdr.groupID = binary.BigEndian.Uint64(data[5:13])
+	hdr.index = binary.BigEndian.Uint32(data[13:17])
+	hdr.count = binary.BigEndian.Uint32(data[17:21])
+	if hdr.count == 0 || hdr.index >= hdr.count {
+		return hdr, nil, false
+	}
+	return hdr, data[chunkHeaderLength:], true
+}
+
+// splitIntoChunks splits the record into chunks whose sizes, including the
+// header, are at most chunkSize.
+func splitIntoChunks(record []byte, chunkSize int) ([][]byte, error) {
+	var id [8]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+	payloadSize := chunkSize - chunkHeaderLength
+	count := (len(record) + payloadSize - 1) / payloadSize
+	chunks := make([][]byte, 0, count)
+	for i := 0; i < count; i++ {
+		piece := record[i*payloadSize:]
+		if len(piece) > payloadSize {
+			piece = piece[:payloadSize]
+		}
+		chunk := make([]byte, chunkHeaderLength+len(piece))
+		encodeChunkHeader(chunkHeader{
+			groupID: binary.BigEndian.Uint64(id[:]),
+			index:   uint32(i),
+			count:   uint32(count),
+		}, chunk)
+		copy(chunk[chunkHeaderLength:], piece)
+		chunks = append(chunks, chunk)
+	}
+	return chunks, nil
+}
+
+type chunkGroup struct {
+	id      uint64
+	next    uint32
+	size    int
+	payload [][]byte
+}
+
+// chunkAssembler reassembles chunks of log entries read in order. It keeps
+// the group being read for each log stream. It is not safe for concurrent
+// use.
+type chunkAssembler struct {
+	groups map[types.LogStreamID]*chunkGroup
+}
+
+func newChunkAssembler() *chunkAssembler {
+	return &chunkAssembler{
+		groups: make(map[types.LogStreamID]*chunkGroup),
+	}
+}
+
+// add adds the log entry read next. It returns the log entry and true if the
+// log entry is not a chunk or is the last chunk of a group. In the latter
+// case, the returned log entry has the whole record. Otherwise, it returns
+// false.
+func (ca *chunkAssembler) add(le varlogpb.LogEntry) (varlogpb.LogEntry, bool) {
+	hdr, payload, ok := decodeChunkHeader(le.Data)
+	cg := ca.groups[le.LogStreamID]
+	if !ok {
+		// The group being read, if any, is incomplete since chunks of a
+		// group are consecutive.
+		delete(ca.groups, le.LogStreamID)
+		return le, true
+	}
+
+	switch {
+	case hdr.index == 0:
+		cg = &chunkGroup{id: hdr.groupID}
+		ca.groups[le.LogStreamID] = cg
+	case cg == nil:
+		// The first chunks are missing.
+		return le, false
+	case cg.id != hdr.groupID || cg.next != hdr.index:
+		delete(ca.groups, le.LogStreamID)
+		return le, false
+	}
+
+	cg.payload = append(cg.payload, payload)
+	cg.size += len(payload)
+	cg.next++
+	if cg.next < hdr.count {
+		return le, false
+	}
+
+	delete(ca.groups, le.LogStreamID)
+	data := make([]byte, 0, cg.size)
+	for _, p := range cg.payload {
+		data = append(data, p...)
+	}
+	le.Data = data
+	return le, true
+}
@@ -0,0 +1,139 @@
+package varlog
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func TestChunk_SplitAndAssemble(t *testing.T) {
+	const chunkSize = chunkHeaderLength + 10
+
+	record := bytes.Repeat([]byte("0123456789abcdef"), 4)
+	chunks, err := splitIntoChunks(record, chunkSize)
+	require.NoError(t, err)
+	require.Len(t, chunks, 7)
+	for i, chunk := range chunks {
+		require.LessOrEqual(t, len(chunk), chunkSize)
+		hdr, _, ok := decodeChunkHeader(chunk)
+		require.True(t, ok)
+		require.EqualValues(t, i, hdr.index)
+		require.EqualValues(t, len(chunks), hdr.count)
+	}
+
+	other, err := splitIntoChunks([]byte("0123456789012345"), chunkSize)
+	require.NoError(t, err)
+	require.Len(t, other, 2)
+
+	// Chunks of a group are consecutive in a log stream, but groups in
+	// different log streams are interleaved in a topic.
+	var les []varlogpb.LogEntry
+	add := func(lsid types.LogStreamID, data []byte) {
+		glsn := types.GLSN(len(les) + 1)
+		les = append(les, varlogpb.LogEntry{
+			LogEntryMeta: varlogpb.LogEntryMeta{LogStreamID: lsid, GLSN: glsn, LLSN: types.LLSN(glsn)},
+			Data:         data,
+		})
+	}
+	add(1, chunks[0])
+	add(2, other[0])
+	add(3, []byte("foo"))
+	for _, chunk := range chunks[1:4] {
+		add(1, chunk)
+	}
+	add(2, other[1])
+	for _, chunk := range chunks[4:] {
+		add(1, chunk)
+	}
+	add(2, []byte("bar"))
+
+	ca := newChunkAssembler()
+	var got []varlogpb.LogEntry
+	for _, le := range les {
+		if le, ok := ca.add(le); ok {
+			got = append(got, le)
+		}
+	}
+	require.Len(t, got, 4)
+	require.Equal(t, []byte("foo"), got[0].Data)
+	require.Equal(t, []byte("0123456789012345"), got[1].Data)
+	require.EqualValues(t, 7, got[1].GLSN)
+	require.Equal(t, record, got[2].Data)
+	require.EqualValues(t, 10, got[2].GLSN)
+	require.EqualValues(t, 1, got[2].LogStreamID)
+	require.Equal(t, []byte("bar"), got[3].Data)
+	require.Empty(t, ca.groups)
+}
+
+func TestChunk_Incomplete(t *testing.T) {
+	const chunkSize = chunkHeaderLength + 10
+
+	chunks, err := splitIntoChunks(bytes.Repeat([]byte("x"), 50), chunkSize)
+	require.NoError(t, err)
+
+	// The first chunks are missing, for instance, trimmed.
+	ca := newChunkAssembler()
+	for _, chunk := range chunks[2:] {
+		_, ok := ca.add(varlogpb.LogEntry{Data: chunk})
+		require.False(t, ok)
+	}
+	require.Empty(t, ca.groups)
+
+	// A chunk in the middle is missing.
+	for i, chunk := range chunks {
+		if i == 2 {
+			continue
+		}
+		_, ok := ca.add(varlogpb.LogEntry{Data: chunk})
+		require.False(t, ok)
+	}
+	require.Empty(t, ca.groups)
+
+	// The last chunks are missing, for instance, since the log stream was
+	// sealed while appending the group. The prefix of the group is dropped
+	// once another log entry follows it.
+	for _, chunk := range chunks[:3] {
+		_, ok := ca.add(varlogpb.LogEntry{Data: chunk})
+		require.False(t, ok)
+	}
+	require.Len(t, ca.groups, 1)
+	le, ok := ca.add(varlogpb.LogEntry{Data: []byte("foo")})
+	require.True(t, ok)
+	require.Equal(t, []byte("foo"), le.Data)
+	require.Empty(t, ca.groups)
+
+	// The group is appended again by retrying after its prefix.
+	for _, chunk := range chunks[:3] {
+		_, ok := ca.add(varlogpb.LogEntry{Data: chunk})
+		require.False(t, ok)
+	}
+	for i, chunk := range chunks {
+		le, ok := ca.add(varlogpb.LogEntry{Data: chunk})
+		require.Equal(t, i == len(chunks)-1, ok)
+		if ok {
+			require.Equal(t, bytes.Repeat([]byte("x"), 50), le.Data)
+		}
+	}
+	require.Empty(t, ca.groups)
+}
+
+func TestChunk_NotChunk(t *testing.T) {
+	chunks, err := splitIntoChunks(bytes.Repeat([]byte("x"), 50), chunkHeaderLength+10)
+	require.NoError(t, err)
+
+	corrupted := append([]byte(nil), chunks[0]...)
+	corrupted[chunkHeaderLength-1]++
+	for _, data := range [][]byte{
+		nil,
+		[]byte("foo"),
+		chunks[0][:chunkHeaderLength-1],
+		corrupted,
+	} {
+		_, _, ok := decodeChunkHeader(data)
+		require.False(t, ok)
+	}
+}
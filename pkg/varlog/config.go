@@ -46,6 +46,7 @@ type adminCallConfig struct {
 		time.Duration
 		set bool
 	}
+	operationID *uint64
 }
 
 func newAdminCallConfig(defaultOpts []AdminCallOption, opts []AdminCallOption) adminCallConfig {
@@ -91,3 +92,22 @@ func WithTimeout(timeout time.Duration) AdminCallOption {
 		cfg.timeout.set = true
 	})
 }
+
+// WithOperationID makes the call store the identifier of the long-running
+// operation issued by it to the argument opid. Seal, Unseal, Sync and
+// UpdateLogStream issue long-running operations. The identifier is stored
+// even if the call returns an error, unless the admin server fails to issue
+// the operation, in which case opid is left unchanged.
+func WithOperationID(opid *uint64) AdminCallOption {
+	return newFuncAdminCallOption(func(cfg *adminCallConfig) {
+		cfg.operationID = opid
+	})
+}
+
+// setOperationID stores the argument opid to the location given by
+// WithOperationID if it is valid.
+func (cfg *adminCallConfig) setOperationID(opid uint64) {
+	if cfg.operationID != nil && opid != 0 {
+		*cfg.operationID = opid
+	}
+}
@@ -4,6 +4,7 @@ package varlog
 
 import (
 	"context"
+	"fmt"
 	"io"
 
 	"go.uber.org/zap"
@@ -20,6 +21,12 @@ import (
 type Log interface {
 	io.Closer
 
+	// Append writes a list of data to a log stream selected in the topic
+	// identified by the topicID argument.
+	// If the append exceeds the quota of the topic or the log stream in the
+	// storage node, the error in the AppendResult wraps verrors.ErrThrottled.
+	// The append is not retried in that case, and the caller should back off
+	// before retrying it.
 	Append(ctx context.Context, topicID types.TopicID, data [][]byte, opts ...AppendOption) AppendResult
 
 	// AppendTo writes a list of data to the log stream identified by the topicID and
@@ -28,6 +35,7 @@ type Log interface {
 	// and an error if partial failures occur.
 	// The length of the metadata list can be less than or equal to the number of data since
 	// metadata for failed operations is not included in the metadata list.
+	// Like Append, a throttled append fails with verrors.ErrThrottled.
 	AppendTo(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, data [][]byte, opts ...AppendOption) AppendResult
 
 	Subscribe(ctx context.Context, topicID types.TopicID, begin types.GLSN, end types.GLSN, onNextFunc OnNext, opts ...SubscribeOption) (SubscribeCloser, error)
@@ -42,6 +50,13 @@ type Log interface {
 	// replica. If none of the replicas' statuses is either appendable or
 	// sealed, it returns an error.
 	PeekLogStream(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID) (first varlogpb.LogSequenceNumber, last varlogpb.LogSequenceNumber, err error)
+
+	// LookupByKey returns log entries having the record key in the topic
+	// in ascending order of GLSN. It queries all log streams of the topic
+	// concurrently and merges their results. It fails if any of the log
+	// streams cannot be queried since the result could be incomplete.
+	// Storage nodes should enable the key index.
+	LookupByKey(ctx context.Context, tpid types.TopicID, key []byte, opts ...LookupOption) ([]varlogpb.LogEntry, error)
 }
 
 type AppendResult struct {
@@ -76,6 +91,9 @@ func Open(ctx context.Context, clusterID types.ClusterID, mrAddrs []string, opts
 		opt.apply(&logOpts)
 	}
 	logOpts.logger = logOpts.logger.Named("varlog").With(zap.Any("cid", clusterID))
+	if logOpts.chunkSize < 0 || (logOpts.chunkSize > 0 && logOpts.chunkSize <= chunkHeaderLength) {
+		return nil, fmt.Errorf("varlog: invalid chunk size %d", logOpts.chunkSize)
+	}
 
 	v := &logImpl{
 		clusterID: clusterID,
@@ -177,6 +195,10 @@ func (v *logImpl) PeekLogStream(ctx context.Context, tpid types.TopicID, lsid ty
 	return v.peekLogStream(ctx, tpid, lsid)
 }
 
+func (v *logImpl) LookupByKey(ctx context.Context, tpid types.TopicID, key []byte, opts ...LookupOption) ([]varlogpb.LogEntry, error) {
+	return v.lookupByKey(ctx, tpid, key, opts...)
+}
+
 func (v *logImpl) Close() (err error) {
 	if v.closed.Load() {
 		return
@@ -89,6 +89,26 @@ func (mr *MockLogMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockLog)(nil).Close))
 }
 
+// LookupByKey mocks base method.
+func (m *MockLog) LookupByKey(arg0 context.Context, arg1 types.TopicID, arg2 []byte, arg3 ...LookupOption) ([]varlogpb.LogEntry, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LookupByKey", varargs...)
+	ret0, _ := ret[0].([]varlogpb.LogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupByKey indicates an expected call of LookupByKey.
+func (mr *MockLogMockRecorder) LookupByKey(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupByKey", reflect.TypeOf((*MockLog)(nil).LookupByKey), varargs...)
+}
+
 // PeekLogStream mocks base method.
 func (m *MockLog) PeekLogStream(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID) (varlogpb.LogSequenceNumber, varlogpb.LogSequenceNumber, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,78 @@
+package varlog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"go.uber.org/multierr"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func (v *logImpl) lookupByKey(ctx context.Context, tpid types.TopicID, key []byte, opts ...LookupOption) ([]varlogpb.LogEntry, error) {
+	lookupOpts := lookupOptions{}
+	for _, opt := range opts {
+		opt.apply(&lookupOpts)
+	}
+
+	replicasMap := v.replicasRetriever.All(tpid)
+	if len(replicasMap) == 0 {
+		return nil, fmt.Errorf("lookup: no log stream in topic %d", tpid)
+	}
+
+	var (
+		wg  sync.WaitGroup
+		mu  sync.Mutex
+		les []varlogpb.LogEntry
+		err error
+	)
+	for lsid, replicas := range replicasMap {
+		wg.Add(1)
+		go func(lsid types.LogStreamID, replicas []varlogpb.LogStreamReplica) {
+			defer wg.Done()
+			res, erri := v.lookupLogStream(ctx, tpid, lsid, replicas, key, lookupOpts.latest)
+			mu.Lock()
+			defer mu.Unlock()
+			if erri != nil {
+				err = multierr.Append(err, erri)
+				return
+			}
+			les = append(les, res...)
+		}(lsid, replicas)
+	}
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(les, func(i, j int) bool {
+		return les[i].GLSN < les[j].GLSN
+	})
+	if lookupOpts.latest && len(les) > 0 {
+		les = les[len(les)-1:]
+	}
+	return les, nil
+}
+
+// lookupLogStream asks the replicas of the log stream in order until one of
+// them answers.
+func (v *logImpl) lookupLogStream(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, replicas []varlogpb.LogStreamReplica, key []byte, latest bool) ([]varlogpb.LogEntry, error) {
+	var err error
+	for _, replica := range replicas {
+		cl, erri := v.logCLManager.GetOrConnect(ctx, replica.StorageNodeID, replica.Address)
+		if erri != nil {
+			err = multierr.Append(err, erri)
+			continue
+		}
+		les, erri := cl.LookupByKey(ctx, tpid, lsid, key, latest)
+		if erri != nil {
+			err = multierr.Append(err, erri)
+			continue
+		}
+		return les, nil
+	}
+	return nil, fmt.Errorf("lookup: log stream %d: %w", lsid, err)
+}
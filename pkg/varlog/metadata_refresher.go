@@ -4,13 +4,16 @@ package varlog
 
 import (
 	"context"
+	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/gogo/status"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/codes"
 
 	"github.com/kakao/varlog/pkg/mrc/mrconnector"
 	"github.com/kakao/varlog/pkg/util/runner"
@@ -30,6 +33,11 @@ type MetadataRefresher interface {
 // metadataRefresher fetches metadata from the metadata repository nodes via mrconnector. It also
 // updates internal fields to provide metadata to its callers.
 // It can provide stale metadata to callers.
+//
+// It watches the metadata repository to receive changes of metadata as soon
+// as possible. Polling with refreshInterval is used only while the watch is
+// not established, for instance, the metadata repository does not support
+// the WatchMetadata RPC.
 type metadataRefresher struct {
 	connector         mrconnector.Connector
 	metadata          atomic.Value // *varlogpb.MetadataDescriptor
@@ -37,9 +45,15 @@ type metadataRefresher struct {
 	replicasRetriever RenewableReplicasRetriever
 	refreshInterval   time.Duration
 	group             singleflight.Group
-	runner            *runner.Runner
-	cancel            context.CancelFunc
-	logger            *zap.Logger
+
+	// mu serializes updates of metadata, allowlist and replicasRetriever.
+	mu sync.Mutex
+	// watching is true while the watch delivers metadata.
+	watching atomic.Bool
+
+	runner *runner.Runner
+	cancel context.CancelFunc
+	logger *zap.Logger
 }
 
 func newMetadataRefresher(
@@ -73,6 +87,11 @@ func newMetadataRefresher(
 		mr.runner.Stop()
 		return nil, err
 	}
+	if err := mr.runner.RunC(mctx, mr.watcher); err != nil {
+		cancel()
+		mr.runner.Stop()
+		return nil, err
+	}
 	mr.cancel = cancel
 	return mr, nil
 }
@@ -92,13 +111,55 @@ func (mr *metadataRefresher) refresher(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			_ = mr.refresh(ctx)
+			if !mr.watching.Load() {
+				_ = mr.refresh(ctx)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// watcher watches the metadata repository until the argument ctx is done. It
+// reconnects if the watch fails, and gives up watching if the metadata
+// repository does not support it.
+func (mr *metadataRefresher) watcher(ctx context.Context) {
+	for {
+		err := mr.watch(ctx)
+		mr.watching.Store(false)
+		if ctx.Err() != nil {
+			return
+		}
+		if status.Code(errors.Cause(err)) == codes.Unimplemented {
+			mr.logger.Info("metadata repository does not support watch, fall back to polling")
+			return
+		}
+		mr.logger.Debug("watch metadata failed", zap.Error(err))
+
+		select {
+		case <-time.After(mr.refreshInterval):
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
+func (mr *metadataRefresher) watch(ctx context.Context) error {
+	client, err := mr.connector.Client(ctx)
+	if err != nil {
+		return err
+	}
+	err = client.WatchMetadata(ctx, mr.getAppliedIndex(), func(clusmeta *varlogpb.MetadataDescriptor) error {
+		mr.watching.Store(true)
+		mr.update(clusmeta)
+		return nil
+	})
+	if ctx.Err() == nil {
+		err = multierr.Append(err, client.Close())
+	}
+	return err
+}
+
 func (mr *metadataRefresher) refresh(ctx context.Context) error {
 	_, err, _ := mr.group.Do("refresh", func() (interface{}, error) {
 		// TODO
@@ -119,23 +180,32 @@ func (mr *metadataRefresher) refresh(ctx context.Context) error {
 			return nil, multierr.Append(err, client.Close())
 		}
 
-		if clusmeta.GetAppliedIndex() == mr.getAppliedIndex() {
-			return nil, nil
-		}
-
-		// update metadata
-		mr.metadata.Store(clusmeta)
-
-		// update allowlist
-		mr.allowlist.Renew(clusmeta)
-
-		// update replicasRetriever
-		mr.replicasRetriever.Renew(clusmeta)
+		mr.update(clusmeta)
 		return nil, nil
 	})
 	return err
 }
 
+// update replaces the metadata if the argument clusmeta is newer than the
+// current one.
+func (mr *metadataRefresher) update(clusmeta *varlogpb.MetadataDescriptor) {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+
+	if clusmeta.GetAppliedIndex() <= mr.getAppliedIndex() {
+		return
+	}
+
+	// update metadata
+	mr.metadata.Store(clusmeta)
+
+	// update allowlist
+	mr.allowlist.Renew(clusmeta)
+
+	// update replicasRetriever
+	mr.replicasRetriever.Renew(clusmeta)
+}
+
 func (mr *metadataRefresher) getAppliedIndex() uint64 {
 	f := mr.metadata.Load()
 	if f == nil {
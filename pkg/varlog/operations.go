@@ -2,6 +2,7 @@ package varlog
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -20,7 +21,87 @@ func (v *logImpl) append(ctx context.Context, tpid types.TopicID, lsid types.Log
 	for _, opt := range opts {
 		opt.apply(&appendOpts)
 	}
+	if len(appendOpts.keys) > 0 && len(appendOpts.keys) != len(data) {
+		result.Err = fmt.Errorf("append: %d keys for %d records", len(appendOpts.keys), len(data))
+		return result
+	}
+
+	if v.opts.chunkSize > 0 {
+		for _, d := range data {
+			if len(d) > v.opts.chunkSize {
+				return v.appendChunked(ctx, tpid, lsid, data, appendOpts)
+			}
+		}
+	}
+	return v.appendBatch(ctx, tpid, lsid, data, appendOpts)
+}
+
+// appendChunked appends data having records larger than the chunk size.
+// Consecutive small records are appended in a batch, and each large record is
+// appended as a chunk group. All of them are appended to the log stream
+// selected at first. It stops at the first failure, and metadata of the
+// appended records are returned with the error.
+func (v *logImpl) appendChunked(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, data [][]byte, appendOpts appendOptions) (result AppendResult) {
+	keys := appendOpts.keys
+	for len(data) > 0 {
+		var res AppendResult
+		n := 1
+		if len(data[0]) > v.opts.chunkSize {
+			if len(keys) > 0 && len(keys[0]) > 0 {
+				result.Err = errors.New("append: record having key larger than chunk size")
+				return result
+			}
+			appendOpts.keys = nil
+			res = v.appendChunkGroup(ctx, tpid, lsid, data[0], appendOpts)
+		} else {
+			for n < len(data) && len(data[n]) <= v.opts.chunkSize {
+				n++
+			}
+			if len(keys) > 0 {
+				appendOpts.keys = keys[:n]
+			}
+			res = v.appendBatch(ctx, tpid, lsid, data[:n], appendOpts)
+		}
+		result.Metadata = append(result.Metadata, res.Metadata...)
+		if res.Err == nil && len(res.Metadata) == 0 {
+			res.Err = errors.New("append: no result")
+		}
+		if res.Err != nil {
+			result.Err = res.Err
+			return result
+		}
+		lsid = res.Metadata[0].LogStreamID
+		appendOpts.selectLogStream = false
+		data = data[n:]
+		if len(keys) > 0 {
+			keys = keys[n:]
+		}
+	}
+	return result
+}
 
+// appendChunkGroup appends the record as a chunk group. The metadata of the
+// last chunk is the result.
+func (v *logImpl) appendChunkGroup(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, record []byte, appendOpts appendOptions) (result AppendResult) {
+	chunks, err := splitIntoChunks(record, v.opts.chunkSize)
+	if err != nil {
+		result.Err = fmt.Errorf("append: %w", err)
+		return result
+	}
+	appendOpts.chunkGroup = true
+	res := v.appendBatch(ctx, tpid, lsid, chunks, appendOpts)
+	if res.Err == nil && len(res.Metadata) != len(chunks) {
+		res.Err = fmt.Errorf("%d results for %d chunks", len(res.Metadata), len(chunks))
+	}
+	if res.Err != nil {
+		result.Err = fmt.Errorf("append: chunk group: %w", res.Err)
+		return result
+	}
+	result.Metadata = res.Metadata[len(res.Metadata)-1:]
+	return result
+}
+
+func (v *logImpl) appendBatch(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, data [][]byte, appendOpts appendOptions) (result AppendResult) {
 	for i := 0; i < appendOpts.retryCount+1; i++ {
 		if appendOpts.selectLogStream {
 			var ok bool
@@ -38,9 +119,14 @@ func (v *logImpl) append(ctx context.Context, tpid types.TopicID, lsid types.Log
 				continue
 			}
 		}
-		res, err := v.appendTo(ctx, tpid, lsid, data)
+		res, err := v.appendTo(ctx, tpid, lsid, data, appendOpts)
 		if err != nil {
 			result.Err = err
+			// Retrying a throttled append immediately only adds load to
+			// the storage node; the caller should back off.
+			if errors.Is(err, verrors.ErrThrottled) {
+				break
+			}
 			continue
 		}
 		result.Err = nil
@@ -60,7 +146,7 @@ func (v *logImpl) append(ctx context.Context, tpid types.TopicID, lsid types.Log
 	return result
 }
 
-func (v *logImpl) appendTo(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, data [][]byte) ([]snpb.AppendResult, error) {
+func (v *logImpl) appendTo(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, data [][]byte, appendOpts appendOptions) ([]snpb.AppendResult, error) {
 	replicas, ok := v.replicasRetriever.Retrieve(tpid, lsid)
 	if !ok {
 		return nil, fmt.Errorf("append: log stream %d of topic %d does not exist", lsid, tpid)
@@ -80,7 +166,12 @@ func (v *logImpl) appendTo(ctx context.Context, tpid types.TopicID, lsid types.L
 		backup[i].Address = replicas[i+1].Address
 	}
 
-	res, err := cl.Append(ctx, tpid, lsid, data, backup...)
+	var res []snpb.AppendResult
+	if appendOpts.chunkGroup {
+		res, err = cl.AppendChunkGroup(ctx, tpid, lsid, data)
+	} else {
+		res, err = cl.AppendWithKeys(ctx, tpid, lsid, data, appendOpts.keys, backup...)
+	}
 	if err != nil {
 		if strings.Contains(err.Error(), "sealed") {
 			err = fmt.Errorf("append: %s: %w", err.Error(), verrors.ErrSealed)
@@ -89,8 +180,12 @@ func (v *logImpl) appendTo(ctx context.Context, tpid types.TopicID, lsid types.L
 		// FIXME: Do not close clients. Let gRPC manages the connection.
 		// _ = cl.Close()
 
-		// add deny list
-		v.allowlist.Deny(tpid, lsid)
+		// A throttled log stream is still healthy, hence it is not added to
+		// the deny list.
+		if !errors.Is(err, verrors.ErrThrottled) {
+			// add deny list
+			v.allowlist.Deny(tpid, lsid)
+		}
 
 		return nil, err
 	}
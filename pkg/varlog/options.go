@@ -67,6 +67,10 @@ type options struct {
 	// grpcOptions
 	grpcDialOptions []grpc.DialOption
 
+	// chunkSize is the maximum size of a log entry appended. A record larger
+	// than it is split into chunks. Zero means chunking is disabled.
+	chunkSize int
+
 	logger *zap.Logger
 }
 
@@ -140,6 +144,22 @@ func WithGRPCDialOptions(grpcDialOptions ...grpc.DialOption) Option {
 	})
 }
 
+// WithChunkSize makes the client split a record larger than chunkSize bytes
+// into chunks whose sizes are at most chunkSize bytes. It should be less than
+// the maximum message size of storage nodes. The chunks of a record are
+// appended to a log stream atomically as a group, that is, they are stored
+// consecutively only after the storage node receives all of them, and
+// Subscribe and SubscribeTo reassemble them into a logical log entry
+// identified by the GLSN of the last chunk. Readers skip a record whose chunks
+// are incomplete, for instance, trimmed partially or not in the subscribed
+// range entirely. Zero, the default, disables chunking, though readers always
+// reassemble chunks.
+func WithChunkSize(chunkSize int) Option {
+	return newOption(func(opts *options) {
+		opts.chunkSize = chunkSize
+	})
+}
+
 const (
 	defaultRetryCount = 3
 )
@@ -155,6 +175,10 @@ type appendOptions struct {
 	retryCount        int
 	selectLogStream   bool
 	allowedLogStreams map[types.LogStreamID]struct{}
+	keys              [][]byte
+	// chunkGroup makes the data appended as a chunk group. It is set only
+	// by the client.
+	chunkGroup bool
 }
 
 type AppendOption interface {
@@ -191,6 +215,17 @@ func WithAllowedLogStreams(logStreams map[types.LogStreamID]struct{}) AppendOpti
 	})
 }
 
+// WithKeys sets record keys of the records appended. The keys should be as
+// many as the records, and an empty key means that the record has no key.
+// Storage nodes index the keys if their key index is enabled; then, LookupByKey
+// can find the records by their keys. A record having a key cannot be split
+// into chunks; hence, appending it fails if it is larger than the chunk size.
+func WithKeys(keys [][]byte) AppendOption {
+	return newAppendOption(func(opts *appendOptions) {
+		opts.keys = keys
+	})
+}
+
 func defaultSubscribeOptions() subscribeOptions {
 	return subscribeOptions{
 		timeout: defaultSubscribeTimeout,
@@ -222,3 +257,31 @@ func WithSubscribeTimeout(timeout time.Duration) SubscribeOption {
 		opts.timeout = timeout
 	})
 }
+
+type lookupOptions struct {
+	latest bool
+}
+
+type LookupOption interface {
+	apply(*lookupOptions)
+}
+
+type lookupOption struct {
+	f func(*lookupOptions)
+}
+
+func (opt *lookupOption) apply(opts *lookupOptions) {
+	opt.f(opts)
+}
+
+func newLookupOption(f func(*lookupOptions)) *lookupOption {
+	return &lookupOption{f: f}
+}
+
+// WithLatest makes LookupByKey return only the latest log entry having the
+// key in the topic.
+func WithLatest() LookupOption {
+	return newLookupOption(func(opts *lookupOptions) {
+		opts.latest = true
+	})
+}
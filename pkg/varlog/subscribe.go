@@ -65,10 +65,19 @@ func (v *logImpl) subscribe(ctx context.Context, topicID types.TopicID, begin, e
 		logger:            tlogger,
 	}
 
+	ca := newChunkAssembler()
 	dis := &dispatcher{
-		onNextFunc: onNext,
-		sleq:       sleq,
-		logger:     v.logger,
+		onNextFunc: func(logEntry varlogpb.LogEntry, err error) {
+			if err == nil {
+				var ok bool
+				if logEntry, ok = ca.add(logEntry); !ok {
+					return
+				}
+			}
+			onNext(logEntry, err)
+		},
+		sleq:   sleq,
+		logger: v.logger,
 	}
 	if err = subscribeRunner.RunC(mctx, tsm.transmit); err != nil {
 		goto errOut
@@ -404,7 +413,16 @@ func (p *transmitter) handleResult(r transmitResult) error {
 			p.sleq.pushBack(r.result)
 		}
 	} else if p.wanted == r.result.GLSN {
-		p.sleq.pushBack(r.result)
+		// NOTE Log entries removed by compaction fill the gaps of GLSNs,
+		// but they are not delivered to the user.
+		if r.result.Compacted {
+			p.sleq.skip()
+		} else {
+			// Readers reassemble chunk groups per log stream.
+			r.result.TopicID = p.topicID
+			r.result.LogStreamID = r.logStreamID
+			p.sleq.pushBack(r.result)
+		}
 		p.wanted++
 		p.timer.Reset(p.timeout)
 	}
@@ -473,6 +491,11 @@ func (q *subscribedLogEntriesQueue) pushBack(result client.SubscribeResult) {
 	}
 }
 
+// skip advances the wanted GLSN without delivering a log entry.
+func (q *subscribedLogEntriesQueue) skip() {
+	q.wanted++
+}
+
 func (q *subscribedLogEntriesQueue) pushable(result client.SubscribeResult) bool {
 	return result.LogEntry.GLSN == q.wanted || result.Error != nil
 }
@@ -582,6 +605,7 @@ func (v *logImpl) subscribeTo(ctx context.Context, topicID types.TopicID, logStr
 		closer:  func() { close(ch) },
 		logCL:   logCL,
 		resultC: resultC,
+		ca:      newChunkAssembler(),
 	}
 }
 
@@ -592,6 +616,7 @@ type logStreamSubscriber struct {
 	closeC  <-chan struct{}
 	logCL   *client.LogClient
 	resultC <-chan client.SubscribeResult
+	ca      *chunkAssembler
 
 	mu      sync.Mutex
 	closer  func()
@@ -599,7 +624,21 @@ type logStreamSubscriber struct {
 	errOnce sync.Once
 }
 
-func (s *logStreamSubscriber) Next() (logEntry varlogpb.LogEntry, err error) {
+// Next returns the next log entry. Chunks of a record are reassembled into a
+// log entry.
+func (s *logStreamSubscriber) Next() (varlogpb.LogEntry, error) {
+	for {
+		logEntry, err := s.next()
+		if err != nil {
+			return logEntry, err
+		}
+		if logEntry, ok := s.ca.add(logEntry); ok {
+			return logEntry, nil
+		}
+	}
+}
+
+func (s *logStreamSubscriber) next() (logEntry varlogpb.LogEntry, err error) {
 	s.mu.Lock()
 	err = s.err
 	s.mu.Unlock()
@@ -141,6 +141,18 @@ func (c *testAdmin) UnregisterStorageNode(ctx context.Context, storageNodeID typ
 	return nil
 }
 
+func (c *testAdmin) DrainStorageNode(context.Context, types.StorageNodeID, ...varlog.AdminCallOption) (*vmspb.StorageNodeMetadata, error) {
+	panic("not implemented")
+}
+
+func (c *testAdmin) SetStorageNodeSyncBandwidth(context.Context, types.StorageNodeID, int64, ...varlog.AdminCallOption) (*vmspb.SetStorageNodeSyncBandwidthResponse, error) {
+	panic("not implemented")
+}
+
+func (c *testAdmin) SetStorageNodeAppendQuota(context.Context, types.StorageNodeID, types.TopicID, types.LogStreamID, snpb.AppendQuota, ...varlog.AdminCallOption) (*vmspb.SetStorageNodeAppendQuotaResponse, error) {
+	panic("not implemented")
+}
+
 func (c *testAdmin) GetTopic(ctx context.Context, tpid types.TopicID, opts ...varlog.AdminCallOption) (*varlogpb.TopicDescriptor, error) {
 	panic("not implemented")
 }
@@ -164,6 +176,14 @@ func (c *testAdmin) Topics(ctx context.Context, opts ...varlog.AdminCallOption)
 }
 
 func (c *testAdmin) AddTopic(ctx context.Context, opts ...varlog.AdminCallOption) (*varlogpb.TopicDescriptor, error) {
+	return c.addTopic(false)
+}
+
+func (c *testAdmin) AddCompactedTopic(ctx context.Context, opts ...varlog.AdminCallOption) (*varlogpb.TopicDescriptor, error) {
+	return c.addTopic(true)
+}
+
+func (c *testAdmin) addTopic(compacted bool) (*varlogpb.TopicDescriptor, error) {
 	if err := c.lock(); err != nil {
 		return nil, err
 	}
@@ -174,6 +194,7 @@ func (c *testAdmin) AddTopic(ctx context.Context, opts ...varlog.AdminCallOption
 		TopicID:    topicID,
 		Status:     varlogpb.TopicStatusRunning,
 		LogStreams: nil,
+		Compacted:  compacted,
 	}
 	c.vt.topics[topicID] = topicDesc
 	c.vt.trimGLSNs[topicID] = types.InvalidGLSN
@@ -465,6 +486,26 @@ func (c *testAdmin) Trim(ctx context.Context, topicID types.TopicID, lastGLSN ty
 	return ret, nil
 }
 
+func (c *testAdmin) CheckpointLogStreamReplica(context.Context, types.StorageNodeID, types.TopicID, types.LogStreamID, string, bool, ...varlog.AdminCallOption) (*snpb.LogStreamReplicaCheckpoint, error) {
+	panic("not implemented")
+}
+
+func (c *testAdmin) GetOperation(context.Context, uint64, ...varlog.AdminCallOption) (*vmspb.Operation, error) {
+	panic("not implemented")
+}
+
+func (c *testAdmin) ListOperations(context.Context, ...varlog.AdminCallOption) ([]vmspb.Operation, error) {
+	panic("not implemented")
+}
+
+func (c *testAdmin) CancelOperation(context.Context, uint64, ...varlog.AdminCallOption) (*vmspb.Operation, error) {
+	panic("not implemented")
+}
+
+func (c *testAdmin) WatchEvents(context.Context, uint64, bool, func(*vmspb.WatchEventsResponse) error, ...varlog.AdminCallOption) error {
+	panic("not implemented")
+}
+
 func (c *testAdmin) GetMetadataRepositoryNode(ctx context.Context, nid types.NodeID, opts ...varlog.AdminCallOption) (*varlogpb.MetadataRepositoryNode, error) {
 	panic("not implemented")
 }
@@ -493,6 +534,10 @@ func (c *testAdmin) RemoveMRPeer(ctx context.Context, raftURL string, opts ...va
 	panic("not implemented")
 }
 
+func (c *testAdmin) TransferMetadataRepositoryLeadership(ctx context.Context, nid types.NodeID, opts ...varlog.AdminCallOption) error {
+	panic("not implemented")
+}
+
 func (c *testAdmin) Close() error {
 	c.vt.cond.L.Lock()
 	defer c.vt.cond.L.Unlock()
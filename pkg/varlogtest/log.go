@@ -269,6 +269,10 @@ func (c *testLog) Trim(ctx context.Context, topicID types.TopicID, until types.G
 	panic("not implemented")
 }
 
+func (c *testLog) LookupByKey(ctx context.Context, tpid types.TopicID, key []byte, opts ...varlog.LookupOption) ([]varlogpb.LogEntry, error) {
+	panic("not implemented")
+}
+
 func (c *testLog) PeekLogStream(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID) (first varlogpb.LogSequenceNumber, last varlogpb.LogSequenceNumber, err error) {
 	if err = c.lock(); err != nil {
 		return first, last, err
@@ -26,6 +26,9 @@ var (
 	ErrCorruptLogStream = errors.New("logstream: corrupt")
 	ErrSealed           = errors.New("sealed")
 	ErrUnordered        = errors.New("logstream: unordered scanner")
+	// ErrThrottled is returned when an append exceeds the quota of the topic
+	// or log stream. Clients should back off before retrying it.
+	ErrThrottled = errors.New("logstream: throttled")
 )
 
 var (
@@ -47,6 +50,9 @@ var (
 	ErrStopped    = errors.New("stopped")
 	ErrNotMember  = errors.New("not member")
 	ErrNotEmpty   = errors.New("not empty")
+	// ErrStaleAdminLease is returned when a request is sent by an admin
+	// server whose lease epoch is older than the latest one.
+	ErrStaleAdminLease = errors.New("stale admin lease")
 
 	ErrInvalidArgument = errors.New("status - invalid argument")
 	ErrAlreadyExists   = errors.New("status - varlogserver: already exists")
@@ -80,7 +86,7 @@ func init() {
 		ErrNoEntry, ErrCorruptStorage,
 
 		// logstream
-		ErrTrimmed, ErrUndecidable, ErrCorruptLogStream, ErrSealed, ErrUnordered,
+		ErrTrimmed, ErrUndecidable, ErrCorruptLogStream, ErrSealed, ErrUnordered, ErrThrottled,
 
 		ErrInvalidArgument, ErrAlreadyExists, ErrNotExist,
 
@@ -88,7 +94,7 @@ func init() {
 		ErrExist,
 
 		// metadata repository
-		ErrNotMember,
+		ErrNotMember, ErrStaleAdminLease,
 	)
 }
 
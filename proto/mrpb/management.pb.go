@@ -278,6 +278,19 @@ type ClusterInfo_Member struct {
 	Peer     string `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
 	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
 	Learner  bool   `protobuf:"varint,3,opt,name=learner,proto3" json:"learner,omitempty"`
+	// match_index is the index of the last RAFT entry replicated to the
+	// member. Replication progress such as match_index, lag and
+	// progress_state is reported only by the leader.
+	MatchIndex uint64 `protobuf:"varint,4,opt,name=match_index,json=matchIndex,proto3" json:"matchIndex"`
+	// lag is the number of RAFT entries that the leader has, but the member
+	// does not.
+	Lag uint64 `protobuf:"varint,5,opt,name=lag,proto3" json:"lag"`
+	// progress_state is the state of replication to the member, that is,
+	// one of "StateProbe", "StateReplicate" and "StateSnapshot".
+	ProgressState string `protobuf:"bytes,6,opt,name=progress_state,json=progressState,proto3" json:"progressState"`
+	// active is true if the node reporting the cluster info is connected to
+	// the member.
+	Active bool `protobuf:"varint,7,opt,name=active,proto3" json:"active"`
 }
 
 func (m *ClusterInfo_Member) Reset()         { *m = ClusterInfo_Member{} }
@@ -334,6 +347,34 @@ func (m *ClusterInfo_Member) GetLearner() bool {
 	return false
 }
 
+func (m *ClusterInfo_Member) GetMatchIndex() uint64 {
+	if m != nil {
+		return m.MatchIndex
+	}
+	return 0
+}
+
+func (m *ClusterInfo_Member) GetLag() uint64 {
+	if m != nil {
+		return m.Lag
+	}
+	return 0
+}
+
+func (m *ClusterInfo_Member) GetProgressState() string {
+	if m != nil {
+		return m.ProgressState
+	}
+	return ""
+}
+
+func (m *ClusterInfo_Member) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
 type GetClusterInfoResponse struct {
 	ClusterInfo *ClusterInfo `protobuf:"bytes,1,opt,name=cluster_info,json=clusterInfo,proto3" json:"cluster_info,omitempty"`
 }
@@ -378,6 +419,221 @@ func (m *GetClusterInfoResponse) GetClusterInfo() *ClusterInfo {
 	return nil
 }
 
+type TransferLeadershipRequest struct {
+	ClusterID github_com_kakao_varlog_pkg_types.ClusterID `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3,casttype=github.com/kakao/varlog/pkg/types.ClusterID" json:"cluster_id,omitempty"`
+	NodeID    github_com_kakao_varlog_pkg_types.NodeID    `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.NodeID" json:"node_id,omitempty"`
+}
+
+func (m *TransferLeadershipRequest) Reset()         { *m = TransferLeadershipRequest{} }
+func (m *TransferLeadershipRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferLeadershipRequest) ProtoMessage()    {}
+func (*TransferLeadershipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8658321b298c6927, []int{5}
+}
+func (m *TransferLeadershipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferLeadershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferLeadershipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferLeadershipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferLeadershipRequest.Merge(m, src)
+}
+func (m *TransferLeadershipRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TransferLeadershipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferLeadershipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferLeadershipRequest proto.InternalMessageInfo
+
+func (m *TransferLeadershipRequest) GetClusterID() github_com_kakao_varlog_pkg_types.ClusterID {
+	if m != nil {
+		return m.ClusterID
+	}
+	return 0
+}
+
+func (m *TransferLeadershipRequest) GetNodeID() github_com_kakao_varlog_pkg_types.NodeID {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+type BackupRequest struct {
+	ClusterID github_com_kakao_varlog_pkg_types.ClusterID `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3,casttype=github.com/kakao/varlog/pkg/types.ClusterID" json:"cluster_id,omitempty"`
+}
+
+func (m *BackupRequest) Reset()         { *m = BackupRequest{} }
+func (m *BackupRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupRequest) ProtoMessage()    {}
+func (*BackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8658321b298c6927, []int{6}
+}
+func (m *BackupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupRequest.Merge(m, src)
+}
+func (m *BackupRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *BackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupRequest proto.InternalMessageInfo
+
+func (m *BackupRequest) GetClusterID() github_com_kakao_varlog_pkg_types.ClusterID {
+	if m != nil {
+		return m.ClusterID
+	}
+	return 0
+}
+
+// BackupResponse is the export of the state machine of the metadata
+// repository. It is also the format of backup files written by mrtool.
+type BackupResponse struct {
+	ClusterID github_com_kakao_varlog_pkg_types.ClusterID `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3,casttype=github.com/kakao/varlog/pkg/types.ClusterID" json:"clusterId"`
+	// applied_index is the applied index of RAFT at which the state machine
+	// is exported.
+	AppliedIndex uint64 `protobuf:"varint,2,opt,name=applied_index,json=appliedIndex,proto3" json:"appliedIndex"`
+	// state_machine has storage nodes, topics, log streams, reports of log
+	// stream replicas and the latest commit results. It does not have members
+	// of the cluster.
+	StateMachine *MetadataRepositoryDescriptor `protobuf:"bytes,3,opt,name=state_machine,json=stateMachine,proto3" json:"stateMachine"`
+}
+
+func (m *BackupResponse) Reset()         { *m = BackupResponse{} }
+func (m *BackupResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupResponse) ProtoMessage()    {}
+func (*BackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8658321b298c6927, []int{7}
+}
+func (m *BackupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupResponse.Merge(m, src)
+}
+func (m *BackupResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *BackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupResponse proto.InternalMessageInfo
+
+func (m *BackupResponse) GetClusterID() github_com_kakao_varlog_pkg_types.ClusterID {
+	if m != nil {
+		return m.ClusterID
+	}
+	return 0
+}
+
+func (m *BackupResponse) GetAppliedIndex() uint64 {
+	if m != nil {
+		return m.AppliedIndex
+	}
+	return 0
+}
+
+func (m *BackupResponse) GetStateMachine() *MetadataRepositoryDescriptor {
+	if m != nil {
+		return m.StateMachine
+	}
+	return nil
+}
+
+type RestoreRequest struct {
+	ClusterID    github_com_kakao_varlog_pkg_types.ClusterID `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3,casttype=github.com/kakao/varlog/pkg/types.ClusterID" json:"cluster_id,omitempty"`
+	StateMachine *MetadataRepositoryDescriptor               `protobuf:"bytes,2,opt,name=state_machine,json=stateMachine,proto3" json:"state_machine,omitempty"`
+}
+
+func (m *RestoreRequest) Reset()         { *m = RestoreRequest{} }
+func (m *RestoreRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreRequest) ProtoMessage()    {}
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8658321b298c6927, []int{8}
+}
+func (m *RestoreRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreRequest.Merge(m, src)
+}
+func (m *RestoreRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RestoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreRequest proto.InternalMessageInfo
+
+func (m *RestoreRequest) GetClusterID() github_com_kakao_varlog_pkg_types.ClusterID {
+	if m != nil {
+		return m.ClusterID
+	}
+	return 0
+}
+
+func (m *RestoreRequest) GetStateMachine() *MetadataRepositoryDescriptor {
+	if m != nil {
+		return m.StateMachine
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AddPeerRequest)(nil), "varlog.mrpb.AddPeerRequest")
 	proto.RegisterType((*RemovePeerRequest)(nil), "varlog.mrpb.RemovePeerRequest")
@@ -386,54 +642,75 @@ func init() {
 	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.NodeID]*ClusterInfo_Member)(nil), "varlog.mrpb.ClusterInfo.MembersEntry")
 	proto.RegisterType((*ClusterInfo_Member)(nil), "varlog.mrpb.ClusterInfo.Member")
 	proto.RegisterType((*GetClusterInfoResponse)(nil), "varlog.mrpb.GetClusterInfoResponse")
+	proto.RegisterType((*TransferLeadershipRequest)(nil), "varlog.mrpb.TransferLeadershipRequest")
+	proto.RegisterType((*BackupRequest)(nil), "varlog.mrpb.BackupRequest")
+	proto.RegisterType((*BackupResponse)(nil), "varlog.mrpb.BackupResponse")
+	proto.RegisterType((*RestoreRequest)(nil), "varlog.mrpb.RestoreRequest")
 }
 
 func init() { proto.RegisterFile("proto/mrpb/management.proto", fileDescriptor_8658321b298c6927) }
 
 var fileDescriptor_8658321b298c6927 = []byte{
-	// 662 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcb, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8e, 0xdb, 0x5c, 0x9a, 0x93, 0xb6, 0x6a, 0x47, 0x6a, 0x15, 0xb9, 0x92, 0x1d, 0xf9, 0xd7,
-	0x2f, 0x45, 0xa2, 0xd8, 0x52, 0x50, 0x11, 0x82, 0x2e, 0x4a, 0x68, 0x8b, 0xb2, 0x28, 0xa0, 0x91,
-	0x58, 0x40, 0x17, 0x95, 0x13, 0x9f, 0xb8, 0x56, 0x6c, 0x8f, 0x71, 0x26, 0x15, 0xd9, 0xf2, 0x04,
-	0x3c, 0x02, 0x2f, 0xc1, 0x03, 0xb0, 0x63, 0xd9, 0x25, 0x2b, 0x23, 0x25, 0xbb, 0x6c, 0xd9, 0xb1,
-	0x42, 0x1e, 0xe7, 0xe6, 0x5e, 0x55, 0x90, 0x90, 0xd8, 0x9d, 0xdb, 0x7c, 0x67, 0xce, 0xe7, 0x33,
-	0x9f, 0x61, 0x2b, 0x08, 0x19, 0x67, 0x86, 0x17, 0x06, 0x4d, 0xc3, 0x33, 0x7d, 0xd3, 0x46, 0x0f,
-	0x7d, 0xae, 0x8b, 0x28, 0x29, 0x9d, 0x99, 0xa1, 0xcb, 0x6c, 0x3d, 0xce, 0xca, 0xf7, 0x6d, 0x87,
-	0x9f, 0xf6, 0x9a, 0x7a, 0x8b, 0x79, 0x86, 0xcd, 0x6c, 0x66, 0x88, 0x9a, 0x66, 0xaf, 0x2d, 0xbc,
-	0x04, 0x26, 0xb6, 0x92, 0xb3, 0xf2, 0x96, 0xcd, 0x98, 0xed, 0xe2, 0xac, 0x0a, 0xbd, 0x80, 0xf7,
-	0x93, 0xa4, 0x76, 0x2e, 0xc1, 0xea, 0x53, 0xcb, 0x7a, 0x85, 0x18, 0x52, 0x7c, 0xd7, 0xc3, 0x2e,
-	0x27, 0xc7, 0x00, 0x2d, 0xb7, 0xd7, 0xe5, 0x18, 0x9e, 0x38, 0x56, 0x59, 0xaa, 0x48, 0xd5, 0x95,
-	0xfa, 0xee, 0x20, 0x52, 0x8b, 0xcf, 0x92, 0x68, 0x63, 0xff, 0x67, 0xa4, 0xde, 0x9b, 0xbb, 0x43,
-	0xc7, 0xec, 0x98, 0xcc, 0x48, 0x6e, 0x68, 0x04, 0x1d, 0xdb, 0xe0, 0xfd, 0x00, 0xbb, 0xfa, 0xb4,
-	0x9c, 0x16, 0xc7, 0x78, 0x0d, 0x8b, 0xbc, 0x84, 0x82, 0xcf, 0x2c, 0x8c, 0x91, 0x17, 0x2a, 0x52,
-	0x35, 0x5b, 0x7f, 0x38, 0x88, 0xd4, 0xfc, 0x0b, 0x66, 0xa1, 0x80, 0xad, 0xde, 0x0e, 0x9b, 0xd4,
-	0xd2, 0x7c, 0x0c, 0xd3, 0xb0, 0xc8, 0x1a, 0x2c, 0xf6, 0x42, 0xb7, 0xbc, 0x58, 0x91, 0xaa, 0x45,
-	0x1a, 0x9b, 0xda, 0x17, 0x09, 0xd6, 0x29, 0x7a, 0xec, 0x0c, 0xff, 0xd9, 0xa9, 0x34, 0x0e, 0x1b,
-	0xcf, 0x91, 0x4f, 0x7a, 0xf9, 0x6d, 0xf6, 0x37, 0xc6, 0xd0, 0x3e, 0xe7, 0xa0, 0x34, 0xd7, 0x93,
-	0x9c, 0x5e, 0xd1, 0xac, 0x91, 0x6a, 0x36, 0x8a, 0xd4, 0xd9, 0xf9, 0x3f, 0x20, 0xf0, 0xcd, 0x45,
-	0x02, 0xf7, 0x66, 0x04, 0x8e, 0x22, 0x75, 0x4c, 0xcb, 0x6f, 0x2d, 0xc8, 0x3e, 0xe4, 0x5d, 0x34,
-	0x2d, 0x0c, 0xc5, 0x8e, 0x64, 0xeb, 0xdb, 0x77, 0x43, 0x49, 0xce, 0x92, 0x7d, 0x20, 0x21, 0x06,
-	0xae, 0xd3, 0x32, 0xb9, 0xc3, 0xfc, 0x93, 0xb6, 0xd9, 0xe2, 0x2c, 0x2c, 0x67, 0x2b, 0x52, 0x35,
-	0x57, 0xdf, 0x18, 0x45, 0xea, 0xfa, 0x5c, 0xf6, 0x50, 0x24, 0xe9, 0xe5, 0x10, 0xf1, 0xa0, 0xe0,
-	0xa1, 0xd7, 0xc4, 0xb0, 0x5b, 0xce, 0x55, 0x16, 0xab, 0xa5, 0xda, 0xff, 0xfa, 0xdc, 0xc3, 0xd6,
-	0xe7, 0xb8, 0xd7, 0x8f, 0x92, 0xba, 0x03, 0x9f, 0x87, 0xfd, 0xfa, 0xf6, 0x87, 0xef, 0x77, 0xb8,
-	0xf3, 0xa4, 0x07, 0xd9, 0x81, 0x15, 0x33, 0x08, 0x5c, 0x07, 0xad, 0x13, 0xc7, 0xb7, 0xf0, 0x7d,
-	0x39, 0x2f, 0x18, 0x58, 0x1b, 0x45, 0xea, 0xf2, 0x38, 0xd1, 0x88, 0xe3, 0x34, 0xe5, 0xc9, 0x14,
-	0xf2, 0x49, 0x77, 0x42, 0x20, 0x1b, 0x20, 0x86, 0xe2, 0xd3, 0x17, 0xa9, 0xb0, 0x89, 0x0c, 0x4b,
-	0xe8, 0x5b, 0x01, 0x73, 0x7c, 0x2e, 0xbe, 0x55, 0x91, 0x4e, 0x7d, 0x52, 0x86, 0x82, 0x8b, 0x66,
-	0xe8, 0x8f, 0xc9, 0x5e, 0xa2, 0x13, 0x57, 0x3e, 0x86, 0xe5, 0xf9, 0x89, 0xe2, 0x67, 0xdb, 0xc1,
-	0xbe, 0x00, 0xce, 0xd2, 0xd8, 0x24, 0x3b, 0x90, 0x3b, 0x33, 0xdd, 0x1e, 0x0a, 0xd0, 0x52, 0x4d,
-	0xbd, 0x85, 0x19, 0x9a, 0x54, 0x3f, 0x5e, 0x78, 0x24, 0x69, 0xaf, 0x61, 0xf3, 0xe2, 0x6b, 0xe9,
-	0x06, 0xcc, 0xef, 0x22, 0x79, 0x02, 0xcb, 0xd3, 0x0d, 0xf6, 0xdb, 0x4c, 0xf4, 0x2b, 0xd5, 0xca,
-	0xd7, 0x61, 0xd3, 0x52, 0x6b, 0xe6, 0xd4, 0x7e, 0x48, 0x00, 0x47, 0x53, 0x25, 0x26, 0x7b, 0x50,
-	0x18, 0x2b, 0x25, 0xd9, 0x4a, 0x01, 0xa4, 0xf5, 0x53, 0xde, 0xd4, 0x13, 0xc1, 0xd5, 0x27, 0x82,
-	0xab, 0x1f, 0xc4, 0x82, 0xab, 0x65, 0xc8, 0x21, 0xc0, 0x4c, 0x98, 0x88, 0x92, 0x02, 0xb9, 0xa4,
-	0x58, 0x37, 0xe0, 0x1c, 0xc3, 0x6a, 0x7a, 0x5e, 0xa2, 0xa5, 0xb0, 0xae, 0x94, 0x0e, 0xf9, 0xbf,
-	0x1b, 0x6b, 0x12, 0xc2, 0xb4, 0x4c, 0x7d, 0xf7, 0xeb, 0x40, 0x91, 0xce, 0x07, 0x8a, 0xf4, 0x71,
-	0xa8, 0x64, 0x3e, 0x0d, 0x15, 0xe9, 0x7c, 0xa8, 0x64, 0xbe, 0x0d, 0x95, 0xcc, 0x5b, 0xed, 0xda,
-	0x0d, 0x9c, 0xfe, 0xb9, 0x9a, 0x79, 0x61, 0x3f, 0xf8, 0x15, 0x00, 0x00, 0xff, 0xff, 0x43, 0x7b,
-	0x79, 0x16, 0xce, 0x06, 0x00, 0x00,
+	// 932 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4b, 0x6f, 0xe3, 0xd4,
+	0x17, 0xaf, 0x9b, 0x34, 0x99, 0x9c, 0x34, 0xd1, 0xf4, 0x4a, 0x33, 0xca, 0xa4, 0x52, 0x1c, 0x79,
+	0xf4, 0xff, 0x2b, 0x88, 0xc1, 0x91, 0x82, 0x06, 0x8d, 0x60, 0x16, 0x25, 0xd3, 0x19, 0x14, 0x89,
+	0x0e, 0xe8, 0xf2, 0x90, 0xa0, 0x8b, 0x70, 0x63, 0x9f, 0x38, 0x56, 0x6c, 0x5f, 0x73, 0x7d, 0x53,
+	0xd1, 0x2d, 0x9f, 0x00, 0x89, 0x2f, 0xc0, 0x17, 0x61, 0xc1, 0x0e, 0x76, 0x15, 0x2b, 0x56, 0x06,
+	0xb5, 0xbb, 0x7c, 0x04, 0xc4, 0x02, 0xf9, 0xda, 0x79, 0xb8, 0xaf, 0xa1, 0x20, 0x55, 0x9a, 0x4d,
+	0x72, 0x5e, 0xf7, 0x77, 0x1e, 0xf7, 0x9c, 0xeb, 0x03, 0xbb, 0xa1, 0xe0, 0x92, 0x77, 0x7d, 0x11,
+	0x8e, 0xba, 0x3e, 0x0b, 0x98, 0x83, 0x3e, 0x06, 0xd2, 0x54, 0x52, 0x52, 0x3d, 0x62, 0xc2, 0xe3,
+	0x8e, 0x99, 0x68, 0x9b, 0x6f, 0x39, 0xae, 0x9c, 0xcc, 0x46, 0xa6, 0xc5, 0xfd, 0xae, 0xc3, 0x1d,
+	0xde, 0x55, 0x36, 0xa3, 0xd9, 0x58, 0x71, 0x29, 0x4c, 0x42, 0xa5, 0x67, 0x9b, 0xbb, 0x0e, 0xe7,
+	0x8e, 0x87, 0x2b, 0x2b, 0xf4, 0x43, 0x79, 0x9c, 0x29, 0x1f, 0x2a, 0x7f, 0x82, 0x8d, 0xe5, 0xd0,
+	0x47, 0xc9, 0x6c, 0x26, 0xd9, 0x50, 0x60, 0xc8, 0x23, 0x57, 0x72, 0x91, 0x19, 0x19, 0x27, 0x1a,
+	0xd4, 0xdf, 0xb7, 0xed, 0x8f, 0x11, 0x05, 0xc5, 0xaf, 0x67, 0x18, 0x49, 0x72, 0x08, 0x60, 0x79,
+	0xb3, 0x48, 0xa2, 0x18, 0xba, 0x76, 0x43, 0x6b, 0x6b, 0x9d, 0x5a, 0xff, 0xe9, 0x69, 0xac, 0x57,
+	0x9e, 0xa5, 0xd2, 0xc1, 0xfe, 0x9f, 0xb1, 0xfe, 0xe6, 0x5a, 0xa0, 0x53, 0x36, 0x65, 0xbc, 0x9b,
+	0xa6, 0xd1, 0x0d, 0xa7, 0x4e, 0x57, 0x1e, 0x87, 0x18, 0x99, 0x4b, 0x73, 0x5a, 0xc9, 0xf0, 0x06,
+	0x36, 0xf9, 0x08, 0xca, 0x01, 0xb7, 0x31, 0x41, 0xde, 0x6c, 0x6b, 0x9d, 0x62, 0xff, 0x9d, 0xd3,
+	0x58, 0x2f, 0xbd, 0xe4, 0x36, 0x2a, 0xd8, 0xce, 0xab, 0x61, 0x53, 0x5b, 0x5a, 0x4a, 0x60, 0x06,
+	0x36, 0xb9, 0x0b, 0x85, 0x99, 0xf0, 0x1a, 0x85, 0xb6, 0xd6, 0xa9, 0xd0, 0x84, 0x34, 0x7e, 0xd2,
+	0x60, 0x87, 0xa2, 0xcf, 0x8f, 0xf0, 0xb5, 0xcd, 0xca, 0x90, 0x70, 0xef, 0x03, 0x94, 0x0b, 0x5f,
+	0xc1, 0x98, 0xdf, 0x46, 0x1a, 0xc6, 0x1f, 0x25, 0xa8, 0xae, 0xf9, 0x24, 0x93, 0x4b, 0x9c, 0x0d,
+	0x72, 0xce, 0xe6, 0xb1, 0xbe, 0x3a, 0xff, 0x1f, 0x0a, 0xf8, 0xc5, 0xf9, 0x02, 0xee, 0xad, 0x0a,
+	0x38, 0x8f, 0xf5, 0xac, 0x2c, 0xff, 0xaa, 0x41, 0xf6, 0xa1, 0xe4, 0x21, 0xb3, 0x51, 0xa8, 0x1e,
+	0x29, 0xf6, 0x1f, 0xdd, 0x0c, 0x25, 0x3d, 0x4b, 0xf6, 0x81, 0x08, 0x0c, 0x3d, 0xd7, 0x62, 0xd2,
+	0xe5, 0xc1, 0x70, 0xcc, 0x2c, 0xc9, 0x45, 0xa3, 0xd8, 0xd6, 0x3a, 0x5b, 0xfd, 0x7b, 0xf3, 0x58,
+	0xdf, 0x59, 0xd3, 0xbe, 0x50, 0x4a, 0x7a, 0x51, 0x44, 0x7c, 0x28, 0xfb, 0xe8, 0x8f, 0x50, 0x44,
+	0x8d, 0xad, 0x76, 0xa1, 0x53, 0xed, 0xfd, 0xcf, 0x5c, 0x9b, 0x7e, 0x73, 0xad, 0xf6, 0xe6, 0x41,
+	0x6a, 0xf7, 0x3c, 0x90, 0xe2, 0xb8, 0xff, 0xe8, 0xdb, 0xdf, 0x6f, 0x10, 0xf3, 0xc2, 0x07, 0x79,
+	0x0c, 0x35, 0x16, 0x86, 0x9e, 0x8b, 0xf6, 0xd0, 0x0d, 0x6c, 0xfc, 0xa6, 0x51, 0x52, 0x15, 0xb8,
+	0x3b, 0x8f, 0xf5, 0xed, 0x4c, 0x31, 0x48, 0xe4, 0x34, 0xc7, 0x35, 0xff, 0xd2, 0xa0, 0x94, 0xba,
+	0x27, 0x04, 0x8a, 0x21, 0xa2, 0x50, 0x77, 0x5f, 0xa1, 0x8a, 0x26, 0x4d, 0xb8, 0x83, 0x81, 0x1d,
+	0x72, 0x37, 0x90, 0xea, 0xb2, 0x2a, 0x74, 0xc9, 0x93, 0x06, 0x94, 0x3d, 0x64, 0x22, 0xc8, 0xaa,
+	0x7d, 0x87, 0x2e, 0x58, 0xd2, 0x85, 0xaa, 0xcf, 0xa4, 0x35, 0xc9, 0x22, 0x29, 0xaa, 0x48, 0xea,
+	0xf3, 0x58, 0x07, 0x25, 0x4e, 0xe3, 0x58, 0xa3, 0xc9, 0x03, 0x28, 0x78, 0xcc, 0x69, 0x6c, 0x29,
+	0xc3, 0xf2, 0x3c, 0xd6, 0x13, 0x96, 0x26, 0x3f, 0xe4, 0x09, 0xd4, 0x43, 0xc1, 0x1d, 0x81, 0x51,
+	0x34, 0x8c, 0x24, 0x93, 0xa8, 0x12, 0xab, 0xf4, 0x77, 0xe6, 0xb1, 0x5e, 0x5b, 0x68, 0x3e, 0x49,
+	0x14, 0x34, 0xcf, 0x12, 0x03, 0x4a, 0xcc, 0x92, 0xee, 0x11, 0x36, 0xca, 0x49, 0x78, 0x7d, 0x48,
+	0x9a, 0x2b, 0x95, 0xd0, 0xec, 0xbf, 0x79, 0x08, 0xdb, 0xeb, 0xc5, 0x4f, 0x5e, 0x98, 0x29, 0x1e,
+	0xab, 0x12, 0x14, 0x69, 0x42, 0x92, 0xc7, 0xb0, 0x75, 0xc4, 0xbc, 0x19, 0xaa, 0xf4, 0xab, 0x3d,
+	0xfd, 0x15, 0x97, 0x48, 0x53, 0xeb, 0x77, 0x37, 0x9f, 0x68, 0xc6, 0x67, 0x70, 0xff, 0xfc, 0x60,
+	0x47, 0x21, 0x0f, 0x22, 0x24, 0xef, 0xc1, 0xf6, 0x72, 0xd8, 0x82, 0x31, 0x57, 0xfe, 0xaa, 0xbd,
+	0xc6, 0x55, 0xd8, 0xb4, 0x6a, 0xad, 0x18, 0xe3, 0x17, 0x0d, 0x1e, 0x7c, 0x2a, 0x58, 0x10, 0x8d,
+	0x51, 0x7c, 0xa8, 0x3a, 0x36, 0x9a, 0xb8, 0xe1, 0xeb, 0xf9, 0xf6, 0x79, 0x50, 0xeb, 0x33, 0x6b,
+	0x3a, 0xbb, 0x95, 0xf0, 0x8d, 0xef, 0x37, 0xa1, 0xbe, 0x70, 0x97, 0xdd, 0xc4, 0xed, 0x3d, 0x7b,
+	0x17, 0x06, 0x74, 0xf3, 0x9f, 0x0c, 0x28, 0xf9, 0x0a, 0x6a, 0xaa, 0xed, 0x87, 0x3e, 0xb3, 0x26,
+	0x6e, 0x80, 0x6a, 0xd6, 0xaa, 0xbd, 0x37, 0x72, 0xbd, 0x72, 0x90, 0x7d, 0xf3, 0xe9, 0xf2, 0x93,
+	0xbf, 0x8f, 0x91, 0x25, 0xdc, 0x50, 0x72, 0x91, 0x7a, 0x50, 0x18, 0x07, 0x29, 0x04, 0xcd, 0x71,
+	0xc6, 0x8f, 0x1a, 0xd4, 0x29, 0x46, 0x92, 0x0b, 0xbc, 0x95, 0x26, 0x7a, 0x79, 0x3e, 0xa3, 0xcd,
+	0x1b, 0x66, 0x94, 0x8f, 0xbf, 0xf7, 0x6b, 0x01, 0xe0, 0x60, 0xb9, 0x69, 0x91, 0x3d, 0x28, 0x67,
+	0x4b, 0x0e, 0xd9, 0xcd, 0x41, 0xe6, 0x57, 0x9f, 0xe6, 0x7d, 0x33, 0x5d, 0xa8, 0xcc, 0xc5, 0x42,
+	0x65, 0x3e, 0x4f, 0x16, 0x2a, 0x63, 0x83, 0xbc, 0x00, 0x58, 0xed, 0x14, 0xa4, 0x95, 0x03, 0xb9,
+	0xb0, 0x6c, 0x5c, 0x83, 0x73, 0x08, 0xf5, 0xfc, 0xfc, 0x13, 0x23, 0x87, 0x75, 0xe9, 0x57, 0xbf,
+	0xf9, 0xf0, 0x5a, 0x9b, 0xb4, 0x6d, 0x8d, 0x0d, 0xf2, 0x39, 0x90, 0x8b, 0x8f, 0x00, 0xf9, 0x7f,
+	0xee, 0xf0, 0x95, 0xaf, 0xc4, 0x35, 0x41, 0x3f, 0x83, 0x52, 0x3a, 0x22, 0xa4, 0x99, 0xc3, 0xca,
+	0x8d, 0x69, 0x73, 0xf7, 0x52, 0xdd, 0x32, 0xb8, 0x3d, 0x28, 0x67, 0x1d, 0x75, 0xee, 0x0e, 0xf2,
+	0x7d, 0x76, 0x75, 0x18, 0xfd, 0xa7, 0x3f, 0x9f, 0xb6, 0xb4, 0x93, 0xd3, 0x96, 0xf6, 0xdd, 0x59,
+	0x6b, 0xe3, 0x87, 0xb3, 0x96, 0x76, 0x72, 0xd6, 0xda, 0xf8, 0xed, 0xac, 0xb5, 0xf1, 0xa5, 0x71,
+	0x65, 0xdb, 0x2d, 0x17, 0xef, 0x51, 0x49, 0xd1, 0x6f, 0xff, 0x3d, 0x00, 0x35, 0x07, 0xcc, 0x59,
+	0x8d, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -451,6 +728,18 @@ type ManagementClient interface {
 	AddPeer(ctx context.Context, in *AddPeerRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	RemovePeer(ctx context.Context, in *RemovePeerRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	GetClusterInfo(ctx context.Context, in *GetClusterInfoRequest, opts ...grpc.CallOption) (*GetClusterInfoResponse, error)
+	// TransferLeadership transfers the leadership of RAFT to the given member.
+	// It returns after the member becomes the leader. The member should be a
+	// voter rather than a learner.
+	TransferLeadership(ctx context.Context, in *TransferLeadershipRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Backup exports the state machine consistently. It can be called while
+	// the metadata repository is serving.
+	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error)
+	// Restore replaces the state machine with the exported one. It succeeds
+	// only if the metadata repository is empty, that is, it has neither
+	// storage nodes, topics, log streams nor commit results. All log streams
+	// restored become sealing.
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type managementClient struct {
@@ -488,11 +777,50 @@ func (c *managementClient) GetClusterInfo(ctx context.Context, in *GetClusterInf
 	return out, nil
 }
 
+func (c *managementClient) TransferLeadership(ctx context.Context, in *TransferLeadershipRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.Management/TransferLeadership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error) {
+	out := new(BackupResponse)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.Management/Backup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.Management/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ManagementServer is the server API for Management service.
 type ManagementServer interface {
 	AddPeer(context.Context, *AddPeerRequest) (*types.Empty, error)
 	RemovePeer(context.Context, *RemovePeerRequest) (*types.Empty, error)
 	GetClusterInfo(context.Context, *GetClusterInfoRequest) (*GetClusterInfoResponse, error)
+	// TransferLeadership transfers the leadership of RAFT to the given member.
+	// It returns after the member becomes the leader. The member should be a
+	// voter rather than a learner.
+	TransferLeadership(context.Context, *TransferLeadershipRequest) (*types.Empty, error)
+	// Backup exports the state machine consistently. It can be called while
+	// the metadata repository is serving.
+	Backup(context.Context, *BackupRequest) (*BackupResponse, error)
+	// Restore replaces the state machine with the exported one. It succeeds
+	// only if the metadata repository is empty, that is, it has neither
+	// storage nodes, topics, log streams nor commit results. All log streams
+	// restored become sealing.
+	Restore(context.Context, *RestoreRequest) (*types.Empty, error)
 }
 
 // UnimplementedManagementServer can be embedded to have forward compatible implementations.
@@ -508,6 +836,15 @@ func (*UnimplementedManagementServer) RemovePeer(ctx context.Context, req *Remov
 func (*UnimplementedManagementServer) GetClusterInfo(ctx context.Context, req *GetClusterInfoRequest) (*GetClusterInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetClusterInfo not implemented")
 }
+func (*UnimplementedManagementServer) TransferLeadership(ctx context.Context, req *TransferLeadershipRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLeadership not implemented")
+}
+func (*UnimplementedManagementServer) Backup(ctx context.Context, req *BackupRequest) (*BackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+func (*UnimplementedManagementServer) Restore(ctx context.Context, req *RestoreRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
 
 func RegisterManagementServer(s *grpc.Server, srv ManagementServer) {
 	s.RegisterService(&_Management_serviceDesc, srv)
@@ -567,6 +904,60 @@ func _Management_GetClusterInfo_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Management_TransferLeadership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferLeadershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).TransferLeadership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.Management/TransferLeadership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).TransferLeadership(ctx, req.(*TransferLeadershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_Backup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).Backup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.Management/Backup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).Backup(ctx, req.(*BackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.Management/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).Restore(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Management_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "varlog.mrpb.Management",
 	HandlerType: (*ManagementServer)(nil),
@@ -583,6 +974,18 @@ var _Management_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetClusterInfo",
 			Handler:    _Management_GetClusterInfo_Handler,
 		},
+		{
+			MethodName: "TransferLeadership",
+			Handler:    _Management_TransferLeadership_Handler,
+		},
+		{
+			MethodName: "Backup",
+			Handler:    _Management_Backup_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _Management_Restore_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/mrpb/management.proto",
@@ -781,6 +1184,33 @@ func (m *ClusterInfo_Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ProgressState) > 0 {
+		i -= len(m.ProgressState)
+		copy(dAtA[i:], m.ProgressState)
+		i = encodeVarintManagement(dAtA, i, uint64(len(m.ProgressState)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Lag != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.Lag))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MatchIndex != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.MatchIndex))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Learner {
 		i--
 		if m.Learner {
@@ -843,23 +1273,169 @@ func (m *GetClusterInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintManagement(dAtA []byte, offset int, v uint64) int {
-	offset -= sovManagement(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TransferLeadershipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AddPeerRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+
+func (m *TransferLeadershipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLeadershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.NodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StateMachine != nil {
+		{
+			size, err := m.StateMachine.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintManagement(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppliedIndex != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.AppliedIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StateMachine != nil {
+		{
+			size, err := m.StateMachine.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintManagement(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintManagement(dAtA []byte, offset int, v uint64) int {
+	offset -= sovManagement(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddPeerRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.ClusterID != 0 {
 		n += 1 + sovManagement(uint64(m.ClusterID))
 	}
@@ -954,6 +1530,19 @@ func (m *ClusterInfo_Member) ProtoSize() (n int) {
 	if m.Learner {
 		n += 2
 	}
+	if m.MatchIndex != 0 {
+		n += 1 + sovManagement(uint64(m.MatchIndex))
+	}
+	if m.Lag != 0 {
+		n += 1 + sovManagement(uint64(m.Lag))
+	}
+	l = len(m.ProgressState)
+	if l > 0 {
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
 	return n
 }
 
@@ -970,6 +1559,68 @@ func (m *GetClusterInfoResponse) ProtoSize() (n int) {
 	return n
 }
 
+func (m *TransferLeadershipRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	if m.NodeID != 0 {
+		n += 1 + sovManagement(uint64(m.NodeID))
+	}
+	return n
+}
+
+func (m *BackupRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	return n
+}
+
+func (m *BackupResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	if m.AppliedIndex != 0 {
+		n += 1 + sovManagement(uint64(m.AppliedIndex))
+	}
+	if m.StateMachine != nil {
+		l = m.StateMachine.ProtoSize()
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	return n
+}
+
+func (m *RestoreRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	if m.StateMachine != nil {
+		l = m.StateMachine.ProtoSize()
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	return n
+}
+
 func sovManagement(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1626,61 +2277,151 @@ func (m *ClusterInfo_Member) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Learner = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipManagement(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthManagement
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetClusterInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowManagement
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetClusterInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetClusterInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterInfo", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchIndex", wireType)
 			}
-			var msglen int
+			m.MatchIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
+			}
+			m.Lag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lag |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManagement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgressState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetClusterInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetClusterInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetClusterInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterInfo", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManagement
@@ -1733,6 +2474,392 @@ func (m *GetClusterInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TransferLeadershipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferLeadershipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferLeadershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedIndex", wireType)
+			}
+			m.AppliedIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppliedIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateMachine", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManagement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StateMachine == nil {
+				m.StateMachine = &MetadataRepositoryDescriptor{}
+			}
+			if err := m.StateMachine.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateMachine", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManagement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StateMachine == nil {
+				m.StateMachine = &MetadataRepositoryDescriptor{}
+			}
+			if err := m.StateMachine.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipManagement(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,9 +9,11 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -25,6 +27,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +36,14 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GetMetadataRequest struct {
+	// linearizable makes the metadata repository confirm that it has applied
+	// all entries committed before the request by using RAFT ReadIndex. Thus,
+	// the response reflects all changes completed before the request, even if
+	// the node serving it is a lagging follower.
+	Linearizable bool `protobuf:"varint,1,opt,name=linearizable,proto3" json:"linearizable,omitempty"`
+	// applied_index makes the metadata repository wait until the applied index
+	// of its metadata becomes greater than or equal to it.
+	AppliedIndex uint64 `protobuf:"varint,2,opt,name=applied_index,json=appliedIndex,proto3" json:"applied_index,omitempty"`
 }
 
 func (m *GetMetadataRequest) Reset()         { *m = GetMetadataRequest{} }
@@ -68,6 +79,20 @@ func (m *GetMetadataRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetMetadataRequest proto.InternalMessageInfo
 
+func (m *GetMetadataRequest) GetLinearizable() bool {
+	if m != nil {
+		return m.Linearizable
+	}
+	return false
+}
+
+func (m *GetMetadataRequest) GetAppliedIndex() uint64 {
+	if m != nil {
+		return m.AppliedIndex
+	}
+	return 0
+}
+
 type GetMetadataResponse struct {
 	Metadata *varlogpb.MetadataDescriptor `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
@@ -402,6 +427,9 @@ func (m *UnsealResponse) GetStatus() varlogpb.LogStreamStatus {
 
 type TopicRequest struct {
 	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	// compacted is used only by RegisterTopic, and it makes the topic
+	// compacted.
+	Compacted bool `protobuf:"varint,2,opt,name=compacted,proto3" json:"compacted,omitempty"`
 }
 
 func (m *TopicRequest) Reset()         { *m = TopicRequest{} }
@@ -444,6 +472,319 @@ func (m *TopicRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
 	return 0
 }
 
+func (m *TopicRequest) GetCompacted() bool {
+	if m != nil {
+		return m.Compacted
+	}
+	return false
+}
+
+type AcquireAdminLeaseRequest struct {
+	// holder is the address of the admin server trying to acquire the lease.
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// duration is the length of the lease. The holder can release the lease by
+	// acquiring it with zero duration.
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *AcquireAdminLeaseRequest) Reset()         { *m = AcquireAdminLeaseRequest{} }
+func (m *AcquireAdminLeaseRequest) String() string { return proto.CompactTextString(m) }
+func (*AcquireAdminLeaseRequest) ProtoMessage()    {}
+func (*AcquireAdminLeaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{9}
+}
+func (m *AcquireAdminLeaseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcquireAdminLeaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcquireAdminLeaseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AcquireAdminLeaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcquireAdminLeaseRequest.Merge(m, src)
+}
+func (m *AcquireAdminLeaseRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AcquireAdminLeaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcquireAdminLeaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcquireAdminLeaseRequest proto.InternalMessageInfo
+
+func (m *AcquireAdminLeaseRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *AcquireAdminLeaseRequest) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type AcquireAdminLeaseResponse struct {
+	// lease is the current lease. The caller holds the lease only if the holder
+	// of the lease is the same as the holder of the request.
+	Lease AdminLease `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease"`
+}
+
+func (m *AcquireAdminLeaseResponse) Reset()         { *m = AcquireAdminLeaseResponse{} }
+func (m *AcquireAdminLeaseResponse) String() string { return proto.CompactTextString(m) }
+func (*AcquireAdminLeaseResponse) ProtoMessage()    {}
+func (*AcquireAdminLeaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{10}
+}
+func (m *AcquireAdminLeaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcquireAdminLeaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcquireAdminLeaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AcquireAdminLeaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcquireAdminLeaseResponse.Merge(m, src)
+}
+func (m *AcquireAdminLeaseResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AcquireAdminLeaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcquireAdminLeaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcquireAdminLeaseResponse proto.InternalMessageInfo
+
+func (m *AcquireAdminLeaseResponse) GetLease() AdminLease {
+	if m != nil {
+		return m.Lease
+	}
+	return AdminLease{}
+}
+
+type WatchMetadataRequest struct {
+	// applied_index is the applied index of the metadata that the client has.
+	// Only metadata whose applied index is greater than it is sent.
+	AppliedIndex uint64 `protobuf:"varint,1,opt,name=applied_index,json=appliedIndex,proto3" json:"applied_index,omitempty"`
+}
+
+func (m *WatchMetadataRequest) Reset()         { *m = WatchMetadataRequest{} }
+func (m *WatchMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchMetadataRequest) ProtoMessage()    {}
+func (*WatchMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{11}
+}
+func (m *WatchMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchMetadataRequest.Merge(m, src)
+}
+func (m *WatchMetadataRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *WatchMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchMetadataRequest proto.InternalMessageInfo
+
+func (m *WatchMetadataRequest) GetAppliedIndex() uint64 {
+	if m != nil {
+		return m.AppliedIndex
+	}
+	return 0
+}
+
+type WatchMetadataResponse struct {
+	// metadata is the snapshot of the metadata. Its applied index increases
+	// monotonically in a stream.
+	Metadata *varlogpb.MetadataDescriptor `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *WatchMetadataResponse) Reset()         { *m = WatchMetadataResponse{} }
+func (m *WatchMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchMetadataResponse) ProtoMessage()    {}
+func (*WatchMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{12}
+}
+func (m *WatchMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchMetadataResponse.Merge(m, src)
+}
+func (m *WatchMetadataResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *WatchMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchMetadataResponse proto.InternalMessageInfo
+
+func (m *WatchMetadataResponse) GetMetadata() *varlogpb.MetadataDescriptor {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type LookupGLSNRequest struct {
+	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	GLSN    github_com_kakao_varlog_pkg_types.GLSN    `protobuf:"varint,2,opt,name=glsn,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn,omitempty"`
+}
+
+func (m *LookupGLSNRequest) Reset()         { *m = LookupGLSNRequest{} }
+func (m *LookupGLSNRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupGLSNRequest) ProtoMessage()    {}
+func (*LookupGLSNRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{13}
+}
+func (m *LookupGLSNRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LookupGLSNRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LookupGLSNRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LookupGLSNRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupGLSNRequest.Merge(m, src)
+}
+func (m *LookupGLSNRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *LookupGLSNRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupGLSNRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupGLSNRequest proto.InternalMessageInfo
+
+func (m *LookupGLSNRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+func (m *LookupGLSNRequest) GetGLSN() github_com_kakao_varlog_pkg_types.GLSN {
+	if m != nil {
+		return m.GLSN
+	}
+	return 0
+}
+
+type LookupGLSNResponse struct {
+	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	// llsn is the LLSN of the log entry in the log stream.
+	LLSN github_com_kakao_varlog_pkg_types.LLSN `protobuf:"varint,3,opt,name=llsn,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn,omitempty"`
+}
+
+func (m *LookupGLSNResponse) Reset()         { *m = LookupGLSNResponse{} }
+func (m *LookupGLSNResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupGLSNResponse) ProtoMessage()    {}
+func (*LookupGLSNResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{14}
+}
+func (m *LookupGLSNResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LookupGLSNResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LookupGLSNResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LookupGLSNResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupGLSNResponse.Merge(m, src)
+}
+func (m *LookupGLSNResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *LookupGLSNResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupGLSNResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupGLSNResponse proto.InternalMessageInfo
+
+func (m *LookupGLSNResponse) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+func (m *LookupGLSNResponse) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
+}
+
+func (m *LookupGLSNResponse) GetLLSN() github_com_kakao_varlog_pkg_types.LLSN {
+	if m != nil {
+		return m.LLSN
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GetMetadataRequest)(nil), "varlog.mrpb.GetMetadataRequest")
 	proto.RegisterType((*GetMetadataResponse)(nil), "varlog.mrpb.GetMetadataResponse")
@@ -454,6 +795,12 @@ func init() {
 	proto.RegisterType((*UnsealRequest)(nil), "varlog.mrpb.UnsealRequest")
 	proto.RegisterType((*UnsealResponse)(nil), "varlog.mrpb.UnsealResponse")
 	proto.RegisterType((*TopicRequest)(nil), "varlog.mrpb.TopicRequest")
+	proto.RegisterType((*AcquireAdminLeaseRequest)(nil), "varlog.mrpb.AcquireAdminLeaseRequest")
+	proto.RegisterType((*AcquireAdminLeaseResponse)(nil), "varlog.mrpb.AcquireAdminLeaseResponse")
+	proto.RegisterType((*WatchMetadataRequest)(nil), "varlog.mrpb.WatchMetadataRequest")
+	proto.RegisterType((*WatchMetadataResponse)(nil), "varlog.mrpb.WatchMetadataResponse")
+	proto.RegisterType((*LookupGLSNRequest)(nil), "varlog.mrpb.LookupGLSNRequest")
+	proto.RegisterType((*LookupGLSNResponse)(nil), "varlog.mrpb.LookupGLSNResponse")
 }
 
 func init() {
@@ -461,52 +808,72 @@ func init() {
 }
 
 var fileDescriptor_0ffe516e0fdff161 = []byte{
-	// 719 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x96, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0xc7, 0xe3, 0x0a, 0x02, 0x4c, 0x08, 0x94, 0x4d, 0x3f, 0xc0, 0xa8, 0x71, 0xe4, 0x22, 0x44,
-	0x55, 0xe1, 0x48, 0xf4, 0xc2, 0x81, 0x8a, 0x2a, 0xd0, 0xa2, 0xa0, 0x94, 0x56, 0x4e, 0xe9, 0xa1,
-	0x55, 0x15, 0x39, 0xf1, 0xd6, 0xb5, 0xb0, 0xb3, 0xae, 0x77, 0x83, 0xc4, 0x5b, 0xb4, 0x6f, 0xd0,
-	0xd7, 0xe8, 0x1b, 0x70, 0x44, 0x3d, 0xf5, 0xe4, 0x43, 0xf2, 0x16, 0x9c, 0x2a, 0xaf, 0xbd, 0xfe,
-	0x20, 0x7c, 0x1c, 0xe0, 0xd4, 0x9b, 0xbd, 0x33, 0xf3, 0x9b, 0xff, 0xee, 0x64, 0xff, 0x0e, 0xac,
-	0x78, 0x3e, 0x61, 0xa4, 0xee, 0xfa, 0x5e, 0xb7, 0xee, 0x62, 0x66, 0x98, 0x06, 0x33, 0x3a, 0x3e,
-	0xf6, 0x08, 0xb5, 0x19, 0xf1, 0x4f, 0x34, 0x1e, 0x46, 0xa5, 0x63, 0xc3, 0x77, 0x88, 0xa5, 0x85,
-	0x69, 0xf2, 0xba, 0x65, 0xb3, 0x6f, 0x83, 0xae, 0xd6, 0x23, 0x6e, 0xdd, 0x22, 0x16, 0xa9, 0xf3,
-	0x9c, 0xee, 0xe0, 0x2b, 0x7f, 0x8b, 0x78, 0xe1, 0x53, 0x54, 0x2b, 0x2f, 0x5b, 0x84, 0x58, 0x0e,
-	0x4e, 0xb3, 0xb0, 0xeb, 0xb1, 0x18, 0x2c, 0x3f, 0x8e, 0xc0, 0x99, 0xe6, 0x51, 0x40, 0x7d, 0x00,
-	0x68, 0x0f, 0xb3, 0xb7, 0xf1, 0xa2, 0x8e, 0xbf, 0x0f, 0x30, 0x65, 0xea, 0x47, 0xa8, 0xe4, 0x56,
-	0xa9, 0x47, 0xfa, 0x14, 0xa3, 0x6d, 0x98, 0x16, 0xe5, 0x8b, 0x52, 0x4d, 0x5a, 0x2b, 0x6d, 0x3c,
-	0xd5, 0x62, 0xc5, 0x82, 0xaf, 0x89, 0xa2, 0x5d, 0x4c, 0x7b, 0xbe, 0xed, 0x31, 0xe2, 0xeb, 0x49,
-	0x91, 0x8a, 0x01, 0xb5, 0x19, 0xf1, 0x0d, 0x0b, 0x1f, 0x10, 0x13, 0xc7, 0xdd, 0xd0, 0x3b, 0x98,
-	0xa5, 0xd1, 0x6a, 0xa7, 0x4f, 0x4c, 0x1c, 0xa3, 0x57, 0xc7, 0xd0, 0x99, 0xd2, 0x94, 0xde, 0x98,
-	0x38, 0x0d, 0x14, 0x49, 0x2f, 0xd1, 0x34, 0xa8, 0x7e, 0x81, 0xfb, 0x2d, 0x62, 0xb5, 0x99, 0x8f,
-	0x0d, 0x57, 0x34, 0x69, 0x02, 0x38, 0xc4, 0xea, 0x50, 0xbe, 0x18, 0xb7, 0x58, 0x19, 0x6b, 0x91,
-	0x94, 0x8d, 0x35, 0x98, 0x71, 0x44, 0x48, 0x3d, 0x93, 0xa0, 0xd4, 0xc6, 0x86, 0x23, 0xd0, 0x9f,
-	0x01, 0x7a, 0xce, 0x80, 0x32, 0xec, 0x77, 0x6c, 0x93, 0xa3, 0xcb, 0x8d, 0xad, 0x61, 0xa0, 0xcc,
-	0xec, 0x44, 0xab, 0xcd, 0xdd, 0xf3, 0x40, 0x79, 0x9e, 0x99, 0xe6, 0x91, 0x71, 0x64, 0x90, 0x7a,
-	0xd4, 0xb4, 0xee, 0x1d, 0x59, 0x75, 0x76, 0xe2, 0x61, 0xaa, 0x25, 0xe9, 0xfa, 0x4c, 0xcc, 0x6b,
-	0x9a, 0xc8, 0x84, 0x72, 0xaa, 0x3b, 0xe4, 0xdf, 0xab, 0x49, 0x6b, 0x93, 0x8d, 0x57, 0xc3, 0x40,
-	0x29, 0x25, 0x6a, 0x79, 0x87, 0xf5, 0x9b, 0x3b, 0x64, 0x0a, 0xf4, 0x52, 0xb2, 0xa1, 0xa6, 0xa9,
-	0xfe, 0x96, 0x60, 0x36, 0xda, 0x52, 0x3c, 0xea, 0x4d, 0x28, 0x52, 0x66, 0xb0, 0x01, 0xe5, 0xfb,
-	0x99, 0xdb, 0xa8, 0x5d, 0x7d, 0x54, 0x6d, 0x9e, 0xa7, 0xc7, 0xf9, 0x88, 0x40, 0xc5, 0x31, 0x28,
-	0xeb, 0xf4, 0x88, 0xeb, 0xda, 0x8c, 0x61, 0xb3, 0x63, 0x39, 0xb4, 0xcf, 0x65, 0x4f, 0x34, 0xb6,
-	0x87, 0x81, 0xb2, 0xd0, 0x32, 0x28, 0xdb, 0x11, 0xd1, 0xbd, 0x56, 0xfb, 0xe0, 0x3c, 0x50, 0x56,
-	0x6f, 0x16, 0x1f, 0x66, 0xea, 0x0b, 0x4e, 0xae, 0xd8, 0xa1, 0x7d, 0xf5, 0x8f, 0x04, 0xe5, 0xc3,
-	0x3e, 0xfd, 0xbf, 0x06, 0xb2, 0x0f, 0x73, 0x62, 0x4f, 0xb7, 0x9d, 0x88, 0xda, 0x83, 0xd9, 0x0f,
-	0xc4, 0xb3, 0x7b, 0xe2, 0x78, 0xda, 0x30, 0xcd, 0xc2, 0x77, 0x71, 0x38, 0x93, 0x8d, 0xcd, 0x61,
-	0xa0, 0x4c, 0xf1, 0x1c, 0x2e, 0xfc, 0xd9, 0xcd, 0xc2, 0xe3, 0x64, 0x7d, 0x8a, 0x93, 0x9a, 0xe6,
-	0xc6, 0xcf, 0x22, 0x2c, 0xa5, 0x86, 0x21, 0x7c, 0xad, 0x8d, 0xfd, 0x63, 0xbb, 0x87, 0xd1, 0x7b,
-	0xa8, 0xe8, 0xd8, 0xb2, 0xc3, 0x23, 0xcc, 0xdc, 0x62, 0xa4, 0x68, 0x19, 0xc3, 0xd3, 0xc6, 0xad,
-	0x41, 0x7e, 0xa4, 0x45, 0xae, 0xa6, 0x09, 0x57, 0xd3, 0x5e, 0x87, 0xae, 0xa6, 0x16, 0x90, 0x0e,
-	0x0f, 0x0f, 0xfb, 0xfe, 0xdd, 0x32, 0x77, 0xa1, 0x2c, 0x54, 0xf2, 0xfd, 0xa1, 0xa5, 0x1c, 0x2b,
-	0x7b, 0x88, 0xd7, 0x50, 0xde, 0xc0, 0x7c, 0xaa, 0xec, 0x16, 0x9c, 0x16, 0x2c, 0x08, 0x35, 0xc9,
-	0x64, 0xd1, 0x93, 0x1c, 0xe9, 0xa2, 0xcb, 0x5d, 0x43, 0x3b, 0x80, 0x4a, 0xaa, 0xea, 0x0e, 0x78,
-	0xfb, 0x30, 0x7f, 0xe8, 0x99, 0x06, 0xc3, 0x77, 0xc0, 0xd2, 0xa1, 0x94, 0xf9, 0xdc, 0x5c, 0x98,
-	0xe0, 0xf8, 0xe7, 0x49, 0xae, 0x5d, 0x9d, 0x10, 0x5d, 0x16, 0xb5, 0x80, 0x5e, 0xc2, 0x44, 0x68,
-	0x68, 0x68, 0x31, 0xff, 0x73, 0x48, 0x5d, 0x42, 0x5e, 0xba, 0x24, 0x92, 0x94, 0xef, 0x40, 0x31,
-	0xba, 0x7f, 0x48, 0xce, 0xa5, 0xe5, 0x8c, 0x46, 0x5e, 0xbe, 0x34, 0x26, 0x20, 0x8d, 0xad, 0xd3,
-	0x61, 0x55, 0x3a, 0x1b, 0x56, 0xa5, 0x1f, 0xa3, 0x6a, 0xe1, 0xd7, 0xa8, 0x2a, 0x9d, 0x8d, 0xaa,
-	0x85, 0xbf, 0xa3, 0x6a, 0xe1, 0x93, 0x7a, 0xe5, 0x0d, 0x4b, 0xfe, 0x26, 0x74, 0x8b, 0xfc, 0xf9,
-	0xc5, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x84, 0x18, 0x7e, 0x3b, 0x08, 0x00, 0x00,
+	// 1027 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x16, 0x27, 0xb5, 0x9f, 0xed, 0x16, 0x4f, 0x68, 0x1b, 0x6f, 0xc1, 0x1b, 0x36, 0x25,
+	0x2a, 0x42, 0x5d, 0x23, 0xf7, 0x52, 0x89, 0xa2, 0x52, 0x27, 0xb4, 0x72, 0x65, 0xd2, 0x6a, 0x4d,
+	0x00, 0x15, 0x55, 0xd6, 0x78, 0x77, 0xba, 0x59, 0x65, 0xbd, 0xb3, 0xdd, 0x19, 0x57, 0x94, 0x1b,
+	0xdf, 0x80, 0x1b, 0x1c, 0x39, 0xf0, 0x01, 0xb8, 0xf2, 0x0d, 0x72, 0x8c, 0x38, 0x71, 0x32, 0x92,
+	0xf3, 0x2d, 0x7a, 0x42, 0x3b, 0xfb, 0xdf, 0x7f, 0x12, 0x50, 0x13, 0x0e, 0xdc, 0xbc, 0xf3, 0xde,
+	0xfb, 0xbd, 0xdf, 0xbc, 0x37, 0xf3, 0x7b, 0x63, 0xb8, 0xe1, 0xf9, 0x94, 0xd3, 0xd6, 0xc8, 0xf7,
+	0x86, 0xad, 0x11, 0xe1, 0xd8, 0xc4, 0x1c, 0x0f, 0x7c, 0xe2, 0x51, 0x66, 0x73, 0xea, 0xbf, 0xd2,
+	0x84, 0x19, 0x55, 0x5e, 0x62, 0xdf, 0xa1, 0x96, 0x16, 0xb8, 0xc9, 0xb7, 0x2c, 0x9b, 0xef, 0x8f,
+	0x87, 0x9a, 0x41, 0x47, 0x2d, 0x8b, 0x5a, 0xb4, 0x25, 0x7c, 0x86, 0xe3, 0xe7, 0xe2, 0x2b, 0xc4,
+	0x0b, 0x7e, 0x85, 0xb1, 0x72, 0xd3, 0xa2, 0xd4, 0x72, 0x48, 0xea, 0x65, 0x8e, 0x7d, 0xcc, 0x6d,
+	0xea, 0x46, 0xf6, 0xeb, 0xb3, 0x76, 0x32, 0xf2, 0x78, 0x94, 0x58, 0xbe, 0x16, 0x26, 0xce, 0x90,
+	0x8b, 0x0c, 0x9b, 0x82, 0xb1, 0x8f, 0x9f, 0xf3, 0xc1, 0x52, 0xda, 0xea, 0x33, 0x40, 0x0f, 0x09,
+	0xff, 0x22, 0xb2, 0xeb, 0xe4, 0xc5, 0x98, 0x30, 0x8e, 0x54, 0xa8, 0x3a, 0xb6, 0x4b, 0xb0, 0x6f,
+	0x7f, 0x8f, 0x87, 0x0e, 0x59, 0x97, 0x36, 0xa4, 0x9b, 0x25, 0x3d, 0xb7, 0x86, 0x36, 0xa1, 0x86,
+	0x3d, 0xcf, 0xb1, 0x89, 0x39, 0xb0, 0x5d, 0x93, 0x7c, 0xb7, 0x7e, 0x61, 0x43, 0xba, 0x59, 0xd4,
+	0xab, 0xd1, 0x62, 0x37, 0x58, 0x53, 0xbf, 0x82, 0xb5, 0x1c, 0x3c, 0xf3, 0xa8, 0xcb, 0x08, 0xba,
+	0x07, 0xa5, 0x98, 0x92, 0xc0, 0xae, 0xb4, 0x37, 0xb5, 0xa8, 0x7e, 0xf1, 0x6e, 0xb4, 0x38, 0x68,
+	0x87, 0x30, 0xc3, 0xb7, 0x3d, 0x4e, 0x7d, 0x3d, 0x09, 0x52, 0x09, 0xa0, 0x3e, 0xa7, 0x3e, 0xb6,
+	0xc8, 0x2e, 0x35, 0x49, 0x4c, 0xfb, 0x31, 0x54, 0x59, 0xb8, 0x3a, 0x70, 0xa9, 0x49, 0x22, 0xe8,
+	0xad, 0x39, 0xe8, 0x4c, 0x68, 0x8a, 0xde, 0x29, 0x1e, 0x4e, 0x14, 0x49, 0xaf, 0xb0, 0xd4, 0xa8,
+	0x3e, 0x83, 0xb7, 0x7b, 0xd4, 0xea, 0x73, 0x9f, 0xe0, 0x51, 0x9c, 0xa4, 0x0b, 0xe0, 0x50, 0x6b,
+	0xc0, 0xc4, 0x62, 0x94, 0xe2, 0xc6, 0x5c, 0x8a, 0x24, 0x6c, 0x2e, 0x41, 0xd9, 0x89, 0x4d, 0xea,
+	0x91, 0x04, 0x95, 0x3e, 0xc1, 0x4e, 0x0c, 0xfd, 0x2d, 0x80, 0xe1, 0x8c, 0x19, 0x27, 0xfe, 0xc0,
+	0x36, 0x05, 0x74, 0xad, 0x73, 0x77, 0x3a, 0x51, 0xca, 0xdb, 0xe1, 0x6a, 0x77, 0xe7, 0xf5, 0x44,
+	0xf9, 0x28, 0x73, 0xb6, 0x0e, 0xf0, 0x01, 0xa6, 0xad, 0x30, 0x69, 0xcb, 0x3b, 0xb0, 0x5a, 0xfc,
+	0x95, 0x47, 0x98, 0x96, 0xb8, 0xeb, 0xe5, 0x08, 0xaf, 0x6b, 0x22, 0x13, 0x6a, 0x29, 0xef, 0x00,
+	0x3f, 0xe8, 0xd7, 0x4a, 0xe7, 0xb3, 0xe9, 0x44, 0xa9, 0x24, 0x6c, 0x45, 0x86, 0x5b, 0xa7, 0x67,
+	0xc8, 0x04, 0xe8, 0x95, 0x64, 0x43, 0x5d, 0x53, 0xfd, 0x5d, 0x82, 0x6a, 0xb8, 0xa5, 0xa8, 0xd5,
+	0x77, 0x60, 0x95, 0x71, 0xcc, 0xc7, 0x4c, 0xec, 0xe7, 0x52, 0x7b, 0x63, 0x79, 0xa9, 0xfa, 0xc2,
+	0x4f, 0x8f, 0xfc, 0x11, 0x85, 0x35, 0x07, 0x33, 0x3e, 0x30, 0xe8, 0x68, 0x64, 0x73, 0x4e, 0xcc,
+	0x81, 0xe5, 0x30, 0x37, 0x3c, 0x66, 0x9d, 0x7b, 0xd3, 0x89, 0x52, 0xef, 0x61, 0xc6, 0xb7, 0x63,
+	0xeb, 0xc3, 0x5e, 0x7f, 0xf7, 0xf5, 0x44, 0xd9, 0x3a, 0x9d, 0x7c, 0xe0, 0xa9, 0xd7, 0x9d, 0x5c,
+	0xb0, 0xc3, 0x5c, 0xf5, 0x0f, 0x09, 0x6a, 0x7b, 0x2e, 0xfb, 0x7f, 0x35, 0xe4, 0x11, 0x5c, 0x8a,
+	0xf7, 0xf4, 0xa6, 0x1d, 0x51, 0x7f, 0x90, 0xa0, 0xfa, 0x25, 0xf5, 0x6c, 0x23, 0xae, 0x4f, 0x1f,
+	0x4a, 0x3c, 0xf8, 0x8e, 0xab, 0xb3, 0xd2, 0xb9, 0x33, 0x9d, 0x28, 0x17, 0x85, 0x8f, 0x60, 0xfe,
+	0xe1, 0xe9, 0xcc, 0x23, 0x67, 0xfd, 0xa2, 0x40, 0xea, 0x9a, 0xe8, 0x5d, 0x28, 0x1b, 0x74, 0xe4,
+	0x61, 0x83, 0x93, 0xb0, 0x26, 0x25, 0x3d, 0x5d, 0x50, 0x19, 0xac, 0xdf, 0x37, 0x5e, 0x8c, 0x6d,
+	0x9f, 0xdc, 0x37, 0x47, 0xb6, 0xdb, 0x23, 0x98, 0x25, 0xf7, 0xff, 0x2a, 0xac, 0xee, 0x53, 0xc7,
+	0x24, 0xbe, 0x20, 0x53, 0xd6, 0xa3, 0xaf, 0x40, 0x6e, 0x62, 0x45, 0x15, 0x80, 0x95, 0x76, 0x43,
+	0x0b, 0x25, 0x55, 0x8b, 0x25, 0x55, 0xdb, 0x89, 0x1c, 0x3a, 0xa5, 0xc3, 0x89, 0x52, 0xf8, 0xf9,
+	0x2f, 0x45, 0xd2, 0x93, 0x20, 0xf5, 0x09, 0x34, 0x16, 0x24, 0x8d, 0xea, 0x79, 0x1b, 0x56, 0x9c,
+	0x60, 0x21, 0xd2, 0x82, 0x6b, 0x5a, 0x66, 0x12, 0x68, 0xa9, 0xbf, 0xb8, 0xfe, 0x05, 0x3d, 0xf4,
+	0x55, 0x3f, 0x81, 0x77, 0xbe, 0xc6, 0xdc, 0xd8, 0x9f, 0x55, 0xde, 0x39, 0x55, 0x95, 0x16, 0xa8,
+	0xea, 0x37, 0x70, 0x65, 0x26, 0xf8, 0xac, 0x74, 0xf5, 0x37, 0x09, 0xea, 0x3d, 0x4a, 0x0f, 0xc6,
+	0x9e, 0xb8, 0x24, 0xe7, 0xd9, 0xe6, 0x07, 0x50, 0xcc, 0xdc, 0xe7, 0xf6, 0x74, 0xa2, 0x14, 0xff,
+	0xe5, 0x15, 0x16, 0xf1, 0xea, 0x4f, 0x17, 0x00, 0x65, 0x29, 0x47, 0xa5, 0x38, 0x17, 0xce, 0xff,
+	0xc9, 0x95, 0x0d, 0x2a, 0xe3, 0x04, 0x95, 0x79, 0x2b, 0xad, 0x4c, 0xef, 0x1f, 0x57, 0xa6, 0x27,
+	0x2a, 0x13, 0xc4, 0xb7, 0x7f, 0x2d, 0x41, 0x23, 0x3d, 0x22, 0xf1, 0xe0, 0xef, 0x13, 0xff, 0xa5,
+	0x6d, 0x10, 0xf4, 0x04, 0xd6, 0x74, 0x62, 0xd9, 0x81, 0x18, 0x65, 0xe6, 0x21, 0x52, 0x72, 0xc7,
+	0x77, 0x7e, 0xc8, 0xca, 0x57, 0xe7, 0xae, 0xce, 0xe7, 0xc1, 0x6b, 0x44, 0x2d, 0x20, 0x1d, 0xae,
+	0xec, 0xb9, 0xfe, 0xd9, 0x62, 0xee, 0x40, 0x2d, 0x66, 0x29, 0xba, 0x81, 0x1a, 0x39, 0xac, 0xac,
+	0x1a, 0x9d, 0x80, 0xf2, 0x00, 0x2e, 0xa7, 0xcc, 0xde, 0x00, 0xa7, 0x07, 0xf5, 0x98, 0x4d, 0xd2,
+	0x3d, 0xf4, 0x5e, 0x0e, 0x69, 0xf6, 0xbd, 0x70, 0x02, 0xda, 0x2e, 0xac, 0xa5, 0xac, 0xce, 0x00,
+	0xef, 0x11, 0x5c, 0xde, 0xf3, 0x4c, 0xcc, 0xc9, 0x19, 0x60, 0xe9, 0x50, 0xc9, 0x3c, 0xdc, 0x66,
+	0x3a, 0x38, 0xff, 0x62, 0x94, 0x37, 0x96, 0x3b, 0x84, 0x17, 0x52, 0x2d, 0xa0, 0x4f, 0xa1, 0x18,
+	0x3c, 0x0d, 0xd0, 0x7a, 0xfe, 0x38, 0xa4, 0xf3, 0x56, 0x6e, 0x2c, 0xb0, 0x24, 0xe1, 0xdb, 0xb0,
+	0x1a, 0x4e, 0x32, 0x24, 0xe7, 0xdc, 0x72, 0x23, 0x5b, 0xbe, 0xbe, 0xd0, 0x96, 0x80, 0x98, 0x50,
+	0x9f, 0x53, 0x72, 0xf4, 0x41, 0x5e, 0xb2, 0x97, 0x8c, 0x17, 0x79, 0xeb, 0x34, 0xb7, 0x24, 0xcb,
+	0x53, 0xa8, 0xe5, 0x04, 0x1a, 0xbd, 0x9f, 0x0b, 0x5d, 0xa4, 0xfc, 0xb2, 0x7a, 0x92, 0x4b, 0x8c,
+	0xfc, 0xb1, 0x84, 0x1e, 0x03, 0xa4, 0x72, 0x87, 0x9a, 0x33, 0x0d, 0x9e, 0x91, 0x6e, 0x59, 0x59,
+	0x6a, 0x8f, 0x21, 0x3b, 0x77, 0x0f, 0xa7, 0x4d, 0xe9, 0x68, 0xda, 0x94, 0x7e, 0x3c, 0x6e, 0x16,
+	0x7e, 0x39, 0x6e, 0x4a, 0x47, 0xc7, 0xcd, 0xc2, 0x9f, 0xc7, 0xcd, 0xc2, 0x53, 0x75, 0xa9, 0xdc,
+	0x24, 0xff, 0x88, 0x86, 0xab, 0xe2, 0xf7, 0xed, 0xbf, 0x07, 0x00, 0x80, 0xb3, 0x61, 0xfd, 0x26,
+	0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -531,6 +898,22 @@ type MetadataRepositoryServiceClient interface {
 	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error)
 	Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error)
 	Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error)
+	// AcquireAdminLease acquires or renews the lease for the leader of admin
+	// servers. It does not fail even if another admin server holds the lease;
+	// instead, the response has the current lease.
+	AcquireAdminLease(ctx context.Context, in *AcquireAdminLeaseRequest, opts ...grpc.CallOption) (*AcquireAdminLeaseResponse, error)
+	// WatchMetadata sends the metadata whenever it changes. The first response
+	// has the current metadata if its applied index is greater than that of
+	// the request. Since each response is a snapshot rather than a delta,
+	// changes made in a short period can be coalesced into one response.
+	WatchMetadata(ctx context.Context, in *WatchMetadataRequest, opts ...grpc.CallOption) (MetadataRepositoryService_WatchMetadataClient, error)
+	// LookupGLSN returns the log stream to which the log entry of the given
+	// GLSN was committed, and its LLSN. It works even after the commit history
+	// is trimmed, but the index of trimmed commits keeps at most 65536 ranges
+	// of GLSNs for each topic. It returns an error of ErrTrimmed if the GLSN
+	// has been dropped from the index, and ErrNotExist if the GLSN has not
+	// been committed yet.
+	LookupGLSN(ctx context.Context, in *LookupGLSNRequest, opts ...grpc.CallOption) (*LookupGLSNResponse, error)
 }
 
 type metadataRepositoryServiceClient struct {
@@ -631,6 +1014,56 @@ func (c *metadataRepositoryServiceClient) Unseal(ctx context.Context, in *Unseal
 	return out, nil
 }
 
+func (c *metadataRepositoryServiceClient) AcquireAdminLease(ctx context.Context, in *AcquireAdminLeaseRequest, opts ...grpc.CallOption) (*AcquireAdminLeaseResponse, error) {
+	out := new(AcquireAdminLeaseResponse)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/AcquireAdminLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataRepositoryServiceClient) WatchMetadata(ctx context.Context, in *WatchMetadataRequest, opts ...grpc.CallOption) (MetadataRepositoryService_WatchMetadataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MetadataRepositoryService_serviceDesc.Streams[0], "/varlog.mrpb.MetadataRepositoryService/WatchMetadata", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &metadataRepositoryServiceWatchMetadataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MetadataRepositoryService_WatchMetadataClient interface {
+	Recv() (*WatchMetadataResponse, error)
+	grpc.ClientStream
+}
+
+type metadataRepositoryServiceWatchMetadataClient struct {
+	grpc.ClientStream
+}
+
+func (x *metadataRepositoryServiceWatchMetadataClient) Recv() (*WatchMetadataResponse, error) {
+	m := new(WatchMetadataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *metadataRepositoryServiceClient) LookupGLSN(ctx context.Context, in *LookupGLSNRequest, opts ...grpc.CallOption) (*LookupGLSNResponse, error) {
+	out := new(LookupGLSNResponse)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/LookupGLSN", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MetadataRepositoryServiceServer is the server API for MetadataRepositoryService service.
 type MetadataRepositoryServiceServer interface {
 	RegisterStorageNode(context.Context, *StorageNodeRequest) (*types.Empty, error)
@@ -643,6 +1076,22 @@ type MetadataRepositoryServiceServer interface {
 	GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error)
 	Seal(context.Context, *SealRequest) (*SealResponse, error)
 	Unseal(context.Context, *UnsealRequest) (*UnsealResponse, error)
+	// AcquireAdminLease acquires or renews the lease for the leader of admin
+	// servers. It does not fail even if another admin server holds the lease;
+	// instead, the response has the current lease.
+	AcquireAdminLease(context.Context, *AcquireAdminLeaseRequest) (*AcquireAdminLeaseResponse, error)
+	// WatchMetadata sends the metadata whenever it changes. The first response
+	// has the current metadata if its applied index is greater than that of
+	// the request. Since each response is a snapshot rather than a delta,
+	// changes made in a short period can be coalesced into one response.
+	WatchMetadata(*WatchMetadataRequest, MetadataRepositoryService_WatchMetadataServer) error
+	// LookupGLSN returns the log stream to which the log entry of the given
+	// GLSN was committed, and its LLSN. It works even after the commit history
+	// is trimmed, but the index of trimmed commits keeps at most 65536 ranges
+	// of GLSNs for each topic. It returns an error of ErrTrimmed if the GLSN
+	// has been dropped from the index, and ErrNotExist if the GLSN has not
+	// been committed yet.
+	LookupGLSN(context.Context, *LookupGLSNRequest) (*LookupGLSNResponse, error)
 }
 
 // UnimplementedMetadataRepositoryServiceServer can be embedded to have forward compatible implementations.
@@ -679,6 +1128,15 @@ func (*UnimplementedMetadataRepositoryServiceServer) Seal(ctx context.Context, r
 func (*UnimplementedMetadataRepositoryServiceServer) Unseal(ctx context.Context, req *UnsealRequest) (*UnsealResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unseal not implemented")
 }
+func (*UnimplementedMetadataRepositoryServiceServer) AcquireAdminLease(ctx context.Context, req *AcquireAdminLeaseRequest) (*AcquireAdminLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireAdminLease not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) WatchMetadata(req *WatchMetadataRequest, srv MetadataRepositoryService_WatchMetadataServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMetadata not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) LookupGLSN(ctx context.Context, req *LookupGLSNRequest) (*LookupGLSNResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupGLSN not implemented")
+}
 
 func RegisterMetadataRepositoryServiceServer(s *grpc.Server, srv MetadataRepositoryServiceServer) {
 	s.RegisterService(&_MetadataRepositoryService_serviceDesc, srv)
@@ -864,36 +1322,93 @@ func _MetadataRepositoryService_Unseal_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
-var _MetadataRepositoryService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "varlog.mrpb.MetadataRepositoryService",
-	HandlerType: (*MetadataRepositoryServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterStorageNode",
-			Handler:    _MetadataRepositoryService_RegisterStorageNode_Handler,
-		},
-		{
-			MethodName: "UnregisterStorageNode",
-			Handler:    _MetadataRepositoryService_UnregisterStorageNode_Handler,
-		},
-		{
-			MethodName: "RegisterTopic",
-			Handler:    _MetadataRepositoryService_RegisterTopic_Handler,
-		},
-		{
-			MethodName: "UnregisterTopic",
-			Handler:    _MetadataRepositoryService_UnregisterTopic_Handler,
-		},
-		{
-			MethodName: "RegisterLogStream",
-			Handler:    _MetadataRepositoryService_RegisterLogStream_Handler,
-		},
-		{
-			MethodName: "UnregisterLogStream",
-			Handler:    _MetadataRepositoryService_UnregisterLogStream_Handler,
-		},
-		{
-			MethodName: "UpdateLogStream",
+func _MetadataRepositoryService_AcquireAdminLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireAdminLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).AcquireAdminLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/AcquireAdminLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).AcquireAdminLease(ctx, req.(*AcquireAdminLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_WatchMetadata_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMetadataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetadataRepositoryServiceServer).WatchMetadata(m, &metadataRepositoryServiceWatchMetadataServer{stream})
+}
+
+type MetadataRepositoryService_WatchMetadataServer interface {
+	Send(*WatchMetadataResponse) error
+	grpc.ServerStream
+}
+
+type metadataRepositoryServiceWatchMetadataServer struct {
+	grpc.ServerStream
+}
+
+func (x *metadataRepositoryServiceWatchMetadataServer) Send(m *WatchMetadataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MetadataRepositoryService_LookupGLSN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupGLSNRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).LookupGLSN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/LookupGLSN",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).LookupGLSN(ctx, req.(*LookupGLSNRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MetadataRepositoryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "varlog.mrpb.MetadataRepositoryService",
+	HandlerType: (*MetadataRepositoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterStorageNode",
+			Handler:    _MetadataRepositoryService_RegisterStorageNode_Handler,
+		},
+		{
+			MethodName: "UnregisterStorageNode",
+			Handler:    _MetadataRepositoryService_UnregisterStorageNode_Handler,
+		},
+		{
+			MethodName: "RegisterTopic",
+			Handler:    _MetadataRepositoryService_RegisterTopic_Handler,
+		},
+		{
+			MethodName: "UnregisterTopic",
+			Handler:    _MetadataRepositoryService_UnregisterTopic_Handler,
+		},
+		{
+			MethodName: "RegisterLogStream",
+			Handler:    _MetadataRepositoryService_RegisterLogStream_Handler,
+		},
+		{
+			MethodName: "UnregisterLogStream",
+			Handler:    _MetadataRepositoryService_UnregisterLogStream_Handler,
+		},
+		{
+			MethodName: "UpdateLogStream",
 			Handler:    _MetadataRepositoryService_UpdateLogStream_Handler,
 		},
 		{
@@ -908,8 +1423,22 @@ var _MetadataRepositoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Unseal",
 			Handler:    _MetadataRepositoryService_Unseal_Handler,
 		},
+		{
+			MethodName: "AcquireAdminLease",
+			Handler:    _MetadataRepositoryService_AcquireAdminLease_Handler,
+		},
+		{
+			MethodName: "LookupGLSN",
+			Handler:    _MetadataRepositoryService_LookupGLSN_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchMetadata",
+			Handler:       _MetadataRepositoryService_WatchMetadata_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/mrpb/metadata_repository.proto",
 }
 
@@ -933,6 +1462,21 @@ func (m *GetMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AppliedIndex != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.AppliedIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Linearizable {
+		i--
+		if m.Linearizable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1188,6 +1732,221 @@ func (m *TopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Compacted {
+		i--
+		if m.Compacted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AcquireAdminLeaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcquireAdminLeaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcquireAdminLeaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintMetadataRepository(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AcquireAdminLeaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcquireAdminLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcquireAdminLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Lease.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AppliedIndex != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.AppliedIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LookupGLSNRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LookupGLSNRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LookupGLSNRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GLSN != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.GLSN))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LookupGLSNResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LookupGLSNResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LookupGLSNResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LLSN != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.LLSN))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.TopicID != 0 {
 		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.TopicID))
 		i--
@@ -1213,6 +1972,12 @@ func (m *GetMetadataRequest) ProtoSize() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Linearizable {
+		n += 2
+	}
+	if m.AppliedIndex != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.AppliedIndex))
+	}
 	return n
 }
 
@@ -1321,16 +2086,714 @@ func (m *TopicRequest) ProtoSize() (n int) {
 	if m.TopicID != 0 {
 		n += 1 + sovMetadataRepository(uint64(m.TopicID))
 	}
+	if m.Compacted {
+		n += 2
+	}
 	return n
 }
 
-func sovMetadataRepository(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMetadataRepository(x uint64) (n int) {
-	return sovMetadataRepository(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *AcquireAdminLeaseRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovMetadataRepository(uint64(l))
+	return n
+}
+
+func (m *AcquireAdminLeaseResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Lease.ProtoSize()
+	n += 1 + l + sovMetadataRepository(uint64(l))
+	return n
+}
+
+func (m *WatchMetadataRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppliedIndex != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.AppliedIndex))
+	}
+	return n
+}
+
+func (m *WatchMetadataResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.ProtoSize()
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	return n
+}
+
+func (m *LookupGLSNRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.TopicID))
+	}
+	if m.GLSN != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.GLSN))
+	}
+	return n
+}
+
+func (m *LookupGLSNResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.LogStreamID))
+	}
+	if m.LLSN != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.LLSN))
+	}
+	return n
+}
+
+func sovMetadataRepository(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMetadataRepository(x uint64) (n int) {
+	return sovMetadataRepository(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Linearizable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Linearizable = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedIndex", wireType)
+			}
+			m.AppliedIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppliedIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &varlogpb.MetadataDescriptor{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageNode == nil {
+				m.StorageNode = &varlogpb.StorageNodeDescriptor{}
+			}
+			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LogStream == nil {
+				m.LogStream = &varlogpb.LogStreamDescriptor{}
+			}
+			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= varlogpb.LogStreamStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCommittedGLSN", wireType)
+			}
+			m.LastCommittedGLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCommittedGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsealRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1349,16 +2812,35 @@ func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetMetadataRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsealResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= varlogpb.LogStreamStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
@@ -1380,7 +2862,7 @@ func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *TopicRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1403,17 +2885,17 @@ func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			var msglen int
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -1423,28 +2905,31 @@ func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetadataRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetadataRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = &varlogpb.MetadataDescriptor{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compacted", wireType)
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Compacted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
@@ -1466,7 +2951,7 @@ func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StorageNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *AcquireAdminLeaseRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1489,15 +2974,47 @@ func (m *StorageNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorageNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AcquireAdminLeaseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AcquireAdminLeaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1524,10 +3041,7 @@ func (m *StorageNodeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StorageNode == nil {
-				m.StorageNode = &varlogpb.StorageNodeDescriptor{}
-			}
-			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1552,7 +3066,7 @@ func (m *StorageNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *AcquireAdminLeaseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1575,15 +3089,15 @@ func (m *LogStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AcquireAdminLeaseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AcquireAdminLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1610,10 +3124,7 @@ func (m *LogStreamRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LogStream == nil {
-				m.LogStream = &varlogpb.LogStreamDescriptor{}
-			}
-			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1638,7 +3149,7 @@ func (m *LogStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SealRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1661,36 +3172,17 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SealRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
-			}
-			m.ClusterID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetadataRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedIndex", wireType)
 			}
-			m.LogStreamID = 0
+			m.AppliedIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -1700,7 +3192,7 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				m.AppliedIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1726,7 +3218,7 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SealResponse) Unmarshal(dAtA []byte) error {
+func (m *WatchMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1749,17 +3241,17 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SealResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -1769,30 +3261,28 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= varlogpb.LogStreamStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCommittedGLSN", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
 			}
-			m.LastCommittedGLSN = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetadataRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastCommittedGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &varlogpb.MetadataDescriptor{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
@@ -1814,7 +3304,7 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
+func (m *LookupGLSNRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1837,17 +3327,17 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsealRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LookupGLSNRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LookupGLSNRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			m.ClusterID = 0
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -1857,16 +3347,16 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSN", wireType)
 			}
-			m.LogStreamID = 0
+			m.GLSN = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -1876,7 +3366,7 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				m.GLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1902,7 +3392,7 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
+func (m *LookupGLSNResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1925,17 +3415,17 @@ func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsealResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LookupGLSNResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LookupGLSNResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			m.Status = 0
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -1945,66 +3435,35 @@ func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= varlogpb.LogStreamStatus(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMetadataRepository
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TopicRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetadataRepository
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TopicRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LLSN", wireType)
 			}
-			m.TopicID = 0
+			m.LLSN = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -2014,7 +3473,7 @@ func (m *TopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				m.LLSN |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -58,6 +58,26 @@ func (mr *MockManagementClientMockRecorder) AddPeer(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPeer", reflect.TypeOf((*MockManagementClient)(nil).AddPeer), varargs...)
 }
 
+// Backup mocks base method.
+func (m *MockManagementClient) Backup(arg0 context.Context, arg1 *mrpb.BackupRequest, arg2 ...grpc.CallOption) (*mrpb.BackupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Backup", varargs...)
+	ret0, _ := ret[0].(*mrpb.BackupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockManagementClientMockRecorder) Backup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockManagementClient)(nil).Backup), varargs...)
+}
+
 // GetClusterInfo mocks base method.
 func (m *MockManagementClient) GetClusterInfo(arg0 context.Context, arg1 *mrpb.GetClusterInfoRequest, arg2 ...grpc.CallOption) (*mrpb.GetClusterInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -98,6 +118,46 @@ func (mr *MockManagementClientMockRecorder) RemovePeer(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePeer", reflect.TypeOf((*MockManagementClient)(nil).RemovePeer), varargs...)
 }
 
+// Restore mocks base method.
+func (m *MockManagementClient) Restore(arg0 context.Context, arg1 *mrpb.RestoreRequest, arg2 ...grpc.CallOption) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Restore", varargs...)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockManagementClientMockRecorder) Restore(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockManagementClient)(nil).Restore), varargs...)
+}
+
+// TransferLeadership mocks base method.
+func (m *MockManagementClient) TransferLeadership(arg0 context.Context, arg1 *mrpb.TransferLeadershipRequest, arg2 ...grpc.CallOption) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TransferLeadership", varargs...)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferLeadership indicates an expected call of TransferLeadership.
+func (mr *MockManagementClientMockRecorder) TransferLeadership(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeadership", reflect.TypeOf((*MockManagementClient)(nil).TransferLeadership), varargs...)
+}
+
 // MockManagementServer is a mock of ManagementServer interface.
 type MockManagementServer struct {
 	ctrl     *gomock.Controller
@@ -136,6 +196,21 @@ func (mr *MockManagementServerMockRecorder) AddPeer(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPeer", reflect.TypeOf((*MockManagementServer)(nil).AddPeer), arg0, arg1)
 }
 
+// Backup mocks base method.
+func (m *MockManagementServer) Backup(arg0 context.Context, arg1 *mrpb.BackupRequest) (*mrpb.BackupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backup", arg0, arg1)
+	ret0, _ := ret[0].(*mrpb.BackupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockManagementServerMockRecorder) Backup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockManagementServer)(nil).Backup), arg0, arg1)
+}
+
 // GetClusterInfo mocks base method.
 func (m *MockManagementServer) GetClusterInfo(arg0 context.Context, arg1 *mrpb.GetClusterInfoRequest) (*mrpb.GetClusterInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +241,36 @@ func (mr *MockManagementServerMockRecorder) RemovePeer(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePeer", reflect.TypeOf((*MockManagementServer)(nil).RemovePeer), arg0, arg1)
 }
 
+// Restore mocks base method.
+func (m *MockManagementServer) Restore(arg0 context.Context, arg1 *mrpb.RestoreRequest) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockManagementServerMockRecorder) Restore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockManagementServer)(nil).Restore), arg0, arg1)
+}
+
+// TransferLeadership mocks base method.
+func (m *MockManagementServer) TransferLeadership(arg0 context.Context, arg1 *mrpb.TransferLeadershipRequest) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferLeadership", arg0, arg1)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferLeadership indicates an expected call of TransferLeadership.
+func (mr *MockManagementServerMockRecorder) TransferLeadership(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeadership", reflect.TypeOf((*MockManagementServer)(nil).TransferLeadership), arg0, arg1)
+}
+
 // MockMetadataRepositoryServiceClient is a mock of MetadataRepositoryServiceClient interface.
 type MockMetadataRepositoryServiceClient struct {
 	ctrl     *gomock.Controller
@@ -189,6 +294,26 @@ func (m *MockMetadataRepositoryServiceClient) EXPECT() *MockMetadataRepositorySe
 	return m.recorder
 }
 
+// AcquireAdminLease mocks base method.
+func (m *MockMetadataRepositoryServiceClient) AcquireAdminLease(arg0 context.Context, arg1 *mrpb.AcquireAdminLeaseRequest, arg2 ...grpc.CallOption) (*mrpb.AcquireAdminLeaseResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AcquireAdminLease", varargs...)
+	ret0, _ := ret[0].(*mrpb.AcquireAdminLeaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireAdminLease indicates an expected call of AcquireAdminLease.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) AcquireAdminLease(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireAdminLease", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).AcquireAdminLease), varargs...)
+}
+
 // GetMetadata mocks base method.
 func (m *MockMetadataRepositoryServiceClient) GetMetadata(arg0 context.Context, arg1 *mrpb.GetMetadataRequest, arg2 ...grpc.CallOption) (*mrpb.GetMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -209,6 +334,26 @@ func (mr *MockMetadataRepositoryServiceClientMockRecorder) GetMetadata(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).GetMetadata), varargs...)
 }
 
+// LookupGLSN mocks base method.
+func (m *MockMetadataRepositoryServiceClient) LookupGLSN(arg0 context.Context, arg1 *mrpb.LookupGLSNRequest, arg2 ...grpc.CallOption) (*mrpb.LookupGLSNResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LookupGLSN", varargs...)
+	ret0, _ := ret[0].(*mrpb.LookupGLSNResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupGLSN indicates an expected call of LookupGLSN.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) LookupGLSN(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupGLSN", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).LookupGLSN), varargs...)
+}
+
 // RegisterLogStream mocks base method.
 func (m *MockMetadataRepositoryServiceClient) RegisterLogStream(arg0 context.Context, arg1 *mrpb.LogStreamRequest, arg2 ...grpc.CallOption) (*types.Empty, error) {
 	m.ctrl.T.Helper()
@@ -389,6 +534,26 @@ func (mr *MockMetadataRepositoryServiceClientMockRecorder) UpdateLogStream(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLogStream", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).UpdateLogStream), varargs...)
 }
 
+// WatchMetadata mocks base method.
+func (m *MockMetadataRepositoryServiceClient) WatchMetadata(arg0 context.Context, arg1 *mrpb.WatchMetadataRequest, arg2 ...grpc.CallOption) (mrpb.MetadataRepositoryService_WatchMetadataClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchMetadata", varargs...)
+	ret0, _ := ret[0].(mrpb.MetadataRepositoryService_WatchMetadataClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchMetadata indicates an expected call of WatchMetadata.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) WatchMetadata(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchMetadata", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).WatchMetadata), varargs...)
+}
+
 // MockMetadataRepositoryServiceServer is a mock of MetadataRepositoryServiceServer interface.
 type MockMetadataRepositoryServiceServer struct {
 	ctrl     *gomock.Controller
@@ -412,6 +577,21 @@ func (m *MockMetadataRepositoryServiceServer) EXPECT() *MockMetadataRepositorySe
 	return m.recorder
 }
 
+// AcquireAdminLease mocks base method.
+func (m *MockMetadataRepositoryServiceServer) AcquireAdminLease(arg0 context.Context, arg1 *mrpb.AcquireAdminLeaseRequest) (*mrpb.AcquireAdminLeaseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireAdminLease", arg0, arg1)
+	ret0, _ := ret[0].(*mrpb.AcquireAdminLeaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireAdminLease indicates an expected call of AcquireAdminLease.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) AcquireAdminLease(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireAdminLease", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).AcquireAdminLease), arg0, arg1)
+}
+
 // GetMetadata mocks base method.
 func (m *MockMetadataRepositoryServiceServer) GetMetadata(arg0 context.Context, arg1 *mrpb.GetMetadataRequest) (*mrpb.GetMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -427,6 +607,21 @@ func (mr *MockMetadataRepositoryServiceServerMockRecorder) GetMetadata(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).GetMetadata), arg0, arg1)
 }
 
+// LookupGLSN mocks base method.
+func (m *MockMetadataRepositoryServiceServer) LookupGLSN(arg0 context.Context, arg1 *mrpb.LookupGLSNRequest) (*mrpb.LookupGLSNResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupGLSN", arg0, arg1)
+	ret0, _ := ret[0].(*mrpb.LookupGLSNResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupGLSN indicates an expected call of LookupGLSN.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) LookupGLSN(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupGLSN", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).LookupGLSN), arg0, arg1)
+}
+
 // RegisterLogStream mocks base method.
 func (m *MockMetadataRepositoryServiceServer) RegisterLogStream(arg0 context.Context, arg1 *mrpb.LogStreamRequest) (*types.Empty, error) {
 	m.ctrl.T.Helper()
@@ -561,3 +756,17 @@ func (mr *MockMetadataRepositoryServiceServerMockRecorder) UpdateLogStream(arg0,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLogStream", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).UpdateLogStream), arg0, arg1)
 }
+
+// WatchMetadata mocks base method.
+func (m *MockMetadataRepositoryServiceServer) WatchMetadata(arg0 *mrpb.WatchMetadataRequest, arg1 mrpb.MetadataRepositoryService_WatchMetadataServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchMetadata", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchMetadata indicates an expected call of WatchMetadata.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) WatchMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchMetadata", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).WatchMetadata), arg0, arg1)
+}
@@ -12,6 +12,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 
@@ -121,7 +122,8 @@ func (m *UnregisterStorageNode) GetStorageNodeID() github_com_kakao_varlog_pkg_t
 }
 
 type RegisterTopic struct {
-	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	TopicID   github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	Compacted bool                                      `protobuf:"varint,2,opt,name=compacted,proto3" json:"compacted,omitempty"`
 }
 
 func (m *RegisterTopic) Reset()         { *m = RegisterTopic{} }
@@ -164,6 +166,13 @@ func (m *RegisterTopic) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
 	return 0
 }
 
+func (m *RegisterTopic) GetCompacted() bool {
+	if m != nil {
+		return m.Compacted
+	}
+	return false
+}
+
 type UnregisterTopic struct {
 	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 }
@@ -792,18 +801,85 @@ func (m *RecoverStateMachine) GetStateMachine() *MetadataRepositoryDescriptor {
 	return nil
 }
 
+type AcquireAdminLease struct {
+	Holder   string        `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+	// expired_index is the applied index of the lease that the proposer
+	// regarded as expired. The lease is granted to another holder only if it
+	// has not been renewed since then.
+	ExpiredIndex uint64 `protobuf:"varint,4,opt,name=expired_index,json=expiredIndex,proto3" json:"expired_index,omitempty"`
+}
+
+func (m *AcquireAdminLease) Reset()         { *m = AcquireAdminLease{} }
+func (m *AcquireAdminLease) String() string { return proto.CompactTextString(m) }
+func (*AcquireAdminLease) ProtoMessage()    {}
+func (*AcquireAdminLease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9661c8402dd472d1, []int{16}
+}
+func (m *AcquireAdminLease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcquireAdminLease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcquireAdminLease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AcquireAdminLease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcquireAdminLease.Merge(m, src)
+}
+func (m *AcquireAdminLease) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AcquireAdminLease) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcquireAdminLease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcquireAdminLease proto.InternalMessageInfo
+
+func (m *AcquireAdminLease) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *AcquireAdminLease) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *AcquireAdminLease) GetExpiredIndex() uint64 {
+	if m != nil {
+		return m.ExpiredIndex
+	}
+	return 0
+}
+
 type RaftEntry struct {
 	NodeIndex    uint64            `protobuf:"varint,1,opt,name=node_index,json=nodeIndex,proto3" json:"node_index,omitempty"`
 	RequestIndex uint64            `protobuf:"varint,2,opt,name=request_index,json=requestIndex,proto3" json:"request_index,omitempty"`
 	AppliedIndex uint64            `protobuf:"varint,3,opt,name=applied_index,json=appliedIndex,proto3" json:"applied_index,omitempty"`
 	Request      RaftEntry_Request `protobuf:"bytes,4,opt,name=request,proto3" json:"request"`
+	// admin_lease_epoch is the epoch of the admin lease sent with the request.
+	// The request is rejected if it is older than the epoch of the current
+	// lease. Zero means that the request is not fenced.
+	AdminLeaseEpoch uint64 `protobuf:"varint,5,opt,name=admin_lease_epoch,json=adminLeaseEpoch,proto3" json:"admin_lease_epoch,omitempty"`
 }
 
 func (m *RaftEntry) Reset()         { *m = RaftEntry{} }
 func (m *RaftEntry) String() string { return proto.CompactTextString(m) }
 func (*RaftEntry) ProtoMessage()    {}
 func (*RaftEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9661c8402dd472d1, []int{16}
+	return fileDescriptor_9661c8402dd472d1, []int{17}
 }
 func (m *RaftEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -860,6 +936,13 @@ func (m *RaftEntry) GetRequest() RaftEntry_Request {
 	return RaftEntry_Request{}
 }
 
+func (m *RaftEntry) GetAdminLeaseEpoch() uint64 {
+	if m != nil {
+		return m.AdminLeaseEpoch
+	}
+	return 0
+}
+
 type RaftEntry_Request struct {
 	RegisterStorageNode   *RegisterStorageNode   `protobuf:"bytes,1,opt,name=register_storage_node,json=registerStorageNode,proto3" json:"register_storage_node,omitempty"`
 	UnregisterStorageNode *UnregisterStorageNode `protobuf:"bytes,2,opt,name=unregister_storage_node,json=unregisterStorageNode,proto3" json:"unregister_storage_node,omitempty"`
@@ -876,13 +959,14 @@ type RaftEntry_Request struct {
 	RecoverStateMachine   *RecoverStateMachine   `protobuf:"bytes,13,opt,name=recover_state_machine,json=recoverStateMachine,proto3" json:"recover_state_machine,omitempty"`
 	RegisterTopic         *RegisterTopic         `protobuf:"bytes,14,opt,name=register_topic,json=registerTopic,proto3" json:"register_topic,omitempty"`
 	UnregisterTopic       *UnregisterTopic       `protobuf:"bytes,15,opt,name=unregister_topic,json=unregisterTopic,proto3" json:"unregister_topic,omitempty"`
+	AcquireAdminLease     *AcquireAdminLease     `protobuf:"bytes,16,opt,name=acquire_admin_lease,json=acquireAdminLease,proto3" json:"acquire_admin_lease,omitempty"`
 }
 
 func (m *RaftEntry_Request) Reset()         { *m = RaftEntry_Request{} }
 func (m *RaftEntry_Request) String() string { return proto.CompactTextString(m) }
 func (*RaftEntry_Request) ProtoMessage()    {}
 func (*RaftEntry_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9661c8402dd472d1, []int{16, 0}
+	return fileDescriptor_9661c8402dd472d1, []int{17, 0}
 }
 func (m *RaftEntry_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1016,6 +1100,13 @@ func (m *RaftEntry_Request) GetUnregisterTopic() *UnregisterTopic {
 	return nil
 }
 
+func (m *RaftEntry_Request) GetAcquireAdminLease() *AcquireAdminLease {
+	if m != nil {
+		return m.AcquireAdminLease
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RegisterStorageNode)(nil), "varlog.mrpb.RegisterStorageNode")
 	proto.RegisterType((*UnregisterStorageNode)(nil), "varlog.mrpb.UnregisterStorageNode")
@@ -1033,6 +1124,7 @@ func init() {
 	proto.RegisterType((*RemovePeer)(nil), "varlog.mrpb.RemovePeer")
 	proto.RegisterType((*Endpoint)(nil), "varlog.mrpb.Endpoint")
 	proto.RegisterType((*RecoverStateMachine)(nil), "varlog.mrpb.RecoverStateMachine")
+	proto.RegisterType((*AcquireAdminLease)(nil), "varlog.mrpb.AcquireAdminLease")
 	proto.RegisterType((*RaftEntry)(nil), "varlog.mrpb.RaftEntry")
 	proto.RegisterType((*RaftEntry_Request)(nil), "varlog.mrpb.RaftEntry.Request")
 }
@@ -1040,76 +1132,85 @@ func init() {
 func init() { proto.RegisterFile("proto/mrpb/raft_entry.proto", fileDescriptor_9661c8402dd472d1) }
 
 var fileDescriptor_9661c8402dd472d1 = []byte{
-	// 1097 bytes of a gzipped FileDescriptorProto
+	// 1247 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0xdc, 0x44,
-	0x14, 0x8f, 0xbb, 0xdb, 0xfd, 0x78, 0x9b, 0xcd, 0x36, 0x0e, 0x51, 0xac, 0x00, 0xbb, 0x91, 0x0b,
-	0x28, 0x15, 0xc4, 0x16, 0x20, 0xa1, 0x0a, 0x21, 0x44, 0x43, 0xaa, 0x12, 0xa9, 0x4d, 0xd1, 0x24,
-	0xb9, 0x54, 0x80, 0xe5, 0x5d, 0x4f, 0x5c, 0x2b, 0x6b, 0x8f, 0x19, 0x8f, 0x23, 0x2a, 0xce, 0x9c,
-	0xb8, 0xf4, 0xc6, 0xb5, 0xe2, 0xaf, 0x89, 0xc4, 0xa5, 0xe2, 0xc4, 0x69, 0x41, 0x9b, 0xff, 0x82,
-	0x13, 0x9a, 0x0f, 0x7f, 0x65, 0x8d, 0x72, 0x21, 0x11, 0xb7, 0xd9, 0x99, 0xdf, 0xfb, 0xf8, 0x8d,
-	0xdf, 0xfc, 0xde, 0x5b, 0x78, 0x33, 0xa6, 0x84, 0x11, 0x3b, 0xa4, 0xf1, 0xd8, 0xa6, 0xee, 0x09,
-	0x73, 0x70, 0xc4, 0xe8, 0x0b, 0x4b, 0xec, 0xea, 0xbd, 0x33, 0x97, 0x4e, 0x89, 0x6f, 0xf1, 0xd3,
-	0xcd, 0x91, 0x4f, 0x88, 0x3f, 0xc5, 0xb6, 0x38, 0x1a, 0xa7, 0x27, 0x36, 0x0b, 0x42, 0x9c, 0x30,
-	0x37, 0x8c, 0x25, 0x7a, 0x73, 0xc7, 0x0f, 0xd8, 0xf3, 0x74, 0x6c, 0x4d, 0x48, 0x68, 0xfb, 0xc4,
-	0x27, 0x05, 0x92, 0xff, 0x92, 0x71, 0xf8, 0x4a, 0xc1, 0x37, 0xa4, 0xf3, 0x78, 0x6c, 0x87, 0x98,
-	0xb9, 0x9e, 0xcb, 0x5c, 0x75, 0x30, 0x4c, 0xa2, 0x78, 0x6c, 0x4f, 0x89, 0xef, 0x24, 0x8c, 0x62,
-	0x37, 0x74, 0x28, 0x8e, 0x09, 0x65, 0x98, 0xaa, 0xf3, 0xbb, 0x45, 0xb2, 0x99, 0xa5, 0x80, 0x24,
-	0x01, 0x23, 0x59, 0xea, 0xe6, 0x09, 0xac, 0x21, 0xec, 0x07, 0x09, 0xc3, 0xf4, 0x90, 0x11, 0xea,
-	0xfa, 0xf8, 0x80, 0x78, 0x58, 0x7f, 0x0a, 0xcb, 0x89, 0xfc, 0xe9, 0x44, 0xc4, 0xc3, 0x86, 0xb6,
-	0xa5, 0x6d, 0xf7, 0x3e, 0x7a, 0xcf, 0x52, 0x44, 0xb3, 0x94, 0xac, 0x92, 0xcd, 0x1e, 0x4e, 0x26,
-	0x34, 0x88, 0x19, 0xa1, 0xbb, 0xcd, 0xf3, 0xd9, 0x48, 0x43, 0xbd, 0xa4, 0x38, 0x34, 0x7f, 0xd2,
-	0x60, 0xfd, 0x38, 0xa2, 0x35, 0xa1, 0xa6, 0x30, 0x28, 0x87, 0x72, 0x02, 0x4f, 0x44, 0xbb, 0xbd,
-	0xbb, 0x37, 0x9f, 0x8d, 0xfa, 0x25, 0xe4, 0xfe, 0xde, 0xdf, 0xb3, 0x91, 0x5d, 0xba, 0xbc, 0x53,
-	0xf7, 0xd4, 0x25, 0xb6, 0xcc, 0xc5, 0x8e, 0x4f, 0x7d, 0x9b, 0xbd, 0x88, 0x71, 0x62, 0x55, 0x4c,
-	0x50, 0xbf, 0x94, 0xc5, 0xbe, 0x67, 0x7a, 0xd0, 0xcf, 0xf8, 0x1e, 0x91, 0x38, 0x98, 0xe8, 0x87,
-	0xd0, 0x61, 0x7c, 0x51, 0xc4, 0xbd, 0x3f, 0x9f, 0x8d, 0xda, 0xe2, 0x50, 0x44, 0xbc, 0x77, 0x75,
-	0x44, 0x05, 0x46, 0x6d, 0xe1, 0x69, 0xdf, 0x33, 0x4f, 0x60, 0x50, 0x90, 0xbd, 0xc6, 0x38, 0xdf,
-	0xc1, 0x6a, 0xc6, 0xe6, 0x31, 0xf1, 0x0f, 0x45, 0x19, 0xe8, 0xfb, 0x00, 0x45, 0x51, 0xa8, 0x2f,
-	0xf7, 0xce, 0xc2, 0x97, 0xcb, 0xf1, 0x0b, 0xdf, 0xad, 0x3b, 0xcd, 0x8e, 0xcc, 0x1f, 0x61, 0xad,
-	0xe0, 0x51, 0x44, 0xf0, 0xa0, 0x5f, 0x2a, 0xbb, 0x9c, 0xd0, 0x17, 0xf3, 0xd9, 0xa8, 0x97, 0xa3,
-	0x04, 0xa9, 0x9d, 0xab, 0x49, 0x95, 0x0c, 0x50, 0x2f, 0x0f, 0xbd, 0xef, 0x99, 0xdf, 0xc0, 0xe0,
-	0x38, 0xf6, 0x5c, 0x86, 0xaf, 0x85, 0xda, 0x6f, 0x1a, 0xb4, 0x90, 0x78, 0x30, 0x37, 0x5b, 0x81,
-	0xfa, 0x21, 0x0c, 0xd2, 0x68, 0x42, 0xc2, 0x30, 0x60, 0xea, 0xc5, 0x1a, 0x8d, 0xad, 0x46, 0x99,
-	0x08, 0x7f, 0xd7, 0x05, 0x89, 0x63, 0x05, 0x96, 0xc9, 0x0a, 0x22, 0x4b, 0x68, 0x25, 0xad, 0xec,
-	0x9a, 0xbf, 0x6b, 0xd0, 0x96, 0xcb, 0x44, 0x7f, 0x0a, 0xed, 0x32, 0x8d, 0xe6, 0xee, 0x27, 0xf3,
-	0xd9, 0xa8, 0x95, 0xe7, 0xbf, 0x7d, 0x75, 0xfe, 0x2a, 0xf1, 0x56, 0x24, 0x33, 0x7e, 0x04, 0xcb,
-	0x13, 0x8a, 0x5d, 0x86, 0x3d, 0x87, 0x6b, 0x99, 0x71, 0x4b, 0xdc, 0xfb, 0xa6, 0x25, 0x85, 0xce,
-	0xca, 0xe4, 0xcb, 0x3a, 0xca, 0x84, 0x6e, 0xb7, 0xc3, 0x93, 0x7c, 0xf9, 0x27, 0x17, 0x01, 0x65,
-	0xc9, 0xcf, 0xf4, 0x1d, 0x68, 0x4b, 0xc6, 0x89, 0xa2, 0xbc, 0x66, 0x95, 0x94, 0xd3, 0x92, 0x04,
-	0x50, 0x86, 0x31, 0x7f, 0xd5, 0xa0, 0xf5, 0xa5, 0x60, 0xf9, 0xff, 0xe5, 0x64, 0x4e, 0xa1, 0x79,
-	0x88, 0xdd, 0xe9, 0x0d, 0xbd, 0x89, 0x08, 0x5a, 0xc7, 0x51, 0x72, 0x73, 0xf1, 0x7e, 0xd6, 0xa0,
-	0xfd, 0xc0, 0xf3, 0xbe, 0xc6, 0x98, 0xfe, 0xf7, 0xdf, 0xe0, 0x0e, 0x34, 0x52, 0x3a, 0x15, 0x57,
-	0xdf, 0x45, 0x7c, 0xa9, 0xbf, 0x0d, 0x10, 0x24, 0xce, 0x14, 0xbb, 0x34, 0xc2, 0xd4, 0x68, 0x6c,
-	0x69, 0xdb, 0x1d, 0xd4, 0x0d, 0x92, 0xc7, 0x72, 0xc3, 0xfc, 0x16, 0x00, 0xe1, 0x90, 0x9c, 0xe1,
-	0x6b, 0xc9, 0xc7, 0x0c, 0xa1, 0xf3, 0x30, 0xf2, 0x62, 0x12, 0x44, 0xec, 0x06, 0xc8, 0x9a, 0x98,
-	0xb7, 0xde, 0x09, 0x39, 0xe3, 0xed, 0xd0, 0x65, 0xf8, 0x89, 0x3b, 0x79, 0x1e, 0x44, 0x58, 0x3f,
-	0x80, 0x7e, 0xc2, 0x7f, 0x3b, 0xa1, 0xdc, 0x50, 0x32, 0x77, 0xaf, 0xf2, 0x54, 0x9e, 0xa8, 0x86,
-	0x8e, 0xf2, 0x7e, 0x5e, 0x68, 0x1d, 0x5a, 0x4e, 0x4a, 0xfe, 0xcc, 0x5f, 0xba, 0xd0, 0x45, 0xee,
-	0x09, 0x7b, 0xc8, 0x07, 0x16, 0x7e, 0xc3, 0x92, 0x57, 0xe4, 0xe1, 0x1f, 0x24, 0x35, 0xd4, 0x15,
-	0x29, 0xf2, 0x0d, 0xfd, 0x2e, 0xf4, 0x29, 0xfe, 0x3e, 0xc5, 0x09, 0x53, 0x88, 0x5b, 0x02, 0xb1,
-	0xac, 0x36, 0x73, 0x90, 0x1b, 0xc7, 0xd3, 0x00, 0x7b, 0x0a, 0xd4, 0x90, 0x20, 0xb5, 0x29, 0x41,
-	0x9f, 0xf3, 0xb7, 0x2e, 0x8c, 0x8c, 0xa6, 0x20, 0x30, 0xac, 0xbe, 0xf5, 0x2c, 0x23, 0x0b, 0x49,
-	0x94, 0x12, 0xb6, 0xcc, 0x68, 0xf3, 0xaf, 0x36, 0x57, 0x34, 0xb1, 0xd6, 0x8f, 0x60, 0x3d, 0x6b,
-	0x42, 0x4e, 0xcd, 0x58, 0xb2, 0x75, 0x49, 0x45, 0x16, 0x66, 0x0c, 0xb4, 0x56, 0x37, 0x78, 0x3c,
-	0x83, 0x8d, 0x34, 0xaa, 0xf7, 0x2b, 0xd5, 0xc0, 0xac, 0xf8, 0xad, 0x9d, 0x5e, 0xd0, 0x7a, 0x5a,
-	0x3b, 0xd4, 0x1c, 0x40, 0x1e, 0xd2, 0x29, 0x75, 0xac, 0x46, 0xdd, 0x4d, 0x5c, 0x6e, 0xaf, 0x68,
-	0x75, 0xb1, 0xe3, 0x1e, 0x41, 0x29, 0x50, 0xd9, 0x63, 0xb3, 0xe6, 0x06, 0x6a, 0x5a, 0x36, 0x5a,
-	0x4b, 0x6b, 0xfa, 0xf8, 0x57, 0xb0, 0x9a, 0x8a, 0x0e, 0x5b, 0xf6, 0x78, 0x5b, 0x78, 0x7c, 0xab,
-	0xea, 0xb1, 0xda, 0x87, 0xd1, 0x20, 0xbd, 0xd4, 0x98, 0x3f, 0x80, 0x96, 0xea, 0x65, 0x2d, 0x61,
-	0xfe, 0x46, 0x8d, 0xb0, 0x27, 0x48, 0x61, 0xf4, 0xf7, 0xa1, 0x25, 0xbb, 0x97, 0xd1, 0x16, 0xe8,
-	0x6a, 0x1b, 0x90, 0x92, 0x8f, 0x14, 0x44, 0x7f, 0x17, 0x9a, 0x5c, 0xf0, 0x8c, 0x8e, 0x80, 0xae,
-	0x56, 0xa0, 0x5c, 0x79, 0x91, 0x38, 0xe6, 0x3e, 0x53, 0xa1, 0x8c, 0x46, 0xb7, 0xc6, 0xa7, 0x14,
-	0x4d, 0xa4, 0x20, 0xba, 0x0d, 0x1d, 0xd7, 0xf3, 0x9c, 0x18, 0x63, 0x6a, 0x40, 0x4d, 0xc2, 0x4a,
-	0xf2, 0x50, 0xdb, 0x55, 0xda, 0x77, 0x1f, 0x7a, 0x54, 0x28, 0x8f, 0xb4, 0xe9, 0x09, 0x9b, 0x8d,
-	0x4b, 0x24, 0x33, 0x65, 0x42, 0x40, 0x0b, 0x95, 0xfa, 0x10, 0x3a, 0x58, 0x89, 0x8a, 0xb1, 0x2c,
-	0xcc, 0xd6, 0x2b, 0x66, 0x99, 0xe2, 0xa0, 0x1c, 0x26, 0xcb, 0x5d, 0x08, 0x83, 0x53, 0x55, 0x82,
-	0x7e, 0x6d, 0xb9, 0x2f, 0x48, 0x08, 0x2f, 0xf7, 0x45, 0x5d, 0x79, 0x00, 0x2b, 0x79, 0x01, 0x89,
-	0xf9, 0xd1, 0x58, 0x51, 0x3d, 0xaf, 0xae, 0x1a, 0xc5, 0xa8, 0x89, 0xfa, 0xd5, 0x19, 0xf6, 0x11,
-	0xdc, 0x29, 0x55, 0xa1, 0x74, 0x32, 0xa8, 0x2b, 0x97, 0xea, 0xec, 0x8b, 0x06, 0x69, 0x75, 0xe3,
-	0xd3, 0xe6, 0xf9, 0xab, 0x91, 0xb6, 0xfb, 0xd9, 0xf9, 0x7c, 0xa8, 0xbd, 0x9e, 0x0f, 0xb5, 0x97,
-	0x17, 0xc3, 0xa5, 0x57, 0x17, 0x43, 0xed, 0xf5, 0xc5, 0x70, 0xe9, 0x8f, 0x8b, 0xe1, 0xd2, 0x33,
-	0xf3, 0x5f, 0xe5, 0x35, 0xff, 0x17, 0x36, 0x6e, 0x89, 0xf5, 0xc7, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xe2, 0x66, 0x54, 0x77, 0x9a, 0x0d, 0x00, 0x00,
+	0x14, 0x8f, 0xbb, 0xdb, 0xfd, 0x78, 0x9b, 0xed, 0x66, 0x1d, 0x42, 0x4d, 0x28, 0xbb, 0x91, 0x0b,
+	0xa8, 0x05, 0x6a, 0x8b, 0x22, 0xa1, 0x0a, 0x21, 0x20, 0x21, 0x55, 0x09, 0xea, 0x07, 0x9a, 0x24,
+	0x97, 0x0a, 0xb0, 0x26, 0xeb, 0xc9, 0xc6, 0xea, 0xda, 0xe3, 0x8e, 0xc7, 0x55, 0x2b, 0x6e, 0x48,
+	0x9c, 0xb8, 0x54, 0xe2, 0x00, 0xc7, 0x8a, 0xbf, 0x80, 0x3f, 0xa3, 0x12, 0x97, 0x8a, 0x13, 0xa7,
+	0x20, 0x6d, 0xfe, 0x0b, 0x4e, 0x68, 0x3e, 0xec, 0xb5, 0x77, 0x8d, 0x7a, 0x21, 0x11, 0xb7, 0xd9,
+	0x37, 0xbf, 0xf7, 0xe9, 0x37, 0xbf, 0xf7, 0x16, 0x5e, 0x8f, 0x19, 0xe5, 0xd4, 0x0d, 0x59, 0x7c,
+	0xe0, 0x32, 0x7c, 0xc8, 0x3d, 0x12, 0x71, 0xf6, 0xc4, 0x91, 0x52, 0xb3, 0xf3, 0x08, 0xb3, 0x09,
+	0x1d, 0x3b, 0xe2, 0x76, 0x7d, 0x30, 0xa6, 0x74, 0x3c, 0x21, 0xae, 0xbc, 0x3a, 0x48, 0x0f, 0x5d,
+	0x3f, 0x65, 0x98, 0x07, 0x34, 0x52, 0xe0, 0xf5, 0xe1, 0xfc, 0x3d, 0x0f, 0x42, 0x92, 0x70, 0x1c,
+	0xc6, 0x1a, 0x70, 0x6d, 0x1c, 0xf0, 0xa3, 0xf4, 0xc0, 0x19, 0xd1, 0xd0, 0x1d, 0xd3, 0x31, 0x9d,
+	0x21, 0xc5, 0x2f, 0x15, 0x87, 0x38, 0x69, 0xf8, 0x45, 0xe5, 0x3c, 0x3e, 0x70, 0x43, 0xc2, 0xb1,
+	0x8f, 0x39, 0xd6, 0x17, 0x83, 0x24, 0x8a, 0x0f, 0xdc, 0x09, 0x1d, 0x7b, 0x09, 0x67, 0x04, 0x87,
+	0x1e, 0x23, 0x31, 0x65, 0x9c, 0x30, 0x7d, 0x7f, 0x79, 0x96, 0x4c, 0xa6, 0x29, 0x21, 0x49, 0xc0,
+	0x69, 0x96, 0x9a, 0x7d, 0x08, 0xab, 0x88, 0x8c, 0x83, 0x84, 0x13, 0xb6, 0xcb, 0x29, 0xc3, 0x63,
+	0x72, 0x97, 0xfa, 0xc4, 0xbc, 0x07, 0xcb, 0x89, 0xfa, 0xe9, 0x45, 0xd4, 0x27, 0x96, 0xb1, 0x61,
+	0x5c, 0xe9, 0x5c, 0x7f, 0xdb, 0xd1, 0x85, 0xc8, 0x42, 0x72, 0x0a, 0x3a, 0xdb, 0x24, 0x19, 0xb1,
+	0x20, 0xe6, 0x94, 0x6d, 0xd5, 0x9f, 0x1f, 0x0f, 0x0d, 0xd4, 0x49, 0x66, 0x97, 0xf6, 0x0f, 0x06,
+	0xac, 0xed, 0x47, 0xac, 0xc2, 0xd5, 0x04, 0x7a, 0x45, 0x57, 0x5e, 0xe0, 0x4b, 0x6f, 0xe7, 0xb7,
+	0xb6, 0xa7, 0xc7, 0xc3, 0x6e, 0x01, 0xb9, 0xb3, 0xfd, 0xf7, 0xf1, 0xd0, 0x2d, 0x14, 0xef, 0x01,
+	0x7e, 0x80, 0xa9, 0xab, 0x62, 0x71, 0xe3, 0x07, 0x63, 0x97, 0x3f, 0x89, 0x49, 0xe2, 0x94, 0x54,
+	0x50, 0xb7, 0x10, 0xc5, 0x8e, 0x6f, 0x7f, 0x6f, 0x40, 0x37, 0x4b, 0x78, 0x8f, 0xc6, 0xc1, 0xc8,
+	0xdc, 0x85, 0x16, 0x17, 0x87, 0x99, 0xe3, 0x1b, 0xd3, 0xe3, 0x61, 0x53, 0x5e, 0x4a, 0x97, 0x57,
+	0x5f, 0xee, 0x52, 0x83, 0x51, 0x53, 0x5a, 0xda, 0xf1, 0xcd, 0x4b, 0xd0, 0x1e, 0xd1, 0x30, 0xc6,
+	0x23, 0x4e, 0x7c, 0xeb, 0xdc, 0x86, 0x71, 0xa5, 0x85, 0x66, 0x02, 0xfb, 0x10, 0x7a, 0xb3, 0x5a,
+	0x9c, 0x5e, 0x14, 0xf6, 0xb7, 0xd0, 0xcf, 0x72, 0xbd, 0x4d, 0xc7, 0xbb, 0xb2, 0x4b, 0xcc, 0x1d,
+	0x80, 0x59, 0xcf, 0xe8, 0x0f, 0xfb, 0xe6, 0xc2, 0x87, 0xcd, 0xf1, 0x0b, 0x9f, 0xb5, 0x3d, 0xc9,
+	0xae, 0xec, 0xef, 0x60, 0x75, 0x96, 0xc7, 0xcc, 0x83, 0x0f, 0xdd, 0x42, 0x57, 0xe6, 0x09, 0x7d,
+	0x36, 0x3d, 0x1e, 0x76, 0x72, 0x94, 0x4c, 0xea, 0xda, 0xcb, 0x93, 0x2a, 0x28, 0xa0, 0x4e, 0xee,
+	0x7a, 0xc7, 0xb7, 0xbf, 0x86, 0xde, 0x7e, 0xec, 0x63, 0x4e, 0x4e, 0x25, 0xb5, 0xdf, 0x0d, 0x68,
+	0x20, 0xf9, 0x9e, 0xce, 0xb6, 0x41, 0xcd, 0x5d, 0xe8, 0xa5, 0xd1, 0x88, 0x86, 0x61, 0xc0, 0xf5,
+	0x83, 0xb6, 0x6a, 0x1b, 0xb5, 0x62, 0x22, 0x49, 0x54, 0x4c, 0x62, 0x5f, 0x83, 0x55, 0xb0, 0x32,
+	0x91, 0x25, 0x74, 0x21, 0x2d, 0x49, 0xed, 0x3f, 0x0c, 0x68, 0xaa, 0x63, 0x62, 0xde, 0x83, 0x66,
+	0x31, 0x8d, 0xfa, 0xd6, 0x87, 0xd3, 0xe3, 0x61, 0x23, 0x8f, 0xff, 0xca, 0xcb, 0xe3, 0xd7, 0x81,
+	0x37, 0x22, 0x15, 0xf1, 0x2d, 0x58, 0x1e, 0x31, 0x82, 0x39, 0xf1, 0x3d, 0x41, 0x75, 0xb2, 0xdd,
+	0x3b, 0xd7, 0xd7, 0x1d, 0xc5, 0x83, 0x4e, 0xc6, 0x6e, 0xce, 0x5e, 0xc6, 0x83, 0x5b, 0x2d, 0x11,
+	0xe4, 0xd3, 0xbf, 0x04, 0x47, 0x68, 0x4d, 0x71, 0x67, 0x5e, 0x83, 0xa6, 0xca, 0x38, 0xd1, 0x29,
+	0xaf, 0x3a, 0x05, 0xe2, 0x75, 0x54, 0x02, 0x28, 0xc3, 0xd8, 0xbf, 0x1a, 0xd0, 0xf8, 0x5c, 0x66,
+	0xf9, 0xff, 0xcd, 0xc9, 0x9e, 0x40, 0x7d, 0x97, 0xe0, 0xc9, 0x19, 0xbd, 0x89, 0x08, 0x1a, 0xfb,
+	0x51, 0x72, 0x76, 0xfe, 0x7e, 0x34, 0xa0, 0xb9, 0xe9, 0xfb, 0x5f, 0x11, 0xc2, 0xfe, 0xfb, 0x6f,
+	0xb0, 0x02, 0xb5, 0x94, 0x4d, 0x64, 0xe9, 0xdb, 0x48, 0x1c, 0xcd, 0x37, 0x00, 0x82, 0xc4, 0x9b,
+	0x10, 0xcc, 0x22, 0xc2, 0xac, 0x9a, 0xa2, 0xd5, 0x20, 0xb9, 0xad, 0x04, 0xf6, 0x37, 0x00, 0x88,
+	0x84, 0xf4, 0x11, 0x39, 0x95, 0x78, 0xec, 0x10, 0x5a, 0x37, 0x23, 0x3f, 0xa6, 0x41, 0xc4, 0xcf,
+	0x20, 0x59, 0x9b, 0x88, 0xc9, 0x3c, 0xa2, 0x8f, 0xc4, 0xb4, 0xc4, 0x9c, 0xdc, 0xc1, 0xa3, 0xa3,
+	0x20, 0x22, 0xe6, 0x5d, 0xe8, 0x26, 0xe2, 0xb7, 0x17, 0x2a, 0x81, 0xa6, 0xb9, 0xab, 0xa5, 0xa7,
+	0x72, 0x47, 0xcf, 0x7b, 0x94, 0x8f, 0xfb, 0x19, 0xd7, 0xa1, 0xe5, 0xa4, 0x60, 0xcf, 0xfe, 0xd9,
+	0x80, 0xfe, 0xe6, 0xe8, 0x61, 0x1a, 0x30, 0xb2, 0xe9, 0x87, 0x41, 0x74, 0x9b, 0xe0, 0x84, 0x98,
+	0xaf, 0x42, 0xe3, 0x88, 0x4e, 0x7c, 0xc2, 0xa4, 0xf9, 0x36, 0xd2, 0xbf, 0xcc, 0x4f, 0xa1, 0x95,
+	0xad, 0x3b, 0xfa, 0x4d, 0xbc, 0xb6, 0xf0, 0x26, 0xb6, 0x35, 0x40, 0x3d, 0x89, 0x5f, 0xc4, 0x93,
+	0xc8, 0x95, 0xcc, 0xcb, 0xd0, 0x25, 0x8f, 0xe3, 0x80, 0x11, 0xdf, 0x0b, 0x22, 0x9f, 0x3c, 0xb6,
+	0xea, 0xa2, 0x7c, 0x68, 0x59, 0x0b, 0x77, 0x84, 0xec, 0xcb, 0x7a, 0xab, 0xb6, 0x52, 0xb7, 0x7f,
+	0x02, 0x68, 0x23, 0x7c, 0xc8, 0x6f, 0x8a, 0x4d, 0x4c, 0x7c, 0x7b, 0x55, 0x71, 0xa9, 0x25, 0x8b,
+	0x8e, 0xda, 0xb2, 0x78, 0x42, 0x20, 0xec, 0x32, 0xf2, 0x30, 0x25, 0x09, 0xd7, 0x88, 0x73, 0xca,
+	0xae, 0x16, 0xe6, 0x20, 0x1c, 0xc7, 0x93, 0x20, 0x77, 0x5e, 0x53, 0x20, 0x2d, 0x54, 0xa0, 0x4f,
+	0xa0, 0xa9, 0x95, 0x64, 0x6c, 0x9d, 0xeb, 0x83, 0x32, 0x0b, 0x65, 0x11, 0x39, 0x48, 0xa1, 0x34,
+	0xe5, 0x66, 0x4a, 0xe6, 0x3b, 0xd0, 0xc7, 0xa2, 0x90, 0xa2, 0x4f, 0x13, 0xe2, 0x91, 0x98, 0x8e,
+	0x8e, 0xac, 0xf3, 0xd2, 0x51, 0x0f, 0xe7, 0x15, 0xbe, 0x29, 0xc4, 0xeb, 0xbf, 0xb5, 0x04, 0x2f,
+	0x2b, 0xbd, 0x3d, 0x58, 0xcb, 0x46, 0xa9, 0x57, 0xb1, 0x7b, 0x6d, 0xcc, 0x71, 0xe1, 0xc2, 0x22,
+	0x85, 0x56, 0xab, 0xb6, 0xab, 0xfb, 0x70, 0x31, 0x8d, 0xaa, 0xed, 0xaa, 0xef, 0x67, 0x97, 0xec,
+	0x56, 0xae, 0x68, 0x68, 0x2d, 0xad, 0x12, 0x9b, 0x77, 0x21, 0x77, 0xe9, 0x15, 0xe6, 0x6e, 0xad,
+	0xaa, 0x6a, 0xf3, 0x4b, 0x02, 0xea, 0x2f, 0xee, 0x0d, 0x7b, 0x50, 0x70, 0x54, 0xb4, 0x58, 0xaf,
+	0xa8, 0x40, 0xc5, 0xe2, 0x81, 0x56, 0xd3, 0x45, 0xa1, 0xf9, 0x05, 0xf4, 0x53, 0xb9, 0x27, 0x14,
+	0x2d, 0x9e, 0x97, 0x16, 0x2f, 0x95, 0x2d, 0x96, 0xb7, 0x09, 0xd4, 0x4b, 0xcb, 0x02, 0xf3, 0x3d,
+	0x68, 0xe8, 0x89, 0xdc, 0x90, 0xea, 0xaf, 0x54, 0x8c, 0xa7, 0x04, 0x69, 0x8c, 0xf9, 0x2e, 0x34,
+	0xd4, 0x0c, 0xb6, 0x9a, 0x1b, 0xc6, 0xc2, 0x30, 0x53, 0x83, 0x0b, 0x69, 0x88, 0xf9, 0x16, 0xd4,
+	0x05, 0x6d, 0x5b, 0x2d, 0x09, 0xed, 0x97, 0xa0, 0x62, 0x7e, 0x20, 0x79, 0x2d, 0x6c, 0xa6, 0x92,
+	0xdf, 0xad, 0x76, 0x85, 0x4d, 0x45, 0xfd, 0x48, 0x43, 0x4c, 0x17, 0x5a, 0xd8, 0xf7, 0xbd, 0x98,
+	0x10, 0x66, 0x41, 0x45, 0xc0, 0x9a, 0xb8, 0x51, 0x13, 0xab, 0x83, 0x79, 0x03, 0x3a, 0x4c, 0xf2,
+	0xa7, 0xd2, 0xe9, 0x48, 0x9d, 0x8b, 0x73, 0x49, 0x66, 0xfc, 0x8a, 0x80, 0xe5, 0x67, 0xf3, 0x7d,
+	0x68, 0x11, 0x4d, 0x8d, 0xd6, 0xb2, 0x54, 0x5b, 0x2b, 0xa9, 0x65, 0xbc, 0x89, 0x72, 0x98, 0x6a,
+	0x77, 0x49, 0x6f, 0x5e, 0x99, 0xcf, 0xba, 0x95, 0xed, 0xbe, 0x40, 0x84, 0xa2, 0xdd, 0x17, 0x84,
+	0xe6, 0x26, 0x5c, 0xc8, 0x1b, 0x48, 0x6e, 0xc1, 0xd6, 0x05, 0x3d, 0xb9, 0xab, 0xba, 0x51, 0x2e,
+	0xcc, 0xa8, 0x5b, 0xde, 0xc4, 0x6f, 0xc1, 0x4a, 0x1a, 0xcd, 0x19, 0xe9, 0x55, 0xb5, 0x4b, 0x79,
+	0x83, 0x47, 0xbd, 0xb4, 0x2c, 0x10, 0xcf, 0x03, 0x2b, 0x62, 0xf5, 0x0a, 0x84, 0x60, 0xad, 0x54,
+	0x3c, 0x8f, 0x05, 0x02, 0x46, 0x7d, 0x3c, 0x2f, 0xfa, 0xa8, 0xfe, 0xfc, 0xd9, 0xd0, 0xd8, 0xfa,
+	0xf8, 0xf9, 0x74, 0x60, 0xbc, 0x98, 0x0e, 0x8c, 0xa7, 0x27, 0x83, 0xa5, 0x67, 0x27, 0x03, 0xe3,
+	0xc5, 0xc9, 0x60, 0xe9, 0xcf, 0x93, 0xc1, 0xd2, 0x7d, 0xfb, 0x5f, 0x87, 0x4e, 0xfe, 0xd7, 0xf6,
+	0xa0, 0x21, 0xcf, 0x1f, 0xfc, 0x33, 0x00, 0x7e, 0x64, 0x9d, 0xec, 0xef, 0x0e, 0x00, 0x00,
 }
 
 func (m *RegisterStorageNode) Marshal() (dAtA []byte, err error) {
@@ -1195,6 +1296,16 @@ func (m *RegisterTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Compacted {
+		i--
+		if m.Compacted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.TopicID != 0 {
 		i = encodeVarintRaftEntry(dAtA, i, uint64(m.TopicID))
 		i--
@@ -1656,6 +1767,49 @@ func (m *RecoverStateMachine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AcquireAdminLease) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcquireAdminLease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcquireAdminLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiredIndex != 0 {
+		i = encodeVarintRaftEntry(dAtA, i, uint64(m.ExpiredIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintRaftEntry(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintRaftEntry(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RaftEntry) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1676,6 +1830,11 @@ func (m *RaftEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AdminLeaseEpoch != 0 {
+		i = encodeVarintRaftEntry(dAtA, i, uint64(m.AdminLeaseEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1724,6 +1883,20 @@ func (m *RaftEntry_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AcquireAdminLease != nil {
+		{
+			size, err := m.AcquireAdminLease.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.UnregisterTopic != nil {
 		{
 			size, err := m.UnregisterTopic.MarshalToSizedBuffer(dAtA[:i])
@@ -1952,6 +2125,9 @@ func (m *RegisterTopic) ProtoSize() (n int) {
 	if m.TopicID != 0 {
 		n += 1 + sovRaftEntry(uint64(m.TopicID))
 	}
+	if m.Compacted {
+		n += 2
+	}
 	return n
 }
 
@@ -2141,6 +2317,24 @@ func (m *RecoverStateMachine) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AcquireAdminLease) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovRaftEntry(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovRaftEntry(uint64(l))
+	if m.ExpiredIndex != 0 {
+		n += 1 + sovRaftEntry(uint64(m.ExpiredIndex))
+	}
+	return n
+}
+
 func (m *RaftEntry) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -2158,6 +2352,9 @@ func (m *RaftEntry) ProtoSize() (n int) {
 	}
 	l = m.Request.ProtoSize()
 	n += 1 + l + sovRaftEntry(uint64(l))
+	if m.AdminLeaseEpoch != 0 {
+		n += 1 + sovRaftEntry(uint64(m.AdminLeaseEpoch))
+	}
 	return n
 }
 
@@ -2227,6 +2424,10 @@ func (m *RaftEntry_Request) ProtoSize() (n int) {
 		l = m.UnregisterTopic.ProtoSize()
 		n += 1 + l + sovRaftEntry(uint64(l))
 	}
+	if m.AcquireAdminLease != nil {
+		l = m.AcquireAdminLease.ProtoSize()
+		n += 2 + l + sovRaftEntry(uint64(l))
+	}
 	return n
 }
 
@@ -2282,6 +2483,9 @@ func (this *RaftEntry_Request) GetValue() interface{} {
 	if this.UnregisterTopic != nil {
 		return this.UnregisterTopic
 	}
+	if this.AcquireAdminLease != nil {
+		return this.AcquireAdminLease
+	}
 	return nil
 }
 
@@ -2317,6 +2521,8 @@ func (this *RaftEntry_Request) SetValue(value interface{}) bool {
 		this.RegisterTopic = vt
 	case *UnregisterTopic:
 		this.UnregisterTopic = vt
+	case *AcquireAdminLease:
+		this.AcquireAdminLease = vt
 	default:
 		return false
 	}
@@ -2525,6 +2731,26 @@ func (m *RegisterTopic) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compacted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compacted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftEntry(dAtA[iNdEx:])
@@ -3712,6 +3938,140 @@ func (m *RecoverStateMachine) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AcquireAdminLease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftEntry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcquireAdminLease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcquireAdminLease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredIndex", wireType)
+			}
+			m.ExpiredIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiredIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftEntry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RaftEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3831,6 +4191,25 @@ func (m *RaftEntry) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminLeaseEpoch", wireType)
+			}
+			m.AdminLeaseEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminLeaseEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftEntry(dAtA[iNdEx:])
@@ -4421,6 +4800,42 @@ func (m *RaftEntry_Request) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcquireAdminLease", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AcquireAdminLease == nil {
+				m.AcquireAdminLease = &AcquireAdminLease{}
+			}
+			if err := m.AcquireAdminLease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftEntry(dAtA[iNdEx:])
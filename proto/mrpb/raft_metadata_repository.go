@@ -133,3 +133,83 @@ func (crs *LogStreamCommitResults) LastHighWatermark(topicID types.TopicID, hint
 
 	return types.InvalidGLSN, -1
 }
+
+// LookupGLSN returns the commit result of the topic that contains the given
+// GLSN.
+func (crs *LogStreamCommitResults) LookupGLSN(topicID types.TopicID, glsn types.GLSN) (snpb.LogStreamCommitResult, bool) {
+	if crs == nil {
+		return snpb.InvalidLogStreamCommitResult, false
+	}
+
+	for _, cr := range crs.CommitResults {
+		if cr.TopicID == topicID &&
+			cr.CommittedGLSNOffset <= glsn &&
+			glsn < cr.CommittedGLSNOffset+types.GLSN(cr.CommittedGLSNLength) {
+			return cr, true
+		}
+	}
+	return snpb.InvalidLogStreamCommitResult, false
+}
+
+// Append adds the range of GLSNs committed by the commit result to the
+// index. The commit result should follow the ranges already in the index.
+func (ci *CommitIndex) Append(cr snpb.LogStreamCommitResult) {
+	if cr.CommittedGLSNLength == 0 {
+		return
+	}
+
+	if n := len(ci.Ranges); n > 0 {
+		last := &ci.Ranges[n-1]
+		if last.LogStreamID == cr.LogStreamID &&
+			last.GLSNBegin+types.GLSN(last.Length) == cr.CommittedGLSNOffset &&
+			last.LLSNBegin+types.LLSN(last.Length) == cr.CommittedLLSNOffset {
+			last.Length += cr.CommittedGLSNLength
+			return
+		}
+	}
+
+	ci.Ranges = append(ci.Ranges, GLSNRange{
+		LogStreamID: cr.LogStreamID,
+		GLSNBegin:   cr.CommittedGLSNOffset,
+		LLSNBegin:   cr.CommittedLLSNOffset,
+		Length:      cr.CommittedGLSNLength,
+	})
+}
+
+// Trim drops the oldest ranges so that the index has at most maxRanges
+// ranges. Ranges of log streams that commit in turn cannot be merged, hence
+// the index grows as fast as the commit history does unless it is trimmed.
+// GLSNs in the dropped ranges can no longer be looked up, and Trimmed tells
+// them.
+func (ci *CommitIndex) Trim(maxRanges int) {
+	if n := len(ci.Ranges); maxRanges < n {
+		last := ci.Ranges[n-maxRanges-1]
+		ci.TrimmedGLSN = last.GLSNBegin + types.GLSN(last.Length) - 1
+		ci.Ranges = append(ci.Ranges[:0], ci.Ranges[n-maxRanges:]...)
+	}
+}
+
+// Trimmed returns true if the given GLSN was in the ranges dropped by Trim.
+func (ci *CommitIndex) Trimmed(glsn types.GLSN) bool {
+	return ci != nil && glsn <= ci.TrimmedGLSN
+}
+
+// Lookup returns the range that contains the given GLSN.
+func (ci *CommitIndex) Lookup(glsn types.GLSN) (GLSNRange, bool) {
+	if ci == nil {
+		return GLSNRange{}, false
+	}
+
+	i := sort.Search(len(ci.Ranges), func(i int) bool {
+		return glsn < ci.Ranges[i].GLSNBegin+types.GLSN(ci.Ranges[i].Length)
+	})
+	if i < len(ci.Ranges) && ci.Ranges[i].GLSNBegin <= glsn {
+		return ci.Ranges[i], true
+	}
+	return GLSNRange{}, false
+}
+
+// LLSN returns the LLSN corresponding to the given GLSN in the range.
+func (r GLSNRange) LLSN(glsn types.GLSN) types.LLSN {
+	return r.LLSNBegin + types.LLSN(glsn-r.GLSNBegin)
+}
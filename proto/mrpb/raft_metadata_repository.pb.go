@@ -8,9 +8,12 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 
 	github_com_kakao_varlog_pkg_types "github.com/kakao/varlog/pkg/types"
 	snpb "github.com/kakao/varlog/proto/snpb"
@@ -21,6 +24,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -184,18 +188,232 @@ func (m *LogStreamUncommitReports) GetStatus() varlogpb.LogStreamStatus {
 	return varlogpb.LogStreamStatusRunning
 }
 
+// AdminLease is a lease that elects the leader among admin servers. Only the
+// holder of an unexpired lease acts as the leader.
+//
+// The lease has no expire time since clocks of servers can be skewed.
+// Instead, each metadata repository regards the lease as expired once the
+// duration has passed on its local clock since it applied the last grant or
+// renewal of the lease, which is identified by the applied index. The holder
+// measures the duration from the time of sending its request, thus, it stops
+// acting as the leader before any metadata repository regards the lease as
+// expired.
+type AdminLease struct {
+	// holder is the address of the admin server holding the lease.
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// epoch increases whenever the holder of the lease changes. Admin servers
+	// send it with requests changing the cluster, and metadata repositories
+	// and storage nodes reject requests having older epochs.
+	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// duration is the length of the lease.
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
+	// applied_index is the raft index of the last grant or renewal of the
+	// lease.
+	AppliedIndex uint64 `protobuf:"varint,5,opt,name=applied_index,json=appliedIndex,proto3" json:"applied_index,omitempty"`
+}
+
+func (m *AdminLease) Reset()         { *m = AdminLease{} }
+func (m *AdminLease) String() string { return proto.CompactTextString(m) }
+func (*AdminLease) ProtoMessage()    {}
+func (*AdminLease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60447af781d89487, []int{3}
+}
+func (m *AdminLease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminLease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminLease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminLease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminLease.Merge(m, src)
+}
+func (m *AdminLease) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AdminLease) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminLease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminLease proto.InternalMessageInfo
+
+func (m *AdminLease) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *AdminLease) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *AdminLease) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *AdminLease) GetAppliedIndex() uint64 {
+	if m != nil {
+		return m.AppliedIndex
+	}
+	return 0
+}
+
+// GLSNRange is a range of GLSNs committed to a log stream consecutively.
+type GLSNRange struct {
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,1,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	GLSNBegin   github_com_kakao_varlog_pkg_types.GLSN        `protobuf:"varint,2,opt,name=glsn_begin,json=glsnBegin,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn_begin,omitempty"`
+	LLSNBegin   github_com_kakao_varlog_pkg_types.LLSN        `protobuf:"varint,3,opt,name=llsn_begin,json=llsnBegin,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn_begin,omitempty"`
+	Length      uint64                                        `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (m *GLSNRange) Reset()         { *m = GLSNRange{} }
+func (m *GLSNRange) String() string { return proto.CompactTextString(m) }
+func (*GLSNRange) ProtoMessage()    {}
+func (*GLSNRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60447af781d89487, []int{4}
+}
+func (m *GLSNRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GLSNRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GLSNRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GLSNRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GLSNRange.Merge(m, src)
+}
+func (m *GLSNRange) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GLSNRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_GLSNRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GLSNRange proto.InternalMessageInfo
+
+func (m *GLSNRange) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
+}
+
+func (m *GLSNRange) GetGLSNBegin() github_com_kakao_varlog_pkg_types.GLSN {
+	if m != nil {
+		return m.GLSNBegin
+	}
+	return 0
+}
+
+func (m *GLSNRange) GetLLSNBegin() github_com_kakao_varlog_pkg_types.LLSN {
+	if m != nil {
+		return m.LLSNBegin
+	}
+	return 0
+}
+
+func (m *GLSNRange) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+// CommitIndex maps GLSNs of a topic to log streams. It summarizes commit
+// results trimmed from the commit history so that GLSNs can be looked up
+// after trimming. Ranges are sorted by GLSN, and adjacent ranges of the same
+// log stream are merged. The number of ranges is bounded, and the oldest ones
+// are dropped first.
+type CommitIndex struct {
+	Ranges []GLSNRange `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges"`
+	// trimmed_glsn is the last GLSN of the ranges dropped from the index. GLSNs
+	// less than or equal to it can no longer be looked up.
+	TrimmedGLSN github_com_kakao_varlog_pkg_types.GLSN `protobuf:"varint,2,opt,name=trimmed_glsn,json=trimmedGlsn,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"trimmed_glsn,omitempty"`
+}
+
+func (m *CommitIndex) Reset()         { *m = CommitIndex{} }
+func (m *CommitIndex) String() string { return proto.CompactTextString(m) }
+func (*CommitIndex) ProtoMessage()    {}
+func (*CommitIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60447af781d89487, []int{5}
+}
+func (m *CommitIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitIndex.Merge(m, src)
+}
+func (m *CommitIndex) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CommitIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitIndex proto.InternalMessageInfo
+
+func (m *CommitIndex) GetRanges() []GLSNRange {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
+}
+
+func (m *CommitIndex) GetTrimmedGLSN() github_com_kakao_varlog_pkg_types.GLSN {
+	if m != nil {
+		return m.TrimmedGLSN
+	}
+	return 0
+}
+
 type MetadataRepositoryDescriptor struct {
-	Metadata  *varlogpb.MetadataDescriptor                        `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	LogStream *MetadataRepositoryDescriptor_LogStreamDescriptor   `protobuf:"bytes,2,opt,name=log_stream,json=logStream,proto3" json:"log_stream,omitempty"`
-	PeersMap  MetadataRepositoryDescriptor_PeerDescriptorMap      `protobuf:"bytes,3,opt,name=peers_map,json=peersMap,proto3" json:"peers_map"`
-	Endpoints map[github_com_kakao_varlog_pkg_types.NodeID]string `protobuf:"bytes,4,rep,name=endpoints,proto3,castkey=github.com/kakao/varlog/pkg/types.NodeID" json:"endpoints,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Metadata   *varlogpb.MetadataDescriptor                        `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	LogStream  *MetadataRepositoryDescriptor_LogStreamDescriptor   `protobuf:"bytes,2,opt,name=log_stream,json=logStream,proto3" json:"log_stream,omitempty"`
+	PeersMap   MetadataRepositoryDescriptor_PeerDescriptorMap      `protobuf:"bytes,3,opt,name=peers_map,json=peersMap,proto3" json:"peers_map"`
+	Endpoints  map[github_com_kakao_varlog_pkg_types.NodeID]string `protobuf:"bytes,4,rep,name=endpoints,proto3,castkey=github.com/kakao/varlog/pkg/types.NodeID" json:"endpoints,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AdminLease *AdminLease                                         `protobuf:"bytes,5,opt,name=admin_lease,json=adminLease,proto3" json:"admin_lease,omitempty"`
 }
 
 func (m *MetadataRepositoryDescriptor) Reset()         { *m = MetadataRepositoryDescriptor{} }
 func (m *MetadataRepositoryDescriptor) String() string { return proto.CompactTextString(m) }
 func (*MetadataRepositoryDescriptor) ProtoMessage()    {}
 func (*MetadataRepositoryDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60447af781d89487, []int{3}
+	return fileDescriptor_60447af781d89487, []int{6}
 }
 func (m *MetadataRepositoryDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -252,10 +470,19 @@ func (m *MetadataRepositoryDescriptor) GetEndpoints() map[github_com_kakao_varlo
 	return nil
 }
 
+func (m *MetadataRepositoryDescriptor) GetAdminLease() *AdminLease {
+	if m != nil {
+		return m.AdminLease
+	}
+	return nil
+}
+
 type MetadataRepositoryDescriptor_LogStreamDescriptor struct {
 	TrimVersion     github_com_kakao_varlog_pkg_types.Version                                   `protobuf:"varint,1,opt,name=trim_version,json=trimVersion,proto3,casttype=github.com/kakao/varlog/pkg/types.Version" json:"trim_version,omitempty"`
 	CommitHistory   []*LogStreamCommitResults                                                   `protobuf:"bytes,2,rep,name=commit_history,json=commitHistory,proto3" json:"commit_history,omitempty"`
 	UncommitReports map[github_com_kakao_varlog_pkg_types.LogStreamID]*LogStreamUncommitReports `protobuf:"bytes,3,rep,name=uncommit_reports,json=uncommitReports,proto3,castkey=github.com/kakao/varlog/pkg/types.LogStreamID" json:"uncommit_reports,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// commit_index is the index of trimmed commit results for each topic.
+	CommitIndex map[github_com_kakao_varlog_pkg_types.TopicID]*CommitIndex `protobuf:"bytes,4,rep,name=commit_index,json=commitIndex,proto3,castkey=github.com/kakao/varlog/pkg/types.TopicID" json:"commit_index,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *MetadataRepositoryDescriptor_LogStreamDescriptor) Reset() {
@@ -266,7 +493,7 @@ func (m *MetadataRepositoryDescriptor_LogStreamDescriptor) String() string {
 }
 func (*MetadataRepositoryDescriptor_LogStreamDescriptor) ProtoMessage() {}
 func (*MetadataRepositoryDescriptor_LogStreamDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60447af781d89487, []int{3, 0}
+	return fileDescriptor_60447af781d89487, []int{6, 0}
 }
 func (m *MetadataRepositoryDescriptor_LogStreamDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,6 +543,13 @@ func (m *MetadataRepositoryDescriptor_LogStreamDescriptor) GetUncommitReports()
 	return nil
 }
 
+func (m *MetadataRepositoryDescriptor_LogStreamDescriptor) GetCommitIndex() map[github_com_kakao_varlog_pkg_types.TopicID]*CommitIndex {
+	if m != nil {
+		return m.CommitIndex
+	}
+	return nil
+}
+
 type MetadataRepositoryDescriptor_PeerDescriptor struct {
 	URL       string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 	IsLearner bool   `protobuf:"varint,2,opt,name=is_learner,json=isLearner,proto3" json:"is_learner,omitempty"`
@@ -329,7 +563,7 @@ func (m *MetadataRepositoryDescriptor_PeerDescriptor) String() string {
 }
 func (*MetadataRepositoryDescriptor_PeerDescriptor) ProtoMessage() {}
 func (*MetadataRepositoryDescriptor_PeerDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60447af781d89487, []int{3, 1}
+	return fileDescriptor_60447af781d89487, []int{6, 1}
 }
 func (m *MetadataRepositoryDescriptor_PeerDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,7 +622,7 @@ func (m *MetadataRepositoryDescriptor_PeerDescriptorMap) String() string {
 }
 func (*MetadataRepositoryDescriptor_PeerDescriptorMap) ProtoMessage() {}
 func (*MetadataRepositoryDescriptor_PeerDescriptorMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60447af781d89487, []int{3, 2}
+	return fileDescriptor_60447af781d89487, []int{6, 2}
 }
 func (m *MetadataRepositoryDescriptor_PeerDescriptorMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -436,9 +670,13 @@ func init() {
 	proto.RegisterType((*StorageNodeUncommitReport)(nil), "varlog.mrpb.StorageNodeUncommitReport")
 	proto.RegisterType((*LogStreamUncommitReports)(nil), "varlog.mrpb.LogStreamUncommitReports")
 	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.StorageNodeID]snpb.LogStreamUncommitReport)(nil), "varlog.mrpb.LogStreamUncommitReports.ReplicasEntry")
+	proto.RegisterType((*AdminLease)(nil), "varlog.mrpb.AdminLease")
+	proto.RegisterType((*GLSNRange)(nil), "varlog.mrpb.GLSNRange")
+	proto.RegisterType((*CommitIndex)(nil), "varlog.mrpb.CommitIndex")
 	proto.RegisterType((*MetadataRepositoryDescriptor)(nil), "varlog.mrpb.MetadataRepositoryDescriptor")
 	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.NodeID]string)(nil), "varlog.mrpb.MetadataRepositoryDescriptor.EndpointsEntry")
 	proto.RegisterType((*MetadataRepositoryDescriptor_LogStreamDescriptor)(nil), "varlog.mrpb.MetadataRepositoryDescriptor.LogStreamDescriptor")
+	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.TopicID]*CommitIndex)(nil), "varlog.mrpb.MetadataRepositoryDescriptor.LogStreamDescriptor.CommitIndexEntry")
 	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.LogStreamID]*LogStreamUncommitReports)(nil), "varlog.mrpb.MetadataRepositoryDescriptor.LogStreamDescriptor.UncommitReportsEntry")
 	proto.RegisterType((*MetadataRepositoryDescriptor_PeerDescriptor)(nil), "varlog.mrpb.MetadataRepositoryDescriptor.PeerDescriptor")
 	proto.RegisterType((*MetadataRepositoryDescriptor_PeerDescriptorMap)(nil), "varlog.mrpb.MetadataRepositoryDescriptor.PeerDescriptorMap")
@@ -450,61 +688,82 @@ func init() {
 }
 
 var fileDescriptor_60447af781d89487 = []byte{
-	// 862 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc4, 0x4e, 0x1b, 0xbf, 0xd4, 0x69, 0x19, 0x2a, 0x70, 0x2d, 0xb0, 0x23, 0x07, 0xa4,
-	0x54, 0x22, 0xbb, 0x22, 0x3d, 0x10, 0xa5, 0xe5, 0x62, 0x12, 0x20, 0x28, 0x29, 0xd1, 0x46, 0xe1,
-	0xc0, 0x81, 0xd5, 0xd8, 0x9e, 0xba, 0xab, 0xec, 0xee, 0x8c, 0x66, 0x66, 0x23, 0x7c, 0x8d, 0x38,
-	0x20, 0x4e, 0x7c, 0x84, 0x7e, 0x04, 0x8e, 0xf0, 0x0d, 0x72, 0xcc, 0x0d, 0x4e, 0x0e, 0x4a, 0x2e,
-	0x7c, 0x86, 0x9e, 0xd0, 0xce, 0xcc, 0xda, 0xbb, 0x64, 0x43, 0xd2, 0xe6, 0xb6, 0xf3, 0xe7, 0xf7,
-	0x7e, 0xef, 0xf7, 0xe6, 0xfd, 0x9e, 0x16, 0x1e, 0x73, 0xc1, 0x14, 0x73, 0x23, 0xc1, 0x7b, 0xae,
-	0x20, 0x2f, 0x94, 0x1f, 0x51, 0x45, 0x06, 0x44, 0x11, 0x5f, 0x50, 0xce, 0x64, 0xa0, 0x98, 0x18,
-	0x39, 0xfa, 0x0e, 0x5e, 0x38, 0x22, 0x22, 0x64, 0x43, 0x27, 0xbd, 0xdb, 0x5c, 0x1d, 0x06, 0xea,
-	0x65, 0xd2, 0x73, 0xfa, 0x2c, 0x72, 0x87, 0x6c, 0xc8, 0x5c, 0x7d, 0xa7, 0x97, 0xbc, 0xd0, 0x2b,
-	0x13, 0x34, 0xfd, 0x32, 0xd8, 0xe6, 0xfb, 0x06, 0xcb, 0x7b, 0x6e, 0x16, 0xdf, 0x1e, 0xb4, 0x64,
-	0xcc, 0x7b, 0x6e, 0xc8, 0x86, 0xbe, 0x54, 0x82, 0x92, 0x48, 0xd3, 0x0a, 0x45, 0x85, 0x39, 0xef,
-	0xfc, 0x8e, 0xe0, 0xbd, 0x1d, 0x36, 0xdc, 0xd7, 0x87, 0x5f, 0xb0, 0x28, 0x0a, 0x94, 0x47, 0x65,
-	0x12, 0x2a, 0x89, 0xbf, 0x82, 0xbb, 0x47, 0x54, 0xc8, 0x80, 0xc5, 0x0d, 0xb4, 0x84, 0x56, 0xaa,
-	0xdd, 0xd5, 0xd7, 0xe3, 0xf6, 0xe3, 0x5c, 0x5e, 0x87, 0xe4, 0x90, 0x30, 0xd7, 0x30, 0xbb, 0xfc,
-	0x70, 0xe8, 0xaa, 0x11, 0xa7, 0xd2, 0xf9, 0xce, 0x80, 0xbc, 0x0c, 0x8d, 0xbf, 0x85, 0xc5, 0xbe,
-	0x8e, 0xec, 0x0b, 0x13, 0xba, 0x51, 0x59, 0xaa, 0xac, 0x2c, 0xac, 0x75, 0x1c, 0xab, 0x38, 0xcd,
-	0xd1, 0x29, 0xcd, 0xa2, 0x5b, 0x3d, 0x19, 0xb7, 0x67, 0xbc, 0x7a, 0x3f, 0x9f, 0xd9, 0x46, 0xf5,
-	0x9f, 0x57, 0x6d, 0xd4, 0xf9, 0x1b, 0xc1, 0xa3, 0x7d, 0xc5, 0x04, 0x19, 0xd2, 0xe7, 0x6c, 0x40,
-	0x0f, 0xe2, 0xec, 0x52, 0x2a, 0x10, 0x87, 0x70, 0x5f, 0x9a, 0x43, 0x3f, 0x66, 0x03, 0xea, 0x07,
-	0x03, 0xad, 0x62, 0xae, 0xbb, 0x79, 0x3e, 0x6e, 0xd7, 0x73, 0xb8, 0xed, 0xcd, 0xd7, 0xe3, 0xb6,
-	0x7b, 0xbd, 0xac, 0x02, 0xc4, 0xab, 0xcb, 0xdc, 0x72, 0x80, 0x0f, 0xe0, 0x41, 0x12, 0x4f, 0x44,
-	0xa6, 0x09, 0xc8, 0xc6, 0xac, 0x16, 0xf9, 0x51, 0xb9, 0xc8, 0x62, 0xb6, 0x56, 0xe6, 0xfd, 0xa4,
-	0xb0, 0x2b, 0x3b, 0x27, 0xb3, 0xd0, 0xb8, 0x02, 0x22, 0xf1, 0xcf, 0x08, 0xe6, 0x05, 0xe5, 0x61,
-	0xd0, 0x27, 0xb2, 0x81, 0x34, 0xd9, 0x13, 0x27, 0xd7, 0x43, 0x57, 0x91, 0x49, 0xc7, 0xb3, 0xa8,
-	0xad, 0x58, 0x89, 0x51, 0xf7, 0xb3, 0x94, 0xfb, 0xf8, 0xec, 0xcd, 0x6b, 0x30, 0x61, 0xc7, 0xeb,
-	0x70, 0x47, 0x2a, 0xa2, 0x92, 0x54, 0x34, 0x5a, 0x59, 0x5c, 0x5b, 0xca, 0xf2, 0xc8, 0xda, 0x72,
-	0x9a, 0xcb, 0xbe, 0xbe, 0xe7, 0xd9, 0xfb, 0x4d, 0x02, 0xf5, 0x42, 0x36, 0xf8, 0x01, 0x54, 0x0e,
-	0xe9, 0xc8, 0xbc, 0x95, 0x97, 0x7e, 0xe2, 0x0d, 0x98, 0x3b, 0x22, 0x61, 0x42, 0x75, 0xec, 0x1b,
-	0x16, 0xd4, 0x33, 0x90, 0x8d, 0xd9, 0x75, 0x64, 0xbb, 0xe5, 0x14, 0xe0, 0x83, 0x5d, 0xeb, 0x0d,
-	0x6f, 0x62, 0xbd, 0x4d, 0x2a, 0xfb, 0x22, 0xe0, 0x8a, 0x09, 0xbc, 0x05, 0xf3, 0x99, 0x77, 0x34,
-	0xfb, 0xc2, 0xda, 0xf2, 0x25, 0x15, 0x59, 0x80, 0x29, 0x4c, 0xbf, 0x1c, 0xf2, 0x26, 0x50, 0xdc,
-	0x03, 0x98, 0xba, 0xcd, 0xa6, 0xfc, 0x79, 0xe1, 0x59, 0xfe, 0x2f, 0x8b, 0xa9, 0x9e, 0x4b, 0x14,
-	0xb5, 0x30, 0x3b, 0xc2, 0x3f, 0x40, 0x8d, 0x53, 0x2a, 0xa4, 0x1f, 0x11, 0xde, 0xa8, 0x68, 0x8a,
-	0xa7, 0x37, 0xa7, 0xd8, 0xa3, 0x54, 0x4c, 0x97, 0xbb, 0x84, 0xdb, 0xee, 0x9b, 0xd7, 0x31, 0x77,
-	0x09, 0xc7, 0x3f, 0x21, 0xa8, 0xd1, 0x78, 0xc0, 0x59, 0x10, 0x2b, 0xd9, 0xa8, 0xea, 0xd6, 0x5a,
-	0xbf, 0x39, 0xc1, 0x56, 0x06, 0x35, 0xfd, 0xf5, 0xc9, 0xf1, 0x59, 0x7b, 0xe5, 0xfa, 0xde, 0xb2,
-	0x4d, 0x35, 0x25, 0x6e, 0xfe, 0x59, 0x81, 0x77, 0x4b, 0xea, 0x81, 0xf7, 0xe0, 0x9e, 0x12, 0x41,
-	0xe4, 0xdf, 0x6a, 0x3a, 0x2d, 0xa4, 0x21, 0xec, 0x02, 0xef, 0x4d, 0x26, 0xd4, 0xcb, 0x20, 0x35,
-	0xf6, 0xc8, 0x9a, 0x77, 0xb9, 0xdc, 0x4f, 0x85, 0x39, 0x69, 0x9f, 0xc7, 0x8e, 0xa8, 0xaf, 0x0d,
-	0x1e, 0xff, 0x86, 0x4a, 0x26, 0x82, 0x19, 0x7b, 0xde, 0xad, 0xba, 0xc1, 0xf9, 0x8f, 0x99, 0x4d,
-	0x8d, 0x3f, 0x3d, 0x3e, 0x6b, 0xaf, 0x5e, 0x2f, 0x7e, 0x12, 0x6f, 0x7b, 0xf3, 0xd2, 0xb0, 0x69,
-	0x06, 0xf0, 0xb0, 0x2c, 0x76, 0x89, 0x23, 0x9f, 0x16, 0x1d, 0xf9, 0xf1, 0x8d, 0xa6, 0x4e, 0xce,
-	0x92, 0xcd, 0x6f, 0x60, 0xb1, 0xd8, 0x85, 0xf8, 0x11, 0x54, 0x12, 0x11, 0x6a, 0x92, 0x5a, 0xf7,
-	0xee, 0xf9, 0xb8, 0x5d, 0x39, 0xf0, 0x76, 0xbc, 0x74, 0x0f, 0x7f, 0x08, 0x10, 0x48, 0x3f, 0xa4,
-	0x44, 0xc4, 0x54, 0x68, 0xca, 0x79, 0xaf, 0x16, 0xc8, 0x1d, 0xb3, 0xd1, 0xfc, 0x63, 0x16, 0xde,
-	0xb9, 0xd4, 0xd2, 0xf8, 0x17, 0x04, 0x73, 0xba, 0x9f, 0xed, 0x64, 0xfc, 0xf2, 0x16, 0xfe, 0xd0,
-	0x3b, 0x6f, 0xd5, 0xcc, 0x26, 0x05, 0xbc, 0x0c, 0x75, 0xc2, 0x79, 0x18, 0xd0, 0x81, 0x1f, 0xc4,
-	0x03, 0xfa, 0xa3, 0x16, 0x51, 0xf5, 0xee, 0xd9, 0xcd, 0xed, 0x74, 0xaf, 0x29, 0x00, 0xa6, 0x3c,
-	0xf9, 0xa2, 0x57, 0x4d, 0xd1, 0x9f, 0x17, 0x8b, 0xbe, 0xfe, 0xb6, 0x82, 0xf2, 0xef, 0xf0, 0x0c,
-	0x16, 0x8b, 0x66, 0x2d, 0xe1, 0x7d, 0x98, 0xe7, 0xad, 0xe5, 0xd0, 0xdd, 0x67, 0x27, 0xe7, 0x2d,
-	0x74, 0x7a, 0xde, 0x42, 0xbf, 0x5e, 0xb4, 0x66, 0x5e, 0x5d, 0xb4, 0xd0, 0xe9, 0x45, 0x6b, 0xe6,
-	0xaf, 0x8b, 0xd6, 0xcc, 0xf7, 0x9d, 0x2b, 0x2b, 0x34, 0xf9, 0x1b, 0xea, 0xdd, 0xd1, 0xdf, 0x4f,
-	0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xad, 0x94, 0x9c, 0xd0, 0x22, 0x09, 0x00, 0x00,
+	// 1188 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0xfa, 0x23, 0xb5, 0x5f, 0xd7, 0x69, 0x7e, 0xf3, 0xab, 0x52, 0xd7, 0x02, 0xbb, 0x72,
+	0x00, 0xa5, 0x12, 0x59, 0x8b, 0x14, 0xa9, 0x21, 0x2d, 0x5f, 0x26, 0x21, 0xb4, 0x72, 0x42, 0xb4,
+	0x69, 0x2a, 0x84, 0x10, 0xab, 0xb5, 0x77, 0xba, 0x59, 0x65, 0xbd, 0xb3, 0x9a, 0x59, 0x47, 0xe4,
+	0x1a, 0x71, 0x40, 0x48, 0x48, 0x1c, 0x39, 0x86, 0x0b, 0x67, 0x8e, 0x70, 0xe2, 0x9a, 0x63, 0x0f,
+	0x1c, 0x38, 0x39, 0xc8, 0xb9, 0xf0, 0x37, 0xe4, 0x84, 0xe6, 0xc3, 0xfb, 0x91, 0x38, 0x24, 0x69,
+	0x6e, 0x9e, 0xd9, 0x79, 0xdf, 0xe7, 0x79, 0x3f, 0x1f, 0x19, 0xee, 0x07, 0x94, 0x84, 0xa4, 0xd9,
+	0xa3, 0x41, 0xa7, 0x49, 0xad, 0x17, 0xa1, 0xd9, 0xc3, 0xa1, 0x65, 0x5b, 0xa1, 0x65, 0x52, 0x1c,
+	0x10, 0xe6, 0x86, 0x84, 0xee, 0xe9, 0xe2, 0x0d, 0x2a, 0xed, 0x5a, 0xd4, 0x23, 0x8e, 0xce, 0xdf,
+	0x56, 0x6b, 0x0e, 0x21, 0x8e, 0x87, 0x9b, 0xe2, 0x53, 0xa7, 0xff, 0xa2, 0x69, 0xf7, 0xa9, 0x15,
+	0xba, 0xc4, 0x97, 0x8f, 0xab, 0xf3, 0x8e, 0x1b, 0x6e, 0xf7, 0x3b, 0x7a, 0x97, 0xf4, 0x9a, 0x0e,
+	0x71, 0x48, 0xfc, 0x90, 0x9f, 0x24, 0x28, 0xff, 0xa5, 0x9e, 0xdf, 0x91, 0xbe, 0x83, 0x4e, 0x73,
+	0x84, 0xaf, 0x3e, 0xd4, 0x98, 0x1f, 0x74, 0x9a, 0x1e, 0x71, 0x4c, 0x16, 0x52, 0x6c, 0xf5, 0x04,
+	0x2d, 0x1a, 0x62, 0x2a, 0xbf, 0x37, 0x7e, 0xd3, 0x60, 0xa6, 0x4d, 0x9c, 0x4d, 0xf1, 0xf1, 0x13,
+	0xd2, 0xeb, 0xb9, 0xa1, 0x81, 0x59, 0xdf, 0x0b, 0x19, 0x5a, 0x85, 0x1b, 0xbb, 0x98, 0x32, 0x97,
+	0xf8, 0x15, 0xed, 0x9e, 0x36, 0x97, 0x6b, 0xcd, 0x9f, 0x0c, 0xea, 0xf7, 0x13, 0xbc, 0x76, 0xac,
+	0x1d, 0x8b, 0x34, 0x25, 0x72, 0x33, 0xd8, 0x71, 0x9a, 0xe1, 0x5e, 0x80, 0x99, 0xfe, 0x5c, 0x1a,
+	0x19, 0x23, 0x6b, 0xf4, 0x39, 0x4c, 0x75, 0x85, 0x67, 0x93, 0x4a, 0xd7, 0x95, 0xec, 0xbd, 0xec,
+	0x5c, 0x69, 0xa1, 0xa1, 0xab, 0x8c, 0x70, 0x8e, 0xfa, 0x58, 0x16, 0xad, 0xdc, 0xe1, 0xa0, 0x3e,
+	0x61, 0x94, 0xbb, 0x49, 0x66, 0x4b, 0xb9, 0x7f, 0x0e, 0xea, 0x5a, 0xe3, 0x6f, 0x0d, 0xee, 0x6e,
+	0x86, 0x84, 0x5a, 0x0e, 0x5e, 0x27, 0x36, 0xde, 0xf2, 0x47, 0x8f, 0x78, 0x80, 0xc8, 0x83, 0x5b,
+	0x4c, 0x7e, 0x34, 0x7d, 0x62, 0x63, 0xd3, 0xb5, 0x45, 0x14, 0xf9, 0xd6, 0xf2, 0x70, 0x50, 0x2f,
+	0x27, 0xec, 0x9e, 0x2c, 0x9f, 0x0c, 0xea, 0xcd, 0x8b, 0xc3, 0x4a, 0x99, 0x18, 0x65, 0x96, 0x38,
+	0xda, 0x68, 0x0b, 0xa6, 0xfb, 0x7e, 0x14, 0x24, 0x27, 0xc0, 0x2a, 0x19, 0x11, 0xe4, 0x1b, 0xe3,
+	0x83, 0x4c, 0xb3, 0x55, 0x61, 0xde, 0xea, 0xa7, 0x6e, 0x59, 0xe3, 0x30, 0x03, 0x95, 0x73, 0x4c,
+	0x18, 0xfa, 0x4e, 0x83, 0x02, 0xc5, 0x81, 0xe7, 0x76, 0x2d, 0x56, 0xd1, 0x04, 0xd8, 0x03, 0x3d,
+	0xd1, 0x63, 0xe7, 0x81, 0x31, 0xdd, 0x50, 0x56, 0x2b, 0x7e, 0x48, 0xf7, 0x5a, 0x0f, 0x39, 0xf6,
+	0xfe, 0xd1, 0xd5, 0x73, 0x10, 0xa1, 0xa3, 0x45, 0x98, 0x64, 0xa1, 0x15, 0xf6, 0x79, 0xd0, 0xda,
+	0xdc, 0xd4, 0xc2, 0xbd, 0x11, 0x8f, 0x51, 0x5b, 0xc6, 0x5c, 0x36, 0xc5, 0x3b, 0x43, 0xbd, 0xaf,
+	0x5a, 0x50, 0x4e, 0xb1, 0x41, 0xd3, 0x90, 0xdd, 0xc1, 0x7b, 0xb2, 0x56, 0x06, 0xff, 0x89, 0x96,
+	0x20, 0xbf, 0x6b, 0x79, 0x7d, 0x2c, 0x7c, 0x5f, 0x32, 0xa1, 0x86, 0x34, 0x59, 0xca, 0x2c, 0x6a,
+	0xaa, 0x5b, 0x7e, 0xd1, 0x00, 0x3e, 0xb6, 0x7b, 0xae, 0xdf, 0xc6, 0x16, 0xc3, 0x68, 0x06, 0x26,
+	0xb7, 0x89, 0x67, 0x63, 0x2a, 0x90, 0x8a, 0x86, 0x3a, 0xa1, 0xdb, 0x90, 0xc7, 0x01, 0xe9, 0x6e,
+	0x57, 0xb2, 0xbc, 0xe5, 0x0d, 0x79, 0x40, 0x1f, 0x42, 0x61, 0x34, 0x9f, 0x95, 0x9c, 0x60, 0x71,
+	0x57, 0x97, 0x03, 0xac, 0x8f, 0xe6, 0x52, 0x5f, 0x56, 0x0f, 0x5a, 0x05, 0x9e, 0xcf, 0x9f, 0x8e,
+	0xea, 0x9a, 0x11, 0x19, 0xa1, 0x59, 0x28, 0x5b, 0x41, 0xe0, 0xb9, 0xd8, 0x36, 0x5d, 0xdf, 0xc6,
+	0xdf, 0x54, 0xf2, 0xc2, 0xfd, 0x4d, 0x75, 0xf9, 0x84, 0xdf, 0x3d, 0xcd, 0x15, 0x32, 0xd3, 0xd9,
+	0xc6, 0x1f, 0x19, 0x28, 0xae, 0xb6, 0x37, 0xd7, 0x0d, 0xcb, 0x77, 0x30, 0xb2, 0xa1, 0x9c, 0x18,
+	0xde, 0xa8, 0x89, 0x3f, 0x1a, 0x0e, 0xea, 0xa5, 0x28, 0x76, 0xd1, 0xc2, 0xf3, 0x17, 0x97, 0x2f,
+	0x61, 0x60, 0x94, 0xbc, 0xe8, 0x60, 0xa3, 0xe7, 0x00, 0x8e, 0xc7, 0x7c, 0xb3, 0x83, 0x1d, 0xd7,
+	0x17, 0x79, 0xce, 0xb5, 0x1e, 0x0e, 0x07, 0x75, 0x41, 0xa4, 0xc5, 0x2f, 0x4f, 0x06, 0xf5, 0xb7,
+	0x2e, 0x06, 0x10, 0xbc, 0x8b, 0xdc, 0x95, 0x30, 0xe2, 0x7e, 0xbd, 0xd8, 0x6f, 0x36, 0xf6, 0xdb,
+	0xbe, 0x9a, 0xdf, 0xb6, 0xf0, 0xeb, 0x45, 0x7e, 0x67, 0x60, 0xd2, 0xc3, 0xbe, 0x13, 0x6e, 0x8b,
+	0x6a, 0xe4, 0x0c, 0x75, 0x52, 0xa5, 0xfe, 0x59, 0x83, 0x92, 0x5c, 0x22, 0x22, 0xaf, 0xe8, 0x5d,
+	0x98, 0xa4, 0x3c, 0x99, 0xa3, 0x29, 0x99, 0x49, 0x4d, 0x49, 0x94, 0x6b, 0x35, 0x84, 0xea, 0x2d,
+	0xfa, 0x0a, 0x6e, 0x86, 0xd4, 0xed, 0xf5, 0xb0, 0x6d, 0xf2, 0x80, 0x54, 0x56, 0xde, 0xe3, 0x89,
+	0x7f, 0x26, 0xef, 0xb9, 0xe5, 0x15, 0xf2, 0x52, 0x52, 0xee, 0x56, 0x3d, 0xe6, 0x37, 0x7e, 0x28,
+	0xc3, 0x6b, 0x6b, 0x6a, 0x55, 0x1b, 0x91, 0x52, 0x2c, 0x63, 0xd6, 0xa5, 0x6e, 0x10, 0x12, 0x8a,
+	0x56, 0xa0, 0x30, 0x5a, 0xe5, 0xa2, 0xe6, 0xa5, 0x85, 0xd9, 0x33, 0x43, 0x35, 0x72, 0x10, 0x9b,
+	0x89, 0x18, 0x34, 0x23, 0x32, 0x45, 0x1d, 0x80, 0xb8, 0x7f, 0xd4, 0x04, 0xbd, 0x9f, 0x8a, 0xff,
+	0xbf, 0x58, 0xc4, 0x1d, 0x73, 0x06, 0xa2, 0x18, 0xf5, 0x0f, 0xfa, 0x1a, 0x8a, 0x01, 0xc6, 0x94,
+	0x99, 0x3d, 0x2b, 0x10, 0x45, 0x2e, 0x2d, 0x3c, 0xba, 0x3c, 0xc4, 0x06, 0xc6, 0x34, 0x3e, 0xae,
+	0x59, 0x81, 0xaa, 0x43, 0x41, 0xf8, 0x5c, 0xb3, 0x02, 0xf4, 0xad, 0x06, 0x45, 0xec, 0xdb, 0x01,
+	0x71, 0xfd, 0x90, 0x55, 0x72, 0xa2, 0x86, 0x8b, 0x97, 0x07, 0x58, 0x19, 0x99, 0xca, 0x75, 0xf7,
+	0xf6, 0xfe, 0x51, 0x7d, 0xee, 0xe2, 0x92, 0xa9, 0x1d, 0x17, 0x03, 0xa3, 0x0f, 0xa0, 0x64, 0xf1,
+	0x05, 0x62, 0x7a, 0x7c, 0x83, 0x88, 0x09, 0x2e, 0x2d, 0xdc, 0x49, 0xf1, 0x88, 0x17, 0x8c, 0xca,
+	0x12, 0x58, 0xd1, 0x4d, 0xf5, 0xcf, 0x3c, 0xfc, 0x7f, 0x4c, 0x3e, 0xd1, 0x86, 0x6c, 0x34, 0xf3,
+	0x5a, 0x62, 0x2b, 0x9a, 0x4b, 0x1d, 0xd0, 0x46, 0x24, 0xb8, 0xdb, 0x2e, 0xd7, 0xa9, 0x3d, 0xa5,
+	0x45, 0xb3, 0xe3, 0xe5, 0x21, 0x25, 0xfb, 0x8a, 0xb8, 0x52, 0xdc, 0xcf, 0xa4, 0x3d, 0xfa, 0x55,
+	0x1b, 0x23, 0x70, 0x52, 0xc5, 0x8d, 0x6b, 0x75, 0x93, 0x7e, 0x4a, 0x9b, 0x64, 0x8d, 0xde, 0xd9,
+	0x3f, 0xba, 0xea, 0x3e, 0x3b, 0xad, 0x9d, 0xe8, 0x40, 0x83, 0x9b, 0x8a, 0xb0, 0x5c, 0xb9, 0xb2,
+	0x71, 0xd6, 0xaf, 0x47, 0x37, 0xb1, 0x57, 0x24, 0xd5, 0xf9, 0xfd, 0xa3, 0xcb, 0xd4, 0xe9, 0x19,
+	0x09, 0xdc, 0x2e, 0x5f, 0xbb, 0xdd, 0xd8, 0x41, 0xd5, 0x85, 0xdb, 0xe3, 0xc2, 0x1f, 0xa3, 0x81,
+	0x8f, 0xd2, 0x1a, 0xf8, 0xe6, 0xa5, 0x74, 0x3e, 0x21, 0x82, 0xd5, 0x2f, 0x60, 0xfa, 0x34, 0xf5,
+	0x31, 0x30, 0x7a, 0x1a, 0xa6, 0x92, 0x82, 0x49, 0xd8, 0x27, 0x3d, 0x3f, 0x85, 0xa9, 0xf4, 0x08,
+	0xa3, 0xbb, 0x90, 0xed, 0x53, 0x4f, 0x0a, 0x6b, 0xeb, 0xc6, 0x70, 0x50, 0xcf, 0x6e, 0x19, 0x6d,
+	0x83, 0xdf, 0xa1, 0xd7, 0x01, 0x5c, 0xc6, 0x07, 0x88, 0xfa, 0x98, 0x0a, 0x94, 0x82, 0x51, 0x74,
+	0x59, 0x5b, 0x5e, 0x54, 0x7f, 0xcf, 0xc0, 0xff, 0xce, 0xec, 0x03, 0xf4, 0xbd, 0x06, 0x79, 0xb1,
+	0x0c, 0xd4, 0xfe, 0xfe, 0xf4, 0x1a, 0xcb, 0x45, 0xdc, 0xbc, 0xd2, 0x26, 0x90, 0x14, 0xce, 0x2a,
+	0x79, 0xe6, 0xac, 0x92, 0x57, 0x29, 0x40, 0x8c, 0x93, 0xcc, 0x73, 0x4e, 0xe6, 0x79, 0x3d, 0x9d,
+	0xe7, 0xc5, 0x57, 0x0d, 0x28, 0x59, 0x87, 0xc7, 0x30, 0x95, 0xde, 0x74, 0x63, 0x70, 0x6f, 0x27,
+	0x71, 0x8b, 0x09, 0xeb, 0xd6, 0xe3, 0xc3, 0x61, 0x4d, 0x7b, 0x39, 0xac, 0x69, 0x3f, 0x1e, 0xd7,
+	0x26, 0x0e, 0x8e, 0x6b, 0xda, 0xcb, 0xe3, 0xda, 0xc4, 0x5f, 0xc7, 0xb5, 0x89, 0x2f, 0x1b, 0xe7,
+	0x66, 0x28, 0xfa, 0xe7, 0xd3, 0x99, 0x14, 0xbf, 0x1f, 0xfc, 0x3b, 0x00, 0x82, 0x52, 0x9d, 0x8a,
+	0x0e, 0x0d, 0x00, 0x00,
 }
 
 func (this *LogStreamCommitResults) Equal(that interface{}) bool {
@@ -573,6 +832,39 @@ func (this *LogStreamUncommitReports) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GLSNRange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GLSNRange)
+	if !ok {
+		that2, ok := that.(GLSNRange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LogStreamID != that1.LogStreamID {
+		return false
+	}
+	if this.GLSNBegin != that1.GLSNBegin {
+		return false
+	}
+	if this.LLSNBegin != that1.LLSNBegin {
+		return false
+	}
+	if this.Length != that1.Length {
+		return false
+	}
+	return true
+}
 func (m *LogStreamCommitResults) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -707,6 +999,139 @@ func (m *LogStreamUncommitReports) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *AdminLease) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminLease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AppliedIndex != 0 {
+		i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(m.AppliedIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.Epoch != 0 {
+		i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GLSNRange) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GLSNRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GLSNRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Length != 0 {
+		i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LLSNBegin != 0 {
+		i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(m.LLSNBegin))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GLSNBegin != 0 {
+		i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(m.GLSNBegin))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitIndex) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TrimmedGLSN != 0 {
+		i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(m.TrimmedGLSN))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Ranges) > 0 {
+		for iNdEx := len(m.Ranges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ranges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MetadataRepositoryDescriptor) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -727,6 +1152,18 @@ func (m *MetadataRepositoryDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.AdminLease != nil {
+		{
+			size, err := m.AdminLease.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Endpoints) > 0 {
 		for k := range m.Endpoints {
 			v := m.Endpoints[k]
@@ -801,8 +1238,32 @@ func (m *MetadataRepositoryDescriptor_LogStreamDescriptor) MarshalToSizedBuffer(
 	_ = i
 	var l int
 	_ = l
-	if len(m.UncommitReports) > 0 {
-		for k := range m.UncommitReports {
+	if len(m.CommitIndex) > 0 {
+		for k := range m.CommitIndex {
+			v := m.CommitIndex[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UncommitReports) > 0 {
+		for k := range m.UncommitReports {
 			v := m.UncommitReports[k]
 			baseI := i
 			if v != nil {
@@ -1007,6 +1468,66 @@ func (m *LogStreamUncommitReports) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AdminLease) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovRaftMetadataRepository(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovRaftMetadataRepository(uint64(m.Epoch))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovRaftMetadataRepository(uint64(l))
+	if m.AppliedIndex != 0 {
+		n += 1 + sovRaftMetadataRepository(uint64(m.AppliedIndex))
+	}
+	return n
+}
+
+func (m *GLSNRange) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogStreamID != 0 {
+		n += 1 + sovRaftMetadataRepository(uint64(m.LogStreamID))
+	}
+	if m.GLSNBegin != 0 {
+		n += 1 + sovRaftMetadataRepository(uint64(m.GLSNBegin))
+	}
+	if m.LLSNBegin != 0 {
+		n += 1 + sovRaftMetadataRepository(uint64(m.LLSNBegin))
+	}
+	if m.Length != 0 {
+		n += 1 + sovRaftMetadataRepository(uint64(m.Length))
+	}
+	return n
+}
+
+func (m *CommitIndex) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ranges) > 0 {
+		for _, e := range m.Ranges {
+			l = e.ProtoSize()
+			n += 1 + l + sovRaftMetadataRepository(uint64(l))
+		}
+	}
+	if m.TrimmedGLSN != 0 {
+		n += 1 + sovRaftMetadataRepository(uint64(m.TrimmedGLSN))
+	}
+	return n
+}
+
 func (m *MetadataRepositoryDescriptor) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -1031,6 +1552,10 @@ func (m *MetadataRepositoryDescriptor) ProtoSize() (n int) {
 			n += mapEntrySize + 1 + sovRaftMetadataRepository(uint64(mapEntrySize))
 		}
 	}
+	if m.AdminLease != nil {
+		l = m.AdminLease.ProtoSize()
+		n += 1 + l + sovRaftMetadataRepository(uint64(l))
+	}
 	return n
 }
 
@@ -1062,6 +1587,19 @@ func (m *MetadataRepositoryDescriptor_LogStreamDescriptor) ProtoSize() (n int) {
 			n += mapEntrySize + 1 + sovRaftMetadataRepository(uint64(mapEntrySize))
 		}
 	}
+	if len(m.CommitIndex) > 0 {
+		for k, v := range m.CommitIndex {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.ProtoSize()
+				l += 1 + sovRaftMetadataRepository(uint64(l))
+			}
+			mapEntrySize := 1 + sovRaftMetadataRepository(uint64(k)) + l
+			n += mapEntrySize + 1 + sovRaftMetadataRepository(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -1258,16 +1796,494 @@ func (m *StorageNodeUncommitReport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncommitReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UncommitReports = append(m.UncommitReports, snpb.LogStreamUncommitReport{})
+			if err := m.UncommitReports[len(m.UncommitReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogStreamUncommitReports) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogStreamUncommitReports: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogStreamUncommitReports: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Replicas == nil {
+				m.Replicas = make(map[github_com_kakao_varlog_pkg_types.StorageNodeID]snpb.LogStreamUncommitReport)
+			}
+			var mapkey int32
+			mapvalue := &snpb.LogStreamUncommitReport{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRaftMetadataRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftMetadataRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftMetadataRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &snpb.LogStreamUncommitReport{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRaftMetadataRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Replicas[github_com_kakao_varlog_pkg_types.StorageNodeID(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= varlogpb.LogStreamStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminLease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminLease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminLease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedIndex", wireType)
+			}
+			m.AppliedIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppliedIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GLSNRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GLSNRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GLSNRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSNBegin", wireType)
+			}
+			m.GLSNBegin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GLSNBegin |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LLSNBegin", wireType)
+			}
+			m.LLSNBegin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LLSNBegin |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UncommitReports", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
-			var msglen int
+			m.Length = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftMetadataRepository
@@ -1277,26 +2293,11 @@ func (m *StorageNodeUncommitReport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Length |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftMetadataRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftMetadataRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UncommitReports = append(m.UncommitReports, snpb.LogStreamUncommitReport{})
-			if err := m.UncommitReports[len(m.UncommitReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftMetadataRepository(dAtA[iNdEx:])
@@ -1318,7 +2319,7 @@ func (m *StorageNodeUncommitReport) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogStreamUncommitReports) Unmarshal(dAtA []byte) error {
+func (m *CommitIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1341,15 +2342,15 @@ func (m *LogStreamUncommitReports) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogStreamUncommitReports: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogStreamUncommitReports: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ranges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1376,97 +2377,16 @@ func (m *LogStreamUncommitReports) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Replicas == nil {
-				m.Replicas = make(map[github_com_kakao_varlog_pkg_types.StorageNodeID]snpb.LogStreamUncommitReport)
-			}
-			var mapkey int32
-			mapvalue := &snpb.LogStreamUncommitReport{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRaftMetadataRepository
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRaftMetadataRepository
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRaftMetadataRepository
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthRaftMetadataRepository
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthRaftMetadataRepository
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &snpb.LogStreamUncommitReport{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRaftMetadataRepository(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthRaftMetadataRepository
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Ranges = append(m.Ranges, GLSNRange{})
+			if err := m.Ranges[len(m.Ranges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Replicas[github_com_kakao_varlog_pkg_types.StorageNodeID(mapkey)] = *mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimmedGLSN", wireType)
 			}
-			m.Status = 0
+			m.TrimmedGLSN = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftMetadataRepository
@@ -1476,7 +2396,7 @@ func (m *LogStreamUncommitReports) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= varlogpb.LogStreamStatus(b&0x7F) << shift
+				m.TrimmedGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1749,6 +2669,42 @@ func (m *MetadataRepositoryDescriptor) Unmarshal(dAtA []byte) error {
 			}
 			m.Endpoints[github_com_kakao_varlog_pkg_types.NodeID(mapkey)] = mapvalue
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminLease", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AdminLease == nil {
+				m.AdminLease = &AdminLease{}
+			}
+			if err := m.AdminLease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftMetadataRepository(dAtA[iNdEx:])
@@ -1967,6 +2923,121 @@ func (m *MetadataRepositoryDescriptor_LogStreamDescriptor) Unmarshal(dAtA []byte
 			}
 			m.UncommitReports[github_com_kakao_varlog_pkg_types.LogStreamID(mapkey)] = mapvalue
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitIndex == nil {
+				m.CommitIndex = make(map[github_com_kakao_varlog_pkg_types.TopicID]*CommitIndex)
+			}
+			var mapkey int32
+			var mapvalue *CommitIndex
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRaftMetadataRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftMetadataRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftMetadataRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &CommitIndex{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRaftMetadataRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CommitIndex[github_com_kakao_varlog_pkg_types.TopicID(mapkey)] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftMetadataRepository(dAtA[iNdEx:])
@@ -39,6 +39,9 @@ type AppendRequest struct {
 	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
 	Payload     [][]byte                                      `protobuf:"bytes,3,rep,name=payload,proto3" json:"payload,omitempty"`
 	Backups     []varlogpb.StorageNode                        `protobuf:"bytes,4,rep,name=backups,proto3" json:"backups"`
+	// Keys are record keys of the payload. It is either empty or has the same
+	// length as the payload. An empty key means that the record has no key.
+	Keys [][]byte `protobuf:"bytes,5,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
 func (m *AppendRequest) Reset()         { *m = AppendRequest{} }
@@ -102,6 +105,13 @@ func (m *AppendRequest) GetBackups() []varlogpb.StorageNode {
 	return nil
 }
 
+func (m *AppendRequest) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
 type AppendResult struct {
 	Meta  varlogpb.LogEntryMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta"`
 	Error string                `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
@@ -397,6 +407,9 @@ type SubscribeResponse struct {
 	GLSN    github_com_kakao_varlog_pkg_types.GLSN `protobuf:"varint,1,opt,name=glsn,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn,omitempty"`
 	LLSN    github_com_kakao_varlog_pkg_types.LLSN `protobuf:"varint,2,opt,name=llsn,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn,omitempty"`
 	Payload []byte                                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Compacted is true if the log entry was removed by compaction. The
+	// payload is empty, and clients skip it.
+	Compacted bool `protobuf:"varint,4,opt,name=compacted,proto3" json:"compacted,omitempty"`
 }
 
 func (m *SubscribeResponse) Reset()         { *m = SubscribeResponse{} }
@@ -453,6 +466,13 @@ func (m *SubscribeResponse) GetPayload() []byte {
 	return nil
 }
 
+func (m *SubscribeResponse) GetCompacted() bool {
+	if m != nil {
+		return m.Compacted
+	}
+	return false
+}
+
 type SubscribeToRequest struct {
 	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
@@ -812,6 +832,124 @@ func (m *LogStreamReplicaMetadataResponse) GetLogStreamReplica() LogStreamReplic
 	return LogStreamReplicaMetadataDescriptor{}
 }
 
+// LookupByKeyRequest asks a storage node to find log entries having the
+// record key in the log stream.
+type LookupByKeyRequest struct {
+	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	Key         []byte                                        `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// Latest makes the storage node return only the latest log entry having
+	// the key.
+	Latest bool `protobuf:"varint,4,opt,name=latest,proto3" json:"latest,omitempty"`
+}
+
+func (m *LookupByKeyRequest) Reset()         { *m = LookupByKeyRequest{} }
+func (m *LookupByKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupByKeyRequest) ProtoMessage()    {}
+func (*LookupByKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7692726f23e518ee, []int{14}
+}
+func (m *LookupByKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LookupByKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LookupByKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LookupByKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupByKeyRequest.Merge(m, src)
+}
+func (m *LookupByKeyRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *LookupByKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupByKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupByKeyRequest proto.InternalMessageInfo
+
+func (m *LookupByKeyRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+func (m *LookupByKeyRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
+}
+
+func (m *LookupByKeyRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *LookupByKeyRequest) GetLatest() bool {
+	if m != nil {
+		return m.Latest
+	}
+	return false
+}
+
+// LookupByKeyResponse contains log entries having the record key in
+// ascending order of GLSN.
+type LookupByKeyResponse struct {
+	LogEntries []varlogpb.LogEntry `protobuf:"bytes,1,rep,name=log_entries,json=logEntries,proto3" json:"log_entries"`
+}
+
+func (m *LookupByKeyResponse) Reset()         { *m = LookupByKeyResponse{} }
+func (m *LookupByKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupByKeyResponse) ProtoMessage()    {}
+func (*LookupByKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7692726f23e518ee, []int{15}
+}
+func (m *LookupByKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LookupByKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LookupByKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LookupByKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupByKeyResponse.Merge(m, src)
+}
+func (m *LookupByKeyResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *LookupByKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupByKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupByKeyResponse proto.InternalMessageInfo
+
+func (m *LookupByKeyResponse) GetLogEntries() []varlogpb.LogEntry {
+	if m != nil {
+		return m.LogEntries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AppendRequest)(nil), "varlog.snpb.AppendRequest")
 	proto.RegisterType((*AppendResult)(nil), "varlog.snpb.AppendResult")
@@ -827,71 +965,80 @@ func init() {
 	proto.RegisterType((*LogStreamMetadataResponse)(nil), "varlog.snpb.LogStreamMetadataResponse")
 	proto.RegisterType((*LogStreamReplicaMetadataRequest)(nil), "varlog.snpb.LogStreamReplicaMetadataRequest")
 	proto.RegisterType((*LogStreamReplicaMetadataResponse)(nil), "varlog.snpb.LogStreamReplicaMetadataResponse")
+	proto.RegisterType((*LookupByKeyRequest)(nil), "varlog.snpb.LookupByKeyRequest")
+	proto.RegisterType((*LookupByKeyResponse)(nil), "varlog.snpb.LookupByKeyResponse")
 }
 
 func init() { proto.RegisterFile("proto/snpb/log_io.proto", fileDescriptor_7692726f23e518ee) }
 
 var fileDescriptor_7692726f23e518ee = []byte{
-	// 932 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x1d, 0x77, 0xd3, 0xbc, 0x74, 0x57, 0xcb, 0x94, 0x65, 0x53, 0x2f, 0x1b, 0x47, 0x16,
-	0x42, 0x45, 0xa2, 0x36, 0x2a, 0x42, 0x0b, 0x52, 0x91, 0x20, 0xb4, 0x8b, 0x2a, 0xbc, 0x05, 0x39,
-	0xd5, 0x1e, 0x90, 0xa0, 0xb2, 0xe3, 0xc1, 0x58, 0x9d, 0x78, 0x8c, 0xed, 0x20, 0x45, 0xfc, 0x00,
-	0xae, 0xfb, 0x13, 0x10, 0xbf, 0x81, 0x03, 0x17, 0xee, 0x7b, 0xdc, 0x0b, 0x12, 0x07, 0x94, 0x43,
-	0xf2, 0x23, 0x10, 0x2b, 0x0e, 0x68, 0xc6, 0x63, 0xc7, 0x6e, 0x13, 0x6d, 0x23, 0xc8, 0x61, 0x7b,
-	0xf3, 0xcc, 0xbc, 0xf7, 0xf9, 0xbd, 0xef, 0x7d, 0x7a, 0xf3, 0x06, 0xee, 0x46, 0x31, 0x4d, 0xa9,
-	0x99, 0x84, 0x91, 0x6b, 0x12, 0xea, 0x9f, 0x05, 0xd4, 0xe0, 0x3b, 0xa8, 0xf5, 0xbd, 0x13, 0x13,
-	0xea, 0x1b, 0xec, 0x44, 0xdd, 0xf3, 0x83, 0xf4, 0xdb, 0x91, 0x6b, 0x0c, 0xe8, 0xd0, 0xf4, 0xa9,
-	0x4f, 0x4d, 0x6e, 0xe3, 0x8e, 0xbe, 0xe1, 0xab, 0x0c, 0x82, 0x7d, 0x65, 0xbe, 0xea, 0x3d, 0x9f,
-	0x52, 0x9f, 0xe0, 0xb9, 0x15, 0x1e, 0x46, 0xe9, 0x58, 0x1c, 0xde, 0xcd, 0x80, 0x23, 0xd7, 0x1c,
-	0xe2, 0xd4, 0xf1, 0x9c, 0xd4, 0x11, 0x07, 0xdb, 0x3c, 0x88, 0xea, 0xa6, 0xfe, 0xb3, 0x0c, 0x37,
-	0x3f, 0x8e, 0x22, 0x1c, 0x7a, 0x36, 0xfe, 0x6e, 0x84, 0x93, 0x14, 0xf5, 0x61, 0x33, 0xa5, 0x51,
-	0x30, 0x38, 0x0b, 0xbc, 0xb6, 0xd4, 0x95, 0x76, 0x37, 0x7a, 0xef, 0x4f, 0x27, 0x5a, 0xe3, 0x94,
-	0xed, 0x1d, 0x1f, 0x3e, 0x9f, 0x68, 0x6f, 0x95, 0x82, 0x3d, 0x77, 0xce, 0x1d, 0x6a, 0x66, 0x7f,
-	0x34, 0xa3, 0x73, 0xdf, 0x4c, 0xc7, 0x11, 0x4e, 0x0c, 0x61, 0x6c, 0x37, 0x38, 0xd2, 0xb1, 0x87,
-	0x3c, 0xb8, 0xc9, 0xb2, 0x4f, 0xd2, 0x18, 0x3b, 0x43, 0x86, 0x2c, 0x73, 0xe4, 0x8f, 0xa6, 0x13,
-	0xad, 0x65, 0x51, 0xbf, 0xcf, 0xf7, 0x39, 0xfa, 0xde, 0x8b, 0xd1, 0x4b, 0x0e, 0x76, 0x8b, 0x14,
-	0x0b, 0x0f, 0xb5, 0xa1, 0x11, 0x39, 0x63, 0x42, 0x1d, 0xaf, 0x5d, 0xef, 0xd6, 0x77, 0xb7, 0xec,
-	0x7c, 0x89, 0x0e, 0xa0, 0xe1, 0x3a, 0x83, 0xf3, 0x51, 0x94, 0xb4, 0x95, 0x6e, 0x7d, 0xb7, 0xb5,
-	0xff, 0xba, 0x21, 0xf8, 0xcf, 0xd9, 0x32, 0xfa, 0x29, 0x8d, 0x1d, 0x1f, 0x9f, 0x50, 0x0f, 0xf7,
-	0x94, 0xa7, 0x13, 0xad, 0x66, 0xe7, 0x2e, 0xfa, 0x57, 0xb0, 0x95, 0x73, 0x94, 0x8c, 0x48, 0x8a,
-	0x1e, 0x80, 0xc2, 0x68, 0xe4, 0xf4, 0xb4, 0xf6, 0xef, 0x5f, 0x82, 0xb2, 0xa8, 0x7f, 0x14, 0xa6,
-	0xf1, 0xf8, 0x11, 0x4e, 0x1d, 0x81, 0xc5, 0x1d, 0xd0, 0xab, 0xb0, 0x81, 0xe3, 0x98, 0xc6, 0x3c,
-	0xfd, 0xa6, 0x9d, 0x2d, 0xf4, 0xcf, 0xe0, 0x56, 0x01, 0x1f, 0xd1, 0x30, 0xc1, 0xe8, 0x03, 0x68,
-	0xc4, 0xfc, 0x57, 0x49, 0x5b, 0xe2, 0xe1, 0xee, 0x18, 0x25, 0xb9, 0x18, 0xe5, 0x60, 0xf2, 0x58,
-	0x85, 0xbd, 0xfe, 0x44, 0x86, 0x96, 0x8d, 0x9d, 0xa2, 0x9c, 0x0f, 0x41, 0xf1, 0x49, 0x12, 0xf2,
-	0x58, 0x95, 0xde, 0xfe, 0x74, 0xa2, 0x29, 0x9f, 0x5a, 0xfd, 0x93, 0xe7, 0x13, 0xed, 0xcd, 0x17,
-	0x33, 0xcd, 0x2c, 0x6d, 0xee, 0x5f, 0x91, 0x85, 0xbc, 0x36, 0x59, 0xd4, 0xd7, 0x20, 0x0b, 0xfd,
-	0x57, 0x09, 0xb6, 0x32, 0x4a, 0x04, 0xbd, 0xff, 0x17, 0x27, 0x0f, 0x41, 0x21, 0x0c, 0x47, 0x9e,
-	0xe3, 0x58, 0x57, 0xc6, 0xb1, 0x38, 0x0e, 0xf3, 0xaf, 0xea, 0x56, 0x2a, 0xe9, 0x56, 0xff, 0x4b,
-	0x86, 0xdb, 0xfd, 0x91, 0x9b, 0x0c, 0xe2, 0xc0, 0xc5, 0x79, 0x49, 0x1f, 0x03, 0xb0, 0xdf, 0x9f,
-	0xb9, 0xd8, 0x0f, 0xf2, 0x24, 0x1e, 0x4c, 0x27, 0x5a, 0x93, 0x85, 0xd6, 0x63, 0x9b, 0x2b, 0x64,
-	0xd2, 0x64, 0x50, 0xdc, 0x09, 0x7d, 0x01, 0x9b, 0x1c, 0x17, 0x87, 0x9e, 0x48, 0xe9, 0x3d, 0x56,
-	0x62, 0x66, 0x76, 0x14, 0x7a, 0x2b, 0x60, 0x36, 0x18, 0xcc, 0x51, 0xe8, 0x55, 0x44, 0x53, 0x5f,
-	0x9b, 0x68, 0x94, 0x75, 0x88, 0xe6, 0x37, 0x09, 0x5e, 0x29, 0x31, 0xff, 0xd2, 0x29, 0xe7, 0x6f,
-	0x19, 0x50, 0x11, 0xff, 0x29, 0xbd, 0x06, 0xdd, 0xfd, 0x31, 0x00, 0x99, 0xcb, 0xbe, 0x3e, 0x97,
-	0xbd, 0xb5, 0x9a, 0xec, 0x39, 0x7d, 0x4d, 0x52, 0x96, 0x3d, 0xc9, 0x65, 0xaf, 0xcc, 0x65, 0x6f,
-	0xad, 0x22, 0x7b, 0x8e, 0xd9, 0x20, 0x99, 0xec, 0xf5, 0x3e, 0x6c, 0x57, 0xa8, 0x17, 0xe2, 0x39,
-	0x80, 0x26, 0xa3, 0x09, 0xb3, 0xab, 0x41, 0xdc, 0x1d, 0x3b, 0x4b, 0xef, 0x0e, 0xd1, 0xd7, 0x37,
-	0x89, 0x58, 0xeb, 0xbf, 0x48, 0x70, 0xe7, 0x34, 0x0e, 0x86, 0x87, 0x38, 0x8a, 0xf1, 0xc0, 0x49,
-	0xf1, 0x7a, 0x6f, 0xec, 0x5c, 0xe9, 0xf2, 0x7f, 0x53, 0xba, 0xfe, 0xbb, 0x04, 0xed, 0xa2, 0xa4,
-	0x8f, 0xc4, 0xf0, 0xf1, 0xf2, 0xab, 0x51, 0xff, 0x01, 0x76, 0x16, 0xa4, 0x25, 0x2a, 0xfd, 0x35,
-	0xdc, 0x29, 0x85, 0xe0, 0x61, 0x26, 0x85, 0x28, 0xa5, 0xb1, 0xa8, 0xfa, 0x1b, 0x8b, 0xaa, 0x9e,
-	0x41, 0x1d, 0x16, 0xb6, 0x42, 0x00, 0xdb, 0xe4, 0xf2, 0x91, 0xfe, 0xa7, 0x04, 0x5a, 0xe1, 0x62,
-	0xe3, 0x88, 0x04, 0x03, 0xe7, 0x1a, 0x71, 0xfb, 0xa3, 0x04, 0xdd, 0xe5, 0xe9, 0x09, 0x8e, 0x07,
-	0x80, 0x4a, 0xa1, 0xc4, 0x99, 0x95, 0x20, 0xd8, 0xac, 0x8c, 0x4b, 0xcb, 0xa0, 0x2e, 0x71, 0x7d,
-	0x9b, 0x5c, 0xb0, 0xdc, 0xff, 0xa7, 0x0e, 0x1b, 0x16, 0xf5, 0x8f, 0x3f, 0x47, 0x9f, 0xc0, 0x8d,
-	0x6c, 0xec, 0x42, 0xea, 0xc2, 0x59, 0x8c, 0x93, 0xae, 0xde, 0x5b, 0x3c, 0xa7, 0xf1, 0x88, 0xf5,
-	0x1a, 0xfa, 0x10, 0x14, 0x36, 0x88, 0xa0, 0x76, 0xc5, 0xac, 0x34, 0xae, 0xa9, 0x3b, 0x0b, 0x4e,
-	0x0a, 0xf7, 0x13, 0x68, 0x16, 0x7d, 0x05, 0xdd, 0xaf, 0x58, 0x5e, 0x1c, 0x12, 0xd4, 0xce, 0xb2,
-	0xe3, 0x1c, 0xed, 0x1d, 0x09, 0x9d, 0x42, 0xab, 0xd4, 0xa7, 0x90, 0xb6, 0xd8, 0xa5, 0xb8, 0x3c,
-	0xd4, 0xee, 0x72, 0x83, 0x12, 0xea, 0x09, 0xdc, 0xaa, 0xf6, 0x29, 0xa4, 0x57, 0xfc, 0x16, 0x36,
-	0x31, 0xf5, 0x35, 0x23, 0x7b, 0xd4, 0x18, 0xf9, 0xa3, 0xc6, 0x38, 0x62, 0x8f, 0x1a, 0xbd, 0x86,
-	0xc6, 0xa5, 0x06, 0x72, 0xa1, 0x82, 0xe8, 0xed, 0x2b, 0x15, 0x3a, 0xff, 0xc7, 0xde, 0x15, 0xad,
-	0xf3, 0x64, 0x7a, 0x07, 0x4f, 0xa7, 0x1d, 0xe9, 0xd9, 0xb4, 0x23, 0x3d, 0x99, 0x75, 0x6a, 0x3f,
-	0xcd, 0x3a, 0xd2, 0xb3, 0x59, 0xa7, 0xf6, 0xc7, 0xac, 0x53, 0xfb, 0x52, 0x5f, 0x2a, 0xef, 0xe2,
-	0xbd, 0xe7, 0xde, 0xe0, 0xdf, 0xef, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x66, 0xf8, 0x83,
-	0x04, 0x0e, 0x00, 0x00,
+	// 1053 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xd1, 0x6a, 0x1b, 0x47,
+	0x14, 0xd5, 0x4a, 0x6b, 0xcb, 0xba, 0x72, 0x82, 0x3b, 0x6e, 0x12, 0x59, 0x49, 0x24, 0x31, 0x94,
+	0xe2, 0x42, 0xbd, 0x2a, 0x2e, 0x25, 0x2d, 0xb8, 0x90, 0x2a, 0x76, 0x82, 0x89, 0xe2, 0x94, 0x95,
+	0x49, 0xa1, 0xd0, 0x9a, 0x95, 0x76, 0xba, 0x59, 0xb4, 0xda, 0x99, 0xee, 0x8e, 0x0a, 0xa2, 0x1f,
+	0xd0, 0xd7, 0x7c, 0x42, 0xfb, 0x0f, 0xa5, 0xf4, 0x13, 0xf2, 0x98, 0x97, 0x42, 0xa1, 0x45, 0x0f,
+	0xf2, 0x47, 0x94, 0xe4, 0xa9, 0xcc, 0xec, 0xec, 0x6a, 0xd7, 0x92, 0x88, 0x4d, 0xa2, 0x87, 0xf8,
+	0x6d, 0x67, 0xe6, 0xde, 0x33, 0x73, 0xcf, 0x9c, 0x7b, 0xef, 0x48, 0x70, 0x83, 0x05, 0x94, 0xd3,
+	0x66, 0xe8, 0xb3, 0x6e, 0xd3, 0xa3, 0xce, 0x89, 0x4b, 0x0d, 0x39, 0x83, 0xca, 0x3f, 0x59, 0x81,
+	0x47, 0x1d, 0x43, 0xac, 0x54, 0x77, 0x1c, 0x97, 0x3f, 0x1d, 0x76, 0x8d, 0x1e, 0x1d, 0x34, 0x1d,
+	0xea, 0xd0, 0xa6, 0xb4, 0xe9, 0x0e, 0x7f, 0x90, 0xa3, 0x08, 0x42, 0x7c, 0x45, 0xbe, 0xd5, 0x9b,
+	0x0e, 0xa5, 0x8e, 0x47, 0xa6, 0x56, 0x64, 0xc0, 0xf8, 0x48, 0x2d, 0xde, 0x88, 0x80, 0x59, 0xb7,
+	0x39, 0x20, 0xdc, 0xb2, 0x2d, 0x6e, 0xa9, 0x85, 0xcd, 0xd0, 0x9f, 0x99, 0xc4, 0x7f, 0xe4, 0xe1,
+	0xca, 0x57, 0x8c, 0x11, 0xdf, 0x36, 0xc9, 0x8f, 0x43, 0x12, 0x72, 0xd4, 0x81, 0x35, 0x4e, 0x99,
+	0xdb, 0x3b, 0x71, 0xed, 0x8a, 0xd6, 0xd0, 0xb6, 0x57, 0x5a, 0x9f, 0x4f, 0xc6, 0xf5, 0xe2, 0xb1,
+	0x98, 0x3b, 0xdc, 0x7f, 0x35, 0xae, 0x7f, 0x94, 0x3a, 0x6c, 0xdf, 0xea, 0x5b, 0xb4, 0x19, 0xed,
+	0xd8, 0x64, 0x7d, 0xa7, 0xc9, 0x47, 0x8c, 0x84, 0x86, 0x32, 0x36, 0x8b, 0x12, 0xe9, 0xd0, 0x46,
+	0x36, 0x5c, 0x11, 0xd1, 0x87, 0x3c, 0x20, 0xd6, 0x40, 0x20, 0xe7, 0x25, 0xf2, 0xdd, 0xc9, 0xb8,
+	0x5e, 0x6e, 0x53, 0xa7, 0x23, 0xe7, 0x25, 0xfa, 0xce, 0xeb, 0xd1, 0x53, 0x0e, 0x66, 0xd9, 0x4b,
+	0x06, 0x36, 0xaa, 0x40, 0x91, 0x59, 0x23, 0x8f, 0x5a, 0x76, 0xa5, 0xd0, 0x28, 0x6c, 0xaf, 0x9b,
+	0xf1, 0x10, 0xed, 0x41, 0xb1, 0x6b, 0xf5, 0xfa, 0x43, 0x16, 0x56, 0xf4, 0x46, 0x61, 0xbb, 0xbc,
+	0x7b, 0xcb, 0x50, 0xfc, 0xc7, 0x6c, 0x19, 0x1d, 0x4e, 0x03, 0xcb, 0x21, 0x47, 0xd4, 0x26, 0x2d,
+	0xfd, 0xf9, 0xb8, 0x9e, 0x33, 0x63, 0x17, 0x84, 0x40, 0xef, 0x93, 0x51, 0x58, 0x59, 0x91, 0xa0,
+	0xf2, 0x1b, 0x7f, 0x07, 0xeb, 0x31, 0x6f, 0xe1, 0xd0, 0xe3, 0xe8, 0x0e, 0xe8, 0x82, 0x5a, 0x49,
+	0x59, 0x79, 0xf7, 0xf6, 0x0c, 0x7c, 0x9b, 0x3a, 0x07, 0x3e, 0x0f, 0x46, 0x8f, 0x08, 0xb7, 0x14,
+	0xbe, 0x74, 0x40, 0xef, 0xc3, 0x0a, 0x09, 0x02, 0x1a, 0x48, 0x4a, 0x4a, 0x66, 0x34, 0xc0, 0x0f,
+	0xe1, 0x6a, 0x02, 0xcf, 0xa8, 0x1f, 0x12, 0xf4, 0x05, 0x14, 0x03, 0xb9, 0x55, 0x58, 0xd1, 0x64,
+	0x08, 0x5b, 0x46, 0x4a, 0x42, 0x46, 0xfa, 0x30, 0xf1, 0xf9, 0x95, 0x3d, 0x7e, 0x96, 0x87, 0xb2,
+	0x49, 0xac, 0xe4, 0x8a, 0xef, 0x83, 0xee, 0x78, 0xa1, 0x2f, 0xcf, 0xaa, 0xb7, 0x76, 0x27, 0xe3,
+	0xba, 0xfe, 0xa0, 0xdd, 0x39, 0x7a, 0x35, 0xae, 0x7f, 0xf8, 0x7a, 0xf6, 0x85, 0xa5, 0x29, 0xfd,
+	0x33, 0x52, 0xc9, 0x2f, 0x4d, 0x2a, 0x85, 0x25, 0x48, 0x05, 0xff, 0xa9, 0xc1, 0x7a, 0x44, 0x89,
+	0xa2, 0xf7, 0x6d, 0x71, 0x72, 0x1f, 0x74, 0x4f, 0xe0, 0xe4, 0xa7, 0x38, 0xed, 0x73, 0xe3, 0xb4,
+	0x25, 0x8e, 0xf0, 0xcf, 0x6a, 0x59, 0x4b, 0x69, 0x19, 0xff, 0x97, 0x87, 0x8d, 0xce, 0xb0, 0x1b,
+	0xf6, 0x02, 0xb7, 0x4b, 0xe2, 0x2b, 0x7d, 0x02, 0x20, 0xb6, 0x3f, 0xe9, 0x12, 0xc7, 0x8d, 0x83,
+	0xb8, 0x33, 0x19, 0xd7, 0x4b, 0xe2, 0x68, 0x2d, 0x31, 0x79, 0x81, 0x48, 0x4a, 0x02, 0x4a, 0x3a,
+	0xa1, 0xaf, 0x61, 0x4d, 0xe2, 0x12, 0xdf, 0x56, 0x21, 0x7d, 0x26, 0xae, 0x58, 0x98, 0x1d, 0xf8,
+	0xf6, 0x05, 0x30, 0x8b, 0x02, 0xe6, 0xc0, 0xb7, 0x33, 0xa2, 0x29, 0x2c, 0x4d, 0x34, 0xfa, 0x32,
+	0x44, 0xf3, 0x8f, 0x06, 0xef, 0xa5, 0x98, 0x7f, 0xd7, 0x94, 0x83, 0x6e, 0x41, 0xa9, 0x47, 0x07,
+	0xcc, 0xea, 0x71, 0x12, 0x31, 0xb4, 0x66, 0x4e, 0x27, 0xf0, 0xcb, 0x3c, 0xa0, 0x24, 0xba, 0x63,
+	0x7a, 0x09, 0xfa, 0xc1, 0x13, 0x00, 0x6f, 0x9a, 0x14, 0x85, 0x69, 0x52, 0xb4, 0x2f, 0x96, 0x14,
+	0x92, 0xdc, 0x92, 0x97, 0x4e, 0x0a, 0x2f, 0x4e, 0x0a, 0x7d, 0x9a, 0x14, 0xed, 0x8b, 0x24, 0x85,
+	0xc4, 0x2c, 0x7a, 0x51, 0x52, 0xe0, 0x0e, 0x6c, 0x66, 0xa8, 0x57, 0xd2, 0xda, 0x83, 0x92, 0xa0,
+	0x89, 0x88, 0xc6, 0xa1, 0x3a, 0xcb, 0xd6, 0xc2, 0xce, 0xa2, 0xaa, 0xfe, 0x9a, 0xa7, 0xc6, 0xf8,
+	0x77, 0x0d, 0xae, 0x1d, 0x07, 0xee, 0x60, 0x9f, 0xb0, 0x80, 0xf4, 0x2c, 0x4e, 0x96, 0xdb, 0xe3,
+	0xe3, 0x3c, 0xc8, 0xbf, 0x59, 0x1e, 0xe0, 0xbf, 0x34, 0xa8, 0x24, 0x57, 0xfa, 0x48, 0x3d, 0x57,
+	0xde, 0x7d, 0x35, 0xe2, 0x9f, 0x61, 0x6b, 0x4e, 0x58, 0xea, 0xa6, 0xbf, 0x87, 0x6b, 0xa9, 0x23,
+	0xd8, 0x44, 0x48, 0x81, 0x71, 0x1a, 0xa8, 0x5b, 0xff, 0x60, 0xde, 0xad, 0x47, 0x50, 0xfb, 0x89,
+	0xad, 0x12, 0xc0, 0xa6, 0x37, 0xbb, 0x84, 0xff, 0xd5, 0xa0, 0x9e, 0xb8, 0x98, 0x84, 0x79, 0x6e,
+	0xcf, 0xba, 0x44, 0xdc, 0xfe, 0xa2, 0x41, 0x63, 0x71, 0x78, 0x8a, 0xe3, 0x1e, 0xa0, 0xd4, 0x51,
+	0x82, 0xc8, 0x4a, 0x11, 0xdc, 0xcc, 0x3c, 0xa6, 0x16, 0x41, 0xcd, 0x70, 0xbd, 0xe1, 0x9d, 0xb1,
+	0xc4, 0x2f, 0x35, 0x40, 0x6d, 0x4a, 0xfb, 0x43, 0xd6, 0x1a, 0x3d, 0x24, 0xa3, 0x4b, 0x50, 0x45,
+	0x37, 0xa0, 0xd0, 0x27, 0x23, 0xd5, 0x4b, 0xc4, 0x27, 0xba, 0x0e, 0xab, 0x9e, 0xc5, 0x49, 0xc8,
+	0x55, 0x13, 0x51, 0x23, 0xfc, 0x0d, 0x6c, 0x66, 0x42, 0x57, 0xbc, 0xdf, 0x85, 0x72, 0x5c, 0xc5,
+	0x5c, 0x32, 0xf3, 0x7a, 0x5d, 0x54, 0xc7, 0x40, 0xd5, 0x31, 0x97, 0x84, 0xbb, 0xbf, 0xad, 0xc0,
+	0x4a, 0x9b, 0x3a, 0x87, 0x8f, 0xd1, 0x3d, 0x58, 0x8d, 0x5e, 0xba, 0xa8, 0x3a, 0xf7, 0xf9, 0x2b,
+	0xd9, 0xae, 0xde, 0x9c, 0xbb, 0x16, 0x1d, 0x07, 0xe7, 0xd0, 0x97, 0xa0, 0x8b, 0xb7, 0x1f, 0xaa,
+	0x64, 0xcc, 0x52, 0x2f, 0xe4, 0xea, 0xd6, 0x9c, 0x95, 0xc4, 0xfd, 0x08, 0x4a, 0x49, 0xb1, 0x46,
+	0xb7, 0x33, 0x96, 0x67, 0xdf, 0x65, 0xd5, 0xda, 0xa2, 0xe5, 0x18, 0xed, 0x13, 0x0d, 0x1d, 0x43,
+	0x39, 0x55, 0xfc, 0x51, 0x7d, 0xbe, 0x4b, 0xd2, 0x91, 0xab, 0x8d, 0xc5, 0x06, 0x29, 0xd4, 0x23,
+	0xb8, 0x9a, 0x2d, 0xfe, 0x08, 0x67, 0xfc, 0xe6, 0x76, 0x86, 0xea, 0x75, 0x23, 0xfa, 0x6d, 0x69,
+	0xc4, 0xbf, 0x2d, 0x8d, 0x03, 0xf1, 0xdb, 0x12, 0xe7, 0xd0, 0x28, 0x55, 0x95, 0xcf, 0xa4, 0x05,
+	0xfa, 0xf8, 0x5c, 0xd9, 0x13, 0xef, 0xb1, 0x73, 0x4e, 0xeb, 0x84, 0x70, 0x13, 0xca, 0x29, 0x5d,
+	0x9d, 0x21, 0x68, 0x36, 0xd9, 0xaa, 0x8d, 0xc5, 0x06, 0x09, 0xe6, 0x63, 0xd8, 0x88, 0x74, 0x71,
+	0xef, 0xe9, 0xd0, 0xef, 0x3f, 0x08, 0xe8, 0x90, 0xbd, 0x81, 0xa4, 0xb6, 0xb5, 0xd6, 0xde, 0xf3,
+	0x49, 0x4d, 0x7b, 0x31, 0xa9, 0x69, 0xcf, 0x4e, 0x6b, 0xb9, 0x5f, 0x4f, 0x6b, 0xda, 0x8b, 0xd3,
+	0x5a, 0xee, 0xef, 0xd3, 0x5a, 0xee, 0x5b, 0xbc, 0x30, 0xf9, 0x92, 0xff, 0x06, 0xba, 0xab, 0xf2,
+	0xfb, 0xd3, 0xff, 0x07, 0x00, 0x15, 0x67, 0xd7, 0x7f, 0x30, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -912,6 +1059,16 @@ type LogIOClient interface {
 	SubscribeTo(ctx context.Context, in *SubscribeToRequest, opts ...grpc.CallOption) (LogIO_SubscribeToClient, error)
 	TrimDeprecated(ctx context.Context, in *TrimDeprecatedRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	LogStreamReplicaMetadata(ctx context.Context, in *LogStreamReplicaMetadataRequest, opts ...grpc.CallOption) (*LogStreamReplicaMetadataResponse, error)
+	// LookupByKey returns log entries having the record key in the log stream.
+	// It requires the key index to be enabled in the storage node.
+	LookupByKey(ctx context.Context, in *LookupByKeyRequest, opts ...grpc.CallOption) (*LookupByKeyResponse, error)
+	// AppendChunkGroup appends the payloads of all requests in the stream to
+	// the log stream as a group. The log entries of a group have consecutive
+	// LLSNs, that is, no other log entries are interleaved with them, and
+	// nothing is appended unless the stream is closed successfully. Requests in
+	// the stream should have the same topic and log stream, and they should not
+	// have keys.
+	AppendChunkGroup(ctx context.Context, opts ...grpc.CallOption) (LogIO_AppendChunkGroupClient, error)
 }
 
 type logIOClient struct {
@@ -1022,6 +1179,49 @@ func (c *logIOClient) LogStreamReplicaMetadata(ctx context.Context, in *LogStrea
 	return out, nil
 }
 
+func (c *logIOClient) LookupByKey(ctx context.Context, in *LookupByKeyRequest, opts ...grpc.CallOption) (*LookupByKeyResponse, error) {
+	out := new(LookupByKeyResponse)
+	err := c.cc.Invoke(ctx, "/varlog.snpb.LogIO/LookupByKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logIOClient) AppendChunkGroup(ctx context.Context, opts ...grpc.CallOption) (LogIO_AppendChunkGroupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_LogIO_serviceDesc.Streams[2], "/varlog.snpb.LogIO/AppendChunkGroup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logIOAppendChunkGroupClient{stream}
+	return x, nil
+}
+
+type LogIO_AppendChunkGroupClient interface {
+	Send(*AppendRequest) error
+	CloseAndRecv() (*AppendResponse, error)
+	grpc.ClientStream
+}
+
+type logIOAppendChunkGroupClient struct {
+	grpc.ClientStream
+}
+
+func (x *logIOAppendChunkGroupClient) Send(m *AppendRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *logIOAppendChunkGroupClient) CloseAndRecv() (*AppendResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(AppendResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // LogIOServer is the server API for LogIO service.
 type LogIOServer interface {
 	Append(context.Context, *AppendRequest) (*AppendResponse, error)
@@ -1030,6 +1230,16 @@ type LogIOServer interface {
 	SubscribeTo(*SubscribeToRequest, LogIO_SubscribeToServer) error
 	TrimDeprecated(context.Context, *TrimDeprecatedRequest) (*types.Empty, error)
 	LogStreamReplicaMetadata(context.Context, *LogStreamReplicaMetadataRequest) (*LogStreamReplicaMetadataResponse, error)
+	// LookupByKey returns log entries having the record key in the log stream.
+	// It requires the key index to be enabled in the storage node.
+	LookupByKey(context.Context, *LookupByKeyRequest) (*LookupByKeyResponse, error)
+	// AppendChunkGroup appends the payloads of all requests in the stream to
+	// the log stream as a group. The log entries of a group have consecutive
+	// LLSNs, that is, no other log entries are interleaved with them, and
+	// nothing is appended unless the stream is closed successfully. Requests in
+	// the stream should have the same topic and log stream, and they should not
+	// have keys.
+	AppendChunkGroup(LogIO_AppendChunkGroupServer) error
 }
 
 // UnimplementedLogIOServer can be embedded to have forward compatible implementations.
@@ -1054,6 +1264,12 @@ func (*UnimplementedLogIOServer) TrimDeprecated(ctx context.Context, req *TrimDe
 func (*UnimplementedLogIOServer) LogStreamReplicaMetadata(ctx context.Context, req *LogStreamReplicaMetadataRequest) (*LogStreamReplicaMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LogStreamReplicaMetadata not implemented")
 }
+func (*UnimplementedLogIOServer) LookupByKey(ctx context.Context, req *LookupByKeyRequest) (*LookupByKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupByKey not implemented")
+}
+func (*UnimplementedLogIOServer) AppendChunkGroup(srv LogIO_AppendChunkGroupServer) error {
+	return status.Errorf(codes.Unimplemented, "method AppendChunkGroup not implemented")
+}
 
 func RegisterLogIOServer(s *grpc.Server, srv LogIOServer) {
 	s.RegisterService(&_LogIO_serviceDesc, srv)
@@ -1173,6 +1389,50 @@ func _LogIO_LogStreamReplicaMetadata_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogIO_LookupByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupByKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogIOServer).LookupByKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.snpb.LogIO/LookupByKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogIOServer).LookupByKey(ctx, req.(*LookupByKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogIO_AppendChunkGroup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LogIOServer).AppendChunkGroup(&logIOAppendChunkGroupServer{stream})
+}
+
+type LogIO_AppendChunkGroupServer interface {
+	SendAndClose(*AppendResponse) error
+	Recv() (*AppendRequest, error)
+	grpc.ServerStream
+}
+
+type logIOAppendChunkGroupServer struct {
+	grpc.ServerStream
+}
+
+func (x *logIOAppendChunkGroupServer) SendAndClose(m *AppendResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *logIOAppendChunkGroupServer) Recv() (*AppendRequest, error) {
+	m := new(AppendRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _LogIO_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "varlog.snpb.LogIO",
 	HandlerType: (*LogIOServer)(nil),
@@ -1193,6 +1453,10 @@ var _LogIO_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LogStreamReplicaMetadata",
 			Handler:    _LogIO_LogStreamReplicaMetadata_Handler,
 		},
+		{
+			MethodName: "LookupByKey",
+			Handler:    _LogIO_LookupByKey_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1205,6 +1469,11 @@ var _LogIO_serviceDesc = grpc.ServiceDesc{
 			Handler:       _LogIO_SubscribeTo_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "AppendChunkGroup",
+			Handler:       _LogIO_AppendChunkGroup_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "proto/snpb/log_io.proto",
 }
@@ -1229,6 +1498,15 @@ func (m *AppendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintLogIo(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Backups) > 0 {
 		for iNdEx := len(m.Backups) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1483,6 +1761,16 @@ func (m *SubscribeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Compacted {
+		i--
+		if m.Compacted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Payload) > 0 {
 		i -= len(m.Payload)
 		copy(dAtA[i:], m.Payload)
@@ -1744,6 +2032,93 @@ func (m *LogStreamReplicaMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *LookupByKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LookupByKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LookupByKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Latest {
+		i--
+		if m.Latest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintLogIo(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LookupByKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LookupByKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LookupByKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LogEntries) > 0 {
+		for iNdEx := len(m.LogEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLogIo(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLogIo(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLogIo(v)
 	base := offset
@@ -1779,6 +2154,12 @@ func (m *AppendRequest) ProtoSize() (n int) {
 			n += 1 + l + sovLogIo(uint64(l))
 		}
 	}
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
+			n += 1 + l + sovLogIo(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1886,6 +2267,9 @@ func (m *SubscribeResponse) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLogIo(uint64(l))
 	}
+	if m.Compacted {
+		n += 2
+	}
 	return n
 }
 
@@ -1988,6 +2372,43 @@ func (m *LogStreamReplicaMetadataResponse) ProtoSize() (n int) {
 	return n
 }
 
+func (m *LookupByKeyRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovLogIo(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovLogIo(uint64(m.LogStreamID))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovLogIo(uint64(l))
+	}
+	if m.Latest {
+		n += 2
+	}
+	return n
+}
+
+func (m *LookupByKeyResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LogEntries) > 0 {
+		for _, e := range m.LogEntries {
+			l = e.ProtoSize()
+			n += 1 + l + sovLogIo(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovLogIo(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2127,6 +2548,38 @@ func (m *AppendRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogIo(dAtA[iNdEx:])
@@ -2803,6 +3256,26 @@ func (m *SubscribeResponse) Unmarshal(dAtA []byte) error {
 				m.Payload = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compacted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compacted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogIo(dAtA[iNdEx:])
@@ -3463,6 +3936,232 @@ func (m *LogStreamReplicaMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LookupByKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogIo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LookupByKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LookupByKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Latest = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogIo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LookupByKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogIo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LookupByKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LookupByKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogEntries = append(m.LogEntries, varlogpb.LogEntry{})
+			if err := m.LogEntries[len(m.LogEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogIo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLogIo(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
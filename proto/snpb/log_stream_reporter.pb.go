@@ -9,9 +9,12 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -23,6 +26,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -196,6 +200,54 @@ func (m *GetReportResponse) GetUncommitReports() []LogStreamUncommitReport {
 	return nil
 }
 
+// WatchReportRequest asks the storage node to push reports whenever an
+// uncommitted range or a commit context of its log streams changes.
+type WatchReportRequest struct {
+	// heartbeat_interval is the longest interval between reports. The storage
+	// node sends a report even if nothing changes during the interval.
+	HeartbeatInterval time.Duration `protobuf:"bytes,1,opt,name=heartbeat_interval,json=heartbeatInterval,proto3,stdduration" json:"heartbeat_interval"`
+}
+
+func (m *WatchReportRequest) Reset()         { *m = WatchReportRequest{} }
+func (m *WatchReportRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchReportRequest) ProtoMessage()    {}
+func (*WatchReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6a839cf0bdc32d5, []int{3}
+}
+func (m *WatchReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchReportRequest.Merge(m, src)
+}
+func (m *WatchReportRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *WatchReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchReportRequest proto.InternalMessageInfo
+
+func (m *WatchReportRequest) GetHeartbeatInterval() time.Duration {
+	if m != nil {
+		return m.HeartbeatInterval
+	}
+	return 0
+}
+
 // GlobalLogStreamDescriptor is a committing result against with
 // LocalLogStreamDescriptor. Field highest_glsn is the highest position in the
 // global log space.
@@ -215,7 +267,7 @@ func (m *LogStreamCommitResult) Reset()         { *m = LogStreamCommitResult{} }
 func (m *LogStreamCommitResult) String() string { return proto.CompactTextString(m) }
 func (*LogStreamCommitResult) ProtoMessage()    {}
 func (*LogStreamCommitResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6a839cf0bdc32d5, []int{3}
+	return fileDescriptor_b6a839cf0bdc32d5, []int{4}
 }
 func (m *LogStreamCommitResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,7 +354,7 @@ func (m *CommitRequest) Reset()         { *m = CommitRequest{} }
 func (m *CommitRequest) String() string { return proto.CompactTextString(m) }
 func (*CommitRequest) ProtoMessage()    {}
 func (*CommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6a839cf0bdc32d5, []int{4}
+	return fileDescriptor_b6a839cf0bdc32d5, []int{5}
 }
 func (m *CommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -352,7 +404,7 @@ func (m *CommitResponse) Reset()         { *m = CommitResponse{} }
 func (m *CommitResponse) String() string { return proto.CompactTextString(m) }
 func (*CommitResponse) ProtoMessage()    {}
 func (*CommitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6a839cf0bdc32d5, []int{5}
+	return fileDescriptor_b6a839cf0bdc32d5, []int{6}
 }
 func (m *CommitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -390,7 +442,7 @@ func (m *CommitBatchRequest) Reset()         { *m = CommitBatchRequest{} }
 func (m *CommitBatchRequest) String() string { return proto.CompactTextString(m) }
 func (*CommitBatchRequest) ProtoMessage()    {}
 func (*CommitBatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6a839cf0bdc32d5, []int{6}
+	return fileDescriptor_b6a839cf0bdc32d5, []int{7}
 }
 func (m *CommitBatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -440,7 +492,7 @@ func (m *CommitBatchResponse) Reset()         { *m = CommitBatchResponse{} }
 func (m *CommitBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*CommitBatchResponse) ProtoMessage()    {}
 func (*CommitBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6a839cf0bdc32d5, []int{7}
+	return fileDescriptor_b6a839cf0bdc32d5, []int{8}
 }
 func (m *CommitBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,6 +525,7 @@ func init() {
 	proto.RegisterType((*LogStreamUncommitReport)(nil), "varlog.snpb.LogStreamUncommitReport")
 	proto.RegisterType((*GetReportRequest)(nil), "varlog.snpb.GetReportRequest")
 	proto.RegisterType((*GetReportResponse)(nil), "varlog.snpb.GetReportResponse")
+	proto.RegisterType((*WatchReportRequest)(nil), "varlog.snpb.WatchReportRequest")
 	proto.RegisterType((*LogStreamCommitResult)(nil), "varlog.snpb.LogStreamCommitResult")
 	proto.RegisterType((*CommitRequest)(nil), "varlog.snpb.CommitRequest")
 	proto.RegisterType((*CommitResponse)(nil), "varlog.snpb.CommitResponse")
@@ -485,54 +538,59 @@ func init() {
 }
 
 var fileDescriptor_b6a839cf0bdc32d5 = []byte{
-	// 745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0xd3, 0xb4, 0x81, 0x0b, 0xe9, 0x9f, 0xab, 0xa2, 0x86, 0x20, 0xe2, 0xc8, 0xaa, 0x50,
-	0x40, 0x6a, 0x8c, 0xca, 0x82, 0x80, 0x01, 0xd2, 0xa2, 0x50, 0x11, 0x5a, 0xea, 0xb4, 0x20, 0xb1,
-	0x44, 0x4e, 0x7c, 0x75, 0xa2, 0x38, 0xbe, 0xe0, 0x3b, 0x17, 0x21, 0x36, 0x3e, 0x01, 0x1f, 0xa1,
-	0x1f, 0x85, 0xb1, 0x03, 0x43, 0x25, 0x96, 0x4e, 0x1e, 0x9c, 0x85, 0x95, 0xb5, 0x13, 0xf2, 0xd9,
-	0x71, 0xce, 0xc4, 0x51, 0x83, 0x2a, 0x95, 0xcd, 0xfe, 0xdd, 0xfb, 0xbd, 0x77, 0x3f, 0xdf, 0x7b,
-	0x97, 0x80, 0xf5, 0x81, 0x85, 0x29, 0x96, 0x89, 0x39, 0x68, 0xc9, 0x06, 0xd6, 0x9b, 0x84, 0x5a,
-	0x48, 0xed, 0x37, 0x2d, 0x34, 0xc0, 0x16, 0x45, 0x56, 0x85, 0x2d, 0xc3, 0xcc, 0xb1, 0x6a, 0x19,
-	0x58, 0xaf, 0x78, 0xb0, 0xc2, 0x86, 0xde, 0xa5, 0x1d, 0xbb, 0x55, 0x69, 0xe3, 0xbe, 0xac, 0x63,
-	0x1d, 0xcb, 0x0c, 0xd3, 0xb2, 0x8f, 0xd8, 0x9b, 0xcf, 0xe7, 0x3d, 0xf9, 0xbd, 0xd2, 0xef, 0x39,
-	0xb0, 0x56, 0xc7, 0x7a, 0x83, 0x11, 0x1f, 0x9a, 0x6d, 0xdc, 0xef, 0x77, 0xa9, 0xc2, 0xf8, 0xa1,
-	0x06, 0xb2, 0x9c, 0x68, 0x57, 0xcb, 0x0b, 0x25, 0xa1, 0x3c, 0x5f, 0x7d, 0xee, 0x3a, 0x62, 0x26,
-	0xec, 0xd9, 0xd9, 0xbe, 0x70, 0x44, 0x5e, 0xb4, 0xa7, 0xf6, 0x54, 0x2c, 0xfb, 0x5b, 0x92, 0x07,
-	0x3d, 0x5d, 0xa6, 0x9f, 0x07, 0x88, 0x54, 0xb8, 0x06, 0x25, 0x63, 0x84, 0x2f, 0x1a, 0xfc, 0x02,
-	0xd6, 0xec, 0x40, 0x97, 0x22, 0xad, 0x69, 0x18, 0xc4, 0x6c, 0xe2, 0xa3, 0x23, 0x82, 0x68, 0x3e,
-	0x59, 0x12, 0xca, 0xa9, 0xea, 0x96, 0xeb, 0x88, 0xb9, 0xc3, 0x31, 0xa4, 0x5e, 0x6f, 0xec, 0xee,
-	0x31, 0xc0, 0x85, 0x23, 0xde, 0x9b, 0x41, 0xb9, 0xde, 0xd8, 0x55, 0x72, 0x9c, 0x46, 0xdd, 0x20,
-	0xa6, 0x4f, 0x00, 0xf7, 0x63, 0xc4, 0x0d, 0x64, 0xea, 0xb4, 0x93, 0x9f, 0x63, 0xe2, 0xb7, 0x63,
-	0xc4, 0xeb, 0x0c, 0x30, 0x41, 0xe9, 0x97, 0x61, 0x0d, 0xa4, 0x8f, 0x91, 0x45, 0xba, 0xd8, 0xcc,
-	0xa7, 0x18, 0xc5, 0xc6, 0x85, 0x23, 0xde, 0xbf, 0x7c, 0x9b, 0xef, 0xfc, 0x26, 0x65, 0xd4, 0x0d,
-	0xf7, 0xc1, 0x62, 0xa7, 0xab, 0x77, 0x9a, 0x9f, 0x54, 0x8a, 0xac, 0xbe, 0x6a, 0xf5, 0xf2, 0xf3,
-	0x8c, 0xef, 0xc1, 0x6c, 0x63, 0xd7, 0xbc, 0xb1, 0xb3, 0x1e, 0xc3, 0xfb, 0x11, 0xc1, 0x93, 0xd4,
-	0xaf, 0x13, 0x51, 0x90, 0x20, 0x58, 0xae, 0xa1, 0xe0, 0x90, 0x15, 0xf4, 0xd1, 0x46, 0x84, 0x4a,
-	0xe7, 0x02, 0x58, 0xe1, 0x8a, 0x64, 0x80, 0x4d, 0x82, 0xa0, 0x01, 0x96, 0x08, 0xc5, 0x96, 0xaa,
-	0xa3, 0xa6, 0x89, 0x35, 0x34, 0xf6, 0xc0, 0xb6, 0xeb, 0x88, 0xd9, 0x86, 0xbf, 0xb4, 0x8b, 0x35,
-	0xc4, 0x5c, 0x20, 0x5f, 0xbe, 0xa9, 0x48, 0x8b, 0x92, 0x25, 0xdc, 0xab, 0x06, 0x0f, 0xc1, 0xf2,
-	0xe8, 0x93, 0x06, 0x16, 0x27, 0xf9, 0x64, 0x69, 0xae, 0x9c, 0xd9, 0x5c, 0xaf, 0x70, 0x16, 0xaf,
-	0x4c, 0xf1, 0x6b, 0x35, 0x75, 0xea, 0x88, 0x09, 0x65, 0xc9, 0x8e, 0x54, 0x89, 0xf4, 0x7d, 0x1e,
-	0xe4, 0xc2, 0x96, 0xad, 0x60, 0x89, 0xd8, 0xc6, 0x75, 0x19, 0xbc, 0x01, 0x6e, 0x50, 0x3c, 0xe8,
-	0xb6, 0x3d, 0x81, 0x24, 0x13, 0x78, 0xec, 0x3a, 0x62, 0xfa, 0xc0, 0xab, 0x31, 0xf2, 0x19, 0xcc,
-	0x11, 0x80, 0x95, 0x34, 0x63, 0xda, 0xd1, 0xa0, 0x0d, 0x72, 0xf1, 0x99, 0xf1, 0x6d, 0xfb, 0xc2,
-	0x75, 0xc4, 0xd5, 0xad, 0x2b, 0x25, 0x66, 0x35, 0x2e, 0x2f, 0x11, 0x59, 0x9d, 0x93, 0x4d, 0xc5,
-	0xc8, 0xd6, 0xfe, 0x51, 0xb6, 0x16, 0x95, 0xad, 0x8d, 0x65, 0x5f, 0x4f, 0xc8, 0x06, 0x21, 0xf5,
-	0x13, 0xb1, 0x36, 0x21, 0x1b, 0x44, 0x34, 0x4a, 0x36, 0x19, 0xd0, 0x85, 0x2b, 0x05, 0xb4, 0x35,
-	0x11, 0xd0, 0x34, 0xe3, 0x7b, 0xea, 0x85, 0xe3, 0x15, 0x1f, 0xbc, 0xab, 0x26, 0xf6, 0x87, 0x00,
-	0xb2, 0x23, 0xe7, 0xb2, 0xbc, 0x5e, 0x73, 0x32, 0xdf, 0x80, 0x6c, 0x98, 0x4b, 0x2f, 0x39, 0xcc,
-	0xc7, 0x99, 0x4d, 0x29, 0x3e, 0x96, 0x7c, 0xc6, 0x82, 0x50, 0xde, 0x6a, 0x73, 0x35, 0x69, 0x19,
-	0x2c, 0x86, 0x18, 0x76, 0xd1, 0x48, 0x3f, 0x05, 0x00, 0xfd, 0x52, 0x55, 0xa5, 0xed, 0xce, 0xff,
-	0x99, 0x72, 0x0f, 0x2c, 0x46, 0xa6, 0x1c, 0xdd, 0x3e, 0xb3, 0x8f, 0x99, 0xe5, 0xc7, 0x24, 0x52,
-	0x0e, 0xac, 0x46, 0x86, 0xf2, 0x87, 0xdd, 0xfc, 0x9a, 0x04, 0x2b, 0x21, 0x8b, 0x12, 0xfc, 0x96,
-	0xc3, 0xb7, 0xe0, 0x66, 0x78, 0x01, 0xc3, 0xbb, 0x11, 0xc9, 0xbf, 0x6f, 0xeb, 0x42, 0x71, 0xda,
-	0x72, 0xf0, 0x39, 0x13, 0x65, 0xe1, 0xa1, 0x00, 0x5f, 0x82, 0x05, 0x5f, 0x1e, 0x16, 0x22, 0xf8,
-	0x88, 0x93, 0x0a, 0x77, 0x62, 0xd7, 0xc6, 0x44, 0xf0, 0x00, 0x64, 0xb8, 0x29, 0xa0, 0x18, 0x83,
-	0xe7, 0x0f, 0xad, 0x50, 0x9a, 0x0e, 0x18, 0xb3, 0x56, 0x9f, 0x9d, 0xba, 0x45, 0xe1, 0xcc, 0x2d,
-	0x0a, 0xdf, 0x86, 0xc5, 0xc4, 0xc9, 0xb0, 0x28, 0x9c, 0x0d, 0x8b, 0x89, 0xf3, 0x61, 0x31, 0xf1,
-	0x41, 0x9a, 0x7a, 0x8c, 0xe1, 0x9f, 0xa1, 0xd6, 0x02, 0x7b, 0x7e, 0xf4, 0x27, 0x00, 0x00, 0xff,
-	0xff, 0xb2, 0xcb, 0x7a, 0x75, 0x21, 0x09, 0x00, 0x00,
+	// 832 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x6f, 0xeb, 0x44,
+	0x10, 0x8e, 0xd3, 0xb4, 0x79, 0xac, 0x49, 0x5f, 0xbb, 0x55, 0xd4, 0xbc, 0x20, 0xec, 0xc8, 0xaa,
+	0x50, 0x40, 0xaa, 0x5d, 0x85, 0x0b, 0x02, 0x0e, 0x90, 0x06, 0x85, 0x88, 0xd0, 0x52, 0xa7, 0xa5,
+	0x12, 0x97, 0xc8, 0x89, 0xb7, 0x8e, 0x15, 0xc7, 0x1b, 0xbc, 0xeb, 0x22, 0xc4, 0x9f, 0xe0, 0xc8,
+	0xb1, 0x3f, 0x85, 0x63, 0x0f, 0x1c, 0x2a, 0x21, 0xa1, 0x9e, 0x8c, 0x94, 0x5c, 0xb8, 0x72, 0xed,
+	0x09, 0x79, 0xd7, 0x71, 0xec, 0x26, 0x51, 0x83, 0x2a, 0xf5, 0xdd, 0xec, 0xd9, 0x99, 0xef, 0x9b,
+	0xd9, 0xcc, 0xf7, 0xc5, 0xe0, 0x60, 0xec, 0x61, 0x8a, 0x35, 0xe2, 0x8e, 0x7b, 0x9a, 0x83, 0xad,
+	0x2e, 0xa1, 0x1e, 0x32, 0x46, 0x5d, 0x0f, 0x8d, 0xb1, 0x47, 0x91, 0xa7, 0xb2, 0x63, 0x28, 0x5e,
+	0x1b, 0x9e, 0x83, 0x2d, 0x35, 0x4c, 0x2b, 0x1f, 0x5a, 0x36, 0x1d, 0xf8, 0x3d, 0xb5, 0x8f, 0x47,
+	0x9a, 0x85, 0x2d, 0xac, 0xb1, 0x9c, 0x9e, 0x7f, 0xc5, 0xde, 0x38, 0x5e, 0xf8, 0xc4, 0x6b, 0xcb,
+	0x92, 0x85, 0xb1, 0xe5, 0xa0, 0x79, 0x96, 0xe9, 0x7b, 0x06, 0xb5, 0xb1, 0xcb, 0xcf, 0x95, 0x7f,
+	0x37, 0xc0, 0x7e, 0x1b, 0x5b, 0x1d, 0x46, 0x7c, 0xe1, 0xf6, 0xf1, 0x68, 0x64, 0x53, 0x9d, 0xf1,
+	0x43, 0x13, 0x14, 0x12, 0x4d, 0xd9, 0x66, 0x49, 0xa8, 0x08, 0xd5, 0xcd, 0xfa, 0x17, 0x93, 0x40,
+	0x16, 0xe3, 0x9a, 0x56, 0xe3, 0x21, 0x90, 0x93, 0x4d, 0x0d, 0x8d, 0xa1, 0x81, 0x35, 0xde, 0xb2,
+	0x36, 0x1e, 0x5a, 0x1a, 0xfd, 0x79, 0x8c, 0x88, 0x9a, 0x28, 0xd0, 0x45, 0x27, 0x7e, 0x31, 0xe1,
+	0x2f, 0x60, 0xdf, 0x8f, 0x78, 0x29, 0x32, 0xbb, 0x8e, 0x43, 0xdc, 0x2e, 0xbe, 0xba, 0x22, 0x88,
+	0x96, 0xb2, 0x15, 0xa1, 0x9a, 0xab, 0x1f, 0x4f, 0x02, 0xb9, 0x78, 0x31, 0x4f, 0x69, 0xb7, 0x3b,
+	0x27, 0xa7, 0x2c, 0xe1, 0x21, 0x90, 0x3f, 0x58, 0x83, 0xb9, 0xdd, 0x39, 0xd1, 0x8b, 0x09, 0x8e,
+	0xb6, 0x43, 0x5c, 0x0e, 0x00, 0xcf, 0x96, 0x90, 0x3b, 0xc8, 0xb5, 0xe8, 0xa0, 0xb4, 0xc1, 0xc8,
+	0xdf, 0x2c, 0x21, 0x6f, 0xb3, 0x84, 0x05, 0x48, 0x1e, 0x86, 0x4d, 0x90, 0xbf, 0x46, 0x1e, 0xb1,
+	0xb1, 0x5b, 0xca, 0x31, 0x88, 0xc3, 0x87, 0x40, 0xfe, 0xf0, 0xe9, 0x36, 0xbf, 0xe7, 0x45, 0xfa,
+	0xac, 0x1a, 0x9e, 0x81, 0xed, 0x81, 0x6d, 0x0d, 0xba, 0x3f, 0x19, 0x14, 0x79, 0x23, 0xc3, 0x1b,
+	0x96, 0x36, 0x19, 0xde, 0x47, 0xeb, 0x8d, 0xdd, 0x0c, 0xc7, 0x2e, 0x84, 0x08, 0x97, 0x33, 0x80,
+	0x4f, 0x73, 0xff, 0xdc, 0xc8, 0x82, 0x02, 0xc1, 0x4e, 0x13, 0x45, 0x3f, 0xb2, 0x8e, 0x7e, 0xf4,
+	0x11, 0xa1, 0xca, 0xbd, 0x00, 0x76, 0x13, 0x41, 0x32, 0xc6, 0x2e, 0x41, 0xd0, 0x01, 0xaf, 0x09,
+	0xc5, 0x9e, 0x61, 0xa1, 0xae, 0x8b, 0x4d, 0x34, 0xdf, 0x81, 0xc6, 0x24, 0x90, 0x0b, 0x1d, 0x7e,
+	0x74, 0x82, 0x4d, 0xc4, 0xb6, 0x40, 0x7b, 0xba, 0xa9, 0x54, 0x89, 0x5e, 0x20, 0x89, 0x57, 0x13,
+	0x5e, 0x80, 0x9d, 0xd9, 0x95, 0x46, 0x12, 0x20, 0xa5, 0x6c, 0x65, 0xa3, 0x2a, 0xd6, 0x0e, 0xd4,
+	0x84, 0x04, 0xd4, 0x15, 0xfb, 0x5a, 0xcf, 0xdd, 0x06, 0x72, 0x46, 0x7f, 0xed, 0xa7, 0xa2, 0x44,
+	0x19, 0x00, 0x78, 0x69, 0xd0, 0xfe, 0x20, 0x35, 0x30, 0xd4, 0x01, 0x1c, 0x20, 0xc3, 0xa3, 0x3d,
+	0x64, 0xd0, 0xae, 0xed, 0x52, 0xe4, 0x5d, 0x1b, 0x0e, 0x9b, 0x4e, 0xac, 0xbd, 0x51, 0xb9, 0x6a,
+	0xd4, 0x99, 0x6a, 0xd4, 0x46, 0xa4, 0x9a, 0xfa, 0xab, 0x90, 0xe3, 0xb7, 0xbf, 0x65, 0x41, 0xdf,
+	0x8d, 0xcb, 0x5b, 0x51, 0xb5, 0xf2, 0xfb, 0x26, 0x28, 0xc6, 0xcd, 0x1d, 0x47, 0x4d, 0x10, 0xdf,
+	0x79, 0x29, 0x29, 0x75, 0xc0, 0x2b, 0x8a, 0xc7, 0x76, 0x3f, 0x24, 0xc8, 0x32, 0x82, 0x4f, 0x26,
+	0x81, 0x9c, 0x3f, 0x0f, 0x63, 0xad, 0xc6, 0x7a, 0x6b, 0x18, 0x25, 0xeb, 0x79, 0x86, 0xd4, 0x32,
+	0xa1, 0x0f, 0x8a, 0xcb, 0xd5, 0xc9, 0x05, 0xf2, 0xe5, 0x24, 0x90, 0xf7, 0x8e, 0x9f, 0xa5, 0xcd,
+	0xbd, 0x65, 0xca, 0x4c, 0xd1, 0x5a, 0x09, 0xda, 0xdc, 0x12, 0xda, 0xe6, 0xff, 0xa4, 0x6d, 0xa6,
+	0x69, 0x9b, 0x73, 0xda, 0x6f, 0x16, 0x68, 0x23, 0x3b, 0xe0, 0xda, 0xdb, 0x5f, 0xa0, 0x8d, 0xcc,
+	0x20, 0x0d, 0xb6, 0x68, 0x05, 0x5b, 0xcf, 0xb2, 0x82, 0xde, 0x82, 0x15, 0xe4, 0x19, 0xde, 0x67,
+	0xa1, 0x0c, 0xbf, 0x4e, 0x4a, 0xfc, 0xb9, 0xde, 0xf0, 0x87, 0x00, 0x0a, 0xb3, 0xcd, 0xe5, 0x42,
+	0x79, 0x59, 0x0f, 0xf8, 0x16, 0x14, 0x62, 0x07, 0x08, 0x95, 0xc3, 0xf6, 0x58, 0xac, 0x29, 0xcb,
+	0x0d, 0x20, 0xa9, 0xb1, 0x48, 0xfe, 0xef, 0xf6, 0x13, 0x31, 0x65, 0x07, 0x6c, 0xc7, 0x39, 0xcc,
+	0xd2, 0x94, 0x3f, 0x05, 0x00, 0x79, 0xa8, 0xce, 0x4d, 0xe1, 0x6d, 0x4c, 0x79, 0x0a, 0xb6, 0x53,
+	0x53, 0xce, 0x7c, 0x6e, 0xfd, 0x31, 0x0b, 0xc9, 0x31, 0x89, 0x52, 0x04, 0x7b, 0xa9, 0xa1, 0xf8,
+	0xb0, 0xb5, 0xbf, 0xb2, 0x60, 0x37, 0x46, 0xd1, 0xa3, 0xaf, 0x0a, 0xf8, 0x1d, 0x78, 0x27, 0xb6,
+	0x7a, 0xf8, 0x7e, 0x8a, 0xf2, 0xf1, 0xff, 0x42, 0x59, 0x5a, 0x75, 0x1c, 0x5d, 0x67, 0xa6, 0x2a,
+	0x1c, 0x09, 0x50, 0x07, 0x62, 0xc2, 0x62, 0xa1, 0x9c, 0x2a, 0x5a, 0x34, 0xdf, 0xa7, 0x51, 0x8f,
+	0x04, 0xf8, 0x15, 0xd8, 0xe2, 0x23, 0xc1, 0x72, 0x2a, 0x3b, 0xb5, 0x9d, 0xe5, 0xf7, 0x96, 0x9e,
+	0xcd, 0x9b, 0x83, 0xe7, 0x40, 0x4c, 0xdc, 0xcc, 0xa3, 0xd6, 0x16, 0x17, 0xa1, 0x5c, 0x59, 0x9d,
+	0x30, 0x47, 0xad, 0x7f, 0x7e, 0x3b, 0x91, 0x84, 0xbb, 0x89, 0x24, 0xfc, 0x3a, 0x95, 0x32, 0x37,
+	0x53, 0x49, 0xb8, 0x9b, 0x4a, 0x99, 0xfb, 0xa9, 0x94, 0xf9, 0x41, 0x59, 0xb9, 0x1a, 0xf1, 0xa7,
+	0x5e, 0x6f, 0x8b, 0x3d, 0x7f, 0xfc, 0xdf, 0x00, 0x73, 0xcf, 0xba, 0x10, 0xff, 0x09, 0x00, 0x00,
 }
 
 func (this *LogStreamUncommitReport) Equal(that interface{}) bool {
@@ -627,6 +685,9 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type LogStreamReporterClient interface {
 	GetReport(ctx context.Context, opts ...grpc.CallOption) (LogStreamReporter_GetReportClient, error)
+	// WatchReport is the push-based alternative to GetReport. The storage node
+	// sends reports only when they change or the heartbeat interval elapses.
+	WatchReport(ctx context.Context, in *WatchReportRequest, opts ...grpc.CallOption) (LogStreamReporter_WatchReportClient, error)
 	Commit(ctx context.Context, opts ...grpc.CallOption) (LogStreamReporter_CommitClient, error)
 	CommitBatch(ctx context.Context, opts ...grpc.CallOption) (LogStreamReporter_CommitBatchClient, error)
 }
@@ -670,8 +731,40 @@ func (x *logStreamReporterGetReportClient) Recv() (*GetReportResponse, error) {
 	return m, nil
 }
 
+func (c *logStreamReporterClient) WatchReport(ctx context.Context, in *WatchReportRequest, opts ...grpc.CallOption) (LogStreamReporter_WatchReportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_LogStreamReporter_serviceDesc.Streams[1], "/varlog.snpb.LogStreamReporter/WatchReport", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logStreamReporterWatchReportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LogStreamReporter_WatchReportClient interface {
+	Recv() (*GetReportResponse, error)
+	grpc.ClientStream
+}
+
+type logStreamReporterWatchReportClient struct {
+	grpc.ClientStream
+}
+
+func (x *logStreamReporterWatchReportClient) Recv() (*GetReportResponse, error) {
+	m := new(GetReportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *logStreamReporterClient) Commit(ctx context.Context, opts ...grpc.CallOption) (LogStreamReporter_CommitClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_LogStreamReporter_serviceDesc.Streams[1], "/varlog.snpb.LogStreamReporter/Commit", opts...)
+	stream, err := c.cc.NewStream(ctx, &_LogStreamReporter_serviceDesc.Streams[2], "/varlog.snpb.LogStreamReporter/Commit", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -705,7 +798,7 @@ func (x *logStreamReporterCommitClient) CloseAndRecv() (*CommitResponse, error)
 }
 
 func (c *logStreamReporterClient) CommitBatch(ctx context.Context, opts ...grpc.CallOption) (LogStreamReporter_CommitBatchClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_LogStreamReporter_serviceDesc.Streams[2], "/varlog.snpb.LogStreamReporter/CommitBatch", opts...)
+	stream, err := c.cc.NewStream(ctx, &_LogStreamReporter_serviceDesc.Streams[3], "/varlog.snpb.LogStreamReporter/CommitBatch", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -741,6 +834,9 @@ func (x *logStreamReporterCommitBatchClient) CloseAndRecv() (*CommitBatchRespons
 // LogStreamReporterServer is the server API for LogStreamReporter service.
 type LogStreamReporterServer interface {
 	GetReport(LogStreamReporter_GetReportServer) error
+	// WatchReport is the push-based alternative to GetReport. The storage node
+	// sends reports only when they change or the heartbeat interval elapses.
+	WatchReport(*WatchReportRequest, LogStreamReporter_WatchReportServer) error
 	Commit(LogStreamReporter_CommitServer) error
 	CommitBatch(LogStreamReporter_CommitBatchServer) error
 }
@@ -752,6 +848,9 @@ type UnimplementedLogStreamReporterServer struct {
 func (*UnimplementedLogStreamReporterServer) GetReport(srv LogStreamReporter_GetReportServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetReport not implemented")
 }
+func (*UnimplementedLogStreamReporterServer) WatchReport(req *WatchReportRequest, srv LogStreamReporter_WatchReportServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchReport not implemented")
+}
 func (*UnimplementedLogStreamReporterServer) Commit(srv LogStreamReporter_CommitServer) error {
 	return status.Errorf(codes.Unimplemented, "method Commit not implemented")
 }
@@ -789,6 +888,27 @@ func (x *logStreamReporterGetReportServer) Recv() (*GetReportRequest, error) {
 	return m, nil
 }
 
+func _LogStreamReporter_WatchReport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchReportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LogStreamReporterServer).WatchReport(m, &logStreamReporterWatchReportServer{stream})
+}
+
+type LogStreamReporter_WatchReportServer interface {
+	Send(*GetReportResponse) error
+	grpc.ServerStream
+}
+
+type logStreamReporterWatchReportServer struct {
+	grpc.ServerStream
+}
+
+func (x *logStreamReporterWatchReportServer) Send(m *GetReportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _LogStreamReporter_Commit_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(LogStreamReporterServer).Commit(&logStreamReporterCommitServer{stream})
 }
@@ -852,6 +972,11 @@ var _LogStreamReporter_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "WatchReport",
+			Handler:       _LogStreamReporter_WatchReport_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Commit",
 			Handler:       _LogStreamReporter_Commit_Handler,
@@ -979,6 +1104,37 @@ func (m *GetReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WatchReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.HeartbeatInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.HeartbeatInterval):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLogStreamReporter(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *LogStreamCommitResult) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1225,6 +1381,17 @@ func (m *GetReportResponse) ProtoSize() (n int) {
 	return n
 }
 
+func (m *WatchReportRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.HeartbeatInterval)
+	n += 1 + l + sovLogStreamReporter(uint64(l))
+	return n
+}
+
 func (m *LogStreamCommitResult) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -1609,6 +1776,89 @@ func (m *GetReportResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WatchReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogStreamReporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogStreamReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.HeartbeatInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogStreamReporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *LogStreamCommitResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
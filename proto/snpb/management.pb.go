@@ -126,6 +126,9 @@ type AddLogStreamReplicaRequest struct {
 	TopicID         github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 	LogStreamID     github_com_kakao_varlog_pkg_types.LogStreamID   `protobuf:"varint,4,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
 	StorageNodePath string                                          `protobuf:"bytes,5,opt,name=storage_node_path,json=storageNodePath,proto3" json:"storage_node_path,omitempty"`
+	// compacted tells that the log stream replica belongs to a compacted
+	// topic. Its storage maintains the index of record keys.
+	Compacted bool `protobuf:"varint,6,opt,name=compacted,proto3" json:"compacted,omitempty"`
 }
 
 func (m *AddLogStreamReplicaRequest) Reset()         { *m = AddLogStreamReplicaRequest{} }
@@ -196,6 +199,13 @@ func (m *AddLogStreamReplicaRequest) GetStorageNodePath() string {
 	return ""
 }
 
+func (m *AddLogStreamReplicaRequest) GetCompacted() bool {
+	if m != nil {
+		return m.Compacted
+	}
+	return false
+}
+
 type AddLogStreamReplicaResponse struct {
 	LogStreamReplica LogStreamReplicaMetadataDescriptor `protobuf:"bytes,1,opt,name=log_stream_replica,json=logStreamReplica,proto3" json:"log_stream_replica"`
 }
@@ -781,6 +791,368 @@ func (m *TrimResponse) GetResults() map[github_com_kakao_varlog_pkg_types.LogStr
 	return nil
 }
 
+type SetSyncBandwidthRequest struct {
+	ClusterID     github_com_kakao_varlog_pkg_types.ClusterID     `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3,casttype=github.com/kakao/varlog/pkg/types.ClusterID" json:"cluster_id,omitempty"`
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,2,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
+	// BytesPerSecond is the limit of bandwidth for synchronization. Zero means
+	// unlimited.
+	BytesPerSecond int64 `protobuf:"varint,3,opt,name=bytes_per_second,json=bytesPerSecond,proto3" json:"bytes_per_second,omitempty"`
+}
+
+func (m *SetSyncBandwidthRequest) Reset()         { *m = SetSyncBandwidthRequest{} }
+func (m *SetSyncBandwidthRequest) String() string { return proto.CompactTextString(m) }
+func (*SetSyncBandwidthRequest) ProtoMessage()    {}
+func (*SetSyncBandwidthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2a108895042472a, []int{12}
+}
+func (m *SetSyncBandwidthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetSyncBandwidthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetSyncBandwidthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetSyncBandwidthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetSyncBandwidthRequest.Merge(m, src)
+}
+func (m *SetSyncBandwidthRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SetSyncBandwidthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetSyncBandwidthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetSyncBandwidthRequest proto.InternalMessageInfo
+
+func (m *SetSyncBandwidthRequest) GetClusterID() github_com_kakao_varlog_pkg_types.ClusterID {
+	if m != nil {
+		return m.ClusterID
+	}
+	return 0
+}
+
+func (m *SetSyncBandwidthRequest) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.StorageNodeID
+	}
+	return 0
+}
+
+func (m *SetSyncBandwidthRequest) GetBytesPerSecond() int64 {
+	if m != nil {
+		return m.BytesPerSecond
+	}
+	return 0
+}
+
+type SetSyncBandwidthResponse struct {
+	// BytesPerSecond is the limit of bandwidth before the change.
+	BytesPerSecond int64 `protobuf:"varint,1,opt,name=bytes_per_second,json=bytesPerSecond,proto3" json:"bytes_per_second,omitempty"`
+}
+
+func (m *SetSyncBandwidthResponse) Reset()         { *m = SetSyncBandwidthResponse{} }
+func (m *SetSyncBandwidthResponse) String() string { return proto.CompactTextString(m) }
+func (*SetSyncBandwidthResponse) ProtoMessage()    {}
+func (*SetSyncBandwidthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2a108895042472a, []int{13}
+}
+func (m *SetSyncBandwidthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetSyncBandwidthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetSyncBandwidthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetSyncBandwidthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetSyncBandwidthResponse.Merge(m, src)
+}
+func (m *SetSyncBandwidthResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SetSyncBandwidthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetSyncBandwidthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetSyncBandwidthResponse proto.InternalMessageInfo
+
+func (m *SetSyncBandwidthResponse) GetBytesPerSecond() int64 {
+	if m != nil {
+		return m.BytesPerSecond
+	}
+	return 0
+}
+
+type SetAppendQuotaRequest struct {
+	ClusterID     github_com_kakao_varlog_pkg_types.ClusterID     `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3,casttype=github.com/kakao/varlog/pkg/types.ClusterID" json:"cluster_id,omitempty"`
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,2,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
+	TopicID       github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	// LogStreamID is the log stream whose quota is changed. If it is invalid,
+	// the quota of the topic, which is shared by all log stream replicas of the
+	// topic in the storage node, is changed.
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,4,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	Quota       AppendQuota                                   `protobuf:"bytes,5,opt,name=quota,proto3" json:"quota"`
+}
+
+func (m *SetAppendQuotaRequest) Reset()         { *m = SetAppendQuotaRequest{} }
+func (m *SetAppendQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*SetAppendQuotaRequest) ProtoMessage()    {}
+func (*SetAppendQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2a108895042472a, []int{14}
+}
+func (m *SetAppendQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAppendQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAppendQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetAppendQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAppendQuotaRequest.Merge(m, src)
+}
+func (m *SetAppendQuotaRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SetAppendQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAppendQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAppendQuotaRequest proto.InternalMessageInfo
+
+func (m *SetAppendQuotaRequest) GetClusterID() github_com_kakao_varlog_pkg_types.ClusterID {
+	if m != nil {
+		return m.ClusterID
+	}
+	return 0
+}
+
+func (m *SetAppendQuotaRequest) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.StorageNodeID
+	}
+	return 0
+}
+
+func (m *SetAppendQuotaRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+func (m *SetAppendQuotaRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
+}
+
+func (m *SetAppendQuotaRequest) GetQuota() AppendQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return AppendQuota{}
+}
+
+type SetAppendQuotaResponse struct {
+	// Quota is the quota before the change.
+	Quota AppendQuota `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota"`
+}
+
+func (m *SetAppendQuotaResponse) Reset()         { *m = SetAppendQuotaResponse{} }
+func (m *SetAppendQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*SetAppendQuotaResponse) ProtoMessage()    {}
+func (*SetAppendQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2a108895042472a, []int{15}
+}
+func (m *SetAppendQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAppendQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAppendQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetAppendQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAppendQuotaResponse.Merge(m, src)
+}
+func (m *SetAppendQuotaResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SetAppendQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAppendQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAppendQuotaResponse proto.InternalMessageInfo
+
+func (m *SetAppendQuotaResponse) GetQuota() AppendQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return AppendQuota{}
+}
+
+type CheckpointLogStreamReplicaRequest struct {
+	ClusterID     github_com_kakao_varlog_pkg_types.ClusterID     `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3,casttype=github.com/kakao/varlog/pkg/types.ClusterID" json:"cluster_id,omitempty"`
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,2,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
+	TopicID       github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID   github_com_kakao_varlog_pkg_types.LogStreamID   `protobuf:"varint,4,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	// Path is an absolute path in the storage node where the checkpoint is
+	// made. It must not exist.
+	Path string `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	// Archive makes the checkpoint a gzipped tar file rather than a directory.
+	Archive bool `protobuf:"varint,6,opt,name=archive,proto3" json:"archive,omitempty"`
+}
+
+func (m *CheckpointLogStreamReplicaRequest) Reset()         { *m = CheckpointLogStreamReplicaRequest{} }
+func (m *CheckpointLogStreamReplicaRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckpointLogStreamReplicaRequest) ProtoMessage()    {}
+func (*CheckpointLogStreamReplicaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2a108895042472a, []int{16}
+}
+func (m *CheckpointLogStreamReplicaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointLogStreamReplicaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointLogStreamReplicaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointLogStreamReplicaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointLogStreamReplicaRequest.Merge(m, src)
+}
+func (m *CheckpointLogStreamReplicaRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CheckpointLogStreamReplicaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointLogStreamReplicaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointLogStreamReplicaRequest proto.InternalMessageInfo
+
+func (m *CheckpointLogStreamReplicaRequest) GetClusterID() github_com_kakao_varlog_pkg_types.ClusterID {
+	if m != nil {
+		return m.ClusterID
+	}
+	return 0
+}
+
+func (m *CheckpointLogStreamReplicaRequest) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.StorageNodeID
+	}
+	return 0
+}
+
+func (m *CheckpointLogStreamReplicaRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+func (m *CheckpointLogStreamReplicaRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
+}
+
+func (m *CheckpointLogStreamReplicaRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *CheckpointLogStreamReplicaRequest) GetArchive() bool {
+	if m != nil {
+		return m.Archive
+	}
+	return false
+}
+
+type CheckpointLogStreamReplicaResponse struct {
+	Checkpoint LogStreamReplicaCheckpoint `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint"`
+}
+
+func (m *CheckpointLogStreamReplicaResponse) Reset()         { *m = CheckpointLogStreamReplicaResponse{} }
+func (m *CheckpointLogStreamReplicaResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckpointLogStreamReplicaResponse) ProtoMessage()    {}
+func (*CheckpointLogStreamReplicaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2a108895042472a, []int{17}
+}
+func (m *CheckpointLogStreamReplicaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointLogStreamReplicaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointLogStreamReplicaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointLogStreamReplicaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointLogStreamReplicaResponse.Merge(m, src)
+}
+func (m *CheckpointLogStreamReplicaResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CheckpointLogStreamReplicaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointLogStreamReplicaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointLogStreamReplicaResponse proto.InternalMessageInfo
+
+func (m *CheckpointLogStreamReplicaResponse) GetCheckpoint() LogStreamReplicaCheckpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return LogStreamReplicaCheckpoint{}
+}
+
 func init() {
 	proto.RegisterType((*GetMetadataRequest)(nil), "varlog.snpb.GetMetadataRequest")
 	proto.RegisterType((*GetMetadataResponse)(nil), "varlog.snpb.GetMetadataResponse")
@@ -796,75 +1168,97 @@ func init() {
 	proto.RegisterType((*TrimRequest)(nil), "varlog.snpb.TrimRequest")
 	proto.RegisterType((*TrimResponse)(nil), "varlog.snpb.TrimResponse")
 	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.LogStreamID]string)(nil), "varlog.snpb.TrimResponse.ResultsEntry")
+	proto.RegisterType((*SetSyncBandwidthRequest)(nil), "varlog.snpb.SetSyncBandwidthRequest")
+	proto.RegisterType((*SetSyncBandwidthResponse)(nil), "varlog.snpb.SetSyncBandwidthResponse")
+	proto.RegisterType((*SetAppendQuotaRequest)(nil), "varlog.snpb.SetAppendQuotaRequest")
+	proto.RegisterType((*SetAppendQuotaResponse)(nil), "varlog.snpb.SetAppendQuotaResponse")
+	proto.RegisterType((*CheckpointLogStreamReplicaRequest)(nil), "varlog.snpb.CheckpointLogStreamReplicaRequest")
+	proto.RegisterType((*CheckpointLogStreamReplicaResponse)(nil), "varlog.snpb.CheckpointLogStreamReplicaResponse")
 }
 
 func init() { proto.RegisterFile("proto/snpb/management.proto", fileDescriptor_b2a108895042472a) }
 
 var fileDescriptor_b2a108895042472a = []byte{
-	// 1006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x8b, 0xdb, 0x46,
-	0x14, 0xb7, 0xd6, 0xde, 0x3f, 0x7e, 0x5a, 0x77, 0xb3, 0xe3, 0x26, 0x71, 0xb5, 0x60, 0xb9, 0x2a,
-	0xa4, 0x6e, 0x4a, 0x24, 0xea, 0x42, 0x59, 0x42, 0xda, 0x34, 0xde, 0x0d, 0x61, 0x61, 0x13, 0x82,
-	0x9c, 0x5e, 0x5a, 0xa8, 0x19, 0x4b, 0x53, 0xad, 0x6b, 0x59, 0xa3, 0x68, 0xc6, 0x0b, 0xbe, 0x86,
-	0x7e, 0x80, 0x1e, 0x7a, 0x2b, 0x94, 0x7e, 0x8b, 0xd2, 0x53, 0xaf, 0x39, 0x95, 0x1c, 0x4b, 0x0f,
-	0x2e, 0x78, 0xcf, 0xfd, 0x02, 0x39, 0x15, 0xcd, 0xc8, 0xb6, 0xe4, 0xb5, 0x31, 0x81, 0x6e, 0xd8,
-	0xc3, 0xde, 0xac, 0x99, 0xdf, 0xfb, 0xff, 0x9b, 0xf7, 0x66, 0x0c, 0x7b, 0x61, 0x44, 0x39, 0xb5,
-	0x58, 0x10, 0x76, 0xac, 0x3e, 0x0e, 0xb0, 0x47, 0xfa, 0x24, 0xe0, 0xa6, 0x58, 0x45, 0xea, 0x29,
-	0x8e, 0x7c, 0xea, 0x99, 0xf1, 0xae, 0x76, 0xc7, 0xeb, 0xf2, 0x93, 0x41, 0xc7, 0x74, 0x68, 0xdf,
-	0xf2, 0xa8, 0x47, 0x2d, 0x81, 0xe9, 0x0c, 0xbe, 0x13, 0x5f, 0x52, 0x4d, 0xfc, 0x4b, 0xca, 0x6a,
-	0x7b, 0x1e, 0xa5, 0x9e, 0x4f, 0x66, 0x28, 0xd2, 0x0f, 0xf9, 0x30, 0xd9, 0xbc, 0x29, 0x15, 0xc7,
-	0x36, 0x09, 0xc7, 0x2e, 0xe6, 0x38, 0xd9, 0x28, 0x4b, 0x47, 0xb2, 0x8b, 0xd7, 0xc5, 0x62, 0x44,
-	0x42, 0xbf, 0xeb, 0x60, 0x4e, 0x23, 0xb9, 0x6c, 0x3c, 0x07, 0xf4, 0x88, 0xf0, 0xc7, 0x09, 0xd6,
-	0x26, 0xcf, 0x07, 0x84, 0x71, 0xf4, 0x0d, 0x80, 0xe3, 0x0f, 0x18, 0x27, 0x51, 0xbb, 0xeb, 0x56,
-	0x94, 0x9a, 0x52, 0x2f, 0x35, 0xef, 0x8d, 0x47, 0x7a, 0xf1, 0x40, 0xae, 0x1e, 0x1d, 0xbe, 0x1e,
-	0xe9, 0x1f, 0xa7, 0x62, 0xe9, 0xe1, 0x1e, 0xa6, 0x96, 0x74, 0xc8, 0x0a, 0x7b, 0x9e, 0xc5, 0x87,
-	0x21, 0x61, 0xe6, 0x14, 0x6e, 0x17, 0x13, 0x7d, 0x47, 0xae, 0x31, 0x80, 0x72, 0xc6, 0x24, 0x0b,
-	0x69, 0xc0, 0x08, 0xfa, 0x16, 0xae, 0x33, 0x4e, 0x23, 0xec, 0x91, 0x76, 0x40, 0x5d, 0xd2, 0x9e,
-	0xf8, 0x2f, 0xcc, 0xab, 0x8d, 0xdb, 0x66, 0x2a, 0x8f, 0x66, 0x4b, 0x22, 0x9f, 0x50, 0x97, 0x4c,
-	0x14, 0x1d, 0x12, 0xe6, 0x44, 0xdd, 0x90, 0xd3, 0xc8, 0x2e, 0xb3, 0xf3, 0xdb, 0xc6, 0x9f, 0x79,
-	0xd0, 0x1e, 0xb8, 0xee, 0x31, 0xf5, 0x5a, 0x3c, 0x22, 0xb8, 0x6f, 0xcb, 0x54, 0xbc, 0x8d, 0x90,
-	0x91, 0x0f, 0x3b, 0x99, 0xd8, 0xba, 0x6e, 0x65, 0xad, 0xa6, 0xd4, 0xd7, 0x9b, 0x87, 0xe3, 0x91,
-	0x5e, 0x4a, 0x05, 0x23, 0xac, 0x58, 0xab, 0xad, 0x64, 0x44, 0xec, 0x52, 0x2a, 0xde, 0x23, 0x17,
-	0xb5, 0x60, 0x8b, 0xd3, 0xb0, 0xeb, 0xc4, 0x66, 0xf2, 0xc2, 0xcc, 0xfe, 0x78, 0xa4, 0x6f, 0x3e,
-	0x8b, 0xd7, 0x84, 0x81, 0x8f, 0x56, 0x1b, 0x48, 0xc0, 0xf6, 0xa6, 0xd0, 0x74, 0xe4, 0x22, 0x17,
-	0x4a, 0x3e, 0xf5, 0xda, 0x4c, 0xe4, 0x2e, 0xd6, 0x5c, 0x10, 0x9a, 0xbf, 0x1c, 0x8f, 0x74, 0x75,
-	0x9a, 0x53, 0xa1, 0xfd, 0xce, 0x6a, 0xed, 0x29, 0x01, 0x5b, 0xf5, 0xa7, 0x1f, 0x2e, 0xba, 0x0d,
-	0xbb, 0x99, 0x44, 0x85, 0x98, 0x9f, 0x54, 0xd6, 0x6b, 0x4a, 0xbd, 0x68, 0xef, 0xa4, 0x82, 0x7c,
-	0x8a, 0xf9, 0x89, 0xf1, 0x42, 0x81, 0xbd, 0x85, 0x05, 0x4d, 0x08, 0xe5, 0x00, 0x4a, 0x79, 0x9c,
-	0x30, 0x3f, 0x61, 0x93, 0x95, 0x61, 0xd3, 0xbc, 0x8a, 0xf3, 0x94, 0x6a, 0x16, 0x5e, 0x8e, 0xf4,
-	0x9c, 0x7d, 0xcd, 0x9f, 0x43, 0x1a, 0xbf, 0xe4, 0xe1, 0x86, 0x4d, 0xfa, 0xf4, 0x94, 0xa4, 0x94,
-	0x5c, 0x31, 0xea, 0xd2, 0x30, 0xca, 0xf8, 0xa1, 0x00, 0x6a, 0x8b, 0x60, 0xff, 0xaa, 0x2a, 0x97,
-	0xe9, 0x9c, 0x53, 0x28, 0xfb, 0x98, 0xf1, 0xb6, 0x43, 0xfb, 0xfd, 0x2e, 0xe7, 0xc4, 0x6d, 0x7b,
-	0x3e, 0x0b, 0xc4, 0x49, 0x2f, 0x34, 0xef, 0x8f, 0x47, 0xfa, 0xee, 0x31, 0x66, 0xfc, 0x60, 0xb2,
-	0xfb, 0xe8, 0xb8, 0xf5, 0xe4, 0xf5, 0x48, 0xbf, 0xb5, 0xda, 0x62, 0x8c, 0xb4, 0x77, 0xfd, 0x8c,
-	0xb0, 0xcf, 0x02, 0xe3, 0x77, 0x05, 0xb6, 0x25, 0x0d, 0x92, 0xee, 0xb0, 0x0f, 0x1b, 0x8c, 0x63,
-	0x3e, 0x60, 0x82, 0x03, 0xef, 0x34, 0x6a, 0x93, 0x8e, 0x30, 0x99, 0xaa, 0x33, 0xe7, 0x5b, 0x02,
-	0x67, 0x27, 0xf8, 0x65, 0xbe, 0xaf, 0x5d, 0x98, 0xef, 0x7f, 0xe7, 0xa1, 0xf4, 0x55, 0xc0, 0xae,
-	0x48, 0x7c, 0xc9, 0x48, 0x7c, 0x00, 0x5b, 0xc9, 0x54, 0x61, 0x95, 0xf5, 0x5a, 0xbe, 0xae, 0x36,
-	0xde, 0x5f, 0x4e, 0xa2, 0x64, 0x60, 0x24, 0x83, 0x64, 0x2a, 0x68, 0xfc, 0x1b, 0xf7, 0xa7, 0x61,
-	0xe0, 0x5c, 0x95, 0xf6, 0x32, 0x95, 0xf6, 0x01, 0x6c, 0x74, 0xb0, 0xd3, 0x1b, 0x84, 0xa2, 0x25,
-	0xa9, 0x8d, 0x0f, 0xb2, 0xb7, 0xcf, 0x59, 0xbd, 0xcc, 0xa6, 0x80, 0xc5, 0x11, 0x8b, 0xd2, 0x2a,
-	0x76, 0x22, 0xa8, 0xfd, 0xa4, 0x00, 0xcc, 0x36, 0x17, 0xa5, 0x5e, 0xb9, 0xb8, 0xd4, 0x57, 0x60,
-	0x13, 0xbb, 0x6e, 0x44, 0x18, 0x13, 0x05, 0x2e, 0xda, 0x93, 0x4f, 0xe3, 0x3e, 0x6c, 0x4b, 0xf7,
-	0x93, 0x3e, 0x68, 0x65, 0xfa, 0xa0, 0xda, 0xb8, 0x79, 0x2e, 0xd2, 0x6c, 0xfb, 0x33, 0x7e, 0x53,
-	0x40, 0x7d, 0x16, 0x75, 0xa7, 0xd7, 0x9c, 0x74, 0x95, 0x95, 0xff, 0xab, 0xca, 0x2d, 0x28, 0x8a,
-	0x1e, 0x9b, 0xea, 0xac, 0x9f, 0x8d, 0x47, 0xfa, 0x56, 0xdc, 0x59, 0xdf, 0xb0, 0xa1, 0x6e, 0xc5,
-	0x8a, 0x44, 0x1f, 0xfd, 0x43, 0x81, 0x6d, 0xe9, 0x79, 0x12, 0x3b, 0x83, 0xcd, 0x88, 0xb0, 0x81,
-	0xcf, 0xe3, 0xe0, 0xe3, 0xf3, 0x7b, 0x2b, 0x13, 0x7c, 0x1a, 0x6b, 0xda, 0x12, 0xf8, 0x30, 0xe0,
-	0xd1, 0xb0, 0xf9, 0xc9, 0x8b, 0x7f, 0xde, 0x94, 0x5e, 0x13, 0x4b, 0xda, 0x5d, 0xd8, 0x4e, 0xeb,
-	0x42, 0xd7, 0x20, 0xdf, 0x23, 0x43, 0x99, 0x3a, 0x3b, 0xfe, 0x89, 0xde, 0x85, 0xf5, 0x53, 0xec,
-	0x0f, 0x48, 0x52, 0x3a, 0xf9, 0x71, 0x77, 0x6d, 0x5f, 0x69, 0xfc, 0x5c, 0x00, 0x78, 0x3c, 0x7d,
-	0x60, 0x22, 0x1b, 0xd4, 0xd4, 0x4b, 0x0a, 0xe9, 0x19, 0xef, 0xcf, 0x3f, 0xeb, 0xb4, 0xda, 0x72,
-	0x80, 0x8c, 0xd2, 0xc8, 0xa1, 0xef, 0xa1, 0xbc, 0xe0, 0x52, 0x8d, 0x3e, 0xcc, 0x88, 0x2e, 0x7f,
-	0x47, 0x69, 0xf5, 0xd5, 0xc0, 0xa9, 0xad, 0xa7, 0xb0, 0x33, 0x77, 0x77, 0x46, 0xd9, 0x83, 0xb6,
-	0xf8, 0x66, 0xad, 0xdd, 0x30, 0xe5, 0xbb, 0xd8, 0x9c, 0xbc, 0x8b, 0xcd, 0x87, 0xf1, 0xbb, 0xd8,
-	0xc8, 0xa1, 0xcf, 0xa1, 0x10, 0x4f, 0x79, 0x54, 0xc9, 0xb2, 0x78, 0x36, 0x3a, 0xb5, 0xf7, 0x16,
-	0xec, 0x4c, 0x1d, 0xfa, 0x02, 0x36, 0xe4, 0xa0, 0x45, 0x5a, 0x06, 0x96, 0x99, 0xbe, 0x2b, 0xcc,
-	0x0f, 0x03, 0x67, 0xde, 0xfc, 0xac, 0x5d, 0xcc, 0x9b, 0x4f, 0x9d, 0x44, 0x29, 0x1e, 0x73, 0x6e,
-	0x4e, 0x3c, 0x75, 0xd8, 0xe6, 0xc4, 0xd3, 0x04, 0x35, 0x72, 0xcd, 0x7b, 0x2f, 0xc7, 0x55, 0xe5,
-	0xd5, 0xb8, 0xaa, 0xfc, 0x78, 0x56, 0xcd, 0xfd, 0x7a, 0x56, 0x55, 0x5e, 0x9d, 0x55, 0x73, 0x7f,
-	0x9d, 0x55, 0x73, 0x5f, 0x1b, 0x4b, 0xb9, 0x3a, 0xfd, 0xe3, 0xa2, 0xb3, 0x21, 0x7e, 0x7f, 0xfa,
-	0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0x4d, 0x55, 0xd6, 0xcd, 0x10, 0x00, 0x00,
+	// 1252 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x8f, 0xdb, 0xc4,
+	0x17, 0x8f, 0x37, 0xd9, 0xec, 0xe6, 0x65, 0xb7, 0xdd, 0xce, 0x7e, 0xdb, 0xa6, 0xee, 0x57, 0x49,
+	0xea, 0x42, 0x09, 0x45, 0x75, 0x44, 0x40, 0xa8, 0xaa, 0x5a, 0x4a, 0xb3, 0x5b, 0x55, 0x2b, 0x75,
+	0x57, 0x8b, 0x53, 0x2e, 0x54, 0x22, 0x72, 0xec, 0xc1, 0x09, 0x71, 0x3c, 0x5e, 0xcf, 0x64, 0x51,
+	0x4e, 0x48, 0x15, 0x67, 0xc4, 0x81, 0x33, 0xe2, 0xbf, 0x40, 0x9c, 0xb8, 0xf6, 0x58, 0x89, 0x03,
+	0x08, 0xa1, 0x20, 0x65, 0xaf, 0xf0, 0x0f, 0xf4, 0x84, 0x3c, 0x9e, 0x38, 0x76, 0x7e, 0x6c, 0x58,
+	0x89, 0xa2, 0x08, 0xe5, 0x66, 0xcf, 0x7c, 0xe6, 0xf3, 0xde, 0x9b, 0xf7, 0xfc, 0x99, 0xf1, 0x83,
+	0xab, 0xae, 0x47, 0x18, 0x29, 0x53, 0xc7, 0x6d, 0x94, 0x3b, 0xba, 0xa3, 0x5b, 0xb8, 0x83, 0x1d,
+	0xa6, 0xf2, 0x51, 0x94, 0x3d, 0xd6, 0x3d, 0x9b, 0x58, 0xaa, 0x3f, 0x2b, 0xdf, 0xb2, 0x5a, 0xac,
+	0xd9, 0x6d, 0xa8, 0x06, 0xe9, 0x94, 0x2d, 0x62, 0x91, 0x32, 0xc7, 0x34, 0xba, 0x9f, 0xf2, 0xb7,
+	0x80, 0xc6, 0x7f, 0x0a, 0xd6, 0xca, 0x57, 0x2d, 0x42, 0x2c, 0x1b, 0x8f, 0x50, 0xb8, 0xe3, 0xb2,
+	0x9e, 0x98, 0xbc, 0x1c, 0x10, 0xfb, 0x36, 0x31, 0xd3, 0x4d, 0x9d, 0xe9, 0x62, 0x62, 0x9b, 0x3a,
+	0x93, 0x83, 0x17, 0xf9, 0xa0, 0x87, 0x5d, 0xbb, 0x65, 0xe8, 0x8c, 0x78, 0xc1, 0xb0, 0x72, 0x04,
+	0xe8, 0x11, 0x66, 0xfb, 0x02, 0xab, 0xe1, 0xa3, 0x2e, 0xa6, 0x0c, 0x3d, 0x05, 0x30, 0xec, 0x2e,
+	0x65, 0xd8, 0xab, 0xb7, 0xcc, 0x9c, 0x54, 0x94, 0x4a, 0x9b, 0xd5, 0xbb, 0x83, 0x7e, 0x21, 0xb3,
+	0x13, 0x8c, 0xee, 0xed, 0xbe, 0xec, 0x17, 0xde, 0x8a, 0xc4, 0xd2, 0xd6, 0xdb, 0x3a, 0x29, 0x07,
+	0x0e, 0x95, 0xdd, 0xb6, 0x55, 0x66, 0x3d, 0x17, 0x53, 0x35, 0x84, 0x6b, 0x19, 0xc1, 0xb7, 0x67,
+	0x2a, 0x5d, 0xd8, 0x8e, 0x99, 0xa4, 0x2e, 0x71, 0x28, 0x46, 0x9f, 0xc0, 0x45, 0xca, 0x88, 0xa7,
+	0x5b, 0xb8, 0xee, 0x10, 0x13, 0xd7, 0x87, 0xfe, 0x73, 0xf3, 0xd9, 0xca, 0x4d, 0x35, 0xb2, 0x8f,
+	0x6a, 0x2d, 0x40, 0x1e, 0x10, 0x13, 0x0f, 0x89, 0x76, 0x31, 0x35, 0xbc, 0x96, 0xcb, 0x88, 0xa7,
+	0x6d, 0xd3, 0xc9, 0x69, 0xe5, 0x8f, 0x24, 0xc8, 0x0f, 0x4c, 0xf3, 0x31, 0xb1, 0x6a, 0xcc, 0xc3,
+	0x7a, 0x47, 0x0b, 0xb6, 0xe2, 0xdf, 0x08, 0x19, 0xd9, 0x70, 0x3e, 0x16, 0x5b, 0xcb, 0xcc, 0xad,
+	0x14, 0xa5, 0xd2, 0x6a, 0x75, 0x77, 0xd0, 0x2f, 0x6c, 0x46, 0x82, 0xe1, 0x56, 0xca, 0xf3, 0xad,
+	0xc4, 0x96, 0x68, 0x9b, 0x91, 0x78, 0xf7, 0x4c, 0x54, 0x83, 0x75, 0x46, 0xdc, 0x96, 0xe1, 0x9b,
+	0x49, 0x72, 0x33, 0xb7, 0x07, 0xfd, 0xc2, 0xda, 0x13, 0x7f, 0x8c, 0x1b, 0x78, 0x73, 0xbe, 0x01,
+	0x01, 0xd6, 0xd6, 0x38, 0xd3, 0x9e, 0x89, 0x4c, 0xd8, 0xb4, 0x89, 0x55, 0xa7, 0x7c, 0xef, 0x7c,
+	0xe6, 0x14, 0x67, 0xfe, 0x60, 0xd0, 0x2f, 0x64, 0xc3, 0x3d, 0xe5, 0xec, 0xb7, 0xe6, 0xb3, 0x47,
+	0x16, 0x68, 0x59, 0x3b, 0x7c, 0x31, 0xd1, 0x4d, 0xb8, 0x10, 0xdb, 0x28, 0x57, 0x67, 0xcd, 0xdc,
+	0x6a, 0x51, 0x2a, 0x65, 0xb4, 0xf3, 0x91, 0x20, 0x0f, 0x75, 0xd6, 0x44, 0xff, 0x87, 0x8c, 0x41,
+	0x3a, 0xae, 0x6e, 0x30, 0x6c, 0xe6, 0xd2, 0x45, 0xa9, 0xb4, 0xae, 0x8d, 0x06, 0x94, 0x67, 0x12,
+	0x5c, 0x9d, 0x9a, 0x6e, 0x51, 0x6e, 0x06, 0xa0, 0x48, 0x3c, 0xe2, 0xbb, 0x10, 0xb5, 0x56, 0x8e,
+	0xd5, 0xda, 0x38, 0xc5, 0x64, 0xc1, 0x55, 0x53, 0xcf, 0xfb, 0x85, 0x84, 0xb6, 0x65, 0x8f, 0x21,
+	0x95, 0x6f, 0x93, 0x70, 0x49, 0xc3, 0x1d, 0x72, 0x8c, 0x23, 0x24, 0xcb, 0x7a, 0x5b, 0x98, 0x7a,
+	0x53, 0xbe, 0x4c, 0x41, 0xb6, 0x86, 0x75, 0x7b, 0x99, 0x95, 0x45, 0x52, 0x01, 0x02, 0xdb, 0xb6,
+	0x4e, 0x59, 0xdd, 0x20, 0x9d, 0x4e, 0x8b, 0x31, 0x6c, 0xd6, 0x2d, 0x9b, 0x3a, 0x5c, 0x07, 0x52,
+	0xd5, 0xfb, 0x83, 0x7e, 0xe1, 0xc2, 0x63, 0x9d, 0xb2, 0x9d, 0xe1, 0xec, 0xa3, 0xc7, 0xb5, 0x83,
+	0x97, 0xfd, 0xc2, 0x8d, 0xf9, 0x16, 0x7d, 0xa4, 0x76, 0xc1, 0x8e, 0x2d, 0xb6, 0xa9, 0xa3, 0xfc,
+	0x20, 0xc1, 0x46, 0x50, 0x06, 0x42, 0x1d, 0x6e, 0x43, 0x9a, 0x32, 0x9d, 0x75, 0x29, 0xaf, 0x81,
+	0x73, 0x95, 0xe2, 0x50, 0x11, 0x86, 0x67, 0xee, 0xc8, 0xf9, 0x1a, 0xc7, 0x69, 0x02, 0x3f, 0xcb,
+	0xf7, 0x95, 0x57, 0xe6, 0xfb, 0xaf, 0x49, 0xd8, 0xfc, 0xc8, 0xa1, 0xcb, 0x22, 0x5e, 0xb0, 0x22,
+	0xde, 0x81, 0x75, 0x71, 0xaa, 0xd0, 0xdc, 0x6a, 0x31, 0x59, 0xca, 0x56, 0xae, 0xcd, 0x2e, 0x22,
+	0x71, 0x60, 0x88, 0x83, 0x24, 0x5c, 0xa8, 0xfc, 0xe9, 0xeb, 0x53, 0xcf, 0x31, 0x96, 0xa9, 0x5d,
+	0xa4, 0xd4, 0x3e, 0x80, 0x74, 0x43, 0x37, 0xda, 0x5d, 0x97, 0x4b, 0x52, 0xb6, 0x72, 0x3d, 0x7e,
+	0x37, 0x1d, 0xe5, 0x4b, 0xad, 0x72, 0x98, 0x1f, 0x31, 0x4f, 0xad, 0xa4, 0x89, 0x85, 0xf2, 0x37,
+	0x12, 0xc0, 0x68, 0x72, 0xda, 0xd6, 0x4b, 0xaf, 0x6e, 0xeb, 0x73, 0xb0, 0xa6, 0x9b, 0xa6, 0x87,
+	0x29, 0xe5, 0x09, 0xce, 0x68, 0xc3, 0x57, 0xe5, 0x3e, 0x6c, 0x04, 0xee, 0x0b, 0x1d, 0x2c, 0xc7,
+	0x74, 0x30, 0x5b, 0xb9, 0x3c, 0x11, 0x69, 0x5c, 0xfe, 0x94, 0xef, 0x25, 0xc8, 0x3e, 0xf1, 0x5a,
+	0xe1, 0x35, 0x27, 0x9a, 0x65, 0xe9, 0x9f, 0xca, 0x72, 0x0d, 0x32, 0x5c, 0x63, 0x23, 0xca, 0xfa,
+	0xde, 0xa0, 0x5f, 0x58, 0xf7, 0x95, 0xf5, 0x8c, 0x82, 0xba, 0xee, 0x13, 0x71, 0x1d, 0xfd, 0x51,
+	0x82, 0x8d, 0xc0, 0x73, 0x11, 0x3b, 0x85, 0x35, 0x0f, 0xd3, 0xae, 0xcd, 0xfc, 0xe0, 0xfd, 0xef,
+	0xf7, 0x46, 0x2c, 0xf8, 0x28, 0x56, 0xd5, 0x02, 0xe0, 0x43, 0x87, 0x79, 0xbd, 0xea, 0xdb, 0xcf,
+	0x7e, 0x3f, 0x6b, 0x79, 0x0d, 0x2d, 0xc9, 0x77, 0x60, 0x23, 0xca, 0x85, 0xb6, 0x20, 0xd9, 0xc6,
+	0xbd, 0x60, 0xeb, 0x34, 0xff, 0x11, 0xfd, 0x0f, 0x56, 0x8f, 0x75, 0xbb, 0x8b, 0x45, 0xea, 0x82,
+	0x97, 0x3b, 0x2b, 0xb7, 0x25, 0xe5, 0xab, 0x15, 0xb8, 0x5c, 0xc3, 0xcc, 0xcf, 0x4a, 0x55, 0x77,
+	0xcc, 0xcf, 0x5b, 0x26, 0x6b, 0xfe, 0x07, 0x85, 0xa3, 0x04, 0x5b, 0x8d, 0x1e, 0xc3, 0xb4, 0xee,
+	0x62, 0xaf, 0x4e, 0xb1, 0x41, 0x9c, 0x40, 0x40, 0x92, 0xda, 0x39, 0x3e, 0x7e, 0x88, 0xbd, 0x1a,
+	0x1f, 0x55, 0x76, 0x21, 0x37, 0xb9, 0x1f, 0x22, 0xbb, 0xd3, 0x58, 0xa4, 0xa9, 0x2c, 0x3f, 0x25,
+	0xe1, 0x62, 0x0d, 0xb3, 0x07, 0xae, 0x8b, 0x1d, 0xf3, 0xc3, 0x2e, 0x61, 0xcb, 0x7f, 0xc6, 0x85,
+	0x52, 0xe3, 0x77, 0x61, 0xf5, 0xc8, 0xcf, 0x8a, 0x10, 0xe3, 0x5c, 0xec, 0x2b, 0x8d, 0x64, 0x4d,
+	0x1c, 0xae, 0x01, 0x58, 0x39, 0x80, 0x4b, 0xe3, 0x49, 0x15, 0x95, 0x11, 0xf2, 0x49, 0x67, 0xe1,
+	0xfb, 0x39, 0x09, 0xd7, 0x76, 0x9a, 0xd8, 0x68, 0xbb, 0xa4, 0xe5, 0xb0, 0x65, 0x97, 0x61, 0x91,
+	0x2b, 0x06, 0x41, 0x2a, 0xd2, 0x58, 0xe0, 0xcf, 0xfc, 0x4c, 0xf4, 0x8c, 0x66, 0xeb, 0x18, 0x8b,
+	0x5e, 0xc2, 0xf0, 0x55, 0xa1, 0xa0, 0x9c, 0x96, 0x58, 0x51, 0x35, 0xfb, 0x00, 0x46, 0x88, 0x12,
+	0xa5, 0xf3, 0xc6, 0xa9, 0x7d, 0x84, 0x11, 0xa9, 0xa8, 0xa4, 0x08, 0x41, 0xe5, 0xb7, 0x34, 0xc0,
+	0x7e, 0xd8, 0x4a, 0x44, 0x1a, 0x64, 0x23, 0x3d, 0x33, 0x54, 0x88, 0x11, 0x4f, 0x36, 0xf0, 0xe4,
+	0xe2, 0x6c, 0x40, 0xe0, 0xaf, 0x92, 0x40, 0x9f, 0xc1, 0xf6, 0x94, 0x06, 0x09, 0x8a, 0x3b, 0x3d,
+	0xbb, 0x63, 0x26, 0x97, 0xe6, 0x03, 0x43, 0x5b, 0x87, 0x70, 0x7e, 0xac, 0x0f, 0x82, 0xe2, 0x97,
+	0xa6, 0xe9, 0x5d, 0x12, 0xf9, 0x92, 0x1a, 0x74, 0x40, 0xd5, 0x61, 0x07, 0x54, 0x7d, 0xe8, 0x77,
+	0x40, 0x95, 0x04, 0xba, 0x07, 0x29, 0xff, 0x8f, 0x0d, 0xc5, 0x3f, 0xcf, 0xc8, 0xbf, 0xbc, 0x7c,
+	0x65, 0xca, 0x4c, 0xe8, 0xd0, 0xfb, 0x90, 0x0e, 0x7e, 0x9a, 0x90, 0x1c, 0x83, 0xc5, 0xfe, 0xa4,
+	0xe6, 0x98, 0xef, 0x39, 0xc6, 0xb8, 0xf9, 0xd1, 0xd5, 0x4f, 0xbe, 0x32, 0x65, 0x26, 0x34, 0x7f,
+	0x0f, 0x52, 0xfe, 0xfd, 0x61, 0x6c, 0x79, 0xe4, 0xe2, 0x24, 0x5f, 0x99, 0x32, 0x13, 0x2e, 0xd7,
+	0x61, 0x6b, 0xfc, 0x60, 0x43, 0xaf, 0x8d, 0x85, 0x3b, 0xf5, 0x1e, 0x20, 0xbf, 0x3e, 0x07, 0x15,
+	0x9a, 0x78, 0x0a, 0xe7, 0xe2, 0xfa, 0x88, 0x94, 0xf1, 0xa5, 0x93, 0x27, 0xa2, 0x7c, 0xfd, 0x54,
+	0x4c, 0x48, 0xfe, 0x05, 0xc8, 0xb3, 0x3f, 0x29, 0xa4, 0xc6, 0x48, 0xe6, 0x8a, 0xaa, 0x5c, 0xfe,
+	0xdb, 0xf8, 0xa1, 0x03, 0xd5, 0xbb, 0xcf, 0x07, 0x79, 0xe9, 0xc5, 0x20, 0x2f, 0x7d, 0x7d, 0x92,
+	0x4f, 0x7c, 0x77, 0x92, 0x97, 0x5e, 0x9c, 0xe4, 0x13, 0xbf, 0x9c, 0xe4, 0x13, 0x1f, 0x2b, 0x33,
+	0x75, 0x25, 0xec, 0xf1, 0x37, 0xd2, 0xfc, 0xf9, 0x9d, 0xbf, 0x06, 0x00, 0x38, 0x4c, 0xa1, 0x3d,
+	0xf8, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -893,6 +1287,19 @@ type ManagementClient interface {
 	// Sync starts mirroring between two StorageNodes.
 	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error)
 	Trim(ctx context.Context, in *TrimRequest, opts ...grpc.CallOption) (*TrimResponse, error)
+	// SetSyncBandwidth changes the limit of bandwidth shared by all
+	// synchronizations in the storage node, whether they are sources or
+	// destinations.
+	SetSyncBandwidth(ctx context.Context, in *SetSyncBandwidthRequest, opts ...grpc.CallOption) (*SetSyncBandwidthResponse, error)
+	// SetAppendQuota changes the quota of appends to a topic or a log stream in
+	// the storage node. Appends exceeding either quota of the topic or the log
+	// stream fail with ResourceExhausted.
+	SetAppendQuota(ctx context.Context, in *SetAppendQuotaRequest, opts ...grpc.CallOption) (*SetAppendQuotaResponse, error)
+	// CheckpointLogStreamReplica makes a consistent point-in-time checkpoint of
+	// the log stream replica while it is running. The checkpoint has the log
+	// entries, commit context and identity of the replica, and it can be
+	// restored into a new replica by `varlogsn restore`.
+	CheckpointLogStreamReplica(ctx context.Context, in *CheckpointLogStreamReplicaRequest, opts ...grpc.CallOption) (*CheckpointLogStreamReplicaResponse, error)
 }
 
 type managementClient struct {
@@ -966,6 +1373,33 @@ func (c *managementClient) Trim(ctx context.Context, in *TrimRequest, opts ...gr
 	return out, nil
 }
 
+func (c *managementClient) SetSyncBandwidth(ctx context.Context, in *SetSyncBandwidthRequest, opts ...grpc.CallOption) (*SetSyncBandwidthResponse, error) {
+	out := new(SetSyncBandwidthResponse)
+	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/SetSyncBandwidth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) SetAppendQuota(ctx context.Context, in *SetAppendQuotaRequest, opts ...grpc.CallOption) (*SetAppendQuotaResponse, error) {
+	out := new(SetAppendQuotaResponse)
+	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/SetAppendQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) CheckpointLogStreamReplica(ctx context.Context, in *CheckpointLogStreamReplicaRequest, opts ...grpc.CallOption) (*CheckpointLogStreamReplicaResponse, error) {
+	out := new(CheckpointLogStreamReplicaResponse)
+	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/CheckpointLogStreamReplica", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ManagementServer is the server API for Management service.
 type ManagementServer interface {
 	// GetMetadata returns metadata of StorageNode.
@@ -982,6 +1416,19 @@ type ManagementServer interface {
 	// Sync starts mirroring between two StorageNodes.
 	Sync(context.Context, *SyncRequest) (*SyncResponse, error)
 	Trim(context.Context, *TrimRequest) (*TrimResponse, error)
+	// SetSyncBandwidth changes the limit of bandwidth shared by all
+	// synchronizations in the storage node, whether they are sources or
+	// destinations.
+	SetSyncBandwidth(context.Context, *SetSyncBandwidthRequest) (*SetSyncBandwidthResponse, error)
+	// SetAppendQuota changes the quota of appends to a topic or a log stream in
+	// the storage node. Appends exceeding either quota of the topic or the log
+	// stream fail with ResourceExhausted.
+	SetAppendQuota(context.Context, *SetAppendQuotaRequest) (*SetAppendQuotaResponse, error)
+	// CheckpointLogStreamReplica makes a consistent point-in-time checkpoint of
+	// the log stream replica while it is running. The checkpoint has the log
+	// entries, commit context and identity of the replica, and it can be
+	// restored into a new replica by `varlogsn restore`.
+	CheckpointLogStreamReplica(context.Context, *CheckpointLogStreamReplicaRequest) (*CheckpointLogStreamReplicaResponse, error)
 }
 
 // UnimplementedManagementServer can be embedded to have forward compatible implementations.
@@ -1009,6 +1456,15 @@ func (*UnimplementedManagementServer) Sync(ctx context.Context, req *SyncRequest
 func (*UnimplementedManagementServer) Trim(ctx context.Context, req *TrimRequest) (*TrimResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Trim not implemented")
 }
+func (*UnimplementedManagementServer) SetSyncBandwidth(ctx context.Context, req *SetSyncBandwidthRequest) (*SetSyncBandwidthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSyncBandwidth not implemented")
+}
+func (*UnimplementedManagementServer) SetAppendQuota(ctx context.Context, req *SetAppendQuotaRequest) (*SetAppendQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAppendQuota not implemented")
+}
+func (*UnimplementedManagementServer) CheckpointLogStreamReplica(ctx context.Context, req *CheckpointLogStreamReplicaRequest) (*CheckpointLogStreamReplicaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointLogStreamReplica not implemented")
+}
 
 func RegisterManagementServer(s *grpc.Server, srv ManagementServer) {
 	s.RegisterService(&_Management_serviceDesc, srv)
@@ -1140,11 +1596,65 @@ func _Management_Trim_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Management_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "varlog.snpb.Management",
-	HandlerType: (*ManagementServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
+func _Management_SetSyncBandwidth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSyncBandwidthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).SetSyncBandwidth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.snpb.Management/SetSyncBandwidth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).SetSyncBandwidth(ctx, req.(*SetSyncBandwidthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_SetAppendQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAppendQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).SetAppendQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.snpb.Management/SetAppendQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).SetAppendQuota(ctx, req.(*SetAppendQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_CheckpointLogStreamReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointLogStreamReplicaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).CheckpointLogStreamReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.snpb.Management/CheckpointLogStreamReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).CheckpointLogStreamReplica(ctx, req.(*CheckpointLogStreamReplicaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Management_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "varlog.snpb.Management",
+	HandlerType: (*ManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
 			MethodName: "GetMetadata",
 			Handler:    _Management_GetMetadata_Handler,
 		},
@@ -1172,6 +1682,18 @@ var _Management_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Trim",
 			Handler:    _Management_Trim_Handler,
 		},
+		{
+			MethodName: "SetSyncBandwidth",
+			Handler:    _Management_SetSyncBandwidth_Handler,
+		},
+		{
+			MethodName: "SetAppendQuota",
+			Handler:    _Management_SetAppendQuota_Handler,
+		},
+		{
+			MethodName: "CheckpointLogStreamReplica",
+			Handler:    _Management_CheckpointLogStreamReplica_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/snpb/management.proto",
@@ -1260,6 +1782,16 @@ func (m *AddLogStreamReplicaRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.Compacted {
+		i--
+		if m.Compacted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.StorageNodePath) > 0 {
 		i -= len(m.StorageNodePath)
 		copy(dAtA[i:], m.StorageNodePath)
@@ -1702,123 +2234,371 @@ func (m *TrimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintManagement(dAtA []byte, offset int, v uint64) int {
-	offset -= sovManagement(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SetSyncBandwidthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GetMetadataRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SetSyncBandwidthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetSyncBandwidthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.BytesPerSecond != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.BytesPerSecond))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ClusterID != 0 {
-		n += 1 + sovManagement(uint64(m.ClusterID))
+		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetMetadataResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *SetSyncBandwidthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetSyncBandwidthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetSyncBandwidthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StorageNodeMetadata != nil {
-		l = m.StorageNodeMetadata.ProtoSize()
-		n += 1 + l + sovManagement(uint64(l))
+	if m.BytesPerSecond != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.BytesPerSecond))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddLogStreamReplicaRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *SetAppendQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAppendQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAppendQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ClusterID != 0 {
-		n += 1 + sovManagement(uint64(m.ClusterID))
+	{
+		size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintManagement(dAtA, i, uint64(size))
 	}
-	if m.StorageNodeID != 0 {
-		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	i--
+	dAtA[i] = 0x2a
+	if m.LogStreamID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.TopicID != 0 {
-		n += 1 + sovManagement(uint64(m.TopicID))
+		i = encodeVarintManagement(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovManagement(uint64(m.LogStreamID))
+	if m.StorageNodeID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.StorageNodePath)
-	if l > 0 {
-		n += 1 + l + sovManagement(uint64(l))
+	if m.ClusterID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddLogStreamReplicaResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *SetAppendQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.LogStreamReplica.ProtoSize()
-	n += 1 + l + sovManagement(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RemoveLogStreamRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetAppendQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAppendQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ClusterID != 0 {
-		n += 1 + sovManagement(uint64(m.ClusterID))
-	}
-	if m.StorageNodeID != 0 {
-		n += 1 + sovManagement(uint64(m.StorageNodeID))
-	}
-	if m.TopicID != 0 {
-		n += 1 + sovManagement(uint64(m.TopicID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovManagement(uint64(m.LogStreamID))
+	{
+		size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintManagement(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SealRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *CheckpointLogStreamReplicaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointLogStreamReplicaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointLogStreamReplicaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ClusterID != 0 {
-		n += 1 + sovManagement(uint64(m.ClusterID))
-	}
-	if m.StorageNodeID != 0 {
-		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	if m.Archive {
+		i--
+		if m.Archive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.TopicID != 0 {
-		n += 1 + sovManagement(uint64(m.TopicID))
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintManagement(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.LogStreamID != 0 {
-		n += 1 + sovManagement(uint64(m.LogStreamID))
+		i = encodeVarintManagement(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.LastCommittedGLSN != 0 {
-		n += 1 + sovManagement(uint64(m.LastCommittedGLSN))
+	if m.TopicID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-
+	if m.StorageNodeID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointLogStreamReplicaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointLogStreamReplicaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointLogStreamReplicaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintManagement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintManagement(dAtA []byte, offset int, v uint64) int {
+	offset -= sovManagement(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetMetadataRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	return n
+}
+
+func (m *GetMetadataResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeMetadata != nil {
+		l = m.StorageNodeMetadata.ProtoSize()
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	return n
+}
+
+func (m *AddLogStreamReplicaRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	if m.StorageNodeID != 0 {
+		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovManagement(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovManagement(uint64(m.LogStreamID))
+	}
+	l = len(m.StorageNodePath)
+	if l > 0 {
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	if m.Compacted {
+		n += 2
+	}
+	return n
+}
+
+func (m *AddLogStreamReplicaResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LogStreamReplica.ProtoSize()
+	n += 1 + l + sovManagement(uint64(l))
+	return n
+}
+
+func (m *RemoveLogStreamRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	if m.StorageNodeID != 0 {
+		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovManagement(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovManagement(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *SealRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	if m.StorageNodeID != 0 {
+		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovManagement(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovManagement(uint64(m.LogStreamID))
+	}
+	if m.LastCommittedGLSN != 0 {
+		n += 1 + sovManagement(uint64(m.LastCommittedGLSN))
+	}
+	return n
+}
+
 func (m *SealResponse) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -1947,6 +2727,109 @@ func (m *TrimResponse) ProtoSize() (n int) {
 	return n
 }
 
+func (m *SetSyncBandwidthRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	if m.StorageNodeID != 0 {
+		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	}
+	if m.BytesPerSecond != 0 {
+		n += 1 + sovManagement(uint64(m.BytesPerSecond))
+	}
+	return n
+}
+
+func (m *SetSyncBandwidthResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BytesPerSecond != 0 {
+		n += 1 + sovManagement(uint64(m.BytesPerSecond))
+	}
+	return n
+}
+
+func (m *SetAppendQuotaRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	if m.StorageNodeID != 0 {
+		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovManagement(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovManagement(uint64(m.LogStreamID))
+	}
+	l = m.Quota.ProtoSize()
+	n += 1 + l + sovManagement(uint64(l))
+	return n
+}
+
+func (m *SetAppendQuotaResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Quota.ProtoSize()
+	n += 1 + l + sovManagement(uint64(l))
+	return n
+}
+
+func (m *CheckpointLogStreamReplicaRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	if m.StorageNodeID != 0 {
+		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovManagement(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovManagement(uint64(m.LogStreamID))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	if m.Archive {
+		n += 2
+	}
+	return n
+}
+
+func (m *CheckpointLogStreamReplicaResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Checkpoint.ProtoSize()
+	n += 1 + l + sovManagement(uint64(l))
+	return n
+}
+
 func sovManagement(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2245,14 +3128,34 @@ func (m *AddLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.StorageNodePath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipManagement(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthManagement
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compacted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compacted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -3468,6 +4371,685 @@ func (m *TrimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetSyncBandwidthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetSyncBandwidthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetSyncBandwidthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesPerSecond", wireType)
+			}
+			m.BytesPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesPerSecond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetSyncBandwidthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetSyncBandwidthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetSyncBandwidthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesPerSecond", wireType)
+			}
+			m.BytesPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesPerSecond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetAppendQuotaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetAppendQuotaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetAppendQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManagement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetAppendQuotaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetAppendQuotaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetAppendQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManagement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointLogStreamReplicaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointLogStreamReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManagement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Archive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointLogStreamReplicaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointLogStreamReplicaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointLogStreamReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManagement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipManagement(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
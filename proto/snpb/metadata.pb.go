@@ -247,61 +247,256 @@ func (m *LogStreamReplicaMetadataDescriptor) GetUpdatedTime() time.Time {
 	return time.Time{}
 }
 
+// LogStreamReplicaCheckpoint describes a consistent point-in-time checkpoint
+// of a log stream replica. The identifiers are those of the data directory of
+// the replica when the checkpoint is made.
+type LogStreamReplicaCheckpoint struct {
+	ClusterID     github_com_kakao_varlog_pkg_types.ClusterID     `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3,casttype=github.com/kakao/varlog/pkg/types.ClusterID" json:"clusterId"`
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,2,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storageNodeId"`
+	TopicID       github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topicId"`
+	LogStreamID   github_com_kakao_varlog_pkg_types.LogStreamID   `protobuf:"varint,4,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"logStreamId"`
+	// CommitContext is the last commit context in the checkpoint. It is nil if
+	// the replica has never been committed.
+	CommitContext *varlogpb.CommitContext `protobuf:"bytes,5,opt,name=commit_context,json=commitContext,proto3" json:"commitContext,omitempty"`
+	// LocalLowWatermark and LocalHighWatermark are the first and last log
+	// entries in the checkpoint, respectively.
+	LocalLowWatermark  varlogpb.LogSequenceNumber `protobuf:"bytes,6,opt,name=local_low_watermark,json=localLowWatermark,proto3" json:"localLowWatermark"`
+	LocalHighWatermark varlogpb.LogSequenceNumber `protobuf:"bytes,7,opt,name=local_high_watermark,json=localHighWatermark,proto3" json:"localHighWatermark"`
+	CreateTime         time.Time                  `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3,stdtime" json:"createTime"`
+	// Path is the directory or archive having the checkpoint in the storage
+	// node.
+	Path string `protobuf:"bytes,9,opt,name=path,proto3" json:"path"`
+}
+
+func (m *LogStreamReplicaCheckpoint) Reset()         { *m = LogStreamReplicaCheckpoint{} }
+func (m *LogStreamReplicaCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*LogStreamReplicaCheckpoint) ProtoMessage()    {}
+func (*LogStreamReplicaCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0d7c3885ca513ae, []int{2}
+}
+func (m *LogStreamReplicaCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogStreamReplicaCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogStreamReplicaCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogStreamReplicaCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogStreamReplicaCheckpoint.Merge(m, src)
+}
+func (m *LogStreamReplicaCheckpoint) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *LogStreamReplicaCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogStreamReplicaCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogStreamReplicaCheckpoint proto.InternalMessageInfo
+
+func (m *LogStreamReplicaCheckpoint) GetClusterID() github_com_kakao_varlog_pkg_types.ClusterID {
+	if m != nil {
+		return m.ClusterID
+	}
+	return 0
+}
+
+func (m *LogStreamReplicaCheckpoint) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.StorageNodeID
+	}
+	return 0
+}
+
+func (m *LogStreamReplicaCheckpoint) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+func (m *LogStreamReplicaCheckpoint) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
+}
+
+func (m *LogStreamReplicaCheckpoint) GetCommitContext() *varlogpb.CommitContext {
+	if m != nil {
+		return m.CommitContext
+	}
+	return nil
+}
+
+func (m *LogStreamReplicaCheckpoint) GetLocalLowWatermark() varlogpb.LogSequenceNumber {
+	if m != nil {
+		return m.LocalLowWatermark
+	}
+	return varlogpb.LogSequenceNumber{}
+}
+
+func (m *LogStreamReplicaCheckpoint) GetLocalHighWatermark() varlogpb.LogSequenceNumber {
+	if m != nil {
+		return m.LocalHighWatermark
+	}
+	return varlogpb.LogSequenceNumber{}
+}
+
+func (m *LogStreamReplicaCheckpoint) GetCreateTime() time.Time {
+	if m != nil {
+		return m.CreateTime
+	}
+	return time.Time{}
+}
+
+func (m *LogStreamReplicaCheckpoint) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+// AppendQuota is the limit of appends to log stream replicas in a storage
+// node. Appends exceeding the limit are rejected rather than queued so that
+// clients can back off. Zero means unlimited.
+type AppendQuota struct {
+	// BytesPerSecond is the limit of the total size of appended data per
+	// second.
+	BytesPerSecond int64 `protobuf:"varint,1,opt,name=bytes_per_second,json=bytesPerSecond,proto3" json:"bytesPerSecond"`
+	// RecordsPerSecond is the limit of the number of appended log entries per
+	// second.
+	RecordsPerSecond int64 `protobuf:"varint,2,opt,name=records_per_second,json=recordsPerSecond,proto3" json:"recordsPerSecond"`
+}
+
+func (m *AppendQuota) Reset()         { *m = AppendQuota{} }
+func (m *AppendQuota) String() string { return proto.CompactTextString(m) }
+func (*AppendQuota) ProtoMessage()    {}
+func (*AppendQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0d7c3885ca513ae, []int{3}
+}
+func (m *AppendQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppendQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppendQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppendQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppendQuota.Merge(m, src)
+}
+func (m *AppendQuota) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AppendQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppendQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppendQuota proto.InternalMessageInfo
+
+func (m *AppendQuota) GetBytesPerSecond() int64 {
+	if m != nil {
+		return m.BytesPerSecond
+	}
+	return 0
+}
+
+func (m *AppendQuota) GetRecordsPerSecond() int64 {
+	if m != nil {
+		return m.RecordsPerSecond
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*StorageNodeMetadataDescriptor)(nil), "varlog.snpb.StorageNodeMetadataDescriptor")
 	proto.RegisterType((*LogStreamReplicaMetadataDescriptor)(nil), "varlog.snpb.LogStreamReplicaMetadataDescriptor")
+	proto.RegisterType((*LogStreamReplicaCheckpoint)(nil), "varlog.snpb.LogStreamReplicaCheckpoint")
+	proto.RegisterType((*AppendQuota)(nil), "varlog.snpb.AppendQuota")
 }
 
 func init() { proto.RegisterFile("proto/snpb/metadata.proto", fileDescriptor_b0d7c3885ca513ae) }
 
 var fileDescriptor_b0d7c3885ca513ae = []byte{
-	// 727 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x8d, 0x21, 0x04, 0x32, 0xe1, 0x3d, 0xc1, 0xf0, 0x10, 0x26, 0xef, 0xbd, 0x38, 0xcd, 0xa2,
-	0x4a, 0xd5, 0x62, 0x4b, 0x74, 0x53, 0xa1, 0x6e, 0xea, 0x22, 0x51, 0x24, 0x40, 0x95, 0x53, 0x51,
-	0xa9, 0x52, 0x65, 0x4d, 0x92, 0xa9, 0x63, 0xc5, 0xce, 0xb8, 0x33, 0x63, 0x10, 0x7c, 0x05, 0x9f,
-	0xc0, 0xae, 0xbf, 0xc2, 0x92, 0x55, 0xd5, 0x95, 0x2b, 0x91, 0x4d, 0x95, 0x4f, 0x60, 0x55, 0x79,
-	0x3c, 0x8e, 0x53, 0x87, 0x28, 0xec, 0x66, 0xee, 0xbd, 0xe7, 0xdc, 0x7b, 0xcf, 0x9c, 0xc4, 0x60,
-	0x3b, 0xa0, 0x84, 0x13, 0x83, 0x0d, 0x82, 0xb6, 0xe1, 0x63, 0x8e, 0xba, 0x88, 0x23, 0x5d, 0xc4,
-	0x60, 0xe5, 0x0c, 0x51, 0x8f, 0x38, 0x7a, 0x9c, 0xab, 0xee, 0x38, 0x2e, 0xef, 0x85, 0x6d, 0xbd,
-	0x43, 0x7c, 0xc3, 0x21, 0x0e, 0x31, 0x44, 0x4d, 0x3b, 0xfc, 0x22, 0x6e, 0x09, 0x49, 0x7c, 0x4a,
-	0xb0, 0xd5, 0x7f, 0x1d, 0x42, 0x1c, 0x0f, 0x67, 0x55, 0xd8, 0x0f, 0xf8, 0x85, 0x4c, 0x6a, 0xf9,
-	0x24, 0x77, 0x7d, 0xcc, 0x38, 0xf2, 0x03, 0x59, 0xb0, 0x95, 0x74, 0x9e, 0x1a, 0xa9, 0xf1, 0xad,
-	0x08, 0xfe, 0x6f, 0x71, 0x42, 0x91, 0x83, 0x4f, 0x48, 0x17, 0x1f, 0xcb, 0xec, 0x3e, 0x66, 0x1d,
-	0xea, 0x06, 0x9c, 0x50, 0xd8, 0x03, 0xa0, 0xe3, 0x85, 0x8c, 0x63, 0x6a, 0xbb, 0x5d, 0x55, 0xa9,
-	0x2b, 0xcd, 0xbf, 0xcc, 0xc3, 0xbb, 0x48, 0x2b, 0xbf, 0x4d, 0xa2, 0x87, 0xfb, 0xa3, 0x48, 0x2b,
-	0xcb, 0x92, 0xc3, 0xee, 0x7d, 0xa4, 0x3d, 0x9f, 0xd8, 0xac, 0x8f, 0xfa, 0x88, 0x18, 0x49, 0x77,
-	0x23, 0xe8, 0x3b, 0x06, 0xbf, 0x08, 0x30, 0xd3, 0xc7, 0x58, 0x2b, 0x43, 0xc2, 0x63, 0xb0, 0xca,
-	0x92, 0x51, 0xec, 0x01, 0xe9, 0x62, 0x75, 0xa1, 0xae, 0x34, 0x2b, 0xbb, 0xff, 0xe9, 0x52, 0xb5,
-	0x74, 0x05, 0x7d, 0x62, 0x5e, 0x73, 0xf5, 0x26, 0xd2, 0x0a, 0xb7, 0x91, 0xa6, 0x8c, 0x22, 0xad,
-	0x60, 0x55, 0x58, 0x96, 0x82, 0xfb, 0x60, 0x45, 0x5e, 0x99, 0xba, 0x58, 0x5f, 0x6c, 0x56, 0x76,
-	0x1b, 0xb3, 0xa8, 0xb2, 0x75, 0xcd, 0x62, 0x4c, 0x68, 0x8d, 0x91, 0x90, 0x81, 0x0d, 0x8f, 0x38,
-	0x36, 0xe3, 0x14, 0x23, 0xdf, 0xa6, 0x38, 0xf0, 0xdc, 0x0e, 0x62, 0x6a, 0x51, 0x10, 0x1a, 0xfa,
-	0xc4, 0x8b, 0xea, 0x47, 0xc4, 0x69, 0x89, 0x32, 0x2b, 0xa9, 0x9a, 0x16, 0xd3, 0x84, 0x31, 0xfb,
-	0x28, 0xd2, 0x80, 0x97, 0xd6, 0x32, 0x6b, 0xdd, 0xcb, 0xe1, 0x18, 0xdc, 0x03, 0x25, 0xc6, 0x11,
-	0x0f, 0x99, 0xba, 0x54, 0x57, 0x9a, 0x7f, 0xcf, 0x1e, 0x3c, 0x5e, 0xb4, 0x25, 0x2a, 0x2d, 0x89,
-	0x80, 0xef, 0x01, 0x60, 0x1c, 0x51, 0x6e, 0xc7, 0x1e, 0x50, 0x4b, 0x42, 0xc3, 0xaa, 0x9e, 0x18,
-	0x44, 0x4f, 0x0d, 0xa2, 0x7f, 0x48, 0x0d, 0x62, 0x6e, 0xca, 0x91, 0xca, 0x02, 0x15, 0xc7, 0xaf,
-	0x7e, 0x6a, 0x8a, 0x95, 0x5d, 0xf7, 0x8a, 0xbf, 0xae, 0x35, 0xa5, 0xf1, 0xbd, 0x04, 0x1a, 0xf3,
-	0x37, 0x84, 0x9f, 0x01, 0x9c, 0xd6, 0x4b, 0xd8, 0xa6, 0xb2, 0xfb, 0x64, 0x6a, 0x8d, 0x3c, 0x61,
-	0xee, 0x3d, 0xd7, 0xf2, 0xd2, 0xc0, 0x57, 0x63, 0x65, 0x16, 0x84, 0x32, 0xf5, 0xd9, 0x94, 0x39,
-	0x5d, 0x0e, 0xc0, 0xf2, 0x19, 0xa6, 0xcc, 0x25, 0x03, 0x75, 0xb1, 0xae, 0x34, 0x8b, 0xe6, 0xce,
-	0x7d, 0xa4, 0x3d, 0x9b, 0x6f, 0xd5, 0xd3, 0x04, 0x64, 0xa5, 0x68, 0x18, 0x82, 0x4d, 0xc7, 0x23,
-	0x6d, 0xe4, 0xd9, 0x3d, 0xd7, 0xe9, 0xd9, 0xe7, 0x88, 0x63, 0xea, 0x23, 0xda, 0x57, 0x8b, 0x82,
-	0xf6, 0xcd, 0x28, 0xd2, 0x36, 0x92, 0x82, 0x77, 0xae, 0xd3, 0xfb, 0x98, 0xa6, 0xef, 0x23, 0xed,
-	0xe9, 0xfc, 0x6e, 0x07, 0x47, 0xad, 0x13, 0xeb, 0x21, 0x38, 0xf4, 0x63, 0x23, 0x76, 0x90, 0x67,
-	0x7b, 0xe4, 0x7c, 0xa2, 0xe9, 0x92, 0x50, 0xb6, 0xf1, 0xa0, 0x0c, 0xf8, 0x6b, 0x88, 0x07, 0x1d,
-	0x7c, 0x12, 0xfa, 0x6d, 0x4c, 0xcd, 0x6d, 0xf9, 0xd0, 0xeb, 0x82, 0xe6, 0x88, 0x9c, 0x8f, 0xb9,
-	0xad, 0xe9, 0x10, 0x0c, 0xc0, 0x3f, 0x49, 0xbb, 0xdc, 0x92, 0xa5, 0x47, 0xf7, 0xab, 0xca, 0x7e,
-	0x50, 0xf0, 0xfc, 0xb1, 0x8c, 0xf5, 0x40, 0x0c, 0x42, 0x50, 0x0c, 0x10, 0xef, 0xa9, 0xcb, 0x75,
-	0xa5, 0x59, 0xb6, 0xc4, 0x19, 0xbe, 0x00, 0x30, 0xfd, 0x4b, 0x60, 0xee, 0x25, 0xb6, 0xdb, 0x17,
-	0x1c, 0x33, 0x75, 0x25, 0x16, 0xda, 0x5a, 0x93, 0x99, 0x96, 0x7b, 0x89, 0xcd, 0x38, 0x0e, 0x4f,
-	0xc1, 0x6a, 0x87, 0x62, 0xc4, 0x71, 0x37, 0x31, 0x7f, 0x79, 0xae, 0xf9, 0xb7, 0xe4, 0x8c, 0x15,
-	0x89, 0x1b, 0xdb, 0x7f, 0x32, 0x10, 0xf3, 0x86, 0x41, 0x37, 0xe3, 0x05, 0x8f, 0xe7, 0x95, 0xb8,
-	0x8c, 0x77, 0x22, 0x90, 0xfc, 0xb0, 0xcc, 0xd7, 0x37, 0x77, 0x35, 0xe5, 0xf6, 0xae, 0xa6, 0x5c,
-	0x0d, 0x6b, 0x85, 0xeb, 0x61, 0x4d, 0xb9, 0x1d, 0xd6, 0x0a, 0x3f, 0x86, 0xb5, 0xc2, 0xa7, 0xc6,
-	0x4c, 0xbf, 0x8c, 0x3f, 0x31, 0xed, 0x92, 0x38, 0xbf, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xa6,
-	0xcd, 0x63, 0x29, 0x77, 0x06, 0x00, 0x00,
+	// 999 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x2d, 0x59, 0x3f, 0x2b, 0xcb, 0x75, 0x36, 0x49, 0xcd, 0x28, 0xa9, 0x56, 0xd5, 0xa1,
+	0x50, 0xd1, 0x98, 0x02, 0xdc, 0x4b, 0x11, 0xe4, 0x12, 0xca, 0x40, 0x6a, 0xc0, 0x71, 0x53, 0x2a,
+	0x4d, 0x81, 0x02, 0x2d, 0xb1, 0x22, 0xb7, 0x14, 0x21, 0x52, 0xcb, 0x72, 0x57, 0x71, 0x1d, 0xa0,
+	0xcf, 0xd0, 0xa0, 0x4f, 0x90, 0x5b, 0x5f, 0x25, 0x47, 0x9f, 0x8a, 0x9e, 0x58, 0x40, 0xbe, 0x14,
+	0x7a, 0x04, 0x9f, 0x0a, 0x2e, 0x57, 0x12, 0x45, 0x59, 0x90, 0x2f, 0xf5, 0x8d, 0x3b, 0x33, 0xdf,
+	0xf7, 0xed, 0xcc, 0xce, 0x8c, 0x04, 0x1e, 0x04, 0x21, 0xe5, 0xb4, 0xc3, 0x46, 0x41, 0xbf, 0xe3,
+	0x13, 0x8e, 0x6d, 0xcc, 0xb1, 0x26, 0x6c, 0xb0, 0xfa, 0x06, 0x87, 0x1e, 0x75, 0xb4, 0xd8, 0x57,
+	0x3f, 0x70, 0x5c, 0x3e, 0x18, 0xf7, 0x35, 0x8b, 0xfa, 0x1d, 0x87, 0x3a, 0xb4, 0x23, 0x62, 0xfa,
+	0xe3, 0x9f, 0xc5, 0x29, 0x21, 0x89, 0xbf, 0x12, 0x6c, 0xfd, 0xa1, 0x43, 0xa9, 0xe3, 0x91, 0x45,
+	0x14, 0xf1, 0x03, 0x7e, 0x2e, 0x9d, 0x28, 0xeb, 0xe4, 0xae, 0x4f, 0x18, 0xc7, 0x7e, 0x20, 0x03,
+	0xf6, 0x13, 0xe5, 0x95, 0x2b, 0xb5, 0xfe, 0x2c, 0x80, 0x4f, 0x7a, 0x9c, 0x86, 0xd8, 0x21, 0xa7,
+	0xd4, 0x26, 0x2f, 0xa4, 0xf7, 0x88, 0x30, 0x2b, 0x74, 0x03, 0x4e, 0x43, 0x38, 0x00, 0xc0, 0xf2,
+	0xc6, 0x8c, 0x93, 0xd0, 0x74, 0x6d, 0x55, 0x69, 0x2a, 0xed, 0x9a, 0x7e, 0x3c, 0x89, 0x50, 0xa5,
+	0x9b, 0x58, 0x8f, 0x8f, 0xa6, 0x11, 0xaa, 0xc8, 0x90, 0x63, 0xfb, 0x2a, 0x42, 0x5f, 0xa4, 0x32,
+	0x1b, 0xe2, 0x21, 0xa6, 0x9d, 0x44, 0xbd, 0x13, 0x0c, 0x9d, 0x0e, 0x3f, 0x0f, 0x08, 0xd3, 0xe6,
+	0x58, 0x63, 0x81, 0x84, 0x2f, 0xc0, 0x0e, 0x4b, 0xae, 0x62, 0x8e, 0xa8, 0x4d, 0xd4, 0xad, 0xa6,
+	0xd2, 0xae, 0x1e, 0x3e, 0xd2, 0x64, 0xd5, 0x66, 0x29, 0x68, 0xa9, 0xfb, 0xea, 0x3b, 0x1f, 0x22,
+	0x94, 0xbb, 0x88, 0x90, 0x32, 0x8d, 0x50, 0xce, 0xa8, 0xb2, 0x85, 0x0b, 0x1e, 0x81, 0xb2, 0x3c,
+	0x32, 0x35, 0xdf, 0xcc, 0xb7, 0xab, 0x87, 0xad, 0x75, 0x54, 0x8b, 0x74, 0xf5, 0x42, 0x4c, 0x68,
+	0xcc, 0x91, 0x90, 0x81, 0xbb, 0x1e, 0x75, 0x4c, 0xc6, 0x43, 0x82, 0x7d, 0x33, 0x24, 0x81, 0xe7,
+	0x5a, 0x98, 0xa9, 0x05, 0x41, 0xd8, 0xd1, 0x52, 0x2f, 0xaa, 0x9d, 0x50, 0xa7, 0x27, 0xc2, 0x8c,
+	0x24, 0x6a, 0xb5, 0x98, 0x3a, 0x8c, 0xd9, 0xa7, 0x11, 0x02, 0xde, 0x2c, 0x96, 0x19, 0x77, 0xbc,
+	0x0c, 0x8e, 0xc1, 0x27, 0xa0, 0xc8, 0x38, 0xe6, 0x63, 0xa6, 0x6e, 0x37, 0x95, 0xf6, 0xee, 0xfa,
+	0x8b, 0xc7, 0x89, 0xf6, 0x44, 0xa4, 0x21, 0x11, 0xf0, 0x25, 0x00, 0x8c, 0xe3, 0x90, 0x9b, 0x71,
+	0x0f, 0xa8, 0x45, 0x51, 0xc3, 0xba, 0x96, 0x34, 0x88, 0x36, 0x6b, 0x10, 0xed, 0xd5, 0xac, 0x41,
+	0xf4, 0xfb, 0xf2, 0x4a, 0x15, 0x81, 0x8a, 0xed, 0xef, 0xfe, 0x41, 0x8a, 0xb1, 0x38, 0x3e, 0x29,
+	0xfc, 0xfb, 0x1e, 0x29, 0xad, 0xbf, 0x8a, 0xa0, 0xb5, 0x39, 0x43, 0xf8, 0x23, 0x80, 0xab, 0xf5,
+	0x12, 0x6d, 0x53, 0x3d, 0xfc, 0x74, 0x25, 0x8d, 0x2c, 0x61, 0xe6, 0x3d, 0xf7, 0xb2, 0xa5, 0x81,
+	0x5f, 0xcd, 0x2b, 0xb3, 0x25, 0x2a, 0xd3, 0x5c, 0x4f, 0x99, 0xa9, 0xcb, 0x73, 0x50, 0x7a, 0x43,
+	0x42, 0xe6, 0xd2, 0x91, 0x9a, 0x6f, 0x2a, 0xed, 0x82, 0x7e, 0x70, 0x15, 0xa1, 0xcf, 0x37, 0xb7,
+	0xea, 0xeb, 0x04, 0x64, 0xcc, 0xd0, 0x70, 0x0c, 0xee, 0x3b, 0x1e, 0xed, 0x63, 0xcf, 0x1c, 0xb8,
+	0xce, 0xc0, 0x3c, 0xc3, 0x9c, 0x84, 0x3e, 0x0e, 0x87, 0x6a, 0x41, 0xd0, 0x3e, 0x9b, 0x46, 0xe8,
+	0x6e, 0x12, 0xf0, 0xb5, 0xeb, 0x0c, 0xbe, 0x9f, 0xb9, 0xaf, 0x22, 0xf4, 0xd9, 0x66, 0xb5, 0xe7,
+	0x27, 0xbd, 0x53, 0xe3, 0x3a, 0x38, 0xf4, 0xe3, 0x46, 0xb4, 0xb0, 0x67, 0x7a, 0xf4, 0x2c, 0x25,
+	0xba, 0x2d, 0x2a, 0xdb, 0xba, 0xb6, 0x0c, 0xe4, 0x97, 0x31, 0x19, 0x59, 0xe4, 0x74, 0xec, 0xf7,
+	0x49, 0xa8, 0x3f, 0x90, 0x0f, 0x7d, 0x47, 0xd0, 0x9c, 0xd0, 0xb3, 0x39, 0xb7, 0xb1, 0x6a, 0x82,
+	0x01, 0xb8, 0x97, 0xc8, 0x65, 0x92, 0x2c, 0xde, 0x58, 0xaf, 0x2e, 0xf5, 0xa0, 0xe0, 0x59, 0x4a,
+	0xc6, 0xb8, 0xc6, 0x06, 0x21, 0x28, 0x04, 0x98, 0x0f, 0xd4, 0x52, 0x53, 0x69, 0x57, 0x0c, 0xf1,
+	0x0d, 0x1f, 0x03, 0x38, 0x5b, 0x09, 0xcc, 0x7d, 0x4b, 0xcc, 0xfe, 0x39, 0x27, 0x4c, 0x2d, 0xc7,
+	0x85, 0x36, 0xf6, 0xa4, 0xa7, 0xe7, 0xbe, 0x25, 0x7a, 0x6c, 0x87, 0xaf, 0xc1, 0x8e, 0x15, 0x12,
+	0xcc, 0x89, 0x9d, 0x34, 0x7f, 0x65, 0x63, 0xf3, 0xef, 0xcb, 0x3b, 0x56, 0x25, 0x6e, 0xde, 0xfe,
+	0x69, 0x43, 0xcc, 0x3b, 0x0e, 0xec, 0x05, 0x2f, 0xb8, 0x39, 0xaf, 0xc4, 0x2d, 0x78, 0x53, 0x06,
+	0x39, 0x58, 0x7f, 0x94, 0x40, 0x3d, 0x3b, 0x07, 0xdd, 0x01, 0xb1, 0x86, 0x01, 0x75, 0x47, 0xfc,
+	0x16, 0xf7, 0xef, 0x6f, 0xe0, 0xa3, 0xf4, 0xfe, 0x8d, 0xe5, 0xe2, 0x21, 0xdb, 0xd6, 0xbf, 0x9b,
+	0x44, 0xa8, 0x96, 0xda, 0x38, 0x42, 0xb2, 0x96, 0xda, 0xb5, 0x42, 0xb6, 0xb3, 0x59, 0x76, 0x89,
+	0xc3, 0x58, 0x66, 0x80, 0x3f, 0x81, 0x32, 0xa7, 0x81, 0x6b, 0xc5, 0xba, 0x79, 0xa1, 0xdb, 0x9d,
+	0x44, 0xa8, 0xf4, 0x2a, 0xb6, 0x09, 0xc5, 0x92, 0x70, 0x1f, 0xdb, 0x37, 0x9b, 0x5b, 0x89, 0x33,
+	0x66, 0x28, 0xc8, 0x40, 0x2d, 0xb5, 0x99, 0x5c, 0x5b, 0xcc, 0xeb, 0xb6, 0xfe, 0xcd, 0x24, 0x42,
+	0xd5, 0x79, 0xfd, 0x85, 0x50, 0x75, 0xbe, 0x76, 0x84, 0xd8, 0xc1, 0x66, 0xb1, 0x14, 0xde, 0x48,
+	0xa3, 0x61, 0x1f, 0xec, 0x5a, 0xd4, 0xf7, 0x5d, 0x6e, 0x5a, 0x74, 0xc4, 0xc9, 0xaf, 0x5c, 0x0e,
+	0x6c, 0x63, 0x65, 0x80, 0xba, 0x22, 0xac, 0x9b, 0x44, 0xe9, 0x0f, 0xa7, 0x11, 0xda, 0xb7, 0xd2,
+	0xa6, 0xc7, 0xd4, 0x77, 0xb9, 0xf8, 0xd1, 0x37, 0x6a, 0x4b, 0x8e, 0x75, 0x9b, 0xa1, 0x78, 0xcb,
+	0x9b, 0xa1, 0xf4, 0xbf, 0x6d, 0x86, 0x1e, 0x90, 0xe3, 0x98, 0x8c, 0x5f, 0x79, 0xe3, 0xf8, 0x7d,
+	0x3c, 0xfb, 0x99, 0x4d, 0x60, 0xf3, 0xe9, 0x4b, 0x9d, 0xe1, 0x23, 0xb9, 0x6e, 0xe2, 0x25, 0x51,
+	0xd1, 0xcb, 0xd3, 0x08, 0x89, 0x73, 0xb2, 0x78, 0x5a, 0xbf, 0x2b, 0xa0, 0xfa, 0x2c, 0x08, 0xc8,
+	0xc8, 0xfe, 0x76, 0x4c, 0x39, 0x86, 0x4f, 0xc1, 0x9e, 0xd8, 0x3d, 0x66, 0x40, 0x42, 0x93, 0x11,
+	0x8b, 0x8e, 0x92, 0x59, 0xcc, 0xeb, 0x70, 0x1a, 0xa1, 0x5d, 0xe1, 0x7b, 0x49, 0xc2, 0x9e, 0xf0,
+	0x18, 0x99, 0x33, 0xd4, 0x01, 0x0c, 0x89, 0x45, 0x43, 0x7b, 0x09, 0xbf, 0x25, 0xf0, 0xf7, 0xa6,
+	0x11, 0xda, 0x93, 0xde, 0x05, 0xc3, 0x8a, 0x45, 0x7f, 0xfa, 0x61, 0xd2, 0x50, 0x2e, 0x26, 0x0d,
+	0xe5, 0xdd, 0x65, 0x23, 0xf7, 0xfe, 0xb2, 0xa1, 0x5c, 0x5c, 0x36, 0x72, 0x7f, 0x5f, 0x36, 0x72,
+	0x3f, 0xb4, 0xd6, 0xf6, 0xe7, 0xfc, 0x9f, 0x68, 0xbf, 0x28, 0xbe, 0xbf, 0xfc, 0x6f, 0x00, 0x66,
+	0xa7, 0x49, 0xa8, 0x9e, 0x0a, 0x00, 0x00,
 }
 
 func (this *StorageNodeMetadataDescriptor) Equal(that interface{}) bool {
@@ -579,6 +774,129 @@ func (m *LogStreamReplicaMetadataDescriptor) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *LogStreamReplicaCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogStreamReplicaCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogStreamReplicaCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintMetadata(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.LocalHighWatermark.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMetadata(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.LocalLowWatermark.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMetadata(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.CommitContext != nil {
+		{
+			size, err := m.CommitContext.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AppendQuota) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppendQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppendQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordsPerSecond != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.RecordsPerSecond))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BytesPerSecond != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.BytesPerSecond))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMetadata(v)
 	base := offset
@@ -656,6 +974,56 @@ func (m *LogStreamReplicaMetadataDescriptor) ProtoSize() (n int) {
 	return n
 }
 
+func (m *LogStreamReplicaCheckpoint) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovMetadata(uint64(m.ClusterID))
+	}
+	if m.StorageNodeID != 0 {
+		n += 1 + sovMetadata(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovMetadata(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovMetadata(uint64(m.LogStreamID))
+	}
+	if m.CommitContext != nil {
+		l = m.CommitContext.ProtoSize()
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	l = m.LocalLowWatermark.ProtoSize()
+	n += 1 + l + sovMetadata(uint64(l))
+	l = m.LocalHighWatermark.ProtoSize()
+	n += 1 + l + sovMetadata(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime)
+	n += 1 + l + sovMetadata(uint64(l))
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *AppendQuota) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BytesPerSecond != 0 {
+		n += 1 + sovMetadata(uint64(m.BytesPerSecond))
+	}
+	if m.RecordsPerSecond != 0 {
+		n += 1 + sovMetadata(uint64(m.RecordsPerSecond))
+	}
+	return n
+}
+
 func sovMetadata(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1207,6 +1575,387 @@ func (m *LogStreamReplicaMetadataDescriptor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LogStreamReplicaCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogStreamReplicaCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogStreamReplicaCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitContext == nil {
+				m.CommitContext = &varlogpb.CommitContext{}
+			}
+			if err := m.CommitContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalLowWatermark", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LocalLowWatermark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalHighWatermark", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LocalHighWatermark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppendQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppendQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppendQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesPerSecond", wireType)
+			}
+			m.BytesPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesPerSecond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordsPerSecond", wireType)
+			}
+			m.RecordsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordsPerSecond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMetadata(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -380,6 +380,26 @@ func (mr *MockLogIOClientMockRecorder) Append(arg0, arg1 interface{}, arg2 ...in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockLogIOClient)(nil).Append), varargs...)
 }
 
+// AppendChunkGroup mocks base method.
+func (m *MockLogIOClient) AppendChunkGroup(arg0 context.Context, arg1 ...grpc.CallOption) (snpb.LogIO_AppendChunkGroupClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AppendChunkGroup", varargs...)
+	ret0, _ := ret[0].(snpb.LogIO_AppendChunkGroupClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendChunkGroup indicates an expected call of AppendChunkGroup.
+func (mr *MockLogIOClientMockRecorder) AppendChunkGroup(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendChunkGroup", reflect.TypeOf((*MockLogIOClient)(nil).AppendChunkGroup), varargs...)
+}
+
 // LogStreamReplicaMetadata mocks base method.
 func (m *MockLogIOClient) LogStreamReplicaMetadata(arg0 context.Context, arg1 *snpb.LogStreamReplicaMetadataRequest, arg2 ...grpc.CallOption) (*snpb.LogStreamReplicaMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -400,6 +420,26 @@ func (mr *MockLogIOClientMockRecorder) LogStreamReplicaMetadata(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogStreamReplicaMetadata", reflect.TypeOf((*MockLogIOClient)(nil).LogStreamReplicaMetadata), varargs...)
 }
 
+// LookupByKey mocks base method.
+func (m *MockLogIOClient) LookupByKey(arg0 context.Context, arg1 *snpb.LookupByKeyRequest, arg2 ...grpc.CallOption) (*snpb.LookupByKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LookupByKey", varargs...)
+	ret0, _ := ret[0].(*snpb.LookupByKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupByKey indicates an expected call of LookupByKey.
+func (mr *MockLogIOClientMockRecorder) LookupByKey(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupByKey", reflect.TypeOf((*MockLogIOClient)(nil).LookupByKey), varargs...)
+}
+
 // Read mocks base method.
 func (m *MockLogIOClient) Read(arg0 context.Context, arg1 *snpb.ReadRequest, arg2 ...grpc.CallOption) (*snpb.ReadResponse, error) {
 	m.ctrl.T.Helper()
@@ -518,6 +558,20 @@ func (mr *MockLogIOServerMockRecorder) Append(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockLogIOServer)(nil).Append), arg0, arg1)
 }
 
+// AppendChunkGroup mocks base method.
+func (m *MockLogIOServer) AppendChunkGroup(arg0 snpb.LogIO_AppendChunkGroupServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendChunkGroup", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendChunkGroup indicates an expected call of AppendChunkGroup.
+func (mr *MockLogIOServerMockRecorder) AppendChunkGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendChunkGroup", reflect.TypeOf((*MockLogIOServer)(nil).AppendChunkGroup), arg0)
+}
+
 // LogStreamReplicaMetadata mocks base method.
 func (m *MockLogIOServer) LogStreamReplicaMetadata(arg0 context.Context, arg1 *snpb.LogStreamReplicaMetadataRequest) (*snpb.LogStreamReplicaMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -533,6 +587,21 @@ func (mr *MockLogIOServerMockRecorder) LogStreamReplicaMetadata(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogStreamReplicaMetadata", reflect.TypeOf((*MockLogIOServer)(nil).LogStreamReplicaMetadata), arg0, arg1)
 }
 
+// LookupByKey mocks base method.
+func (m *MockLogIOServer) LookupByKey(arg0 context.Context, arg1 *snpb.LookupByKeyRequest) (*snpb.LookupByKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupByKey", arg0, arg1)
+	ret0, _ := ret[0].(*snpb.LookupByKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupByKey indicates an expected call of LookupByKey.
+func (mr *MockLogIOServerMockRecorder) LookupByKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupByKey", reflect.TypeOf((*MockLogIOServer)(nil).LookupByKey), arg0, arg1)
+}
+
 // Read mocks base method.
 func (m *MockLogIOServer) Read(arg0 context.Context, arg1 *snpb.ReadRequest) (*snpb.ReadResponse, error) {
 	m.ctrl.T.Helper()
@@ -916,6 +985,26 @@ func (mr *MockLogStreamReporterClientMockRecorder) GetReport(arg0 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockLogStreamReporterClient)(nil).GetReport), varargs...)
 }
 
+// WatchReport mocks base method.
+func (m *MockLogStreamReporterClient) WatchReport(arg0 context.Context, arg1 *snpb.WatchReportRequest, arg2 ...grpc.CallOption) (snpb.LogStreamReporter_WatchReportClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchReport", varargs...)
+	ret0, _ := ret[0].(snpb.LogStreamReporter_WatchReportClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchReport indicates an expected call of WatchReport.
+func (mr *MockLogStreamReporterClientMockRecorder) WatchReport(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchReport", reflect.TypeOf((*MockLogStreamReporterClient)(nil).WatchReport), varargs...)
+}
+
 // MockLogStreamReporterServer is a mock of LogStreamReporterServer interface.
 type MockLogStreamReporterServer struct {
 	ctrl     *gomock.Controller
@@ -981,6 +1070,20 @@ func (mr *MockLogStreamReporterServerMockRecorder) GetReport(arg0 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockLogStreamReporterServer)(nil).GetReport), arg0)
 }
 
+// WatchReport mocks base method.
+func (m *MockLogStreamReporterServer) WatchReport(arg0 *snpb.WatchReportRequest, arg1 snpb.LogStreamReporter_WatchReportServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchReport", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchReport indicates an expected call of WatchReport.
+func (mr *MockLogStreamReporterServerMockRecorder) WatchReport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchReport", reflect.TypeOf((*MockLogStreamReporterServer)(nil).WatchReport), arg0, arg1)
+}
+
 // MockManagementClient is a mock of ManagementClient interface.
 type MockManagementClient struct {
 	ctrl     *gomock.Controller
@@ -1024,6 +1127,26 @@ func (mr *MockManagementClientMockRecorder) AddLogStreamReplica(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLogStreamReplica", reflect.TypeOf((*MockManagementClient)(nil).AddLogStreamReplica), varargs...)
 }
 
+// CheckpointLogStreamReplica mocks base method.
+func (m *MockManagementClient) CheckpointLogStreamReplica(arg0 context.Context, arg1 *snpb.CheckpointLogStreamReplicaRequest, arg2 ...grpc.CallOption) (*snpb.CheckpointLogStreamReplicaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckpointLogStreamReplica", varargs...)
+	ret0, _ := ret[0].(*snpb.CheckpointLogStreamReplicaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckpointLogStreamReplica indicates an expected call of CheckpointLogStreamReplica.
+func (mr *MockManagementClientMockRecorder) CheckpointLogStreamReplica(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckpointLogStreamReplica", reflect.TypeOf((*MockManagementClient)(nil).CheckpointLogStreamReplica), varargs...)
+}
+
 // GetMetadata mocks base method.
 func (m *MockManagementClient) GetMetadata(arg0 context.Context, arg1 *snpb.GetMetadataRequest, arg2 ...grpc.CallOption) (*snpb.GetMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -1084,6 +1207,46 @@ func (mr *MockManagementClientMockRecorder) Seal(arg0, arg1 interface{}, arg2 ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockManagementClient)(nil).Seal), varargs...)
 }
 
+// SetAppendQuota mocks base method.
+func (m *MockManagementClient) SetAppendQuota(arg0 context.Context, arg1 *snpb.SetAppendQuotaRequest, arg2 ...grpc.CallOption) (*snpb.SetAppendQuotaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetAppendQuota", varargs...)
+	ret0, _ := ret[0].(*snpb.SetAppendQuotaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAppendQuota indicates an expected call of SetAppendQuota.
+func (mr *MockManagementClientMockRecorder) SetAppendQuota(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAppendQuota", reflect.TypeOf((*MockManagementClient)(nil).SetAppendQuota), varargs...)
+}
+
+// SetSyncBandwidth mocks base method.
+func (m *MockManagementClient) SetSyncBandwidth(arg0 context.Context, arg1 *snpb.SetSyncBandwidthRequest, arg2 ...grpc.CallOption) (*snpb.SetSyncBandwidthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetSyncBandwidth", varargs...)
+	ret0, _ := ret[0].(*snpb.SetSyncBandwidthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSyncBandwidth indicates an expected call of SetSyncBandwidth.
+func (mr *MockManagementClientMockRecorder) SetSyncBandwidth(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSyncBandwidth", reflect.TypeOf((*MockManagementClient)(nil).SetSyncBandwidth), varargs...)
+}
+
 // Sync mocks base method.
 func (m *MockManagementClient) Sync(arg0 context.Context, arg1 *snpb.SyncRequest, arg2 ...grpc.CallOption) (*snpb.SyncResponse, error) {
 	m.ctrl.T.Helper()
@@ -1182,6 +1345,21 @@ func (mr *MockManagementServerMockRecorder) AddLogStreamReplica(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLogStreamReplica", reflect.TypeOf((*MockManagementServer)(nil).AddLogStreamReplica), arg0, arg1)
 }
 
+// CheckpointLogStreamReplica mocks base method.
+func (m *MockManagementServer) CheckpointLogStreamReplica(arg0 context.Context, arg1 *snpb.CheckpointLogStreamReplicaRequest) (*snpb.CheckpointLogStreamReplicaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckpointLogStreamReplica", arg0, arg1)
+	ret0, _ := ret[0].(*snpb.CheckpointLogStreamReplicaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckpointLogStreamReplica indicates an expected call of CheckpointLogStreamReplica.
+func (mr *MockManagementServerMockRecorder) CheckpointLogStreamReplica(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckpointLogStreamReplica", reflect.TypeOf((*MockManagementServer)(nil).CheckpointLogStreamReplica), arg0, arg1)
+}
+
 // GetMetadata mocks base method.
 func (m *MockManagementServer) GetMetadata(arg0 context.Context, arg1 *snpb.GetMetadataRequest) (*snpb.GetMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -1227,6 +1405,36 @@ func (mr *MockManagementServerMockRecorder) Seal(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockManagementServer)(nil).Seal), arg0, arg1)
 }
 
+// SetAppendQuota mocks base method.
+func (m *MockManagementServer) SetAppendQuota(arg0 context.Context, arg1 *snpb.SetAppendQuotaRequest) (*snpb.SetAppendQuotaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAppendQuota", arg0, arg1)
+	ret0, _ := ret[0].(*snpb.SetAppendQuotaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAppendQuota indicates an expected call of SetAppendQuota.
+func (mr *MockManagementServerMockRecorder) SetAppendQuota(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAppendQuota", reflect.TypeOf((*MockManagementServer)(nil).SetAppendQuota), arg0, arg1)
+}
+
+// SetSyncBandwidth mocks base method.
+func (m *MockManagementServer) SetSyncBandwidth(arg0 context.Context, arg1 *snpb.SetSyncBandwidthRequest) (*snpb.SetSyncBandwidthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSyncBandwidth", arg0, arg1)
+	ret0, _ := ret[0].(*snpb.SetSyncBandwidthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSyncBandwidth indicates an expected call of SetSyncBandwidth.
+func (mr *MockManagementServerMockRecorder) SetSyncBandwidth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSyncBandwidth", reflect.TypeOf((*MockManagementServer)(nil).SetSyncBandwidth), arg0, arg1)
+}
+
 // Sync mocks base method.
 func (m *MockManagementServer) Sync(arg0 context.Context, arg1 *snpb.SyncRequest) (*snpb.SyncResponse, error) {
 	m.ctrl.T.Helper()
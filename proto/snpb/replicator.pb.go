@@ -62,6 +62,9 @@ type ReplicateRequest struct {
 	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
 	LLSN        []github_com_kakao_varlog_pkg_types.LLSN      `protobuf:"varint,3,rep,packed,name=llsn,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn,omitempty"`
 	Data        [][]byte                                      `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
+	// Keys are record keys of the data. It is either empty or has the same
+	// length as the data.
+	Keys [][]byte `protobuf:"bytes,5,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
 func (m *ReplicateRequest) Reset()         { *m = ReplicateRequest{} }
@@ -125,6 +128,13 @@ func (m *ReplicateRequest) GetData() [][]byte {
 	return nil
 }
 
+func (m *ReplicateRequest) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
 type ReplicateResponse struct {
 }
 
@@ -336,6 +346,7 @@ func (m *SyncStatus) GetCurrent() SyncPosition {
 type SyncPayload struct {
 	CommitContext *varlogpb.CommitContext `protobuf:"bytes,1,opt,name=commit_context,json=commitContext,proto3" json:"commit_context,omitempty"`
 	LogEntry      *varlogpb.LogEntry      `protobuf:"bytes,2,opt,name=log_entry,json=logEntry,proto3" json:"log_entry,omitempty"`
+	FileChunk     *SyncFileChunk          `protobuf:"bytes,3,opt,name=file_chunk,json=fileChunk,proto3" json:"file_chunk,omitempty"`
 }
 
 func (m *SyncPayload) Reset()         { *m = SyncPayload{} }
@@ -385,18 +396,130 @@ func (m *SyncPayload) GetLogEntry() *varlogpb.LogEntry {
 	return nil
 }
 
+func (m *SyncPayload) GetFileChunk() *SyncFileChunk {
+	if m != nil {
+		return m.FileChunk
+	}
+	return nil
+}
+
+// SyncFileChunk is a piece of an SSTable shipped by bulk synchronization.
+type SyncFileChunk struct {
+	// Name is the name of the file, which is unique in a synchronization.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Offset is the position of the data in the file.
+	Offset int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data   []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Checksum is the CRC-32C of the data.
+	Checksum uint32 `protobuf:"varint,4,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// EOF tells that the chunk is the last one of the file.
+	EOF bool `protobuf:"varint,5,opt,name=eof,proto3" json:"eof,omitempty"`
+	// FileChecksum is the CRC-32C of the whole file. It is set only if EOF is
+	// true.
+	FileChecksum uint32 `protobuf:"varint,6,opt,name=file_checksum,json=fileChecksum,proto3" json:"file_checksum,omitempty"`
+	// Segment is set by the last chunk of a segment, which is a set of files
+	// that the destination can ingest. It has the range of log entries in the
+	// segment.
+	Segment *SyncRange `protobuf:"bytes,7,opt,name=segment,proto3" json:"segment,omitempty"`
+}
+
+func (m *SyncFileChunk) Reset()         { *m = SyncFileChunk{} }
+func (m *SyncFileChunk) String() string { return proto.CompactTextString(m) }
+func (*SyncFileChunk) ProtoMessage()    {}
+func (*SyncFileChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85705cb817486b63, []int{6}
+}
+func (m *SyncFileChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncFileChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncFileChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncFileChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncFileChunk.Merge(m, src)
+}
+func (m *SyncFileChunk) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SyncFileChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncFileChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncFileChunk proto.InternalMessageInfo
+
+func (m *SyncFileChunk) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SyncFileChunk) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *SyncFileChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *SyncFileChunk) GetChecksum() uint32 {
+	if m != nil {
+		return m.Checksum
+	}
+	return 0
+}
+
+func (m *SyncFileChunk) GetEOF() bool {
+	if m != nil {
+		return m.EOF
+	}
+	return false
+}
+
+func (m *SyncFileChunk) GetFileChecksum() uint32 {
+	if m != nil {
+		return m.FileChecksum
+	}
+	return 0
+}
+
+func (m *SyncFileChunk) GetSegment() *SyncRange {
+	if m != nil {
+		return m.Segment
+	}
+	return nil
+}
+
 type SyncInitRequest struct {
 	ClusterID   github_com_kakao_varlog_pkg_types.ClusterID `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3,casttype=github.com/kakao/varlog/pkg/types.ClusterID" json:"cluster_id,omitempty"`
 	Source      varlogpb.LogStreamReplica                   `protobuf:"bytes,2,opt,name=source,proto3" json:"source"`
 	Destination varlogpb.LogStreamReplica                   `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination"`
 	Range       SyncRange                                   `protobuf:"bytes,4,opt,name=range,proto3" json:"range"`
+	// BulkSync tells that the source can ship SSTables rather than log
+	// entries.
+	BulkSync bool `protobuf:"varint,5,opt,name=bulk_sync,json=bulkSync,proto3" json:"bulk_sync,omitempty"`
 }
 
 func (m *SyncInitRequest) Reset()         { *m = SyncInitRequest{} }
 func (m *SyncInitRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncInitRequest) ProtoMessage()    {}
 func (*SyncInitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85705cb817486b63, []int{6}
+	return fileDescriptor_85705cb817486b63, []int{7}
 }
 func (m *SyncInitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,15 +576,25 @@ func (m *SyncInitRequest) GetRange() SyncRange {
 	return SyncRange{}
 }
 
+func (m *SyncInitRequest) GetBulkSync() bool {
+	if m != nil {
+		return m.BulkSync
+	}
+	return false
+}
+
 type SyncInitResponse struct {
 	Range SyncRange `protobuf:"bytes,1,opt,name=range,proto3" json:"range"`
+	// BulkSync tells that the destination accepts SSTables shipped by the
+	// source. If it is false, the source should copy log entries one by one.
+	BulkSync bool `protobuf:"varint,2,opt,name=bulk_sync,json=bulkSync,proto3" json:"bulk_sync,omitempty"`
 }
 
 func (m *SyncInitResponse) Reset()         { *m = SyncInitResponse{} }
 func (m *SyncInitResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncInitResponse) ProtoMessage()    {}
 func (*SyncInitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85705cb817486b63, []int{7}
+	return fileDescriptor_85705cb817486b63, []int{8}
 }
 func (m *SyncInitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -497,6 +630,13 @@ func (m *SyncInitResponse) GetRange() SyncRange {
 	return SyncRange{}
 }
 
+func (m *SyncInitResponse) GetBulkSync() bool {
+	if m != nil {
+		return m.BulkSync
+	}
+	return false
+}
+
 type SyncReplicateRequest struct {
 	ClusterID   github_com_kakao_varlog_pkg_types.ClusterID `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3,casttype=github.com/kakao/varlog/pkg/types.ClusterID" json:"cluster_id,omitempty"`
 	Source      varlogpb.LogStreamReplica                   `protobuf:"bytes,2,opt,name=source,proto3" json:"source"`
@@ -508,7 +648,7 @@ func (m *SyncReplicateRequest) Reset()         { *m = SyncReplicateRequest{} }
 func (m *SyncReplicateRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncReplicateRequest) ProtoMessage()    {}
 func (*SyncReplicateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85705cb817486b63, []int{8}
+	return fileDescriptor_85705cb817486b63, []int{9}
 }
 func (m *SyncReplicateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -573,7 +713,7 @@ func (m *SyncReplicateResponse) Reset()         { *m = SyncReplicateResponse{} }
 func (m *SyncReplicateResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncReplicateResponse) ProtoMessage()    {}
 func (*SyncReplicateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85705cb817486b63, []int{9}
+	return fileDescriptor_85705cb817486b63, []int{10}
 }
 func (m *SyncReplicateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -617,6 +757,7 @@ func init() {
 	proto.RegisterType((*SyncRange)(nil), "varlog.snpb.SyncRange")
 	proto.RegisterType((*SyncStatus)(nil), "varlog.snpb.SyncStatus")
 	proto.RegisterType((*SyncPayload)(nil), "varlog.snpb.SyncPayload")
+	proto.RegisterType((*SyncFileChunk)(nil), "varlog.snpb.SyncFileChunk")
 	proto.RegisterType((*SyncInitRequest)(nil), "varlog.snpb.SyncInitRequest")
 	proto.RegisterType((*SyncInitResponse)(nil), "varlog.snpb.SyncInitResponse")
 	proto.RegisterType((*SyncReplicateRequest)(nil), "varlog.snpb.SyncReplicateRequest")
@@ -626,68 +767,77 @@ func init() {
 func init() { proto.RegisterFile("proto/snpb/replicator.proto", fileDescriptor_85705cb817486b63) }
 
 var fileDescriptor_85705cb817486b63 = []byte{
-	// 962 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xbd, 0xce, 0xa6, 0xb1, 0x9f, 0x93, 0xe0, 0x4e, 0x28, 0x31, 0x86, 0xec, 0xba, 0x8b,
-	0x84, 0xcc, 0x8f, 0x7a, 0x25, 0x57, 0x94, 0x52, 0x55, 0x02, 0xd9, 0x38, 0xc1, 0x92, 0x49, 0xa2,
-	0x71, 0x85, 0x10, 0x1c, 0xcc, 0x7a, 0x3d, 0x5d, 0x56, 0x59, 0xef, 0x2c, 0x3b, 0x63, 0x44, 0xfe,
-	0x02, 0x50, 0x4e, 0x88, 0x7b, 0xa4, 0x4a, 0xe4, 0x00, 0x17, 0xc4, 0x11, 0xfe, 0x83, 0x1c, 0x7b,
-	0xe4, 0x64, 0x09, 0xfb, 0xc2, 0xdf, 0xd0, 0x13, 0x9a, 0xd9, 0x1f, 0x71, 0xec, 0x86, 0xba, 0x82,
-	0x1b, 0xb7, 0xd9, 0x79, 0xdf, 0xf9, 0xec, 0x77, 0xdf, 0xbc, 0xf7, 0x6c, 0x78, 0x25, 0x08, 0x29,
-	0xa7, 0x26, 0xf3, 0x83, 0xbe, 0x19, 0x92, 0xc0, 0x73, 0x6d, 0x8b, 0xd3, 0xb0, 0x26, 0x77, 0x51,
-	0xe1, 0x6b, 0x2b, 0xf4, 0xa8, 0x53, 0x13, 0xd1, 0xb2, 0xee, 0x50, 0xea, 0x78, 0xc4, 0x94, 0xa1,
-	0xfe, 0xe8, 0xa1, 0xc9, 0xdd, 0x21, 0x61, 0xdc, 0x1a, 0x06, 0x91, 0xba, 0x7c, 0xcb, 0x71, 0xf9,
-	0x97, 0xa3, 0x7e, 0xcd, 0xa6, 0x43, 0xd3, 0xa1, 0x0e, 0xbd, 0x50, 0x8a, 0xa7, 0xe8, 0x3d, 0x62,
-	0x15, 0xcb, 0xb7, 0x23, 0x78, 0xd0, 0x37, 0x87, 0x84, 0x5b, 0x03, 0x8b, 0x5b, 0x51, 0xc0, 0xf8,
-	0x39, 0x0b, 0x45, 0x1c, 0x5b, 0x21, 0x98, 0x7c, 0x35, 0x22, 0x8c, 0xa3, 0x2e, 0xe4, 0x38, 0x0d,
-	0x5c, 0xbb, 0xe7, 0x0e, 0x4a, 0x4a, 0x45, 0xa9, 0xae, 0x36, 0xee, 0x4e, 0xc6, 0xfa, 0xda, 0x03,
-	0xb1, 0xd7, 0xfe, 0xf0, 0xc9, 0x58, 0x7f, 0x63, 0xe6, 0xed, 0x47, 0xd6, 0x91, 0x45, 0xcd, 0x88,
-	0x6f, 0x06, 0x47, 0x8e, 0xc9, 0x8f, 0x03, 0xc2, 0x6a, 0xb1, 0x18, 0xaf, 0x49, 0x52, 0x7b, 0x80,
-	0x06, 0xb0, 0xe1, 0x51, 0xa7, 0xc7, 0x78, 0x48, 0xac, 0xa1, 0x20, 0x67, 0x25, 0xf9, 0x83, 0xc9,
-	0x58, 0x2f, 0x74, 0xa8, 0xd3, 0x95, 0xfb, 0x92, 0x7e, 0xeb, 0xd9, 0xf4, 0x99, 0x03, 0xb8, 0xe0,
-	0xa5, 0x0f, 0x03, 0xb4, 0x0b, 0xaa, 0xe7, 0x31, 0xbf, 0xb4, 0x52, 0x59, 0xa9, 0xaa, 0x8d, 0xfa,
-	0x64, 0xac, 0xab, 0x9d, 0x4e, 0x77, 0xff, 0xc9, 0x58, 0x7f, 0x7d, 0x09, 0x6a, 0xa7, 0xbb, 0x8f,
-	0xe5, 0x79, 0x84, 0x40, 0x15, 0x59, 0x2a, 0xa9, 0x95, 0x95, 0xea, 0x3a, 0x96, 0x6b, 0x63, 0x0b,
-	0xae, 0xcf, 0xa4, 0x8a, 0x05, 0xd4, 0x67, 0xc4, 0x38, 0x53, 0x60, 0xbd, 0x7b, 0xec, 0xdb, 0x87,
-	0x94, 0xb9, 0xdc, 0xa5, 0x7e, 0xea, 0x40, 0x24, 0xee, 0xdf, 0x38, 0xd8, 0x05, 0xd5, 0x11, 0x9c,
-	0xec, 0x05, 0x67, 0x6f, 0x69, 0xce, 0x9e, 0xe4, 0x88, 0xf3, 0xf7, 0xd4, 0xbf, 0x1e, 0xe9, 0x8a,
-	0xf1, 0x9b, 0x02, 0x79, 0x61, 0x13, 0x5b, 0xbe, 0x43, 0xd0, 0x27, 0x00, 0x0f, 0xdd, 0x90, 0xf1,
-	0xde, 0x8c, 0xd3, 0x77, 0x27, 0x63, 0x3d, 0xbf, 0x2b, 0x76, 0x9f, 0xd3, 0x6e, 0x5e, 0xa2, 0x3a,
-	0xc2, 0x73, 0x17, 0xf2, 0x9e, 0x95, 0x60, 0x23, 0xe3, 0x77, 0x26, 0x63, 0x3d, 0xd7, 0xb1, 0x9e,
-	0x9b, 0x9a, 0x13, 0x20, 0x01, 0x35, 0xfe, 0x54, 0x00, 0x84, 0xf5, 0x2e, 0xb7, 0xf8, 0x88, 0xa1,
-	0xb7, 0x61, 0x95, 0x71, 0x8b, 0x13, 0x69, 0x7b, 0xb3, 0xfe, 0x52, 0x6d, 0xa6, 0x6f, 0x6a, 0x89,
-	0x8e, 0xe0, 0x48, 0x84, 0xde, 0x81, 0x55, 0x69, 0x4f, 0xba, 0x29, 0xd4, 0x5f, 0x5e, 0x50, 0x27,
-	0xf7, 0xd6, 0x50, 0xcf, 0xc7, 0x7a, 0x06, 0x47, 0x6a, 0x74, 0x1b, 0x54, 0xf1, 0xfe, 0xd2, 0xca,
-	0x72, 0xa7, 0xa4, 0x18, 0xbd, 0x07, 0x6b, 0xf6, 0x28, 0x0c, 0x89, 0xcf, 0x4b, 0xea, 0x72, 0xe7,
-	0x12, 0xbd, 0xf1, 0x83, 0x02, 0x05, 0x19, 0xb7, 0x8e, 0x3d, 0x6a, 0x0d, 0x50, 0x0b, 0x36, 0x6d,
-	0x3a, 0x1c, 0xba, 0xbc, 0x67, 0x53, 0x9f, 0x93, 0x6f, 0xb8, 0xfc, 0xda, 0x42, 0x5d, 0x4b, 0x88,
-	0x49, 0x3f, 0xd7, 0x9a, 0x52, 0xd6, 0x8c, 0x54, 0x78, 0xc3, 0x9e, 0x7d, 0x44, 0x77, 0x20, 0x2f,
-	0x7a, 0x8e, 0xf8, 0x3c, 0x3c, 0x9e, 0xcf, 0x40, 0x4a, 0xe8, 0x50, 0xa7, 0x25, 0x04, 0x38, 0xe7,
-	0xc5, 0xab, 0x7b, 0xea, 0xb9, 0xa8, 0x99, 0x5f, 0xb2, 0xf0, 0x82, 0x30, 0xd5, 0xf6, 0x5d, 0x9e,
-	0x8c, 0x86, 0xcf, 0x01, 0x6c, 0x6f, 0xc4, 0x38, 0x09, 0x93, 0xe1, 0xb0, 0xd1, 0xb8, 0x2f, 0x2a,
-	0xa7, 0x19, 0xed, 0xca, 0x06, 0x7e, 0xeb, 0xd9, 0x77, 0x9c, 0xca, 0x71, 0x3e, 0xe6, 0xb5, 0x07,
-	0xe8, 0x7d, 0xb8, 0xc6, 0xe8, 0x28, 0xb4, 0x49, 0xec, 0xf5, 0xe6, 0xd3, 0xbc, 0x46, 0xad, 0x1e,
-	0x37, 0x62, 0x9c, 0xc7, 0xf8, 0x18, 0x6a, 0x43, 0x61, 0x40, 0x18, 0x77, 0x7d, 0x4b, 0x24, 0x39,
-	0xbe, 0xbd, 0xa5, 0x29, 0xb3, 0x67, 0x51, 0x1d, 0x56, 0x43, 0xd1, 0x2b, 0xf1, 0x55, 0x2e, 0x96,
-	0x99, 0xec, 0xa4, 0xa4, 0x6a, 0xa4, 0xd4, 0xd8, 0x85, 0xe2, 0x45, 0xbe, 0xa2, 0xf9, 0x70, 0xc1,
-	0x51, 0x96, 0xe7, 0xfc, 0x9e, 0x85, 0x17, 0x65, 0x68, 0x7e, 0x30, 0xff, 0x6f, 0xb2, 0x7f, 0x17,
-	0xd6, 0x82, 0xa8, 0x15, 0xe2, 0xfc, 0x97, 0x16, 0x5b, 0x29, 0x8a, 0x27, 0x9d, 0x14, 0xcb, 0x8d,
-	0x8f, 0xe0, 0xc6, 0x5c, 0xea, 0xe2, 0x8b, 0x30, 0xe1, 0x1a, 0x93, 0x13, 0x24, 0xbe, 0x89, 0xed,
-	0xa7, 0x0e, 0x8e, 0x11, 0xc3, 0xb1, 0xec, 0xcd, 0x6f, 0xe3, 0x91, 0x29, 0xe7, 0x09, 0xda, 0x81,
-	0xd5, 0x16, 0xc6, 0x07, 0xb8, 0x98, 0x29, 0xa3, 0x93, 0xd3, 0xca, 0x66, 0x1a, 0x69, 0x85, 0x21,
-	0x0d, 0x51, 0x15, 0x0a, 0xed, 0xfd, 0xde, 0x21, 0x3e, 0xd8, 0xc3, 0xad, 0x6e, 0xb7, 0xa8, 0x94,
-	0xb7, 0x4f, 0x4e, 0x2b, 0x5b, 0xa9, 0xa8, 0xed, 0x1f, 0x86, 0xd4, 0x09, 0x09, 0x63, 0xe8, 0x35,
-	0xc8, 0x35, 0x0f, 0x3e, 0x3e, 0xec, 0xb4, 0x1e, 0xb4, 0x8a, 0xd9, 0xf2, 0x8d, 0x93, 0xd3, 0xca,
-	0xf5, 0x54, 0xd6, 0xa4, 0xc3, 0xc0, 0x23, 0x9c, 0x94, 0xd7, 0xbf, 0xfb, 0x51, 0xcb, 0xfc, 0x74,
-	0xa6, 0x65, 0x7e, 0x3d, 0xd3, 0x94, 0xfa, 0x34, 0x0b, 0x80, 0xd3, 0xff, 0x0b, 0x68, 0x1f, 0xf2,
-	0xe9, 0xe7, 0xa1, 0x9d, 0x4b, 0x9f, 0x31, 0x5f, 0x31, 0x65, 0xed, 0xaa, 0x70, 0xfc, 0xf3, 0x95,
-	0xa9, 0x2a, 0xa8, 0x0d, 0xb9, 0xa4, 0x6c, 0xd1, 0xab, 0x0b, 0x59, 0x99, 0xe9, 0xfe, 0xf2, 0xce,
-	0x15, 0xd1, 0x04, 0x86, 0x3e, 0x85, 0x8d, 0x4b, 0xd9, 0x47, 0x37, 0x17, 0xeb, 0x7d, 0xde, 0xa2,
-	0xf1, 0x4f, 0x92, 0x94, 0xfc, 0x05, 0x6c, 0x5d, 0x0a, 0x45, 0x25, 0xf4, 0x9f, 0xf1, 0xab, 0x4a,
-	0xe3, 0xfe, 0xf9, 0x44, 0x53, 0x1e, 0x4f, 0x34, 0xe5, 0xfb, 0xa9, 0x96, 0x79, 0x34, 0xd5, 0x94,
-	0xc7, 0x53, 0x2d, 0xf3, 0xc7, 0x54, 0xcb, 0x7c, 0x66, 0x5c, 0xd9, 0x51, 0xe9, 0xff, 0xb9, 0xfe,
-	0x35, 0xb9, 0xbe, 0xfd, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x27, 0x8a, 0x3c, 0x26, 0xe4, 0x09,
-	0x00, 0x00,
+	// 1116 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0xeb, 0xd8, 0x7e, 0x8e, 0x4b, 0x3a, 0xa5, 0xad, 0xeb, 0x12, 0xdb, 0x75, 0x25,
+	0x64, 0xfe, 0xd4, 0x46, 0xae, 0x28, 0x6d, 0x55, 0x09, 0x64, 0xe3, 0x04, 0x4b, 0x26, 0x89, 0xc6,
+	0x15, 0x42, 0x70, 0x30, 0xeb, 0xf5, 0x64, 0xb3, 0xf2, 0x7a, 0xc7, 0xec, 0x8c, 0x11, 0xfe, 0x04,
+	0xa0, 0x9c, 0xf8, 0x02, 0x91, 0x2a, 0x91, 0x03, 0x47, 0x8e, 0x70, 0xe0, 0x1e, 0x09, 0x21, 0xf5,
+	0xc8, 0x05, 0x4b, 0x38, 0x17, 0x3e, 0x43, 0x4f, 0x68, 0x66, 0x67, 0x37, 0x8e, 0xdd, 0xb4, 0x09,
+	0x70, 0xe3, 0x36, 0xf3, 0xde, 0xef, 0xfd, 0xde, 0x9b, 0xf7, 0x6f, 0x17, 0x6e, 0x8e, 0x3c, 0xca,
+	0x69, 0x95, 0xb9, 0xa3, 0x5e, 0xd5, 0x23, 0x23, 0xc7, 0x36, 0x0d, 0x4e, 0xbd, 0x8a, 0x94, 0xa2,
+	0xf4, 0x57, 0x86, 0xe7, 0x50, 0xab, 0x22, 0xb4, 0xb9, 0x82, 0x45, 0xa9, 0xe5, 0x90, 0xaa, 0x54,
+	0xf5, 0xc6, 0xbb, 0x55, 0x6e, 0x0f, 0x09, 0xe3, 0xc6, 0x70, 0xe4, 0xa3, 0x73, 0x77, 0x2c, 0x9b,
+	0xef, 0x8d, 0x7b, 0x15, 0x93, 0x0e, 0xab, 0x16, 0xb5, 0xe8, 0x09, 0x52, 0xdc, 0x7c, 0x3f, 0xe2,
+	0xa4, 0xe0, 0xd7, 0x7d, 0xf2, 0x51, 0xaf, 0x3a, 0x24, 0xdc, 0xe8, 0x1b, 0xdc, 0xf0, 0x15, 0xa5,
+	0x5f, 0xa2, 0xb0, 0x86, 0x55, 0x28, 0x04, 0x93, 0x2f, 0xc7, 0x84, 0x71, 0xd4, 0x81, 0x24, 0xa7,
+	0x23, 0xdb, 0xec, 0xda, 0xfd, 0xac, 0x56, 0xd4, 0xca, 0xf1, 0xfa, 0xfd, 0xd9, 0xb4, 0x90, 0x78,
+	0x2c, 0x64, 0xad, 0x0f, 0x9f, 0x4d, 0x0b, 0x6f, 0xcc, 0x79, 0x1f, 0x18, 0x03, 0x83, 0x56, 0x7d,
+	0xfe, 0xea, 0x68, 0x60, 0x55, 0xf9, 0x64, 0x44, 0x58, 0x45, 0x81, 0x71, 0x42, 0x32, 0xb5, 0xfa,
+	0xa8, 0x0f, 0x19, 0x87, 0x5a, 0x5d, 0xc6, 0x3d, 0x62, 0x0c, 0x05, 0x73, 0x54, 0x32, 0x7f, 0x30,
+	0x9b, 0x16, 0xd2, 0x6d, 0x6a, 0x75, 0xa4, 0x5c, 0xb2, 0xdf, 0x79, 0x39, 0xfb, 0x9c, 0x01, 0x4e,
+	0x3b, 0xe1, 0xa5, 0x8f, 0x36, 0x40, 0x77, 0x1c, 0xe6, 0x66, 0x63, 0xc5, 0x58, 0x59, 0xaf, 0xd7,
+	0x66, 0xd3, 0x82, 0xde, 0x6e, 0x77, 0xb6, 0x9e, 0x4d, 0x0b, 0xaf, 0x9f, 0x83, 0xb5, 0xdd, 0xd9,
+	0xc2, 0xd2, 0x1e, 0x21, 0xd0, 0x45, 0x96, 0xb2, 0x7a, 0x31, 0x56, 0x5e, 0xc5, 0xf2, 0x2c, 0x64,
+	0x03, 0x32, 0x61, 0xd9, 0xb8, 0x2f, 0x13, 0xe7, 0xd2, 0x15, 0xb8, 0x3c, 0x97, 0x3e, 0x36, 0xa2,
+	0x2e, 0x23, 0xa5, 0x43, 0x0d, 0x56, 0x3b, 0x13, 0xd7, 0xdc, 0xa1, 0xcc, 0xe6, 0x36, 0x75, 0xc3,
+	0xa8, 0x44, 0x32, 0xff, 0x4d, 0x54, 0x1b, 0xa0, 0x5b, 0x82, 0x27, 0x7a, 0xc2, 0xb3, 0x79, 0x6e,
+	0x9e, 0x4d, 0xc9, 0x23, 0xec, 0x1f, 0xea, 0x7f, 0x3d, 0x29, 0x68, 0xa5, 0x9f, 0x34, 0x48, 0x89,
+	0x30, 0xb1, 0xe1, 0x5a, 0x04, 0x7d, 0x02, 0xb0, 0x6b, 0x7b, 0x8c, 0x77, 0xe7, 0x22, 0x7d, 0x6f,
+	0x36, 0x2d, 0xa4, 0x36, 0x84, 0xf4, 0x82, 0xe1, 0xa6, 0x24, 0x55, 0x5b, 0xc4, 0xdc, 0x81, 0x94,
+	0x63, 0x04, 0xb4, 0x7e, 0xe0, 0xf7, 0x66, 0xd3, 0x42, 0xb2, 0x6d, 0x5c, 0x98, 0x35, 0xe9, 0x18,
+	0x3e, 0x69, 0xe9, 0x4f, 0x0d, 0x40, 0x84, 0xde, 0xe1, 0x06, 0x1f, 0x33, 0xf4, 0x36, 0xc4, 0x19,
+	0x37, 0x38, 0x91, 0x61, 0x5f, 0xaa, 0x5d, 0xab, 0xcc, 0xcd, 0x52, 0x25, 0xc0, 0x11, 0xec, 0x83,
+	0xd0, 0xbb, 0x10, 0x97, 0xe1, 0xc9, 0x68, 0xd2, 0xb5, 0x1b, 0x4b, 0xe8, 0xa0, 0x6e, 0x75, 0xfd,
+	0x68, 0x5a, 0x88, 0x60, 0x1f, 0x8d, 0xee, 0x82, 0x2e, 0xfc, 0x67, 0x63, 0xe7, 0xb3, 0x92, 0x60,
+	0xf4, 0x00, 0x12, 0xe6, 0xd8, 0xf3, 0x88, 0xcb, 0xb3, 0xfa, 0xf9, 0xec, 0x02, 0x7c, 0xe9, 0x37,
+	0x0d, 0xd2, 0x52, 0x6f, 0x4c, 0x1c, 0x6a, 0xf4, 0x51, 0x13, 0x2e, 0x99, 0x74, 0x38, 0xb4, 0x79,
+	0xd7, 0xa4, 0x2e, 0x27, 0x5f, 0x73, 0xf9, 0xda, 0x74, 0x2d, 0x1f, 0x30, 0x06, 0x33, 0x5e, 0x69,
+	0x48, 0x58, 0xc3, 0x47, 0xe1, 0x8c, 0x39, 0x7f, 0x45, 0xf7, 0x20, 0x25, 0xe6, 0x90, 0xb8, 0xdc,
+	0x9b, 0x2c, 0x66, 0x20, 0x64, 0x68, 0x53, 0xab, 0x29, 0x00, 0x38, 0xe9, 0xa8, 0x13, 0x7a, 0x20,
+	0xfa, 0xc3, 0x21, 0x5d, 0x73, 0x6f, 0xec, 0x0e, 0x54, 0x12, 0x72, 0x4b, 0x8f, 0xd9, 0xb0, 0x1d,
+	0xd2, 0x10, 0x08, 0xd1, 0x02, 0xea, 0xf8, 0x50, 0x3f, 0x12, 0xed, 0xf6, 0x87, 0x06, 0x99, 0x53,
+	0x10, 0x31, 0x50, 0xae, 0x31, 0xf4, 0xab, 0x96, 0xc2, 0xf2, 0x8c, 0xae, 0xc1, 0x0a, 0xdd, 0xdd,
+	0x65, 0xc4, 0xaf, 0x4e, 0x0c, 0xab, 0x5b, 0x38, 0x90, 0xc2, 0x71, 0x30, 0x90, 0x39, 0x48, 0x9a,
+	0x7b, 0xc4, 0x1c, 0xb0, 0xf1, 0x50, 0x66, 0x37, 0x83, 0xc3, 0x3b, 0xba, 0x01, 0x31, 0x42, 0x77,
+	0xb3, 0xf1, 0xa2, 0x56, 0x4e, 0xd6, 0x13, 0xb3, 0x69, 0x21, 0xd6, 0xdc, 0xde, 0xc0, 0x42, 0x86,
+	0x6e, 0x43, 0x46, 0xbd, 0x44, 0xd9, 0xae, 0x48, 0xdb, 0x55, 0x3f, 0x60, 0x65, 0xff, 0x0e, 0x24,
+	0x18, 0xb1, 0x86, 0xa2, 0x70, 0x09, 0xf9, 0xd6, 0xe5, 0xa6, 0x92, 0x73, 0x83, 0x03, 0x58, 0xe9,
+	0xd7, 0x28, 0xbc, 0x22, 0xc4, 0x2d, 0xd7, 0xe6, 0xc1, 0x26, 0xfd, 0x1c, 0xc0, 0x74, 0xc6, 0x8c,
+	0x13, 0x2f, 0xd8, 0xa5, 0x99, 0xfa, 0x23, 0x31, 0x54, 0x0d, 0x5f, 0x2a, 0xf7, 0xdd, 0x5b, 0x2f,
+	0x6f, 0xff, 0x10, 0x8e, 0x53, 0x8a, 0xaf, 0xd5, 0x47, 0xef, 0xc3, 0x0a, 0xa3, 0x63, 0xcf, 0x24,
+	0xaa, 0x8c, 0xb7, 0x9e, 0x57, 0x46, 0x7f, 0x33, 0xaa, 0x1d, 0xa5, 0x5a, 0x4c, 0x99, 0xa1, 0x16,
+	0xa4, 0xfb, 0x84, 0x71, 0xdb, 0x35, 0x44, 0xff, 0x65, 0x63, 0x17, 0x63, 0x99, 0xb7, 0x45, 0x35,
+	0x88, 0x7b, 0x22, 0x1d, 0x59, 0xfd, 0x45, 0xc9, 0x0a, 0x06, 0x4a, 0x42, 0xd1, 0x4d, 0x48, 0xf5,
+	0xc6, 0xce, 0xa0, 0xcb, 0x26, 0xae, 0xe9, 0x17, 0x0a, 0x27, 0x85, 0x40, 0xc0, 0x4b, 0x26, 0xac,
+	0x9d, 0x24, 0xd3, 0xdf, 0xab, 0x27, 0x4e, 0xb4, 0x7f, 0xe8, 0x24, 0xba, 0xe0, 0xe4, 0xe7, 0x28,
+	0xbc, 0x2a, 0xed, 0x16, 0xbf, 0x80, 0xff, 0x9b, 0xba, 0xdd, 0x87, 0xc4, 0xc8, 0xdf, 0x2f, 0xaa,
+	0x72, 0xd9, 0xe5, 0xfd, 0xe4, 0xeb, 0x83, 0xf5, 0xa4, 0xe0, 0xa5, 0x8f, 0xe0, 0xea, 0x42, 0xea,
+	0x54, 0x95, 0xaa, 0xb0, 0xc2, 0xe4, 0x5a, 0x56, 0x65, 0xba, 0xfe, 0xdc, 0x6d, 0x3c, 0x66, 0x58,
+	0xc1, 0xde, 0xfc, 0x46, 0x7d, 0x87, 0x3a, 0x72, 0x3b, 0xaf, 0x43, 0xbc, 0x89, 0xf1, 0x36, 0x5e,
+	0x8b, 0xe4, 0xd0, 0xfe, 0x41, 0xf1, 0x52, 0xa8, 0x69, 0x7a, 0x1e, 0xf5, 0x50, 0x19, 0xd2, 0xad,
+	0xad, 0xee, 0x0e, 0xde, 0xde, 0xc4, 0xcd, 0x4e, 0x67, 0x4d, 0xcb, 0x5d, 0xdf, 0x3f, 0x28, 0x5e,
+	0x09, 0x41, 0x2d, 0x77, 0xc7, 0xa3, 0x96, 0x47, 0x18, 0x43, 0xb7, 0x21, 0xd9, 0xd8, 0xfe, 0x78,
+	0xa7, 0xdd, 0x7c, 0xdc, 0x5c, 0x8b, 0xe6, 0xae, 0xee, 0x1f, 0x14, 0x2f, 0x87, 0xb0, 0x06, 0x1d,
+	0x8e, 0x1c, 0xc2, 0x49, 0x6e, 0xf5, 0xdb, 0xef, 0xf3, 0x91, 0x1f, 0x0e, 0xf3, 0x91, 0x1f, 0x0f,
+	0xf3, 0x5a, 0xed, 0x38, 0x0a, 0x80, 0xc3, 0x1f, 0x33, 0xb4, 0x05, 0xa9, 0xe0, 0x46, 0xd0, 0xfa,
+	0xa9, 0x67, 0x2c, 0x76, 0x4c, 0x2e, 0x7f, 0x96, 0x5a, 0xfd, 0x13, 0x44, 0xca, 0x1a, 0x6a, 0x41,
+	0x32, 0xe8, 0x69, 0xf4, 0xda, 0x52, 0x56, 0xe6, 0xf6, 0x46, 0x6e, 0xfd, 0x0c, 0x6d, 0x40, 0x86,
+	0x3e, 0xf5, 0x77, 0xe9, 0x49, 0x78, 0xb7, 0x96, 0x87, 0x61, 0x31, 0xc4, 0xd2, 0x8b, 0x20, 0x21,
+	0xf3, 0x17, 0x70, 0xe5, 0x94, 0xca, 0x6f, 0xa1, 0xff, 0x8c, 0xbf, 0xac, 0xd5, 0x1f, 0x1d, 0xcd,
+	0xf2, 0xda, 0xd3, 0x59, 0x5e, 0xfb, 0xee, 0x38, 0x1f, 0x79, 0x72, 0x9c, 0xd7, 0x9e, 0x1e, 0xe7,
+	0x23, 0xbf, 0x1f, 0xe7, 0x23, 0x9f, 0x95, 0xce, 0x9c, 0xa8, 0xf0, 0xc7, 0xb9, 0xb7, 0x22, 0xcf,
+	0x77, 0xff, 0x1e, 0x00, 0xc6, 0xac, 0x26, 0x2d, 0x4d, 0x0b, 0x00, 0x00,
 }
 
 func (x SyncState) String() string {
@@ -1010,6 +1160,15 @@ func (m *ReplicateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintReplicator(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Data) > 0 {
 		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Data[iNdEx])
@@ -1217,6 +1376,18 @@ func (m *SyncPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FileChunk != nil {
+		{
+			size, err := m.FileChunk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplicator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.LogEntry != nil {
 		{
 			size, err := m.LogEntry.MarshalToSizedBuffer(dAtA[:i])
@@ -1244,6 +1415,80 @@ func (m *SyncPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncFileChunk) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncFileChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncFileChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Segment != nil {
+		{
+			size, err := m.Segment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplicator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.FileChecksum != 0 {
+		i = encodeVarintReplicator(dAtA, i, uint64(m.FileChecksum))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EOF {
+		i--
+		if m.EOF {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Checksum != 0 {
+		i = encodeVarintReplicator(dAtA, i, uint64(m.Checksum))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintReplicator(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Offset != 0 {
+		i = encodeVarintReplicator(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintReplicator(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncInitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1264,6 +1509,16 @@ func (m *SyncInitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BulkSync {
+		i--
+		if m.BulkSync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size, err := m.Range.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1322,6 +1577,16 @@ func (m *SyncInitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BulkSync {
+		i--
+		if m.BulkSync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.Range.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1464,6 +1729,12 @@ func (m *ReplicateRequest) ProtoSize() (n int) {
 			n += 1 + l + sovReplicator(uint64(l))
 		}
 	}
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
+			n += 1 + l + sovReplicator(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1538,6 +1809,43 @@ func (m *SyncPayload) ProtoSize() (n int) {
 		l = m.LogEntry.ProtoSize()
 		n += 1 + l + sovReplicator(uint64(l))
 	}
+	if m.FileChunk != nil {
+		l = m.FileChunk.ProtoSize()
+		n += 1 + l + sovReplicator(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncFileChunk) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovReplicator(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovReplicator(uint64(m.Offset))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovReplicator(uint64(l))
+	}
+	if m.Checksum != 0 {
+		n += 1 + sovReplicator(uint64(m.Checksum))
+	}
+	if m.EOF {
+		n += 2
+	}
+	if m.FileChecksum != 0 {
+		n += 1 + sovReplicator(uint64(m.FileChecksum))
+	}
+	if m.Segment != nil {
+		l = m.Segment.ProtoSize()
+		n += 1 + l + sovReplicator(uint64(l))
+	}
 	return n
 }
 
@@ -1556,6 +1864,9 @@ func (m *SyncInitRequest) ProtoSize() (n int) {
 	n += 1 + l + sovReplicator(uint64(l))
 	l = m.Range.ProtoSize()
 	n += 1 + l + sovReplicator(uint64(l))
+	if m.BulkSync {
+		n += 2
+	}
 	return n
 }
 
@@ -1567,6 +1878,9 @@ func (m *SyncInitResponse) ProtoSize() (n int) {
 	_ = l
 	l = m.Range.ProtoSize()
 	n += 1 + l + sovReplicator(uint64(l))
+	if m.BulkSync {
+		n += 2
+	}
 	return n
 }
 
@@ -1614,6 +1928,9 @@ func (this *SyncPayload) GetValue() interface{} {
 	if this.LogEntry != nil {
 		return this.LogEntry
 	}
+	if this.FileChunk != nil {
+		return this.FileChunk
+	}
 	return nil
 }
 
@@ -1623,6 +1940,8 @@ func (this *SyncPayload) SetValue(value interface{}) bool {
 		this.CommitContext = vt
 	case *varlogpb.LogEntry:
 		this.LogEntry = vt
+	case *SyncFileChunk:
+		this.FileChunk = vt
 	default:
 		return false
 	}
@@ -1803,6 +2122,38 @@ func (m *ReplicateRequest) Unmarshal(dAtA []byte) error {
 			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
 			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplicator(dAtA[iNdEx:])
@@ -2319,6 +2670,271 @@ func (m *SyncPayload) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileChunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FileChunk == nil {
+				m.FileChunk = &SyncFileChunk{}
+			}
+			if err := m.FileChunk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplicator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncFileChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplicator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncFileChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncFileChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			m.Checksum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Checksum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EOF", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EOF = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileChecksum", wireType)
+			}
+			m.FileChecksum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileChecksum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Segment == nil {
+				m.Segment = &SyncRange{}
+			}
+			if err := m.Segment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplicator(dAtA[iNdEx:])
@@ -2487,6 +3103,26 @@ func (m *SyncInitRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BulkSync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BulkSync = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplicator(dAtA[iNdEx:])
@@ -2570,6 +3206,26 @@ func (m *SyncInitResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BulkSync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BulkSync = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplicator(dAtA[iNdEx:])
@@ -454,6 +454,10 @@ type TopicDescriptor struct {
 	TopicID    github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topicId"`
 	Status     TopicStatus                                     `protobuf:"varint,2,opt,name=status,proto3,enum=varlog.varlogpb.TopicStatus" json:"status"`
 	LogStreams []github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,3,rep,packed,name=log_streams,json=logStreams,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"logStreams,omitempty"`
+	// compacted tells whether log streams of the topic are compacted. Log
+	// stream replicas of a compacted topic maintain the index of record keys
+	// and keep only the latest log entry for each record key.
+	Compacted bool `protobuf:"varint,4,opt,name=compacted,proto3" json:"compacted,omitempty"`
 }
 
 func (m *TopicDescriptor) Reset()         { *m = TopicDescriptor{} }
@@ -510,6 +514,13 @@ func (m *TopicDescriptor) GetLogStreams() []github_com_kakao_varlog_pkg_types.Lo
 	return nil
 }
 
+func (m *TopicDescriptor) GetCompacted() bool {
+	if m != nil {
+		return m.Compacted
+	}
+	return false
+}
+
 // StorageNode is a structure to represent identifier and address of storage
 // node.
 type StorageNode struct {
@@ -782,6 +793,13 @@ func (m *LogEntryMeta) GetLLSN() github_com_kakao_varlog_pkg_types.LLSN {
 type LogEntry struct {
 	LogEntryMeta `protobuf:"bytes,1,opt,name=meta,proto3,embedded=meta" json:"meta"`
 	Data         []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Key is the record key of the log entry. It is set only by operations
+	// aware of record keys, for instance, lookup by key.
+	Key []byte `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// Compacted tells that the log entry was removed by compaction. Only its
+	// GLSN and LLSN are valid, and it is used internally to keep the sequence
+	// of log entries contiguous.
+	Compacted bool `protobuf:"varint,4,opt,name=compacted,proto3" json:"compacted,omitempty"`
 }
 
 func (m *LogEntry) Reset()         { *m = LogEntry{} }
@@ -824,6 +842,20 @@ func (m *LogEntry) GetData() []byte {
 	return nil
 }
 
+func (m *LogEntry) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *LogEntry) GetCompacted() bool {
+	if m != nil {
+		return m.Compacted
+	}
+	return false
+}
+
 type CommitContext struct {
 	Version            github_com_kakao_varlog_pkg_types.Version `protobuf:"varint,1,opt,name=version,proto3,casttype=github.com/kakao/varlog/pkg/types.Version" json:"version,omitempty"`
 	HighWatermark      github_com_kakao_varlog_pkg_types.GLSN    `protobuf:"varint,2,opt,name=high_watermark,json=highWatermark,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"high_watermark,omitempty"`
@@ -906,6 +938,17 @@ type MetadataRepositoryNode struct {
 	RPCAddr string                                   `protobuf:"bytes,3,opt,name=rpc_addr,json=rpcAddr,proto3" json:"rpcAddr"`
 	Leader  bool                                     `protobuf:"varint,4,opt,name=leader,proto3" json:"leader"`
 	Learner bool                                     `protobuf:"varint,5,opt,name=learner,proto3" json:"learner"`
+	// match_index is the index of the last RAFT entry replicated to the node.
+	MatchIndex uint64 `protobuf:"varint,6,opt,name=match_index,json=matchIndex,proto3" json:"matchIndex"`
+	// lag is the number of RAFT entries that the leader has, but the node does
+	// not.
+	Lag uint64 `protobuf:"varint,7,opt,name=lag,proto3" json:"lag"`
+	// progress_state is the state of replication to the node reported by the
+	// leader.
+	ProgressState string `protobuf:"bytes,8,opt,name=progress_state,json=progressState,proto3" json:"progressState"`
+	// active is true if the node is connected to the node that reports the
+	// cluster info.
+	Active bool `protobuf:"varint,9,opt,name=active,proto3" json:"active"`
 }
 
 func (m *MetadataRepositoryNode) Reset()         { *m = MetadataRepositoryNode{} }
@@ -976,6 +1019,34 @@ func (m *MetadataRepositoryNode) GetLearner() bool {
 	return false
 }
 
+func (m *MetadataRepositoryNode) GetMatchIndex() uint64 {
+	if m != nil {
+		return m.MatchIndex
+	}
+	return 0
+}
+
+func (m *MetadataRepositoryNode) GetLag() uint64 {
+	if m != nil {
+		return m.Lag
+	}
+	return 0
+}
+
+func (m *MetadataRepositoryNode) GetProgressState() string {
+	if m != nil {
+		return m.ProgressState
+	}
+	return ""
+}
+
+func (m *MetadataRepositoryNode) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("varlog.varlogpb.StorageNodeStatus", StorageNodeStatus_name, StorageNodeStatus_value)
 	proto.RegisterEnum("varlog.varlogpb.LogStreamStatus", LogStreamStatus_name, LogStreamStatus_value)
@@ -999,99 +1070,107 @@ func init() {
 func init() { proto.RegisterFile("proto/varlogpb/metadata.proto", fileDescriptor_eb4411772ca3492a) }
 
 var fileDescriptor_eb4411772ca3492a = []byte{
-	// 1465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcb, 0x6f, 0x1b, 0x45,
-	0x1c, 0xce, 0xda, 0x9b, 0xc4, 0x19, 0xc7, 0x89, 0x33, 0x4d, 0x23, 0x63, 0x5a, 0xaf, 0x15, 0x41,
-	0x95, 0x56, 0xd4, 0xa6, 0x41, 0x95, 0xaa, 0x56, 0x40, 0xe3, 0xd8, 0xa4, 0x91, 0x5c, 0xa7, 0x1a,
-	0x3b, 0x54, 0xe5, 0x80, 0x35, 0xf6, 0x4e, 0xd7, 0xab, 0xac, 0x77, 0x97, 0xdd, 0x71, 0xdb, 0x1c,
-	0xb8, 0x71, 0x40, 0x39, 0x55, 0x70, 0x80, 0x4b, 0xa4, 0x4a, 0x70, 0x41, 0xe2, 0x8f, 0xe0, 0xd8,
-	0x63, 0x8f, 0x70, 0x71, 0x25, 0xe7, 0x82, 0xc2, 0x85, 0x73, 0x4f, 0x68, 0x66, 0x67, 0xed, 0xdd,
-	0xb5, 0xd3, 0x36, 0x14, 0x84, 0xc4, 0xc9, 0xf3, 0xfa, 0x7e, 0x8f, 0xef, 0xf7, 0xcd, 0x63, 0x0d,
-	0xce, 0xdb, 0x8e, 0x45, 0xad, 0xe2, 0x03, 0xec, 0x18, 0x96, 0x66, 0xb7, 0x8a, 0x5d, 0x42, 0xb1,
-	0x8a, 0x29, 0x2e, 0xf0, 0x71, 0xb8, 0xe8, 0x4d, 0x14, 0xfc, 0xf9, 0xac, 0xa2, 0x59, 0x96, 0x66,
-	0x90, 0x22, 0x9f, 0x6e, 0xf5, 0xee, 0x17, 0xa9, 0xde, 0x25, 0x2e, 0xc5, 0x5d, 0xdb, 0x43, 0x64,
-	0x2f, 0x6b, 0x3a, 0xed, 0xf4, 0x5a, 0x85, 0xb6, 0xd5, 0x2d, 0x6a, 0x96, 0x66, 0x8d, 0x56, 0xb2,
-	0x9e, 0xe7, 0x8d, 0xb5, 0xbc, 0xe5, 0xab, 0xbf, 0xc5, 0x00, 0xbc, 0x2d, 0x7c, 0x96, 0x89, 0xdb,
-	0x76, 0x74, 0x9b, 0x5a, 0x0e, 0xbc, 0x0a, 0x52, 0xd8, 0xb6, 0x0d, 0x9d, 0xa8, 0x4d, 0xdd, 0x54,
-	0xc9, 0xa3, 0x8c, 0x94, 0x97, 0xd6, 0xe4, 0x52, 0xfa, 0xb8, 0xaf, 0xcc, 0x8b, 0x89, 0x6d, 0x36,
-	0x8e, 0x42, 0x3d, 0x88, 0x41, 0xca, 0xa5, 0x96, 0x83, 0x35, 0xd2, 0x34, 0x2d, 0x95, 0xb8, 0x99,
-	0x58, 0x3e, 0xbe, 0x96, 0x5c, 0xbf, 0x50, 0x88, 0xa4, 0x51, 0xa8, 0x7b, 0xab, 0x6a, 0x96, 0x4a,
-	0x46, 0x5e, 0x4b, 0xcb, 0x4f, 0xfb, 0x8a, 0xc4, 0x5c, 0xb8, 0xa3, 0x69, 0x17, 0x85, 0x7a, 0xf0,
-	0x1e, 0x48, 0x1a, 0x96, 0xd6, 0x74, 0xa9, 0x43, 0x70, 0xd7, 0xcd, 0xc4, 0xb9, 0x83, 0x77, 0xc6,
-	0x1c, 0x54, 0x2d, 0xad, 0xce, 0x97, 0x04, 0xcc, 0x43, 0x61, 0x1e, 0x18, 0xfe, 0xa4, 0x8b, 0x02,
-	0x6d, 0x78, 0x0b, 0xcc, 0x50, 0xcb, 0xd6, 0xdb, 0x6e, 0x46, 0xe6, 0x56, 0xf3, 0x63, 0x56, 0x1b,
-	0x6c, 0x3a, 0x60, 0x71, 0x41, 0x58, 0x14, 0x38, 0x24, 0x7e, 0xaf, 0xcb, 0xbf, 0x3f, 0x51, 0xa4,
-	0xd5, 0x6f, 0x63, 0xe0, 0xec, 0xc4, 0x44, 0xe1, 0x6d, 0x30, 0x1f, 0xe4, 0x89, 0xb3, 0x9b, 0x5c,
-	0x3f, 0xf7, 0x32, 0x9a, 0x4a, 0xf3, 0x4f, 0xfb, 0xca, 0xd4, 0x33, 0xcf, 0xdf, 0x14, 0x4a, 0x06,
-	0x48, 0x81, 0xd7, 0xc1, 0x8c, 0x4b, 0x31, 0xed, 0x31, 0xbe, 0xa5, 0xb5, 0x85, 0xf5, 0xd5, 0x97,
-	0x19, 0xaa, 0xf3, 0x95, 0x48, 0x20, 0xe0, 0x32, 0x98, 0xb6, 0x31, 0xed, 0x78, 0x4c, 0xce, 0x21,
-	0xaf, 0x03, 0xeb, 0x20, 0xd9, 0x76, 0x08, 0xa6, 0xa4, 0xc9, 0xf4, 0x95, 0x91, 0x79, 0x7c, 0xd9,
-	0x82, 0x27, 0xbe, 0x82, 0x2f, 0xa9, 0x42, 0xc3, 0x17, 0x5f, 0x69, 0x85, 0x45, 0xc7, 0xb8, 0xf5,
-	0x60, 0x6c, 0xe2, 0xf1, 0x73, 0x45, 0x42, 0x81, 0xbe, 0x60, 0xe5, 0x2e, 0x58, 0x12, 0xd1, 0x04,
-	0x08, 0x81, 0x40, 0x66, 0x8e, 0x39, 0x11, 0x73, 0x88, 0xb7, 0xd9, 0x58, 0xcf, 0x25, 0x2a, 0xcf,
-	0x49, 0x46, 0xbc, 0xcd, 0xa2, 0xa5, 0x16, 0xc5, 0x46, 0x26, 0xce, 0x07, 0xbd, 0x8e, 0x30, 0xfc,
-	0x67, 0x0c, 0x9c, 0x99, 0x50, 0x76, 0xf8, 0x39, 0x48, 0xf0, 0xb2, 0x34, 0x75, 0x95, 0xdb, 0x9f,
-	0x2e, 0x6d, 0x0e, 0xfa, 0xca, 0x2c, 0xaf, 0xe5, 0x76, 0xf9, 0xb8, 0xaf, 0xcc, 0xf2, 0xe9, 0x6d,
-	0xf5, 0x45, 0x5f, 0xb9, 0x18, 0xd8, 0x3d, 0x7b, 0x78, 0x0f, 0xfb, 0x3b, 0xb3, 0x68, 0xef, 0x69,
-	0x45, 0xba, 0x6f, 0x13, 0xb7, 0x20, 0x70, 0xc8, 0x47, 0x41, 0x17, 0xa4, 0x46, 0x8a, 0x64, 0x4e,
-	0x62, 0xdc, 0xc9, 0xce, 0xa0, 0xaf, 0x24, 0x87, 0xf1, 0x70, 0x47, 0xc9, 0xa1, 0xd8, 0xb8, 0xb3,
-	0xcb, 0xaf, 0x76, 0x16, 0xc0, 0xa3, 0x20, 0x1a, 0x5e, 0x1b, 0x96, 0x3c, 0xce, 0x4b, 0x9e, 0x3f,
-	0x79, 0x07, 0x44, 0x0a, 0x5e, 0x06, 0x09, 0x87, 0xd8, 0x86, 0xde, 0xc6, 0xbe, 0xce, 0xc7, 0xe5,
-	0x82, 0xbc, 0x05, 0x01, 0xa5, 0xcb, 0x4c, 0xe9, 0x68, 0x88, 0x14, 0x94, 0x7f, 0x15, 0x03, 0x4b,
-	0x63, 0x6b, 0xe1, 0x97, 0x60, 0x31, 0xa8, 0xee, 0x11, 0xef, 0xbb, 0x83, 0xbe, 0x92, 0x0a, 0x48,
-	0x91, 0x93, 0x92, 0x0a, 0x28, 0x99, 0xd3, 0x52, 0x7c, 0x35, 0x2d, 0x21, 0x1b, 0x28, 0x6c, 0x01,
-	0x7e, 0x0c, 0x96, 0x42, 0xee, 0xb9, 0xb0, 0x58, 0x4d, 0xe6, 0x4a, 0x67, 0x8e, 0xfb, 0xca, 0x62,
-	0x60, 0xf5, 0x1d, 0x4c, 0x3b, 0x28, 0x3a, 0x00, 0x2f, 0x82, 0x39, 0x76, 0x1c, 0x7a, 0xc0, 0x38,
-	0x07, 0xce, 0x1f, 0xf7, 0x95, 0x04, 0x1b, 0xe4, 0x88, 0x61, 0x4b, 0xd0, 0xf0, 0x53, 0x0c, 0x2c,
-	0x46, 0x8e, 0x86, 0x7f, 0x5d, 0x75, 0x37, 0x23, 0x7b, 0xfe, 0xdc, 0xe4, 0xc3, 0xca, 0x2b, 0x7e,
-	0x09, 0xb0, 0x43, 0xca, 0x0d, 0x0b, 0xc1, 0x1c, 0x3f, 0x49, 0xa7, 0x4b, 0xb7, 0xc5, 0x89, 0xb6,
-	0x3c, 0x3a, 0x17, 0xdf, 0xb3, 0xba, 0x3a, 0x25, 0x5d, 0x9b, 0xee, 0x9f, 0x5e, 0xb3, 0x81, 0xe3,
-	0x55, 0x70, 0xf5, 0xb3, 0x04, 0x92, 0x81, 0xf2, 0xfd, 0xd7, 0x62, 0xc9, 0x80, 0x59, 0xac, 0xaa,
-	0x0e, 0x71, 0x3d, 0x1e, 0xe7, 0x90, 0xdf, 0x15, 0xe1, 0xfe, 0x21, 0x81, 0x05, 0x4e, 0xe4, 0x30,
-	0xab, 0xff, 0xe5, 0x79, 0x22, 0xb2, 0xfd, 0x45, 0x02, 0xe9, 0xe1, 0x12, 0xb1, 0xb1, 0xff, 0xe9,
-	0xcb, 0xea, 0x2e, 0x48, 0x7b, 0xf4, 0x8d, 0x92, 0xe4, 0x19, 0x26, 0xd7, 0x95, 0xc9, 0x12, 0x1e,
-	0x06, 0x14, 0xb1, 0xba, 0x40, 0x43, 0xb3, 0xfe, 0x5e, 0x94, 0xc0, 0x12, 0x1b, 0x23, 0x5f, 0xf4,
-	0x88, 0xd9, 0x26, 0xb5, 0x5e, 0xb7, 0x45, 0x1c, 0xf8, 0x09, 0x90, 0x0d, 0xc3, 0x35, 0xc5, 0x33,
-	0x66, 0x7d, 0xd0, 0x57, 0xe4, 0x6a, 0xb5, 0x5e, 0x7b, 0xd1, 0x57, 0x2e, 0xbc, 0x06, 0x69, 0xd5,
-	0x7a, 0x0d, 0x71, 0x3c, 0xb3, 0xa3, 0x31, 0x3b, 0xb1, 0x91, 0x9d, 0xad, 0xd7, 0xb6, 0xb3, 0xc5,
-	0xed, 0x30, 0xbc, 0x88, 0xf5, 0x79, 0x0c, 0xcc, 0x57, 0x2d, 0xad, 0x62, 0x52, 0x67, 0x9f, 0x3d,
-	0xc2, 0x60, 0x7d, 0x4c, 0x5a, 0xd7, 0x02, 0xd2, 0xfa, 0x9b, 0x7a, 0x52, 0x27, 0xeb, 0xe9, 0x66,
-	0x44, 0x4f, 0x6f, 0x78, 0x21, 0xf9, 0xcc, 0xc4, 0xdf, 0x8c, 0x99, 0x61, 0xa5, 0xe4, 0x37, 0xab,
-	0x94, 0x60, 0x18, 0x83, 0x84, 0x4f, 0x30, 0xbc, 0x01, 0x64, 0xf6, 0xba, 0x16, 0xfa, 0x3d, 0x3f,
-	0xe9, 0xc2, 0x1c, 0x56, 0xa2, 0x94, 0xf0, 0xa5, 0x86, 0x38, 0x88, 0x3d, 0x46, 0xd8, 0xa1, 0xcf,
-	0xb9, 0x9b, 0x47, 0xbc, 0x2d, 0x5c, 0x7c, 0x23, 0x83, 0xd4, 0xa6, 0xd5, 0xed, 0xea, 0x74, 0xd3,
-	0x32, 0x29, 0x79, 0x44, 0xe1, 0x16, 0x98, 0x7d, 0x40, 0x1c, 0x57, 0xb7, 0x7c, 0xbd, 0x5d, 0x7e,
-	0xbd, 0xca, 0x7d, 0xea, 0x81, 0x90, 0x8f, 0x86, 0x2d, 0xb0, 0xd0, 0xd1, 0xb5, 0x4e, 0xf3, 0x21,
-	0xa6, 0xc4, 0xe9, 0x62, 0x67, 0x4f, 0xe8, 0xee, 0x06, 0x3b, 0x1a, 0x6f, 0xe9, 0x5a, 0xe7, 0xae,
-	0x3f, 0x71, 0x0a, 0x9a, 0x53, 0x9d, 0x20, 0x10, 0x3a, 0x60, 0xb9, 0xcd, 0xa3, 0xa7, 0x44, 0x6d,
-	0xb2, 0x0a, 0x34, 0x5b, 0x44, 0xd3, 0xfd, 0x3a, 0x32, 0x91, 0xc0, 0x4d, 0x7f, 0x9e, 0xe1, 0x4b,
-	0x6c, 0xf6, 0x14, 0xee, 0xe0, 0xd0, 0xfa, 0x96, 0xe1, 0x9a, 0x1c, 0x0d, 0x0d, 0x00, 0x23, 0x3e,
-	0x89, 0xa9, 0x8a, 0x8a, 0x7f, 0x34, 0xe8, 0x2b, 0xe9, 0x90, 0xc7, 0x8a, 0xa9, 0x9e, 0xc2, 0x5f,
-	0x3a, 0xe4, 0xaf, 0x62, 0xaa, 0xe1, 0x0c, 0x8d, 0x51, 0x86, 0xd3, 0x13, 0x32, 0xac, 0x9e, 0x2e,
-	0xc3, 0x6a, 0x38, 0xc3, 0xaa, 0x9f, 0xe1, 0xea, 0x8f, 0x31, 0xb0, 0xe2, 0x7f, 0x56, 0x21, 0x62,
-	0x5b, 0xae, 0x4e, 0x2d, 0x67, 0x9f, 0x9f, 0x7f, 0xf7, 0xc0, 0x6c, 0xf0, 0xa2, 0xf3, 0x22, 0x98,
-	0x19, 0xde, 0x70, 0x33, 0xa6, 0x7f, 0xb5, 0xad, 0xbd, 0xda, 0xbf, 0xb8, 0xd3, 0x04, 0x06, 0x5e,
-	0x01, 0x09, 0x07, 0xdf, 0xa7, 0xcd, 0x9e, 0x63, 0x88, 0x07, 0xcf, 0x0a, 0x3b, 0x3e, 0x10, 0xbe,
-	0x4f, 0x77, 0x51, 0x95, 0xdd, 0x4c, 0x8e, 0xd7, 0x44, 0x5e, 0xc3, 0x31, 0x38, 0xc4, 0x6e, 0x37,
-	0xd9, 0xa5, 0x27, 0x9e, 0x3a, 0x1e, 0xe4, 0xce, 0xe6, 0x86, 0xaa, 0x3a, 0x1c, 0x62, 0xb7, 0x59,
-	0x13, 0xf9, 0x0d, 0xb8, 0x0a, 0x66, 0x0c, 0x82, 0x55, 0xe2, 0xf0, 0x8a, 0x25, 0xbc, 0xb7, 0x85,
-	0x37, 0x82, 0xc4, 0x2f, 0x7c, 0x17, 0xcc, 0x1a, 0x04, 0x3b, 0x26, 0x71, 0x38, 0xcd, 0x89, 0x52,
-	0x92, 0x99, 0x12, 0x43, 0xc8, 0x6f, 0x5c, 0xfa, 0x4e, 0x1a, 0x7e, 0x0c, 0x8c, 0x3e, 0x4d, 0xe0,
-	0x87, 0xe0, 0xed, 0x7a, 0x63, 0x07, 0x6d, 0x6c, 0x55, 0x9a, 0xb5, 0x9d, 0x72, 0xa5, 0x59, 0x6f,
-	0x6c, 0x34, 0x76, 0xeb, 0x4d, 0xb4, 0x5b, 0xab, 0x6d, 0xd7, 0xb6, 0xd2, 0x53, 0xd9, 0x73, 0x07,
-	0x87, 0xf9, 0xcc, 0xf8, 0x27, 0x4d, 0xcf, 0x34, 0x75, 0x53, 0x3b, 0x09, 0x5e, 0xae, 0x54, 0x2b,
-	0x8d, 0x4a, 0x39, 0x2d, 0x9d, 0x00, 0x2f, 0x13, 0x83, 0x50, 0xa2, 0x66, 0xe5, 0xaf, 0x7f, 0xc8,
-	0x4d, 0x5d, 0xfa, 0x3e, 0x06, 0x16, 0x23, 0x2f, 0x68, 0x78, 0x05, 0x2c, 0x55, 0xeb, 0xe3, 0xd1,
-	0x64, 0x0f, 0x0e, 0xf3, 0x2b, 0xd1, 0xd7, 0xb6, 0x88, 0x25, 0x04, 0xa9, 0x57, 0x36, 0xaa, 0x0c,
-	0x22, 0x4d, 0x84, 0xd4, 0x09, 0x36, 0x18, 0xa4, 0x08, 0xd2, 0x61, 0x48, 0xa5, 0x9c, 0x8e, 0x65,
-	0xdf, 0x3a, 0x38, 0xcc, 0x9f, 0x9d, 0x80, 0x20, 0x6a, 0xd8, 0x87, 0x9f, 0x65, 0x7c, 0xa2, 0x0f,
-	0x91, 0x23, 0xbc, 0x0a, 0xce, 0x8c, 0x20, 0xbb, 0x35, 0x3f, 0x30, 0xd9, 0xa3, 0x26, 0x02, 0xda,
-	0x35, 0x5d, 0x2f, 0x34, 0x41, 0xcd, 0x43, 0x90, 0x0c, 0x3c, 0x2d, 0xe1, 0xfb, 0x60, 0xb9, 0xb1,
-	0x73, 0x67, 0x7b, 0x73, 0x9c, 0x98, 0x95, 0x83, 0xc3, 0x3c, 0x0c, 0x2c, 0xf5, 0x49, 0x89, 0x22,
-	0x46, 0x95, 0x89, 0x22, 0x42, 0x35, 0x29, 0xdd, 0x7c, 0x3a, 0xc8, 0x49, 0xcf, 0x06, 0x39, 0xe9,
-	0xf1, 0x51, 0x6e, 0xea, 0xc9, 0x51, 0x4e, 0x7a, 0x76, 0x94, 0x9b, 0xfa, 0xf5, 0x28, 0x37, 0xf5,
-	0xd9, 0xc9, 0x5b, 0x35, 0xf4, 0xef, 0x4a, 0x6b, 0x86, 0xf7, 0x3f, 0xf8, 0x2b, 0x00, 0x00, 0xff,
-	0xff, 0x6c, 0x3e, 0x87, 0x81, 0x76, 0x11, 0x00, 0x00,
+	// 1587 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xda, 0x4e, 0xec, 0x8c, 0xf3, 0xc3, 0x99, 0xa4, 0xc1, 0x0d, 0x69, 0xd6, 0x8a, 0xa0,
+	0x4a, 0x2b, 0x6a, 0xd3, 0xa0, 0x4a, 0x55, 0x2b, 0xa0, 0x71, 0x6c, 0xd2, 0x48, 0xae, 0x53, 0x8d,
+	0x13, 0xaa, 0x72, 0x60, 0x35, 0xf1, 0x4e, 0xd7, 0xab, 0xac, 0x77, 0x97, 0xdd, 0x71, 0xdb, 0x1c,
+	0xb8, 0x71, 0x40, 0x39, 0x15, 0x38, 0xc0, 0x25, 0x52, 0x25, 0x4e, 0x48, 0xfc, 0x11, 0x1c, 0x7b,
+	0x42, 0x3d, 0xc2, 0x65, 0x8b, 0x9c, 0x0b, 0x0a, 0x17, 0xce, 0x3d, 0xa1, 0x99, 0x9d, 0xf5, 0xee,
+	0xda, 0x4e, 0xdb, 0x50, 0x10, 0x12, 0x97, 0x78, 0x7e, 0x7d, 0xef, 0xcd, 0xfb, 0xde, 0xb7, 0x6f,
+	0x66, 0x02, 0xce, 0xd9, 0x8e, 0x45, 0xad, 0xd2, 0x7d, 0xec, 0x18, 0x96, 0x66, 0xef, 0x96, 0xda,
+	0x84, 0x62, 0x15, 0x53, 0x5c, 0xe4, 0xe3, 0x70, 0xda, 0x9f, 0x28, 0x06, 0xf3, 0x0b, 0xb2, 0x66,
+	0x59, 0x9a, 0x41, 0x4a, 0x7c, 0x7a, 0xb7, 0x73, 0xaf, 0x44, 0xf5, 0x36, 0x71, 0x29, 0x6e, 0xdb,
+	0x3e, 0x62, 0xe1, 0x92, 0xa6, 0xd3, 0x56, 0x67, 0xb7, 0xd8, 0xb4, 0xda, 0x25, 0xcd, 0xd2, 0xac,
+	0x70, 0x25, 0xeb, 0xf9, 0xde, 0x58, 0xcb, 0x5f, 0xbe, 0xfc, 0x6b, 0x02, 0xc0, 0x5b, 0xc2, 0x67,
+	0x85, 0xb8, 0x4d, 0x47, 0xb7, 0xa9, 0xe5, 0xc0, 0x2b, 0x60, 0x12, 0xdb, 0xb6, 0xa1, 0x13, 0x55,
+	0xd1, 0x4d, 0x95, 0x3c, 0xcc, 0x4b, 0x05, 0x69, 0x25, 0x55, 0xce, 0x1d, 0x7b, 0xf2, 0x84, 0x98,
+	0xd8, 0x64, 0xe3, 0x28, 0xd6, 0x83, 0x18, 0x4c, 0xba, 0xd4, 0x72, 0xb0, 0x46, 0x14, 0xd3, 0x52,
+	0x89, 0x9b, 0x4f, 0x14, 0x92, 0x2b, 0xd9, 0xd5, 0xf3, 0xc5, 0xbe, 0x30, 0x8a, 0x0d, 0x7f, 0x55,
+	0xdd, 0x52, 0x49, 0xe8, 0xb5, 0x3c, 0xf7, 0xc4, 0x93, 0x25, 0xe6, 0xc2, 0x0d, 0xa7, 0x5d, 0x14,
+	0xeb, 0xc1, 0xbb, 0x20, 0x6b, 0x58, 0x9a, 0xe2, 0x52, 0x87, 0xe0, 0xb6, 0x9b, 0x4f, 0x72, 0x07,
+	0x6f, 0x0d, 0x38, 0xa8, 0x59, 0x5a, 0x83, 0x2f, 0x89, 0x98, 0x87, 0xc2, 0x3c, 0x30, 0x82, 0x49,
+	0x17, 0x45, 0xda, 0xf0, 0x26, 0x18, 0xa3, 0x96, 0xad, 0x37, 0xdd, 0x7c, 0x8a, 0x5b, 0x2d, 0x0c,
+	0x58, 0xdd, 0x66, 0xd3, 0x11, 0x8b, 0x53, 0xc2, 0xa2, 0xc0, 0x21, 0xf1, 0x7b, 0x2d, 0xf5, 0xfb,
+	0x63, 0x59, 0x5a, 0xfe, 0x26, 0x01, 0xce, 0x0c, 0x0d, 0x14, 0xde, 0x02, 0x13, 0x51, 0x9e, 0x38,
+	0xbb, 0xd9, 0xd5, 0xc5, 0x17, 0xd1, 0x54, 0x9e, 0x78, 0xe2, 0xc9, 0x23, 0x4f, 0x7d, 0x7f, 0x23,
+	0x28, 0x1b, 0x21, 0x05, 0x5e, 0x03, 0x63, 0x2e, 0xc5, 0xb4, 0xc3, 0xf8, 0x96, 0x56, 0xa6, 0x56,
+	0x97, 0x5f, 0x64, 0xa8, 0xc1, 0x57, 0x22, 0x81, 0x80, 0x73, 0x60, 0xd4, 0xc6, 0xb4, 0xe5, 0x33,
+	0x39, 0x8e, 0xfc, 0x0e, 0x6c, 0x80, 0x6c, 0xd3, 0x21, 0x98, 0x12, 0x85, 0xe9, 0x2b, 0x9f, 0xe2,
+	0xfb, 0x5b, 0x28, 0xfa, 0xe2, 0x2b, 0x06, 0x92, 0x2a, 0x6e, 0x07, 0xe2, 0x2b, 0xcf, 0xb3, 0xdd,
+	0x31, 0x6e, 0x7d, 0x18, 0x9b, 0x78, 0xf4, 0x4c, 0x96, 0x50, 0xa4, 0x2f, 0x58, 0xb9, 0x03, 0x66,
+	0xc4, 0x6e, 0x22, 0x84, 0x40, 0x90, 0x62, 0x8e, 0x39, 0x11, 0xe3, 0x88, 0xb7, 0xd9, 0x58, 0xc7,
+	0x25, 0x2a, 0x8f, 0x29, 0x85, 0x78, 0x9b, 0xed, 0x96, 0x5a, 0x14, 0x1b, 0xf9, 0x24, 0x1f, 0xf4,
+	0x3b, 0xc2, 0xf0, 0x9f, 0x09, 0x30, 0x3b, 0x24, 0xed, 0xf0, 0x53, 0x90, 0xe1, 0x69, 0x51, 0x74,
+	0x95, 0xdb, 0x1f, 0x2d, 0xaf, 0x77, 0x3d, 0x39, 0xcd, 0x73, 0xb9, 0x59, 0x39, 0xf6, 0xe4, 0x34,
+	0x9f, 0xde, 0x54, 0x9f, 0x7b, 0xf2, 0x85, 0xc8, 0xd7, 0xb3, 0x87, 0xf7, 0x70, 0xf0, 0x65, 0x96,
+	0xec, 0x3d, 0xad, 0x44, 0xf7, 0x6d, 0xe2, 0x16, 0x05, 0x0e, 0x05, 0x28, 0xe8, 0x82, 0xc9, 0x50,
+	0x91, 0x8a, 0xee, 0x6f, 0x78, 0xb4, 0xbc, 0xd5, 0xf5, 0xe4, 0x6c, 0x6f, 0x3f, 0xdc, 0x51, 0xb6,
+	0x27, 0x36, 0xee, 0xec, 0xd2, 0xcb, 0x9d, 0x45, 0xf0, 0x28, 0x8a, 0x86, 0x57, 0x7b, 0x29, 0x4f,
+	0xf2, 0x94, 0x17, 0x4e, 0xfe, 0x02, 0xfa, 0x12, 0x5e, 0x01, 0x19, 0x87, 0xd8, 0x86, 0xde, 0xc4,
+	0x81, 0xce, 0x07, 0xe5, 0x82, 0xfc, 0x05, 0x11, 0xa5, 0xa7, 0x98, 0xd2, 0x51, 0x0f, 0x29, 0x28,
+	0xff, 0x22, 0x01, 0x66, 0x06, 0xd6, 0xc2, 0xcf, 0xc1, 0x74, 0x54, 0xdd, 0x21, 0xef, 0x3b, 0x5d,
+	0x4f, 0x9e, 0x8c, 0x48, 0x91, 0x93, 0x32, 0x19, 0x51, 0x32, 0xa7, 0xa5, 0xf4, 0x72, 0x5a, 0x62,
+	0x36, 0x50, 0xdc, 0x02, 0xfc, 0x10, 0xcc, 0xc4, 0xdc, 0x73, 0x61, 0xb1, 0x9c, 0x8c, 0x97, 0x67,
+	0x8f, 0x3d, 0x79, 0x3a, 0xb2, 0xfa, 0x36, 0xa6, 0x2d, 0xd4, 0x3f, 0x00, 0x2f, 0x80, 0x71, 0x56,
+	0x0e, 0x7d, 0x60, 0x92, 0x03, 0x27, 0x8e, 0x3d, 0x39, 0xc3, 0x06, 0x39, 0xa2, 0xd7, 0x12, 0x34,
+	0xfc, 0x96, 0x00, 0xd3, 0x7d, 0xa5, 0xe1, 0x5f, 0x57, 0xdd, 0x8d, 0xbe, 0x6f, 0x7e, 0x71, 0x78,
+	0xb1, 0xf2, 0x93, 0x5f, 0x06, 0xac, 0x48, 0xb9, 0x71, 0x21, 0x98, 0x83, 0x95, 0x74, 0xb4, 0x7c,
+	0x4b, 0x54, 0xb4, 0xb9, 0xb0, 0x2e, 0xbe, 0x63, 0xb5, 0x75, 0x4a, 0xda, 0x36, 0xdd, 0x3f, 0xbd,
+	0x66, 0xa3, 0xe5, 0xf5, 0x0a, 0x18, 0x6f, 0x5a, 0x6d, 0x1b, 0x37, 0x29, 0x51, 0x79, 0x45, 0xc9,
+	0x94, 0xdf, 0x38, 0xf6, 0xe4, 0xd9, 0xde, 0x60, 0xe8, 0x08, 0x85, 0x2b, 0x05, 0xc5, 0x3f, 0x4a,
+	0x20, 0x1b, 0xc9, 0xfa, 0x7f, 0xad, 0xb1, 0x3c, 0x48, 0x63, 0x55, 0x75, 0x88, 0xeb, 0xd3, 0x3f,
+	0x8e, 0x82, 0xae, 0xd8, 0xee, 0x1f, 0x12, 0x98, 0xe2, 0xfc, 0xf7, 0xc8, 0xf8, 0x5f, 0x96, 0x21,
+	0x11, 0xed, 0x4f, 0x12, 0xc8, 0xf5, 0x96, 0x88, 0x7a, 0xf0, 0x4f, 0x9f, 0x71, 0x77, 0x40, 0xce,
+	0xa7, 0x2f, 0x0c, 0x92, 0x47, 0x98, 0x5d, 0x95, 0x87, 0x2b, 0xbf, 0xb7, 0xa1, 0x3e, 0xab, 0x53,
+	0x34, 0x36, 0x2b, 0x42, 0xf8, 0x41, 0x02, 0x33, 0x6c, 0x8c, 0x7c, 0xd6, 0x21, 0x66, 0x93, 0xd4,
+	0x3b, 0xed, 0x5d, 0xe2, 0xc0, 0x8f, 0x40, 0xca, 0x30, 0x5c, 0x53, 0xdc, 0x7e, 0x56, 0xbb, 0x9e,
+	0x9c, 0xaa, 0xd5, 0x1a, 0xf5, 0xe7, 0x9e, 0x7c, 0xfe, 0x15, 0x48, 0xab, 0x35, 0xea, 0x88, 0xe3,
+	0x99, 0x1d, 0x8d, 0xd9, 0x49, 0x84, 0x76, 0x36, 0x5e, 0xd9, 0xce, 0x06, 0xb7, 0xc3, 0xf0, 0x62,
+	0xaf, 0xcf, 0x12, 0x60, 0xa2, 0x66, 0x69, 0x55, 0x93, 0x3a, 0xfb, 0xec, 0xee, 0x06, 0x1b, 0x03,
+	0xd2, 0xba, 0x1a, 0x91, 0xd6, 0xdf, 0xd4, 0x93, 0x3a, 0x5c, 0x4f, 0x37, 0xfa, 0xf4, 0xf4, 0x9a,
+	0xe7, 0x58, 0xc0, 0x4c, 0xf2, 0xf5, 0x98, 0xe9, 0x65, 0x2a, 0xf5, 0x7a, 0x99, 0x12, 0x0c, 0x7f,
+	0x25, 0x81, 0x4c, 0xc0, 0x30, 0xbc, 0x0e, 0x52, 0xec, 0x56, 0x2e, 0x04, 0x7c, 0x6e, 0xd8, 0x41,
+	0xdb, 0x4b, 0x45, 0x39, 0x13, 0x68, 0x0d, 0x71, 0x10, 0xbb, 0xc4, 0xb0, 0xc3, 0x82, 0x93, 0x37,
+	0x81, 0x78, 0x1b, 0xe6, 0x40, 0x72, 0x8f, 0xec, 0xf3, 0x90, 0x27, 0x10, 0x6b, 0xc2, 0xc5, 0x81,
+	0xd2, 0x38, 0x58, 0x01, 0xbf, 0x4e, 0x81, 0xc9, 0x75, 0xab, 0xdd, 0xd6, 0xe9, 0xba, 0x65, 0x52,
+	0xf2, 0x90, 0xc2, 0x0d, 0x90, 0xbe, 0x4f, 0x1c, 0x57, 0xb7, 0x02, 0x81, 0x5e, 0x7a, 0xb5, 0x54,
+	0x7f, 0xec, 0x83, 0x50, 0x80, 0x86, 0xbb, 0x60, 0xaa, 0xa5, 0x6b, 0x2d, 0xe5, 0x01, 0xa6, 0xc4,
+	0x69, 0x63, 0x67, 0x4f, 0x08, 0xf5, 0x3a, 0xab, 0xa5, 0x37, 0x75, 0xad, 0x75, 0x27, 0x98, 0x38,
+	0x45, 0x5e, 0x26, 0x5b, 0x51, 0x20, 0x74, 0xc0, 0x5c, 0x93, 0xef, 0x9e, 0x12, 0x55, 0x61, 0x29,
+	0x53, 0x76, 0x89, 0xa6, 0x07, 0x89, 0x67, 0xaa, 0x82, 0xeb, 0xc1, 0x3c, 0xc3, 0x97, 0xd9, 0xec,
+	0x29, 0xdc, 0xc1, 0x9e, 0xf5, 0x0d, 0xc3, 0x35, 0x39, 0x1a, 0x1a, 0x00, 0xf6, 0xf9, 0x24, 0xa6,
+	0x2a, 0x24, 0xf2, 0x41, 0xd7, 0x93, 0x73, 0x31, 0x8f, 0x55, 0x53, 0x3d, 0x85, 0xbf, 0x5c, 0xcc,
+	0x5f, 0xd5, 0x54, 0xe3, 0x11, 0x1a, 0x61, 0x84, 0xa3, 0x43, 0x22, 0xac, 0x9d, 0x2e, 0xc2, 0x5a,
+	0x3c, 0xc2, 0x5a, 0x10, 0xe1, 0xf2, 0xcf, 0x49, 0x30, 0x1f, 0x3c, 0xdf, 0x10, 0xb1, 0x2d, 0x57,
+	0xa7, 0x96, 0xb3, 0xcf, 0x0b, 0xe6, 0x5d, 0x90, 0x8e, 0x9e, 0x8c, 0xfe, 0x0e, 0xc6, 0x7a, 0x47,
+	0xe2, 0x98, 0x19, 0x9c, 0x85, 0x2b, 0x2f, 0xf7, 0x2f, 0x0e, 0x41, 0x81, 0x81, 0x97, 0x41, 0xc6,
+	0xc1, 0xf7, 0xa8, 0xd2, 0x71, 0x0c, 0x71, 0xb1, 0x9a, 0x67, 0xf5, 0x06, 0xe1, 0x7b, 0x74, 0x07,
+	0xd5, 0xd8, 0x51, 0xe6, 0xf8, 0x4d, 0xe4, 0x37, 0x1c, 0x83, 0x43, 0xec, 0xa6, 0xc2, 0x4e, 0xc9,
+	0x7c, 0x32, 0x02, 0xb9, 0xbd, 0xbe, 0xa6, 0xaa, 0x0e, 0x87, 0xd8, 0x4d, 0xd6, 0x44, 0x41, 0x03,
+	0x2e, 0x83, 0x31, 0x83, 0x60, 0x95, 0x38, 0xe2, 0xb2, 0xc0, 0xef, 0x30, 0xfe, 0x08, 0x12, 0xbf,
+	0xf0, 0x6d, 0x90, 0x36, 0x08, 0x76, 0x4c, 0xe2, 0x70, 0x9a, 0x33, 0xe5, 0x2c, 0x33, 0x25, 0x86,
+	0x50, 0xd0, 0x80, 0x25, 0x90, 0x6d, 0x63, 0xda, 0x6c, 0x89, 0xc7, 0xec, 0x18, 0xe7, 0x63, 0x8a,
+	0x3d, 0x57, 0xf8, 0xb0, 0xff, 0x94, 0x8d, 0xb4, 0xe1, 0x59, 0x90, 0x34, 0xb0, 0x96, 0x4f, 0xf3,
+	0x85, 0xe9, 0x63, 0x4f, 0x66, 0x5d, 0xc4, 0xfe, 0xc0, 0xab, 0x60, 0xca, 0x76, 0x2c, 0x8d, 0x1d,
+	0xf6, 0x8a, 0x4b, 0x31, 0x25, 0xf9, 0x0c, 0x8f, 0x67, 0x86, 0xdd, 0x33, 0x82, 0x19, 0x76, 0xed,
+	0x22, 0x28, 0xde, 0x65, 0x01, 0xe1, 0x26, 0xd5, 0xef, 0x93, 0xfc, 0x78, 0x18, 0x90, 0x3f, 0x82,
+	0xc4, 0xef, 0xc5, 0x6f, 0xa5, 0xde, 0xf3, 0x28, 0x7c, 0xac, 0xc1, 0xf7, 0xc1, 0x9b, 0x8d, 0xed,
+	0x2d, 0xb4, 0xb6, 0x51, 0x55, 0xea, 0x5b, 0x95, 0xaa, 0xd2, 0xd8, 0x5e, 0xdb, 0xde, 0x69, 0x28,
+	0x68, 0xa7, 0x5e, 0xdf, 0xac, 0x6f, 0xe4, 0x46, 0x16, 0x16, 0x0f, 0x0e, 0x0b, 0xf9, 0x01, 0x1c,
+	0xea, 0x98, 0xa6, 0x6e, 0x6a, 0x27, 0xc1, 0x2b, 0xd5, 0x5a, 0x75, 0xbb, 0x5a, 0xc9, 0x49, 0x27,
+	0xc0, 0x2b, 0xc4, 0x20, 0x94, 0xa8, 0x0b, 0xa9, 0x2f, 0xbf, 0x5f, 0x1a, 0xb9, 0xf8, 0x5d, 0x02,
+	0x4c, 0xf7, 0xbd, 0x29, 0xe0, 0x65, 0x30, 0x53, 0x6b, 0x0c, 0xee, 0x66, 0xe1, 0xe0, 0xb0, 0x30,
+	0xdf, 0xb7, 0x36, 0xd8, 0x4b, 0x0c, 0xd2, 0xa8, 0xae, 0xd5, 0x18, 0x44, 0x1a, 0x0a, 0x69, 0x10,
+	0x6c, 0x30, 0x48, 0x09, 0xe4, 0xe2, 0x90, 0x6a, 0x25, 0x97, 0x58, 0x38, 0x7b, 0x70, 0x58, 0x38,
+	0x33, 0x04, 0x41, 0xd4, 0xb8, 0x8f, 0x20, 0xca, 0xe4, 0x50, 0x1f, 0x22, 0x46, 0x78, 0x05, 0xcc,
+	0x86, 0x90, 0x9d, 0x7a, 0xb0, 0xb1, 0x94, 0x4f, 0x4d, 0x1f, 0x68, 0xc7, 0x74, 0xfd, 0xad, 0x09,
+	0x6a, 0x1e, 0x80, 0x6c, 0xe4, 0xb2, 0x0d, 0xdf, 0x05, 0x73, 0xdb, 0x5b, 0xb7, 0x37, 0xd7, 0x07,
+	0x89, 0x99, 0x3f, 0x38, 0x2c, 0xc0, 0xc8, 0xd2, 0x80, 0x94, 0x7e, 0x44, 0x98, 0x99, 0x7e, 0x44,
+	0x2c, 0x27, 0xe5, 0x1b, 0x4f, 0xba, 0x4b, 0xd2, 0xd3, 0xee, 0x92, 0xf4, 0xe8, 0x68, 0x69, 0xe4,
+	0xf1, 0xd1, 0x92, 0xf4, 0xf4, 0x68, 0x69, 0xe4, 0x97, 0xa3, 0xa5, 0x91, 0x4f, 0x4e, 0x2e, 0x2a,
+	0xb1, 0xff, 0x37, 0xed, 0x8e, 0xf1, 0xfe, 0x7b, 0x7f, 0x0d, 0x00, 0x36, 0x90, 0x5d, 0xd5, 0x88,
+	0x12, 0x00, 0x00,
 }
 
 func (this *MetadataDescriptor) Equal(that interface{}) bool {
@@ -1311,6 +1390,9 @@ func (this *TopicDescriptor) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Compacted != that1.Compacted {
+		return false
+	}
 	return true
 }
 func (this *StorageNode) Equal(that interface{}) bool {
@@ -1479,6 +1561,12 @@ func (this *LogEntry) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Data, that1.Data) {
 		return false
 	}
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if this.Compacted != that1.Compacted {
+		return false
+	}
 	return true
 }
 func (m *MetadataDescriptor) Marshal() (dAtA []byte, err error) {
@@ -1760,6 +1848,16 @@ func (m *TopicDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Compacted {
+		i--
+		if m.Compacted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.LogStreams) > 0 {
 		dAtA4 := make([]byte, len(m.LogStreams)*10)
 		var j3 int
@@ -1999,6 +2097,23 @@ func (m *LogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Compacted {
+		i--
+		if m.Compacted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Data) > 0 {
 		i -= len(m.Data)
 		copy(dAtA[i:], m.Data)
@@ -2087,6 +2202,33 @@ func (m *MetadataRepositoryNode) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ProgressState) > 0 {
+		i -= len(m.ProgressState)
+		copy(dAtA[i:], m.ProgressState)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.ProgressState)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Lag != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.Lag))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MatchIndex != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MatchIndex))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Learner {
 		i--
 		if m.Learner {
@@ -2274,6 +2416,9 @@ func (m *TopicDescriptor) ProtoSize() (n int) {
 		}
 		n += 1 + sovMetadata(uint64(l)) + l
 	}
+	if m.Compacted {
+		n += 2
+	}
 	return n
 }
 
@@ -2369,6 +2514,13 @@ func (m *LogEntry) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMetadata(uint64(l))
 	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	if m.Compacted {
+		n += 2
+	}
 	return n
 }
 
@@ -2419,6 +2571,19 @@ func (m *MetadataRepositoryNode) ProtoSize() (n int) {
 	if m.Learner {
 		n += 2
 	}
+	if m.MatchIndex != 0 {
+		n += 1 + sovMetadata(uint64(m.MatchIndex))
+	}
+	if m.Lag != 0 {
+		n += 1 + sovMetadata(uint64(m.Lag))
+	}
+	l = len(m.ProgressState)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
 	return n
 }
 
@@ -3303,6 +3468,26 @@ func (m *TopicDescriptor) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compacted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compacted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -3939,6 +4124,60 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compacted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compacted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -4257,6 +4496,96 @@ func (m *MetadataRepositoryNode) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Learner = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchIndex", wireType)
+			}
+			m.MatchIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
+			}
+			m.Lag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lag |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgressState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
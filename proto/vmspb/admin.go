@@ -1,3 +1,211 @@
 package vmspb
 
 //go:generate mockgen -build_flags -mod=vendor -package vmspb -destination admin_mock.go . ClusterManagerClient,ClusterManagerServer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	drainStateNone     = "none"
+	drainStateDraining = "draining"
+	drainStateFailed   = "failed"
+)
+
+// MarshalJSON returns the JSON encoding of the DrainState.
+func (ds DrainState) MarshalJSON() ([]byte, error) {
+	var s string
+	switch ds {
+	case DrainStateNone:
+		s = drainStateNone
+	case DrainStateDraining:
+		s = drainStateDraining
+	case DrainStateFailed:
+		s = drainStateFailed
+	default:
+		return nil, fmt.Errorf("unexpected drain state: %v", ds)
+	}
+	return json.Marshal(s)
+}
+
+// UnmarshalJSON parses the JSON-encoded data and stores the result in the
+// value of type DrainState.
+func (ds *DrainState) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	switch strings.ToLower(s) {
+	case drainStateNone:
+		*ds = DrainStateNone
+	case drainStateDraining:
+		*ds = DrainStateDraining
+	case drainStateFailed:
+		*ds = DrainStateFailed
+	default:
+		return fmt.Errorf("unexpected data: %s", s)
+	}
+	return nil
+}
+
+const (
+	operationKindUnknown         = "unknown"
+	operationKindSeal            = "seal"
+	operationKindUnseal          = "unseal"
+	operationKindSync            = "sync"
+	operationKindUpdateLogStream = "update_log_stream"
+)
+
+// MarshalJSON returns the JSON encoding of the OperationKind.
+func (k OperationKind) MarshalJSON() ([]byte, error) {
+	var s string
+	switch k {
+	case OperationKindUnknown:
+		s = operationKindUnknown
+	case OperationKindSeal:
+		s = operationKindSeal
+	case OperationKindUnseal:
+		s = operationKindUnseal
+	case OperationKindSync:
+		s = operationKindSync
+	case OperationKindUpdateLogStream:
+		s = operationKindUpdateLogStream
+	default:
+		return nil, fmt.Errorf("unexpected operation kind: %v", k)
+	}
+	return json.Marshal(s)
+}
+
+// UnmarshalJSON parses the JSON-encoded data and stores the result in the
+// value of type OperationKind.
+func (k *OperationKind) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	switch strings.ToLower(s) {
+	case operationKindUnknown:
+		*k = OperationKindUnknown
+	case operationKindSeal:
+		*k = OperationKindSeal
+	case operationKindUnseal:
+		*k = OperationKindUnseal
+	case operationKindSync:
+		*k = OperationKindSync
+	case operationKindUpdateLogStream:
+		*k = OperationKindUpdateLogStream
+	default:
+		return fmt.Errorf("unexpected data: %s", s)
+	}
+	return nil
+}
+
+const (
+	operationStateUnknown   = "unknown"
+	operationStateRunning   = "running"
+	operationStateSucceeded = "succeeded"
+	operationStateFailed    = "failed"
+	operationStateCanceled  = "canceled"
+)
+
+// Done returns true if the operation is finished, that is, succeeded, failed
+// or canceled.
+func (st OperationState) Done() bool {
+	return st == OperationStateSucceeded || st == OperationStateFailed || st == OperationStateCanceled
+}
+
+// MarshalJSON returns the JSON encoding of the OperationState.
+func (st OperationState) MarshalJSON() ([]byte, error) {
+	var s string
+	switch st {
+	case OperationStateUnknown:
+		s = operationStateUnknown
+	case OperationStateRunning:
+		s = operationStateRunning
+	case OperationStateSucceeded:
+		s = operationStateSucceeded
+	case OperationStateFailed:
+		s = operationStateFailed
+	case OperationStateCanceled:
+		s = operationStateCanceled
+	default:
+		return nil, fmt.Errorf("unexpected operation state: %v", st)
+	}
+	return json.Marshal(s)
+}
+
+// UnmarshalJSON parses the JSON-encoded data and stores the result in the
+// value of type OperationState.
+func (st *OperationState) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	switch strings.ToLower(s) {
+	case operationStateUnknown:
+		*st = OperationStateUnknown
+	case operationStateRunning:
+		*st = OperationStateRunning
+	case operationStateSucceeded:
+		*st = OperationStateSucceeded
+	case operationStateFailed:
+		*st = OperationStateFailed
+	case operationStateCanceled:
+		*st = OperationStateCanceled
+	default:
+		return fmt.Errorf("unexpected data: %s", s)
+	}
+	return nil
+}
+
+const (
+	eventKindUnknown            = "unknown"
+	eventKindHeartbeatTimeout   = "heartbeat_timeout"
+	eventKindLogStreamSealing   = "log_stream_sealing"
+	eventKindLogStreamSealed    = "log_stream_sealed"
+	eventKindLogStreamUnsealed  = "log_stream_unsealed"
+	eventKindSyncProgress       = "sync_progress"
+	eventKindSyncCompleted      = "sync_completed"
+	eventKindSyncFailed         = "sync_failed"
+	eventKindLogStreamReplicaGC = "log_stream_replica_gc"
+)
+
+var eventKindNames = map[EventKind]string{
+	EventKindUnknown:            eventKindUnknown,
+	EventKindHeartbeatTimeout:   eventKindHeartbeatTimeout,
+	EventKindLogStreamSealing:   eventKindLogStreamSealing,
+	EventKindLogStreamSealed:    eventKindLogStreamSealed,
+	EventKindLogStreamUnsealed:  eventKindLogStreamUnsealed,
+	EventKindSyncProgress:       eventKindSyncProgress,
+	EventKindSyncCompleted:      eventKindSyncCompleted,
+	EventKindSyncFailed:         eventKindSyncFailed,
+	EventKindLogStreamReplicaGC: eventKindLogStreamReplicaGC,
+}
+
+// MarshalJSON returns the JSON encoding of the EventKind.
+func (k EventKind) MarshalJSON() ([]byte, error) {
+	s, ok := eventKindNames[k]
+	if !ok {
+		return nil, fmt.Errorf("unexpected event kind: %v", k)
+	}
+	return json.Marshal(s)
+}
+
+// UnmarshalJSON parses the JSON-encoded data and stores the result in the
+// value of type EventKind.
+func (k *EventKind) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	s = strings.ToLower(s)
+	for kind, name := range eventKindNames {
+		if name == s {
+			*k = kind
+			return nil
+		}
+	}
+	return fmt.Errorf("unexpected data: %s", s)
+}
@@ -37,6 +37,167 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DrainState is the state of draining a storage node.
+type DrainState int32
+
+const (
+	DrainStateNone     DrainState = 0
+	DrainStateDraining DrainState = 1
+	DrainStateFailed   DrainState = 2
+)
+
+var DrainState_name = map[int32]string{
+	0: "DRAIN_STATE_NONE",
+	1: "DRAIN_STATE_DRAINING",
+	2: "DRAIN_STATE_FAILED",
+}
+
+var DrainState_value = map[string]int32{
+	"DRAIN_STATE_NONE":     0,
+	"DRAIN_STATE_DRAINING": 1,
+	"DRAIN_STATE_FAILED":   2,
+}
+
+func (x DrainState) String() string {
+	return proto.EnumName(DrainState_name, int32(x))
+}
+
+func (DrainState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{0}
+}
+
+// OperationKind is the kind of long-running operation.
+type OperationKind int32
+
+const (
+	OperationKindUnknown         OperationKind = 0
+	OperationKindSeal            OperationKind = 1
+	OperationKindUnseal          OperationKind = 2
+	OperationKindSync            OperationKind = 3
+	OperationKindUpdateLogStream OperationKind = 4
+)
+
+var OperationKind_name = map[int32]string{
+	0: "OPERATION_KIND_UNKNOWN",
+	1: "OPERATION_KIND_SEAL",
+	2: "OPERATION_KIND_UNSEAL",
+	3: "OPERATION_KIND_SYNC",
+	4: "OPERATION_KIND_UPDATE_LOG_STREAM",
+}
+
+var OperationKind_value = map[string]int32{
+	"OPERATION_KIND_UNKNOWN":           0,
+	"OPERATION_KIND_SEAL":              1,
+	"OPERATION_KIND_UNSEAL":            2,
+	"OPERATION_KIND_SYNC":              3,
+	"OPERATION_KIND_UPDATE_LOG_STREAM": 4,
+}
+
+func (x OperationKind) String() string {
+	return proto.EnumName(OperationKind_name, int32(x))
+}
+
+func (OperationKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{1}
+}
+
+// OperationState is the state of long-running operation.
+type OperationState int32
+
+const (
+	OperationStateUnknown   OperationState = 0
+	OperationStateRunning   OperationState = 1
+	OperationStateSucceeded OperationState = 2
+	OperationStateFailed    OperationState = 3
+	OperationStateCanceled  OperationState = 4
+)
+
+var OperationState_name = map[int32]string{
+	0: "OPERATION_STATE_UNKNOWN",
+	1: "OPERATION_STATE_RUNNING",
+	2: "OPERATION_STATE_SUCCEEDED",
+	3: "OPERATION_STATE_FAILED",
+	4: "OPERATION_STATE_CANCELED",
+}
+
+var OperationState_value = map[string]int32{
+	"OPERATION_STATE_UNKNOWN":   0,
+	"OPERATION_STATE_RUNNING":   1,
+	"OPERATION_STATE_SUCCEEDED": 2,
+	"OPERATION_STATE_FAILED":    3,
+	"OPERATION_STATE_CANCELED":  4,
+}
+
+func (x OperationState) String() string {
+	return proto.EnumName(OperationState_name, int32(x))
+}
+
+func (OperationState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{2}
+}
+
+// EventKind is the kind of cluster event.
+type EventKind int32
+
+const (
+	EventKindUnknown EventKind = 0
+	// EVENT_KIND_HEARTBEAT_TIMEOUT means that the storage node has not
+	// responded to the admin server for a while.
+	EventKindHeartbeatTimeout EventKind = 1
+	// EVENT_KIND_LOG_STREAM_SEALING means that the admin server started
+	// sealing the log stream.
+	EventKindLogStreamSealing EventKind = 2
+	// EVENT_KIND_LOG_STREAM_SEALED means that all replicas of the log stream
+	// are sealed.
+	EventKindLogStreamSealed EventKind = 3
+	// EVENT_KIND_LOG_STREAM_UNSEALED means that the log stream is unsealed.
+	EventKindLogStreamUnsealed EventKind = 4
+	// EVENT_KIND_SYNC_PROGRESS means that the sync of the log stream replica
+	// started or made progress.
+	EventKindSyncProgress EventKind = 5
+	// EVENT_KIND_SYNC_COMPLETED means that the sync of the log stream replica
+	// completed.
+	EventKindSyncCompleted EventKind = 6
+	// EVENT_KIND_SYNC_FAILED means that the sync of the log stream replica
+	// failed.
+	EventKindSyncFailed EventKind = 7
+	// EVENT_KIND_LOG_STREAM_REPLICA_GC means that the admin server removed
+	// the garbage log stream replica not registered to the cluster.
+	EventKindLogStreamReplicaGC EventKind = 8
+)
+
+var EventKind_name = map[int32]string{
+	0: "EVENT_KIND_UNKNOWN",
+	1: "EVENT_KIND_HEARTBEAT_TIMEOUT",
+	2: "EVENT_KIND_LOG_STREAM_SEALING",
+	3: "EVENT_KIND_LOG_STREAM_SEALED",
+	4: "EVENT_KIND_LOG_STREAM_UNSEALED",
+	5: "EVENT_KIND_SYNC_PROGRESS",
+	6: "EVENT_KIND_SYNC_COMPLETED",
+	7: "EVENT_KIND_SYNC_FAILED",
+	8: "EVENT_KIND_LOG_STREAM_REPLICA_GC",
+}
+
+var EventKind_value = map[string]int32{
+	"EVENT_KIND_UNKNOWN":               0,
+	"EVENT_KIND_HEARTBEAT_TIMEOUT":     1,
+	"EVENT_KIND_LOG_STREAM_SEALING":    2,
+	"EVENT_KIND_LOG_STREAM_SEALED":     3,
+	"EVENT_KIND_LOG_STREAM_UNSEALED":   4,
+	"EVENT_KIND_SYNC_PROGRESS":         5,
+	"EVENT_KIND_SYNC_COMPLETED":        6,
+	"EVENT_KIND_SYNC_FAILED":           7,
+	"EVENT_KIND_LOG_STREAM_REPLICA_GC": 8,
+}
+
+func (x EventKind) String() string {
+	return proto.EnumName(EventKind_name, int32(x))
+}
+
+func (EventKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{3}
+}
+
 // StorageNodeMetadata represents the current status of the storage node.
 type StorageNodeMetadata struct {
 	snpb.StorageNodeMetadataDescriptor `protobuf:"bytes,1,opt,name=storage_node,json=storageNode,proto3,embedded=storage_node" json:""`
@@ -49,6 +210,9 @@ type StorageNodeMetadata struct {
 	// registered, the admin server does not know the field. It is also possible
 	// that the admin server is just restarted.
 	LastHeartbeatTime time.Time `protobuf:"bytes,3,opt,name=last_heartbeat_time,json=lastHeartbeatTime,proto3,stdtime" json:"lastHeartbeatTime"`
+	// DrainStatus is the progress of draining the storage node. It is nil if
+	// the storage node is not being drained.
+	DrainStatus *DrainStatus `protobuf:"bytes,4,opt,name=drain_status,json=drainStatus,proto3" json:"drainStatus,omitempty"`
 }
 
 func (m *StorageNodeMetadata) Reset()         { *m = StorageNodeMetadata{} }
@@ -98,22 +262,42 @@ func (m *StorageNodeMetadata) GetLastHeartbeatTime() time.Time {
 	return time.Time{}
 }
 
-type GetStorageNodeRequest struct {
-	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,1,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
+func (m *StorageNodeMetadata) GetDrainStatus() *DrainStatus {
+	if m != nil {
+		return m.DrainStatus
+	}
+	return nil
 }
 
-func (m *GetStorageNodeRequest) Reset()         { *m = GetStorageNodeRequest{} }
-func (m *GetStorageNodeRequest) String() string { return proto.CompactTextString(m) }
-func (*GetStorageNodeRequest) ProtoMessage()    {}
-func (*GetStorageNodeRequest) Descriptor() ([]byte, []int) {
+// DrainStatus represents the progress of draining a storage node.
+type DrainStatus struct {
+	State DrainState `protobuf:"varint,1,opt,name=state,proto3,enum=varlog.vmspb.DrainState" json:"state"`
+	// TotalReplicas is the number of log stream replicas in the storage node
+	// when draining starts.
+	TotalReplicas int32 `protobuf:"varint,2,opt,name=total_replicas,json=totalReplicas,proto3" json:"totalReplicas"`
+	// MovedReplicas is the number of log stream replicas moved to other storage
+	// nodes.
+	MovedReplicas int32 `protobuf:"varint,3,opt,name=moved_replicas,json=movedReplicas,proto3" json:"movedReplicas"`
+	// LogStreamID is the log stream whose replica is being moved now.
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,4,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"logStreamId,omitempty"`
+	// Error is the reason why draining failed.
+	Error      string    `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	StartTime  time.Time `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"startTime"`
+	UpdateTime time.Time `protobuf:"bytes,7,opt,name=update_time,json=updateTime,proto3,stdtime" json:"updateTime"`
+}
+
+func (m *DrainStatus) Reset()         { *m = DrainStatus{} }
+func (m *DrainStatus) String() string { return proto.CompactTextString(m) }
+func (*DrainStatus) ProtoMessage()    {}
+func (*DrainStatus) Descriptor() ([]byte, []int) {
 	return fileDescriptor_55f6257e87fe6989, []int{1}
 }
-func (m *GetStorageNodeRequest) XXX_Unmarshal(b []byte) error {
+func (m *DrainStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetStorageNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DrainStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetStorageNodeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DrainStatus.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -123,85 +307,90 @@ func (m *GetStorageNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *GetStorageNodeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetStorageNodeRequest.Merge(m, src)
+func (m *DrainStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainStatus.Merge(m, src)
 }
-func (m *GetStorageNodeRequest) XXX_Size() int {
+func (m *DrainStatus) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *GetStorageNodeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetStorageNodeRequest.DiscardUnknown(m)
+func (m *DrainStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetStorageNodeRequest proto.InternalMessageInfo
+var xxx_messageInfo_DrainStatus proto.InternalMessageInfo
 
-func (m *GetStorageNodeRequest) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+func (m *DrainStatus) GetState() DrainState {
 	if m != nil {
-		return m.StorageNodeID
+		return m.State
 	}
-	return 0
+	return DrainStateNone
 }
 
-type GetStorageNodeResponse struct {
-	// StorageNode registered to the cluster.
-	StorageNode *StorageNodeMetadata `protobuf:"bytes,1,opt,name=storage_node,json=storageNode,proto3" json:"storageNode"`
+func (m *DrainStatus) GetTotalReplicas() int32 {
+	if m != nil {
+		return m.TotalReplicas
+	}
+	return 0
 }
 
-func (m *GetStorageNodeResponse) Reset()         { *m = GetStorageNodeResponse{} }
-func (m *GetStorageNodeResponse) String() string { return proto.CompactTextString(m) }
-func (*GetStorageNodeResponse) ProtoMessage()    {}
-func (*GetStorageNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{2}
-}
-func (m *GetStorageNodeResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GetStorageNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GetStorageNodeResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *DrainStatus) GetMovedReplicas() int32 {
+	if m != nil {
+		return m.MovedReplicas
 	}
+	return 0
 }
-func (m *GetStorageNodeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetStorageNodeResponse.Merge(m, src)
-}
-func (m *GetStorageNodeResponse) XXX_Size() int {
-	return m.ProtoSize()
+
+func (m *DrainStatus) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
 }
-func (m *GetStorageNodeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetStorageNodeResponse.DiscardUnknown(m)
+
+func (m *DrainStatus) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-var xxx_messageInfo_GetStorageNodeResponse proto.InternalMessageInfo
+func (m *DrainStatus) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
 
-func (m *GetStorageNodeResponse) GetStorageNode() *StorageNodeMetadata {
+func (m *DrainStatus) GetUpdateTime() time.Time {
 	if m != nil {
-		return m.StorageNode
+		return m.UpdateTime
 	}
-	return nil
+	return time.Time{}
 }
 
-type ListStorageNodesRequest struct {
+// OperationProgress represents how much work a long-running operation has
+// done. Only the sync operation reports progress currently, and it is counted
+// in log entries rather than bytes since the storage node reports the
+// position of sync only.
+type OperationProgress struct {
+	// TotalEntries is the number of log entries to be copied.
+	TotalEntries uint64 `protobuf:"varint,1,opt,name=total_entries,json=totalEntries,proto3" json:"totalEntries"`
+	// DoneEntries is the number of log entries copied already.
+	DoneEntries uint64 `protobuf:"varint,2,opt,name=done_entries,json=doneEntries,proto3" json:"doneEntries"`
 }
 
-func (m *ListStorageNodesRequest) Reset()         { *m = ListStorageNodesRequest{} }
-func (m *ListStorageNodesRequest) String() string { return proto.CompactTextString(m) }
-func (*ListStorageNodesRequest) ProtoMessage()    {}
-func (*ListStorageNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{3}
+func (m *OperationProgress) Reset()         { *m = OperationProgress{} }
+func (m *OperationProgress) String() string { return proto.CompactTextString(m) }
+func (*OperationProgress) ProtoMessage()    {}
+func (*OperationProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{2}
 }
-func (m *ListStorageNodesRequest) XXX_Unmarshal(b []byte) error {
+func (m *OperationProgress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListStorageNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OperationProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListStorageNodesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OperationProgress.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -211,35 +400,65 @@ func (m *ListStorageNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *ListStorageNodesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListStorageNodesRequest.Merge(m, src)
+func (m *OperationProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationProgress.Merge(m, src)
 }
-func (m *ListStorageNodesRequest) XXX_Size() int {
+func (m *OperationProgress) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *ListStorageNodesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListStorageNodesRequest.DiscardUnknown(m)
+func (m *OperationProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationProgress.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListStorageNodesRequest proto.InternalMessageInfo
+var xxx_messageInfo_OperationProgress proto.InternalMessageInfo
 
-type ListStorageNodesResponse struct {
-	// StorageNodes registered to the cluster.
-	StorageNodes []StorageNodeMetadata `protobuf:"bytes,1,rep,name=storage_nodes,json=storageNodes,proto3" json:"storageNodes"`
+func (m *OperationProgress) GetTotalEntries() uint64 {
+	if m != nil {
+		return m.TotalEntries
+	}
+	return 0
 }
 
-func (m *ListStorageNodesResponse) Reset()         { *m = ListStorageNodesResponse{} }
-func (m *ListStorageNodesResponse) String() string { return proto.CompactTextString(m) }
-func (*ListStorageNodesResponse) ProtoMessage()    {}
-func (*ListStorageNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{4}
+func (m *OperationProgress) GetDoneEntries() uint64 {
+	if m != nil {
+		return m.DoneEntries
+	}
+	return 0
 }
-func (m *ListStorageNodesResponse) XXX_Unmarshal(b []byte) error {
+
+// Operation represents a long-running operation that reconfigures a log
+// stream, for instance, sealing, unsealing, synchronizing and updating
+// replicas. The admin server persists operations, thus, they survive its
+// restart.
+type Operation struct {
+	OperationID uint64                                        `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operationId"`
+	Kind        OperationKind                                 `protobuf:"varint,2,opt,name=kind,proto3,enum=varlog.vmspb.OperationKind" json:"kind"`
+	State       OperationState                                `protobuf:"varint,3,opt,name=state,proto3,enum=varlog.vmspb.OperationState" json:"state"`
+	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,4,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topicId"`
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,5,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"logStreamId"`
+	// SrcStorageNodeID is the source of the sync operation.
+	SrcStorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,6,opt,name=src_storage_node_id,json=srcStorageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"srcStorageNodeId,omitempty"`
+	// DstStorageNodeID is the destination of the sync operation.
+	DstStorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,7,opt,name=dst_storage_node_id,json=dstStorageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"dstStorageNodeId,omitempty"`
+	Progress         OperationProgress                               `protobuf:"bytes,8,opt,name=progress,proto3" json:"progress"`
+	// Error is the reason why the operation failed.
+	Error      string    `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	CreateTime time.Time `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3,stdtime" json:"createTime"`
+	UpdateTime time.Time `protobuf:"bytes,11,opt,name=update_time,json=updateTime,proto3,stdtime" json:"updateTime"`
+}
+
+func (m *Operation) Reset()         { *m = Operation{} }
+func (m *Operation) String() string { return proto.CompactTextString(m) }
+func (*Operation) ProtoMessage()    {}
+func (*Operation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{3}
+}
+func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListStorageNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Operation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListStorageNodesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Operation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -249,85 +468,129 @@ func (m *ListStorageNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *ListStorageNodesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListStorageNodesResponse.Merge(m, src)
+func (m *Operation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation.Merge(m, src)
 }
-func (m *ListStorageNodesResponse) XXX_Size() int {
+func (m *Operation) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *ListStorageNodesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListStorageNodesResponse.DiscardUnknown(m)
+func (m *Operation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListStorageNodesResponse proto.InternalMessageInfo
+var xxx_messageInfo_Operation proto.InternalMessageInfo
 
-func (m *ListStorageNodesResponse) GetStorageNodes() []StorageNodeMetadata {
+func (m *Operation) GetOperationID() uint64 {
 	if m != nil {
-		return m.StorageNodes
+		return m.OperationID
 	}
-	return nil
+	return 0
 }
 
-type AddStorageNodeRequest struct {
-	StorageNode varlogpb.StorageNode `protobuf:"bytes,1,opt,name=storage_node,json=storageNode,proto3" json:"storage_node"`
+func (m *Operation) GetKind() OperationKind {
+	if m != nil {
+		return m.Kind
+	}
+	return OperationKindUnknown
 }
 
-func (m *AddStorageNodeRequest) Reset()         { *m = AddStorageNodeRequest{} }
-func (m *AddStorageNodeRequest) String() string { return proto.CompactTextString(m) }
-func (*AddStorageNodeRequest) ProtoMessage()    {}
-func (*AddStorageNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{5}
+func (m *Operation) GetState() OperationState {
+	if m != nil {
+		return m.State
+	}
+	return OperationStateUnknown
 }
-func (m *AddStorageNodeRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *Operation) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
 }
-func (m *AddStorageNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_AddStorageNodeRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Operation) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
 	}
+	return 0
 }
-func (m *AddStorageNodeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddStorageNodeRequest.Merge(m, src)
+
+func (m *Operation) GetSrcStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.SrcStorageNodeID
+	}
+	return 0
 }
-func (m *AddStorageNodeRequest) XXX_Size() int {
-	return m.ProtoSize()
+
+func (m *Operation) GetDstStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.DstStorageNodeID
+	}
+	return 0
 }
-func (m *AddStorageNodeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddStorageNodeRequest.DiscardUnknown(m)
+
+func (m *Operation) GetProgress() OperationProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return OperationProgress{}
 }
 
-var xxx_messageInfo_AddStorageNodeRequest proto.InternalMessageInfo
+func (m *Operation) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
 
-func (m *AddStorageNodeRequest) GetStorageNode() varlogpb.StorageNode {
+func (m *Operation) GetCreateTime() time.Time {
 	if m != nil {
-		return m.StorageNode
+		return m.CreateTime
 	}
-	return varlogpb.StorageNode{}
+	return time.Time{}
 }
 
-type AddStorageNodeResponse struct {
-	StorageNode *StorageNodeMetadata `protobuf:"bytes,1,opt,name=storage_node,json=storageNode,proto3" json:"storageNode"`
+func (m *Operation) GetUpdateTime() time.Time {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return time.Time{}
 }
 
-func (m *AddStorageNodeResponse) Reset()         { *m = AddStorageNodeResponse{} }
-func (m *AddStorageNodeResponse) String() string { return proto.CompactTextString(m) }
-func (*AddStorageNodeResponse) ProtoMessage()    {}
-func (*AddStorageNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{6}
+// Event is a change of the cluster noticed by the admin server.
+type Event struct {
+	// EventID identifies the event. Event identifiers increase
+	// monotonically, thus, clients can use them as cursors to resume
+	// watching events.
+	EventID       uint64                                          `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"eventId"`
+	Kind          EventKind                                       `protobuf:"varint,2,opt,name=kind,proto3,enum=varlog.vmspb.EventKind" json:"kind"`
+	CreateTime    time.Time                                       `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3,stdtime" json:"createTime"`
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,4,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storageNodeId,omitempty"`
+	TopicID       github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,5,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topicId,omitempty"`
+	LogStreamID   github_com_kakao_varlog_pkg_types.LogStreamID   `protobuf:"varint,6,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"logStreamId,omitempty"`
+	// SrcStorageNodeID is the source of the sync.
+	SrcStorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,7,opt,name=src_storage_node_id,json=srcStorageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"srcStorageNodeId,omitempty"`
+	// DstStorageNodeID is the destination of the sync.
+	DstStorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,8,opt,name=dst_storage_node_id,json=dstStorageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"dstStorageNodeId,omitempty"`
+	// Progress is the progress of the sync.
+	Progress *OperationProgress `protobuf:"bytes,9,opt,name=progress,proto3" json:"progress,omitempty"`
+	// Message describes the event in detail, for instance, the reason why the
+	// sync failed.
+	Message string `protobuf:"bytes,10,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{4}
 }
-func (m *AddStorageNodeResponse) XXX_Unmarshal(b []byte) error {
+func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddStorageNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddStorageNodeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -337,41 +600,104 @@ func (m *AddStorageNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *AddStorageNodeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddStorageNodeResponse.Merge(m, src)
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
 }
-func (m *AddStorageNodeResponse) XXX_Size() int {
+func (m *Event) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *AddStorageNodeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddStorageNodeResponse.DiscardUnknown(m)
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddStorageNodeResponse proto.InternalMessageInfo
+var xxx_messageInfo_Event proto.InternalMessageInfo
 
-func (m *AddStorageNodeResponse) GetStorageNode() *StorageNodeMetadata {
+func (m *Event) GetEventID() uint64 {
 	if m != nil {
-		return m.StorageNode
+		return m.EventID
 	}
-	return nil
+	return 0
 }
 
-type UnregisterStorageNodeRequest struct {
-	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,1,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
-}
+func (m *Event) GetKind() EventKind {
+	if m != nil {
+		return m.Kind
+	}
+	return EventKindUnknown
+}
 
-func (m *UnregisterStorageNodeRequest) Reset()         { *m = UnregisterStorageNodeRequest{} }
-func (m *UnregisterStorageNodeRequest) String() string { return proto.CompactTextString(m) }
-func (*UnregisterStorageNodeRequest) ProtoMessage()    {}
-func (*UnregisterStorageNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{7}
+func (m *Event) GetCreateTime() time.Time {
+	if m != nil {
+		return m.CreateTime
+	}
+	return time.Time{}
 }
-func (m *UnregisterStorageNodeRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *Event) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.StorageNodeID
+	}
+	return 0
+}
+
+func (m *Event) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+func (m *Event) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
+}
+
+func (m *Event) GetSrcStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.SrcStorageNodeID
+	}
+	return 0
+}
+
+func (m *Event) GetDstStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.DstStorageNodeID
+	}
+	return 0
+}
+
+func (m *Event) GetProgress() *OperationProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+func (m *Event) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type GetStorageNodeRequest struct {
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,1,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
+}
+
+func (m *GetStorageNodeRequest) Reset()         { *m = GetStorageNodeRequest{} }
+func (m *GetStorageNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStorageNodeRequest) ProtoMessage()    {}
+func (*GetStorageNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{5}
+}
+func (m *GetStorageNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnregisterStorageNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetStorageNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnregisterStorageNodeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetStorageNodeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -381,40 +707,42 @@ func (m *UnregisterStorageNodeRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *UnregisterStorageNodeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnregisterStorageNodeRequest.Merge(m, src)
+func (m *GetStorageNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStorageNodeRequest.Merge(m, src)
 }
-func (m *UnregisterStorageNodeRequest) XXX_Size() int {
+func (m *GetStorageNodeRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *UnregisterStorageNodeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnregisterStorageNodeRequest.DiscardUnknown(m)
+func (m *GetStorageNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStorageNodeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnregisterStorageNodeRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetStorageNodeRequest proto.InternalMessageInfo
 
-func (m *UnregisterStorageNodeRequest) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+func (m *GetStorageNodeRequest) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
 	if m != nil {
 		return m.StorageNodeID
 	}
 	return 0
 }
 
-type UnregisterStorageNodeResponse struct {
+type GetStorageNodeResponse struct {
+	// StorageNode registered to the cluster.
+	StorageNode *StorageNodeMetadata `protobuf:"bytes,1,opt,name=storage_node,json=storageNode,proto3" json:"storageNode"`
 }
 
-func (m *UnregisterStorageNodeResponse) Reset()         { *m = UnregisterStorageNodeResponse{} }
-func (m *UnregisterStorageNodeResponse) String() string { return proto.CompactTextString(m) }
-func (*UnregisterStorageNodeResponse) ProtoMessage()    {}
-func (*UnregisterStorageNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{8}
+func (m *GetStorageNodeResponse) Reset()         { *m = GetStorageNodeResponse{} }
+func (m *GetStorageNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStorageNodeResponse) ProtoMessage()    {}
+func (*GetStorageNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{6}
 }
-func (m *UnregisterStorageNodeResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetStorageNodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnregisterStorageNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetStorageNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnregisterStorageNodeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetStorageNodeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -424,34 +752,40 @@ func (m *UnregisterStorageNodeResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *UnregisterStorageNodeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnregisterStorageNodeResponse.Merge(m, src)
+func (m *GetStorageNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStorageNodeResponse.Merge(m, src)
 }
-func (m *UnregisterStorageNodeResponse) XXX_Size() int {
+func (m *GetStorageNodeResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *UnregisterStorageNodeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnregisterStorageNodeResponse.DiscardUnknown(m)
+func (m *GetStorageNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStorageNodeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnregisterStorageNodeResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetStorageNodeResponse proto.InternalMessageInfo
 
-type GetTopicRequest struct {
-	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+func (m *GetStorageNodeResponse) GetStorageNode() *StorageNodeMetadata {
+	if m != nil {
+		return m.StorageNode
+	}
+	return nil
 }
 
-func (m *GetTopicRequest) Reset()         { *m = GetTopicRequest{} }
-func (m *GetTopicRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTopicRequest) ProtoMessage()    {}
-func (*GetTopicRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{9}
+type ListStorageNodesRequest struct {
 }
-func (m *GetTopicRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *ListStorageNodesRequest) Reset()         { *m = ListStorageNodesRequest{} }
+func (m *ListStorageNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListStorageNodesRequest) ProtoMessage()    {}
+func (*ListStorageNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{7}
+}
+func (m *ListStorageNodesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListStorageNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTopicRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListStorageNodesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -461,41 +795,35 @@ func (m *GetTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *GetTopicRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTopicRequest.Merge(m, src)
+func (m *ListStorageNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListStorageNodesRequest.Merge(m, src)
 }
-func (m *GetTopicRequest) XXX_Size() int {
+func (m *ListStorageNodesRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *GetTopicRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTopicRequest.DiscardUnknown(m)
+func (m *ListStorageNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListStorageNodesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTopicRequest proto.InternalMessageInfo
-
-func (m *GetTopicRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
-	if m != nil {
-		return m.TopicID
-	}
-	return 0
-}
+var xxx_messageInfo_ListStorageNodesRequest proto.InternalMessageInfo
 
-type GetTopicResponse struct {
-	Topic *varlogpb.TopicDescriptor `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic"`
+type ListStorageNodesResponse struct {
+	// StorageNodes registered to the cluster.
+	StorageNodes []StorageNodeMetadata `protobuf:"bytes,1,rep,name=storage_nodes,json=storageNodes,proto3" json:"storageNodes"`
 }
 
-func (m *GetTopicResponse) Reset()         { *m = GetTopicResponse{} }
-func (m *GetTopicResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTopicResponse) ProtoMessage()    {}
-func (*GetTopicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{10}
+func (m *ListStorageNodesResponse) Reset()         { *m = ListStorageNodesResponse{} }
+func (m *ListStorageNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListStorageNodesResponse) ProtoMessage()    {}
+func (*ListStorageNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{8}
 }
-func (m *GetTopicResponse) XXX_Unmarshal(b []byte) error {
+func (m *ListStorageNodesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListStorageNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTopicResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListStorageNodesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -505,41 +833,41 @@ func (m *GetTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *GetTopicResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTopicResponse.Merge(m, src)
+func (m *ListStorageNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListStorageNodesResponse.Merge(m, src)
 }
-func (m *GetTopicResponse) XXX_Size() int {
+func (m *ListStorageNodesResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *GetTopicResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTopicResponse.DiscardUnknown(m)
+func (m *ListStorageNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListStorageNodesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTopicResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListStorageNodesResponse proto.InternalMessageInfo
 
-func (m *GetTopicResponse) GetTopic() *varlogpb.TopicDescriptor {
+func (m *ListStorageNodesResponse) GetStorageNodes() []StorageNodeMetadata {
 	if m != nil {
-		return m.Topic
+		return m.StorageNodes
 	}
 	return nil
 }
 
-type DescribeTopicRequest struct {
-	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+type AddStorageNodeRequest struct {
+	StorageNode varlogpb.StorageNode `protobuf:"bytes,1,opt,name=storage_node,json=storageNode,proto3" json:"storage_node"`
 }
 
-func (m *DescribeTopicRequest) Reset()         { *m = DescribeTopicRequest{} }
-func (m *DescribeTopicRequest) String() string { return proto.CompactTextString(m) }
-func (*DescribeTopicRequest) ProtoMessage()    {}
-func (*DescribeTopicRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{11}
+func (m *AddStorageNodeRequest) Reset()         { *m = AddStorageNodeRequest{} }
+func (m *AddStorageNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*AddStorageNodeRequest) ProtoMessage()    {}
+func (*AddStorageNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{9}
 }
-func (m *DescribeTopicRequest) XXX_Unmarshal(b []byte) error {
+func (m *AddStorageNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DescribeTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AddStorageNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DescribeTopicRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AddStorageNodeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -549,42 +877,41 @@ func (m *DescribeTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *DescribeTopicRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeTopicRequest.Merge(m, src)
+func (m *AddStorageNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddStorageNodeRequest.Merge(m, src)
 }
-func (m *DescribeTopicRequest) XXX_Size() int {
+func (m *AddStorageNodeRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *DescribeTopicRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeTopicRequest.DiscardUnknown(m)
+func (m *AddStorageNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddStorageNodeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeTopicRequest proto.InternalMessageInfo
+var xxx_messageInfo_AddStorageNodeRequest proto.InternalMessageInfo
 
-func (m *DescribeTopicRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+func (m *AddStorageNodeRequest) GetStorageNode() varlogpb.StorageNode {
 	if m != nil {
-		return m.TopicID
+		return m.StorageNode
 	}
-	return 0
+	return varlogpb.StorageNode{}
 }
 
-type DescribeTopicResponse struct {
-	Topic      varlogpb.TopicDescriptor       `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic"`
-	LogStreams []varlogpb.LogStreamDescriptor `protobuf:"bytes,2,rep,name=log_streams,json=logStreams,proto3" json:"log_streams"`
+type AddStorageNodeResponse struct {
+	StorageNode *StorageNodeMetadata `protobuf:"bytes,1,opt,name=storage_node,json=storageNode,proto3" json:"storageNode"`
 }
 
-func (m *DescribeTopicResponse) Reset()         { *m = DescribeTopicResponse{} }
-func (m *DescribeTopicResponse) String() string { return proto.CompactTextString(m) }
-func (*DescribeTopicResponse) ProtoMessage()    {}
-func (*DescribeTopicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{12}
+func (m *AddStorageNodeResponse) Reset()         { *m = AddStorageNodeResponse{} }
+func (m *AddStorageNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*AddStorageNodeResponse) ProtoMessage()    {}
+func (*AddStorageNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{10}
 }
-func (m *DescribeTopicResponse) XXX_Unmarshal(b []byte) error {
+func (m *AddStorageNodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DescribeTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AddStorageNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DescribeTopicResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AddStorageNodeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -594,47 +921,41 @@ func (m *DescribeTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *DescribeTopicResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeTopicResponse.Merge(m, src)
+func (m *AddStorageNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddStorageNodeResponse.Merge(m, src)
 }
-func (m *DescribeTopicResponse) XXX_Size() int {
+func (m *AddStorageNodeResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *DescribeTopicResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeTopicResponse.DiscardUnknown(m)
+func (m *AddStorageNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddStorageNodeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeTopicResponse proto.InternalMessageInfo
-
-func (m *DescribeTopicResponse) GetTopic() varlogpb.TopicDescriptor {
-	if m != nil {
-		return m.Topic
-	}
-	return varlogpb.TopicDescriptor{}
-}
+var xxx_messageInfo_AddStorageNodeResponse proto.InternalMessageInfo
 
-func (m *DescribeTopicResponse) GetLogStreams() []varlogpb.LogStreamDescriptor {
+func (m *AddStorageNodeResponse) GetStorageNode() *StorageNodeMetadata {
 	if m != nil {
-		return m.LogStreams
+		return m.StorageNode
 	}
 	return nil
 }
 
-type ListTopicsRequest struct {
+type UnregisterStorageNodeRequest struct {
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,1,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
 }
 
-func (m *ListTopicsRequest) Reset()         { *m = ListTopicsRequest{} }
-func (m *ListTopicsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListTopicsRequest) ProtoMessage()    {}
-func (*ListTopicsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{13}
+func (m *UnregisterStorageNodeRequest) Reset()         { *m = UnregisterStorageNodeRequest{} }
+func (m *UnregisterStorageNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*UnregisterStorageNodeRequest) ProtoMessage()    {}
+func (*UnregisterStorageNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{11}
 }
-func (m *ListTopicsRequest) XXX_Unmarshal(b []byte) error {
+func (m *UnregisterStorageNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListTopicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnregisterStorageNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListTopicsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnregisterStorageNodeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -644,34 +965,40 @@ func (m *ListTopicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *ListTopicsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListTopicsRequest.Merge(m, src)
+func (m *UnregisterStorageNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnregisterStorageNodeRequest.Merge(m, src)
 }
-func (m *ListTopicsRequest) XXX_Size() int {
+func (m *UnregisterStorageNodeRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *ListTopicsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListTopicsRequest.DiscardUnknown(m)
+func (m *UnregisterStorageNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnregisterStorageNodeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListTopicsRequest proto.InternalMessageInfo
+var xxx_messageInfo_UnregisterStorageNodeRequest proto.InternalMessageInfo
 
-type ListTopicsResponse struct {
-	Topics []varlogpb.TopicDescriptor `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics"`
+func (m *UnregisterStorageNodeRequest) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.StorageNodeID
+	}
+	return 0
 }
 
-func (m *ListTopicsResponse) Reset()         { *m = ListTopicsResponse{} }
-func (m *ListTopicsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListTopicsResponse) ProtoMessage()    {}
-func (*ListTopicsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{14}
+type UnregisterStorageNodeResponse struct {
 }
-func (m *ListTopicsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *UnregisterStorageNodeResponse) Reset()         { *m = UnregisterStorageNodeResponse{} }
+func (m *UnregisterStorageNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*UnregisterStorageNodeResponse) ProtoMessage()    {}
+func (*UnregisterStorageNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{12}
+}
+func (m *UnregisterStorageNodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListTopicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnregisterStorageNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListTopicsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnregisterStorageNodeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -681,41 +1008,34 @@ func (m *ListTopicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ListTopicsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListTopicsResponse.Merge(m, src)
+func (m *UnregisterStorageNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnregisterStorageNodeResponse.Merge(m, src)
 }
-func (m *ListTopicsResponse) XXX_Size() int {
+func (m *UnregisterStorageNodeResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *ListTopicsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListTopicsResponse.DiscardUnknown(m)
+func (m *UnregisterStorageNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnregisterStorageNodeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListTopicsResponse proto.InternalMessageInfo
-
-func (m *ListTopicsResponse) GetTopics() []varlogpb.TopicDescriptor {
-	if m != nil {
-		return m.Topics
-	}
-	return nil
-}
+var xxx_messageInfo_UnregisterStorageNodeResponse proto.InternalMessageInfo
 
-// AddTopicRequest represents a request to add a topic to the cluster.
-type AddTopicRequest struct {
+type DrainStorageNodeRequest struct {
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,1,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
 }
 
-func (m *AddTopicRequest) Reset()         { *m = AddTopicRequest{} }
-func (m *AddTopicRequest) String() string { return proto.CompactTextString(m) }
-func (*AddTopicRequest) ProtoMessage()    {}
-func (*AddTopicRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{15}
+func (m *DrainStorageNodeRequest) Reset()         { *m = DrainStorageNodeRequest{} }
+func (m *DrainStorageNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*DrainStorageNodeRequest) ProtoMessage()    {}
+func (*DrainStorageNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{13}
 }
-func (m *AddTopicRequest) XXX_Unmarshal(b []byte) error {
+func (m *DrainStorageNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DrainStorageNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddTopicRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DrainStorageNodeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -725,35 +1045,41 @@ func (m *AddTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *AddTopicRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddTopicRequest.Merge(m, src)
+func (m *DrainStorageNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainStorageNodeRequest.Merge(m, src)
 }
-func (m *AddTopicRequest) XXX_Size() int {
+func (m *DrainStorageNodeRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *AddTopicRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddTopicRequest.DiscardUnknown(m)
+func (m *DrainStorageNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainStorageNodeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddTopicRequest proto.InternalMessageInfo
+var xxx_messageInfo_DrainStorageNodeRequest proto.InternalMessageInfo
 
-// AddTopicResponse represents a response of AddTopicRequest.
-type AddTopicResponse struct {
-	Topic *varlogpb.TopicDescriptor `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic"`
+func (m *DrainStorageNodeRequest) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.StorageNodeID
+	}
+	return 0
 }
 
-func (m *AddTopicResponse) Reset()         { *m = AddTopicResponse{} }
-func (m *AddTopicResponse) String() string { return proto.CompactTextString(m) }
-func (*AddTopicResponse) ProtoMessage()    {}
-func (*AddTopicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{16}
+type DrainStorageNodeResponse struct {
+	StorageNode *StorageNodeMetadata `protobuf:"bytes,1,opt,name=storage_node,json=storageNode,proto3" json:"storageNode"`
 }
-func (m *AddTopicResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *DrainStorageNodeResponse) Reset()         { *m = DrainStorageNodeResponse{} }
+func (m *DrainStorageNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*DrainStorageNodeResponse) ProtoMessage()    {}
+func (*DrainStorageNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{14}
+}
+func (m *DrainStorageNodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DrainStorageNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddTopicResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DrainStorageNodeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -763,41 +1089,44 @@ func (m *AddTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *AddTopicResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddTopicResponse.Merge(m, src)
+func (m *DrainStorageNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainStorageNodeResponse.Merge(m, src)
 }
-func (m *AddTopicResponse) XXX_Size() int {
+func (m *DrainStorageNodeResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *AddTopicResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddTopicResponse.DiscardUnknown(m)
+func (m *DrainStorageNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainStorageNodeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddTopicResponse proto.InternalMessageInfo
+var xxx_messageInfo_DrainStorageNodeResponse proto.InternalMessageInfo
 
-func (m *AddTopicResponse) GetTopic() *varlogpb.TopicDescriptor {
+func (m *DrainStorageNodeResponse) GetStorageNode() *StorageNodeMetadata {
 	if m != nil {
-		return m.Topic
+		return m.StorageNode
 	}
 	return nil
 }
 
-type UnregisterTopicRequest struct {
-	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+type SetStorageNodeSyncBandwidthRequest struct {
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,1,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
+	// bytes_per_second is the new limit of bandwidth for synchronization. Zero
+	// means unlimited.
+	BytesPerSecond int64 `protobuf:"varint,2,opt,name=bytes_per_second,json=bytesPerSecond,proto3" json:"bytes_per_second,omitempty"`
 }
 
-func (m *UnregisterTopicRequest) Reset()         { *m = UnregisterTopicRequest{} }
-func (m *UnregisterTopicRequest) String() string { return proto.CompactTextString(m) }
-func (*UnregisterTopicRequest) ProtoMessage()    {}
-func (*UnregisterTopicRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{17}
+func (m *SetStorageNodeSyncBandwidthRequest) Reset()         { *m = SetStorageNodeSyncBandwidthRequest{} }
+func (m *SetStorageNodeSyncBandwidthRequest) String() string { return proto.CompactTextString(m) }
+func (*SetStorageNodeSyncBandwidthRequest) ProtoMessage()    {}
+func (*SetStorageNodeSyncBandwidthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{15}
 }
-func (m *UnregisterTopicRequest) XXX_Unmarshal(b []byte) error {
+func (m *SetStorageNodeSyncBandwidthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnregisterTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetStorageNodeSyncBandwidthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnregisterTopicRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetStorageNodeSyncBandwidthRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -807,40 +1136,51 @@ func (m *UnregisterTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *UnregisterTopicRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnregisterTopicRequest.Merge(m, src)
+func (m *SetStorageNodeSyncBandwidthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetStorageNodeSyncBandwidthRequest.Merge(m, src)
 }
-func (m *UnregisterTopicRequest) XXX_Size() int {
+func (m *SetStorageNodeSyncBandwidthRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *UnregisterTopicRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnregisterTopicRequest.DiscardUnknown(m)
+func (m *SetStorageNodeSyncBandwidthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetStorageNodeSyncBandwidthRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnregisterTopicRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetStorageNodeSyncBandwidthRequest proto.InternalMessageInfo
 
-func (m *UnregisterTopicRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+func (m *SetStorageNodeSyncBandwidthRequest) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
 	if m != nil {
-		return m.TopicID
+		return m.StorageNodeID
 	}
 	return 0
 }
 
-type UnregisterTopicResponse struct {
+func (m *SetStorageNodeSyncBandwidthRequest) GetBytesPerSecond() int64 {
+	if m != nil {
+		return m.BytesPerSecond
+	}
+	return 0
 }
 
-func (m *UnregisterTopicResponse) Reset()         { *m = UnregisterTopicResponse{} }
-func (m *UnregisterTopicResponse) String() string { return proto.CompactTextString(m) }
-func (*UnregisterTopicResponse) ProtoMessage()    {}
-func (*UnregisterTopicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{18}
+type SetStorageNodeSyncBandwidthResponse struct {
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,1,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storageNodeId"`
+	// prev_bytes_per_second is the limit before the change.
+	PrevBytesPerSecond int64 `protobuf:"varint,2,opt,name=prev_bytes_per_second,json=prevBytesPerSecond,proto3" json:"prevBytesPerSecond"`
+	BytesPerSecond     int64 `protobuf:"varint,3,opt,name=bytes_per_second,json=bytesPerSecond,proto3" json:"bytesPerSecond"`
 }
-func (m *UnregisterTopicResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *SetStorageNodeSyncBandwidthResponse) Reset()         { *m = SetStorageNodeSyncBandwidthResponse{} }
+func (m *SetStorageNodeSyncBandwidthResponse) String() string { return proto.CompactTextString(m) }
+func (*SetStorageNodeSyncBandwidthResponse) ProtoMessage()    {}
+func (*SetStorageNodeSyncBandwidthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{16}
+}
+func (m *SetStorageNodeSyncBandwidthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnregisterTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetStorageNodeSyncBandwidthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnregisterTopicResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetStorageNodeSyncBandwidthResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -850,35 +1190,61 @@ func (m *UnregisterTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *UnregisterTopicResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnregisterTopicResponse.Merge(m, src)
+func (m *SetStorageNodeSyncBandwidthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetStorageNodeSyncBandwidthResponse.Merge(m, src)
 }
-func (m *UnregisterTopicResponse) XXX_Size() int {
+func (m *SetStorageNodeSyncBandwidthResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *UnregisterTopicResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnregisterTopicResponse.DiscardUnknown(m)
+func (m *SetStorageNodeSyncBandwidthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetStorageNodeSyncBandwidthResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnregisterTopicResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetStorageNodeSyncBandwidthResponse proto.InternalMessageInfo
 
-type GetLogStreamRequest struct {
-	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
-	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+func (m *SetStorageNodeSyncBandwidthResponse) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.StorageNodeID
+	}
+	return 0
 }
 
-func (m *GetLogStreamRequest) Reset()         { *m = GetLogStreamRequest{} }
-func (m *GetLogStreamRequest) String() string { return proto.CompactTextString(m) }
-func (*GetLogStreamRequest) ProtoMessage()    {}
-func (*GetLogStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{19}
+func (m *SetStorageNodeSyncBandwidthResponse) GetPrevBytesPerSecond() int64 {
+	if m != nil {
+		return m.PrevBytesPerSecond
+	}
+	return 0
 }
-func (m *GetLogStreamRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *SetStorageNodeSyncBandwidthResponse) GetBytesPerSecond() int64 {
+	if m != nil {
+		return m.BytesPerSecond
+	}
+	return 0
+}
+
+type SetStorageNodeAppendQuotaRequest struct {
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,1,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
+	TopicID       github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	// log_stream_id is the log stream whose quota is changed. If it is zero,
+	// the quota of the topic is changed.
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,3,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	// quota is the new quota. Zero means unlimited.
+	Quota snpb.AppendQuota `protobuf:"bytes,4,opt,name=quota,proto3" json:"quota"`
+}
+
+func (m *SetStorageNodeAppendQuotaRequest) Reset()         { *m = SetStorageNodeAppendQuotaRequest{} }
+func (m *SetStorageNodeAppendQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*SetStorageNodeAppendQuotaRequest) ProtoMessage()    {}
+func (*SetStorageNodeAppendQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{17}
+}
+func (m *SetStorageNodeAppendQuotaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetLogStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetStorageNodeAppendQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetLogStreamRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetStorageNodeAppendQuotaRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -888,48 +1254,67 @@ func (m *GetLogStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *GetLogStreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetLogStreamRequest.Merge(m, src)
+func (m *SetStorageNodeAppendQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetStorageNodeAppendQuotaRequest.Merge(m, src)
 }
-func (m *GetLogStreamRequest) XXX_Size() int {
+func (m *SetStorageNodeAppendQuotaRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *GetLogStreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetLogStreamRequest.DiscardUnknown(m)
+func (m *SetStorageNodeAppendQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetStorageNodeAppendQuotaRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetLogStreamRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetStorageNodeAppendQuotaRequest proto.InternalMessageInfo
 
-func (m *GetLogStreamRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+func (m *SetStorageNodeAppendQuotaRequest) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.StorageNodeID
+	}
+	return 0
+}
+
+func (m *SetStorageNodeAppendQuotaRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
 	if m != nil {
 		return m.TopicID
 	}
 	return 0
 }
 
-func (m *GetLogStreamRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+func (m *SetStorageNodeAppendQuotaRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
 	if m != nil {
 		return m.LogStreamID
 	}
 	return 0
 }
 
-type GetLogStreamResponse struct {
-	LogStream *varlogpb.LogStreamDescriptor `protobuf:"bytes,1,opt,name=log_stream,json=logStream,proto3" json:"logStream"`
+func (m *SetStorageNodeAppendQuotaRequest) GetQuota() snpb.AppendQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return snpb.AppendQuota{}
 }
 
-func (m *GetLogStreamResponse) Reset()         { *m = GetLogStreamResponse{} }
-func (m *GetLogStreamResponse) String() string { return proto.CompactTextString(m) }
-func (*GetLogStreamResponse) ProtoMessage()    {}
-func (*GetLogStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{20}
+type SetStorageNodeAppendQuotaResponse struct {
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,1,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storageNodeId"`
+	TopicID       github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topicId"`
+	LogStreamID   github_com_kakao_varlog_pkg_types.LogStreamID   `protobuf:"varint,3,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"logStreamId,omitempty"`
+	// prev_quota is the quota before the change.
+	PrevQuota snpb.AppendQuota `protobuf:"bytes,4,opt,name=prev_quota,json=prevQuota,proto3" json:"prevQuota"`
+	Quota     snpb.AppendQuota `protobuf:"bytes,5,opt,name=quota,proto3" json:"quota"`
 }
-func (m *GetLogStreamResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *SetStorageNodeAppendQuotaResponse) Reset()         { *m = SetStorageNodeAppendQuotaResponse{} }
+func (m *SetStorageNodeAppendQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*SetStorageNodeAppendQuotaResponse) ProtoMessage()    {}
+func (*SetStorageNodeAppendQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{18}
+}
+func (m *SetStorageNodeAppendQuotaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetLogStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetStorageNodeAppendQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetLogStreamResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetStorageNodeAppendQuotaResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -939,41 +1324,69 @@ func (m *GetLogStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *GetLogStreamResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetLogStreamResponse.Merge(m, src)
+func (m *SetStorageNodeAppendQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetStorageNodeAppendQuotaResponse.Merge(m, src)
 }
-func (m *GetLogStreamResponse) XXX_Size() int {
+func (m *SetStorageNodeAppendQuotaResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *GetLogStreamResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetLogStreamResponse.DiscardUnknown(m)
+func (m *SetStorageNodeAppendQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetStorageNodeAppendQuotaResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetLogStreamResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetStorageNodeAppendQuotaResponse proto.InternalMessageInfo
 
-func (m *GetLogStreamResponse) GetLogStream() *varlogpb.LogStreamDescriptor {
+func (m *SetStorageNodeAppendQuotaResponse) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
 	if m != nil {
-		return m.LogStream
+		return m.StorageNodeID
 	}
-	return nil
+	return 0
 }
 
-type ListLogStreamsRequest struct {
+func (m *SetStorageNodeAppendQuotaResponse) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+func (m *SetStorageNodeAppendQuotaResponse) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
+}
+
+func (m *SetStorageNodeAppendQuotaResponse) GetPrevQuota() snpb.AppendQuota {
+	if m != nil {
+		return m.PrevQuota
+	}
+	return snpb.AppendQuota{}
+}
+
+func (m *SetStorageNodeAppendQuotaResponse) GetQuota() snpb.AppendQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return snpb.AppendQuota{}
+}
+
+type GetTopicRequest struct {
 	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 }
 
-func (m *ListLogStreamsRequest) Reset()         { *m = ListLogStreamsRequest{} }
-func (m *ListLogStreamsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListLogStreamsRequest) ProtoMessage()    {}
-func (*ListLogStreamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{21}
+func (m *GetTopicRequest) Reset()         { *m = GetTopicRequest{} }
+func (m *GetTopicRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTopicRequest) ProtoMessage()    {}
+func (*GetTopicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{19}
 }
-func (m *ListLogStreamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetTopicRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListLogStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListLogStreamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetTopicRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -983,41 +1396,41 @@ func (m *ListLogStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *ListLogStreamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListLogStreamsRequest.Merge(m, src)
+func (m *GetTopicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTopicRequest.Merge(m, src)
 }
-func (m *ListLogStreamsRequest) XXX_Size() int {
+func (m *GetTopicRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *ListLogStreamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListLogStreamsRequest.DiscardUnknown(m)
+func (m *GetTopicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTopicRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListLogStreamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetTopicRequest proto.InternalMessageInfo
 
-func (m *ListLogStreamsRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+func (m *GetTopicRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
 	if m != nil {
 		return m.TopicID
 	}
 	return 0
 }
 
-type ListLogStreamsResponse struct {
-	LogStreams []varlogpb.LogStreamDescriptor `protobuf:"bytes,1,rep,name=log_streams,json=logStreams,proto3" json:"logStreams"`
+type GetTopicResponse struct {
+	Topic *varlogpb.TopicDescriptor `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic"`
 }
 
-func (m *ListLogStreamsResponse) Reset()         { *m = ListLogStreamsResponse{} }
-func (m *ListLogStreamsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListLogStreamsResponse) ProtoMessage()    {}
-func (*ListLogStreamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{22}
+func (m *GetTopicResponse) Reset()         { *m = GetTopicResponse{} }
+func (m *GetTopicResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTopicResponse) ProtoMessage()    {}
+func (*GetTopicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{20}
 }
-func (m *ListLogStreamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetTopicResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListLogStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListLogStreamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetTopicResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1027,43 +1440,41 @@ func (m *ListLogStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *ListLogStreamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListLogStreamsResponse.Merge(m, src)
+func (m *GetTopicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTopicResponse.Merge(m, src)
 }
-func (m *ListLogStreamsResponse) XXX_Size() int {
+func (m *GetTopicResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *ListLogStreamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListLogStreamsResponse.DiscardUnknown(m)
+func (m *GetTopicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTopicResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListLogStreamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetTopicResponse proto.InternalMessageInfo
 
-func (m *ListLogStreamsResponse) GetLogStreams() []varlogpb.LogStreamDescriptor {
+func (m *GetTopicResponse) GetTopic() *varlogpb.TopicDescriptor {
 	if m != nil {
-		return m.LogStreams
+		return m.Topic
 	}
 	return nil
 }
 
-type AddLogStreamRequest struct {
+type DescribeTopicRequest struct {
 	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
-	// TODO: nullable = false
-	Replicas []*varlogpb.ReplicaDescriptor `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
 }
 
-func (m *AddLogStreamRequest) Reset()         { *m = AddLogStreamRequest{} }
-func (m *AddLogStreamRequest) String() string { return proto.CompactTextString(m) }
-func (*AddLogStreamRequest) ProtoMessage()    {}
-func (*AddLogStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{23}
+func (m *DescribeTopicRequest) Reset()         { *m = DescribeTopicRequest{} }
+func (m *DescribeTopicRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeTopicRequest) ProtoMessage()    {}
+func (*DescribeTopicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{21}
 }
-func (m *AddLogStreamRequest) XXX_Unmarshal(b []byte) error {
+func (m *DescribeTopicRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddLogStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DescribeTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddLogStreamRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DescribeTopicRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1073,48 +1484,42 @@ func (m *AddLogStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *AddLogStreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddLogStreamRequest.Merge(m, src)
+func (m *DescribeTopicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeTopicRequest.Merge(m, src)
 }
-func (m *AddLogStreamRequest) XXX_Size() int {
+func (m *DescribeTopicRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *AddLogStreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddLogStreamRequest.DiscardUnknown(m)
+func (m *DescribeTopicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeTopicRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddLogStreamRequest proto.InternalMessageInfo
+var xxx_messageInfo_DescribeTopicRequest proto.InternalMessageInfo
 
-func (m *AddLogStreamRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+func (m *DescribeTopicRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
 	if m != nil {
 		return m.TopicID
 	}
 	return 0
 }
 
-func (m *AddLogStreamRequest) GetReplicas() []*varlogpb.ReplicaDescriptor {
-	if m != nil {
-		return m.Replicas
-	}
-	return nil
-}
-
-type AddLogStreamResponse struct {
-	LogStream *varlogpb.LogStreamDescriptor `protobuf:"bytes,1,opt,name=log_stream,json=logStream,proto3" json:"log_stream,omitempty"`
+type DescribeTopicResponse struct {
+	Topic      varlogpb.TopicDescriptor       `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic"`
+	LogStreams []varlogpb.LogStreamDescriptor `protobuf:"bytes,2,rep,name=log_streams,json=logStreams,proto3" json:"log_streams"`
 }
 
-func (m *AddLogStreamResponse) Reset()         { *m = AddLogStreamResponse{} }
-func (m *AddLogStreamResponse) String() string { return proto.CompactTextString(m) }
-func (*AddLogStreamResponse) ProtoMessage()    {}
-func (*AddLogStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{24}
+func (m *DescribeTopicResponse) Reset()         { *m = DescribeTopicResponse{} }
+func (m *DescribeTopicResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeTopicResponse) ProtoMessage()    {}
+func (*DescribeTopicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{22}
 }
-func (m *AddLogStreamResponse) XXX_Unmarshal(b []byte) error {
+func (m *DescribeTopicResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddLogStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DescribeTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddLogStreamResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DescribeTopicResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1124,52 +1529,47 @@ func (m *AddLogStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *AddLogStreamResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddLogStreamResponse.Merge(m, src)
+func (m *DescribeTopicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeTopicResponse.Merge(m, src)
 }
-func (m *AddLogStreamResponse) XXX_Size() int {
+func (m *DescribeTopicResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *AddLogStreamResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddLogStreamResponse.DiscardUnknown(m)
+func (m *DescribeTopicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeTopicResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddLogStreamResponse proto.InternalMessageInfo
+var xxx_messageInfo_DescribeTopicResponse proto.InternalMessageInfo
 
-func (m *AddLogStreamResponse) GetLogStream() *varlogpb.LogStreamDescriptor {
+func (m *DescribeTopicResponse) GetTopic() varlogpb.TopicDescriptor {
 	if m != nil {
-		return m.LogStream
+		return m.Topic
+	}
+	return varlogpb.TopicDescriptor{}
+}
+
+func (m *DescribeTopicResponse) GetLogStreams() []varlogpb.LogStreamDescriptor {
+	if m != nil {
+		return m.LogStreams
 	}
 	return nil
 }
 
-type UpdateLogStreamRequest struct {
-	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
-	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
-	//
-	//// NOTE: popped_replica need not be varlog.ReplicaDescriptor, but it is
-	//// natural. Though it is awkward, popped_storage_node_id is used here.
-	//uint32 popped_storage_node_id = 2 [
-	//(gogoproto.casttype) =
-	//"github.com/kakao/varlog/pkg/types.StorageNodeID",
-	//(gogoproto.customname) = "PoppedStorageNodeID"
-	//];
-	PoppedReplica varlogpb.ReplicaDescriptor `protobuf:"bytes,3,opt,name=popped_replica,json=poppedReplica,proto3" json:"popped_replica"`
-	PushedReplica varlogpb.ReplicaDescriptor `protobuf:"bytes,4,opt,name=pushed_replica,json=pushedReplica,proto3" json:"pushed_replica"`
+type ListTopicsRequest struct {
 }
 
-func (m *UpdateLogStreamRequest) Reset()         { *m = UpdateLogStreamRequest{} }
-func (m *UpdateLogStreamRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateLogStreamRequest) ProtoMessage()    {}
-func (*UpdateLogStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{25}
+func (m *ListTopicsRequest) Reset()         { *m = ListTopicsRequest{} }
+func (m *ListTopicsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTopicsRequest) ProtoMessage()    {}
+func (*ListTopicsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{23}
 }
-func (m *UpdateLogStreamRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListTopicsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateLogStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListTopicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateLogStreamRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListTopicsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1179,107 +1579,81 @@ func (m *UpdateLogStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *UpdateLogStreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateLogStreamRequest.Merge(m, src)
+func (m *ListTopicsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTopicsRequest.Merge(m, src)
 }
-func (m *UpdateLogStreamRequest) XXX_Size() int {
+func (m *ListTopicsRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *UpdateLogStreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateLogStreamRequest.DiscardUnknown(m)
+func (m *ListTopicsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTopicsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateLogStreamRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListTopicsRequest proto.InternalMessageInfo
 
-func (m *UpdateLogStreamRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
-	if m != nil {
-		return m.TopicID
-	}
-	return 0
+type ListTopicsResponse struct {
+	Topics []varlogpb.TopicDescriptor `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics"`
 }
 
-func (m *UpdateLogStreamRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
-	if m != nil {
-		return m.LogStreamID
-	}
-	return 0
+func (m *ListTopicsResponse) Reset()         { *m = ListTopicsResponse{} }
+func (m *ListTopicsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTopicsResponse) ProtoMessage()    {}
+func (*ListTopicsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{24}
 }
-
-func (m *UpdateLogStreamRequest) GetPoppedReplica() varlogpb.ReplicaDescriptor {
-	if m != nil {
-		return m.PoppedReplica
+func (m *ListTopicsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListTopicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListTopicsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return varlogpb.ReplicaDescriptor{}
 }
-
-func (m *UpdateLogStreamRequest) GetPushedReplica() varlogpb.ReplicaDescriptor {
-	if m != nil {
-		return m.PushedReplica
-	}
-	return varlogpb.ReplicaDescriptor{}
-}
-
-type UpdateLogStreamResponse struct {
-	LogStream *varlogpb.LogStreamDescriptor `protobuf:"bytes,1,opt,name=log_stream,json=logStream,proto3" json:"log_stream,omitempty"`
-}
-
-func (m *UpdateLogStreamResponse) Reset()         { *m = UpdateLogStreamResponse{} }
-func (m *UpdateLogStreamResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdateLogStreamResponse) ProtoMessage()    {}
-func (*UpdateLogStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{26}
-}
-func (m *UpdateLogStreamResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *UpdateLogStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_UpdateLogStreamResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *UpdateLogStreamResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateLogStreamResponse.Merge(m, src)
+func (m *ListTopicsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTopicsResponse.Merge(m, src)
 }
-func (m *UpdateLogStreamResponse) XXX_Size() int {
+func (m *ListTopicsResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *UpdateLogStreamResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateLogStreamResponse.DiscardUnknown(m)
+func (m *ListTopicsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTopicsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateLogStreamResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListTopicsResponse proto.InternalMessageInfo
 
-func (m *UpdateLogStreamResponse) GetLogStream() *varlogpb.LogStreamDescriptor {
+func (m *ListTopicsResponse) GetTopics() []varlogpb.TopicDescriptor {
 	if m != nil {
-		return m.LogStream
+		return m.Topics
 	}
 	return nil
 }
 
-type UnregisterLogStreamRequest struct {
-	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
-	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+// AddTopicRequest represents a request to add a topic to the cluster.
+type AddTopicRequest struct {
+	// compacted makes the new topic compacted. See
+	// varlogpb.TopicDescriptor.compacted.
+	Compacted bool `protobuf:"varint,1,opt,name=compacted,proto3" json:"compacted,omitempty"`
 }
 
-func (m *UnregisterLogStreamRequest) Reset()         { *m = UnregisterLogStreamRequest{} }
-func (m *UnregisterLogStreamRequest) String() string { return proto.CompactTextString(m) }
-func (*UnregisterLogStreamRequest) ProtoMessage()    {}
-func (*UnregisterLogStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{27}
+func (m *AddTopicRequest) Reset()         { *m = AddTopicRequest{} }
+func (m *AddTopicRequest) String() string { return proto.CompactTextString(m) }
+func (*AddTopicRequest) ProtoMessage()    {}
+func (*AddTopicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{25}
 }
-func (m *UnregisterLogStreamRequest) XXX_Unmarshal(b []byte) error {
+func (m *AddTopicRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnregisterLogStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AddTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnregisterLogStreamRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AddTopicRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1289,47 +1663,42 @@ func (m *UnregisterLogStreamRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *UnregisterLogStreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnregisterLogStreamRequest.Merge(m, src)
+func (m *AddTopicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddTopicRequest.Merge(m, src)
 }
-func (m *UnregisterLogStreamRequest) XXX_Size() int {
+func (m *AddTopicRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *UnregisterLogStreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnregisterLogStreamRequest.DiscardUnknown(m)
+func (m *AddTopicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddTopicRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnregisterLogStreamRequest proto.InternalMessageInfo
-
-func (m *UnregisterLogStreamRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
-	if m != nil {
-		return m.TopicID
-	}
-	return 0
-}
+var xxx_messageInfo_AddTopicRequest proto.InternalMessageInfo
 
-func (m *UnregisterLogStreamRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+func (m *AddTopicRequest) GetCompacted() bool {
 	if m != nil {
-		return m.LogStreamID
+		return m.Compacted
 	}
-	return 0
+	return false
 }
 
-type UnregisterLogStreamResponse struct {
+// AddTopicResponse represents a response of AddTopicRequest.
+type AddTopicResponse struct {
+	Topic *varlogpb.TopicDescriptor `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic"`
 }
 
-func (m *UnregisterLogStreamResponse) Reset()         { *m = UnregisterLogStreamResponse{} }
-func (m *UnregisterLogStreamResponse) String() string { return proto.CompactTextString(m) }
-func (*UnregisterLogStreamResponse) ProtoMessage()    {}
-func (*UnregisterLogStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{28}
+func (m *AddTopicResponse) Reset()         { *m = AddTopicResponse{} }
+func (m *AddTopicResponse) String() string { return proto.CompactTextString(m) }
+func (*AddTopicResponse) ProtoMessage()    {}
+func (*AddTopicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{26}
 }
-func (m *UnregisterLogStreamResponse) XXX_Unmarshal(b []byte) error {
+func (m *AddTopicResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnregisterLogStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AddTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnregisterLogStreamResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AddTopicResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1339,36 +1708,41 @@ func (m *UnregisterLogStreamResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *UnregisterLogStreamResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnregisterLogStreamResponse.Merge(m, src)
+func (m *AddTopicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddTopicResponse.Merge(m, src)
 }
-func (m *UnregisterLogStreamResponse) XXX_Size() int {
+func (m *AddTopicResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *UnregisterLogStreamResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnregisterLogStreamResponse.DiscardUnknown(m)
+func (m *AddTopicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddTopicResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnregisterLogStreamResponse proto.InternalMessageInfo
+var xxx_messageInfo_AddTopicResponse proto.InternalMessageInfo
 
-type RemoveLogStreamReplicaRequest struct {
-	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,1,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
-	TopicID       github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
-	LogStreamID   github_com_kakao_varlog_pkg_types.LogStreamID   `protobuf:"varint,3,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+func (m *AddTopicResponse) GetTopic() *varlogpb.TopicDescriptor {
+	if m != nil {
+		return m.Topic
+	}
+	return nil
 }
 
-func (m *RemoveLogStreamReplicaRequest) Reset()         { *m = RemoveLogStreamReplicaRequest{} }
-func (m *RemoveLogStreamReplicaRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveLogStreamReplicaRequest) ProtoMessage()    {}
-func (*RemoveLogStreamReplicaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{29}
+type UnregisterTopicRequest struct {
+	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 }
-func (m *RemoveLogStreamReplicaRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *UnregisterTopicRequest) Reset()         { *m = UnregisterTopicRequest{} }
+func (m *UnregisterTopicRequest) String() string { return proto.CompactTextString(m) }
+func (*UnregisterTopicRequest) ProtoMessage()    {}
+func (*UnregisterTopicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{27}
+}
+func (m *UnregisterTopicRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveLogStreamReplicaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnregisterTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveLogStreamReplicaRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnregisterTopicRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1378,54 +1752,40 @@ func (m *RemoveLogStreamReplicaRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *RemoveLogStreamReplicaRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveLogStreamReplicaRequest.Merge(m, src)
+func (m *UnregisterTopicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnregisterTopicRequest.Merge(m, src)
 }
-func (m *RemoveLogStreamReplicaRequest) XXX_Size() int {
+func (m *UnregisterTopicRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *RemoveLogStreamReplicaRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveLogStreamReplicaRequest.DiscardUnknown(m)
+func (m *UnregisterTopicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnregisterTopicRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveLogStreamReplicaRequest proto.InternalMessageInfo
-
-func (m *RemoveLogStreamReplicaRequest) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
-	if m != nil {
-		return m.StorageNodeID
-	}
-	return 0
-}
+var xxx_messageInfo_UnregisterTopicRequest proto.InternalMessageInfo
 
-func (m *RemoveLogStreamReplicaRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+func (m *UnregisterTopicRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
 	if m != nil {
 		return m.TopicID
 	}
 	return 0
 }
 
-func (m *RemoveLogStreamReplicaRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
-	if m != nil {
-		return m.LogStreamID
-	}
-	return 0
-}
-
-type RemoveLogStreamReplicaResponse struct {
+type UnregisterTopicResponse struct {
 }
 
-func (m *RemoveLogStreamReplicaResponse) Reset()         { *m = RemoveLogStreamReplicaResponse{} }
-func (m *RemoveLogStreamReplicaResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveLogStreamReplicaResponse) ProtoMessage()    {}
-func (*RemoveLogStreamReplicaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{30}
+func (m *UnregisterTopicResponse) Reset()         { *m = UnregisterTopicResponse{} }
+func (m *UnregisterTopicResponse) String() string { return proto.CompactTextString(m) }
+func (*UnregisterTopicResponse) ProtoMessage()    {}
+func (*UnregisterTopicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{28}
 }
-func (m *RemoveLogStreamReplicaResponse) XXX_Unmarshal(b []byte) error {
+func (m *UnregisterTopicResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveLogStreamReplicaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnregisterTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveLogStreamReplicaResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnregisterTopicResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1435,35 +1795,35 @@ func (m *RemoveLogStreamReplicaResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *RemoveLogStreamReplicaResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveLogStreamReplicaResponse.Merge(m, src)
+func (m *UnregisterTopicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnregisterTopicResponse.Merge(m, src)
 }
-func (m *RemoveLogStreamReplicaResponse) XXX_Size() int {
+func (m *UnregisterTopicResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *RemoveLogStreamReplicaResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveLogStreamReplicaResponse.DiscardUnknown(m)
+func (m *UnregisterTopicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnregisterTopicResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveLogStreamReplicaResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnregisterTopicResponse proto.InternalMessageInfo
 
-type SealRequest struct {
+type GetLogStreamRequest struct {
 	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
 }
 
-func (m *SealRequest) Reset()         { *m = SealRequest{} }
-func (m *SealRequest) String() string { return proto.CompactTextString(m) }
-func (*SealRequest) ProtoMessage()    {}
-func (*SealRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{31}
+func (m *GetLogStreamRequest) Reset()         { *m = GetLogStreamRequest{} }
+func (m *GetLogStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLogStreamRequest) ProtoMessage()    {}
+func (*GetLogStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{29}
 }
-func (m *SealRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetLogStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SealRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetLogStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SealRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetLogStreamRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1473,49 +1833,48 @@ func (m *SealRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *SealRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SealRequest.Merge(m, src)
+func (m *GetLogStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLogStreamRequest.Merge(m, src)
 }
-func (m *SealRequest) XXX_Size() int {
+func (m *GetLogStreamRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *SealRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SealRequest.DiscardUnknown(m)
+func (m *GetLogStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLogStreamRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SealRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetLogStreamRequest proto.InternalMessageInfo
 
-func (m *SealRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+func (m *GetLogStreamRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
 	if m != nil {
 		return m.TopicID
 	}
 	return 0
 }
 
-func (m *SealRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+func (m *GetLogStreamRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
 	if m != nil {
 		return m.LogStreamID
 	}
 	return 0
 }
 
-type SealResponse struct {
-	LogStreams []snpb.LogStreamReplicaMetadataDescriptor `protobuf:"bytes,1,rep,name=log_streams,json=logStreams,proto3" json:"logStreams"`
-	SealedGLSN github_com_kakao_varlog_pkg_types.GLSN    `protobuf:"varint,2,opt,name=sealed_glsn,json=sealedGlsn,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"sealedGLSN"`
+type GetLogStreamResponse struct {
+	LogStream *varlogpb.LogStreamDescriptor `protobuf:"bytes,1,opt,name=log_stream,json=logStream,proto3" json:"logStream"`
 }
 
-func (m *SealResponse) Reset()         { *m = SealResponse{} }
-func (m *SealResponse) String() string { return proto.CompactTextString(m) }
-func (*SealResponse) ProtoMessage()    {}
-func (*SealResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{32}
-}
-func (m *SealResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *SealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetLogStreamResponse) Reset()         { *m = GetLogStreamResponse{} }
+func (m *GetLogStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLogStreamResponse) ProtoMessage()    {}
+func (*GetLogStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{30}
+}
+func (m *GetLogStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetLogStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SealResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetLogStreamResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1525,49 +1884,41 @@ func (m *SealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *SealResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SealResponse.Merge(m, src)
+func (m *GetLogStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLogStreamResponse.Merge(m, src)
 }
-func (m *SealResponse) XXX_Size() int {
+func (m *GetLogStreamResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *SealResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SealResponse.DiscardUnknown(m)
+func (m *GetLogStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLogStreamResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SealResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetLogStreamResponse proto.InternalMessageInfo
 
-func (m *SealResponse) GetLogStreams() []snpb.LogStreamReplicaMetadataDescriptor {
+func (m *GetLogStreamResponse) GetLogStream() *varlogpb.LogStreamDescriptor {
 	if m != nil {
-		return m.LogStreams
+		return m.LogStream
 	}
 	return nil
 }
 
-func (m *SealResponse) GetSealedGLSN() github_com_kakao_varlog_pkg_types.GLSN {
-	if m != nil {
-		return m.SealedGLSN
-	}
-	return 0
-}
-
-type UnsealRequest struct {
-	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
-	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+type ListLogStreamsRequest struct {
+	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 }
 
-func (m *UnsealRequest) Reset()         { *m = UnsealRequest{} }
-func (m *UnsealRequest) String() string { return proto.CompactTextString(m) }
-func (*UnsealRequest) ProtoMessage()    {}
-func (*UnsealRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{33}
+func (m *ListLogStreamsRequest) Reset()         { *m = ListLogStreamsRequest{} }
+func (m *ListLogStreamsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListLogStreamsRequest) ProtoMessage()    {}
+func (*ListLogStreamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{31}
 }
-func (m *UnsealRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListLogStreamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnsealRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListLogStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnsealRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListLogStreamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1577,48 +1928,41 @@ func (m *UnsealRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *UnsealRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnsealRequest.Merge(m, src)
+func (m *ListLogStreamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLogStreamsRequest.Merge(m, src)
 }
-func (m *UnsealRequest) XXX_Size() int {
+func (m *ListLogStreamsRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *UnsealRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnsealRequest.DiscardUnknown(m)
+func (m *ListLogStreamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLogStreamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnsealRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListLogStreamsRequest proto.InternalMessageInfo
 
-func (m *UnsealRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+func (m *ListLogStreamsRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
 	if m != nil {
 		return m.TopicID
 	}
 	return 0
 }
 
-func (m *UnsealRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
-	if m != nil {
-		return m.LogStreamID
-	}
-	return 0
-}
-
-type UnsealResponse struct {
-	LogStream *varlogpb.LogStreamDescriptor `protobuf:"bytes,1,opt,name=log_stream,json=logStream,proto3" json:"logStream"`
+type ListLogStreamsResponse struct {
+	LogStreams []varlogpb.LogStreamDescriptor `protobuf:"bytes,1,rep,name=log_streams,json=logStreams,proto3" json:"logStreams"`
 }
 
-func (m *UnsealResponse) Reset()         { *m = UnsealResponse{} }
-func (m *UnsealResponse) String() string { return proto.CompactTextString(m) }
-func (*UnsealResponse) ProtoMessage()    {}
-func (*UnsealResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{34}
+func (m *ListLogStreamsResponse) Reset()         { *m = ListLogStreamsResponse{} }
+func (m *ListLogStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListLogStreamsResponse) ProtoMessage()    {}
+func (*ListLogStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{32}
 }
-func (m *UnsealResponse) XXX_Unmarshal(b []byte) error {
+func (m *ListLogStreamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnsealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListLogStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnsealResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListLogStreamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1628,44 +1972,43 @@ func (m *UnsealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *UnsealResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnsealResponse.Merge(m, src)
+func (m *ListLogStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLogStreamsResponse.Merge(m, src)
 }
-func (m *UnsealResponse) XXX_Size() int {
+func (m *ListLogStreamsResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *UnsealResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnsealResponse.DiscardUnknown(m)
+func (m *ListLogStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLogStreamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnsealResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListLogStreamsResponse proto.InternalMessageInfo
 
-func (m *UnsealResponse) GetLogStream() *varlogpb.LogStreamDescriptor {
+func (m *ListLogStreamsResponse) GetLogStreams() []varlogpb.LogStreamDescriptor {
 	if m != nil {
-		return m.LogStream
+		return m.LogStreams
 	}
 	return nil
 }
 
-type SyncRequest struct {
-	TopicID          github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
-	LogStreamID      github_com_kakao_varlog_pkg_types.LogStreamID   `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
-	SrcStorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,3,opt,name=src_storage_node_id,json=srcStorageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"src_storage_node_id,omitempty"`
-	DstStorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,4,opt,name=dst_storage_node_id,json=dstStorageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"dst_storage_node_id,omitempty"`
+type AddLogStreamRequest struct {
+	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	// TODO: nullable = false
+	Replicas []*varlogpb.ReplicaDescriptor `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
 }
 
-func (m *SyncRequest) Reset()         { *m = SyncRequest{} }
-func (m *SyncRequest) String() string { return proto.CompactTextString(m) }
-func (*SyncRequest) ProtoMessage()    {}
-func (*SyncRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{35}
+func (m *AddLogStreamRequest) Reset()         { *m = AddLogStreamRequest{} }
+func (m *AddLogStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*AddLogStreamRequest) ProtoMessage()    {}
+func (*AddLogStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{33}
 }
-func (m *SyncRequest) XXX_Unmarshal(b []byte) error {
+func (m *AddLogStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AddLogStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SyncRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AddLogStreamRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1675,62 +2018,48 @@ func (m *SyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *SyncRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SyncRequest.Merge(m, src)
+func (m *AddLogStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddLogStreamRequest.Merge(m, src)
 }
-func (m *SyncRequest) XXX_Size() int {
+func (m *AddLogStreamRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *SyncRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SyncRequest.DiscardUnknown(m)
+func (m *AddLogStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddLogStreamRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SyncRequest proto.InternalMessageInfo
+var xxx_messageInfo_AddLogStreamRequest proto.InternalMessageInfo
 
-func (m *SyncRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+func (m *AddLogStreamRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
 	if m != nil {
 		return m.TopicID
 	}
 	return 0
 }
 
-func (m *SyncRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
-	if m != nil {
-		return m.LogStreamID
-	}
-	return 0
-}
-
-func (m *SyncRequest) GetSrcStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
-	if m != nil {
-		return m.SrcStorageNodeID
-	}
-	return 0
-}
-
-func (m *SyncRequest) GetDstStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+func (m *AddLogStreamRequest) GetReplicas() []*varlogpb.ReplicaDescriptor {
 	if m != nil {
-		return m.DstStorageNodeID
+		return m.Replicas
 	}
-	return 0
+	return nil
 }
 
-type SyncResponse struct {
-	Status *snpb.SyncStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+type AddLogStreamResponse struct {
+	LogStream *varlogpb.LogStreamDescriptor `protobuf:"bytes,1,opt,name=log_stream,json=logStream,proto3" json:"log_stream,omitempty"`
 }
 
-func (m *SyncResponse) Reset()         { *m = SyncResponse{} }
-func (m *SyncResponse) String() string { return proto.CompactTextString(m) }
-func (*SyncResponse) ProtoMessage()    {}
-func (*SyncResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{36}
+func (m *AddLogStreamResponse) Reset()         { *m = AddLogStreamResponse{} }
+func (m *AddLogStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*AddLogStreamResponse) ProtoMessage()    {}
+func (*AddLogStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{34}
 }
-func (m *SyncResponse) XXX_Unmarshal(b []byte) error {
+func (m *AddLogStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AddLogStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SyncResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AddLogStreamResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1740,42 +2069,52 @@ func (m *SyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *SyncResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SyncResponse.Merge(m, src)
+func (m *AddLogStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddLogStreamResponse.Merge(m, src)
 }
-func (m *SyncResponse) XXX_Size() int {
+func (m *AddLogStreamResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *SyncResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SyncResponse.DiscardUnknown(m)
+func (m *AddLogStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddLogStreamResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SyncResponse proto.InternalMessageInfo
+var xxx_messageInfo_AddLogStreamResponse proto.InternalMessageInfo
 
-func (m *SyncResponse) GetStatus() *snpb.SyncStatus {
+func (m *AddLogStreamResponse) GetLogStream() *varlogpb.LogStreamDescriptor {
 	if m != nil {
-		return m.Status
+		return m.LogStream
 	}
 	return nil
 }
 
-type TrimRequest struct {
-	TopicID  github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topicId"`
-	LastGLSN github_com_kakao_varlog_pkg_types.GLSN    `protobuf:"varint,2,opt,name=last_glsn,json=lastGlsn,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"lastGLSN"`
+type UpdateLogStreamRequest struct {
+	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	//
+	//// NOTE: popped_replica need not be varlog.ReplicaDescriptor, but it is
+	//// natural. Though it is awkward, popped_storage_node_id is used here.
+	//uint32 popped_storage_node_id = 2 [
+	//(gogoproto.casttype) =
+	//"github.com/kakao/varlog/pkg/types.StorageNodeID",
+	//(gogoproto.customname) = "PoppedStorageNodeID"
+	//];
+	PoppedReplica varlogpb.ReplicaDescriptor `protobuf:"bytes,3,opt,name=popped_replica,json=poppedReplica,proto3" json:"popped_replica"`
+	PushedReplica varlogpb.ReplicaDescriptor `protobuf:"bytes,4,opt,name=pushed_replica,json=pushedReplica,proto3" json:"pushed_replica"`
 }
 
-func (m *TrimRequest) Reset()         { *m = TrimRequest{} }
-func (m *TrimRequest) String() string { return proto.CompactTextString(m) }
-func (*TrimRequest) ProtoMessage()    {}
-func (*TrimRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{37}
+func (m *UpdateLogStreamRequest) Reset()         { *m = UpdateLogStreamRequest{} }
+func (m *UpdateLogStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateLogStreamRequest) ProtoMessage()    {}
+func (*UpdateLogStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{35}
 }
-func (m *TrimRequest) XXX_Unmarshal(b []byte) error {
+func (m *UpdateLogStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TrimRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateLogStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TrimRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateLogStreamRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1785,50 +2124,68 @@ func (m *TrimRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *TrimRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TrimRequest.Merge(m, src)
+func (m *UpdateLogStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateLogStreamRequest.Merge(m, src)
 }
-func (m *TrimRequest) XXX_Size() int {
+func (m *UpdateLogStreamRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *TrimRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TrimRequest.DiscardUnknown(m)
+func (m *UpdateLogStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateLogStreamRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TrimRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateLogStreamRequest proto.InternalMessageInfo
 
-func (m *TrimRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+func (m *UpdateLogStreamRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
 	if m != nil {
 		return m.TopicID
 	}
 	return 0
 }
 
-func (m *TrimRequest) GetLastGLSN() github_com_kakao_varlog_pkg_types.GLSN {
+func (m *UpdateLogStreamRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
 	if m != nil {
-		return m.LastGLSN
+		return m.LogStreamID
 	}
 	return 0
 }
 
-type TrimResult struct {
-	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,1,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storageNodeId"`
-	LogStreamID   github_com_kakao_varlog_pkg_types.LogStreamID   `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"logStreamId"`
-	Error         string                                          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+func (m *UpdateLogStreamRequest) GetPoppedReplica() varlogpb.ReplicaDescriptor {
+	if m != nil {
+		return m.PoppedReplica
+	}
+	return varlogpb.ReplicaDescriptor{}
 }
 
-func (m *TrimResult) Reset()         { *m = TrimResult{} }
-func (m *TrimResult) String() string { return proto.CompactTextString(m) }
-func (*TrimResult) ProtoMessage()    {}
-func (*TrimResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{38}
+func (m *UpdateLogStreamRequest) GetPushedReplica() varlogpb.ReplicaDescriptor {
+	if m != nil {
+		return m.PushedReplica
+	}
+	return varlogpb.ReplicaDescriptor{}
 }
-func (m *TrimResult) XXX_Unmarshal(b []byte) error {
+
+type UpdateLogStreamResponse struct {
+	LogStream *varlogpb.LogStreamDescriptor `protobuf:"bytes,1,opt,name=log_stream,json=logStream,proto3" json:"log_stream,omitempty"`
+	// OperationID is the identifier of the operation issued by this call.
+	OperationID uint64 `protobuf:"varint,2,opt,name=operation_id,json=operationId,proto3" json:"operationId,omitempty"`
+	// OperationState is the state of the operation when this call returns. It
+	// is OPERATION_STATE_RUNNING if the operation could not finish before the
+	// deadline of the call; the operation keeps running in the background.
+	OperationState OperationState `protobuf:"varint,3,opt,name=operation_state,json=operationState,proto3,enum=varlog.vmspb.OperationState" json:"operationState,omitempty"`
+}
+
+func (m *UpdateLogStreamResponse) Reset()         { *m = UpdateLogStreamResponse{} }
+func (m *UpdateLogStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateLogStreamResponse) ProtoMessage()    {}
+func (*UpdateLogStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{36}
+}
+func (m *UpdateLogStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TrimResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateLogStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TrimResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateLogStreamResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1838,55 +2195,56 @@ func (m *TrimResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TrimResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TrimResult.Merge(m, src)
+func (m *UpdateLogStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateLogStreamResponse.Merge(m, src)
 }
-func (m *TrimResult) XXX_Size() int {
+func (m *UpdateLogStreamResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *TrimResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_TrimResult.DiscardUnknown(m)
+func (m *UpdateLogStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateLogStreamResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TrimResult proto.InternalMessageInfo
+var xxx_messageInfo_UpdateLogStreamResponse proto.InternalMessageInfo
 
-func (m *TrimResult) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+func (m *UpdateLogStreamResponse) GetLogStream() *varlogpb.LogStreamDescriptor {
 	if m != nil {
-		return m.StorageNodeID
+		return m.LogStream
 	}
-	return 0
+	return nil
 }
 
-func (m *TrimResult) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+func (m *UpdateLogStreamResponse) GetOperationID() uint64 {
 	if m != nil {
-		return m.LogStreamID
+		return m.OperationID
 	}
 	return 0
 }
 
-func (m *TrimResult) GetError() string {
+func (m *UpdateLogStreamResponse) GetOperationState() OperationState {
 	if m != nil {
-		return m.Error
+		return m.OperationState
 	}
-	return ""
+	return OperationStateUnknown
 }
 
-type TrimResponse struct {
-	Results []TrimResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+type UnregisterLogStreamRequest struct {
+	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
 }
 
-func (m *TrimResponse) Reset()         { *m = TrimResponse{} }
-func (m *TrimResponse) String() string { return proto.CompactTextString(m) }
-func (*TrimResponse) ProtoMessage()    {}
-func (*TrimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{39}
+func (m *UnregisterLogStreamRequest) Reset()         { *m = UnregisterLogStreamRequest{} }
+func (m *UnregisterLogStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*UnregisterLogStreamRequest) ProtoMessage()    {}
+func (*UnregisterLogStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{37}
 }
-func (m *TrimResponse) XXX_Unmarshal(b []byte) error {
+func (m *UnregisterLogStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TrimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnregisterLogStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TrimResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnregisterLogStreamRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1896,41 +2254,47 @@ func (m *TrimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *TrimResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TrimResponse.Merge(m, src)
+func (m *UnregisterLogStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnregisterLogStreamRequest.Merge(m, src)
 }
-func (m *TrimResponse) XXX_Size() int {
+func (m *UnregisterLogStreamRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *TrimResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_TrimResponse.DiscardUnknown(m)
+func (m *UnregisterLogStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnregisterLogStreamRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TrimResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnregisterLogStreamRequest proto.InternalMessageInfo
 
-func (m *TrimResponse) GetResults() []TrimResult {
+func (m *UnregisterLogStreamRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
 	if m != nil {
-		return m.Results
+		return m.TopicID
 	}
-	return nil
+	return 0
 }
 
-type GetMetadataRepositoryNodeRequest struct {
-	NodeID github_com_kakao_varlog_pkg_types.NodeID `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.NodeID" json:"node_id,omitempty"`
+func (m *UnregisterLogStreamRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
 }
 
-func (m *GetMetadataRepositoryNodeRequest) Reset()         { *m = GetMetadataRepositoryNodeRequest{} }
-func (m *GetMetadataRepositoryNodeRequest) String() string { return proto.CompactTextString(m) }
-func (*GetMetadataRepositoryNodeRequest) ProtoMessage()    {}
-func (*GetMetadataRepositoryNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{40}
+type UnregisterLogStreamResponse struct {
 }
-func (m *GetMetadataRepositoryNodeRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *UnregisterLogStreamResponse) Reset()         { *m = UnregisterLogStreamResponse{} }
+func (m *UnregisterLogStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*UnregisterLogStreamResponse) ProtoMessage()    {}
+func (*UnregisterLogStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{38}
+}
+func (m *UnregisterLogStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetMetadataRepositoryNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnregisterLogStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetMetadataRepositoryNodeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnregisterLogStreamResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1940,41 +2304,36 @@ func (m *GetMetadataRepositoryNodeRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *GetMetadataRepositoryNodeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMetadataRepositoryNodeRequest.Merge(m, src)
+func (m *UnregisterLogStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnregisterLogStreamResponse.Merge(m, src)
 }
-func (m *GetMetadataRepositoryNodeRequest) XXX_Size() int {
+func (m *UnregisterLogStreamResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *GetMetadataRepositoryNodeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMetadataRepositoryNodeRequest.DiscardUnknown(m)
+func (m *UnregisterLogStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnregisterLogStreamResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMetadataRepositoryNodeRequest proto.InternalMessageInfo
-
-func (m *GetMetadataRepositoryNodeRequest) GetNodeID() github_com_kakao_varlog_pkg_types.NodeID {
-	if m != nil {
-		return m.NodeID
-	}
-	return 0
-}
+var xxx_messageInfo_UnregisterLogStreamResponse proto.InternalMessageInfo
 
-type GetMetadataRepositoryNodeResponse struct {
-	Node *varlogpb.MetadataRepositoryNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+type RemoveLogStreamReplicaRequest struct {
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,1,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
+	TopicID       github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID   github_com_kakao_varlog_pkg_types.LogStreamID   `protobuf:"varint,3,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
 }
 
-func (m *GetMetadataRepositoryNodeResponse) Reset()         { *m = GetMetadataRepositoryNodeResponse{} }
-func (m *GetMetadataRepositoryNodeResponse) String() string { return proto.CompactTextString(m) }
-func (*GetMetadataRepositoryNodeResponse) ProtoMessage()    {}
-func (*GetMetadataRepositoryNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{41}
+func (m *RemoveLogStreamReplicaRequest) Reset()         { *m = RemoveLogStreamReplicaRequest{} }
+func (m *RemoveLogStreamReplicaRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveLogStreamReplicaRequest) ProtoMessage()    {}
+func (*RemoveLogStreamReplicaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{39}
 }
-func (m *GetMetadataRepositoryNodeResponse) XXX_Unmarshal(b []byte) error {
+func (m *RemoveLogStreamReplicaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetMetadataRepositoryNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveLogStreamReplicaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetMetadataRepositoryNodeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveLogStreamReplicaRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1984,40 +2343,54 @@ func (m *GetMetadataRepositoryNodeResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *GetMetadataRepositoryNodeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMetadataRepositoryNodeResponse.Merge(m, src)
+func (m *RemoveLogStreamReplicaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveLogStreamReplicaRequest.Merge(m, src)
 }
-func (m *GetMetadataRepositoryNodeResponse) XXX_Size() int {
+func (m *RemoveLogStreamReplicaRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *GetMetadataRepositoryNodeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMetadataRepositoryNodeResponse.DiscardUnknown(m)
+func (m *RemoveLogStreamReplicaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveLogStreamReplicaRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMetadataRepositoryNodeResponse proto.InternalMessageInfo
+var xxx_messageInfo_RemoveLogStreamReplicaRequest proto.InternalMessageInfo
 
-func (m *GetMetadataRepositoryNodeResponse) GetNode() *varlogpb.MetadataRepositoryNode {
+func (m *RemoveLogStreamReplicaRequest) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
 	if m != nil {
-		return m.Node
+		return m.StorageNodeID
 	}
-	return nil
+	return 0
 }
 
-type ListMetadataRepositoryNodesRequest struct {
+func (m *RemoveLogStreamReplicaRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
 }
 
-func (m *ListMetadataRepositoryNodesRequest) Reset()         { *m = ListMetadataRepositoryNodesRequest{} }
-func (m *ListMetadataRepositoryNodesRequest) String() string { return proto.CompactTextString(m) }
-func (*ListMetadataRepositoryNodesRequest) ProtoMessage()    {}
-func (*ListMetadataRepositoryNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{42}
+func (m *RemoveLogStreamReplicaRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
 }
-func (m *ListMetadataRepositoryNodesRequest) XXX_Unmarshal(b []byte) error {
+
+type RemoveLogStreamReplicaResponse struct {
+}
+
+func (m *RemoveLogStreamReplicaResponse) Reset()         { *m = RemoveLogStreamReplicaResponse{} }
+func (m *RemoveLogStreamReplicaResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveLogStreamReplicaResponse) ProtoMessage()    {}
+func (*RemoveLogStreamReplicaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{40}
+}
+func (m *RemoveLogStreamReplicaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListMetadataRepositoryNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveLogStreamReplicaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListMetadataRepositoryNodesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveLogStreamReplicaResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2027,34 +2400,35 @@ func (m *ListMetadataRepositoryNodesRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *ListMetadataRepositoryNodesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListMetadataRepositoryNodesRequest.Merge(m, src)
+func (m *RemoveLogStreamReplicaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveLogStreamReplicaResponse.Merge(m, src)
 }
-func (m *ListMetadataRepositoryNodesRequest) XXX_Size() int {
+func (m *RemoveLogStreamReplicaResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *ListMetadataRepositoryNodesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListMetadataRepositoryNodesRequest.DiscardUnknown(m)
+func (m *RemoveLogStreamReplicaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveLogStreamReplicaResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListMetadataRepositoryNodesRequest proto.InternalMessageInfo
+var xxx_messageInfo_RemoveLogStreamReplicaResponse proto.InternalMessageInfo
 
-type ListMetadataRepositoryNodesResponse struct {
-	Nodes []varlogpb.MetadataRepositoryNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes"`
+type SealRequest struct {
+	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
 }
 
-func (m *ListMetadataRepositoryNodesResponse) Reset()         { *m = ListMetadataRepositoryNodesResponse{} }
-func (m *ListMetadataRepositoryNodesResponse) String() string { return proto.CompactTextString(m) }
-func (*ListMetadataRepositoryNodesResponse) ProtoMessage()    {}
-func (*ListMetadataRepositoryNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{43}
+func (m *SealRequest) Reset()         { *m = SealRequest{} }
+func (m *SealRequest) String() string { return proto.CompactTextString(m) }
+func (*SealRequest) ProtoMessage()    {}
+func (*SealRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{41}
 }
-func (m *ListMetadataRepositoryNodesResponse) XXX_Unmarshal(b []byte) error {
+func (m *SealRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListMetadataRepositoryNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SealRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListMetadataRepositoryNodesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SealRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2064,43 +2438,55 @@ func (m *ListMetadataRepositoryNodesResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *ListMetadataRepositoryNodesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListMetadataRepositoryNodesResponse.Merge(m, src)
+func (m *SealRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SealRequest.Merge(m, src)
 }
-func (m *ListMetadataRepositoryNodesResponse) XXX_Size() int {
+func (m *SealRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *ListMetadataRepositoryNodesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListMetadataRepositoryNodesResponse.DiscardUnknown(m)
+func (m *SealRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SealRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListMetadataRepositoryNodesResponse proto.InternalMessageInfo
+var xxx_messageInfo_SealRequest proto.InternalMessageInfo
 
-func (m *ListMetadataRepositoryNodesResponse) GetNodes() []varlogpb.MetadataRepositoryNode {
+func (m *SealRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
 	if m != nil {
-		return m.Nodes
+		return m.TopicID
 	}
-	return nil
+	return 0
 }
 
-type GetMRMembersResponse struct {
-	Leader            github_com_kakao_varlog_pkg_types.NodeID            `protobuf:"varint,1,opt,name=leader,proto3,casttype=github.com/kakao/varlog/pkg/types.NodeID" json:"leader,omitempty"`
-	ReplicationFactor int32                                               `protobuf:"varint,2,opt,name=replication_factor,json=replicationFactor,proto3" json:"replicationFactor,omitempty"`
-	Members           map[github_com_kakao_varlog_pkg_types.NodeID]string `protobuf:"bytes,3,rep,name=members,proto3,castkey=github.com/kakao/varlog/pkg/types.NodeID" json:"members,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (m *SealRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
 }
 
-func (m *GetMRMembersResponse) Reset()         { *m = GetMRMembersResponse{} }
-func (m *GetMRMembersResponse) String() string { return proto.CompactTextString(m) }
-func (*GetMRMembersResponse) ProtoMessage()    {}
-func (*GetMRMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{44}
+type SealResponse struct {
+	LogStreams []snpb.LogStreamReplicaMetadataDescriptor `protobuf:"bytes,1,rep,name=log_streams,json=logStreams,proto3" json:"logStreams"`
+	SealedGLSN github_com_kakao_varlog_pkg_types.GLSN    `protobuf:"varint,2,opt,name=sealed_glsn,json=sealedGlsn,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"sealedGLSN"`
+	// OperationID is the identifier of the operation issued by this call.
+	OperationID uint64 `protobuf:"varint,3,opt,name=operation_id,json=operationId,proto3" json:"operationId,omitempty"`
+	// OperationState is the state of the operation when this call returns. It
+	// is OPERATION_STATE_RUNNING if the operation could not finish before the
+	// deadline of the call; the operation keeps running in the background.
+	OperationState OperationState `protobuf:"varint,4,opt,name=operation_state,json=operationState,proto3,enum=varlog.vmspb.OperationState" json:"operationState,omitempty"`
 }
-func (m *GetMRMembersResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *SealResponse) Reset()         { *m = SealResponse{} }
+func (m *SealResponse) String() string { return proto.CompactTextString(m) }
+func (*SealResponse) ProtoMessage()    {}
+func (*SealResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{42}
+}
+func (m *SealResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetMRMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetMRMembersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SealResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2110,56 +2496,63 @@ func (m *GetMRMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *GetMRMembersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMRMembersResponse.Merge(m, src)
+func (m *SealResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SealResponse.Merge(m, src)
 }
-func (m *GetMRMembersResponse) XXX_Size() int {
+func (m *SealResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *GetMRMembersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMRMembersResponse.DiscardUnknown(m)
+func (m *SealResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SealResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMRMembersResponse proto.InternalMessageInfo
+var xxx_messageInfo_SealResponse proto.InternalMessageInfo
 
-func (m *GetMRMembersResponse) GetLeader() github_com_kakao_varlog_pkg_types.NodeID {
+func (m *SealResponse) GetLogStreams() []snpb.LogStreamReplicaMetadataDescriptor {
 	if m != nil {
-		return m.Leader
+		return m.LogStreams
+	}
+	return nil
+}
+
+func (m *SealResponse) GetSealedGLSN() github_com_kakao_varlog_pkg_types.GLSN {
+	if m != nil {
+		return m.SealedGLSN
 	}
 	return 0
 }
 
-func (m *GetMRMembersResponse) GetReplicationFactor() int32 {
+func (m *SealResponse) GetOperationID() uint64 {
 	if m != nil {
-		return m.ReplicationFactor
+		return m.OperationID
 	}
 	return 0
 }
 
-func (m *GetMRMembersResponse) GetMembers() map[github_com_kakao_varlog_pkg_types.NodeID]string {
+func (m *SealResponse) GetOperationState() OperationState {
 	if m != nil {
-		return m.Members
+		return m.OperationState
 	}
-	return nil
+	return OperationStateUnknown
 }
 
-type AddMetadataRepositoryNodeRequest struct {
-	RaftURL string `protobuf:"bytes,1,opt,name=raft_url,json=raftUrl,proto3" json:"raft_url,omitempty"`
-	RPCAddr string `protobuf:"bytes,2,opt,name=rpc_addr,json=rpcAddr,proto3" json:"rpc_addr,omitempty"`
+type UnsealRequest struct {
+	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
 }
 
-func (m *AddMetadataRepositoryNodeRequest) Reset()         { *m = AddMetadataRepositoryNodeRequest{} }
-func (m *AddMetadataRepositoryNodeRequest) String() string { return proto.CompactTextString(m) }
-func (*AddMetadataRepositoryNodeRequest) ProtoMessage()    {}
-func (*AddMetadataRepositoryNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{45}
+func (m *UnsealRequest) Reset()         { *m = UnsealRequest{} }
+func (m *UnsealRequest) String() string { return proto.CompactTextString(m) }
+func (*UnsealRequest) ProtoMessage()    {}
+func (*UnsealRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{43}
 }
-func (m *AddMetadataRepositoryNodeRequest) XXX_Unmarshal(b []byte) error {
+func (m *UnsealRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddMetadataRepositoryNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnsealRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddMetadataRepositoryNodeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnsealRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2169,48 +2562,54 @@ func (m *AddMetadataRepositoryNodeRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *AddMetadataRepositoryNodeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddMetadataRepositoryNodeRequest.Merge(m, src)
+func (m *UnsealRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsealRequest.Merge(m, src)
 }
-func (m *AddMetadataRepositoryNodeRequest) XXX_Size() int {
+func (m *UnsealRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *AddMetadataRepositoryNodeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddMetadataRepositoryNodeRequest.DiscardUnknown(m)
+func (m *UnsealRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsealRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddMetadataRepositoryNodeRequest proto.InternalMessageInfo
+var xxx_messageInfo_UnsealRequest proto.InternalMessageInfo
 
-func (m *AddMetadataRepositoryNodeRequest) GetRaftURL() string {
+func (m *UnsealRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
 	if m != nil {
-		return m.RaftURL
+		return m.TopicID
 	}
-	return ""
+	return 0
 }
 
-func (m *AddMetadataRepositoryNodeRequest) GetRPCAddr() string {
+func (m *UnsealRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
 	if m != nil {
-		return m.RPCAddr
+		return m.LogStreamID
 	}
-	return ""
+	return 0
 }
 
-type AddMetadataRepositoryNodeResponse struct {
-	Node *varlogpb.MetadataRepositoryNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+type UnsealResponse struct {
+	LogStream *varlogpb.LogStreamDescriptor `protobuf:"bytes,1,opt,name=log_stream,json=logStream,proto3" json:"logStream"`
+	// OperationID is the identifier of the operation issued by this call.
+	OperationID uint64 `protobuf:"varint,2,opt,name=operation_id,json=operationId,proto3" json:"operationId,omitempty"`
+	// OperationState is the state of the operation when this call returns. It
+	// is OPERATION_STATE_RUNNING if the operation could not finish before the
+	// deadline of the call; the operation keeps running in the background.
+	OperationState OperationState `protobuf:"varint,3,opt,name=operation_state,json=operationState,proto3,enum=varlog.vmspb.OperationState" json:"operationState,omitempty"`
 }
 
-func (m *AddMetadataRepositoryNodeResponse) Reset()         { *m = AddMetadataRepositoryNodeResponse{} }
-func (m *AddMetadataRepositoryNodeResponse) String() string { return proto.CompactTextString(m) }
-func (*AddMetadataRepositoryNodeResponse) ProtoMessage()    {}
-func (*AddMetadataRepositoryNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{46}
+func (m *UnsealResponse) Reset()         { *m = UnsealResponse{} }
+func (m *UnsealResponse) String() string { return proto.CompactTextString(m) }
+func (*UnsealResponse) ProtoMessage()    {}
+func (*UnsealResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{44}
 }
-func (m *AddMetadataRepositoryNodeResponse) XXX_Unmarshal(b []byte) error {
+func (m *UnsealResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddMetadataRepositoryNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnsealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddMetadataRepositoryNodeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnsealResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2220,42 +2619,58 @@ func (m *AddMetadataRepositoryNodeResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *AddMetadataRepositoryNodeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddMetadataRepositoryNodeResponse.Merge(m, src)
+func (m *UnsealResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsealResponse.Merge(m, src)
 }
-func (m *AddMetadataRepositoryNodeResponse) XXX_Size() int {
+func (m *UnsealResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *AddMetadataRepositoryNodeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddMetadataRepositoryNodeResponse.DiscardUnknown(m)
+func (m *UnsealResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsealResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddMetadataRepositoryNodeResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnsealResponse proto.InternalMessageInfo
 
-func (m *AddMetadataRepositoryNodeResponse) GetNode() *varlogpb.MetadataRepositoryNode {
+func (m *UnsealResponse) GetLogStream() *varlogpb.LogStreamDescriptor {
 	if m != nil {
-		return m.Node
+		return m.LogStream
 	}
 	return nil
 }
 
-type AddMRPeerRequest struct {
-	RaftURL string `protobuf:"bytes,1,opt,name=raft_url,json=raftUrl,proto3" json:"raft_url,omitempty"`
-	RPCAddr string `protobuf:"bytes,2,opt,name=rpc_addr,json=rpcAddr,proto3" json:"rpc_addr,omitempty"`
+func (m *UnsealResponse) GetOperationID() uint64 {
+	if m != nil {
+		return m.OperationID
+	}
+	return 0
 }
 
-func (m *AddMRPeerRequest) Reset()         { *m = AddMRPeerRequest{} }
-func (m *AddMRPeerRequest) String() string { return proto.CompactTextString(m) }
-func (*AddMRPeerRequest) ProtoMessage()    {}
-func (*AddMRPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{47}
+func (m *UnsealResponse) GetOperationState() OperationState {
+	if m != nil {
+		return m.OperationState
+	}
+	return OperationStateUnknown
 }
-func (m *AddMRPeerRequest) XXX_Unmarshal(b []byte) error {
+
+type SyncRequest struct {
+	TopicID          github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID      github_com_kakao_varlog_pkg_types.LogStreamID   `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	SrcStorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,3,opt,name=src_storage_node_id,json=srcStorageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"src_storage_node_id,omitempty"`
+	DstStorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,4,opt,name=dst_storage_node_id,json=dstStorageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"dst_storage_node_id,omitempty"`
+}
+
+func (m *SyncRequest) Reset()         { *m = SyncRequest{} }
+func (m *SyncRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncRequest) ProtoMessage()    {}
+func (*SyncRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{45}
+}
+func (m *SyncRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddMRPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddMRPeerRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SyncRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2265,48 +2680,68 @@ func (m *AddMRPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *AddMRPeerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddMRPeerRequest.Merge(m, src)
+func (m *SyncRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncRequest.Merge(m, src)
 }
-func (m *AddMRPeerRequest) XXX_Size() int {
+func (m *SyncRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *AddMRPeerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddMRPeerRequest.DiscardUnknown(m)
+func (m *SyncRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddMRPeerRequest proto.InternalMessageInfo
+var xxx_messageInfo_SyncRequest proto.InternalMessageInfo
 
-func (m *AddMRPeerRequest) GetRaftURL() string {
+func (m *SyncRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
 	if m != nil {
-		return m.RaftURL
+		return m.TopicID
 	}
-	return ""
+	return 0
 }
 
-func (m *AddMRPeerRequest) GetRPCAddr() string {
+func (m *SyncRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
 	if m != nil {
-		return m.RPCAddr
+		return m.LogStreamID
 	}
-	return ""
+	return 0
 }
 
-type AddMRPeerResponse struct {
-	NodeID github_com_kakao_varlog_pkg_types.NodeID `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.NodeID" json:"nodeId,omitempty"`
+func (m *SyncRequest) GetSrcStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.SrcStorageNodeID
+	}
+	return 0
 }
 
-func (m *AddMRPeerResponse) Reset()         { *m = AddMRPeerResponse{} }
-func (m *AddMRPeerResponse) String() string { return proto.CompactTextString(m) }
-func (*AddMRPeerResponse) ProtoMessage()    {}
-func (*AddMRPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{48}
+func (m *SyncRequest) GetDstStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.DstStorageNodeID
+	}
+	return 0
 }
-func (m *AddMRPeerResponse) XXX_Unmarshal(b []byte) error {
+
+type SyncResponse struct {
+	Status *snpb.SyncStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// OperationID is the identifier of the operation issued by this call.
+	OperationID uint64 `protobuf:"varint,2,opt,name=operation_id,json=operationId,proto3" json:"operationId,omitempty"`
+	// OperationState is the state of the operation when this call returns. It
+	// is OPERATION_STATE_RUNNING if the operation could not finish before the
+	// deadline of the call; the operation keeps running in the background.
+	OperationState OperationState `protobuf:"varint,3,opt,name=operation_state,json=operationState,proto3,enum=varlog.vmspb.OperationState" json:"operationState,omitempty"`
+}
+
+func (m *SyncResponse) Reset()         { *m = SyncResponse{} }
+func (m *SyncResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncResponse) ProtoMessage()    {}
+func (*SyncResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{46}
+}
+func (m *SyncResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddMRPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddMRPeerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SyncResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2316,41 +2751,55 @@ func (m *AddMRPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *AddMRPeerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddMRPeerResponse.Merge(m, src)
+func (m *SyncResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncResponse.Merge(m, src)
 }
-func (m *AddMRPeerResponse) XXX_Size() int {
+func (m *SyncResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *AddMRPeerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddMRPeerResponse.DiscardUnknown(m)
+func (m *SyncResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddMRPeerResponse proto.InternalMessageInfo
+var xxx_messageInfo_SyncResponse proto.InternalMessageInfo
 
-func (m *AddMRPeerResponse) GetNodeID() github_com_kakao_varlog_pkg_types.NodeID {
+func (m *SyncResponse) GetStatus() *snpb.SyncStatus {
 	if m != nil {
-		return m.NodeID
+		return m.Status
+	}
+	return nil
+}
+
+func (m *SyncResponse) GetOperationID() uint64 {
+	if m != nil {
+		return m.OperationID
 	}
 	return 0
 }
 
-type DeleteMetadataRepositoryNodeRequest struct {
-	NodeID github_com_kakao_varlog_pkg_types.NodeID `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.NodeID" json:"nodeId"`
+func (m *SyncResponse) GetOperationState() OperationState {
+	if m != nil {
+		return m.OperationState
+	}
+	return OperationStateUnknown
 }
 
-func (m *DeleteMetadataRepositoryNodeRequest) Reset()         { *m = DeleteMetadataRepositoryNodeRequest{} }
-func (m *DeleteMetadataRepositoryNodeRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteMetadataRepositoryNodeRequest) ProtoMessage()    {}
-func (*DeleteMetadataRepositoryNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{49}
+type GetOperationRequest struct {
+	OperationID uint64 `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
 }
-func (m *DeleteMetadataRepositoryNodeRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *GetOperationRequest) Reset()         { *m = GetOperationRequest{} }
+func (m *GetOperationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOperationRequest) ProtoMessage()    {}
+func (*GetOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{47}
+}
+func (m *GetOperationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteMetadataRepositoryNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteMetadataRepositoryNodeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetOperationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2360,40 +2809,41 @@ func (m *DeleteMetadataRepositoryNodeRequest) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *DeleteMetadataRepositoryNodeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteMetadataRepositoryNodeRequest.Merge(m, src)
+func (m *GetOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOperationRequest.Merge(m, src)
 }
-func (m *DeleteMetadataRepositoryNodeRequest) XXX_Size() int {
+func (m *GetOperationRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *DeleteMetadataRepositoryNodeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteMetadataRepositoryNodeRequest.DiscardUnknown(m)
+func (m *GetOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOperationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteMetadataRepositoryNodeRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetOperationRequest proto.InternalMessageInfo
 
-func (m *DeleteMetadataRepositoryNodeRequest) GetNodeID() github_com_kakao_varlog_pkg_types.NodeID {
+func (m *GetOperationRequest) GetOperationID() uint64 {
 	if m != nil {
-		return m.NodeID
+		return m.OperationID
 	}
 	return 0
 }
 
-type DeleteMetadataRepositoryNodeResponse struct {
+type GetOperationResponse struct {
+	Operation *Operation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation"`
 }
 
-func (m *DeleteMetadataRepositoryNodeResponse) Reset()         { *m = DeleteMetadataRepositoryNodeResponse{} }
-func (m *DeleteMetadataRepositoryNodeResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteMetadataRepositoryNodeResponse) ProtoMessage()    {}
-func (*DeleteMetadataRepositoryNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{50}
+func (m *GetOperationResponse) Reset()         { *m = GetOperationResponse{} }
+func (m *GetOperationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOperationResponse) ProtoMessage()    {}
+func (*GetOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{48}
 }
-func (m *DeleteMetadataRepositoryNodeResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetOperationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteMetadataRepositoryNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteMetadataRepositoryNodeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetOperationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2403,34 +2853,40 @@ func (m *DeleteMetadataRepositoryNodeResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *DeleteMetadataRepositoryNodeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteMetadataRepositoryNodeResponse.Merge(m, src)
+func (m *GetOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOperationResponse.Merge(m, src)
 }
-func (m *DeleteMetadataRepositoryNodeResponse) XXX_Size() int {
+func (m *GetOperationResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *DeleteMetadataRepositoryNodeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteMetadataRepositoryNodeResponse.DiscardUnknown(m)
+func (m *GetOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOperationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteMetadataRepositoryNodeResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetOperationResponse proto.InternalMessageInfo
 
-type RemoveMRPeerRequest struct {
-	RaftURL string `protobuf:"bytes,1,opt,name=raft_url,json=raftUrl,proto3" json:"raft_url,omitempty"`
+func (m *GetOperationResponse) GetOperation() *Operation {
+	if m != nil {
+		return m.Operation
+	}
+	return nil
 }
 
-func (m *RemoveMRPeerRequest) Reset()         { *m = RemoveMRPeerRequest{} }
-func (m *RemoveMRPeerRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveMRPeerRequest) ProtoMessage()    {}
-func (*RemoveMRPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{51}
+type ListOperationsRequest struct {
 }
-func (m *RemoveMRPeerRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *ListOperationsRequest) Reset()         { *m = ListOperationsRequest{} }
+func (m *ListOperationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOperationsRequest) ProtoMessage()    {}
+func (*ListOperationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{49}
+}
+func (m *ListOperationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveMRPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListOperationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveMRPeerRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListOperationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2440,40 +2896,78 @@ func (m *RemoveMRPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RemoveMRPeerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveMRPeerRequest.Merge(m, src)
+func (m *ListOperationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOperationsRequest.Merge(m, src)
 }
-func (m *RemoveMRPeerRequest) XXX_Size() int {
+func (m *ListOperationsRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *RemoveMRPeerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveMRPeerRequest.DiscardUnknown(m)
+func (m *ListOperationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOperationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveMRPeerRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListOperationsRequest proto.InternalMessageInfo
 
-func (m *RemoveMRPeerRequest) GetRaftURL() string {
+type ListOperationsResponse struct {
+	Operations []Operation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations"`
+}
+
+func (m *ListOperationsResponse) Reset()         { *m = ListOperationsResponse{} }
+func (m *ListOperationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOperationsResponse) ProtoMessage()    {}
+func (*ListOperationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{50}
+}
+func (m *ListOperationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListOperationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListOperationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListOperationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOperationsResponse.Merge(m, src)
+}
+func (m *ListOperationsResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ListOperationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOperationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOperationsResponse proto.InternalMessageInfo
+
+func (m *ListOperationsResponse) GetOperations() []Operation {
 	if m != nil {
-		return m.RaftURL
+		return m.Operations
 	}
-	return ""
+	return nil
 }
 
-type RemoveMRPeerResponse struct {
+type CancelOperationRequest struct {
+	OperationID uint64 `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
 }
 
-func (m *RemoveMRPeerResponse) Reset()         { *m = RemoveMRPeerResponse{} }
-func (m *RemoveMRPeerResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveMRPeerResponse) ProtoMessage()    {}
-func (*RemoveMRPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f6257e87fe6989, []int{52}
+func (m *CancelOperationRequest) Reset()         { *m = CancelOperationRequest{} }
+func (m *CancelOperationRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelOperationRequest) ProtoMessage()    {}
+func (*CancelOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{51}
 }
-func (m *RemoveMRPeerResponse) XXX_Unmarshal(b []byte) error {
+func (m *CancelOperationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveMRPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CancelOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveMRPeerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CancelOperationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2483,3774 +2977,9412 @@ func (m *RemoveMRPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *RemoveMRPeerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveMRPeerResponse.Merge(m, src)
+func (m *CancelOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelOperationRequest.Merge(m, src)
 }
-func (m *RemoveMRPeerResponse) XXX_Size() int {
+func (m *CancelOperationRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *RemoveMRPeerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveMRPeerResponse.DiscardUnknown(m)
+func (m *CancelOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelOperationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveMRPeerResponse proto.InternalMessageInfo
+var xxx_messageInfo_CancelOperationRequest proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*StorageNodeMetadata)(nil), "varlog.vmspb.StorageNodeMetadata")
-	proto.RegisterType((*GetStorageNodeRequest)(nil), "varlog.vmspb.GetStorageNodeRequest")
-	proto.RegisterType((*GetStorageNodeResponse)(nil), "varlog.vmspb.GetStorageNodeResponse")
-	proto.RegisterType((*ListStorageNodesRequest)(nil), "varlog.vmspb.ListStorageNodesRequest")
-	proto.RegisterType((*ListStorageNodesResponse)(nil), "varlog.vmspb.ListStorageNodesResponse")
-	proto.RegisterType((*AddStorageNodeRequest)(nil), "varlog.vmspb.AddStorageNodeRequest")
-	proto.RegisterType((*AddStorageNodeResponse)(nil), "varlog.vmspb.AddStorageNodeResponse")
-	proto.RegisterType((*UnregisterStorageNodeRequest)(nil), "varlog.vmspb.UnregisterStorageNodeRequest")
-	proto.RegisterType((*UnregisterStorageNodeResponse)(nil), "varlog.vmspb.UnregisterStorageNodeResponse")
-	proto.RegisterType((*GetTopicRequest)(nil), "varlog.vmspb.GetTopicRequest")
-	proto.RegisterType((*GetTopicResponse)(nil), "varlog.vmspb.GetTopicResponse")
-	proto.RegisterType((*DescribeTopicRequest)(nil), "varlog.vmspb.DescribeTopicRequest")
-	proto.RegisterType((*DescribeTopicResponse)(nil), "varlog.vmspb.DescribeTopicResponse")
-	proto.RegisterType((*ListTopicsRequest)(nil), "varlog.vmspb.ListTopicsRequest")
-	proto.RegisterType((*ListTopicsResponse)(nil), "varlog.vmspb.ListTopicsResponse")
-	proto.RegisterType((*AddTopicRequest)(nil), "varlog.vmspb.AddTopicRequest")
-	proto.RegisterType((*AddTopicResponse)(nil), "varlog.vmspb.AddTopicResponse")
-	proto.RegisterType((*UnregisterTopicRequest)(nil), "varlog.vmspb.UnregisterTopicRequest")
-	proto.RegisterType((*UnregisterTopicResponse)(nil), "varlog.vmspb.UnregisterTopicResponse")
-	proto.RegisterType((*GetLogStreamRequest)(nil), "varlog.vmspb.GetLogStreamRequest")
-	proto.RegisterType((*GetLogStreamResponse)(nil), "varlog.vmspb.GetLogStreamResponse")
-	proto.RegisterType((*ListLogStreamsRequest)(nil), "varlog.vmspb.ListLogStreamsRequest")
-	proto.RegisterType((*ListLogStreamsResponse)(nil), "varlog.vmspb.ListLogStreamsResponse")
-	proto.RegisterType((*AddLogStreamRequest)(nil), "varlog.vmspb.AddLogStreamRequest")
-	proto.RegisterType((*AddLogStreamResponse)(nil), "varlog.vmspb.AddLogStreamResponse")
-	proto.RegisterType((*UpdateLogStreamRequest)(nil), "varlog.vmspb.UpdateLogStreamRequest")
-	proto.RegisterType((*UpdateLogStreamResponse)(nil), "varlog.vmspb.UpdateLogStreamResponse")
-	proto.RegisterType((*UnregisterLogStreamRequest)(nil), "varlog.vmspb.UnregisterLogStreamRequest")
-	proto.RegisterType((*UnregisterLogStreamResponse)(nil), "varlog.vmspb.UnregisterLogStreamResponse")
-	proto.RegisterType((*RemoveLogStreamReplicaRequest)(nil), "varlog.vmspb.RemoveLogStreamReplicaRequest")
-	proto.RegisterType((*RemoveLogStreamReplicaResponse)(nil), "varlog.vmspb.RemoveLogStreamReplicaResponse")
-	proto.RegisterType((*SealRequest)(nil), "varlog.vmspb.SealRequest")
-	proto.RegisterType((*SealResponse)(nil), "varlog.vmspb.SealResponse")
-	proto.RegisterType((*UnsealRequest)(nil), "varlog.vmspb.UnsealRequest")
-	proto.RegisterType((*UnsealResponse)(nil), "varlog.vmspb.UnsealResponse")
-	proto.RegisterType((*SyncRequest)(nil), "varlog.vmspb.SyncRequest")
-	proto.RegisterType((*SyncResponse)(nil), "varlog.vmspb.SyncResponse")
-	proto.RegisterType((*TrimRequest)(nil), "varlog.vmspb.TrimRequest")
-	proto.RegisterType((*TrimResult)(nil), "varlog.vmspb.TrimResult")
-	proto.RegisterType((*TrimResponse)(nil), "varlog.vmspb.TrimResponse")
-	proto.RegisterType((*GetMetadataRepositoryNodeRequest)(nil), "varlog.vmspb.GetMetadataRepositoryNodeRequest")
-	proto.RegisterType((*GetMetadataRepositoryNodeResponse)(nil), "varlog.vmspb.GetMetadataRepositoryNodeResponse")
-	proto.RegisterType((*ListMetadataRepositoryNodesRequest)(nil), "varlog.vmspb.ListMetadataRepositoryNodesRequest")
-	proto.RegisterType((*ListMetadataRepositoryNodesResponse)(nil), "varlog.vmspb.ListMetadataRepositoryNodesResponse")
-	proto.RegisterType((*GetMRMembersResponse)(nil), "varlog.vmspb.GetMRMembersResponse")
-	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.NodeID]string)(nil), "varlog.vmspb.GetMRMembersResponse.MembersEntry")
-	proto.RegisterType((*AddMetadataRepositoryNodeRequest)(nil), "varlog.vmspb.AddMetadataRepositoryNodeRequest")
-	proto.RegisterType((*AddMetadataRepositoryNodeResponse)(nil), "varlog.vmspb.AddMetadataRepositoryNodeResponse")
-	proto.RegisterType((*AddMRPeerRequest)(nil), "varlog.vmspb.AddMRPeerRequest")
-	proto.RegisterType((*AddMRPeerResponse)(nil), "varlog.vmspb.AddMRPeerResponse")
-	proto.RegisterType((*DeleteMetadataRepositoryNodeRequest)(nil), "varlog.vmspb.DeleteMetadataRepositoryNodeRequest")
-	proto.RegisterType((*DeleteMetadataRepositoryNodeResponse)(nil), "varlog.vmspb.DeleteMetadataRepositoryNodeResponse")
-	proto.RegisterType((*RemoveMRPeerRequest)(nil), "varlog.vmspb.RemoveMRPeerRequest")
-	proto.RegisterType((*RemoveMRPeerResponse)(nil), "varlog.vmspb.RemoveMRPeerResponse")
+func (m *CancelOperationRequest) GetOperationID() uint64 {
+	if m != nil {
+		return m.OperationID
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("proto/vmspb/admin.proto", fileDescriptor_55f6257e87fe6989) }
+type CancelOperationResponse struct {
+	Operation *Operation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation"`
+}
 
-var fileDescriptor_55f6257e87fe6989 = []byte{
-	// 2160 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4b, 0x73, 0xdb, 0xc6,
-	0x99, 0xa0, 0xde, 0x1f, 0xf5, 0x5c, 0xea, 0x65, 0xc8, 0x12, 0x64, 0x48, 0x71, 0xed, 0xd4, 0x21,
-	0x1b, 0x77, 0xa6, 0xe3, 0x71, 0x9b, 0x49, 0x44, 0xcb, 0x91, 0xdd, 0x48, 0x76, 0x0a, 0x5a, 0xd3,
-	0x49, 0xd2, 0x98, 0x01, 0x89, 0x15, 0xcd, 0x0a, 0x24, 0x58, 0x2c, 0xa8, 0x46, 0x87, 0x76, 0x3a,
-	0x9d, 0x76, 0x7a, 0xe9, 0x21, 0x3f, 0x21, 0xd3, 0x6b, 0x2f, 0x3d, 0xe6, 0xd0, 0x1f, 0xe0, 0xe9,
-	0xa1, 0xe3, 0x5b, 0x7b, 0x29, 0x32, 0x95, 0x2f, 0x1d, 0xde, 0x7b, 0xc9, 0xa9, 0x83, 0xdd, 0x05,
-	0xb0, 0x78, 0x90, 0x94, 0x14, 0xab, 0x9e, 0xd1, 0x89, 0x00, 0xf6, 0x7b, 0xbf, 0xf6, 0xdb, 0x6f,
-	0x09, 0x4b, 0x6d, 0xdb, 0x72, 0xac, 0xe2, 0x51, 0x93, 0xb4, 0xab, 0x45, 0xdd, 0x68, 0x36, 0x5a,
-	0x05, 0xfa, 0x05, 0x4d, 0x1e, 0xe9, 0xb6, 0x69, 0xd5, 0x0b, 0x74, 0x45, 0x7e, 0xab, 0xde, 0x70,
-	0x9e, 0x75, 0xaa, 0x85, 0x9a, 0xd5, 0x2c, 0xd6, 0xad, 0xba, 0x55, 0xa4, 0x40, 0xd5, 0xce, 0x01,
-	0x7d, 0x63, 0x34, 0xbc, 0x27, 0x86, 0x2c, 0x2b, 0x75, 0xcb, 0xaa, 0x9b, 0x38, 0x84, 0x72, 0x1a,
-	0x4d, 0x4c, 0x1c, 0xbd, 0xd9, 0xe6, 0x00, 0x2b, 0x71, 0x00, 0xdc, 0x6c, 0x3b, 0xc7, 0x7c, 0x71,
-	0x89, 0xb1, 0x6e, 0x57, 0x8b, 0x4d, 0xec, 0xe8, 0x86, 0xee, 0xe8, 0x7c, 0x61, 0x81, 0xb4, 0xda,
-	0xd5, 0xa2, 0x8d, 0xdb, 0x66, 0xa3, 0xa6, 0x3b, 0x96, 0xcd, 0x3f, 0xe7, 0xe9, 0xe7, 0x28, 0xac,
-	0xfa, 0x55, 0x16, 0xf2, 0x65, 0xc7, 0xb2, 0xf5, 0x3a, 0x7e, 0x64, 0x19, 0x78, 0x8f, 0xaf, 0xa2,
-	0x4f, 0x60, 0x92, 0xb0, 0xcf, 0x95, 0x96, 0x65, 0xe0, 0x65, 0x69, 0x5d, 0xba, 0x91, 0xbb, 0xfd,
-	0x66, 0x81, 0xab, 0xeb, 0x91, 0x2a, 0xa4, 0xe0, 0x6d, 0x63, 0x52, 0xb3, 0x1b, 0x6d, 0xc7, 0xb2,
-	0x4b, 0x93, 0xcf, 0x5d, 0x25, 0xf3, 0xc2, 0x55, 0xa4, 0xae, 0xab, 0x64, 0xb4, 0x1c, 0x09, 0x81,
-	0x51, 0x19, 0x72, 0x35, 0x1b, 0xeb, 0x0e, 0xae, 0x78, 0x0a, 0x2f, 0x67, 0x29, 0x6d, 0xb9, 0xc0,
-	0x94, 0x2d, 0xf8, 0xca, 0x16, 0x9e, 0xf8, 0xd6, 0x28, 0x2d, 0x7a, 0xb4, 0xba, 0xae, 0x02, 0x0c,
-	0xcd, 0x5b, 0xf8, 0xe2, 0x6b, 0x45, 0xd2, 0x84, 0x77, 0xd4, 0x80, 0xbc, 0xa9, 0x13, 0xa7, 0xf2,
-	0x0c, 0xeb, 0xb6, 0x53, 0xc5, 0xba, 0xc3, 0x88, 0x0f, 0x0d, 0x24, 0xbe, 0xca, 0x89, 0xcf, 0x79,
-	0xe8, 0x0f, 0x7c, 0xec, 0x80, 0x47, 0xf2, 0xf3, 0xdd, 0xe1, 0xff, 0x7c, 0xa9, 0x48, 0xea, 0xef,
-	0x25, 0x58, 0xd8, 0xc1, 0x8e, 0x60, 0x05, 0x0d, 0xff, 0xa2, 0x83, 0x89, 0x83, 0x4c, 0x98, 0x11,
-	0x8d, 0x57, 0x69, 0x18, 0xd4, 0x7e, 0x23, 0xa5, 0xed, 0x13, 0x57, 0x99, 0x12, 0x10, 0x1e, 0x6e,
-	0x7f, 0xe3, 0x2a, 0x45, 0x21, 0x68, 0x0e, 0xf5, 0x43, 0xdd, 0x2a, 0x32, 0x23, 0x17, 0xdb, 0x87,
-	0xf5, 0xa2, 0x73, 0xdc, 0xc6, 0xa4, 0x10, 0x41, 0xd1, 0xa6, 0x04, 0x5b, 0x3e, 0x34, 0x54, 0x0b,
-	0x16, 0xe3, 0x62, 0x90, 0xb6, 0xd5, 0x22, 0x18, 0xed, 0xa7, 0x3a, 0xf1, 0x5a, 0x41, 0x8c, 0xd9,
-	0x34, 0x2f, 0x96, 0x66, 0xba, 0xae, 0x22, 0x7a, 0x2c, 0xe2, 0x3e, 0xf5, 0x0a, 0x2c, 0xed, 0x36,
-	0x88, 0xc8, 0x91, 0x70, 0xcd, 0xd5, 0xcf, 0x61, 0x39, 0xb9, 0xc4, 0xa5, 0xf9, 0x19, 0x4c, 0x89,
-	0xd2, 0x90, 0x65, 0x69, 0x7d, 0xe8, 0x74, 0xe2, 0xcc, 0x73, 0x0f, 0x4d, 0x12, 0x91, 0x6e, 0xe4,
-	0x4d, 0x7d, 0x0a, 0x0b, 0x5b, 0x86, 0x91, 0xe2, 0x8c, 0xfb, 0xa9, 0x46, 0xb8, 0x1a, 0x70, 0xe5,
-	0x49, 0x24, 0x32, 0x2e, 0x0d, 0x3f, 0x8f, 0xc7, 0xac, 0x67, 0xe5, 0x38, 0xfd, 0x8b, 0xb5, 0xf2,
-	0x1f, 0x25, 0xb8, 0xba, 0xdf, 0xb2, 0x71, 0xbd, 0x41, 0x1c, 0x6c, 0xbf, 0xf6, 0x28, 0x53, 0x60,
-	0xb5, 0x87, 0x34, 0xcc, 0x0c, 0xea, 0x01, 0xcc, 0xec, 0x60, 0xe7, 0x89, 0xd5, 0x6e, 0xd4, 0x7c,
-	0x09, 0xcb, 0x30, 0xee, 0x78, 0xef, 0xa1, 0x68, 0x77, 0x4e, 0x5c, 0x65, 0x8c, 0xc2, 0x50, 0xa1,
-	0x6e, 0x0e, 0x16, 0x8a, 0x03, 0x6b, 0x63, 0x94, 0xd2, 0x43, 0x43, 0xdd, 0x87, 0xd9, 0x90, 0x0f,
-	0x77, 0xc1, 0x16, 0x8c, 0xd0, 0x65, 0x6e, 0xfb, 0xf5, 0x84, 0x73, 0x29, 0xb8, 0x50, 0x9c, 0x26,
-	0xba, 0xae, 0xc2, 0x50, 0x34, 0xf6, 0xa3, 0x1e, 0xc2, 0x3c, 0x5b, 0xaf, 0xe2, 0x8b, 0xd7, 0xe1,
-	0x4f, 0x12, 0x2c, 0xc4, 0xb8, 0x71, 0x4d, 0x7e, 0x74, 0x56, 0x4d, 0x58, 0xa8, 0x32, 0x24, 0xf4,
-	0x01, 0xe4, 0x4c, 0xab, 0x5e, 0x21, 0x8e, 0x8d, 0xf5, 0x26, 0x59, 0xce, 0xd2, 0x04, 0xdb, 0x4c,
-	0xd0, 0xd8, 0xb5, 0xea, 0x65, 0x0a, 0x92, 0xa0, 0x03, 0xa6, 0xbf, 0x44, 0xd4, 0x3c, 0xcc, 0x79,
-	0xb9, 0x4c, 0x19, 0x06, 0x09, 0xfe, 0x14, 0x90, 0xf8, 0x91, 0x4b, 0xfd, 0x00, 0x46, 0xa9, 0x00,
-	0x7e, 0x4e, 0x0f, 0x16, 0x7b, 0x9a, 0xa7, 0x34, 0xc7, 0xd3, 0xf8, 0xaf, 0x3a, 0x07, 0x33, 0x5b,
-	0x86, 0x21, 0x7a, 0xc0, 0x73, 0x78, 0xf8, 0xe9, 0xd5, 0x39, 0xbc, 0x09, 0x8b, 0x61, 0x40, 0x5f,
-	0xbc, 0xcb, 0xaf, 0xc0, 0x52, 0x82, 0x1d, 0xcf, 0x9c, 0x17, 0x12, 0xe4, 0x77, 0xb0, 0x13, 0x78,
-	0xe5, 0x22, 0xe5, 0x40, 0x06, 0x4c, 0x85, 0x21, 0xe2, 0x51, 0xce, 0x52, 0xca, 0xef, 0x9d, 0xb8,
-	0x4a, 0x2e, 0x90, 0x80, 0x52, 0x7f, 0x6b, 0x30, 0x75, 0x01, 0x41, 0xcb, 0x05, 0xa1, 0xf3, 0xd0,
-	0x50, 0x7f, 0x0e, 0xf3, 0x51, 0x8d, 0xb8, 0xdf, 0x34, 0x80, 0x90, 0x3b, 0x77, 0xde, 0xe9, 0xe2,
-	0x73, 0xaa, 0xeb, 0x2a, 0x13, 0x01, 0x0b, 0x2d, 0x7c, 0x54, 0x4d, 0x58, 0xf0, 0x42, 0x32, 0x40,
-	0x22, 0x17, 0xea, 0x47, 0x02, 0x8b, 0x71, 0x6e, 0x5c, 0xb7, 0x8f, 0xa2, 0xc9, 0x27, 0x9d, 0x21,
-	0xf9, 0x90, 0xdf, 0xdf, 0x84, 0xe9, 0x17, 0x49, 0xc5, 0xbf, 0x48, 0x90, 0xdf, 0x32, 0x8c, 0xff,
-	0x4f, 0x84, 0x6c, 0xc3, 0x38, 0xef, 0x1d, 0xfd, 0x0a, 0xa2, 0x26, 0x94, 0xd0, 0x18, 0x40, 0xac,
-	0x7e, 0x48, 0x5a, 0x80, 0xa9, 0x7e, 0x02, 0xf3, 0x51, 0x89, 0xb9, 0x95, 0xee, 0x9d, 0x37, 0x02,
-	0x44, 0x97, 0xff, 0x37, 0x0b, 0x8b, 0xfb, 0x6d, 0x43, 0x77, 0xf0, 0x25, 0x4a, 0x1a, 0xf4, 0x18,
-	0xa6, 0xdb, 0x56, 0xbb, 0x8d, 0x8d, 0x0a, 0xb7, 0x22, 0x6f, 0x5e, 0x4f, 0x6b, 0xfe, 0x8c, 0x36,
-	0xc5, 0xf0, 0xf9, 0x32, 0x25, 0xd8, 0x21, 0xcf, 0x04, 0x82, 0xc3, 0x67, 0x26, 0x48, 0xf1, 0xf9,
-	0xb2, 0xfa, 0x14, 0x96, 0x12, 0x66, 0x7f, 0x95, 0x7e, 0xfd, 0x87, 0x04, 0x72, 0x58, 0x25, 0x2f,
-	0x53, 0x41, 0x5c, 0x85, 0x95, 0x54, 0xc5, 0xf8, 0x16, 0xf0, 0x3c, 0x0b, 0xab, 0x1a, 0x6e, 0x5a,
-	0x47, 0xa2, 0x65, 0xa9, 0xcd, 0x5f, 0x4b, 0xb7, 0x17, 0xb1, 0x74, 0xf6, 0xc2, 0x2c, 0x3d, 0x74,
-	0x11, 0x96, 0x5e, 0x87, 0xb5, 0x5e, 0x96, 0xf4, 0x8d, 0x2d, 0x41, 0xae, 0x8c, 0x75, 0xf3, 0x12,
-	0x84, 0xd5, 0xbf, 0x24, 0x98, 0x64, 0xaa, 0xf0, 0x34, 0x34, 0xd2, 0x36, 0xa1, 0x62, 0xe4, 0xd8,
-	0x1e, 0xb7, 0x4b, 0xca, 0xd9, 0x7d, 0xc0, 0x7e, 0x84, 0xea, 0x90, 0x23, 0x58, 0x37, 0xb1, 0x51,
-	0xa9, 0x9b, 0xa4, 0x45, 0x55, 0x1b, 0x2e, 0xbd, 0x7f, 0xe2, 0x2a, 0x50, 0xa6, 0x9f, 0x77, 0x76,
-	0xcb, 0x8f, 0x3c, 0x74, 0x12, 0xbc, 0x7d, 0xe3, 0x2a, 0xd7, 0x07, 0xeb, 0xe9, 0x41, 0x6a, 0x3e,
-	0x96, 0x49, 0x5a, 0xea, 0xdf, 0x24, 0x98, 0xda, 0x6f, 0x91, 0xcb, 0xe1, 0x2c, 0x03, 0xa6, 0x7d,
-	0x5d, 0x2e, 0xb0, 0x1d, 0xfa, 0x6a, 0x08, 0x72, 0xe5, 0xe3, 0x56, 0xed, 0x12, 0x6c, 0x88, 0x47,
-	0x90, 0x27, 0x76, 0xad, 0x12, 0xaf, 0x7b, 0xac, 0x6c, 0xec, 0x9c, 0xb8, 0xca, 0x6c, 0xd9, 0xae,
-	0x7d, 0xeb, 0xd2, 0x37, 0x4b, 0xa2, 0x44, 0x28, 0x5f, 0x83, 0x38, 0x09, 0xbe, 0xc3, 0x21, 0xdf,
-	0xed, 0xc8, 0x8c, 0xe3, 0x9c, 0x7c, 0x8d, 0x28, 0x11, 0x43, 0x7d, 0x17, 0x26, 0x99, 0xe7, 0x78,
-	0x78, 0x14, 0x61, 0x94, 0x38, 0xba, 0xd3, 0x21, 0x3c, 0x34, 0x96, 0xa2, 0xe3, 0xb7, 0xe3, 0x56,
-	0xad, 0x4c, 0x97, 0x35, 0x0e, 0xa6, 0xfe, 0x5d, 0x82, 0xdc, 0x13, 0xbb, 0x11, 0x6c, 0x98, 0x4f,
-	0x13, 0xbe, 0xbf, 0x27, 0xf8, 0xbe, 0xeb, 0x2a, 0xbe, 0x43, 0xcf, 0x19, 0x06, 0x15, 0x98, 0xa0,
-	0x33, 0x37, 0xa1, 0x0a, 0x94, 0x4e, 0x5c, 0x65, 0x7c, 0x57, 0x27, 0x0e, 0xaf, 0x01, 0xe3, 0x26,
-	0x7f, 0x3e, 0x43, 0x05, 0x60, 0x38, 0x5e, 0xfe, 0xff, 0x39, 0x0b, 0xc0, 0x14, 0x22, 0x1d, 0xd3,
-	0x41, 0xbf, 0xea, 0xb5, 0x09, 0xee, 0x27, 0x36, 0xc1, 0xae, 0xab, 0x44, 0xf7, 0xb4, 0x57, 0xb0,
-	0x2b, 0x92, 0xf4, 0xa8, 0x7f, 0x1c, 0x8b, 0xfa, 0xae, 0xab, 0x88, 0x61, 0xfc, 0x2d, 0x93, 0xe0,
-	0x26, 0x8c, 0x60, 0xdb, 0xb6, 0x6c, 0x1a, 0xf6, 0x13, 0xa5, 0x7c, 0xd7, 0x55, 0x66, 0xe8, 0x87,
-	0x5b, 0x56, 0xb3, 0xe1, 0xd0, 0x81, 0xb0, 0xc6, 0x20, 0xd4, 0x07, 0x30, 0xc9, 0x8d, 0xc5, 0xe2,
-	0xe7, 0x0e, 0x8c, 0xd9, 0xd4, 0x70, 0xfe, 0x46, 0xb0, 0x1c, 0x1d, 0x4a, 0x85, 0x96, 0xe5, 0xed,
-	0x9e, 0x0f, 0xae, 0x12, 0x58, 0xdf, 0xc1, 0x8e, 0xbf, 0x33, 0x68, 0xb8, 0x6d, 0x91, 0x86, 0x63,
-	0xd9, 0xc7, 0xe2, 0xfc, 0xe9, 0x31, 0x8c, 0x89, 0x4e, 0x18, 0x2e, 0xfd, 0xe0, 0xc4, 0x55, 0x46,
-	0x83, 0x7c, 0xb8, 0x31, 0x58, 0x67, 0x6e, 0xe5, 0xd1, 0x16, 0x0b, 0xff, 0xcf, 0xe0, 0x5a, 0x1f,
-	0xa6, 0x5c, 0xa7, 0x1f, 0xc2, 0xb0, 0x30, 0x65, 0xfb, 0x4e, 0xa2, 0x58, 0xf6, 0x40, 0xa7, 0x48,
-	0xea, 0x26, 0xa8, 0xde, 0xe1, 0x2d, 0x1d, 0x26, 0x98, 0x71, 0x10, 0xd8, 0xe8, 0x0b, 0xc5, 0x25,
-	0xd9, 0x85, 0x11, 0x71, 0x8e, 0x79, 0x5a, 0x51, 0x4a, 0x53, 0x7c, 0x73, 0x65, 0xd8, 0x1a, 0xfb,
-	0x51, 0xff, 0x9d, 0xa5, 0x47, 0xe6, 0x3d, 0x6d, 0x0f, 0x37, 0xab, 0xd8, 0x0e, 0xd9, 0x6c, 0xc3,
-	0xa8, 0x89, 0x75, 0x03, 0xdb, 0xdc, 0xca, 0xb7, 0xce, 0x66, 0x5b, 0x86, 0x8b, 0x1e, 0x01, 0xf2,
-	0x2f, 0x04, 0x1a, 0x56, 0xab, 0x72, 0xa0, 0xd7, 0x1c, 0xcb, 0xe6, 0xf1, 0xab, 0x74, 0x5d, 0x65,
-	0x45, 0x58, 0x7d, 0x9f, 0x2e, 0x0a, 0xe1, 0x35, 0x97, 0x58, 0x44, 0xbf, 0x84, 0xb1, 0x26, 0x13,
-	0x74, 0x79, 0x28, 0xda, 0x63, 0xb0, 0xd0, 0x4a, 0x53, 0xa5, 0xc0, 0xdf, 0xef, 0xb7, 0x1c, 0xfb,
-	0xb8, 0x74, 0xeb, 0xb7, 0x5f, 0x9f, 0x41, 0x0f, 0x9f, 0x9b, 0x7c, 0x17, 0x26, 0x45, 0x32, 0x68,
-	0x16, 0x86, 0x0e, 0xf1, 0x31, 0xb3, 0x8d, 0xe6, 0x3d, 0xa2, 0x79, 0x18, 0x39, 0xd2, 0xcd, 0x0e,
-	0xbb, 0x57, 0x98, 0xd0, 0xd8, 0xcb, 0xdd, 0xec, 0x1d, 0x49, 0xb5, 0x61, 0x7d, 0xcb, 0x30, 0xfa,
-	0x47, 0xf5, 0x75, 0x18, 0xb7, 0xf5, 0x03, 0xa7, 0xd2, 0xb1, 0x4d, 0x4a, 0x74, 0xa2, 0x94, 0xf3,
-	0x4a, 0xa6, 0xa6, 0x1f, 0x38, 0xfb, 0xda, 0xae, 0x36, 0xe6, 0x2d, 0xee, 0xdb, 0x26, 0x85, 0x6b,
-	0xd7, 0x2a, 0xba, 0x61, 0x30, 0x33, 0xfa, 0x70, 0x1f, 0xde, 0xdb, 0x32, 0x0c, 0x5b, 0x1b, 0xb3,
-	0xdb, 0x35, 0xef, 0xc1, 0x0b, 0xea, 0x3e, 0x3c, 0x5f, 0x45, 0x50, 0x57, 0xe9, 0x7c, 0x6c, 0x4f,
-	0xfb, 0x10, 0x63, 0xfb, 0xa2, 0xb4, 0xf8, 0x1c, 0xe6, 0x04, 0x1e, 0x5c, 0xea, 0x5a, 0xbc, 0x00,
-	0xfc, 0x38, 0x2c, 0x00, 0x5d, 0x57, 0x99, 0x65, 0x69, 0x1d, 0xc6, 0xd1, 0xb9, 0x8a, 0xc2, 0x6f,
-	0x24, 0xd8, 0xd8, 0xc6, 0x26, 0x76, 0x70, 0x7f, 0xbf, 0x7d, 0x14, 0x17, 0xe6, 0xbd, 0x88, 0x30,
-	0x9c, 0xdc, 0xb9, 0x44, 0xb8, 0x0e, 0x9b, 0xfd, 0x25, 0xe0, 0xe7, 0x8a, 0x77, 0x20, 0xcf, 0x4e,
-	0x1e, 0xe7, 0xf2, 0x85, 0xba, 0x08, 0xf3, 0x51, 0x74, 0x46, 0xf6, 0xf6, 0x5f, 0xf3, 0x30, 0x7d,
-	0xcf, 0xec, 0x78, 0x07, 0xc7, 0x3d, 0xbd, 0xa5, 0xd7, 0xb1, 0x8d, 0x3e, 0x85, 0xe9, 0xe8, 0x95,
-	0x0f, 0xda, 0x48, 0xa4, 0x5f, 0xf2, 0xc6, 0x40, 0xde, 0xec, 0x0f, 0xc4, 0xd5, 0xc8, 0xa0, 0x1a,
-	0xcc, 0xc6, 0x6f, 0x71, 0xd0, 0x1b, 0x51, 0xdc, 0x1e, 0x17, 0x40, 0xf2, 0xf5, 0x41, 0x60, 0x01,
-	0x93, 0x4f, 0x61, 0x3a, 0x7a, 0xa1, 0x12, 0xd7, 0x21, 0xf5, 0x3a, 0x27, 0xae, 0x43, 0xfa, 0x9d,
-	0x8c, 0x9a, 0x41, 0x36, 0x2c, 0xa4, 0xde, 0x57, 0xa0, 0x37, 0xa3, 0x04, 0xfa, 0x5d, 0xb1, 0xc8,
-	0xdf, 0x3d, 0x15, 0x6c, 0xc0, 0xf3, 0x03, 0x18, 0xf7, 0xaf, 0x26, 0xd0, 0x6a, 0xc2, 0xd6, 0xe2,
-	0x8c, 0x59, 0x5e, 0xeb, 0xb5, 0x1c, 0x10, 0xfb, 0x18, 0xa6, 0x22, 0x57, 0x04, 0x48, 0x8d, 0xa2,
-	0xa4, 0xdd, 0x56, 0xc8, 0x1b, 0x7d, 0x61, 0x02, 0xda, 0x3f, 0x01, 0x08, 0xa7, 0xf8, 0x48, 0x49,
-	0xfa, 0x2c, 0x32, 0xf4, 0x97, 0xd7, 0x7b, 0x03, 0x88, 0xba, 0xfb, 0x53, 0xfa, 0xb8, 0xee, 0xb1,
-	0x81, 0x7e, 0x5c, 0xf7, 0xf8, 0x70, 0x5f, 0xcd, 0xa0, 0xcf, 0x60, 0x26, 0x36, 0x2c, 0x47, 0x9b,
-	0xbd, 0x5c, 0x11, 0x21, 0xfd, 0xc6, 0x00, 0xa8, 0x80, 0xc3, 0x4f, 0x61, 0x52, 0x1c, 0x50, 0xa3,
-	0x6b, 0x09, 0x7f, 0xc4, 0xa7, 0x4f, 0xb2, 0xda, 0x0f, 0x44, 0x0c, 0xeb, 0xe8, 0x7c, 0x38, 0x1e,
-	0xd6, 0xa9, 0xb3, 0xea, 0x78, 0x58, 0xa7, 0x8f, 0x98, 0x99, 0xdc, 0xe2, 0x58, 0x35, 0x2e, 0x77,
-	0xca, 0x90, 0x38, 0x2e, 0x77, 0xda, 0x54, 0x96, 0x9b, 0x3c, 0x3a, 0xda, 0x4b, 0x98, 0x3c, 0x75,
-	0xe0, 0x9a, 0x30, 0x79, 0xfa, 0x7c, 0x50, 0xcd, 0x20, 0x13, 0xf2, 0x29, 0x23, 0x30, 0x74, 0xa3,
-	0x97, 0xcb, 0x12, 0x9c, 0x6e, 0x9e, 0x02, 0x32, 0xe0, 0xd6, 0x81, 0xc5, 0xf4, 0x31, 0x10, 0x8a,
-	0x25, 0x75, 0xdf, 0xb1, 0x9b, 0x7c, 0xeb, 0x74, 0xc0, 0x01, 0xdb, 0x77, 0x61, 0xb8, 0x8c, 0x75,
-	0x13, 0x5d, 0x89, 0x5d, 0xff, 0x86, 0x13, 0x0c, 0x59, 0x4e, 0x5b, 0x0a, 0x08, 0xdc, 0x87, 0x51,
-	0x36, 0x24, 0x40, 0x2b, 0x71, 0x75, 0x85, 0x31, 0x88, 0x7c, 0x35, 0x7d, 0x31, 0x22, 0xc7, 0x71,
-	0xab, 0x96, 0x90, 0x23, 0x1c, 0x0c, 0x24, 0xe4, 0x10, 0x4e, 0x9e, 0x8c, 0x80, 0x77, 0x3c, 0x88,
-	0x13, 0x10, 0x4e, 0x97, 0x71, 0x02, 0xe2, 0xd1, 0x43, 0xcd, 0xa0, 0x5f, 0xc3, 0x95, 0x9e, 0xdd,
-	0x3c, 0x2a, 0x24, 0xbb, 0xc5, 0x7e, 0xbb, 0xbb, 0x5c, 0x3c, 0x35, 0x7c, 0xc0, 0xff, 0x77, 0x12,
-	0xac, 0xf4, 0x69, 0xe3, 0xd1, 0xf7, 0x92, 0x19, 0xd7, 0xff, 0x5c, 0x20, 0xbf, 0x7d, 0x06, 0x8c,
-	0x40, 0x8c, 0x5d, 0x5a, 0x68, 0x82, 0x5e, 0x18, 0x2d, 0x26, 0xfe, 0x89, 0x72, 0xdf, 0xeb, 0x8d,
-	0x52, 0xaa, 0x4b, 0xa2, 0x7f, 0x66, 0x46, 0xed, 0xd9, 0x4d, 0xc6, 0x8d, 0x3a, 0xa8, 0xd5, 0x8d,
-	0x1b, 0x75, 0x60, 0x9b, 0xaa, 0x66, 0xd0, 0x23, 0x98, 0x08, 0xfa, 0x40, 0x94, 0xac, 0xe3, 0x91,
-	0xc6, 0x47, 0x56, 0x7a, 0xae, 0x07, 0xf4, 0xfe, 0x20, 0xc1, 0xd5, 0x7e, 0xbd, 0x15, 0x7a, 0x3b,
-	0xbe, 0xa1, 0x0d, 0xec, 0x04, 0xe5, 0xdb, 0x67, 0x41, 0x11, 0x0b, 0xab, 0xd8, 0x7d, 0xc5, 0x0b,
-	0x6b, 0x4a, 0x63, 0x17, 0x77, 0x59, 0x5a, 0xf3, 0xa6, 0x66, 0x4a, 0xef, 0x3c, 0x3f, 0x59, 0x93,
-	0x5e, 0x9c, 0xac, 0x49, 0x5f, 0xbc, 0x5c, 0xcb, 0x7c, 0xf9, 0x72, 0x4d, 0x7a, 0xf1, 0x72, 0x2d,
-	0xf3, 0xcf, 0x97, 0x6b, 0x99, 0x8f, 0x37, 0x7a, 0x76, 0xa2, 0xe1, 0x9f, 0xcd, 0xaa, 0xa3, 0xf4,
-	0xe5, 0xfb, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x93, 0x47, 0x81, 0x82, 0x26, 0x00, 0x00,
+func (m *CancelOperationResponse) Reset()         { *m = CancelOperationResponse{} }
+func (m *CancelOperationResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelOperationResponse) ProtoMessage()    {}
+func (*CancelOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{52}
+}
+func (m *CancelOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelOperationResponse.Merge(m, src)
+}
+func (m *CancelOperationResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CancelOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelOperationResponse.DiscardUnknown(m)
 }
 
-func (this *StorageNodeMetadata) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+var xxx_messageInfo_CancelOperationResponse proto.InternalMessageInfo
+
+func (m *CancelOperationResponse) GetOperation() *Operation {
+	if m != nil {
+		return m.Operation
 	}
+	return nil
+}
 
-	that1, ok := that.(*StorageNodeMetadata)
-	if !ok {
-		that2, ok := that.(StorageNodeMetadata)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type WatchEventsRequest struct {
+	// AfterEventID is the cursor to resume watching. Only events whose
+	// identifiers are greater than it are sent. If it is zero, all events kept
+	// by the admin server are sent.
+	AfterEventID uint64 `protobuf:"varint,1,opt,name=after_event_id,json=afterEventId,proto3" json:"after_event_id,omitempty"`
+	// Follow makes the stream wait for new events. Otherwise, the stream
+	// finishes after sending events kept by the admin server.
+	Follow bool `protobuf:"varint,2,opt,name=follow,proto3" json:"follow,omitempty"`
+}
+
+func (m *WatchEventsRequest) Reset()         { *m = WatchEventsRequest{} }
+func (m *WatchEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchEventsRequest) ProtoMessage()    {}
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{53}
+}
+func (m *WatchEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.StorageNodeMetadataDescriptor.Equal(&that1.StorageNodeMetadataDescriptor) {
-		return false
-	}
-	if !this.CreateTime.Equal(that1.CreateTime) {
-		return false
-	}
-	if !this.LastHeartbeatTime.Equal(that1.LastHeartbeatTime) {
-		return false
+}
+func (m *WatchEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEventsRequest.Merge(m, src)
+}
+func (m *WatchEventsRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *WatchEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchEventsRequest proto.InternalMessageInfo
+
+func (m *WatchEventsRequest) GetAfterEventID() uint64 {
+	if m != nil {
+		return m.AfterEventID
 	}
-	return true
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *WatchEventsRequest) GetFollow() bool {
+	if m != nil {
+		return m.Follow
+	}
+	return false
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type WatchEventsResponse struct {
+	Event Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event"`
+	// Truncated is true if some events after the cursor were discarded
+	// before being sent since the admin server keeps a limited number of
+	// events, or if the cursor was issued by another admin server, for
+	// instance, before the restart or the change of the leader. It can be set
+	// only in the first response of the stream.
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
 
-// ClusterManagerClient is the client API for ClusterManager service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ClusterManagerClient interface {
-	// GetStorageNode returns the metadata of storage node requested.
-	// It returns NotFound if the storage node does not exist.
-	GetStorageNode(ctx context.Context, in *GetStorageNodeRequest, opts ...grpc.CallOption) (*GetStorageNodeResponse, error)
-	// ListStorageNodes returns a list of storage nodes in the cluster.
-	ListStorageNodes(ctx context.Context, in *ListStorageNodesRequest, opts ...grpc.CallOption) (*ListStorageNodesResponse, error)
-	// AddStorageNode adds a new storage node to the cluster.
-	// It is idempotent, that is, adding an already added storage node is okay.
-	AddStorageNode(ctx context.Context, in *AddStorageNodeRequest, opts ...grpc.CallOption) (*AddStorageNodeResponse, error)
-	// UnregisterStorageNode unregisters the storage node specified by the
-	// request.
-	UnregisterStorageNode(ctx context.Context, in *UnregisterStorageNodeRequest, opts ...grpc.CallOption) (*UnregisterStorageNodeResponse, error)
-	// GetTopic returns the topic specified by the request.
-	GetTopic(ctx context.Context, in *GetTopicRequest, opts ...grpc.CallOption) (*GetTopicResponse, error)
-	// DescribeTopic returns the topic specified by the request.
-	// Deprecated: Use GetTopic.
-	DescribeTopic(ctx context.Context, in *DescribeTopicRequest, opts ...grpc.CallOption) (*DescribeTopicResponse, error)
-	// ListTopics returns a list of topics in the cluster.
-	ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error)
-	// AddTopic adds a new topic and returns its metadata.
-	AddTopic(ctx context.Context, in *AddTopicRequest, opts ...grpc.CallOption) (*AddTopicResponse, error)
-	UnregisterTopic(ctx context.Context, in *UnregisterTopicRequest, opts ...grpc.CallOption) (*UnregisterTopicResponse, error)
-	GetLogStream(ctx context.Context, in *GetLogStreamRequest, opts ...grpc.CallOption) (*GetLogStreamResponse, error)
-	ListLogStreams(ctx context.Context, in *ListLogStreamsRequest, opts ...grpc.CallOption) (*ListLogStreamsResponse, error)
-	AddLogStream(ctx context.Context, in *AddLogStreamRequest, opts ...grpc.CallOption) (*AddLogStreamResponse, error)
-	// UpdateLogStream changes the configuration of replicas in a log stream.
-	// Its codes are defines as followings:
-	// - InvalidArgument: The client tries to swap the same replica.
-	// - Unavailable: The cluster metadata cannot be fetched from the metadata
-	// repository transiently.
-	// - NotFound: The target log stream does not exist.
-	// - FailedPrecondition: Either the target log stream is not sealed, the
-	// target log stream doesn't have a victim replica, or the target log stream
-	// already has both victim and new replica. Note that clients should not retry
-	// without any action.
-	// - Unknown: Either storage node or metadata repository returns an error.
-	//
-	// TODO: Moving the data directory within the same node is not supported yet.
-	// TODO: We will define codes for errors returned from storage nodes and
-	// metadata repository soon.
-	UpdateLogStream(ctx context.Context, in *UpdateLogStreamRequest, opts ...grpc.CallOption) (*UpdateLogStreamResponse, error)
-	UnregisterLogStream(ctx context.Context, in *UnregisterLogStreamRequest, opts ...grpc.CallOption) (*UnregisterLogStreamResponse, error)
-	RemoveLogStreamReplica(ctx context.Context, in *RemoveLogStreamReplicaRequest, opts ...grpc.CallOption) (*RemoveLogStreamReplicaResponse, error)
-	Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error)
-	Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error)
-	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error)
-	Trim(ctx context.Context, in *TrimRequest, opts ...grpc.CallOption) (*TrimResponse, error)
-	GetMetadataRepositoryNode(ctx context.Context, in *GetMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*GetMetadataRepositoryNodeResponse, error)
-	ListMetadataRepositoryNodes(ctx context.Context, in *ListMetadataRepositoryNodesRequest, opts ...grpc.CallOption) (*ListMetadataRepositoryNodesResponse, error)
-	GetMRMembers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GetMRMembersResponse, error)
-	AddMetadataRepositoryNode(ctx context.Context, in *AddMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*AddMetadataRepositoryNodeResponse, error)
-	AddMRPeer(ctx context.Context, in *AddMRPeerRequest, opts ...grpc.CallOption) (*AddMRPeerResponse, error)
-	DeleteMetadataRepositoryNode(ctx context.Context, in *DeleteMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*DeleteMetadataRepositoryNodeResponse, error)
-	RemoveMRPeer(ctx context.Context, in *RemoveMRPeerRequest, opts ...grpc.CallOption) (*RemoveMRPeerResponse, error)
-}
-
-type clusterManagerClient struct {
-	cc *grpc.ClientConn
+func (m *WatchEventsResponse) Reset()         { *m = WatchEventsResponse{} }
+func (m *WatchEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchEventsResponse) ProtoMessage()    {}
+func (*WatchEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{54}
 }
-
-func NewClusterManagerClient(cc *grpc.ClientConn) ClusterManagerClient {
-	return &clusterManagerClient{cc}
+func (m *WatchEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *clusterManagerClient) GetStorageNode(ctx context.Context, in *GetStorageNodeRequest, opts ...grpc.CallOption) (*GetStorageNodeResponse, error) {
-	out := new(GetStorageNodeResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/GetStorageNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *WatchEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *clusterManagerClient) ListStorageNodes(ctx context.Context, in *ListStorageNodesRequest, opts ...grpc.CallOption) (*ListStorageNodesResponse, error) {
-	out := new(ListStorageNodesResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/ListStorageNodes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *WatchEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEventsResponse.Merge(m, src)
 }
-
-func (c *clusterManagerClient) AddStorageNode(ctx context.Context, in *AddStorageNodeRequest, opts ...grpc.CallOption) (*AddStorageNodeResponse, error) {
-	out := new(AddStorageNodeResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/AddStorageNode", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *WatchEventsResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *WatchEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEventsResponse.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) UnregisterStorageNode(ctx context.Context, in *UnregisterStorageNodeRequest, opts ...grpc.CallOption) (*UnregisterStorageNodeResponse, error) {
-	out := new(UnregisterStorageNodeResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/UnregisterStorageNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_WatchEventsResponse proto.InternalMessageInfo
+
+func (m *WatchEventsResponse) GetEvent() Event {
+	if m != nil {
+		return m.Event
 	}
-	return out, nil
+	return Event{}
 }
 
-func (c *clusterManagerClient) GetTopic(ctx context.Context, in *GetTopicRequest, opts ...grpc.CallOption) (*GetTopicResponse, error) {
-	out := new(GetTopicResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/GetTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *WatchEventsResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
 	}
-	return out, nil
+	return false
 }
 
-func (c *clusterManagerClient) DescribeTopic(ctx context.Context, in *DescribeTopicRequest, opts ...grpc.CallOption) (*DescribeTopicResponse, error) {
-	out := new(DescribeTopicResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/DescribeTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type TrimRequest struct {
+	TopicID  github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topicId"`
+	LastGLSN github_com_kakao_varlog_pkg_types.GLSN    `protobuf:"varint,2,opt,name=last_glsn,json=lastGlsn,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"lastGLSN"`
 }
 
-func (c *clusterManagerClient) ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error) {
-	out := new(ListTopicsResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/ListTopics", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TrimRequest) Reset()         { *m = TrimRequest{} }
+func (m *TrimRequest) String() string { return proto.CompactTextString(m) }
+func (*TrimRequest) ProtoMessage()    {}
+func (*TrimRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{55}
+}
+func (m *TrimRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrimRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrimRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TrimRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrimRequest.Merge(m, src)
+}
+func (m *TrimRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TrimRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrimRequest.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) AddTopic(ctx context.Context, in *AddTopicRequest, opts ...grpc.CallOption) (*AddTopicResponse, error) {
-	out := new(AddTopicResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/AddTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TrimRequest proto.InternalMessageInfo
+
+func (m *TrimRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *clusterManagerClient) UnregisterTopic(ctx context.Context, in *UnregisterTopicRequest, opts ...grpc.CallOption) (*UnregisterTopicResponse, error) {
-	out := new(UnregisterTopicResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/UnregisterTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TrimRequest) GetLastGLSN() github_com_kakao_varlog_pkg_types.GLSN {
+	if m != nil {
+		return m.LastGLSN
 	}
-	return out, nil
+	return 0
 }
 
-func (c *clusterManagerClient) GetLogStream(ctx context.Context, in *GetLogStreamRequest, opts ...grpc.CallOption) (*GetLogStreamResponse, error) {
-	out := new(GetLogStreamResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/GetLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type TrimResult struct {
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,1,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storageNodeId"`
+	LogStreamID   github_com_kakao_varlog_pkg_types.LogStreamID   `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"logStreamId"`
+	Error         string                                          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (c *clusterManagerClient) ListLogStreams(ctx context.Context, in *ListLogStreamsRequest, opts ...grpc.CallOption) (*ListLogStreamsResponse, error) {
-	out := new(ListLogStreamsResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/ListLogStreams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TrimResult) Reset()         { *m = TrimResult{} }
+func (m *TrimResult) String() string { return proto.CompactTextString(m) }
+func (*TrimResult) ProtoMessage()    {}
+func (*TrimResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{56}
+}
+func (m *TrimResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrimResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrimResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TrimResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrimResult.Merge(m, src)
+}
+func (m *TrimResult) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TrimResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrimResult.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) AddLogStream(ctx context.Context, in *AddLogStreamRequest, opts ...grpc.CallOption) (*AddLogStreamResponse, error) {
-	out := new(AddLogStreamResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/AddLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TrimResult proto.InternalMessageInfo
+
+func (m *TrimResult) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.StorageNodeID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *clusterManagerClient) UpdateLogStream(ctx context.Context, in *UpdateLogStreamRequest, opts ...grpc.CallOption) (*UpdateLogStreamResponse, error) {
-	out := new(UpdateLogStreamResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/UpdateLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TrimResult) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *clusterManagerClient) UnregisterLogStream(ctx context.Context, in *UnregisterLogStreamRequest, opts ...grpc.CallOption) (*UnregisterLogStreamResponse, error) {
-	out := new(UnregisterLogStreamResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/UnregisterLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TrimResult) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return out, nil
+	return ""
 }
 
-func (c *clusterManagerClient) RemoveLogStreamReplica(ctx context.Context, in *RemoveLogStreamReplicaRequest, opts ...grpc.CallOption) (*RemoveLogStreamReplicaResponse, error) {
-	out := new(RemoveLogStreamReplicaResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/RemoveLogStreamReplica", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type TrimResponse struct {
+	Results []TrimResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-func (c *clusterManagerClient) Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error) {
-	out := new(SealResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/Seal", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TrimResponse) Reset()         { *m = TrimResponse{} }
+func (m *TrimResponse) String() string { return proto.CompactTextString(m) }
+func (*TrimResponse) ProtoMessage()    {}
+func (*TrimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{57}
 }
-
-func (c *clusterManagerClient) Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error) {
-	out := new(UnsealResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/Unseal", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TrimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *clusterManagerClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error) {
-	out := new(SyncResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/Sync", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TrimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TrimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrimResponse.Merge(m, src)
+}
+func (m *TrimResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TrimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrimResponse.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) Trim(ctx context.Context, in *TrimRequest, opts ...grpc.CallOption) (*TrimResponse, error) {
-	out := new(TrimResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/Trim", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TrimResponse proto.InternalMessageInfo
+
+func (m *TrimResponse) GetResults() []TrimResult {
+	if m != nil {
+		return m.Results
 	}
-	return out, nil
+	return nil
 }
 
-func (c *clusterManagerClient) GetMetadataRepositoryNode(ctx context.Context, in *GetMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*GetMetadataRepositoryNodeResponse, error) {
-	out := new(GetMetadataRepositoryNodeResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/GetMetadataRepositoryNode", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type CheckpointLogStreamReplicaRequest struct {
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,1,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
+	TopicID       github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID   github_com_kakao_varlog_pkg_types.LogStreamID   `protobuf:"varint,3,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	// path is an absolute path in the storage node where the checkpoint is
+	// made. It must not exist.
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// archive makes the checkpoint a gzipped tar file.
+	Archive bool `protobuf:"varint,5,opt,name=archive,proto3" json:"archive,omitempty"`
 }
 
-func (c *clusterManagerClient) ListMetadataRepositoryNodes(ctx context.Context, in *ListMetadataRepositoryNodesRequest, opts ...grpc.CallOption) (*ListMetadataRepositoryNodesResponse, error) {
-	out := new(ListMetadataRepositoryNodesResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/ListMetadataRepositoryNodes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CheckpointLogStreamReplicaRequest) Reset()         { *m = CheckpointLogStreamReplicaRequest{} }
+func (m *CheckpointLogStreamReplicaRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckpointLogStreamReplicaRequest) ProtoMessage()    {}
+func (*CheckpointLogStreamReplicaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{58}
+}
+func (m *CheckpointLogStreamReplicaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointLogStreamReplicaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointLogStreamReplicaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *CheckpointLogStreamReplicaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointLogStreamReplicaRequest.Merge(m, src)
+}
+func (m *CheckpointLogStreamReplicaRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CheckpointLogStreamReplicaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointLogStreamReplicaRequest.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) GetMRMembers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GetMRMembersResponse, error) {
-	out := new(GetMRMembersResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/GetMRMembers", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CheckpointLogStreamReplicaRequest proto.InternalMessageInfo
+
+func (m *CheckpointLogStreamReplicaRequest) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.StorageNodeID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *clusterManagerClient) AddMetadataRepositoryNode(ctx context.Context, in *AddMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*AddMetadataRepositoryNodeResponse, error) {
-	out := new(AddMetadataRepositoryNodeResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/AddMetadataRepositoryNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CheckpointLogStreamReplicaRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *clusterManagerClient) AddMRPeer(ctx context.Context, in *AddMRPeerRequest, opts ...grpc.CallOption) (*AddMRPeerResponse, error) {
-	out := new(AddMRPeerResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/AddMRPeer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CheckpointLogStreamReplicaRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *clusterManagerClient) DeleteMetadataRepositoryNode(ctx context.Context, in *DeleteMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*DeleteMetadataRepositoryNodeResponse, error) {
-	out := new(DeleteMetadataRepositoryNodeResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/DeleteMetadataRepositoryNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CheckpointLogStreamReplicaRequest) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	return out, nil
+	return ""
 }
 
-func (c *clusterManagerClient) RemoveMRPeer(ctx context.Context, in *RemoveMRPeerRequest, opts ...grpc.CallOption) (*RemoveMRPeerResponse, error) {
-	out := new(RemoveMRPeerResponse)
-	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/RemoveMRPeer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CheckpointLogStreamReplicaRequest) GetArchive() bool {
+	if m != nil {
+		return m.Archive
 	}
-	return out, nil
+	return false
 }
 
-// ClusterManagerServer is the server API for ClusterManager service.
-type ClusterManagerServer interface {
-	// GetStorageNode returns the metadata of storage node requested.
-	// It returns NotFound if the storage node does not exist.
-	GetStorageNode(context.Context, *GetStorageNodeRequest) (*GetStorageNodeResponse, error)
-	// ListStorageNodes returns a list of storage nodes in the cluster.
-	ListStorageNodes(context.Context, *ListStorageNodesRequest) (*ListStorageNodesResponse, error)
-	// AddStorageNode adds a new storage node to the cluster.
-	// It is idempotent, that is, adding an already added storage node is okay.
-	AddStorageNode(context.Context, *AddStorageNodeRequest) (*AddStorageNodeResponse, error)
-	// UnregisterStorageNode unregisters the storage node specified by the
-	// request.
-	UnregisterStorageNode(context.Context, *UnregisterStorageNodeRequest) (*UnregisterStorageNodeResponse, error)
-	// GetTopic returns the topic specified by the request.
-	GetTopic(context.Context, *GetTopicRequest) (*GetTopicResponse, error)
-	// DescribeTopic returns the topic specified by the request.
-	// Deprecated: Use GetTopic.
-	DescribeTopic(context.Context, *DescribeTopicRequest) (*DescribeTopicResponse, error)
-	// ListTopics returns a list of topics in the cluster.
-	ListTopics(context.Context, *ListTopicsRequest) (*ListTopicsResponse, error)
-	// AddTopic adds a new topic and returns its metadata.
-	AddTopic(context.Context, *AddTopicRequest) (*AddTopicResponse, error)
-	UnregisterTopic(context.Context, *UnregisterTopicRequest) (*UnregisterTopicResponse, error)
-	GetLogStream(context.Context, *GetLogStreamRequest) (*GetLogStreamResponse, error)
-	ListLogStreams(context.Context, *ListLogStreamsRequest) (*ListLogStreamsResponse, error)
-	AddLogStream(context.Context, *AddLogStreamRequest) (*AddLogStreamResponse, error)
-	// UpdateLogStream changes the configuration of replicas in a log stream.
-	// Its codes are defines as followings:
-	// - InvalidArgument: The client tries to swap the same replica.
-	// - Unavailable: The cluster metadata cannot be fetched from the metadata
-	// repository transiently.
-	// - NotFound: The target log stream does not exist.
-	// - FailedPrecondition: Either the target log stream is not sealed, the
-	// target log stream doesn't have a victim replica, or the target log stream
-	// already has both victim and new replica. Note that clients should not retry
-	// without any action.
-	// - Unknown: Either storage node or metadata repository returns an error.
-	//
-	// TODO: Moving the data directory within the same node is not supported yet.
-	// TODO: We will define codes for errors returned from storage nodes and
-	// metadata repository soon.
-	UpdateLogStream(context.Context, *UpdateLogStreamRequest) (*UpdateLogStreamResponse, error)
-	UnregisterLogStream(context.Context, *UnregisterLogStreamRequest) (*UnregisterLogStreamResponse, error)
-	RemoveLogStreamReplica(context.Context, *RemoveLogStreamReplicaRequest) (*RemoveLogStreamReplicaResponse, error)
-	Seal(context.Context, *SealRequest) (*SealResponse, error)
-	Unseal(context.Context, *UnsealRequest) (*UnsealResponse, error)
-	Sync(context.Context, *SyncRequest) (*SyncResponse, error)
-	Trim(context.Context, *TrimRequest) (*TrimResponse, error)
-	GetMetadataRepositoryNode(context.Context, *GetMetadataRepositoryNodeRequest) (*GetMetadataRepositoryNodeResponse, error)
-	ListMetadataRepositoryNodes(context.Context, *ListMetadataRepositoryNodesRequest) (*ListMetadataRepositoryNodesResponse, error)
-	GetMRMembers(context.Context, *types.Empty) (*GetMRMembersResponse, error)
-	AddMetadataRepositoryNode(context.Context, *AddMetadataRepositoryNodeRequest) (*AddMetadataRepositoryNodeResponse, error)
-	AddMRPeer(context.Context, *AddMRPeerRequest) (*AddMRPeerResponse, error)
-	DeleteMetadataRepositoryNode(context.Context, *DeleteMetadataRepositoryNodeRequest) (*DeleteMetadataRepositoryNodeResponse, error)
-	RemoveMRPeer(context.Context, *RemoveMRPeerRequest) (*RemoveMRPeerResponse, error)
+type CheckpointLogStreamReplicaResponse struct {
+	Checkpoint snpb.LogStreamReplicaCheckpoint `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint"`
 }
 
-// UnimplementedClusterManagerServer can be embedded to have forward compatible implementations.
-type UnimplementedClusterManagerServer struct {
+func (m *CheckpointLogStreamReplicaResponse) Reset()         { *m = CheckpointLogStreamReplicaResponse{} }
+func (m *CheckpointLogStreamReplicaResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckpointLogStreamReplicaResponse) ProtoMessage()    {}
+func (*CheckpointLogStreamReplicaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{59}
 }
-
-func (*UnimplementedClusterManagerServer) GetStorageNode(ctx context.Context, req *GetStorageNodeRequest) (*GetStorageNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStorageNode not implemented")
+func (m *CheckpointLogStreamReplicaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedClusterManagerServer) ListStorageNodes(ctx context.Context, req *ListStorageNodesRequest) (*ListStorageNodesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListStorageNodes not implemented")
+func (m *CheckpointLogStreamReplicaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointLogStreamReplicaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedClusterManagerServer) AddStorageNode(ctx context.Context, req *AddStorageNodeRequest) (*AddStorageNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddStorageNode not implemented")
+func (m *CheckpointLogStreamReplicaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointLogStreamReplicaResponse.Merge(m, src)
 }
-func (*UnimplementedClusterManagerServer) UnregisterStorageNode(ctx context.Context, req *UnregisterStorageNodeRequest) (*UnregisterStorageNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterStorageNode not implemented")
+func (m *CheckpointLogStreamReplicaResponse) XXX_Size() int {
+	return m.ProtoSize()
 }
-func (*UnimplementedClusterManagerServer) GetTopic(ctx context.Context, req *GetTopicRequest) (*GetTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTopic not implemented")
+func (m *CheckpointLogStreamReplicaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointLogStreamReplicaResponse.DiscardUnknown(m)
 }
-func (*UnimplementedClusterManagerServer) DescribeTopic(ctx context.Context, req *DescribeTopicRequest) (*DescribeTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DescribeTopic not implemented")
+
+var xxx_messageInfo_CheckpointLogStreamReplicaResponse proto.InternalMessageInfo
+
+func (m *CheckpointLogStreamReplicaResponse) GetCheckpoint() snpb.LogStreamReplicaCheckpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return snpb.LogStreamReplicaCheckpoint{}
 }
-func (*UnimplementedClusterManagerServer) ListTopics(ctx context.Context, req *ListTopicsRequest) (*ListTopicsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTopics not implemented")
+
+type GetMetadataRepositoryNodeRequest struct {
+	NodeID github_com_kakao_varlog_pkg_types.NodeID `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.NodeID" json:"node_id,omitempty"`
 }
-func (*UnimplementedClusterManagerServer) AddTopic(ctx context.Context, req *AddTopicRequest) (*AddTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddTopic not implemented")
+
+func (m *GetMetadataRepositoryNodeRequest) Reset()         { *m = GetMetadataRepositoryNodeRequest{} }
+func (m *GetMetadataRepositoryNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMetadataRepositoryNodeRequest) ProtoMessage()    {}
+func (*GetMetadataRepositoryNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{60}
 }
-func (*UnimplementedClusterManagerServer) UnregisterTopic(ctx context.Context, req *UnregisterTopicRequest) (*UnregisterTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterTopic not implemented")
+func (m *GetMetadataRepositoryNodeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedClusterManagerServer) GetLogStream(ctx context.Context, req *GetLogStreamRequest) (*GetLogStreamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLogStream not implemented")
+func (m *GetMetadataRepositoryNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetMetadataRepositoryNodeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedClusterManagerServer) ListLogStreams(ctx context.Context, req *ListLogStreamsRequest) (*ListLogStreamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListLogStreams not implemented")
+func (m *GetMetadataRepositoryNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetadataRepositoryNodeRequest.Merge(m, src)
 }
-func (*UnimplementedClusterManagerServer) AddLogStream(ctx context.Context, req *AddLogStreamRequest) (*AddLogStreamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddLogStream not implemented")
+func (m *GetMetadataRepositoryNodeRequest) XXX_Size() int {
+	return m.ProtoSize()
 }
-func (*UnimplementedClusterManagerServer) UpdateLogStream(ctx context.Context, req *UpdateLogStreamRequest) (*UpdateLogStreamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateLogStream not implemented")
+func (m *GetMetadataRepositoryNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetadataRepositoryNodeRequest.DiscardUnknown(m)
 }
-func (*UnimplementedClusterManagerServer) UnregisterLogStream(ctx context.Context, req *UnregisterLogStreamRequest) (*UnregisterLogStreamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterLogStream not implemented")
+
+var xxx_messageInfo_GetMetadataRepositoryNodeRequest proto.InternalMessageInfo
+
+func (m *GetMetadataRepositoryNodeRequest) GetNodeID() github_com_kakao_varlog_pkg_types.NodeID {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
 }
-func (*UnimplementedClusterManagerServer) RemoveLogStreamReplica(ctx context.Context, req *RemoveLogStreamReplicaRequest) (*RemoveLogStreamReplicaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveLogStreamReplica not implemented")
+
+type GetMetadataRepositoryNodeResponse struct {
+	Node *varlogpb.MetadataRepositoryNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
 }
-func (*UnimplementedClusterManagerServer) Seal(ctx context.Context, req *SealRequest) (*SealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Seal not implemented")
+
+func (m *GetMetadataRepositoryNodeResponse) Reset()         { *m = GetMetadataRepositoryNodeResponse{} }
+func (m *GetMetadataRepositoryNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMetadataRepositoryNodeResponse) ProtoMessage()    {}
+func (*GetMetadataRepositoryNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{61}
 }
-func (*UnimplementedClusterManagerServer) Unseal(ctx context.Context, req *UnsealRequest) (*UnsealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unseal not implemented")
+func (m *GetMetadataRepositoryNodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedClusterManagerServer) Sync(ctx context.Context, req *SyncRequest) (*SyncResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
+func (m *GetMetadataRepositoryNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetMetadataRepositoryNodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedClusterManagerServer) Trim(ctx context.Context, req *TrimRequest) (*TrimResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Trim not implemented")
+func (m *GetMetadataRepositoryNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetadataRepositoryNodeResponse.Merge(m, src)
 }
-func (*UnimplementedClusterManagerServer) GetMetadataRepositoryNode(ctx context.Context, req *GetMetadataRepositoryNodeRequest) (*GetMetadataRepositoryNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataRepositoryNode not implemented")
+func (m *GetMetadataRepositoryNodeResponse) XXX_Size() int {
+	return m.ProtoSize()
 }
-func (*UnimplementedClusterManagerServer) ListMetadataRepositoryNodes(ctx context.Context, req *ListMetadataRepositoryNodesRequest) (*ListMetadataRepositoryNodesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListMetadataRepositoryNodes not implemented")
+func (m *GetMetadataRepositoryNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetadataRepositoryNodeResponse.DiscardUnknown(m)
 }
-func (*UnimplementedClusterManagerServer) GetMRMembers(ctx context.Context, req *types.Empty) (*GetMRMembersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMRMembers not implemented")
+
+var xxx_messageInfo_GetMetadataRepositoryNodeResponse proto.InternalMessageInfo
+
+func (m *GetMetadataRepositoryNodeResponse) GetNode() *varlogpb.MetadataRepositoryNode {
+	if m != nil {
+		return m.Node
+	}
+	return nil
 }
-func (*UnimplementedClusterManagerServer) AddMetadataRepositoryNode(ctx context.Context, req *AddMetadataRepositoryNodeRequest) (*AddMetadataRepositoryNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMetadataRepositoryNode not implemented")
+
+type ListMetadataRepositoryNodesRequest struct {
 }
-func (*UnimplementedClusterManagerServer) AddMRPeer(ctx context.Context, req *AddMRPeerRequest) (*AddMRPeerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMRPeer not implemented")
+
+func (m *ListMetadataRepositoryNodesRequest) Reset()         { *m = ListMetadataRepositoryNodesRequest{} }
+func (m *ListMetadataRepositoryNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMetadataRepositoryNodesRequest) ProtoMessage()    {}
+func (*ListMetadataRepositoryNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{62}
 }
-func (*UnimplementedClusterManagerServer) DeleteMetadataRepositoryNode(ctx context.Context, req *DeleteMetadataRepositoryNodeRequest) (*DeleteMetadataRepositoryNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteMetadataRepositoryNode not implemented")
+func (m *ListMetadataRepositoryNodesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedClusterManagerServer) RemoveMRPeer(ctx context.Context, req *RemoveMRPeerRequest) (*RemoveMRPeerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveMRPeer not implemented")
+func (m *ListMetadataRepositoryNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListMetadataRepositoryNodesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListMetadataRepositoryNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMetadataRepositoryNodesRequest.Merge(m, src)
+}
+func (m *ListMetadataRepositoryNodesRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ListMetadataRepositoryNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMetadataRepositoryNodesRequest.DiscardUnknown(m)
 }
 
-func RegisterClusterManagerServer(s *grpc.Server, srv ClusterManagerServer) {
-	s.RegisterService(&_ClusterManager_serviceDesc, srv)
+var xxx_messageInfo_ListMetadataRepositoryNodesRequest proto.InternalMessageInfo
+
+type ListMetadataRepositoryNodesResponse struct {
+	Nodes []varlogpb.MetadataRepositoryNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes"`
 }
 
-func _ClusterManager_GetStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStorageNodeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).GetStorageNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/GetStorageNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).GetStorageNode(ctx, req.(*GetStorageNodeRequest))
+func (m *ListMetadataRepositoryNodesResponse) Reset()         { *m = ListMetadataRepositoryNodesResponse{} }
+func (m *ListMetadataRepositoryNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMetadataRepositoryNodesResponse) ProtoMessage()    {}
+func (*ListMetadataRepositoryNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{63}
+}
+func (m *ListMetadataRepositoryNodesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListMetadataRepositoryNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListMetadataRepositoryNodesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ListMetadataRepositoryNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMetadataRepositoryNodesResponse.Merge(m, src)
+}
+func (m *ListMetadataRepositoryNodesResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ListMetadataRepositoryNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMetadataRepositoryNodesResponse.DiscardUnknown(m)
 }
 
-func _ClusterManager_ListStorageNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListStorageNodesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).ListStorageNodes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/ListStorageNodes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).ListStorageNodes(ctx, req.(*ListStorageNodesRequest))
+var xxx_messageInfo_ListMetadataRepositoryNodesResponse proto.InternalMessageInfo
+
+func (m *ListMetadataRepositoryNodesResponse) GetNodes() []varlogpb.MetadataRepositoryNode {
+	if m != nil {
+		return m.Nodes
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ClusterManager_AddStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddStorageNodeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).AddStorageNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/AddStorageNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).AddStorageNode(ctx, req.(*AddStorageNodeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type GetMRMembersResponse struct {
+	Leader            github_com_kakao_varlog_pkg_types.NodeID            `protobuf:"varint,1,opt,name=leader,proto3,casttype=github.com/kakao/varlog/pkg/types.NodeID" json:"leader,omitempty"`
+	ReplicationFactor int32                                               `protobuf:"varint,2,opt,name=replication_factor,json=replicationFactor,proto3" json:"replicationFactor,omitempty"`
+	Members           map[github_com_kakao_varlog_pkg_types.NodeID]string `protobuf:"bytes,3,rep,name=members,proto3,castkey=github.com/kakao/varlog/pkg/types.NodeID" json:"members,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func _ClusterManager_UnregisterStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnregisterStorageNodeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).UnregisterStorageNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/UnregisterStorageNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).UnregisterStorageNode(ctx, req.(*UnregisterStorageNodeRequest))
+func (m *GetMRMembersResponse) Reset()         { *m = GetMRMembersResponse{} }
+func (m *GetMRMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMRMembersResponse) ProtoMessage()    {}
+func (*GetMRMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{64}
+}
+func (m *GetMRMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetMRMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetMRMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GetMRMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMRMembersResponse.Merge(m, src)
+}
+func (m *GetMRMembersResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GetMRMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMRMembersResponse.DiscardUnknown(m)
 }
 
-func _ClusterManager_GetTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).GetTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/GetTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).GetTopic(ctx, req.(*GetTopicRequest))
+var xxx_messageInfo_GetMRMembersResponse proto.InternalMessageInfo
+
+func (m *GetMRMembersResponse) GetLeader() github_com_kakao_varlog_pkg_types.NodeID {
+	if m != nil {
+		return m.Leader
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _ClusterManager_DescribeTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeTopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).DescribeTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/DescribeTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).DescribeTopic(ctx, req.(*DescribeTopicRequest))
+func (m *GetMRMembersResponse) GetReplicationFactor() int32 {
+	if m != nil {
+		return m.ReplicationFactor
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _ClusterManager_ListTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTopicsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).ListTopics(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/ListTopics",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).ListTopics(ctx, req.(*ListTopicsRequest))
+func (m *GetMRMembersResponse) GetMembers() map[github_com_kakao_varlog_pkg_types.NodeID]string {
+	if m != nil {
+		return m.Members
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ClusterManager_AddTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddTopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).AddTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/AddTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).AddTopic(ctx, req.(*AddTopicRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type AddMetadataRepositoryNodeRequest struct {
+	RaftURL string `protobuf:"bytes,1,opt,name=raft_url,json=raftUrl,proto3" json:"raft_url,omitempty"`
+	RPCAddr string `protobuf:"bytes,2,opt,name=rpc_addr,json=rpcAddr,proto3" json:"rpc_addr,omitempty"`
 }
 
-func _ClusterManager_UnregisterTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnregisterTopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).UnregisterTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/UnregisterTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).UnregisterTopic(ctx, req.(*UnregisterTopicRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *AddMetadataRepositoryNodeRequest) Reset()         { *m = AddMetadataRepositoryNodeRequest{} }
+func (m *AddMetadataRepositoryNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*AddMetadataRepositoryNodeRequest) ProtoMessage()    {}
+func (*AddMetadataRepositoryNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{65}
 }
-
-func _ClusterManager_GetLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLogStreamRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).GetLogStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/GetLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).GetLogStream(ctx, req.(*GetLogStreamRequest))
+func (m *AddMetadataRepositoryNodeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddMetadataRepositoryNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddMetadataRepositoryNodeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *AddMetadataRepositoryNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddMetadataRepositoryNodeRequest.Merge(m, src)
+}
+func (m *AddMetadataRepositoryNodeRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AddMetadataRepositoryNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddMetadataRepositoryNodeRequest.DiscardUnknown(m)
 }
 
-func _ClusterManager_ListLogStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListLogStreamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).ListLogStreams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/ListLogStreams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).ListLogStreams(ctx, req.(*ListLogStreamsRequest))
+var xxx_messageInfo_AddMetadataRepositoryNodeRequest proto.InternalMessageInfo
+
+func (m *AddMetadataRepositoryNodeRequest) GetRaftURL() string {
+	if m != nil {
+		return m.RaftURL
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ClusterManager_AddLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddLogStreamRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).AddLogStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/AddLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).AddLogStream(ctx, req.(*AddLogStreamRequest))
+func (m *AddMetadataRepositoryNodeRequest) GetRPCAddr() string {
+	if m != nil {
+		return m.RPCAddr
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ClusterManager_UpdateLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateLogStreamRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).UpdateLogStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/UpdateLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).UpdateLogStream(ctx, req.(*UpdateLogStreamRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type AddMetadataRepositoryNodeResponse struct {
+	Node *varlogpb.MetadataRepositoryNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
 }
 
-func _ClusterManager_UnregisterLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnregisterLogStreamRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).UnregisterLogStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/UnregisterLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).UnregisterLogStream(ctx, req.(*UnregisterLogStreamRequest))
+func (m *AddMetadataRepositoryNodeResponse) Reset()         { *m = AddMetadataRepositoryNodeResponse{} }
+func (m *AddMetadataRepositoryNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*AddMetadataRepositoryNodeResponse) ProtoMessage()    {}
+func (*AddMetadataRepositoryNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{66}
+}
+func (m *AddMetadataRepositoryNodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddMetadataRepositoryNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddMetadataRepositoryNodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *AddMetadataRepositoryNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddMetadataRepositoryNodeResponse.Merge(m, src)
+}
+func (m *AddMetadataRepositoryNodeResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AddMetadataRepositoryNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddMetadataRepositoryNodeResponse.DiscardUnknown(m)
 }
 
-func _ClusterManager_RemoveLogStreamReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveLogStreamReplicaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).RemoveLogStreamReplica(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/RemoveLogStreamReplica",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).RemoveLogStreamReplica(ctx, req.(*RemoveLogStreamReplicaRequest))
+var xxx_messageInfo_AddMetadataRepositoryNodeResponse proto.InternalMessageInfo
+
+func (m *AddMetadataRepositoryNodeResponse) GetNode() *varlogpb.MetadataRepositoryNode {
+	if m != nil {
+		return m.Node
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ClusterManager_Seal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SealRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).Seal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/Seal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).Seal(ctx, req.(*SealRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type AddMRPeerRequest struct {
+	RaftURL string `protobuf:"bytes,1,opt,name=raft_url,json=raftUrl,proto3" json:"raft_url,omitempty"`
+	RPCAddr string `protobuf:"bytes,2,opt,name=rpc_addr,json=rpcAddr,proto3" json:"rpc_addr,omitempty"`
 }
 
-func _ClusterManager_Unseal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsealRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).Unseal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/Unseal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).Unseal(ctx, req.(*UnsealRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *AddMRPeerRequest) Reset()         { *m = AddMRPeerRequest{} }
+func (m *AddMRPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*AddMRPeerRequest) ProtoMessage()    {}
+func (*AddMRPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{67}
 }
-
-func _ClusterManager_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SyncRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).Sync(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/Sync",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).Sync(ctx, req.(*SyncRequest))
+func (m *AddMRPeerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddMRPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddMRPeerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *AddMRPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddMRPeerRequest.Merge(m, src)
+}
+func (m *AddMRPeerRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AddMRPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddMRPeerRequest.DiscardUnknown(m)
 }
 
-func _ClusterManager_Trim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TrimRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).Trim(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/Trim",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).Trim(ctx, req.(*TrimRequest))
+var xxx_messageInfo_AddMRPeerRequest proto.InternalMessageInfo
+
+func (m *AddMRPeerRequest) GetRaftURL() string {
+	if m != nil {
+		return m.RaftURL
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ClusterManager_GetMetadataRepositoryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMetadataRepositoryNodeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).GetMetadataRepositoryNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/GetMetadataRepositoryNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).GetMetadataRepositoryNode(ctx, req.(*GetMetadataRepositoryNodeRequest))
+func (m *AddMRPeerRequest) GetRPCAddr() string {
+	if m != nil {
+		return m.RPCAddr
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ClusterManager_ListMetadataRepositoryNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMetadataRepositoryNodesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).ListMetadataRepositoryNodes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/ListMetadataRepositoryNodes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).ListMetadataRepositoryNodes(ctx, req.(*ListMetadataRepositoryNodesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type AddMRPeerResponse struct {
+	NodeID github_com_kakao_varlog_pkg_types.NodeID `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.NodeID" json:"nodeId,omitempty"`
 }
 
-func _ClusterManager_GetMRMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).GetMRMembers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/GetMRMembers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).GetMRMembers(ctx, req.(*types.Empty))
+func (m *AddMRPeerResponse) Reset()         { *m = AddMRPeerResponse{} }
+func (m *AddMRPeerResponse) String() string { return proto.CompactTextString(m) }
+func (*AddMRPeerResponse) ProtoMessage()    {}
+func (*AddMRPeerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{68}
+}
+func (m *AddMRPeerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddMRPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddMRPeerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *AddMRPeerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddMRPeerResponse.Merge(m, src)
+}
+func (m *AddMRPeerResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AddMRPeerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddMRPeerResponse.DiscardUnknown(m)
 }
 
-func _ClusterManager_AddMetadataRepositoryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddMetadataRepositoryNodeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).AddMetadataRepositoryNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/AddMetadataRepositoryNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).AddMetadataRepositoryNode(ctx, req.(*AddMetadataRepositoryNodeRequest))
+var xxx_messageInfo_AddMRPeerResponse proto.InternalMessageInfo
+
+func (m *AddMRPeerResponse) GetNodeID() github_com_kakao_varlog_pkg_types.NodeID {
+	if m != nil {
+		return m.NodeID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _ClusterManager_AddMRPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddMRPeerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).AddMRPeer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/AddMRPeer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).AddMRPeer(ctx, req.(*AddMRPeerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type DeleteMetadataRepositoryNodeRequest struct {
+	NodeID github_com_kakao_varlog_pkg_types.NodeID `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.NodeID" json:"nodeId"`
 }
 
-func _ClusterManager_DeleteMetadataRepositoryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteMetadataRepositoryNodeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).DeleteMetadataRepositoryNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/DeleteMetadataRepositoryNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).DeleteMetadataRepositoryNode(ctx, req.(*DeleteMetadataRepositoryNodeRequest))
+func (m *DeleteMetadataRepositoryNodeRequest) Reset()         { *m = DeleteMetadataRepositoryNodeRequest{} }
+func (m *DeleteMetadataRepositoryNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteMetadataRepositoryNodeRequest) ProtoMessage()    {}
+func (*DeleteMetadataRepositoryNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{69}
+}
+func (m *DeleteMetadataRepositoryNodeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteMetadataRepositoryNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteMetadataRepositoryNodeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DeleteMetadataRepositoryNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMetadataRepositoryNodeRequest.Merge(m, src)
+}
+func (m *DeleteMetadataRepositoryNodeRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DeleteMetadataRepositoryNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMetadataRepositoryNodeRequest.DiscardUnknown(m)
 }
 
-func _ClusterManager_RemoveMRPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveMRPeerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).RemoveMRPeer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.vmspb.ClusterManager/RemoveMRPeer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).RemoveMRPeer(ctx, req.(*RemoveMRPeerRequest))
+var xxx_messageInfo_DeleteMetadataRepositoryNodeRequest proto.InternalMessageInfo
+
+func (m *DeleteMetadataRepositoryNodeRequest) GetNodeID() github_com_kakao_varlog_pkg_types.NodeID {
+	if m != nil {
+		return m.NodeID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _ClusterManager_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "varlog.vmspb.ClusterManager",
-	HandlerType: (*ClusterManagerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetStorageNode",
-			Handler:    _ClusterManager_GetStorageNode_Handler,
-		},
-		{
-			MethodName: "ListStorageNodes",
-			Handler:    _ClusterManager_ListStorageNodes_Handler,
-		},
-		{
-			MethodName: "AddStorageNode",
-			Handler:    _ClusterManager_AddStorageNode_Handler,
-		},
-		{
-			MethodName: "UnregisterStorageNode",
-			Handler:    _ClusterManager_UnregisterStorageNode_Handler,
-		},
-		{
-			MethodName: "GetTopic",
-			Handler:    _ClusterManager_GetTopic_Handler,
-		},
-		{
-			MethodName: "DescribeTopic",
-			Handler:    _ClusterManager_DescribeTopic_Handler,
-		},
-		{
-			MethodName: "ListTopics",
-			Handler:    _ClusterManager_ListTopics_Handler,
-		},
-		{
-			MethodName: "AddTopic",
-			Handler:    _ClusterManager_AddTopic_Handler,
-		},
-		{
-			MethodName: "UnregisterTopic",
-			Handler:    _ClusterManager_UnregisterTopic_Handler,
-		},
-		{
-			MethodName: "GetLogStream",
-			Handler:    _ClusterManager_GetLogStream_Handler,
-		},
-		{
-			MethodName: "ListLogStreams",
-			Handler:    _ClusterManager_ListLogStreams_Handler,
-		},
-		{
-			MethodName: "AddLogStream",
-			Handler:    _ClusterManager_AddLogStream_Handler,
-		},
-		{
-			MethodName: "UpdateLogStream",
-			Handler:    _ClusterManager_UpdateLogStream_Handler,
-		},
-		{
-			MethodName: "UnregisterLogStream",
-			Handler:    _ClusterManager_UnregisterLogStream_Handler,
-		},
-		{
-			MethodName: "RemoveLogStreamReplica",
-			Handler:    _ClusterManager_RemoveLogStreamReplica_Handler,
-		},
-		{
-			MethodName: "Seal",
-			Handler:    _ClusterManager_Seal_Handler,
-		},
-		{
-			MethodName: "Unseal",
-			Handler:    _ClusterManager_Unseal_Handler,
-		},
-		{
-			MethodName: "Sync",
-			Handler:    _ClusterManager_Sync_Handler,
-		},
-		{
-			MethodName: "Trim",
-			Handler:    _ClusterManager_Trim_Handler,
-		},
-		{
-			MethodName: "GetMetadataRepositoryNode",
-			Handler:    _ClusterManager_GetMetadataRepositoryNode_Handler,
-		},
-		{
-			MethodName: "ListMetadataRepositoryNodes",
-			Handler:    _ClusterManager_ListMetadataRepositoryNodes_Handler,
-		},
-		{
-			MethodName: "GetMRMembers",
-			Handler:    _ClusterManager_GetMRMembers_Handler,
-		},
-		{
-			MethodName: "AddMetadataRepositoryNode",
-			Handler:    _ClusterManager_AddMetadataRepositoryNode_Handler,
-		},
-		{
-			MethodName: "AddMRPeer",
-			Handler:    _ClusterManager_AddMRPeer_Handler,
-		},
-		{
-			MethodName: "DeleteMetadataRepositoryNode",
-			Handler:    _ClusterManager_DeleteMetadataRepositoryNode_Handler,
-		},
-		{
-			MethodName: "RemoveMRPeer",
-			Handler:    _ClusterManager_RemoveMRPeer_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/vmspb/admin.proto",
+type DeleteMetadataRepositoryNodeResponse struct {
 }
 
-func (m *StorageNodeMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DeleteMetadataRepositoryNodeResponse) Reset()         { *m = DeleteMetadataRepositoryNodeResponse{} }
+func (m *DeleteMetadataRepositoryNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteMetadataRepositoryNodeResponse) ProtoMessage()    {}
+func (*DeleteMetadataRepositoryNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{70}
 }
-
-func (m *StorageNodeMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DeleteMetadataRepositoryNodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *StorageNodeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHeartbeatTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintAdmin(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x1a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintAdmin(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.StorageNodeMetadataDescriptor.MarshalToSizedBuffer(dAtA[:i])
+func (m *DeleteMetadataRepositoryNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteMetadataRepositoryNodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintAdmin(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *GetStorageNodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DeleteMetadataRepositoryNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMetadataRepositoryNodeResponse.Merge(m, src)
 }
-
-func (m *GetStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DeleteMetadataRepositoryNodeResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DeleteMetadataRepositoryNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMetadataRepositoryNodeResponse.DiscardUnknown(m)
 }
 
-func (m *GetStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StorageNodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_DeleteMetadataRepositoryNodeResponse proto.InternalMessageInfo
+
+type RemoveMRPeerRequest struct {
+	RaftURL string `protobuf:"bytes,1,opt,name=raft_url,json=raftUrl,proto3" json:"raft_url,omitempty"`
 }
 
-func (m *GetStorageNodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RemoveMRPeerRequest) Reset()         { *m = RemoveMRPeerRequest{} }
+func (m *RemoveMRPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveMRPeerRequest) ProtoMessage()    {}
+func (*RemoveMRPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{71}
+}
+func (m *RemoveMRPeerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveMRPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveMRPeerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *GetStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RemoveMRPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveMRPeerRequest.Merge(m, src)
+}
+func (m *RemoveMRPeerRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RemoveMRPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveMRPeerRequest.DiscardUnknown(m)
 }
 
-func (m *GetStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StorageNode != nil {
-		{
-			size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_RemoveMRPeerRequest proto.InternalMessageInfo
+
+func (m *RemoveMRPeerRequest) GetRaftURL() string {
+	if m != nil {
+		return m.RaftURL
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ListStorageNodesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type RemoveMRPeerResponse struct {
 }
 
-func (m *ListStorageNodesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RemoveMRPeerResponse) Reset()         { *m = RemoveMRPeerResponse{} }
+func (m *RemoveMRPeerResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveMRPeerResponse) ProtoMessage()    {}
+func (*RemoveMRPeerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{72}
 }
-
-func (m *ListStorageNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *RemoveMRPeerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ListStorageNodesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RemoveMRPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveMRPeerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ListStorageNodesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RemoveMRPeerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveMRPeerResponse.Merge(m, src)
 }
-
-func (m *ListStorageNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StorageNodes) > 0 {
-		for iNdEx := len(m.StorageNodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StorageNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (m *RemoveMRPeerResponse) XXX_Size() int {
+	return m.ProtoSize()
 }
-
-func (m *AddStorageNodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *RemoveMRPeerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveMRPeerResponse.DiscardUnknown(m)
 }
 
-func (m *AddStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RemoveMRPeerResponse proto.InternalMessageInfo
+
+type TransferMetadataRepositoryLeadershipRequest struct {
+	NodeID github_com_kakao_varlog_pkg_types.NodeID `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.NodeID" json:"nodeId"`
 }
 
-func (m *AddStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
+func (m *TransferMetadataRepositoryLeadershipRequest) Reset() {
+	*m = TransferMetadataRepositoryLeadershipRequest{}
+}
+func (m *TransferMetadataRepositoryLeadershipRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*TransferMetadataRepositoryLeadershipRequest) ProtoMessage() {}
+func (*TransferMetadataRepositoryLeadershipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{73}
+}
+func (m *TransferMetadataRepositoryLeadershipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferMetadataRepositoryLeadershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferMetadataRepositoryLeadershipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintAdmin(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *AddStorageNodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TransferMetadataRepositoryLeadershipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferMetadataRepositoryLeadershipRequest.Merge(m, src)
 }
-
-func (m *AddStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TransferMetadataRepositoryLeadershipRequest) XXX_Size() int {
+	return m.ProtoSize()
 }
-
-func (m *AddStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StorageNode != nil {
-		{
-			size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *TransferMetadataRepositoryLeadershipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferMetadataRepositoryLeadershipRequest.DiscardUnknown(m)
 }
 
-func (m *UnregisterStorageNodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TransferMetadataRepositoryLeadershipRequest proto.InternalMessageInfo
+
+func (m *TransferMetadataRepositoryLeadershipRequest) GetNodeID() github_com_kakao_varlog_pkg_types.NodeID {
+	if m != nil {
+		return m.NodeID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UnregisterStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TransferMetadataRepositoryLeadershipResponse struct {
 }
 
-func (m *UnregisterStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StorageNodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *TransferMetadataRepositoryLeadershipResponse) Reset() {
+	*m = TransferMetadataRepositoryLeadershipResponse{}
 }
-
-func (m *UnregisterStorageNodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TransferMetadataRepositoryLeadershipResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *UnregisterStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*TransferMetadataRepositoryLeadershipResponse) ProtoMessage() {}
+func (*TransferMetadataRepositoryLeadershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f6257e87fe6989, []int{74}
 }
-
-func (m *UnregisterStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *TransferMetadataRepositoryLeadershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *GetTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TransferMetadataRepositoryLeadershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferMetadataRepositoryLeadershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *GetTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TransferMetadataRepositoryLeadershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferMetadataRepositoryLeadershipResponse.Merge(m, src)
 }
-
-func (m *GetTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *TransferMetadataRepositoryLeadershipResponse) XXX_Size() int {
+	return m.ProtoSize()
 }
-
-func (m *GetTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TransferMetadataRepositoryLeadershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferMetadataRepositoryLeadershipResponse.DiscardUnknown(m)
 }
 
-func (m *GetTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_TransferMetadataRepositoryLeadershipResponse proto.InternalMessageInfo
 
-func (m *GetTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Topic != nil {
-		{
-			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterEnum("varlog.vmspb.DrainState", DrainState_name, DrainState_value)
+	proto.RegisterEnum("varlog.vmspb.OperationKind", OperationKind_name, OperationKind_value)
+	proto.RegisterEnum("varlog.vmspb.OperationState", OperationState_name, OperationState_value)
+	proto.RegisterEnum("varlog.vmspb.EventKind", EventKind_name, EventKind_value)
+	proto.RegisterType((*StorageNodeMetadata)(nil), "varlog.vmspb.StorageNodeMetadata")
+	proto.RegisterType((*DrainStatus)(nil), "varlog.vmspb.DrainStatus")
+	proto.RegisterType((*OperationProgress)(nil), "varlog.vmspb.OperationProgress")
+	proto.RegisterType((*Operation)(nil), "varlog.vmspb.Operation")
+	proto.RegisterType((*Event)(nil), "varlog.vmspb.Event")
+	proto.RegisterType((*GetStorageNodeRequest)(nil), "varlog.vmspb.GetStorageNodeRequest")
+	proto.RegisterType((*GetStorageNodeResponse)(nil), "varlog.vmspb.GetStorageNodeResponse")
+	proto.RegisterType((*ListStorageNodesRequest)(nil), "varlog.vmspb.ListStorageNodesRequest")
+	proto.RegisterType((*ListStorageNodesResponse)(nil), "varlog.vmspb.ListStorageNodesResponse")
+	proto.RegisterType((*AddStorageNodeRequest)(nil), "varlog.vmspb.AddStorageNodeRequest")
+	proto.RegisterType((*AddStorageNodeResponse)(nil), "varlog.vmspb.AddStorageNodeResponse")
+	proto.RegisterType((*UnregisterStorageNodeRequest)(nil), "varlog.vmspb.UnregisterStorageNodeRequest")
+	proto.RegisterType((*UnregisterStorageNodeResponse)(nil), "varlog.vmspb.UnregisterStorageNodeResponse")
+	proto.RegisterType((*DrainStorageNodeRequest)(nil), "varlog.vmspb.DrainStorageNodeRequest")
+	proto.RegisterType((*DrainStorageNodeResponse)(nil), "varlog.vmspb.DrainStorageNodeResponse")
+	proto.RegisterType((*SetStorageNodeSyncBandwidthRequest)(nil), "varlog.vmspb.SetStorageNodeSyncBandwidthRequest")
+	proto.RegisterType((*SetStorageNodeSyncBandwidthResponse)(nil), "varlog.vmspb.SetStorageNodeSyncBandwidthResponse")
+	proto.RegisterType((*SetStorageNodeAppendQuotaRequest)(nil), "varlog.vmspb.SetStorageNodeAppendQuotaRequest")
+	proto.RegisterType((*SetStorageNodeAppendQuotaResponse)(nil), "varlog.vmspb.SetStorageNodeAppendQuotaResponse")
+	proto.RegisterType((*GetTopicRequest)(nil), "varlog.vmspb.GetTopicRequest")
+	proto.RegisterType((*GetTopicResponse)(nil), "varlog.vmspb.GetTopicResponse")
+	proto.RegisterType((*DescribeTopicRequest)(nil), "varlog.vmspb.DescribeTopicRequest")
+	proto.RegisterType((*DescribeTopicResponse)(nil), "varlog.vmspb.DescribeTopicResponse")
+	proto.RegisterType((*ListTopicsRequest)(nil), "varlog.vmspb.ListTopicsRequest")
+	proto.RegisterType((*ListTopicsResponse)(nil), "varlog.vmspb.ListTopicsResponse")
+	proto.RegisterType((*AddTopicRequest)(nil), "varlog.vmspb.AddTopicRequest")
+	proto.RegisterType((*AddTopicResponse)(nil), "varlog.vmspb.AddTopicResponse")
+	proto.RegisterType((*UnregisterTopicRequest)(nil), "varlog.vmspb.UnregisterTopicRequest")
+	proto.RegisterType((*UnregisterTopicResponse)(nil), "varlog.vmspb.UnregisterTopicResponse")
+	proto.RegisterType((*GetLogStreamRequest)(nil), "varlog.vmspb.GetLogStreamRequest")
+	proto.RegisterType((*GetLogStreamResponse)(nil), "varlog.vmspb.GetLogStreamResponse")
+	proto.RegisterType((*ListLogStreamsRequest)(nil), "varlog.vmspb.ListLogStreamsRequest")
+	proto.RegisterType((*ListLogStreamsResponse)(nil), "varlog.vmspb.ListLogStreamsResponse")
+	proto.RegisterType((*AddLogStreamRequest)(nil), "varlog.vmspb.AddLogStreamRequest")
+	proto.RegisterType((*AddLogStreamResponse)(nil), "varlog.vmspb.AddLogStreamResponse")
+	proto.RegisterType((*UpdateLogStreamRequest)(nil), "varlog.vmspb.UpdateLogStreamRequest")
+	proto.RegisterType((*UpdateLogStreamResponse)(nil), "varlog.vmspb.UpdateLogStreamResponse")
+	proto.RegisterType((*UnregisterLogStreamRequest)(nil), "varlog.vmspb.UnregisterLogStreamRequest")
+	proto.RegisterType((*UnregisterLogStreamResponse)(nil), "varlog.vmspb.UnregisterLogStreamResponse")
+	proto.RegisterType((*RemoveLogStreamReplicaRequest)(nil), "varlog.vmspb.RemoveLogStreamReplicaRequest")
+	proto.RegisterType((*RemoveLogStreamReplicaResponse)(nil), "varlog.vmspb.RemoveLogStreamReplicaResponse")
+	proto.RegisterType((*SealRequest)(nil), "varlog.vmspb.SealRequest")
+	proto.RegisterType((*SealResponse)(nil), "varlog.vmspb.SealResponse")
+	proto.RegisterType((*UnsealRequest)(nil), "varlog.vmspb.UnsealRequest")
+	proto.RegisterType((*UnsealResponse)(nil), "varlog.vmspb.UnsealResponse")
+	proto.RegisterType((*SyncRequest)(nil), "varlog.vmspb.SyncRequest")
+	proto.RegisterType((*SyncResponse)(nil), "varlog.vmspb.SyncResponse")
+	proto.RegisterType((*GetOperationRequest)(nil), "varlog.vmspb.GetOperationRequest")
+	proto.RegisterType((*GetOperationResponse)(nil), "varlog.vmspb.GetOperationResponse")
+	proto.RegisterType((*ListOperationsRequest)(nil), "varlog.vmspb.ListOperationsRequest")
+	proto.RegisterType((*ListOperationsResponse)(nil), "varlog.vmspb.ListOperationsResponse")
+	proto.RegisterType((*CancelOperationRequest)(nil), "varlog.vmspb.CancelOperationRequest")
+	proto.RegisterType((*CancelOperationResponse)(nil), "varlog.vmspb.CancelOperationResponse")
+	proto.RegisterType((*WatchEventsRequest)(nil), "varlog.vmspb.WatchEventsRequest")
+	proto.RegisterType((*WatchEventsResponse)(nil), "varlog.vmspb.WatchEventsResponse")
+	proto.RegisterType((*TrimRequest)(nil), "varlog.vmspb.TrimRequest")
+	proto.RegisterType((*TrimResult)(nil), "varlog.vmspb.TrimResult")
+	proto.RegisterType((*TrimResponse)(nil), "varlog.vmspb.TrimResponse")
+	proto.RegisterType((*CheckpointLogStreamReplicaRequest)(nil), "varlog.vmspb.CheckpointLogStreamReplicaRequest")
+	proto.RegisterType((*CheckpointLogStreamReplicaResponse)(nil), "varlog.vmspb.CheckpointLogStreamReplicaResponse")
+	proto.RegisterType((*GetMetadataRepositoryNodeRequest)(nil), "varlog.vmspb.GetMetadataRepositoryNodeRequest")
+	proto.RegisterType((*GetMetadataRepositoryNodeResponse)(nil), "varlog.vmspb.GetMetadataRepositoryNodeResponse")
+	proto.RegisterType((*ListMetadataRepositoryNodesRequest)(nil), "varlog.vmspb.ListMetadataRepositoryNodesRequest")
+	proto.RegisterType((*ListMetadataRepositoryNodesResponse)(nil), "varlog.vmspb.ListMetadataRepositoryNodesResponse")
+	proto.RegisterType((*GetMRMembersResponse)(nil), "varlog.vmspb.GetMRMembersResponse")
+	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.NodeID]string)(nil), "varlog.vmspb.GetMRMembersResponse.MembersEntry")
+	proto.RegisterType((*AddMetadataRepositoryNodeRequest)(nil), "varlog.vmspb.AddMetadataRepositoryNodeRequest")
+	proto.RegisterType((*AddMetadataRepositoryNodeResponse)(nil), "varlog.vmspb.AddMetadataRepositoryNodeResponse")
+	proto.RegisterType((*AddMRPeerRequest)(nil), "varlog.vmspb.AddMRPeerRequest")
+	proto.RegisterType((*AddMRPeerResponse)(nil), "varlog.vmspb.AddMRPeerResponse")
+	proto.RegisterType((*DeleteMetadataRepositoryNodeRequest)(nil), "varlog.vmspb.DeleteMetadataRepositoryNodeRequest")
+	proto.RegisterType((*DeleteMetadataRepositoryNodeResponse)(nil), "varlog.vmspb.DeleteMetadataRepositoryNodeResponse")
+	proto.RegisterType((*RemoveMRPeerRequest)(nil), "varlog.vmspb.RemoveMRPeerRequest")
+	proto.RegisterType((*RemoveMRPeerResponse)(nil), "varlog.vmspb.RemoveMRPeerResponse")
+	proto.RegisterType((*TransferMetadataRepositoryLeadershipRequest)(nil), "varlog.vmspb.TransferMetadataRepositoryLeadershipRequest")
+	proto.RegisterType((*TransferMetadataRepositoryLeadershipResponse)(nil), "varlog.vmspb.TransferMetadataRepositoryLeadershipResponse")
 }
 
-func (m *DescribeTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+func init() { proto.RegisterFile("proto/vmspb/admin.proto", fileDescriptor_55f6257e87fe6989) }
 
-func (m *DescribeTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_55f6257e87fe6989 = []byte{
+	// 3976 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4d, 0x6c, 0xe3, 0x56,
+	0x7a, 0xa6, 0x25, 0xff, 0x7d, 0xb2, 0x3d, 0x9a, 0x67, 0x5b, 0xb6, 0x69, 0x8f, 0xa9, 0xe1, 0x38,
+	0xb3, 0x93, 0xec, 0xd4, 0x4a, 0x66, 0x37, 0xe9, 0x64, 0xba, 0xe9, 0x46, 0xb2, 0x35, 0x8e, 0x77,
+	0x6c, 0xd9, 0x4b, 0xd9, 0x1b, 0x64, 0x7f, 0x46, 0x4b, 0x8b, 0x6f, 0x64, 0x75, 0x64, 0x52, 0x21,
+	0x69, 0x67, 0x7d, 0x48, 0xd1, 0x2d, 0xb6, 0xcd, 0xc2, 0x58, 0xa0, 0x29, 0x5a, 0xf4, 0x54, 0xa3,
+	0x8b, 0xf6, 0xd0, 0x43, 0x51, 0xa0, 0xe8, 0xa1, 0xe8, 0xa1, 0x87, 0x1e, 0x83, 0x1e, 0x8a, 0xdc,
+	0xda, 0x93, 0x16, 0x75, 0x2e, 0x85, 0x6f, 0x45, 0xb1, 0x97, 0x9c, 0x16, 0x7c, 0x7c, 0x24, 0x1f,
+	0x1f, 0xa9, 0x1f, 0x4f, 0xe4, 0xcc, 0x26, 0xc8, 0x65, 0x4c, 0xbe, 0xf7, 0xfd, 0xbd, 0xef, 0xef,
+	0x3d, 0x7e, 0xef, 0xd3, 0xc0, 0x6c, 0xd3, 0x34, 0x6c, 0x23, 0x77, 0x7c, 0x68, 0x35, 0xf7, 0x73,
+	0xaa, 0x76, 0x58, 0xd7, 0x57, 0xc8, 0x08, 0x1a, 0x3f, 0x56, 0xcd, 0x86, 0x51, 0x5b, 0x21, 0x33,
+	0xe2, 0xef, 0xd4, 0xea, 0xf6, 0xc1, 0xd1, 0xfe, 0x4a, 0xd5, 0x38, 0xcc, 0xd5, 0x8c, 0x9a, 0x91,
+	0x23, 0x40, 0xfb, 0x47, 0x4f, 0xc8, 0x9b, 0x4b, 0xc3, 0x79, 0x72, 0x91, 0x45, 0xa9, 0x66, 0x18,
+	0xb5, 0x06, 0x0e, 0xa0, 0xec, 0xfa, 0x21, 0xb6, 0x6c, 0xf5, 0xb0, 0x49, 0x01, 0x16, 0x78, 0x00,
+	0x7c, 0xd8, 0xb4, 0x4f, 0xe8, 0xe4, 0xac, 0xcb, 0xba, 0xb9, 0x9f, 0x3b, 0xc4, 0xb6, 0xaa, 0xa9,
+	0xb6, 0x4a, 0x27, 0x66, 0x2c, 0xbd, 0xb9, 0x9f, 0x33, 0x71, 0xb3, 0x51, 0xaf, 0xaa, 0xb6, 0x61,
+	0xd2, 0xe1, 0x29, 0x4b, 0x8f, 0xc0, 0xca, 0x7f, 0x9e, 0x80, 0xa9, 0xb2, 0x6d, 0x98, 0x6a, 0x0d,
+	0x97, 0x0c, 0x0d, 0x6f, 0xd1, 0x59, 0xf4, 0x03, 0x18, 0xb7, 0xdc, 0xe1, 0x8a, 0x6e, 0x68, 0x78,
+	0x4e, 0xc8, 0x0a, 0x77, 0x52, 0xf7, 0x5e, 0x5a, 0xa1, 0xcb, 0x75, 0x48, 0xad, 0xc4, 0xe0, 0xad,
+	0x61, 0xab, 0x6a, 0xd6, 0x9b, 0xb6, 0x61, 0x16, 0xc6, 0x3f, 0x6a, 0x49, 0x03, 0x1f, 0xb7, 0x24,
+	0xe1, 0xa2, 0x25, 0x0d, 0x28, 0x29, 0x2b, 0x00, 0x46, 0x65, 0x48, 0x55, 0x4d, 0xac, 0xda, 0xb8,
+	0xe2, 0x2c, 0x78, 0x6e, 0x90, 0xd0, 0x16, 0x57, 0xdc, 0xc5, 0xae, 0x78, 0x8b, 0x5d, 0xd9, 0xf5,
+	0xb4, 0x51, 0xc8, 0x38, 0xb4, 0x2e, 0x5a, 0x12, 0xb8, 0x68, 0xce, 0xc4, 0x87, 0xbf, 0x92, 0x04,
+	0x85, 0x79, 0x47, 0x75, 0x98, 0x6a, 0xa8, 0x96, 0x5d, 0x39, 0xc0, 0xaa, 0x69, 0xef, 0x63, 0xd5,
+	0x76, 0x89, 0x27, 0xba, 0x12, 0xbf, 0x41, 0x89, 0x5f, 0x77, 0xd0, 0xdf, 0xf2, 0xb0, 0x7d, 0x1e,
+	0xd1, 0x61, 0xf4, 0x36, 0x8c, 0x6b, 0xa6, 0x5a, 0xd7, 0x2b, 0x96, 0xad, 0xda, 0x47, 0xd6, 0x5c,
+	0x92, 0xf0, 0x98, 0x5f, 0x61, 0x7d, 0x61, 0x65, 0xcd, 0x81, 0x28, 0x13, 0x80, 0xc2, 0xfc, 0x45,
+	0x4b, 0x9a, 0xd1, 0x82, 0x81, 0xbb, 0xc6, 0x61, 0xdd, 0x26, 0xb6, 0x54, 0x52, 0xcc, 0xf0, 0x83,
+	0xe4, 0xff, 0xfe, 0x52, 0x12, 0xe4, 0xbf, 0x48, 0x42, 0x8a, 0xc1, 0x46, 0xaf, 0xc3, 0x90, 0xc3,
+	0xc8, 0x35, 0xc2, 0xe4, 0xbd, 0xb9, 0x36, 0x7c, 0x70, 0x61, 0xec, 0xa2, 0x25, 0xb9, 0xa0, 0x8a,
+	0xfb, 0x07, 0xdd, 0x87, 0x49, 0xdb, 0xb0, 0xd5, 0x46, 0x85, 0x7a, 0x83, 0x45, 0x94, 0x3d, 0x54,
+	0xb8, 0x7e, 0xd1, 0x92, 0x26, 0xc8, 0x8c, 0x42, 0x27, 0x94, 0xf0, 0xab, 0x83, 0x79, 0x68, 0x1c,
+	0x63, 0x2d, 0xc0, 0x4c, 0x04, 0x98, 0x64, 0x26, 0xc0, 0x0c, 0xbd, 0xa2, 0xf7, 0x61, 0xa2, 0x61,
+	0xd4, 0x2a, 0x96, 0x6d, 0x62, 0xf5, 0xb0, 0x52, 0xd7, 0x88, 0x7a, 0x86, 0x0a, 0xef, 0x9c, 0xb7,
+	0xa4, 0xd4, 0xa6, 0x51, 0x2b, 0x93, 0xf1, 0x8d, 0x35, 0x47, 0x25, 0x0d, 0xff, 0x55, 0x0b, 0x54,
+	0xf2, 0x69, 0x4b, 0x62, 0xe3, 0xe8, 0xa9, 0xfa, 0x54, 0x35, 0x72, 0xee, 0x92, 0x73, 0xcd, 0xa7,
+	0xb5, 0x9c, 0x7d, 0xd2, 0xc4, 0xd6, 0x0a, 0x43, 0x49, 0x49, 0x31, 0x74, 0xd0, 0x8b, 0x30, 0x84,
+	0x4d, 0xd3, 0x30, 0xe7, 0x86, 0xb2, 0xc2, 0x9d, 0xb1, 0xc2, 0xd4, 0x45, 0x4b, 0xba, 0x46, 0x06,
+	0x18, 0xa5, 0xbb, 0x10, 0x68, 0x07, 0xc0, 0xb2, 0x55, 0x93, 0x7a, 0xca, 0x70, 0x57, 0x4f, 0x99,
+	0xa1, 0x9e, 0x32, 0x46, 0xb0, 0x7c, 0x0f, 0x09, 0x5e, 0x1d, 0xcf, 0x3e, 0x6a, 0x6a, 0xbe, 0x67,
+	0x8f, 0xf4, 0xee, 0xd9, 0x2e, 0x5a, 0xe0, 0xd9, 0xc1, 0x3b, 0xf5, 0x8a, 0x9f, 0x09, 0x70, 0x7d,
+	0xbb, 0x89, 0x4d, 0xd5, 0xae, 0x1b, 0xfa, 0x8e, 0x69, 0xd4, 0x4c, 0x6c, 0x59, 0xe8, 0x55, 0x70,
+	0xed, 0x56, 0xc1, 0xba, 0x6d, 0xd6, 0xb1, 0x45, 0x7c, 0x24, 0x59, 0x48, 0x5f, 0xb4, 0xa4, 0x71,
+	0x32, 0x51, 0x74, 0xc7, 0x95, 0xd0, 0x1b, 0xba, 0x07, 0xe3, 0x9a, 0xa1, 0x63, 0x1f, 0x6b, 0x90,
+	0x60, 0x5d, 0xbb, 0x68, 0x49, 0x29, 0x67, 0xdc, 0x43, 0x62, 0x5f, 0xa8, 0x18, 0xbf, 0x1e, 0x81,
+	0x31, 0x5f, 0x0c, 0x94, 0x87, 0x71, 0xc3, 0x7b, 0x71, 0x4c, 0xed, 0x72, 0x5f, 0x72, 0x4c, 0xed,
+	0x03, 0x11, 0x53, 0xa7, 0x7c, 0xb0, 0x0d, 0x4d, 0x61, 0x5f, 0xd0, 0xeb, 0x90, 0x7c, 0x5a, 0xd7,
+	0x35, 0x22, 0xc2, 0xe4, 0xbd, 0x85, 0xb0, 0x73, 0xfb, 0x44, 0x1e, 0xd5, 0x75, 0xad, 0x30, 0x7a,
+	0xd1, 0x92, 0x08, 0xb0, 0x42, 0xfe, 0x45, 0x6f, 0x78, 0x81, 0x91, 0x20, 0xb8, 0x8b, 0x6d, 0x70,
+	0xdb, 0x05, 0xc7, 0x63, 0x18, 0xb5, 0x8d, 0x66, 0xbd, 0x1a, 0xf8, 0xe8, 0xea, 0x79, 0x4b, 0x1a,
+	0xd9, 0x75, 0xc6, 0x88, 0xd0, 0x23, 0x64, 0x7a, 0x43, 0xfb, 0xb4, 0x25, 0xbd, 0xd8, 0xdd, 0x23,
+	0x29, 0x9e, 0xe2, 0x61, 0x21, 0x8b, 0x0f, 0x84, 0x21, 0xc2, 0x64, 0x3b, 0x1a, 0x08, 0xac, 0x03,
+	0x7f, 0x46, 0xf7, 0xff, 0x4b, 0x01, 0xa6, 0x2c, 0xb3, 0x5a, 0x61, 0xb3, 0xb7, 0xc3, 0x7b, 0x98,
+	0xf0, 0xc6, 0xe7, 0x2d, 0x29, 0x5d, 0x36, 0xab, 0x4c, 0xea, 0x26, 0x02, 0x88, 0x56, 0x78, 0x2c,
+	0x1c, 0x8e, 0xb9, 0xee, 0xf2, 0x84, 0x08, 0x2a, 0x69, 0x9e, 0x1c, 0x11, 0x4b, 0xb3, 0xec, 0x88,
+	0x58, 0x23, 0x81, 0x58, 0x6b, 0x96, 0x1d, 0x11, 0x4b, 0xb3, 0xec, 0x7e, 0x8a, 0xc5, 0x93, 0x43,
+	0x5b, 0x30, 0xda, 0xa4, 0xa1, 0x34, 0x37, 0x4a, 0x82, 0x55, 0x6a, 0xe3, 0x44, 0x5e, 0xc4, 0x15,
+	0xd2, 0x34, 0x62, 0x7d, 0x44, 0xc5, 0x7f, 0x0a, 0x72, 0xcf, 0x58, 0xd7, 0xdc, 0xc3, 0xed, 0x81,
+	0xd0, 0x97, 0x3d, 0x90, 0x4b, 0x3f, 0xa9, 0x3e, 0xa6, 0x9f, 0xff, 0x1f, 0x81, 0xa1, 0xe2, 0x31,
+	0xd6, 0x6d, 0xf4, 0x0a, 0x8c, 0x62, 0xe7, 0x21, 0x88, 0xf7, 0x8c, 0x13, 0x36, 0x64, 0xd2, 0x0d,
+	0x1b, 0x32, 0xbd, 0xa1, 0x29, 0xde, 0x03, 0x7a, 0x35, 0x14, 0xe3, 0xb3, 0x61, 0x15, 0x13, 0xc4,
+	0xd8, 0xf8, 0xe6, 0x74, 0x94, 0xe8, 0x8b, 0x8e, 0x3e, 0x10, 0xe0, 0x1a, 0xef, 0x85, 0x6e, 0xf4,
+	0x57, 0xce, 0x5b, 0xd2, 0x04, 0xef, 0x82, 0xb3, 0x56, 0xff, 0xfc, 0x6f, 0x22, 0x44, 0x0b, 0x1d,
+	0x30, 0xf9, 0xc7, 0x4d, 0x0d, 0x5b, 0xe1, 0xfc, 0x73, 0x9d, 0x66, 0x92, 0x10, 0xd7, 0x67, 0xc9,
+	0x44, 0x91, 0x2d, 0x79, 0xf8, 0x73, 0xdd, 0x92, 0xdb, 0xe5, 0xa4, 0x91, 0xdf, 0xce, 0x9c, 0x34,
+	0xfa, 0x7c, 0x73, 0x52, 0x99, 0xc9, 0x49, 0x63, 0xbd, 0xe5, 0xa4, 0xcc, 0x45, 0x4b, 0x42, 0x1e,
+	0x12, 0x93, 0x6b, 0x82, 0xcc, 0x94, 0x83, 0x91, 0x43, 0x6c, 0x59, 0x6a, 0xcd, 0x4d, 0x35, 0x63,
+	0x85, 0x19, 0xc7, 0xbf, 0xe8, 0x10, 0x83, 0xe1, 0x41, 0xd1, 0xa8, 0xff, 0x13, 0x01, 0x66, 0xd6,
+	0x31, 0x2b, 0xa0, 0x82, 0xdf, 0x3d, 0xc2, 0x96, 0x8d, 0x1a, 0xd1, 0x28, 0x12, 0x88, 0xde, 0xd6,
+	0x22, 0x51, 0xf4, 0xd9, 0x43, 0x45, 0x36, 0x20, 0xc3, 0x8b, 0x61, 0x35, 0x0d, 0xdd, 0xc2, 0x68,
+	0x2f, 0xf6, 0x43, 0xe5, 0x66, 0x58, 0x63, 0x31, 0x5f, 0x2a, 0xee, 0x61, 0x87, 0xe1, 0x12, 0xfa,
+	0x44, 0x91, 0xe7, 0x61, 0x76, 0xb3, 0x1e, 0xb2, 0x8c, 0x45, 0x57, 0x2e, 0xff, 0x04, 0xe6, 0xa2,
+	0x53, 0x54, 0x9a, 0x1f, 0xc2, 0x04, 0x2b, 0x8d, 0x73, 0x1c, 0x4b, 0xf4, 0x26, 0xce, 0x34, 0x4d,
+	0x5d, 0xe3, 0x16, 0x4b, 0x37, 0xf4, 0x26, 0x3f, 0x86, 0x99, 0xbc, 0xa6, 0xc5, 0x18, 0xa3, 0x18,
+	0xab, 0x84, 0xe0, 0x3c, 0x44, 0x3f, 0x14, 0x59, 0xc6, 0x85, 0xe4, 0x47, 0xfc, 0x77, 0x99, 0xa3,
+	0x65, 0x9e, 0xfe, 0xd5, 0x6a, 0xf9, 0x17, 0x02, 0x2c, 0xee, 0xe9, 0x26, 0xae, 0xd5, 0x2d, 0x1b,
+	0x9b, 0xcf, 0xdd, 0xcb, 0x24, 0xb8, 0xd1, 0x46, 0x1a, 0x57, 0x0d, 0xf2, 0x07, 0x02, 0xcc, 0xd2,
+	0xef, 0xad, 0xe7, 0x2c, 0xea, 0xbb, 0x30, 0x17, 0x15, 0xe4, 0x6a, 0x8d, 0xf5, 0x6f, 0x02, 0xc8,
+	0xe5, 0x50, 0x10, 0x96, 0x4f, 0xf4, 0x6a, 0x41, 0xd5, 0xb5, 0xf7, 0xea, 0x9a, 0x7d, 0xf0, 0x5c,
+	0xf4, 0x80, 0xee, 0x40, 0x7a, 0xff, 0xc4, 0xc6, 0x56, 0xa5, 0x89, 0xcd, 0x8a, 0x85, 0xab, 0x06,
+	0x3d, 0x65, 0x24, 0x94, 0x49, 0x32, 0xbe, 0x83, 0xcd, 0x32, 0x19, 0x95, 0xff, 0x71, 0x10, 0x6e,
+	0x75, 0x14, 0x9f, 0x6a, 0xef, 0xfd, 0x76, 0xf2, 0xef, 0xc5, 0x1d, 0x0f, 0xc2, 0xe2, 0xf4, 0x61,
+	0x41, 0x1b, 0x30, 0xd3, 0x34, 0xf1, 0x71, 0x25, 0x7e, 0x55, 0x5e, 0xa6, 0xc7, 0xc7, 0x85, 0xd0,
+	0xea, 0x94, 0x98, 0x31, 0xf4, 0xad, 0x18, 0xdd, 0x24, 0x08, 0x15, 0x74, 0xd1, 0x92, 0x38, 0xfd,
+	0x44, 0xf4, 0xf5, 0xd3, 0x04, 0x64, 0xc3, 0xfa, 0xca, 0x37, 0x9b, 0x58, 0xd7, 0xbe, 0x7b, 0x64,
+	0xd8, 0xea, 0xf3, 0x31, 0x76, 0x99, 0x39, 0x30, 0xb9, 0x75, 0x8c, 0xfb, 0xcc, 0x81, 0xe9, 0x19,
+	0xcf, 0x46, 0x1a, 0x7f, 0x36, 0x72, 0xeb, 0x1c, 0x6f, 0x72, 0x67, 0xa3, 0xcf, 0x78, 0x04, 0xfa,
+	0x26, 0x0c, 0xbd, 0xeb, 0x28, 0x8e, 0xd6, 0x8a, 0xe6, 0x42, 0x85, 0x34, 0x46, 0xb1, 0x34, 0x2d,
+	0xbb, 0xc0, 0xf2, 0x2f, 0x92, 0x70, 0xb3, 0x83, 0x0d, 0x7e, 0x3b, 0x3c, 0xf6, 0x71, 0xc4, 0x2a,
+	0xfd, 0xfd, 0x8c, 0x7e, 0x3f, 0xde, 0x40, 0x9f, 0xd7, 0xe1, 0xf5, 0x3b, 0x00, 0x24, 0x20, 0x7b,
+	0x33, 0xdf, 0x75, 0xaf, 0x44, 0xe4, 0xe0, 0xb8, 0x66, 0x0a, 0x1e, 0x9d, 0x82, 0x85, 0x4b, 0x66,
+	0xa8, 0x0b, 0x99, 0x09, 0x4a, 0xc6, 0x05, 0xf7, 0xdc, 0xe1, 0x09, 0x5c, 0x5b, 0xc7, 0x36, 0x51,
+	0x90, 0x17, 0x80, 0x6c, 0x48, 0x08, 0x7d, 0x0a, 0x09, 0x79, 0x0f, 0xd2, 0x01, 0x1f, 0xea, 0x64,
+	0x79, 0x18, 0x22, 0xd3, 0x74, 0x37, 0xc9, 0x46, 0xce, 0x16, 0x04, 0x9c, 0xa9, 0xff, 0x92, 0x7a,
+	0x0b, 0x41, 0x51, 0xdc, 0x3f, 0xf2, 0x53, 0x98, 0x76, 0xe7, 0xf7, 0xf1, 0xd5, 0xaf, 0xe1, 0x6f,
+	0x05, 0x98, 0xe1, 0xb8, 0xd1, 0x95, 0x7c, 0xeb, 0xb2, 0x2b, 0xa1, 0x21, 0x49, 0x90, 0xd0, 0x23,
+	0x48, 0x05, 0xde, 0xe8, 0x14, 0xce, 0x9c, 0xf3, 0xdd, 0x72, 0x84, 0x86, 0xef, 0x4e, 0x11, 0x3a,
+	0xe0, 0x3b, 0x97, 0x25, 0x4f, 0xc1, 0x75, 0xe7, 0x28, 0x49, 0x18, 0xfa, 0xe7, 0xcb, 0xc7, 0x80,
+	0xd8, 0x41, 0x2a, 0xf5, 0x5b, 0x30, 0x4c, 0x04, 0xf0, 0x8e, 0x94, 0xdd, 0xc5, 0x9e, 0xa4, 0x3e,
+	0x44, 0xf1, 0x14, 0xfa, 0x57, 0xce, 0xc1, 0xb5, 0xbc, 0xa6, 0x85, 0x2c, 0xb0, 0x08, 0x63, 0x55,
+	0xe3, 0xb0, 0xa9, 0x56, 0x6d, 0xec, 0x9a, 0x60, 0x54, 0x09, 0x06, 0x1c, 0x77, 0x08, 0x10, 0xfa,
+	0xe7, 0x0e, 0x87, 0x90, 0x09, 0x4e, 0x5b, 0x57, 0xef, 0x10, 0xf3, 0x30, 0x1b, 0x61, 0x47, 0x8f,
+	0x75, 0x1f, 0x0b, 0x30, 0xb5, 0x8e, 0x6d, 0xdf, 0x66, 0x57, 0x29, 0x47, 0x74, 0xbf, 0x19, 0xbc,
+	0x82, 0xfd, 0x46, 0xfe, 0x03, 0x98, 0x0e, 0xaf, 0x88, 0xda, 0x4d, 0x01, 0x08, 0xb8, 0x53, 0xe3,
+	0xf5, 0xe6, 0xbd, 0x13, 0x4e, 0x56, 0xf3, 0x59, 0x28, 0xc1, 0xa3, 0xdc, 0x80, 0x19, 0xc7, 0x61,
+	0x7d, 0x24, 0xeb, 0x4a, 0xed, 0x68, 0x41, 0x86, 0xe7, 0x46, 0xd7, 0xf6, 0x4e, 0x38, 0x34, 0x85,
+	0x4b, 0x84, 0x26, 0xf2, 0x0a, 0x47, 0x41, 0x70, 0x86, 0x02, 0xf5, 0x9f, 0x04, 0x98, 0xca, 0x6b,
+	0xda, 0xe7, 0xe3, 0x21, 0x6b, 0x30, 0xca, 0x5c, 0xd7, 0x38, 0x8b, 0x90, 0x23, 0x8b, 0xa0, 0xb7,
+	0x2d, 0x5c, 0x76, 0x11, 0x14, 0x1f, 0x53, 0xfe, 0x01, 0x4c, 0x87, 0x25, 0xa6, 0x5a, 0x5a, 0x7d,
+	0x56, 0x0f, 0x60, 0x4d, 0xfe, 0xeb, 0x41, 0xc8, 0xec, 0x91, 0x12, 0xe1, 0x97, 0x28, 0x68, 0xd0,
+	0x36, 0x4c, 0x36, 0x8d, 0x66, 0x33, 0xb8, 0xf4, 0xa2, 0x25, 0xc7, 0x5e, 0xd5, 0x3f, 0xa0, 0x4c,
+	0xb8, 0xf8, 0x74, 0x9a, 0x10, 0x3c, 0xb2, 0x0e, 0x18, 0x82, 0xc9, 0x4b, 0x13, 0x24, 0xf8, 0x74,
+	0x5a, 0xfe, 0x70, 0x10, 0x66, 0x23, 0x7a, 0xef, 0xa3, 0x61, 0xd1, 0x23, 0xee, 0x42, 0xc7, 0xbd,
+	0x18, 0xba, 0x13, 0xbd, 0xd0, 0x99, 0x61, 0xee, 0x70, 0xd8, 0xeb, 0x4c, 0x66, 0x18, 0x55, 0xe1,
+	0x5a, 0x40, 0xac, 0xf7, 0x9b, 0x9a, 0xc5, 0x8b, 0x96, 0x34, 0x67, 0x84, 0xc6, 0x18, 0x0e, 0x93,
+	0xe1, 0x19, 0xf9, 0xbf, 0x04, 0x10, 0x83, 0xc4, 0xfe, 0x65, 0xca, 0xe1, 0x37, 0x60, 0x21, 0x76,
+	0x61, 0x74, 0xd7, 0xfa, 0x68, 0x10, 0x6e, 0x28, 0xd8, 0xb9, 0x7b, 0x65, 0xe6, 0x88, 0x9b, 0x7c,
+	0xf5, 0x75, 0x76, 0x49, 0x4d, 0x67, 0x61, 0xa9, 0x9d, 0x26, 0x3d, 0x65, 0x0b, 0x90, 0x2a, 0x63,
+	0xb5, 0xe1, 0xa9, 0xf6, 0x0b, 0xec, 0x56, 0x7f, 0x96, 0x80, 0x71, 0x77, 0x29, 0x34, 0x71, 0x68,
+	0x71, 0xfb, 0x66, 0x2e, 0xf4, 0x6d, 0xc2, 0xeb, 0x25, 0xa6, 0xdf, 0xa3, 0xcb, 0x16, 0x8a, 0x6a,
+	0x90, 0xb2, 0xb0, 0xda, 0xc0, 0x5a, 0xa5, 0xd6, 0xb0, 0x74, 0x9a, 0x58, 0x1e, 0x9e, 0xb7, 0x24,
+	0x28, 0x93, 0xe1, 0xf5, 0xcd, 0x72, 0xc9, 0x41, 0xb7, 0xfc, 0xb7, 0x4f, 0x5b, 0xd2, 0xed, 0xee,
+	0xeb, 0x74, 0x20, 0x15, 0x0f, 0xab, 0x61, 0xe9, 0x91, 0x14, 0x96, 0xe8, 0x73, 0x0a, 0x4b, 0xf6,
+	0x3d, 0x85, 0xfd, 0x87, 0x00, 0x13, 0x7b, 0xba, 0xf5, 0xe5, 0x70, 0xaf, 0xbf, 0x1a, 0x84, 0x49,
+	0x6f, 0x31, 0x57, 0x77, 0xe8, 0xfc, 0x02, 0x6e, 0x54, 0xff, 0x9a, 0x80, 0x94, 0x53, 0x72, 0xfc,
+	0x12, 0x1c, 0x94, 0x8e, 0xe3, 0xef, 0xf3, 0xdc, 0xdc, 0xbc, 0x1e, 0x77, 0x9f, 0xd7, 0x9f, 0x1b,
+	0xbb, 0xe3, 0xf8, 0x0b, 0xbb, 0x64, 0xc0, 0x97, 0xbf, 0xb0, 0xeb, 0xcb, 0x95, 0x9c, 0xfc, 0x7f,
+	0x02, 0x8c, 0xbb, 0xa6, 0xa3, 0x1e, 0x9d, 0x83, 0x61, 0xda, 0xfb, 0xe5, 0x7a, 0xf3, 0x6c, 0xb8,
+	0x31, 0xee, 0x44, 0xaf, 0xba, 0xbd, 0x5b, 0x0a, 0x05, 0xfb, 0x02, 0xba, 0xeb, 0x06, 0xf9, 0x26,
+	0xf6, 0x49, 0x78, 0x5e, 0x7b, 0x2f, 0xb6, 0xe3, 0xe7, 0x1a, 0xb7, 0x90, 0x90, 0xbc, 0xf2, 0x0f,
+	0xc9, 0xc7, 0x28, 0x43, 0x8a, 0x6a, 0x71, 0x0d, 0xc6, 0x7c, 0x30, 0x5e, 0x91, 0xdc, 0x0a, 0xdc,
+	0x4c, 0xe0, 0x43, 0x2b, 0xc1, 0xa3, 0x3c, 0xeb, 0x7e, 0x7e, 0xfa, 0xa0, 0x7e, 0x21, 0x05, 0x43,
+	0x86, 0x9f, 0xa0, 0x8c, 0x1f, 0x01, 0xf8, 0xf8, 0xde, 0x86, 0xd7, 0x96, 0xb3, 0xbf, 0xb1, 0x05,
+	0x28, 0x0a, 0xf3, 0x2c, 0x6f, 0x42, 0x66, 0x55, 0xd5, 0xab, 0xb8, 0xd1, 0x17, 0x5d, 0x55, 0x60,
+	0x36, 0x42, 0xad, 0xaf, 0xea, 0xd2, 0x00, 0xbd, 0xad, 0xda, 0xd5, 0x03, 0xd2, 0x76, 0xe1, 0x7f,
+	0xaa, 0xbf, 0x06, 0x93, 0xea, 0x13, 0x1b, 0x9b, 0x15, 0xae, 0xb5, 0x23, 0x7d, 0xde, 0x92, 0xc6,
+	0xf3, 0xce, 0x0c, 0xed, 0xef, 0x50, 0xc6, 0xd5, 0xe0, 0x4d, 0x43, 0x19, 0x18, 0x7e, 0x62, 0x34,
+	0x1a, 0xc6, 0x7b, 0xc4, 0xa3, 0x47, 0x15, 0xfa, 0x26, 0xff, 0xa9, 0x00, 0x53, 0x21, 0x36, 0x74,
+	0x0d, 0xf7, 0x61, 0x88, 0x70, 0xa0, 0xf2, 0x4f, 0xc5, 0xb4, 0x82, 0x04, 0xc5, 0x4f, 0x02, 0xa9,
+	0xb8, 0x7f, 0xd0, 0xab, 0x30, 0x66, 0x9b, 0x47, 0x7a, 0x55, 0x75, 0x6a, 0x54, 0x84, 0x59, 0x61,
+	0xf6, 0xa2, 0x25, 0x4d, 0xf9, 0x83, 0x8c, 0x2f, 0x07, 0x90, 0xf2, 0x7f, 0x0a, 0x90, 0xda, 0x35,
+	0xeb, 0xfe, 0xf7, 0xc0, 0xe3, 0x48, 0xd6, 0xed, 0x6f, 0xb5, 0xba, 0x02, 0x63, 0xa4, 0x0d, 0x95,
+	0x39, 0xe4, 0x14, 0xce, 0x5b, 0xd2, 0xe8, 0xa6, 0x6a, 0xd9, 0xf4, 0x88, 0x33, 0xda, 0xa0, 0xcf,
+	0x97, 0x38, 0xe0, 0xb8, 0x38, 0x0d, 0x4b, 0x97, 0xff, 0x61, 0x10, 0xc0, 0x5d, 0x90, 0x75, 0xd4,
+	0xb0, 0x9f, 0x77, 0xf1, 0xdf, 0x8a, 0xdf, 0x6f, 0xae, 0xb6, 0xc7, 0xcd, 0x6f, 0xb3, 0x4a, 0x74,
+	0x6b, 0xb3, 0x92, 0xdf, 0x82, 0x71, 0xaa, 0x2c, 0xcf, 0xff, 0x46, 0x4c, 0xa2, 0x38, 0x2f, 0xec,
+	0xb9, 0x6e, 0xda, 0x40, 0xb3, 0xf4, 0x03, 0xdc, 0x03, 0x97, 0x3f, 0x1d, 0x84, 0x9b, 0xab, 0x07,
+	0xb8, 0xfa, 0xb4, 0x69, 0xd4, 0x75, 0xfb, 0xab, 0x4f, 0xae, 0xcf, 0x68, 0x43, 0x04, 0xc9, 0xa6,
+	0x6a, 0x1f, 0x90, 0xbd, 0x7b, 0x4c, 0x21, 0xcf, 0x68, 0x0e, 0x46, 0x54, 0xb3, 0x7a, 0x50, 0x3f,
+	0xc6, 0xe4, 0x82, 0x64, 0x54, 0xf1, 0x5e, 0x65, 0x0b, 0xe4, 0x4e, 0xba, 0xa7, 0xc6, 0xdd, 0x02,
+	0xa8, 0xfa, 0x50, 0x34, 0xc3, 0x7c, 0xad, 0xe3, 0x77, 0x4c, 0x40, 0xd4, 0xab, 0xce, 0x07, 0x04,
+	0x64, 0x0b, 0xb2, 0xeb, 0xd8, 0xf6, 0x3e, 0x75, 0x14, 0xdc, 0x34, 0xac, 0xba, 0x6d, 0x98, 0x27,
+	0xec, 0xad, 0xff, 0x36, 0x8c, 0xb0, 0x76, 0x4e, 0x16, 0x5e, 0x3b, 0x6f, 0x49, 0xc3, 0xbe, 0x81,
+	0xef, 0x74, 0xd7, 0x10, 0xb5, 0xec, 0xb0, 0xee, 0x1e, 0x35, 0x7e, 0x0c, 0x37, 0x3b, 0x30, 0xa5,
+	0x0b, 0xfd, 0x3d, 0x48, 0x32, 0x37, 0xfb, 0x5f, 0x8b, 0x1c, 0xa5, 0xdb, 0xa0, 0x13, 0x24, 0x79,
+	0x19, 0x64, 0x67, 0x5b, 0x8c, 0x87, 0xf1, 0x37, 0x4f, 0x0b, 0x6e, 0x75, 0x84, 0xa2, 0x92, 0x6c,
+	0xc2, 0x10, 0xdb, 0xe8, 0xd2, 0xab, 0x28, 0x41, 0x8e, 0x27, 0xd8, 0x8a, 0xfb, 0x47, 0xfe, 0x9f,
+	0x41, 0x72, 0x52, 0xd8, 0x52, 0xb6, 0xf0, 0xe1, 0x3e, 0x36, 0x2d, 0x66, 0xeb, 0x1b, 0x6e, 0x60,
+	0x55, 0xc3, 0x26, 0xd5, 0xf2, 0xdd, 0xcb, 0xe9, 0xd6, 0xc5, 0x45, 0x25, 0x40, 0xde, 0xaf, 0x22,
+	0x9c, 0x1d, 0xf9, 0x89, 0x5a, 0xb5, 0x0d, 0x93, 0x06, 0x8e, 0x74, 0xd1, 0x92, 0x16, 0x98, 0xd9,
+	0x87, 0x64, 0x92, 0x49, 0x28, 0xd7, 0x23, 0x93, 0xe8, 0x3d, 0xa7, 0xa9, 0x8a, 0x08, 0x3a, 0x97,
+	0x08, 0x7f, 0x34, 0xbb, 0xc9, 0x24, 0x6e, 0x29, 0x2b, 0xf4, 0xdd, 0xe9, 0xaa, 0x3e, 0x29, 0xdc,
+	0xfd, 0xe3, 0x5f, 0x5d, 0x62, 0x1d, 0x1e, 0x37, 0xf1, 0x01, 0x8c, 0xb3, 0x64, 0x50, 0x1a, 0x12,
+	0x4f, 0xf1, 0x89, 0xab, 0x1b, 0xc5, 0x79, 0x44, 0xd3, 0x30, 0x74, 0xac, 0x36, 0x8e, 0xdc, 0x1f,
+	0x57, 0x8c, 0x29, 0xee, 0xcb, 0x83, 0xc1, 0xfb, 0x82, 0x6c, 0x42, 0x36, 0xaf, 0x69, 0x9d, 0xbd,
+	0xfa, 0x36, 0x8c, 0x9a, 0xea, 0x13, 0xbb, 0x72, 0x64, 0x36, 0x08, 0xd1, 0xb1, 0x42, 0xca, 0xc9,
+	0x2b, 0x8a, 0xfa, 0xc4, 0xde, 0x53, 0x36, 0x95, 0x11, 0x67, 0x72, 0xcf, 0x6c, 0x10, 0xb8, 0x66,
+	0xb5, 0xa2, 0x6a, 0x9a, 0xab, 0x46, 0x0f, 0x6e, 0x67, 0x35, 0xaf, 0x69, 0xa6, 0x32, 0x62, 0x36,
+	0xab, 0xce, 0x83, 0xe3, 0xd4, 0x1d, 0x78, 0xf6, 0xc3, 0xa9, 0xf7, 0xc9, 0x1d, 0xd5, 0x96, 0xb2,
+	0x83, 0xb1, 0x79, 0x55, 0xab, 0xf8, 0x09, 0x5c, 0x67, 0x78, 0x50, 0xa9, 0xab, 0x7c, 0x02, 0xf8,
+	0x4e, 0x90, 0x00, 0x2e, 0x5a, 0x52, 0x5a, 0x8f, 0xf6, 0x08, 0x5e, 0x3e, 0x29, 0xfc, 0x91, 0x00,
+	0xb7, 0xd6, 0x70, 0x03, 0xdb, 0xb8, 0xb3, 0xdd, 0xde, 0xe1, 0x85, 0x79, 0x33, 0x24, 0x0c, 0x25,
+	0xf7, 0x4c, 0x22, 0xdc, 0x86, 0xe5, 0xce, 0x12, 0xd0, 0x42, 0xd9, 0x1b, 0x30, 0xe5, 0x96, 0xd2,
+	0x9e, 0xc9, 0x16, 0x72, 0x06, 0xa6, 0xc3, 0xe8, 0x94, 0xec, 0xcf, 0x05, 0xf8, 0xfa, 0xae, 0xa9,
+	0xea, 0xd6, 0x13, 0x6c, 0x46, 0x25, 0xd8, 0x24, 0xf1, 0x6d, 0x1d, 0xd4, 0x9b, 0x9f, 0x83, 0x26,
+	0x56, 0xe0, 0x6e, 0x6f, 0x92, 0xb8, 0xa2, 0xbf, 0xf4, 0xd7, 0x02, 0x40, 0xf0, 0x23, 0x1d, 0xa7,
+	0x63, 0x69, 0x4d, 0xc9, 0x6f, 0x94, 0x2a, 0xe5, 0xdd, 0xfc, 0x6e, 0xb1, 0x52, 0xda, 0x2e, 0x15,
+	0xd3, 0x03, 0x22, 0x3a, 0x3d, 0xcb, 0x4e, 0x06, 0x50, 0x25, 0x43, 0xc7, 0xe8, 0x65, 0x98, 0x66,
+	0x21, 0xc9, 0xf3, 0x46, 0x69, 0x3d, 0x2d, 0x88, 0x99, 0xd3, 0xb3, 0x2c, 0x0a, 0xa0, 0xc9, 0x53,
+	0x5d, 0xaf, 0xa1, 0xbb, 0x80, 0x58, 0x8c, 0x87, 0xf9, 0x8d, 0xcd, 0xe2, 0x5a, 0x7a, 0x50, 0x9c,
+	0x3e, 0x3d, 0xcb, 0xa6, 0x03, 0xf8, 0x87, 0x6a, 0xbd, 0x81, 0x35, 0x31, 0xf9, 0xf3, 0xbf, 0x5b,
+	0x1a, 0x78, 0xe9, 0xef, 0x07, 0x61, 0x22, 0xf4, 0x33, 0x0b, 0xf4, 0x4d, 0xc8, 0x6c, 0xef, 0x14,
+	0x95, 0xfc, 0xee, 0xc6, 0x76, 0xa9, 0xf2, 0x68, 0xa3, 0xb4, 0x56, 0xd9, 0x2b, 0x3d, 0x2a, 0x6d,
+	0xbf, 0x5d, 0x4a, 0x0f, 0x88, 0x73, 0xa7, 0x67, 0xd9, 0xe9, 0x10, 0xf8, 0x9e, 0xfe, 0x54, 0x37,
+	0xde, 0xd3, 0xd1, 0x0a, 0x4c, 0x71, 0x58, 0xe5, 0x62, 0x7e, 0x33, 0x2d, 0x88, 0x33, 0xa7, 0x67,
+	0xd9, 0xeb, 0x21, 0x14, 0xa7, 0xe0, 0x87, 0xee, 0xc1, 0x4c, 0x84, 0x0b, 0xc1, 0x18, 0x14, 0x67,
+	0x4f, 0xcf, 0xb2, 0x53, 0x1c, 0x13, 0xa7, 0xa0, 0x14, 0xc7, 0xe3, 0x9d, 0xd2, 0x6a, 0x3a, 0x11,
+	0xc7, 0xe3, 0x44, 0xaf, 0xa2, 0x87, 0x90, 0xe5, 0x79, 0xec, 0xac, 0x39, 0x9a, 0xd9, 0xdc, 0x5e,
+	0xaf, 0x94, 0x77, 0x95, 0x62, 0x7e, 0x2b, 0x9d, 0x14, 0xb3, 0xa7, 0x67, 0xd9, 0xc5, 0x30, 0xbb,
+	0xf0, 0x15, 0x0b, 0xd5, 0xd4, 0x3f, 0x0f, 0xc2, 0x64, 0xf8, 0x93, 0x1a, 0xbd, 0x06, 0xb3, 0x01,
+	0x03, 0x57, 0xe9, 0x81, 0xae, 0xe6, 0x4f, 0xcf, 0xb2, 0x33, 0x61, 0x04, 0x4f, 0x59, 0x31, 0x78,
+	0xca, 0x5e, 0x89, 0x5a, 0x37, 0x06, 0x4f, 0x39, 0xd2, 0x89, 0x81, 0x1f, 0xc0, 0x3c, 0x8f, 0x57,
+	0xde, 0x5b, 0x5d, 0x2d, 0x16, 0xd7, 0x88, 0x9d, 0x17, 0x4e, 0xcf, 0xb2, 0xb3, 0x61, 0xcc, 0xf2,
+	0x51, 0xb5, 0x8a, 0xb1, 0x86, 0x39, 0xb3, 0x86, 0x1c, 0x24, 0xc1, 0x99, 0x95, 0x71, 0x12, 0x74,
+	0x1f, 0xe6, 0x78, 0xac, 0xd5, 0x7c, 0x69, 0xb5, 0xe8, 0xe0, 0x25, 0x45, 0xf1, 0xf4, 0x2c, 0x9b,
+	0x09, 0xe3, 0xb9, 0x5f, 0xaf, 0xbe, 0x7b, 0xfd, 0x4b, 0x12, 0xc6, 0xfc, 0x0e, 0x7f, 0xc7, 0x41,
+	0x8b, 0xdf, 0x2b, 0x96, 0x76, 0x79, 0xb7, 0x22, 0x0e, 0xea, 0x83, 0x79, 0x5a, 0xfa, 0x36, 0x2c,
+	0x32, 0xd0, 0x6f, 0x15, 0xf3, 0xca, 0x6e, 0xa1, 0x98, 0xdf, 0xad, 0xec, 0x6e, 0x6c, 0x15, 0xb7,
+	0xf7, 0x76, 0xd3, 0x82, 0x78, 0xe3, 0xf4, 0x2c, 0x3b, 0xef, 0xe3, 0x85, 0x7e, 0xa5, 0x67, 0x1c,
+	0xd9, 0xe8, 0x4d, 0xb8, 0xc1, 0x10, 0x08, 0x8c, 0x4e, 0x5c, 0xd3, 0x51, 0xf6, 0x20, 0x47, 0xc1,
+	0x37, 0xb9, 0xe3, 0xa2, 0x8e, 0xc2, 0x7f, 0x1f, 0x16, 0xdb, 0x53, 0x20, 0xaa, 0x5b, 0x3c, 0x3d,
+	0xcb, 0xce, 0xc5, 0x13, 0xc0, 0x1a, 0x2a, 0xc0, 0x52, 0x3c, 0xbe, 0xeb, 0xec, 0x44, 0x89, 0x4b,
+	0xa7, 0x67, 0x59, 0x31, 0x4a, 0xc1, 0xf5, 0x79, 0xac, 0xa1, 0xdf, 0x85, 0x39, 0x86, 0x86, 0xe3,
+	0xf1, 0x95, 0x1d, 0x65, 0x7b, 0x5d, 0x29, 0x96, 0xcb, 0xe9, 0x21, 0xd7, 0x5b, 0x7c, 0x6c, 0xc7,
+	0xed, 0xfd, 0x1f, 0x87, 0xbd, 0x0e, 0xf3, 0x3c, 0xe2, 0xea, 0xf6, 0xd6, 0xce, 0x66, 0x71, 0xb7,
+	0xb8, 0x96, 0x1e, 0x76, 0x8d, 0x17, 0xc2, 0x5c, 0x35, 0x0e, 0x9b, 0x4e, 0x8e, 0xd7, 0xd0, 0x37,
+	0x20, 0xc3, 0xa3, 0x52, 0x67, 0x19, 0x71, 0xc3, 0x33, 0x84, 0x47, 0x7d, 0xa5, 0x08, 0xd9, 0xf8,
+	0xc5, 0x2a, 0xc5, 0x9d, 0xcd, 0x8d, 0xd5, 0x7c, 0x65, 0x7d, 0x35, 0x3d, 0x2a, 0x4a, 0xa7, 0x67,
+	0xd9, 0x85, 0xe8, 0x72, 0xe9, 0x89, 0x7c, 0x7d, 0xd5, 0x75, 0x9c, 0x7b, 0xff, 0xbe, 0x00, 0x93,
+	0xab, 0x8d, 0x23, 0xcb, 0xc6, 0xe6, 0x96, 0xaa, 0xab, 0x35, 0x6c, 0xa2, 0x1f, 0xc1, 0x64, 0xb8,
+	0x0b, 0x1c, 0xdd, 0x8a, 0x1c, 0xb8, 0xa2, 0x9d, 0xb9, 0xe2, 0x72, 0x67, 0x20, 0xba, 0xc3, 0x0c,
+	0xa0, 0x2a, 0xa4, 0xf9, 0xc6, 0x6e, 0xf4, 0x42, 0x18, 0xb7, 0x4d, 0x4f, 0xb8, 0x78, 0xbb, 0x1b,
+	0x98, 0xcf, 0xe4, 0x47, 0x30, 0x19, 0xee, 0xb1, 0xe6, 0xd7, 0x10, 0xdb, 0xe1, 0x2d, 0x2e, 0x77,
+	0x06, 0xf2, 0xc9, 0x9b, 0x30, 0x13, 0xdb, 0xc2, 0x8c, 0x5e, 0x0a, 0x13, 0xe8, 0xd4, 0x75, 0x2d,
+	0x7e, 0xbd, 0x27, 0x58, 0x56, 0x6f, 0x7c, 0x2f, 0x32, 0xaf, 0xb7, 0x36, 0x4d, 0xd3, 0xe2, 0xed,
+	0x6e, 0x60, 0x3e, 0x93, 0x9f, 0x09, 0xb0, 0xd0, 0xa1, 0x7d, 0x17, 0xbd, 0x1c, 0xa6, 0xd4, 0xbd,
+	0x51, 0x59, 0x7c, 0xe5, 0x12, 0x18, 0xbe, 0x18, 0x7f, 0x08, 0xf3, 0x6d, 0x1b, 0x32, 0xd1, 0x4a,
+	0x27, 0x8a, 0xd1, 0xee, 0x59, 0x31, 0xd7, 0x33, 0xbc, 0xcf, 0xff, 0x11, 0x8c, 0x7a, 0xad, 0x79,
+	0xe8, 0x46, 0xc4, 0xaf, 0xd9, 0x2e, 0x2a, 0x71, 0xa9, 0xdd, 0xb4, 0x4f, 0xec, 0xfb, 0x30, 0x11,
+	0x6a, 0x91, 0x43, 0x32, 0x67, 0x8e, 0x98, 0x6e, 0x3d, 0xf1, 0x56, 0x47, 0x18, 0x9f, 0xf6, 0x77,
+	0x01, 0x82, 0x2e, 0x36, 0x24, 0x45, 0xe3, 0x23, 0xd4, 0xf4, 0x26, 0x66, 0xdb, 0x03, 0xb0, 0x6b,
+	0xf7, 0xfa, 0xd0, 0xf8, 0xb5, 0x73, 0x0d, 0x6d, 0xe2, 0x52, 0xbb, 0x69, 0x9f, 0xd8, 0x8f, 0xe1,
+	0x1a, 0xd7, 0x0e, 0x86, 0x96, 0xdb, 0xb9, 0x7d, 0x88, 0xf4, 0x0b, 0x5d, 0xa0, 0x7c, 0x0e, 0x6f,
+	0xc3, 0x38, 0xdb, 0x82, 0x85, 0x6e, 0x46, 0xec, 0xc1, 0x37, 0x2b, 0x88, 0x72, 0x27, 0x10, 0x36,
+	0x85, 0x84, 0x3b, 0xa0, 0xf8, 0x14, 0x12, 0xdb, 0x8d, 0x25, 0x2e, 0x77, 0x06, 0x62, 0xe5, 0x66,
+	0x1b, 0x87, 0x78, 0xb9, 0x63, 0xda, 0xa0, 0x44, 0xb9, 0x13, 0x48, 0x48, 0xe5, 0xe1, 0x83, 0x55,
+	0x44, 0xe5, 0xb1, 0x2d, 0x45, 0xe2, 0x0b, 0x5d, 0xa0, 0x7c, 0x0e, 0x0d, 0x98, 0x8a, 0xe9, 0x98,
+	0x40, 0x77, 0xda, 0x99, 0x2c, 0xc2, 0xe9, 0xc5, 0x1e, 0x20, 0x7d, 0x6e, 0x47, 0x90, 0x89, 0xef,
+	0x1a, 0x40, 0x5c, 0x02, 0xed, 0xd8, 0xa5, 0x21, 0xde, 0xed, 0x0d, 0xd8, 0x67, 0xfb, 0x6d, 0x48,
+	0x92, 0x03, 0xf4, 0x3c, 0x9f, 0x3d, 0xfc, 0xeb, 0x63, 0x51, 0x8c, 0x9b, 0xf2, 0x09, 0x14, 0x61,
+	0x98, 0x9e, 0xa7, 0x17, 0xf8, 0xe5, 0x32, 0x77, 0xd0, 0xe2, 0x62, 0xfc, 0x64, 0x48, 0x0e, 0xe7,
+	0x90, 0xcd, 0xcb, 0x11, 0x5c, 0x71, 0x8a, 0x62, 0xdc, 0x14, 0x4b, 0xc0, 0x29, 0xb7, 0xf2, 0x04,
+	0x98, 0x6a, 0xbd, 0x28, 0xc6, 0x4d, 0xf9, 0x04, 0x7e, 0x2a, 0x80, 0xd8, 0xbe, 0x2c, 0x88, 0xb8,
+	0xf4, 0xda, 0xb5, 0x78, 0x2b, 0xbe, 0xdc, 0x3b, 0x02, 0x17, 0xe5, 0xc1, 0x2f, 0xe2, 0xa3, 0x51,
+	0xce, 0x5f, 0x0b, 0x89, 0x72, 0x27, 0x10, 0x3e, 0xca, 0xfd, 0xa9, 0xd8, 0x28, 0x8f, 0x5c, 0x7a,
+	0x89, 0xcb, 0x9d, 0x81, 0xd8, 0x60, 0xe4, 0xee, 0x99, 0xf8, 0x60, 0x8c, 0xbf, 0xd4, 0x12, 0x5f,
+	0xe8, 0x02, 0xe5, 0x73, 0xf8, 0x1e, 0xa4, 0x98, 0x1b, 0x20, 0xc4, 0x65, 0xf8, 0xe8, 0x1d, 0x94,
+	0x78, 0xb3, 0x03, 0x84, 0x47, 0xf5, 0x65, 0xc1, 0xd9, 0x82, 0xdb, 0x56, 0x48, 0xf9, 0x2d, 0xb8,
+	0x5b, 0xfd, 0x56, 0xcc, 0xf5, 0x0c, 0x1f, 0x3a, 0x89, 0x74, 0x28, 0x8d, 0xf2, 0x27, 0x91, 0xee,
+	0xb5, 0x56, 0xf1, 0x95, 0x4b, 0x60, 0xf8, 0x62, 0x6c, 0xc2, 0x38, 0x5b, 0x5f, 0x44, 0x99, 0xc8,
+	0xef, 0xa2, 0x8b, 0x4e, 0xbd, 0x29, 0xc6, 0xdb, 0x22, 0x35, 0x49, 0xf7, 0x5c, 0xd3, 0xb6, 0x42,
+	0xc7, 0x2b, 0xb5, 0x5b, 0xf9, 0x50, 0xcc, 0xf5, 0x0c, 0xef, 0xf3, 0x2f, 0xc1, 0x98, 0x5f, 0x5b,
+	0x43, 0xd1, 0xdd, 0x3b, 0x54, 0x4c, 0x12, 0xa5, 0xb6, 0xf3, 0x3e, 0xbd, 0x0f, 0x04, 0x58, 0xec,
+	0x54, 0xaf, 0x42, 0xaf, 0xf0, 0xc7, 0x98, 0xae, 0xd5, 0x35, 0xf1, 0xde, 0x65, 0x50, 0xd8, 0x04,
+	0xc1, 0x56, 0xb4, 0xf8, 0x04, 0x11, 0x53, 0x2c, 0x13, 0xe5, 0x4e, 0x20, 0x3e, 0xe1, 0xbf, 0x11,
+	0x60, 0xb9, 0x97, 0x42, 0x14, 0x7a, 0x9d, 0x4f, 0xa2, 0x3d, 0x97, 0xd1, 0xc4, 0x07, 0xcf, 0x82,
+	0xea, 0x49, 0x58, 0x78, 0xe3, 0xa3, 0xf3, 0x25, 0xe1, 0xe3, 0xf3, 0x25, 0xe1, 0xc3, 0x4f, 0x96,
+	0x06, 0x7e, 0xf9, 0xc9, 0x92, 0xf0, 0xf1, 0x27, 0x4b, 0x03, 0xff, 0xfd, 0xc9, 0xd2, 0xc0, 0xf7,
+	0x6f, 0xb5, 0xad, 0xba, 0x05, 0xff, 0xcf, 0xd2, 0xfe, 0x30, 0x79, 0xf9, 0xc6, 0x6f, 0x06, 0x00,
+	0x09, 0x32, 0x2d, 0x87, 0x7d, 0x49, 0x00, 0x00,
 }
 
-func (m *DescribeTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
+func (this *StorageNodeMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *DescribeTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*StorageNodeMetadata)
+	if !ok {
+		that2, ok := that.(StorageNodeMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DescribeTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.StorageNodeMetadataDescriptor.Equal(&that1.StorageNodeMetadataDescriptor) {
+		return false
+	}
+	if !this.CreateTime.Equal(that1.CreateTime) {
+		return false
+	}
+	if !this.LastHeartbeatTime.Equal(that1.LastHeartbeatTime) {
+		return false
+	}
+	if !this.DrainStatus.Equal(that1.DrainStatus) {
+		return false
+	}
+	return true
 }
+func (this *DrainStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DescribeTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LogStreams) > 0 {
-		for iNdEx := len(m.LogStreams) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	that1, ok := that.(*DrainStatus)
+	if !ok {
+		that2, ok := that.(DrainStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	{
-		size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *ListTopicsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.State != that1.State {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListTopicsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListTopicsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *ListTopicsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.TotalReplicas != that1.TotalReplicas {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListTopicsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.MovedReplicas != that1.MovedReplicas {
+		return false
+	}
+	if this.LogStreamID != that1.LogStreamID {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if !this.UpdateTime.Equal(that1.UpdateTime) {
+		return false
+	}
+	return true
 }
+func (this *OperationProgress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ListTopicsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Topics) > 0 {
-		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*OperationProgress)
+	if !ok {
+		that2, ok := that.(OperationProgress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AddTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.TotalEntries != that1.TotalEntries {
+		return false
+	}
+	if this.DoneEntries != that1.DoneEntries {
+		return false
+	}
+	return true
 }
+func (this *Operation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AddTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*Operation)
+	if !ok {
+		that2, ok := that.(Operation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OperationID != that1.OperationID {
+		return false
+	}
+	if this.Kind != that1.Kind {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.TopicID != that1.TopicID {
+		return false
+	}
+	if this.LogStreamID != that1.LogStreamID {
+		return false
+	}
+	if this.SrcStorageNodeID != that1.SrcStorageNodeID {
+		return false
+	}
+	if this.DstStorageNodeID != that1.DstStorageNodeID {
+		return false
+	}
+	if !this.Progress.Equal(&that1.Progress) {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if !this.CreateTime.Equal(that1.CreateTime) {
+		return false
+	}
+	if !this.UpdateTime.Equal(that1.UpdateTime) {
+		return false
+	}
+	return true
 }
+func (this *Event) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AddTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	that1, ok := that.(*Event)
+	if !ok {
+		that2, ok := that.(Event)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EventID != that1.EventID {
+		return false
+	}
+	if this.Kind != that1.Kind {
+		return false
+	}
+	if !this.CreateTime.Equal(that1.CreateTime) {
+		return false
+	}
+	if this.StorageNodeID != that1.StorageNodeID {
+		return false
+	}
+	if this.TopicID != that1.TopicID {
+		return false
+	}
+	if this.LogStreamID != that1.LogStreamID {
+		return false
+	}
+	if this.SrcStorageNodeID != that1.SrcStorageNodeID {
+		return false
+	}
+	if this.DstStorageNodeID != that1.DstStorageNodeID {
+		return false
+	}
+	if !this.Progress.Equal(that1.Progress) {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
+	}
+	return true
 }
 
-func (m *AddTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ClusterManagerClient is the client API for ClusterManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ClusterManagerClient interface {
+	// GetStorageNode returns the metadata of storage node requested.
+	// It returns NotFound if the storage node does not exist.
+	GetStorageNode(ctx context.Context, in *GetStorageNodeRequest, opts ...grpc.CallOption) (*GetStorageNodeResponse, error)
+	// ListStorageNodes returns a list of storage nodes in the cluster.
+	ListStorageNodes(ctx context.Context, in *ListStorageNodesRequest, opts ...grpc.CallOption) (*ListStorageNodesResponse, error)
+	// AddStorageNode adds a new storage node to the cluster.
+	// It is idempotent, that is, adding an already added storage node is okay.
+	AddStorageNode(ctx context.Context, in *AddStorageNodeRequest, opts ...grpc.CallOption) (*AddStorageNodeResponse, error)
+	// UnregisterStorageNode unregisters the storage node specified by the
+	// request.
+	UnregisterStorageNode(ctx context.Context, in *UnregisterStorageNodeRequest, opts ...grpc.CallOption) (*UnregisterStorageNodeResponse, error)
+	// DrainStorageNode marks the storage node as draining, then moves its log
+	// stream replicas to other storage nodes in the background. New log
+	// streams are not placed on the draining storage node. Once all replicas
+	// are moved, the storage node is unregistered. GetStorageNode reports the
+	// progress while draining.
+	// It is idempotent, that is, draining a storage node being drained is okay.
+	// Its codes are defines as followings:
+	// - NotFound: The storage node does not exist.
+	// - Unavailable: The cluster metadata cannot be fetched from the metadata
+	// repository transiently.
+	DrainStorageNode(ctx context.Context, in *DrainStorageNodeRequest, opts ...grpc.CallOption) (*DrainStorageNodeResponse, error)
+	// SetStorageNodeSyncBandwidth changes the limit of bandwidth for
+	// synchronization of log stream replicas in the storage node at runtime.
+	// The limit is not persisted, thus, the storage node uses its configured
+	// limit after restarting.
+	// Its codes are defines as followings:
+	// - InvalidArgument: The limit is negative.
+	// - NotFound: The storage node does not exist.
+	SetStorageNodeSyncBandwidth(ctx context.Context, in *SetStorageNodeSyncBandwidthRequest, opts ...grpc.CallOption) (*SetStorageNodeSyncBandwidthResponse, error)
+	// SetStorageNodeAppendQuota changes the quota of appends to a topic or a
+	// log stream in the storage node at runtime. The quota of a topic is shared
+	// by all log stream replicas of the topic in the storage node. Appends
+	// exceeding the quota fail with verrors.ErrThrottled. The quota is not
+	// persisted, thus, the storage node uses its configured quota after
+	// restarting.
+	// Its codes are defines as followings:
+	// - InvalidArgument: The quota is negative, or the topic is invalid.
+	// - NotFound: The storage node does not exist.
+	SetStorageNodeAppendQuota(ctx context.Context, in *SetStorageNodeAppendQuotaRequest, opts ...grpc.CallOption) (*SetStorageNodeAppendQuotaResponse, error)
+	// GetTopic returns the topic specified by the request.
+	GetTopic(ctx context.Context, in *GetTopicRequest, opts ...grpc.CallOption) (*GetTopicResponse, error)
+	// DescribeTopic returns the topic specified by the request.
+	// Deprecated: Use GetTopic.
+	DescribeTopic(ctx context.Context, in *DescribeTopicRequest, opts ...grpc.CallOption) (*DescribeTopicResponse, error)
+	// ListTopics returns a list of topics in the cluster.
+	ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error)
+	// AddTopic adds a new topic and returns its metadata.
+	AddTopic(ctx context.Context, in *AddTopicRequest, opts ...grpc.CallOption) (*AddTopicResponse, error)
+	UnregisterTopic(ctx context.Context, in *UnregisterTopicRequest, opts ...grpc.CallOption) (*UnregisterTopicResponse, error)
+	GetLogStream(ctx context.Context, in *GetLogStreamRequest, opts ...grpc.CallOption) (*GetLogStreamResponse, error)
+	ListLogStreams(ctx context.Context, in *ListLogStreamsRequest, opts ...grpc.CallOption) (*ListLogStreamsResponse, error)
+	AddLogStream(ctx context.Context, in *AddLogStreamRequest, opts ...grpc.CallOption) (*AddLogStreamResponse, error)
+	// UpdateLogStream changes the configuration of replicas in a log stream.
+	// Its codes are defines as followings:
+	// - InvalidArgument: The client tries to swap the same replica.
+	// - Unavailable: The cluster metadata cannot be fetched from the metadata
+	// repository transiently.
+	// - NotFound: The target log stream does not exist.
+	// - FailedPrecondition: Either the target log stream is not sealed, the
+	// target log stream doesn't have a victim replica, or the target log stream
+	// already has both victim and new replica. Note that clients should not retry
+	// without any action.
+	// - Unknown: Either storage node or metadata repository returns an error.
+	//
+	// TODO: Moving the data directory within the same node is not supported yet.
+	// TODO: We will define codes for errors returned from storage nodes and
+	// metadata repository soon.
+	UpdateLogStream(ctx context.Context, in *UpdateLogStreamRequest, opts ...grpc.CallOption) (*UpdateLogStreamResponse, error)
+	UnregisterLogStream(ctx context.Context, in *UnregisterLogStreamRequest, opts ...grpc.CallOption) (*UnregisterLogStreamResponse, error)
+	RemoveLogStreamReplica(ctx context.Context, in *RemoveLogStreamReplicaRequest, opts ...grpc.CallOption) (*RemoveLogStreamReplicaResponse, error)
+	Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error)
+	Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error)
+	// Sync starts copying log entries of the log stream from the source
+	// replica to the destination replica. It returns the current status of the
+	// sync and the identifier of the operation. The admin server keeps track of
+	// the operation until the sync completes, thus, clients need not call Sync
+	// repeatedly.
+	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error)
+	Trim(ctx context.Context, in *TrimRequest, opts ...grpc.CallOption) (*TrimResponse, error)
+	// CheckpointLogStreamReplica makes a consistent point-in-time checkpoint of
+	// the log stream replica in the storage node for backup. The checkpoint can
+	// be restored into a new replica by `varlogsn restore`, and then the new
+	// replica can be registered by UpdateLogStream and synchronized.
+	// Its codes are defines as followings:
+	// - InvalidArgument: The path is not absolute.
+	// - AlreadyExists: The path already exists.
+	// - NotFound: The storage node or log stream replica does not exist.
+	CheckpointLogStreamReplica(ctx context.Context, in *CheckpointLogStreamReplicaRequest, opts ...grpc.CallOption) (*CheckpointLogStreamReplicaResponse, error)
+	// GetOperation returns the long-running operation specified by the request.
+	// Seal, Unseal, Sync and UpdateLogStream issue long-running operations.
+	// Even if a client gives up waiting for such an RPC, for instance, due to
+	// its timeout, the operation keeps running.
+	// It returns NotFound if the operation does not exist.
+	GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*GetOperationResponse, error)
+	// ListOperations returns a list of long-running operations. The admin
+	// server keeps a limited number of finished operations.
+	ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error)
+	// CancelOperation cancels the running operation specified by the request.
+	// Canceling an operation already finished does nothing.
+	// Note that it does not roll back what the operation has done.
+	// Canceling a sync operation only stops the admin server from tracking it;
+	// the storage nodes keep copying log entries until the sync completes or
+	// fails.
+	// It returns NotFound if the operation does not exist.
+	CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*CancelOperationResponse, error)
+	// WatchEvents streams events of the cluster, for instance, sealing and
+	// unsealing log streams, heartbeat timeouts of storage nodes and progress
+	// of syncs. The admin server keeps a limited number of recent events in
+	// memory, and clients can resume watching from the identifier of the last
+	// event they received. Since only the leader notices events, followers
+	// reject it with Unavailable.
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (ClusterManager_WatchEventsClient, error)
+	GetMetadataRepositoryNode(ctx context.Context, in *GetMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*GetMetadataRepositoryNodeResponse, error)
+	ListMetadataRepositoryNodes(ctx context.Context, in *ListMetadataRepositoryNodesRequest, opts ...grpc.CallOption) (*ListMetadataRepositoryNodesResponse, error)
+	GetMRMembers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GetMRMembersResponse, error)
+	AddMetadataRepositoryNode(ctx context.Context, in *AddMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*AddMetadataRepositoryNodeResponse, error)
+	AddMRPeer(ctx context.Context, in *AddMRPeerRequest, opts ...grpc.CallOption) (*AddMRPeerResponse, error)
+	DeleteMetadataRepositoryNode(ctx context.Context, in *DeleteMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*DeleteMetadataRepositoryNodeResponse, error)
+	RemoveMRPeer(ctx context.Context, in *RemoveMRPeerRequest, opts ...grpc.CallOption) (*RemoveMRPeerResponse, error)
+	// TransferMetadataRepositoryLeadership transfers the leadership of the
+	// metadata repository to the node specified by the request, and waits until
+	// it becomes the leader.
+	TransferMetadataRepositoryLeadership(ctx context.Context, in *TransferMetadataRepositoryLeadershipRequest, opts ...grpc.CallOption) (*TransferMetadataRepositoryLeadershipResponse, error)
 }
 
-func (m *AddTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type clusterManagerClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *AddTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Topic != nil {
-		{
-			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func NewClusterManagerClient(cc *grpc.ClientConn) ClusterManagerClient {
+	return &clusterManagerClient{cc}
 }
 
-func (m *UnregisterTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) GetStorageNode(ctx context.Context, in *GetStorageNodeRequest, opts ...grpc.CallOption) (*GetStorageNodeResponse, error) {
+	out := new(GetStorageNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/GetStorageNode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UnregisterTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) ListStorageNodes(ctx context.Context, in *ListStorageNodesRequest, opts ...grpc.CallOption) (*ListStorageNodesResponse, error) {
+	out := new(ListStorageNodesResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/ListStorageNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UnregisterTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
+func (c *clusterManagerClient) AddStorageNode(ctx context.Context, in *AddStorageNodeRequest, opts ...grpc.CallOption) (*AddStorageNodeResponse, error) {
+	out := new(AddStorageNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/AddStorageNode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UnregisterTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) UnregisterStorageNode(ctx context.Context, in *UnregisterStorageNodeRequest, opts ...grpc.CallOption) (*UnregisterStorageNodeResponse, error) {
+	out := new(UnregisterStorageNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/UnregisterStorageNode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UnregisterTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) DrainStorageNode(ctx context.Context, in *DrainStorageNodeRequest, opts ...grpc.CallOption) (*DrainStorageNodeResponse, error) {
+	out := new(DrainStorageNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/DrainStorageNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UnregisterTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *clusterManagerClient) SetStorageNodeSyncBandwidth(ctx context.Context, in *SetStorageNodeSyncBandwidthRequest, opts ...grpc.CallOption) (*SetStorageNodeSyncBandwidthResponse, error) {
+	out := new(SetStorageNodeSyncBandwidthResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/SetStorageNodeSyncBandwidth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetLogStreamRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) SetStorageNodeAppendQuota(ctx context.Context, in *SetStorageNodeAppendQuotaRequest, opts ...grpc.CallOption) (*SetStorageNodeAppendQuotaResponse, error) {
+	out := new(SetStorageNodeAppendQuotaResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/SetStorageNodeAppendQuota", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func (c *clusterManagerClient) GetTopic(ctx context.Context, in *GetTopicRequest, opts ...grpc.CallOption) (*GetTopicResponse, error) {
+	out := new(GetTopicResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/GetTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (m *GetLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
+func (c *clusterManagerClient) DescribeTopic(ctx context.Context, in *DescribeTopicRequest, opts ...grpc.CallOption) (*DescribeTopicResponse, error) {
+	out := new(DescribeTopicResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/DescribeTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *clusterManagerClient) ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error) {
+	out := new(ListTopicsResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/ListTopics", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetLogStreamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) AddTopic(ctx context.Context, in *AddTopicRequest, opts ...grpc.CallOption) (*AddTopicResponse, error) {
+	out := new(AddTopicResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/AddTopic", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) UnregisterTopic(ctx context.Context, in *UnregisterTopicRequest, opts ...grpc.CallOption) (*UnregisterTopicResponse, error) {
+	out := new(UnregisterTopicResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/UnregisterTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogStream != nil {
-		{
-			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *clusterManagerClient) GetLogStream(ctx context.Context, in *GetLogStreamRequest, opts ...grpc.CallOption) (*GetLogStreamResponse, error) {
+	out := new(GetLogStreamResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/GetLogStream", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ListLogStreamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) ListLogStreams(ctx context.Context, in *ListLogStreamsRequest, opts ...grpc.CallOption) (*ListLogStreamsResponse, error) {
+	out := new(ListLogStreamsResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/ListLogStreams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListLogStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) AddLogStream(ctx context.Context, in *AddLogStreamRequest, opts ...grpc.CallOption) (*AddLogStreamResponse, error) {
+	out := new(AddLogStreamResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/AddLogStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListLogStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
+func (c *clusterManagerClient) UpdateLogStream(ctx context.Context, in *UpdateLogStreamRequest, opts ...grpc.CallOption) (*UpdateLogStreamResponse, error) {
+	out := new(UpdateLogStreamResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/UpdateLogStream", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ListLogStreamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) UnregisterLogStream(ctx context.Context, in *UnregisterLogStreamRequest, opts ...grpc.CallOption) (*UnregisterLogStreamResponse, error) {
+	out := new(UnregisterLogStreamResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/UnregisterLogStream", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListLogStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) RemoveLogStreamReplica(ctx context.Context, in *RemoveLogStreamReplicaRequest, opts ...grpc.CallOption) (*RemoveLogStreamReplicaResponse, error) {
+	out := new(RemoveLogStreamReplicaResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/RemoveLogStreamReplica", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListLogStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LogStreams) > 0 {
-		for iNdEx := len(m.LogStreams) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *clusterManagerClient) Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error) {
+	out := new(SealResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/Seal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *AddLogStreamRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error) {
+	out := new(UnsealResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/Unseal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AddLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error) {
+	out := new(SyncResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/Sync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *AddLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Replicas) > 0 {
-		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Replicas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (c *clusterManagerClient) Trim(ctx context.Context, in *TrimRequest, opts ...grpc.CallOption) (*TrimResponse, error) {
+	out := new(TrimResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/Trim", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *clusterManagerClient) CheckpointLogStreamReplica(ctx context.Context, in *CheckpointLogStreamReplicaRequest, opts ...grpc.CallOption) (*CheckpointLogStreamReplicaResponse, error) {
+	out := new(CheckpointLogStreamReplicaResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/CheckpointLogStreamReplica", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *AddLogStreamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*GetOperationResponse, error) {
+	out := new(GetOperationResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/GetOperation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AddLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error) {
+	out := new(ListOperationsResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/ListOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *AddLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogStream != nil {
-		{
-			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *clusterManagerClient) CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*CancelOperationResponse, error) {
+	out := new(CancelOperationResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/CancelOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UpdateLogStreamRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (ClusterManager_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ClusterManager_serviceDesc.Streams[0], "/varlog.vmspb.ClusterManager/WatchEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &clusterManagerWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *UpdateLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ClusterManager_WatchEventsClient interface {
+	Recv() (*WatchEventsResponse, error)
+	grpc.ClientStream
 }
 
-func (m *UpdateLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PushedReplica.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintAdmin(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.PoppedReplica.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintAdmin(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type clusterManagerWatchEventsClient struct {
+	grpc.ClientStream
 }
 
-func (m *UpdateLogStreamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *clusterManagerWatchEventsClient) Recv() (*WatchEventsResponse, error) {
+	m := new(WatchEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UpdateLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogStream != nil {
-		{
-			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *UnregisterLogStreamRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) GetMetadataRepositoryNode(ctx context.Context, in *GetMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*GetMetadataRepositoryNodeResponse, error) {
+	out := new(GetMetadataRepositoryNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/GetMetadataRepositoryNode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UnregisterLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UnregisterLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UnregisterLogStreamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) ListMetadataRepositoryNodes(ctx context.Context, in *ListMetadataRepositoryNodesRequest, opts ...grpc.CallOption) (*ListMetadataRepositoryNodesResponse, error) {
+	out := new(ListMetadataRepositoryNodesResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/ListMetadataRepositoryNodes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UnregisterLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UnregisterLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RemoveLogStreamReplicaRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) GetMRMembers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GetMRMembersResponse, error) {
+	out := new(GetMRMembersResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/GetMRMembers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveLogStreamReplicaRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RemoveLogStreamReplicaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.StorageNodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RemoveLogStreamReplicaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) AddMetadataRepositoryNode(ctx context.Context, in *AddMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*AddMetadataRepositoryNodeResponse, error) {
+	out := new(AddMetadataRepositoryNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/AddMetadataRepositoryNode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveLogStreamReplicaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RemoveLogStreamReplicaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SealRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) AddMRPeer(ctx context.Context, in *AddMRPeerRequest, opts ...grpc.CallOption) (*AddMRPeerResponse, error) {
+	out := new(AddMRPeerResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/AddMRPeer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SealRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *SealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
+func (c *clusterManagerClient) DeleteMetadataRepositoryNode(ctx context.Context, in *DeleteMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*DeleteMetadataRepositoryNodeResponse, error) {
+	out := new(DeleteMetadataRepositoryNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/DeleteMetadataRepositoryNode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SealResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) RemoveMRPeer(ctx context.Context, in *RemoveMRPeerRequest, opts ...grpc.CallOption) (*RemoveMRPeerResponse, error) {
+	out := new(RemoveMRPeerResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/RemoveMRPeer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SealResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *SealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SealedGLSN != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.SealedGLSN))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.LogStreams) > 0 {
-		for iNdEx := len(m.LogStreams) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *clusterManagerClient) TransferMetadataRepositoryLeadership(ctx context.Context, in *TransferMetadataRepositoryLeadershipRequest, opts ...grpc.CallOption) (*TransferMetadataRepositoryLeadershipResponse, error) {
+	out := new(TransferMetadataRepositoryLeadershipResponse)
+	err := c.cc.Invoke(ctx, "/varlog.vmspb.ClusterManager/TransferMetadataRepositoryLeadership", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UnsealRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// ClusterManagerServer is the server API for ClusterManager service.
+type ClusterManagerServer interface {
+	// GetStorageNode returns the metadata of storage node requested.
+	// It returns NotFound if the storage node does not exist.
+	GetStorageNode(context.Context, *GetStorageNodeRequest) (*GetStorageNodeResponse, error)
+	// ListStorageNodes returns a list of storage nodes in the cluster.
+	ListStorageNodes(context.Context, *ListStorageNodesRequest) (*ListStorageNodesResponse, error)
+	// AddStorageNode adds a new storage node to the cluster.
+	// It is idempotent, that is, adding an already added storage node is okay.
+	AddStorageNode(context.Context, *AddStorageNodeRequest) (*AddStorageNodeResponse, error)
+	// UnregisterStorageNode unregisters the storage node specified by the
+	// request.
+	UnregisterStorageNode(context.Context, *UnregisterStorageNodeRequest) (*UnregisterStorageNodeResponse, error)
+	// DrainStorageNode marks the storage node as draining, then moves its log
+	// stream replicas to other storage nodes in the background. New log
+	// streams are not placed on the draining storage node. Once all replicas
+	// are moved, the storage node is unregistered. GetStorageNode reports the
+	// progress while draining.
+	// It is idempotent, that is, draining a storage node being drained is okay.
+	// Its codes are defines as followings:
+	// - NotFound: The storage node does not exist.
+	// - Unavailable: The cluster metadata cannot be fetched from the metadata
+	// repository transiently.
+	DrainStorageNode(context.Context, *DrainStorageNodeRequest) (*DrainStorageNodeResponse, error)
+	// SetStorageNodeSyncBandwidth changes the limit of bandwidth for
+	// synchronization of log stream replicas in the storage node at runtime.
+	// The limit is not persisted, thus, the storage node uses its configured
+	// limit after restarting.
+	// Its codes are defines as followings:
+	// - InvalidArgument: The limit is negative.
+	// - NotFound: The storage node does not exist.
+	SetStorageNodeSyncBandwidth(context.Context, *SetStorageNodeSyncBandwidthRequest) (*SetStorageNodeSyncBandwidthResponse, error)
+	// SetStorageNodeAppendQuota changes the quota of appends to a topic or a
+	// log stream in the storage node at runtime. The quota of a topic is shared
+	// by all log stream replicas of the topic in the storage node. Appends
+	// exceeding the quota fail with verrors.ErrThrottled. The quota is not
+	// persisted, thus, the storage node uses its configured quota after
+	// restarting.
+	// Its codes are defines as followings:
+	// - InvalidArgument: The quota is negative, or the topic is invalid.
+	// - NotFound: The storage node does not exist.
+	SetStorageNodeAppendQuota(context.Context, *SetStorageNodeAppendQuotaRequest) (*SetStorageNodeAppendQuotaResponse, error)
+	// GetTopic returns the topic specified by the request.
+	GetTopic(context.Context, *GetTopicRequest) (*GetTopicResponse, error)
+	// DescribeTopic returns the topic specified by the request.
+	// Deprecated: Use GetTopic.
+	DescribeTopic(context.Context, *DescribeTopicRequest) (*DescribeTopicResponse, error)
+	// ListTopics returns a list of topics in the cluster.
+	ListTopics(context.Context, *ListTopicsRequest) (*ListTopicsResponse, error)
+	// AddTopic adds a new topic and returns its metadata.
+	AddTopic(context.Context, *AddTopicRequest) (*AddTopicResponse, error)
+	UnregisterTopic(context.Context, *UnregisterTopicRequest) (*UnregisterTopicResponse, error)
+	GetLogStream(context.Context, *GetLogStreamRequest) (*GetLogStreamResponse, error)
+	ListLogStreams(context.Context, *ListLogStreamsRequest) (*ListLogStreamsResponse, error)
+	AddLogStream(context.Context, *AddLogStreamRequest) (*AddLogStreamResponse, error)
+	// UpdateLogStream changes the configuration of replicas in a log stream.
+	// Its codes are defines as followings:
+	// - InvalidArgument: The client tries to swap the same replica.
+	// - Unavailable: The cluster metadata cannot be fetched from the metadata
+	// repository transiently.
+	// - NotFound: The target log stream does not exist.
+	// - FailedPrecondition: Either the target log stream is not sealed, the
+	// target log stream doesn't have a victim replica, or the target log stream
+	// already has both victim and new replica. Note that clients should not retry
+	// without any action.
+	// - Unknown: Either storage node or metadata repository returns an error.
+	//
+	// TODO: Moving the data directory within the same node is not supported yet.
+	// TODO: We will define codes for errors returned from storage nodes and
+	// metadata repository soon.
+	UpdateLogStream(context.Context, *UpdateLogStreamRequest) (*UpdateLogStreamResponse, error)
+	UnregisterLogStream(context.Context, *UnregisterLogStreamRequest) (*UnregisterLogStreamResponse, error)
+	RemoveLogStreamReplica(context.Context, *RemoveLogStreamReplicaRequest) (*RemoveLogStreamReplicaResponse, error)
+	Seal(context.Context, *SealRequest) (*SealResponse, error)
+	Unseal(context.Context, *UnsealRequest) (*UnsealResponse, error)
+	// Sync starts copying log entries of the log stream from the source
+	// replica to the destination replica. It returns the current status of the
+	// sync and the identifier of the operation. The admin server keeps track of
+	// the operation until the sync completes, thus, clients need not call Sync
+	// repeatedly.
+	Sync(context.Context, *SyncRequest) (*SyncResponse, error)
+	Trim(context.Context, *TrimRequest) (*TrimResponse, error)
+	// CheckpointLogStreamReplica makes a consistent point-in-time checkpoint of
+	// the log stream replica in the storage node for backup. The checkpoint can
+	// be restored into a new replica by `varlogsn restore`, and then the new
+	// replica can be registered by UpdateLogStream and synchronized.
+	// Its codes are defines as followings:
+	// - InvalidArgument: The path is not absolute.
+	// - AlreadyExists: The path already exists.
+	// - NotFound: The storage node or log stream replica does not exist.
+	CheckpointLogStreamReplica(context.Context, *CheckpointLogStreamReplicaRequest) (*CheckpointLogStreamReplicaResponse, error)
+	// GetOperation returns the long-running operation specified by the request.
+	// Seal, Unseal, Sync and UpdateLogStream issue long-running operations.
+	// Even if a client gives up waiting for such an RPC, for instance, due to
+	// its timeout, the operation keeps running.
+	// It returns NotFound if the operation does not exist.
+	GetOperation(context.Context, *GetOperationRequest) (*GetOperationResponse, error)
+	// ListOperations returns a list of long-running operations. The admin
+	// server keeps a limited number of finished operations.
+	ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error)
+	// CancelOperation cancels the running operation specified by the request.
+	// Canceling an operation already finished does nothing.
+	// Note that it does not roll back what the operation has done.
+	// Canceling a sync operation only stops the admin server from tracking it;
+	// the storage nodes keep copying log entries until the sync completes or
+	// fails.
+	// It returns NotFound if the operation does not exist.
+	CancelOperation(context.Context, *CancelOperationRequest) (*CancelOperationResponse, error)
+	// WatchEvents streams events of the cluster, for instance, sealing and
+	// unsealing log streams, heartbeat timeouts of storage nodes and progress
+	// of syncs. The admin server keeps a limited number of recent events in
+	// memory, and clients can resume watching from the identifier of the last
+	// event they received. Since only the leader notices events, followers
+	// reject it with Unavailable.
+	WatchEvents(*WatchEventsRequest, ClusterManager_WatchEventsServer) error
+	GetMetadataRepositoryNode(context.Context, *GetMetadataRepositoryNodeRequest) (*GetMetadataRepositoryNodeResponse, error)
+	ListMetadataRepositoryNodes(context.Context, *ListMetadataRepositoryNodesRequest) (*ListMetadataRepositoryNodesResponse, error)
+	GetMRMembers(context.Context, *types.Empty) (*GetMRMembersResponse, error)
+	AddMetadataRepositoryNode(context.Context, *AddMetadataRepositoryNodeRequest) (*AddMetadataRepositoryNodeResponse, error)
+	AddMRPeer(context.Context, *AddMRPeerRequest) (*AddMRPeerResponse, error)
+	DeleteMetadataRepositoryNode(context.Context, *DeleteMetadataRepositoryNodeRequest) (*DeleteMetadataRepositoryNodeResponse, error)
+	RemoveMRPeer(context.Context, *RemoveMRPeerRequest) (*RemoveMRPeerResponse, error)
+	// TransferMetadataRepositoryLeadership transfers the leadership of the
+	// metadata repository to the node specified by the request, and waits until
+	// it becomes the leader.
+	TransferMetadataRepositoryLeadership(context.Context, *TransferMetadataRepositoryLeadershipRequest) (*TransferMetadataRepositoryLeadershipResponse, error)
 }
 
-func (m *UnsealRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UnimplementedClusterManagerServer can be embedded to have forward compatible implementations.
+type UnimplementedClusterManagerServer struct {
 }
 
-func (m *UnsealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedClusterManagerServer) GetStorageNode(ctx context.Context, req *GetStorageNodeRequest) (*GetStorageNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageNode not implemented")
 }
-
-func (m *UnsealResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedClusterManagerServer) ListStorageNodes(ctx context.Context, req *ListStorageNodesRequest) (*ListStorageNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStorageNodes not implemented")
 }
-
-func (m *UnsealResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedClusterManagerServer) AddStorageNode(ctx context.Context, req *AddStorageNodeRequest) (*AddStorageNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStorageNode not implemented")
 }
-
-func (m *UnsealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogStream != nil {
-		{
-			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedClusterManagerServer) UnregisterStorageNode(ctx context.Context, req *UnregisterStorageNodeRequest) (*UnregisterStorageNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterStorageNode not implemented")
 }
-
-func (m *SyncRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedClusterManagerServer) DrainStorageNode(ctx context.Context, req *DrainStorageNodeRequest) (*DrainStorageNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainStorageNode not implemented")
 }
-
-func (m *SyncRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedClusterManagerServer) SetStorageNodeSyncBandwidth(ctx context.Context, req *SetStorageNodeSyncBandwidthRequest) (*SetStorageNodeSyncBandwidthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStorageNodeSyncBandwidth not implemented")
 }
-
-func (m *SyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DstStorageNodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.DstStorageNodeID))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.SrcStorageNodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.SrcStorageNodeID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedClusterManagerServer) SetStorageNodeAppendQuota(ctx context.Context, req *SetStorageNodeAppendQuotaRequest) (*SetStorageNodeAppendQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStorageNodeAppendQuota not implemented")
 }
-
-func (m *SyncResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedClusterManagerServer) GetTopic(ctx context.Context, req *GetTopicRequest) (*GetTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopic not implemented")
 }
-
-func (m *SyncResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedClusterManagerServer) DescribeTopic(ctx context.Context, req *DescribeTopicRequest) (*DescribeTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTopic not implemented")
 }
-
-func (m *SyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedClusterManagerServer) ListTopics(ctx context.Context, req *ListTopicsRequest) (*ListTopicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopics not implemented")
 }
-
-func (m *TrimRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedClusterManagerServer) AddTopic(ctx context.Context, req *AddTopicRequest) (*AddTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTopic not implemented")
 }
-
-func (m *TrimRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedClusterManagerServer) UnregisterTopic(ctx context.Context, req *UnregisterTopicRequest) (*UnregisterTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterTopic not implemented")
 }
-
-func (m *TrimRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastGLSN != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LastGLSN))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedClusterManagerServer) GetLogStream(ctx context.Context, req *GetLogStreamRequest) (*GetLogStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogStream not implemented")
 }
-
-func (m *TrimResult) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedClusterManagerServer) ListLogStreams(ctx context.Context, req *ListLogStreamsRequest) (*ListLogStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLogStreams not implemented")
 }
-
-func (m *TrimResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedClusterManagerServer) AddLogStream(ctx context.Context, req *AddLogStreamRequest) (*AddLogStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLogStream not implemented")
+}
+func (*UnimplementedClusterManagerServer) UpdateLogStream(ctx context.Context, req *UpdateLogStreamRequest) (*UpdateLogStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLogStream not implemented")
+}
+func (*UnimplementedClusterManagerServer) UnregisterLogStream(ctx context.Context, req *UnregisterLogStreamRequest) (*UnregisterLogStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterLogStream not implemented")
+}
+func (*UnimplementedClusterManagerServer) RemoveLogStreamReplica(ctx context.Context, req *RemoveLogStreamReplicaRequest) (*RemoveLogStreamReplicaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLogStreamReplica not implemented")
+}
+func (*UnimplementedClusterManagerServer) Seal(ctx context.Context, req *SealRequest) (*SealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Seal not implemented")
+}
+func (*UnimplementedClusterManagerServer) Unseal(ctx context.Context, req *UnsealRequest) (*UnsealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unseal not implemented")
+}
+func (*UnimplementedClusterManagerServer) Sync(ctx context.Context, req *SyncRequest) (*SyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
+}
+func (*UnimplementedClusterManagerServer) Trim(ctx context.Context, req *TrimRequest) (*TrimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Trim not implemented")
+}
+func (*UnimplementedClusterManagerServer) CheckpointLogStreamReplica(ctx context.Context, req *CheckpointLogStreamReplicaRequest) (*CheckpointLogStreamReplicaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointLogStreamReplica not implemented")
+}
+func (*UnimplementedClusterManagerServer) GetOperation(ctx context.Context, req *GetOperationRequest) (*GetOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (*UnimplementedClusterManagerServer) ListOperations(ctx context.Context, req *ListOperationsRequest) (*ListOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperations not implemented")
+}
+func (*UnimplementedClusterManagerServer) CancelOperation(ctx context.Context, req *CancelOperationRequest) (*CancelOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOperation not implemented")
+}
+func (*UnimplementedClusterManagerServer) WatchEvents(req *WatchEventsRequest, srv ClusterManager_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (*UnimplementedClusterManagerServer) GetMetadataRepositoryNode(ctx context.Context, req *GetMetadataRepositoryNodeRequest) (*GetMetadataRepositoryNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataRepositoryNode not implemented")
+}
+func (*UnimplementedClusterManagerServer) ListMetadataRepositoryNodes(ctx context.Context, req *ListMetadataRepositoryNodesRequest) (*ListMetadataRepositoryNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetadataRepositoryNodes not implemented")
+}
+func (*UnimplementedClusterManagerServer) GetMRMembers(ctx context.Context, req *types.Empty) (*GetMRMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMRMembers not implemented")
+}
+func (*UnimplementedClusterManagerServer) AddMetadataRepositoryNode(ctx context.Context, req *AddMetadataRepositoryNodeRequest) (*AddMetadataRepositoryNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMetadataRepositoryNode not implemented")
+}
+func (*UnimplementedClusterManagerServer) AddMRPeer(ctx context.Context, req *AddMRPeerRequest) (*AddMRPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMRPeer not implemented")
+}
+func (*UnimplementedClusterManagerServer) DeleteMetadataRepositoryNode(ctx context.Context, req *DeleteMetadataRepositoryNodeRequest) (*DeleteMetadataRepositoryNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMetadataRepositoryNode not implemented")
+}
+func (*UnimplementedClusterManagerServer) RemoveMRPeer(ctx context.Context, req *RemoveMRPeerRequest) (*RemoveMRPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMRPeer not implemented")
+}
+func (*UnimplementedClusterManagerServer) TransferMetadataRepositoryLeadership(ctx context.Context, req *TransferMetadataRepositoryLeadershipRequest) (*TransferMetadataRepositoryLeadershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferMetadataRepositoryLeadership not implemented")
 }
 
-func (m *TrimResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x1a
+func RegisterClusterManagerServer(s *grpc.Server, srv ClusterManagerServer) {
+	s.RegisterService(&_ClusterManager_serviceDesc, srv)
+}
+
+func _ClusterManager_GetStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).GetStorageNode(ctx, in)
 	}
-	if m.StorageNodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/GetStorageNode",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).GetStorageNode(ctx, req.(*GetStorageNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TrimResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_ListStorageNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStorageNodesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ListStorageNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/ListStorageNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ListStorageNodes(ctx, req.(*ListStorageNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TrimResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_AddStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStorageNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).AddStorageNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/AddStorageNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).AddStorageNode(ctx, req.(*AddStorageNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TrimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _ClusterManager_UnregisterStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterStorageNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).UnregisterStorageNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/UnregisterStorageNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).UnregisterStorageNode(ctx, req.(*UnregisterStorageNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_DrainStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainStorageNodeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).DrainStorageNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/DrainStorageNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).DrainStorageNode(ctx, req.(*DrainStorageNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetMetadataRepositoryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_SetStorageNodeSyncBandwidth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStorageNodeSyncBandwidthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).SetStorageNodeSyncBandwidth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/SetStorageNodeSyncBandwidth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).SetStorageNodeSyncBandwidth(ctx, req.(*SetStorageNodeSyncBandwidthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetMetadataRepositoryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
-		i--
-		dAtA[i] = 0x8
+func _ClusterManager_SetStorageNodeAppendQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStorageNodeAppendQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).SetStorageNodeAppendQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/SetStorageNodeAppendQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).SetStorageNodeAppendQuota(ctx, req.(*SetStorageNodeAppendQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_GetTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).GetTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/GetTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).GetTopic(ctx, req.(*GetTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetMetadataRepositoryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_DescribeTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).DescribeTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/DescribeTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).DescribeTopic(ctx, req.(*DescribeTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Node != nil {
-		{
-			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _ClusterManager_ListTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ListTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/ListTopics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ListTopics(ctx, req.(*ListTopicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListMetadataRepositoryNodesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_AddTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTopicRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListMetadataRepositoryNodesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListMetadataRepositoryNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).AddTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/AddTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).AddTopic(ctx, req.(*AddTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListMetadataRepositoryNodesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_UnregisterTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterTopicRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListMetadataRepositoryNodesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListMetadataRepositoryNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).UnregisterTopic(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/UnregisterTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).UnregisterTopic(ctx, req.(*UnregisterTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetMRMembersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_GetLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogStreamRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetMRMembersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetMRMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Members) > 0 {
-		for k := range m.Members {
-			v := m.Members[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarintAdmin(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).GetLogStream(ctx, in)
 	}
-	if m.ReplicationFactor != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.ReplicationFactor))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/GetLogStream",
 	}
-	if m.Leader != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Leader))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).GetLogStream(ctx, req.(*GetLogStreamRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_ListLogStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLogStreamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AddMetadataRepositoryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ListLogStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/ListLogStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ListLogStreams(ctx, req.(*ListLogStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddMetadataRepositoryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RPCAddr) > 0 {
-		i -= len(m.RPCAddr)
-		copy(dAtA[i:], m.RPCAddr)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RPCAddr)))
-		i--
-		dAtA[i] = 0x12
+func _ClusterManager_AddLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLogStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.RaftURL) > 0 {
-		i -= len(m.RaftURL)
-		copy(dAtA[i:], m.RaftURL)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RaftURL)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).AddLogStream(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/AddLogStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).AddLogStream(ctx, req.(*AddLogStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_UpdateLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLogStreamRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).UpdateLogStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/UpdateLogStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).UpdateLogStream(ctx, req.(*UpdateLogStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddMetadataRepositoryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_UnregisterLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterLogStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).UnregisterLogStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/UnregisterLogStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).UnregisterLogStream(ctx, req.(*UnregisterLogStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Node != nil {
-		{
-			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _ClusterManager_RemoveLogStreamReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLogStreamReplicaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).RemoveLogStreamReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/RemoveLogStreamReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).RemoveLogStreamReplica(ctx, req.(*RemoveLogStreamReplicaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddMRPeerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_Seal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SealRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).Seal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/Seal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).Seal(ctx, req.(*SealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddMRPeerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_Unseal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).Unseal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/Unseal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).Unseal(ctx, req.(*UnsealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddMRPeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RPCAddr) > 0 {
-		i -= len(m.RPCAddr)
-		copy(dAtA[i:], m.RPCAddr)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RPCAddr)))
-		i--
-		dAtA[i] = 0x12
+func _ClusterManager_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.RaftURL) > 0 {
-		i -= len(m.RaftURL)
-		copy(dAtA[i:], m.RaftURL)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RaftURL)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).Sync(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/Sync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).Sync(ctx, req.(*SyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddMRPeerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_Trim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrimRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).Trim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/Trim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).Trim(ctx, req.(*TrimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddMRPeerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_CheckpointLogStreamReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointLogStreamReplicaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).CheckpointLogStreamReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/CheckpointLogStreamReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).CheckpointLogStreamReplica(ctx, req.(*CheckpointLogStreamReplicaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddMRPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
-		i--
-		dAtA[i] = 0x8
+func _ClusterManager_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/GetOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).GetOperation(ctx, req.(*GetOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ListOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/ListOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ListOperations(ctx, req.(*ListOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteMetadataRepositoryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).CancelOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/CancelOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).CancelOperation(ctx, req.(*CancelOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteMetadataRepositoryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
-		i--
-		dAtA[i] = 0x8
+func _ClusterManager_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return len(dAtA) - i, nil
+	return srv.(ClusterManagerServer).WatchEvents(m, &clusterManagerWatchEventsServer{stream})
 }
 
-func (m *DeleteMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ClusterManager_WatchEventsServer interface {
+	Send(*WatchEventsResponse) error
+	grpc.ServerStream
 }
 
-func (m *DeleteMetadataRepositoryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type clusterManagerWatchEventsServer struct {
+	grpc.ServerStream
 }
 
-func (m *DeleteMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (x *clusterManagerWatchEventsServer) Send(m *WatchEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *RemoveMRPeerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_GetMetadataRepositoryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataRepositoryNodeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).GetMetadataRepositoryNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/GetMetadataRepositoryNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).GetMetadataRepositoryNode(ctx, req.(*GetMetadataRepositoryNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveMRPeerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_ListMetadataRepositoryNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetadataRepositoryNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ListMetadataRepositoryNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/ListMetadataRepositoryNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ListMetadataRepositoryNodes(ctx, req.(*ListMetadataRepositoryNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveMRPeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RaftURL) > 0 {
-		i -= len(m.RaftURL)
-		copy(dAtA[i:], m.RaftURL)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RaftURL)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RemoveMRPeerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_GetMRMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveMRPeerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RemoveMRPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAdmin(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).GetMRMembers(ctx, in)
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *StorageNodeMetadata) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/GetMRMembers",
 	}
-	var l int
-	_ = l
-	l = m.StorageNodeMetadataDescriptor.ProtoSize()
-	n += 1 + l + sovAdmin(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime)
-	n += 1 + l + sovAdmin(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHeartbeatTime)
-	n += 1 + l + sovAdmin(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).GetMRMembers(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetStorageNodeRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func _ClusterManager_AddMetadataRepositoryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMetadataRepositoryNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.StorageNodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).AddMetadataRepositoryNode(ctx, in)
 	}
-	return n
-}
-
-func (m *GetStorageNodeResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/AddMetadataRepositoryNode",
 	}
-	var l int
-	_ = l
-	if m.StorageNode != nil {
-		l = m.StorageNode.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).AddMetadataRepositoryNode(ctx, req.(*AddMetadataRepositoryNodeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListStorageNodesRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func _ClusterManager_AddMRPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMRPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *ListStorageNodesResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).AddMRPeer(ctx, in)
 	}
-	var l int
-	_ = l
-	if len(m.StorageNodes) > 0 {
-		for _, e := range m.StorageNodes {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/AddMRPeer",
 	}
-	return n
-}
-
-func (m *AddStorageNodeRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).AddMRPeer(ctx, req.(*AddMRPeerRequest))
 	}
-	var l int
-	_ = l
-	l = m.StorageNode.ProtoSize()
-	n += 1 + l + sovAdmin(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddStorageNodeResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StorageNode != nil {
-		l = m.StorageNode.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+func _ClusterManager_DeleteMetadataRepositoryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMetadataRepositoryNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *UnregisterStorageNodeRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).DeleteMetadataRepositoryNode(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.StorageNodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/DeleteMetadataRepositoryNode",
 	}
-	return n
-}
-
-func (m *UnregisterStorageNodeResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).DeleteMetadataRepositoryNode(ctx, req.(*DeleteMetadataRepositoryNodeRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTopicRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func _ClusterManager_RemoveMRPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMRPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).RemoveMRPeer(ctx, in)
 	}
-	return n
-}
-
-func (m *GetTopicResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/RemoveMRPeer",
 	}
-	var l int
-	_ = l
-	if m.Topic != nil {
-		l = m.Topic.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).RemoveMRPeer(ctx, req.(*RemoveMRPeerRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DescribeTopicRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+func _ClusterManager_TransferMetadataRepositoryLeadership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferMetadataRepositoryLeadershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *DescribeTopicResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).TransferMetadataRepositoryLeadership(ctx, in)
 	}
-	var l int
-	_ = l
-	l = m.Topic.ProtoSize()
-	n += 1 + l + sovAdmin(uint64(l))
-	if len(m.LogStreams) > 0 {
-		for _, e := range m.LogStreams {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.vmspb.ClusterManager/TransferMetadataRepositoryLeadership",
 	}
-	return n
-}
-
-func (m *ListTopicsRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).TransferMetadataRepositoryLeadership(ctx, req.(*TransferMetadataRepositoryLeadershipRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListTopicsResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Topics) > 0 {
-		for _, e := range m.Topics {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
-	}
-	return n
+var _ClusterManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "varlog.vmspb.ClusterManager",
+	HandlerType: (*ClusterManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStorageNode",
+			Handler:    _ClusterManager_GetStorageNode_Handler,
+		},
+		{
+			MethodName: "ListStorageNodes",
+			Handler:    _ClusterManager_ListStorageNodes_Handler,
+		},
+		{
+			MethodName: "AddStorageNode",
+			Handler:    _ClusterManager_AddStorageNode_Handler,
+		},
+		{
+			MethodName: "UnregisterStorageNode",
+			Handler:    _ClusterManager_UnregisterStorageNode_Handler,
+		},
+		{
+			MethodName: "DrainStorageNode",
+			Handler:    _ClusterManager_DrainStorageNode_Handler,
+		},
+		{
+			MethodName: "SetStorageNodeSyncBandwidth",
+			Handler:    _ClusterManager_SetStorageNodeSyncBandwidth_Handler,
+		},
+		{
+			MethodName: "SetStorageNodeAppendQuota",
+			Handler:    _ClusterManager_SetStorageNodeAppendQuota_Handler,
+		},
+		{
+			MethodName: "GetTopic",
+			Handler:    _ClusterManager_GetTopic_Handler,
+		},
+		{
+			MethodName: "DescribeTopic",
+			Handler:    _ClusterManager_DescribeTopic_Handler,
+		},
+		{
+			MethodName: "ListTopics",
+			Handler:    _ClusterManager_ListTopics_Handler,
+		},
+		{
+			MethodName: "AddTopic",
+			Handler:    _ClusterManager_AddTopic_Handler,
+		},
+		{
+			MethodName: "UnregisterTopic",
+			Handler:    _ClusterManager_UnregisterTopic_Handler,
+		},
+		{
+			MethodName: "GetLogStream",
+			Handler:    _ClusterManager_GetLogStream_Handler,
+		},
+		{
+			MethodName: "ListLogStreams",
+			Handler:    _ClusterManager_ListLogStreams_Handler,
+		},
+		{
+			MethodName: "AddLogStream",
+			Handler:    _ClusterManager_AddLogStream_Handler,
+		},
+		{
+			MethodName: "UpdateLogStream",
+			Handler:    _ClusterManager_UpdateLogStream_Handler,
+		},
+		{
+			MethodName: "UnregisterLogStream",
+			Handler:    _ClusterManager_UnregisterLogStream_Handler,
+		},
+		{
+			MethodName: "RemoveLogStreamReplica",
+			Handler:    _ClusterManager_RemoveLogStreamReplica_Handler,
+		},
+		{
+			MethodName: "Seal",
+			Handler:    _ClusterManager_Seal_Handler,
+		},
+		{
+			MethodName: "Unseal",
+			Handler:    _ClusterManager_Unseal_Handler,
+		},
+		{
+			MethodName: "Sync",
+			Handler:    _ClusterManager_Sync_Handler,
+		},
+		{
+			MethodName: "Trim",
+			Handler:    _ClusterManager_Trim_Handler,
+		},
+		{
+			MethodName: "CheckpointLogStreamReplica",
+			Handler:    _ClusterManager_CheckpointLogStreamReplica_Handler,
+		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _ClusterManager_GetOperation_Handler,
+		},
+		{
+			MethodName: "ListOperations",
+			Handler:    _ClusterManager_ListOperations_Handler,
+		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _ClusterManager_CancelOperation_Handler,
+		},
+		{
+			MethodName: "GetMetadataRepositoryNode",
+			Handler:    _ClusterManager_GetMetadataRepositoryNode_Handler,
+		},
+		{
+			MethodName: "ListMetadataRepositoryNodes",
+			Handler:    _ClusterManager_ListMetadataRepositoryNodes_Handler,
+		},
+		{
+			MethodName: "GetMRMembers",
+			Handler:    _ClusterManager_GetMRMembers_Handler,
+		},
+		{
+			MethodName: "AddMetadataRepositoryNode",
+			Handler:    _ClusterManager_AddMetadataRepositoryNode_Handler,
+		},
+		{
+			MethodName: "AddMRPeer",
+			Handler:    _ClusterManager_AddMRPeer_Handler,
+		},
+		{
+			MethodName: "DeleteMetadataRepositoryNode",
+			Handler:    _ClusterManager_DeleteMetadataRepositoryNode_Handler,
+		},
+		{
+			MethodName: "RemoveMRPeer",
+			Handler:    _ClusterManager_RemoveMRPeer_Handler,
+		},
+		{
+			MethodName: "TransferMetadataRepositoryLeadership",
+			Handler:    _ClusterManager_TransferMetadataRepositoryLeadership_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _ClusterManager_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/vmspb/admin.proto",
 }
 
-func (m *AddTopicRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *StorageNodeMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AddTopicResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Topic != nil {
-		l = m.Topic.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	return n
+func (m *StorageNodeMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnregisterTopicRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StorageNodeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+	if m.DrainStatus != nil {
+		{
+			size, err := m.DrainStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHeartbeatTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAdmin(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAdmin(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.StorageNodeMetadataDescriptor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *UnregisterTopicResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *DrainStatus) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetLogStreamRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DrainStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrainStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintAdmin(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x3a
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintAdmin(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *GetLogStreamResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	if m.MovedReplicas != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.MovedReplicas))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if m.LogStream != nil {
-		l = m.LogStream.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+	if m.TotalReplicas != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TotalReplicas))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.State != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ListLogStreamsRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+func (m *OperationProgress) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListLogStreamsResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.LogStreams) > 0 {
-		for _, e := range m.LogStreams {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
-	}
-	return n
+func (m *OperationProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddLogStreamRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OperationProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+	if m.DoneEntries != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.DoneEntries))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Replicas) > 0 {
-		for _, e := range m.Replicas {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
+	if m.TotalEntries != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TotalEntries))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddLogStreamResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LogStream != nil {
-		l = m.LogStream.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+func (m *Operation) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UpdateLogStreamRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
-	}
-	l = m.PoppedReplica.ProtoSize()
-	n += 1 + l + sovAdmin(uint64(l))
-	l = m.PushedReplica.ProtoSize()
-	n += 1 + l + sovAdmin(uint64(l))
-	return n
+func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateLogStreamResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LogStream != nil {
-		l = m.LogStream.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	return n
-}
-
-func (m *UnregisterLogStreamRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	i -= n7
+	i = encodeVarintAdmin(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x5a
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime):])
+	if err8 != nil {
+		return 0, err8
 	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+	i -= n8
+	i = encodeVarintAdmin(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x52
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	{
+		size, err := m.Progress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *UnregisterLogStreamResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x42
+	if m.DstStorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.DstStorageNodeID))
+		i--
+		dAtA[i] = 0x38
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *RemoveLogStreamReplicaRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	if m.SrcStorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SrcStorageNodeID))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	if m.StorageNodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	if m.State != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.Kind != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OperationID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.OperationID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveLogStreamReplicaResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SealRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
-	}
-	return n
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SealResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.LogStreams) > 0 {
-		for _, e := range m.LogStreams {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Progress != nil {
+		{
+			size, err := m.Progress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.SealedGLSN != 0 {
-		n += 1 + sovAdmin(uint64(m.SealedGLSN))
+	if m.DstStorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.DstStorageNodeID))
+		i--
+		dAtA[i] = 0x40
 	}
-	return n
-}
-
-func (m *UnsealRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	if m.SrcStorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SrcStorageNodeID))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
 	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *UnsealResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	var l int
-	_ = l
-	if m.LogStream != nil {
-		l = m.LogStream.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+	i -= n11
+	i = encodeVarintAdmin(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	if m.Kind != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.EventID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.EventID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SyncRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
-	}
-	if m.SrcStorageNodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.SrcStorageNodeID))
-	}
-	if m.DstStorageNodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.DstStorageNodeID))
+func (m *GetStorageNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SyncResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != nil {
-		l = m.Status.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	return n
+func (m *GetStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TrimRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
-	}
-	if m.LastGLSN != 0 {
-		n += 1 + sovAdmin(uint64(m.LastGLSN))
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TrimResult) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StorageNodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.StorageNodeID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+func (m *GetStorageNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TrimResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Results) > 0 {
-		for _, e := range m.Results {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
+	if m.StorageNode != nil {
+		{
+			size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetMetadataRepositoryNodeRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *ListStorageNodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListStorageNodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListStorageNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.NodeID))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetMetadataRepositoryNodeResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *ListStorageNodesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListStorageNodesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListStorageNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Node != nil {
-		l = m.Node.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+	if len(m.StorageNodes) > 0 {
+		for iNdEx := len(m.StorageNodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListMetadataRepositoryNodesRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *AddStorageNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListMetadataRepositoryNodesResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AddStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
+	{
+		size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GetMRMembersResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *AddStorageNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Leader != 0 {
-		n += 1 + sovAdmin(uint64(m.Leader))
-	}
-	if m.ReplicationFactor != 0 {
-		n += 1 + sovAdmin(uint64(m.ReplicationFactor))
-	}
-	if len(m.Members) > 0 {
-		for k, v := range m.Members {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovAdmin(uint64(k)) + 1 + len(v) + sovAdmin(uint64(len(v)))
-			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
+	if m.StorageNode != nil {
+		{
+			size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddMetadataRepositoryNodeRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *UnregisterStorageNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UnregisterStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnregisterStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RaftURL)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.RPCAddr)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddMetadataRepositoryNodeResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *UnregisterStorageNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UnregisterStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnregisterStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Node != nil {
-		l = m.Node.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddMRPeerRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *DrainStorageNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrainStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RaftURL)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.RPCAddr)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddMRPeerResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *DrainStorageNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrainStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.NodeID))
+	if m.StorageNode != nil {
+		{
+			size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DeleteMetadataRepositoryNodeRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *SetStorageNodeSyncBandwidthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetStorageNodeSyncBandwidthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetStorageNodeSyncBandwidthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.NodeID))
+	if m.BytesPerSecond != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.BytesPerSecond))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DeleteMetadataRepositoryNodeResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *SetStorageNodeSyncBandwidthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetStorageNodeSyncBandwidthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetStorageNodeSyncBandwidthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.BytesPerSecond != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.BytesPerSecond))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PrevBytesPerSecond != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.PrevBytesPerSecond))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveMRPeerRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *SetStorageNodeAppendQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetStorageNodeAppendQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetStorageNodeAppendQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RaftURL)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	{
+		size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x22
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveMRPeerResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *SetStorageNodeAppendQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func sovAdmin(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *SetStorageNodeAppendQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func sozAdmin(x uint64) (n int) {
-	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SetStorageNodeAppendQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.PrevQuota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Topic != nil {
+		{
+			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeTopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LogStreams) > 0 {
+		for iNdEx := len(m.LogStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ListTopicsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTopicsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListTopicsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListTopicsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTopicsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListTopicsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddTopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Compacted {
+		i--
+		if m.Compacted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Topic != nil {
+		{
+			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnregisterTopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnregisterTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnregisterTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnregisterTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnregisterTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnregisterTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLogStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLogStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		{
+			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListLogStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListLogStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListLogStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListLogStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListLogStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListLogStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LogStreams) > 0 {
+		for iNdEx := len(m.LogStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddLogStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Replicas) > 0 {
+		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Replicas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddLogStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		{
+			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateLogStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PushedReplica.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.PoppedReplica.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateLogStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OperationState != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.OperationState))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OperationID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.OperationID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LogStream != nil {
+		{
+			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnregisterLogStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnregisterLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnregisterLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnregisterLogStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnregisterLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnregisterLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveLogStreamReplicaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveLogStreamReplicaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveLogStreamReplicaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveLogStreamReplicaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveLogStreamReplicaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveLogStreamReplicaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SealRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OperationState != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.OperationState))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OperationID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.OperationID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SealedGLSN != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SealedGLSN))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LogStreams) > 0 {
+		for iNdEx := len(m.LogStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsealRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsealRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OperationState != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.OperationState))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OperationID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.OperationID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LogStream != nil {
+		{
+			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DstStorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.DstStorageNodeID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SrcStorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SrcStorageNodeID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OperationState != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.OperationState))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OperationID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.OperationID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetOperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OperationID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.OperationID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Operation != nil {
+		{
+			size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListOperationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListOperationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListOperationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListOperationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelOperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelOperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OperationID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.OperationID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Operation != nil {
+		{
+			size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Follow {
+		i--
+		if m.Follow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AfterEventID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.AfterEventID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TrimRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrimRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrimRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastGLSN != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LastGLSN))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrimResult) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrimResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrimResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointLogStreamReplicaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointLogStreamReplicaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointLogStreamReplicaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Archive {
+		i--
+		if m.Archive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointLogStreamReplicaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointLogStreamReplicaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointLogStreamReplicaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMetadataRepositoryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMetadataRepositoryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMetadataRepositoryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Node != nil {
+		{
+			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListMetadataRepositoryNodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListMetadataRepositoryNodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListMetadataRepositoryNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListMetadataRepositoryNodesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListMetadataRepositoryNodesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListMetadataRepositoryNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetMRMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMRMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMRMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for k := range m.Members {
+			v := m.Members[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintAdmin(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ReplicationFactor != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ReplicationFactor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Leader != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Leader))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddMetadataRepositoryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddMetadataRepositoryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RPCAddr) > 0 {
+		i -= len(m.RPCAddr)
+		copy(dAtA[i:], m.RPCAddr)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RPCAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RaftURL) > 0 {
+		i -= len(m.RaftURL)
+		copy(dAtA[i:], m.RaftURL)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RaftURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddMetadataRepositoryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Node != nil {
+		{
+			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddMRPeerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddMRPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddMRPeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RPCAddr) > 0 {
+		i -= len(m.RPCAddr)
+		copy(dAtA[i:], m.RPCAddr)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RPCAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RaftURL) > 0 {
+		i -= len(m.RaftURL)
+		copy(dAtA[i:], m.RaftURL)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RaftURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddMRPeerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddMRPeerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddMRPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteMetadataRepositoryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteMetadataRepositoryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteMetadataRepositoryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveMRPeerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveMRPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveMRPeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RaftURL) > 0 {
+		i -= len(m.RaftURL)
+		copy(dAtA[i:], m.RaftURL)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RaftURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveMRPeerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveMRPeerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveMRPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferMetadataRepositoryLeadershipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferMetadataRepositoryLeadershipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferMetadataRepositoryLeadershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferMetadataRepositoryLeadershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferMetadataRepositoryLeadershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferMetadataRepositoryLeadershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StorageNodeMetadata) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StorageNodeMetadataDescriptor.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime)
+	n += 1 + l + sovAdmin(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHeartbeatTime)
+	n += 1 + l + sovAdmin(uint64(l))
+	if m.DrainStatus != nil {
+		l = m.DrainStatus.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *DrainStatus) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovAdmin(uint64(m.State))
+	}
+	if m.TotalReplicas != 0 {
+		n += 1 + sovAdmin(uint64(m.TotalReplicas))
+	}
+	if m.MovedReplicas != 0 {
+		n += 1 + sovAdmin(uint64(m.MovedReplicas))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovAdmin(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *OperationProgress) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalEntries != 0 {
+		n += 1 + sovAdmin(uint64(m.TotalEntries))
+	}
+	if m.DoneEntries != 0 {
+		n += 1 + sovAdmin(uint64(m.DoneEntries))
+	}
+	return n
+}
+
+func (m *Operation) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperationID != 0 {
+		n += 1 + sovAdmin(uint64(m.OperationID))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovAdmin(uint64(m.Kind))
+	}
+	if m.State != 0 {
+		n += 1 + sovAdmin(uint64(m.State))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	if m.SrcStorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.SrcStorageNodeID))
+	}
+	if m.DstStorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.DstStorageNodeID))
+	}
+	l = m.Progress.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime)
+	n += 1 + l + sovAdmin(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *Event) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventID != 0 {
+		n += 1 + sovAdmin(uint64(m.EventID))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovAdmin(uint64(m.Kind))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime)
+	n += 1 + l + sovAdmin(uint64(l))
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	if m.SrcStorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.SrcStorageNodeID))
+	}
+	if m.DstStorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.DstStorageNodeID))
+	}
+	if m.Progress != nil {
+		l = m.Progress.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *GetStorageNodeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
+	return n
+}
+
+func (m *GetStorageNodeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNode != nil {
+		l = m.StorageNode.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *ListStorageNodesRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListStorageNodesResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StorageNodes) > 0 {
+		for _, e := range m.StorageNodes {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddStorageNodeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StorageNode.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *AddStorageNodeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNode != nil {
+		l = m.StorageNode.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *UnregisterStorageNodeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
+	return n
+}
+
+func (m *UnregisterStorageNodeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DrainStorageNodeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
+	return n
+}
+
+func (m *DrainStorageNodeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNode != nil {
+		l = m.StorageNode.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *SetStorageNodeSyncBandwidthRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
+	if m.BytesPerSecond != 0 {
+		n += 1 + sovAdmin(uint64(m.BytesPerSecond))
+	}
+	return n
+}
+
+func (m *SetStorageNodeSyncBandwidthResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
+	if m.PrevBytesPerSecond != 0 {
+		n += 1 + sovAdmin(uint64(m.PrevBytesPerSecond))
+	}
+	if m.BytesPerSecond != 0 {
+		n += 1 + sovAdmin(uint64(m.BytesPerSecond))
+	}
+	return n
+}
+
+func (m *SetStorageNodeAppendQuotaRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	l = m.Quota.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *SetStorageNodeAppendQuotaResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	l = m.PrevQuota.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	l = m.Quota.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *GetTopicRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	return n
+}
+
+func (m *GetTopicResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Topic != nil {
+		l = m.Topic.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeTopicRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	return n
+}
+
+func (m *DescribeTopicResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Topic.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	if len(m.LogStreams) > 0 {
+		for _, e := range m.LogStreams {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListTopicsRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListTopicsResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddTopicRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Compacted {
+		n += 2
+	}
+	return n
+}
+
+func (m *AddTopicResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Topic != nil {
+		l = m.Topic.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *UnregisterTopicRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	return n
+}
+
+func (m *UnregisterTopicResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetLogStreamRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *GetLogStreamResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		l = m.LogStream.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *ListLogStreamsRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	return n
+}
+
+func (m *ListLogStreamsResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LogStreams) > 0 {
+		for _, e := range m.LogStreams {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddLogStreamRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if len(m.Replicas) > 0 {
+		for _, e := range m.Replicas {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddLogStreamResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		l = m.LogStream.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateLogStreamRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	l = m.PoppedReplica.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	l = m.PushedReplica.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *UpdateLogStreamResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		l = m.LogStream.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.OperationID != 0 {
+		n += 1 + sovAdmin(uint64(m.OperationID))
+	}
+	if m.OperationState != 0 {
+		n += 1 + sovAdmin(uint64(m.OperationState))
+	}
+	return n
+}
+
+func (m *UnregisterLogStreamRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *UnregisterLogStreamResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveLogStreamReplicaRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *RemoveLogStreamReplicaResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SealRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *SealResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LogStreams) > 0 {
+		for _, e := range m.LogStreams {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.SealedGLSN != 0 {
+		n += 1 + sovAdmin(uint64(m.SealedGLSN))
+	}
+	if m.OperationID != 0 {
+		n += 1 + sovAdmin(uint64(m.OperationID))
+	}
+	if m.OperationState != 0 {
+		n += 1 + sovAdmin(uint64(m.OperationState))
+	}
+	return n
+}
+
+func (m *UnsealRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *UnsealResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		l = m.LogStream.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.OperationID != 0 {
+		n += 1 + sovAdmin(uint64(m.OperationID))
+	}
+	if m.OperationState != 0 {
+		n += 1 + sovAdmin(uint64(m.OperationState))
+	}
+	return n
+}
+
+func (m *SyncRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	if m.SrcStorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.SrcStorageNodeID))
+	}
+	if m.DstStorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.DstStorageNodeID))
+	}
+	return n
+}
+
+func (m *SyncResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.OperationID != 0 {
+		n += 1 + sovAdmin(uint64(m.OperationID))
+	}
+	if m.OperationState != 0 {
+		n += 1 + sovAdmin(uint64(m.OperationState))
+	}
+	return n
+}
+
+func (m *GetOperationRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperationID != 0 {
+		n += 1 + sovAdmin(uint64(m.OperationID))
+	}
+	return n
+}
+
+func (m *GetOperationResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != nil {
+		l = m.Operation.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *ListOperationsRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListOperationsResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CancelOperationRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperationID != 0 {
+		n += 1 + sovAdmin(uint64(m.OperationID))
+	}
+	return n
+}
+
+func (m *CancelOperationResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != nil {
+		l = m.Operation.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *WatchEventsRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AfterEventID != 0 {
+		n += 1 + sovAdmin(uint64(m.AfterEventID))
+	}
+	if m.Follow {
+		n += 2
+	}
+	return n
+}
+
+func (m *WatchEventsResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Event.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *TrimRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LastGLSN != 0 {
+		n += 1 + sovAdmin(uint64(m.LastGLSN))
+	}
+	return n
+}
+
+func (m *TrimResult) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *TrimResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckpointLogStreamReplicaRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Archive {
+		n += 2
+	}
+	return n
+}
+
+func (m *CheckpointLogStreamReplicaResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Checkpoint.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *GetMetadataRepositoryNodeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.NodeID))
+	}
+	return n
+}
+
+func (m *GetMetadataRepositoryNodeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Node != nil {
+		l = m.Node.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *ListMetadataRepositoryNodesRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListMetadataRepositoryNodesResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetMRMembersResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Leader != 0 {
+		n += 1 + sovAdmin(uint64(m.Leader))
+	}
+	if m.ReplicationFactor != 0 {
+		n += 1 + sovAdmin(uint64(m.ReplicationFactor))
+	}
+	if len(m.Members) > 0 {
+		for k, v := range m.Members {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovAdmin(uint64(k)) + 1 + len(v) + sovAdmin(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *AddMetadataRepositoryNodeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RaftURL)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.RPCAddr)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *AddMetadataRepositoryNodeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Node != nil {
+		l = m.Node.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *AddMRPeerRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RaftURL)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.RPCAddr)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *AddMRPeerResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.NodeID))
+	}
+	return n
+}
+
+func (m *DeleteMetadataRepositoryNodeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.NodeID))
+	}
+	return n
+}
+
+func (m *DeleteMetadataRepositoryNodeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveMRPeerRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RaftURL)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveMRPeerResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TransferMetadataRepositoryLeadershipRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.NodeID))
+	}
+	return n
+}
+
+func (m *TransferMetadataRepositoryLeadershipResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAdmin(x uint64) (n int) {
+	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageNodeMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageNodeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeMetadataDescriptor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorageNodeMetadataDescriptor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DrainStatus == nil {
+				m.DrainStatus = &DrainStatus{}
+			}
+			if err := m.DrainStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrainStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrainStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrainStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= DrainState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReplicas", wireType)
+			}
+			m.TotalReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedReplicas", wireType)
+			}
+			m.MovedReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MovedReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperationProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEntries", wireType)
+			}
+			m.TotalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoneEntries", wireType)
+			}
+			m.DoneEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DoneEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationID", wireType)
+			}
+			m.OperationID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= OperationKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= OperationState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcStorageNodeID", wireType)
+			}
+			m.SrcStorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcStorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstStorageNodeID", wireType)
+			}
+			m.DstStorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstStorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Progress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventID", wireType)
+			}
+			m.EventID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= EventKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcStorageNodeID", wireType)
+			}
+			m.SrcStorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcStorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstStorageNodeID", wireType)
+			}
+			m.DstStorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstStorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Progress == nil {
+				m.Progress = &OperationProgress{}
+			}
+			if err := m.Progress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetStorageNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStorageNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetStorageNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStorageNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageNode == nil {
+				m.StorageNode = &StorageNodeMetadata{}
+			}
+			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListStorageNodesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListStorageNodesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListStorageNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListStorageNodesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListStorageNodesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListStorageNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageNodes = append(m.StorageNodes, StorageNodeMetadata{})
+			if err := m.StorageNodes[len(m.StorageNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddStorageNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddStorageNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddStorageNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddStorageNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageNode == nil {
+				m.StorageNode = &StorageNodeMetadata{}
+			}
+			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnregisterStorageNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnregisterStorageNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnregisterStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnregisterStorageNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnregisterStorageNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnregisterStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrainStorageNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrainStorageNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrainStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrainStorageNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrainStorageNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrainStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageNode == nil {
+				m.StorageNode = &StorageNodeMetadata{}
+			}
+			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetStorageNodeSyncBandwidthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetStorageNodeSyncBandwidthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetStorageNodeSyncBandwidthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesPerSecond", wireType)
+			}
+			m.BytesPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesPerSecond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetStorageNodeSyncBandwidthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetStorageNodeSyncBandwidthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetStorageNodeSyncBandwidthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevBytesPerSecond", wireType)
+			}
+			m.PrevBytesPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevBytesPerSecond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesPerSecond", wireType)
+			}
+			m.BytesPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesPerSecond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetStorageNodeAppendQuotaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetStorageNodeAppendQuotaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetStorageNodeAppendQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetStorageNodeAppendQuotaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetStorageNodeAppendQuotaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetStorageNodeAppendQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrevQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
+func (m *GetTopicRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6273,17 +12405,17 @@ func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorageNodeMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTopicRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageNodeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeMetadataDescriptor", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			var msglen int
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -6293,61 +12425,64 @@ func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StorageNodeMetadataDescriptor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6374,7 +12509,10 @@ func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Topic == nil {
+				m.Topic = &varlogpb.TopicDescriptor{}
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6399,7 +12537,7 @@ func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetStorageNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeTopicRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6422,17 +12560,17 @@ func (m *GetStorageNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetStorageNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeTopicRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			m.StorageNodeID = 0
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -6442,7 +12580,7 @@ func (m *GetStorageNodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6468,7 +12606,7 @@ func (m *GetStorageNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetStorageNodeResponse) Unmarshal(dAtA []byte) error {
+func (m *DescribeTopicResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6491,15 +12629,15 @@ func (m *GetStorageNodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetStorageNodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeTopicResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6526,10 +12664,41 @@ func (m *GetStorageNodeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StorageNode == nil {
-				m.StorageNode = &StorageNodeMetadata{}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogStreams = append(m.LogStreams, varlogpb.LogStreamDescriptor{})
+			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6554,7 +12723,7 @@ func (m *GetStorageNodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListStorageNodesRequest) Unmarshal(dAtA []byte) error {
+func (m *ListTopicsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6577,10 +12746,10 @@ func (m *ListStorageNodesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListStorageNodesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTopicsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListStorageNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTopicsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6604,7 +12773,7 @@ func (m *ListStorageNodesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListStorageNodesResponse) Unmarshal(dAtA []byte) error {
+func (m *ListTopicsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6627,15 +12796,15 @@ func (m *ListStorageNodesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListStorageNodesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTopicsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListStorageNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTopicsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6662,8 +12831,8 @@ func (m *ListStorageNodesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageNodes = append(m.StorageNodes, StorageNodeMetadata{})
-			if err := m.StorageNodes[len(m.StorageNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Topics = append(m.Topics, varlogpb.TopicDescriptor{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6688,7 +12857,7 @@ func (m *ListStorageNodesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddStorageNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *AddTopicRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6711,17 +12880,17 @@ func (m *AddStorageNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddStorageNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddTopicRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compacted", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -6731,25 +12900,12 @@ func (m *AddStorageNodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Compacted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -6771,7 +12927,7 @@ func (m *AddStorageNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddStorageNodeResponse) Unmarshal(dAtA []byte) error {
+func (m *AddTopicResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6794,15 +12950,15 @@ func (m *AddStorageNodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddStorageNodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddTopicResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6829,10 +12985,10 @@ func (m *AddStorageNodeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StorageNode == nil {
-				m.StorageNode = &StorageNodeMetadata{}
+			if m.Topic == nil {
+				m.Topic = &varlogpb.TopicDescriptor{}
 			}
-			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6857,7 +13013,7 @@ func (m *AddStorageNodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnregisterStorageNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *UnregisterTopicRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6880,17 +13036,17 @@ func (m *UnregisterStorageNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnregisterStorageNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnregisterTopicRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnregisterStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnregisterTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			m.StorageNodeID = 0
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -6900,7 +13056,7 @@ func (m *UnregisterStorageNodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6926,7 +13082,7 @@ func (m *UnregisterStorageNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnregisterStorageNodeResponse) Unmarshal(dAtA []byte) error {
+func (m *UnregisterTopicResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6949,10 +13105,10 @@ func (m *UnregisterStorageNodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnregisterStorageNodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnregisterTopicResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnregisterStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnregisterTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6976,7 +13132,7 @@ func (m *UnregisterStorageNodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *GetLogStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6999,17 +13155,36 @@ func (m *GetTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLogStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
 			}
-			m.TopicID = 0
+			m.LogStreamID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -7019,7 +13194,7 @@ func (m *GetTopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7045,7 +13220,7 @@ func (m *GetTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *GetLogStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7068,15 +13243,15 @@ func (m *GetTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLogStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7103,10 +13278,10 @@ func (m *GetTopicResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Topic == nil {
-				m.Topic = &varlogpb.TopicDescriptor{}
+			if m.LogStream == nil {
+				m.LogStream = &varlogpb.LogStreamDescriptor{}
 			}
-			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7131,7 +13306,7 @@ func (m *GetTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *ListLogStreamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7154,10 +13329,10 @@ func (m *DescribeTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListLogStreamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListLogStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7200,7 +13375,7 @@ func (m *DescribeTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *ListLogStreamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7223,15 +13398,15 @@ func (m *DescribeTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListLogStreamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListLogStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7258,13 +13433,83 @@ func (m *DescribeTopicResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LogStreams = append(m.LogStreams, varlogpb.LogStreamDescriptor{})
+			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddLogStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddLogStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7291,8 +13536,8 @@ func (m *DescribeTopicResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogStreams = append(m.LogStreams, varlogpb.LogStreamDescriptor{})
-			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Replicas = append(m.Replicas, &varlogpb.ReplicaDescriptor{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7317,7 +13562,7 @@ func (m *DescribeTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTopicsRequest) Unmarshal(dAtA []byte) error {
+func (m *AddLogStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7340,12 +13585,48 @@ func (m *ListTopicsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTopicsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddLogStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTopicsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LogStream == nil {
+				m.LogStream = &varlogpb.LogStreamDescriptor{}
+			}
+			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7367,7 +13648,7 @@ func (m *ListTopicsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTopicsResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7390,15 +13671,53 @@ func (m *ListTopicsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTopicsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateLogStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTopicsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoppedReplica", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7425,61 +13744,43 @@ func (m *ListTopicsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topics = append(m.Topics, varlogpb.TopicDescriptor{})
-			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoppedReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PushedReplica", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAdmin
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AddTopicRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.PushedReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AddTopicRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7501,7 +13802,7 @@ func (m *AddTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateLogStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7524,15 +13825,15 @@ func (m *AddTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateLogStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7559,13 +13860,51 @@ func (m *AddTopicResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Topic == nil {
-				m.Topic = &varlogpb.TopicDescriptor{}
+			if m.LogStream == nil {
+				m.LogStream = &varlogpb.LogStreamDescriptor{}
 			}
-			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationID", wireType)
+			}
+			m.OperationID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationState", wireType)
+			}
+			m.OperationState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationState |= OperationState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7587,7 +13926,7 @@ func (m *AddTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnregisterTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *UnregisterLogStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7610,10 +13949,10 @@ func (m *UnregisterTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnregisterTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnregisterLogStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnregisterTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnregisterLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7635,6 +13974,25 @@ func (m *UnregisterTopicRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7656,7 +14014,7 @@ func (m *UnregisterTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnregisterTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *UnregisterLogStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7679,10 +14037,10 @@ func (m *UnregisterTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnregisterTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnregisterLogStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnregisterTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnregisterLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7706,7 +14064,7 @@ func (m *UnregisterTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLogStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7729,13 +14087,32 @@ func (m *GetLogStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLogStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveLogStreamReplicaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveLogStreamReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
@@ -7754,7 +14131,7 @@ func (m *GetLogStreamRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
 			}
@@ -7794,7 +14171,7 @@ func (m *GetLogStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLogStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveLogStreamReplicaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7817,48 +14194,12 @@ func (m *GetLogStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLogStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveLogStreamReplicaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveLogStreamReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LogStream == nil {
-				m.LogStream = &varlogpb.LogStreamDescriptor{}
-			}
-			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7880,7 +14221,7 @@ func (m *GetLogStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListLogStreamsRequest) Unmarshal(dAtA []byte) error {
+func (m *SealRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7903,10 +14244,10 @@ func (m *ListLogStreamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListLogStreamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SealRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListLogStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7928,6 +14269,25 @@ func (m *ListLogStreamsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7949,7 +14309,7 @@ func (m *ListLogStreamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListLogStreamsResponse) Unmarshal(dAtA []byte) error {
+func (m *SealResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7972,17 +14332,89 @@ func (m *ListLogStreamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListLogStreamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SealResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListLogStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
 			}
-			var msglen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogStreams = append(m.LogStreams, snpb.LogStreamReplicaMetadataDescriptor{})
+			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedGLSN", wireType)
+			}
+			m.SealedGLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SealedGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationID", wireType)
+			}
+			m.OperationID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationState", wireType)
+			}
+			m.OperationState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -7992,26 +14424,11 @@ func (m *ListLogStreamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OperationState |= OperationState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LogStreams = append(m.LogStreams, varlogpb.LogStreamDescriptor{})
-			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8033,7 +14450,7 @@ func (m *ListLogStreamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddLogStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8056,10 +14473,10 @@ func (m *AddLogStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddLogStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnsealRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnsealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8082,10 +14499,10 @@ func (m *AddLogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
 			}
-			var msglen int
+			m.LogStreamID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8095,26 +14512,11 @@ func (m *AddLogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Replicas = append(m.Replicas, &varlogpb.ReplicaDescriptor{})
-			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8136,7 +14538,7 @@ func (m *AddLogStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddLogStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8159,10 +14561,10 @@ func (m *AddLogStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddLogStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnsealResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnsealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8201,6 +14603,44 @@ func (m *AddLogStreamResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationID", wireType)
+			}
+			m.OperationID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationState", wireType)
+			}
+			m.OperationState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationState |= OperationState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8222,7 +14662,7 @@ func (m *AddLogStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8245,10 +14685,10 @@ func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateLogStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8290,10 +14730,10 @@ func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoppedReplica", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcStorageNodeID", wireType)
 			}
-			var msglen int
+			m.SrcStorageNodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8303,30 +14743,16 @@ func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SrcStorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PoppedReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PushedReplica", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstStorageNodeID", wireType)
 			}
-			var msglen int
+			m.DstStorageNodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8336,25 +14762,11 @@ func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DstStorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PushedReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8376,7 +14788,7 @@ func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateLogStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *SyncResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8399,15 +14811,15 @@ func (m *UpdateLogStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateLogStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8434,13 +14846,51 @@ func (m *UpdateLogStreamResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LogStream == nil {
-				m.LogStream = &varlogpb.LogStreamDescriptor{}
+			if m.Status == nil {
+				m.Status = &snpb.SyncStatus{}
 			}
-			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationID", wireType)
+			}
+			m.OperationID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationState", wireType)
+			}
+			m.OperationState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationState |= OperationState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8462,7 +14912,7 @@ func (m *UpdateLogStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnregisterLogStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *GetOperationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8485,17 +14935,17 @@ func (m *UnregisterLogStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnregisterLogStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOperationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnregisterLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationID", wireType)
 			}
-			m.TopicID = 0
+			m.OperationID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8505,16 +14955,66 @@ func (m *UnregisterLogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				m.OperationID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.LogStreamID = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetOperationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetOperationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8524,11 +15024,28 @@ func (m *UnregisterLogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Operation == nil {
+				m.Operation = &Operation{}
+			}
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8550,7 +15067,7 @@ func (m *UnregisterLogStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnregisterLogStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *ListOperationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8573,10 +15090,10 @@ func (m *UnregisterLogStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnregisterLogStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListOperationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnregisterLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListOperationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8600,7 +15117,7 @@ func (m *UnregisterLogStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
+func (m *ListOperationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8623,17 +15140,17 @@ func (m *RemoveLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveLogStreamReplicaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListOperationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveLogStreamReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
 			}
-			m.StorageNodeID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8643,49 +15160,26 @@ func (m *RemoveLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
 			}
-			m.TopicID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.LogStreamID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Operations = append(m.Operations, Operation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8707,7 +15201,7 @@ func (m *RemoveLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveLogStreamReplicaResponse) Unmarshal(dAtA []byte) error {
+func (m *CancelOperationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8730,12 +15224,31 @@ func (m *RemoveLogStreamReplicaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveLogStreamReplicaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelOperationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveLogStreamReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationID", wireType)
+			}
+			m.OperationID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8757,7 +15270,7 @@ func (m *RemoveLogStreamReplicaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SealRequest) Unmarshal(dAtA []byte) error {
+func (m *CancelOperationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8780,17 +15293,17 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SealRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelOperationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			m.TopicID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8800,30 +15313,28 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
 			}
-			m.LogStreamID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Operation == nil {
+				m.Operation = &Operation{}
 			}
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8845,7 +15356,7 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SealResponse) Unmarshal(dAtA []byte) error {
+func (m *WatchEventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8868,17 +15379,17 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SealResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchEventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterEventID", wireType)
 			}
-			var msglen int
+			m.AfterEventID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8888,31 +15399,16 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AfterEventID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LogStreams = append(m.LogStreams, snpb.LogStreamReplicaMetadataDescriptor{})
-			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SealedGLSN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
 			}
-			m.SealedGLSN = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8922,11 +15418,12 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SealedGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Follow = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8948,7 +15445,7 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchEventsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8971,17 +15468,17 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsealRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchEventsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
-			m.TopicID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8991,16 +15488,30 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
 			}
-			m.LogStreamID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9010,11 +15521,12 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Truncated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -9036,7 +15548,7 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
+func (m *TrimRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9059,17 +15571,17 @@ func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsealResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrimRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrimRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			var msglen int
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9079,28 +15591,30 @@ func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LogStream == nil {
-				m.LogStream = &varlogpb.LogStreamDescriptor{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastGLSN", wireType)
 			}
-			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LastGLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -9122,7 +15636,7 @@ func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncRequest) Unmarshal(dAtA []byte) error {
+func (m *TrimResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9145,17 +15659,17 @@ func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrimResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrimResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
 			}
-			m.TopicID = 0
+			m.StorageNodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9165,7 +15679,7 @@ func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9190,29 +15704,10 @@ func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcStorageNodeID", wireType)
-			}
-			m.SrcStorageNodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SrcStorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstStorageNodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			m.DstStorageNodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9222,11 +15717,24 @@ func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DstStorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -9248,7 +15756,7 @@ func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncResponse) Unmarshal(dAtA []byte) error {
+func (m *TrimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9271,15 +15779,15 @@ func (m *SyncResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9306,10 +15814,8 @@ func (m *SyncResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &snpb.SyncStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, TrimResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9334,7 +15840,7 @@ func (m *SyncResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TrimRequest) Unmarshal(dAtA []byte) error {
+func (m *CheckpointLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9357,17 +15863,17 @@ func (m *TrimRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TrimRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointLogStreamReplicaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrimRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointLogStreamReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
 			}
-			m.TopicID = 0
+			m.StorageNodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9377,85 +15883,16 @@ func (m *TrimRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastGLSN", wireType)
-			}
-			m.LastGLSN = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TrimResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TrimResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrimResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			m.StorageNodeID = 0
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9465,12 +15902,12 @@ func (m *TrimResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
 			}
@@ -9489,9 +15926,9 @@ func (m *TrimResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9519,8 +15956,28 @@ func (m *TrimResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Archive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -9542,7 +15999,7 @@ func (m *TrimResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TrimResponse) Unmarshal(dAtA []byte) error {
+func (m *CheckpointLogStreamReplicaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9565,15 +16022,15 @@ func (m *TrimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TrimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointLogStreamReplicaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointLogStreamReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9600,8 +16057,7 @@ func (m *TrimResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Results = append(m.Results, TrimResult{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10750,6 +17206,125 @@ func (m *RemoveMRPeerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TransferMetadataRepositoryLeadershipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferMetadataRepositoryLeadershipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferMetadataRepositoryLeadershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferMetadataRepositoryLeadershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferMetadataRepositoryLeadershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferMetadataRepositoryLeadershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAdmin(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -136,6 +136,46 @@ func (mr *MockClusterManagerClientMockRecorder) AddTopic(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTopic", reflect.TypeOf((*MockClusterManagerClient)(nil).AddTopic), varargs...)
 }
 
+// CancelOperation mocks base method.
+func (m *MockClusterManagerClient) CancelOperation(arg0 context.Context, arg1 *CancelOperationRequest, arg2 ...grpc.CallOption) (*CancelOperationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelOperation", varargs...)
+	ret0, _ := ret[0].(*CancelOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelOperation indicates an expected call of CancelOperation.
+func (mr *MockClusterManagerClientMockRecorder) CancelOperation(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOperation", reflect.TypeOf((*MockClusterManagerClient)(nil).CancelOperation), varargs...)
+}
+
+// CheckpointLogStreamReplica mocks base method.
+func (m *MockClusterManagerClient) CheckpointLogStreamReplica(arg0 context.Context, arg1 *CheckpointLogStreamReplicaRequest, arg2 ...grpc.CallOption) (*CheckpointLogStreamReplicaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckpointLogStreamReplica", varargs...)
+	ret0, _ := ret[0].(*CheckpointLogStreamReplicaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckpointLogStreamReplica indicates an expected call of CheckpointLogStreamReplica.
+func (mr *MockClusterManagerClientMockRecorder) CheckpointLogStreamReplica(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckpointLogStreamReplica", reflect.TypeOf((*MockClusterManagerClient)(nil).CheckpointLogStreamReplica), varargs...)
+}
+
 // DeleteMetadataRepositoryNode mocks base method.
 func (m *MockClusterManagerClient) DeleteMetadataRepositoryNode(arg0 context.Context, arg1 *DeleteMetadataRepositoryNodeRequest, arg2 ...grpc.CallOption) (*DeleteMetadataRepositoryNodeResponse, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +216,26 @@ func (mr *MockClusterManagerClientMockRecorder) DescribeTopic(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTopic", reflect.TypeOf((*MockClusterManagerClient)(nil).DescribeTopic), varargs...)
 }
 
+// DrainStorageNode mocks base method.
+func (m *MockClusterManagerClient) DrainStorageNode(arg0 context.Context, arg1 *DrainStorageNodeRequest, arg2 ...grpc.CallOption) (*DrainStorageNodeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DrainStorageNode", varargs...)
+	ret0, _ := ret[0].(*DrainStorageNodeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DrainStorageNode indicates an expected call of DrainStorageNode.
+func (mr *MockClusterManagerClientMockRecorder) DrainStorageNode(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrainStorageNode", reflect.TypeOf((*MockClusterManagerClient)(nil).DrainStorageNode), varargs...)
+}
+
 // GetLogStream mocks base method.
 func (m *MockClusterManagerClient) GetLogStream(arg0 context.Context, arg1 *GetLogStreamRequest, arg2 ...grpc.CallOption) (*GetLogStreamResponse, error) {
 	m.ctrl.T.Helper()
@@ -236,6 +296,26 @@ func (mr *MockClusterManagerClientMockRecorder) GetMetadataRepositoryNode(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadataRepositoryNode", reflect.TypeOf((*MockClusterManagerClient)(nil).GetMetadataRepositoryNode), varargs...)
 }
 
+// GetOperation mocks base method.
+func (m *MockClusterManagerClient) GetOperation(arg0 context.Context, arg1 *GetOperationRequest, arg2 ...grpc.CallOption) (*GetOperationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOperation", varargs...)
+	ret0, _ := ret[0].(*GetOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperation indicates an expected call of GetOperation.
+func (mr *MockClusterManagerClientMockRecorder) GetOperation(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperation", reflect.TypeOf((*MockClusterManagerClient)(nil).GetOperation), varargs...)
+}
+
 // GetStorageNode mocks base method.
 func (m *MockClusterManagerClient) GetStorageNode(arg0 context.Context, arg1 *GetStorageNodeRequest, arg2 ...grpc.CallOption) (*GetStorageNodeResponse, error) {
 	m.ctrl.T.Helper()
@@ -316,6 +396,26 @@ func (mr *MockClusterManagerClientMockRecorder) ListMetadataRepositoryNodes(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMetadataRepositoryNodes", reflect.TypeOf((*MockClusterManagerClient)(nil).ListMetadataRepositoryNodes), varargs...)
 }
 
+// ListOperations mocks base method.
+func (m *MockClusterManagerClient) ListOperations(arg0 context.Context, arg1 *ListOperationsRequest, arg2 ...grpc.CallOption) (*ListOperationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOperations", varargs...)
+	ret0, _ := ret[0].(*ListOperationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOperations indicates an expected call of ListOperations.
+func (mr *MockClusterManagerClientMockRecorder) ListOperations(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOperations", reflect.TypeOf((*MockClusterManagerClient)(nil).ListOperations), varargs...)
+}
+
 // ListStorageNodes mocks base method.
 func (m *MockClusterManagerClient) ListStorageNodes(arg0 context.Context, arg1 *ListStorageNodesRequest, arg2 ...grpc.CallOption) (*ListStorageNodesResponse, error) {
 	m.ctrl.T.Helper()
@@ -416,6 +516,46 @@ func (mr *MockClusterManagerClientMockRecorder) Seal(arg0, arg1 interface{}, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockClusterManagerClient)(nil).Seal), varargs...)
 }
 
+// SetStorageNodeAppendQuota mocks base method.
+func (m *MockClusterManagerClient) SetStorageNodeAppendQuota(arg0 context.Context, arg1 *SetStorageNodeAppendQuotaRequest, arg2 ...grpc.CallOption) (*SetStorageNodeAppendQuotaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetStorageNodeAppendQuota", varargs...)
+	ret0, _ := ret[0].(*SetStorageNodeAppendQuotaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStorageNodeAppendQuota indicates an expected call of SetStorageNodeAppendQuota.
+func (mr *MockClusterManagerClientMockRecorder) SetStorageNodeAppendQuota(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageNodeAppendQuota", reflect.TypeOf((*MockClusterManagerClient)(nil).SetStorageNodeAppendQuota), varargs...)
+}
+
+// SetStorageNodeSyncBandwidth mocks base method.
+func (m *MockClusterManagerClient) SetStorageNodeSyncBandwidth(arg0 context.Context, arg1 *SetStorageNodeSyncBandwidthRequest, arg2 ...grpc.CallOption) (*SetStorageNodeSyncBandwidthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetStorageNodeSyncBandwidth", varargs...)
+	ret0, _ := ret[0].(*SetStorageNodeSyncBandwidthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStorageNodeSyncBandwidth indicates an expected call of SetStorageNodeSyncBandwidth.
+func (mr *MockClusterManagerClientMockRecorder) SetStorageNodeSyncBandwidth(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageNodeSyncBandwidth", reflect.TypeOf((*MockClusterManagerClient)(nil).SetStorageNodeSyncBandwidth), varargs...)
+}
+
 // Sync mocks base method.
 func (m *MockClusterManagerClient) Sync(arg0 context.Context, arg1 *SyncRequest, arg2 ...grpc.CallOption) (*SyncResponse, error) {
 	m.ctrl.T.Helper()
@@ -436,6 +576,26 @@ func (mr *MockClusterManagerClientMockRecorder) Sync(arg0, arg1 interface{}, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockClusterManagerClient)(nil).Sync), varargs...)
 }
 
+// TransferMetadataRepositoryLeadership mocks base method.
+func (m *MockClusterManagerClient) TransferMetadataRepositoryLeadership(arg0 context.Context, arg1 *TransferMetadataRepositoryLeadershipRequest, arg2 ...grpc.CallOption) (*TransferMetadataRepositoryLeadershipResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TransferMetadataRepositoryLeadership", varargs...)
+	ret0, _ := ret[0].(*TransferMetadataRepositoryLeadershipResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferMetadataRepositoryLeadership indicates an expected call of TransferMetadataRepositoryLeadership.
+func (mr *MockClusterManagerClientMockRecorder) TransferMetadataRepositoryLeadership(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferMetadataRepositoryLeadership", reflect.TypeOf((*MockClusterManagerClient)(nil).TransferMetadataRepositoryLeadership), varargs...)
+}
+
 // Trim mocks base method.
 func (m *MockClusterManagerClient) Trim(arg0 context.Context, arg1 *TrimRequest, arg2 ...grpc.CallOption) (*TrimResponse, error) {
 	m.ctrl.T.Helper()
@@ -556,6 +716,26 @@ func (mr *MockClusterManagerClientMockRecorder) UpdateLogStream(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLogStream", reflect.TypeOf((*MockClusterManagerClient)(nil).UpdateLogStream), varargs...)
 }
 
+// WatchEvents mocks base method.
+func (m *MockClusterManagerClient) WatchEvents(arg0 context.Context, arg1 *WatchEventsRequest, arg2 ...grpc.CallOption) (ClusterManager_WatchEventsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchEvents", varargs...)
+	ret0, _ := ret[0].(ClusterManager_WatchEventsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchEvents indicates an expected call of WatchEvents.
+func (mr *MockClusterManagerClientMockRecorder) WatchEvents(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchEvents", reflect.TypeOf((*MockClusterManagerClient)(nil).WatchEvents), varargs...)
+}
+
 // MockClusterManagerServer is a mock of ClusterManagerServer interface.
 type MockClusterManagerServer struct {
 	ctrl     *gomock.Controller
@@ -654,6 +834,36 @@ func (mr *MockClusterManagerServerMockRecorder) AddTopic(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTopic", reflect.TypeOf((*MockClusterManagerServer)(nil).AddTopic), arg0, arg1)
 }
 
+// CancelOperation mocks base method.
+func (m *MockClusterManagerServer) CancelOperation(arg0 context.Context, arg1 *CancelOperationRequest) (*CancelOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOperation", arg0, arg1)
+	ret0, _ := ret[0].(*CancelOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelOperation indicates an expected call of CancelOperation.
+func (mr *MockClusterManagerServerMockRecorder) CancelOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOperation", reflect.TypeOf((*MockClusterManagerServer)(nil).CancelOperation), arg0, arg1)
+}
+
+// CheckpointLogStreamReplica mocks base method.
+func (m *MockClusterManagerServer) CheckpointLogStreamReplica(arg0 context.Context, arg1 *CheckpointLogStreamReplicaRequest) (*CheckpointLogStreamReplicaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckpointLogStreamReplica", arg0, arg1)
+	ret0, _ := ret[0].(*CheckpointLogStreamReplicaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckpointLogStreamReplica indicates an expected call of CheckpointLogStreamReplica.
+func (mr *MockClusterManagerServerMockRecorder) CheckpointLogStreamReplica(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckpointLogStreamReplica", reflect.TypeOf((*MockClusterManagerServer)(nil).CheckpointLogStreamReplica), arg0, arg1)
+}
+
 // DeleteMetadataRepositoryNode mocks base method.
 func (m *MockClusterManagerServer) DeleteMetadataRepositoryNode(arg0 context.Context, arg1 *DeleteMetadataRepositoryNodeRequest) (*DeleteMetadataRepositoryNodeResponse, error) {
 	m.ctrl.T.Helper()
@@ -684,6 +894,21 @@ func (mr *MockClusterManagerServerMockRecorder) DescribeTopic(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTopic", reflect.TypeOf((*MockClusterManagerServer)(nil).DescribeTopic), arg0, arg1)
 }
 
+// DrainStorageNode mocks base method.
+func (m *MockClusterManagerServer) DrainStorageNode(arg0 context.Context, arg1 *DrainStorageNodeRequest) (*DrainStorageNodeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DrainStorageNode", arg0, arg1)
+	ret0, _ := ret[0].(*DrainStorageNodeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DrainStorageNode indicates an expected call of DrainStorageNode.
+func (mr *MockClusterManagerServerMockRecorder) DrainStorageNode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrainStorageNode", reflect.TypeOf((*MockClusterManagerServer)(nil).DrainStorageNode), arg0, arg1)
+}
+
 // GetLogStream mocks base method.
 func (m *MockClusterManagerServer) GetLogStream(arg0 context.Context, arg1 *GetLogStreamRequest) (*GetLogStreamResponse, error) {
 	m.ctrl.T.Helper()
@@ -729,6 +954,21 @@ func (mr *MockClusterManagerServerMockRecorder) GetMetadataRepositoryNode(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadataRepositoryNode", reflect.TypeOf((*MockClusterManagerServer)(nil).GetMetadataRepositoryNode), arg0, arg1)
 }
 
+// GetOperation mocks base method.
+func (m *MockClusterManagerServer) GetOperation(arg0 context.Context, arg1 *GetOperationRequest) (*GetOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperation", arg0, arg1)
+	ret0, _ := ret[0].(*GetOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperation indicates an expected call of GetOperation.
+func (mr *MockClusterManagerServerMockRecorder) GetOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperation", reflect.TypeOf((*MockClusterManagerServer)(nil).GetOperation), arg0, arg1)
+}
+
 // GetStorageNode mocks base method.
 func (m *MockClusterManagerServer) GetStorageNode(arg0 context.Context, arg1 *GetStorageNodeRequest) (*GetStorageNodeResponse, error) {
 	m.ctrl.T.Helper()
@@ -789,6 +1029,21 @@ func (mr *MockClusterManagerServerMockRecorder) ListMetadataRepositoryNodes(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMetadataRepositoryNodes", reflect.TypeOf((*MockClusterManagerServer)(nil).ListMetadataRepositoryNodes), arg0, arg1)
 }
 
+// ListOperations mocks base method.
+func (m *MockClusterManagerServer) ListOperations(arg0 context.Context, arg1 *ListOperationsRequest) (*ListOperationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOperations", arg0, arg1)
+	ret0, _ := ret[0].(*ListOperationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOperations indicates an expected call of ListOperations.
+func (mr *MockClusterManagerServerMockRecorder) ListOperations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOperations", reflect.TypeOf((*MockClusterManagerServer)(nil).ListOperations), arg0, arg1)
+}
+
 // ListStorageNodes mocks base method.
 func (m *MockClusterManagerServer) ListStorageNodes(arg0 context.Context, arg1 *ListStorageNodesRequest) (*ListStorageNodesResponse, error) {
 	m.ctrl.T.Helper()
@@ -864,6 +1119,36 @@ func (mr *MockClusterManagerServerMockRecorder) Seal(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockClusterManagerServer)(nil).Seal), arg0, arg1)
 }
 
+// SetStorageNodeAppendQuota mocks base method.
+func (m *MockClusterManagerServer) SetStorageNodeAppendQuota(arg0 context.Context, arg1 *SetStorageNodeAppendQuotaRequest) (*SetStorageNodeAppendQuotaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStorageNodeAppendQuota", arg0, arg1)
+	ret0, _ := ret[0].(*SetStorageNodeAppendQuotaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStorageNodeAppendQuota indicates an expected call of SetStorageNodeAppendQuota.
+func (mr *MockClusterManagerServerMockRecorder) SetStorageNodeAppendQuota(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageNodeAppendQuota", reflect.TypeOf((*MockClusterManagerServer)(nil).SetStorageNodeAppendQuota), arg0, arg1)
+}
+
+// SetStorageNodeSyncBandwidth mocks base method.
+func (m *MockClusterManagerServer) SetStorageNodeSyncBandwidth(arg0 context.Context, arg1 *SetStorageNodeSyncBandwidthRequest) (*SetStorageNodeSyncBandwidthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStorageNodeSyncBandwidth", arg0, arg1)
+	ret0, _ := ret[0].(*SetStorageNodeSyncBandwidthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStorageNodeSyncBandwidth indicates an expected call of SetStorageNodeSyncBandwidth.
+func (mr *MockClusterManagerServerMockRecorder) SetStorageNodeSyncBandwidth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageNodeSyncBandwidth", reflect.TypeOf((*MockClusterManagerServer)(nil).SetStorageNodeSyncBandwidth), arg0, arg1)
+}
+
 // Sync mocks base method.
 func (m *MockClusterManagerServer) Sync(arg0 context.Context, arg1 *SyncRequest) (*SyncResponse, error) {
 	m.ctrl.T.Helper()
@@ -879,6 +1164,21 @@ func (mr *MockClusterManagerServerMockRecorder) Sync(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockClusterManagerServer)(nil).Sync), arg0, arg1)
 }
 
+// TransferMetadataRepositoryLeadership mocks base method.
+func (m *MockClusterManagerServer) TransferMetadataRepositoryLeadership(arg0 context.Context, arg1 *TransferMetadataRepositoryLeadershipRequest) (*TransferMetadataRepositoryLeadershipResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferMetadataRepositoryLeadership", arg0, arg1)
+	ret0, _ := ret[0].(*TransferMetadataRepositoryLeadershipResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferMetadataRepositoryLeadership indicates an expected call of TransferMetadataRepositoryLeadership.
+func (mr *MockClusterManagerServerMockRecorder) TransferMetadataRepositoryLeadership(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferMetadataRepositoryLeadership", reflect.TypeOf((*MockClusterManagerServer)(nil).TransferMetadataRepositoryLeadership), arg0, arg1)
+}
+
 // Trim mocks base method.
 func (m *MockClusterManagerServer) Trim(arg0 context.Context, arg1 *TrimRequest) (*TrimResponse, error) {
 	m.ctrl.T.Helper()
@@ -968,3 +1268,17 @@ func (mr *MockClusterManagerServerMockRecorder) UpdateLogStream(arg0, arg1 inter
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLogStream", reflect.TypeOf((*MockClusterManagerServer)(nil).UpdateLogStream), arg0, arg1)
 }
+
+// WatchEvents mocks base method.
+func (m *MockClusterManagerServer) WatchEvents(arg0 *WatchEventsRequest, arg1 ClusterManager_WatchEventsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchEvents", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchEvents indicates an expected call of WatchEvents.
+func (mr *MockClusterManagerServerMockRecorder) WatchEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchEvents", reflect.TypeOf((*MockClusterManagerServer)(nil).WatchEvents), arg0, arg1)
+}
@@ -12,6 +12,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/kakao/varlog/internal/metarepos"
+	"github.com/kakao/varlog/internal/storage"
+	"github.com/kakao/varlog/internal/storagenode"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/testutil"
 	"github.com/kakao/varlog/pkg/varlog"
@@ -211,6 +214,37 @@ func TestClientAppend(t *testing.T) {
 	}
 }
 
+func TestClientAppendWithPushedReports(t *testing.T) {
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(2),
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(2),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+		it.WithReporterClientFactory(metarepos.NewPushReporterClientFactory(time.Second)),
+	)
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	client := clus.ClientAtIndex(t, 0)
+
+	expectedGLSN := types.MinGLSN
+	for i := 0; i < 10; i++ {
+		// Appends are committed in less than the heartbeat interval since
+		// storage nodes push reports as soon as log entries are written.
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		res := client.Append(ctx, topicID, [][]byte{[]byte("foo")})
+		cancel()
+		require.NoError(t, res.Err)
+		require.Equal(t, expectedGLSN, res.Metadata[0].GLSN)
+		expectedGLSN++
+	}
+}
+
 func TestClientAppendCancel(t *testing.T) {
 	// defer goleak.VerifyNone(t)
 	clus := it.NewVarlogCluster(t,
@@ -399,6 +433,297 @@ func TestClientTrim(t *testing.T) {
 	}
 }
 
+func TestClientAppendChunked(t *testing.T) {
+	const chunkSize = 1 << 20
+
+	clus := it.NewVarlogCluster(t,
+		it.WithNumberOfStorageNodes(1),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	logStreamID := clus.LogStreamIDs(topicID)[0]
+
+	// A record larger than the maximum message size of the storage node.
+	large := make([]byte, 5<<20)
+	for i := range large {
+		large[i] = byte(i)
+	}
+	res := clus.ClientAtIndex(t, 0).Append(context.Background(), topicID, [][]byte{large})
+	require.Error(t, res.Err)
+
+	client, err := varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(), varlog.WithChunkSize(chunkSize))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+
+	data := [][]byte{[]byte("foo"), large, []byte("bar")}
+	res = client.Append(context.Background(), topicID, data)
+	require.NoError(t, res.Err)
+	require.Len(t, res.Metadata, len(data))
+	require.Greater(t, res.Metadata[1].GLSN, res.Metadata[0].GLSN+1)
+	require.Equal(t, res.Metadata[1].GLSN+1, res.Metadata[2].GLSN)
+	expected := res.Metadata
+	last := expected[len(expected)-1]
+
+	subscribe := func(begin, end types.GLSN) []varlogpb.LogEntry {
+		var les []varlogpb.LogEntry
+		errC := make(chan error, 1)
+		closer, err := client.Subscribe(context.Background(), topicID, begin, end, func(le varlogpb.LogEntry, err error) {
+			if err != nil {
+				errC <- err
+				return
+			}
+			les = append(les, le)
+		})
+		require.NoError(t, err)
+		defer closer()
+		require.ErrorIs(t, <-errC, io.EOF)
+		return les
+	}
+
+	les := subscribe(types.MinGLSN, last.GLSN+1)
+	require.Len(t, les, len(data))
+	for i, le := range les {
+		require.Equal(t, expected[i].GLSN, le.GLSN)
+		require.Equal(t, expected[i].LLSN, le.LLSN)
+		require.Equal(t, data[i], le.Data)
+	}
+
+	subscriber := client.SubscribeTo(context.Background(), topicID, logStreamID, types.MinLLSN, last.LLSN+1)
+	for i := range data {
+		le, err := subscriber.Next()
+		require.NoError(t, err)
+		require.Equal(t, topicID, le.TopicID)
+		require.Equal(t, logStreamID, le.LogStreamID)
+		require.Equal(t, expected[i].LLSN, le.LLSN)
+		require.Equal(t, data[i], le.Data)
+	}
+	_, err = subscriber.Next()
+	require.ErrorIs(t, err, io.EOF)
+	require.NoError(t, subscriber.Close())
+
+	// The chunked record is skipped if its first chunks are not in the
+	// range.
+	les = subscribe(expected[0].GLSN+2, last.GLSN+1)
+	require.Len(t, les, 1)
+	require.Equal(t, data[2], les[0].Data)
+
+	// Chunks of records appended concurrently are not interleaved.
+	const numAppends = 4
+	var wg sync.WaitGroup
+	results := make([]varlog.AppendResult, numAppends)
+	for i := 0; i < numAppends; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = client.AppendTo(context.Background(), topicID, logStreamID, [][]byte{large, []byte("baz")})
+		}()
+	}
+	wg.Wait()
+	end := last.GLSN
+	for _, res := range results {
+		require.NoError(t, res.Err)
+		require.Len(t, res.Metadata, 2)
+		if res.Metadata[1].GLSN > end {
+			end = res.Metadata[1].GLSN
+		}
+	}
+	les = subscribe(last.GLSN+1, end+1)
+	require.Len(t, les, numAppends*2)
+	numLarge := 0
+	for _, le := range les {
+		if len(le.Data) == len(large) {
+			require.Equal(t, large, le.Data)
+			numLarge++
+		}
+	}
+	require.Equal(t, numAppends, numLarge)
+}
+
+func TestClientLookupByKey(t *testing.T) {
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(2),
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(2),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+		it.WithStorageNodeOptions(
+			storagenode.WithDefaultStorageOptions(storage.WithKeyIndex()),
+		),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	lsids := clus.LogStreamIDs(topicID)
+	client := clus.ClientAtIndex(t, 0)
+
+	res := client.AppendTo(context.Background(), topicID, lsids[0], [][]byte{[]byte("a1")}, varlog.WithKeys([][]byte{[]byte("a"), []byte("b")}))
+	require.Error(t, res.Err)
+
+	res = client.AppendTo(context.Background(), topicID, lsids[0],
+		[][]byte{[]byte("a1"), []byte("b1"), []byte("a2")},
+		varlog.WithKeys([][]byte{[]byte("a"), []byte("b"), []byte("a")}),
+	)
+	require.NoError(t, res.Err)
+	res = client.AppendTo(context.Background(), topicID, lsids[1],
+		[][]byte{[]byte("a3"), []byte("c1")},
+		varlog.WithKeys([][]byte{[]byte("a"), nil}),
+	)
+	require.NoError(t, res.Err)
+
+	lookup := func(key string, opts ...varlog.LookupOption) ([]string, error) {
+		les, err := client.LookupByKey(context.Background(), topicID, []byte(key), opts...)
+		if err != nil {
+			return nil, err
+		}
+		var data []string
+		for _, le := range les {
+			require.Equal(t, topicID, le.TopicID)
+			require.Equal(t, key, string(le.Key))
+			data = append(data, string(le.Data))
+		}
+		return data, nil
+	}
+
+	data, err := lookup("a")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a1", "a2", "a3"}, data)
+
+	data, err = lookup("a", varlog.WithLatest())
+	require.NoError(t, err)
+	require.Equal(t, []string{"a3"}, data)
+
+	data, err = lookup("b")
+	require.NoError(t, err)
+	require.Equal(t, []string{"b1"}, data)
+
+	data, err = lookup("c")
+	require.NoError(t, err)
+	require.Empty(t, data)
+
+	// Backup replicas also have the key index.
+	clus.CloseSN(t, clus.PrimaryStorageNodeIDOf(t, lsids[0]))
+	require.Eventually(t, func() bool {
+		data, err := lookup("a")
+		return err == nil && assert.ObjectsAreEqual([]string{"a1", "a2", "a3"}, data)
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func TestClientCompactedTopic(t *testing.T) {
+	const topicID = types.TopicID(1)
+
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(2),
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(2),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+		it.WithCompactedTopics(),
+		it.WithStorageNodeOptions(
+			storagenode.WithCompactionInterval(100*time.Millisecond),
+		),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	require.Equal(t, topicID, clus.TopicIDs()[0])
+	lsids := clus.LogStreamIDs(topicID)
+	client := clus.ClientAtIndex(t, 0)
+
+	res := client.AppendTo(context.Background(), topicID, lsids[0],
+		[][]byte{[]byte("a1"), []byte("b1"), []byte("a2")},
+		varlog.WithKeys([][]byte{[]byte("a"), []byte("b"), []byte("a")}),
+	)
+	require.NoError(t, res.Err)
+	// tombstone of the key b
+	res = client.AppendTo(context.Background(), topicID, lsids[0], [][]byte{nil}, varlog.WithKeys([][]byte{[]byte("b")}))
+	require.NoError(t, res.Err)
+	res = client.AppendTo(context.Background(), topicID, lsids[1],
+		[][]byte{[]byte("c1"), []byte("u1"), []byte("c2")},
+		varlog.WithKeys([][]byte{[]byte("c"), nil, []byte("c")}),
+	)
+	require.NoError(t, res.Err)
+	last := res.Metadata[len(res.Metadata)-1]
+
+	subscribe := func(begin, end types.GLSN) []string {
+		data := []string{}
+		errC := make(chan error, 1)
+		closer, err := client.Subscribe(context.Background(), topicID, begin, end, func(le varlogpb.LogEntry, err error) {
+			if err != nil {
+				errC <- err
+				return
+			}
+			data = append(data, string(le.Data))
+		})
+		require.NoError(t, err)
+		defer closer()
+		select {
+		case err := <-errC:
+			require.ErrorIs(t, err, io.EOF)
+		case <-time.After(10 * time.Second):
+			require.FailNow(t, "subscribe: timeout")
+		}
+		return data
+	}
+
+	subscribeTo := func(lsid types.LogStreamID, begin, end types.LLSN) []types.LLSN {
+		llsns := []types.LLSN{}
+		subscriber := client.SubscribeTo(context.Background(), topicID, lsid, begin, end)
+		defer func() {
+			require.NoError(t, subscriber.Close())
+		}()
+		for {
+			le, err := subscriber.Next()
+			if err != nil {
+				require.ErrorIs(t, err, io.EOF)
+				return llsns
+			}
+			llsns = append(llsns, le.LLSN)
+		}
+	}
+
+	// Superseded log entries are removed eventually, and subscribers skip
+	// them.
+	require.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"a2", "", "u1", "c2"}, subscribe(types.MinGLSN, last.GLSN+1))
+	}, 10*time.Second, 100*time.Millisecond)
+	require.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]types.LLSN{3, 4}, subscribeTo(lsids[0], types.MinLLSN, 5))
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// Ranges ending with compacted log entries are also finished.
+	require.Eventually(t, func() bool {
+		return len(subscribe(types.MinGLSN, 3)) == 0
+	}, 10*time.Second, 100*time.Millisecond)
+	require.Empty(t, subscribeTo(lsids[0], types.MinLLSN, 3))
+
+	// The tombstone is the latest log entry of the key b.
+	les, err := client.LookupByKey(context.Background(), topicID, []byte("b"))
+	require.NoError(t, err)
+	require.Len(t, les, 1)
+	require.Empty(t, les[0].Data)
+}
+
 func TestVarlogSubscribeWithSNFail(t *testing.T) {
 	//defer goleak.VerifyNone(t)
 
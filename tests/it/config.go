@@ -12,6 +12,7 @@ import (
 	"github.com/kakao/varlog/internal/admin/mrmanager"
 	"github.com/kakao/varlog/internal/admin/snwatcher"
 	"github.com/kakao/varlog/internal/metarepos"
+	"github.com/kakao/varlog/internal/storagenode"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/testutil/ports"
 )
@@ -40,13 +41,15 @@ type config struct {
 	unsafeNoWAL       bool
 	reporterClientFac metarepos.ReporterClientFactory
 
-	numSN    int
-	numLS    int
-	numCL    int
-	numTopic int
+	numSN           int
+	numLS           int
+	numCL           int
+	numTopic        int
+	compactedTopics bool
 
 	mrMgrOpts []mrmanager.Option
 	VMSOpts   []admin.Option
+	snOpts    []storagenode.Option
 	logger    *zap.Logger
 
 	portBase      int
@@ -145,6 +148,14 @@ func WithVMSOptions(vmsOpts ...admin.Option) Option {
 	}
 }
 
+// WithStorageNodeOptions sets options applied to all storage nodes in the
+// cluster.
+func WithStorageNodeOptions(snOpts ...storagenode.Option) Option {
+	return func(c *config) {
+		c.snOpts = snOpts
+	}
+}
+
 func WithLogger(logger *zap.Logger) Option {
 	return func(c *config) {
 		c.logger = logger
@@ -175,6 +186,13 @@ func WithNumberOfTopics(numTopic int) Option {
 	}
 }
 
+// WithCompactedTopics makes topics added when the cluster starts compacted.
+func WithCompactedTopics() Option {
+	return func(c *config) {
+		c.compactedTopics = true
+	}
+}
+
 func WithNumberOfClients(numCL int) Option {
 	return func(c *config) {
 		c.numCL = numCL
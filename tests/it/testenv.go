@@ -176,6 +176,10 @@ func (clus *VarlogCluster) initSN(t *testing.T) {
 
 func (clus *VarlogCluster) initTopic(t *testing.T) {
 	for i := 0; i < clus.numTopic; i++ {
+		if clus.compactedTopics {
+			clus.AddCompactedTopic(t)
+			continue
+		}
 		clus.AddTopic(t)
 	}
 }
@@ -478,12 +482,12 @@ func (clus *VarlogCluster) AddSN(t *testing.T) types.StorageNodeID {
 
 	volume := t.TempDir()
 
-	sn := storagenode.TestNewSimpleStorageNode(t,
+	sn := storagenode.TestNewSimpleStorageNode(t, append([]storagenode.Option{
 		storagenode.WithClusterID(clus.clusterID),
 		storagenode.WithStorageNodeID(snID),
 		storagenode.WithVolumes(volume),
 		storagenode.WithLogger(clus.logger.Named("sn").With(zap.Int32("snid", int32(snID)))),
-	)
+	}, clus.snOpts...)...)
 
 	if _, ok := clus.snWGs[snID]; !ok {
 		clus.snWGs[snID] = new(sync.WaitGroup)
@@ -563,12 +567,12 @@ func (clus *VarlogCluster) RecoverSN(t *testing.T, snID types.StorageNodeID) *st
 	volume := clus.volumes[snID]
 	addr := clus.snAddrs[snID]
 
-	sn := storagenode.TestNewSimpleStorageNode(t,
+	sn := storagenode.TestNewSimpleStorageNode(t, append([]storagenode.Option{
 		storagenode.WithClusterID(clus.clusterID),
 		storagenode.WithStorageNodeID(snID),
 		storagenode.WithListenAddress(addr),
 		storagenode.WithVolumes(volume),
-	)
+	}, clus.snOpts...)...)
 
 	if _, ok := clus.snWGs[snID]; !ok {
 		clus.snWGs[snID] = new(sync.WaitGroup)
@@ -588,13 +592,22 @@ func (clus *VarlogCluster) RecoverSN(t *testing.T, snID types.StorageNodeID) *st
 }
 
 func (clus *VarlogCluster) AddTopic(t *testing.T) types.TopicID {
+	return clus.addTopic(t, clus.vmsCL.AddTopic)
+}
+
+// AddCompactedTopic adds a topic whose log stream replicas are compacted.
+func (clus *VarlogCluster) AddCompactedTopic(t *testing.T) types.TopicID {
+	return clus.addTopic(t, clus.vmsCL.AddCompactedTopic)
+}
+
+func (clus *VarlogCluster) addTopic(t *testing.T, add func(context.Context, ...varlog.AdminCallOption) (*varlogpb.TopicDescriptor, error)) types.TopicID {
 	clus.muSN.Lock()
 	defer clus.muSN.Unlock()
 
 	clus.muLS.Lock()
 	defer clus.muLS.Unlock()
 
-	topicDesc, err := clus.vmsCL.AddTopic(context.Background())
+	topicDesc, err := add(context.Background())
 	require.NoError(t, err)
 
 	topicID := topicDesc.GetTopicID()
@@ -730,6 +743,7 @@ func (clus *VarlogCluster) AddLSWithoutMR(t *testing.T, topicID types.TopicID) t
 			topicID,
 			lsID,
 			path,
+			false,
 		)
 		require.NoError(t, err)
 		_, err = volume.ParseDataDir(lsrmd.Path)
@@ -787,6 +801,7 @@ func (clus *VarlogCluster) AddLSIncomplete(t *testing.T, topicID types.TopicID)
 			topicID,
 			lsID,
 			path,
+			false,
 		)
 		require.NoError(t, err)
 	}
@@ -838,7 +853,7 @@ func (clus *VarlogCluster) UpdateLSWithoutMR(t *testing.T, topicID types.TopicID
 
 	path := meta.Storages[0].Path
 
-	_, err = clus.snMCLs[storageNodeID].AddLogStreamReplica(context.Background(), topicID, logStreamID, path)
+	_, err = clus.snMCLs[storageNodeID].AddLogStreamReplica(context.Background(), topicID, logStreamID, path, false)
 	require.NoError(t, err)
 
 	replicas[0] = &varlogpb.ReplicaDescriptor{